@@ -3,12 +3,14 @@ module github.com/JDetmar/pulumi-sendgrid
 go 1.24.7
 
 require (
+	github.com/aymerick/raymond v2.0.2+incompatible
 	github.com/blang/semver v3.5.1+incompatible
 	github.com/pulumi/providertest v0.6.0
 	github.com/pulumi/pulumi-go-provider v1.1.2
 	github.com/pulumi/pulumi-random/sdk/v4 v4.18.4
 	github.com/pulumi/pulumi/sdk/v3 v3.212.0
 	github.com/stretchr/testify v1.10.0
+	golang.org/x/net v0.47.0
 )
 
 require (
@@ -91,7 +93,6 @@ require (
 	golang.org/x/crypto v0.45.0 // indirect
 	golang.org/x/exp v0.0.0-20250718183923-645b1fa84792 // indirect
 	golang.org/x/mod v0.29.0 // indirect
-	golang.org/x/net v0.47.0 // indirect
 	golang.org/x/sync v0.18.0 // indirect
 	golang.org/x/sys v0.38.0 // indirect
 	golang.org/x/term v0.37.0 // indirect
@@ -0,0 +1,170 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// accountEmailID is the ID of the AccountEmail, as there is one per account
+const accountEmailID = "email"
+
+// AccountEmail is the controller for the SendGrid account email resource.
+//
+// This resource manages the contact email address of the account the provider
+// is configured for, e.g. a subuser through onBehalfOf. SendGrid accounts always
+// have an email address, so deleting the resource leaves the address in place.
+type AccountEmail struct{}
+
+// AccountEmailArgs are the inputs to the AccountEmail resource.
+type AccountEmailArgs struct {
+	// Email is the contact email address of the account (required)
+	Email string `pulumi:"email"`
+}
+
+// AccountEmailState is the state of the AccountEmail resource.
+type AccountEmailState struct {
+	// Embed the input args in the output state
+	AccountEmailArgs
+}
+
+// Annotate provides descriptions for the AccountEmail resource.
+func (a *AccountEmail) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&a, "Manages the contact email address of the SendGrid account.\n\n"+
+		"Sets the email address of the account the provider authenticates as, or of the subuser given "+
+		"by onBehalfOf, so accounts provisioned programmatically get the right contact address. "+
+		"There is one address per account, so only one of these resources should exist per provider. "+
+		"Deleting the resource leaves the address unchanged, as an account always has one.")
+}
+
+// accountEmailAPIResponse represents the SendGrid API response structure for the account email
+type accountEmailAPIResponse struct {
+	Email string `json:"email"`
+}
+
+// Check validates the AccountEmail inputs.
+func (a *AccountEmail) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[AccountEmailArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[AccountEmailArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[AccountEmailArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[AccountEmailArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid AccountEmailArgs
+func (args *AccountEmailArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.email("email", args.Email)
+	return v.failures
+}
+
+// Create sets the account email address.
+func (a *AccountEmail) Create(ctx context.Context, req infer.CreateRequest[AccountEmailArgs]) (infer.CreateResponse[AccountEmailState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return the expected state
+	if preview {
+		return infer.CreateResponse[AccountEmailState]{
+			ID:     accountEmailID,
+			Output: AccountEmailState{AccountEmailArgs: input},
+		}, nil
+	}
+
+	state, err := a.put(ctx, input)
+	if err != nil {
+		return infer.CreateResponse[AccountEmailState]{}, err
+	}
+
+	return infer.CreateResponse[AccountEmailState]{
+		ID:     accountEmailID,
+		Output: state,
+	}, nil
+}
+
+// put sets the account email address to match the args and returns the resulting state
+func (a *AccountEmail) put(ctx context.Context, input AccountEmailArgs) (AccountEmailState, error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return AccountEmailState{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	var result accountEmailAPIResponse
+	if err := client.Put(ctx, "/v3/user/email", map[string]interface{}{"email": input.Email}, &result); err != nil {
+		return AccountEmailState{}, fmt.Errorf("failed to update account email: %w", err)
+	}
+
+	return AccountEmailState{AccountEmailArgs: AccountEmailArgs{Email: result.Email}}, nil
+}
+
+// Read retrieves the current account email address.
+func (a *AccountEmail) Read(ctx context.Context, req infer.ReadRequest[AccountEmailArgs, AccountEmailState]) (infer.ReadResponse[AccountEmailArgs, AccountEmailState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[AccountEmailArgs, AccountEmailState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// The account always has an email address, so there is no not found case
+	var result accountEmailAPIResponse
+	if err := client.Get(ctx, "/v3/user/email", &result); err != nil {
+		return infer.ReadResponse[AccountEmailArgs, AccountEmailState]{}, fmt.Errorf("failed to read account email: %w", err)
+	}
+
+	state := AccountEmailState{AccountEmailArgs: AccountEmailArgs{Email: result.Email}}
+	inputs := state.AccountEmailArgs
+
+	return infer.ReadResponse[AccountEmailArgs, AccountEmailState]{
+		ID:     id,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// Update changes the account email address.
+func (a *AccountEmail) Update(ctx context.Context, req infer.UpdateRequest[AccountEmailArgs, AccountEmailState]) (infer.UpdateResponse[AccountEmailState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return expected state
+	if preview {
+		return infer.UpdateResponse[AccountEmailState]{Output: AccountEmailState{AccountEmailArgs: input}}, nil
+	}
+
+	state, err := a.put(ctx, input)
+	if err != nil {
+		return infer.UpdateResponse[AccountEmailState]{}, err
+	}
+	return infer.UpdateResponse[AccountEmailState]{Output: state}, nil
+}
+
+// Delete stops managing the account email address. SendGrid has no way to
+// remove it, so the address is left as it is.
+func (a *AccountEmail) Delete(ctx context.Context, req infer.DeleteRequest[AccountEmailState]) (infer.DeleteResponse, error) {
+	p.GetLogger(ctx).Infof("The account email address %s is left unchanged, as SendGrid accounts always have one", req.State.Email)
+	return infer.DeleteResponse{}, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestAccountEmail_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	email := "old@example.com"
+	var requests []string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method + " " + req.URL.Path {
+		case "PUT /v3/user/email":
+			var body map[string]string
+			data, _ := io.ReadAll(req.Body)
+			assert.NoError(t, json.Unmarshal(data, &body))
+			email = body["email"]
+		case "GET /v3/user/email":
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return fakeResponse(req, http.StatusOK, `{"email": "`+email+`"}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("AccountEmail", "contact")
+	inputs := property.NewMap(map[string]property.Value{
+		"email": property.New("ops@example.com"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "email", created.ID)
+	assert.Equal(t, "ops@example.com", created.Properties.Get("email").AsString())
+
+	read, err := server.Read(p.ReadRequest{ID: "email", Urn: urn, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "ops@example.com", read.Inputs.Get("email").AsString())
+
+	// Deleting leaves the address in place without calling SendGrid
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "email", Urn: urn, Properties: read.Properties}))
+	assert.Equal(t, []string{"PUT /v3/user/email", "GET /v3/user/email"}, requests)
+	assert.Equal(t, "ops@example.com", email)
+}
@@ -0,0 +1,179 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// accountPasswordID is the ID of the AccountPassword, as there is one per account
+const accountPasswordID = "password"
+
+// AccountPassword is the controller for the SendGrid account password resource.
+//
+// This resource rotates the password of the account the provider is configured
+// for. SendGrid never returns passwords, so the current one is kept in state as
+// a secret to authorize the next rotation.
+type AccountPassword struct{}
+
+// AccountPasswordArgs are the inputs to the AccountPassword resource.
+type AccountPasswordArgs struct {
+	// OldPassword is the password of the account before the first rotation (required).
+	// Later rotations use the password recorded in state instead.
+	OldPassword string `pulumi:"oldPassword" provider:"secret"`
+
+	// NewPassword is the password to set (required)
+	NewPassword string `pulumi:"newPassword" provider:"secret"`
+
+	// Triggers are arbitrary values that, when changed, mark a rotation (optional).
+	// A rotation needs a new password, e.g. from a random password with the same keepers.
+	Triggers map[string]string `pulumi:"triggers,optional"`
+}
+
+// AccountPasswordState is the state of the AccountPassword resource.
+type AccountPasswordState struct {
+	// Embed the input args in the output state
+	AccountPasswordArgs
+}
+
+// Annotate provides descriptions for the AccountPassword resource.
+func (a *AccountPassword) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&a, "Rotates the password of the SendGrid account.\n\n"+
+		"Creating the resource changes the password from oldPassword to newPassword. Each later change of "+
+		"newPassword rotates it again, using the previous newPassword as the old one, so account credentials "+
+		"can be rotated alongside API keys. Changing triggers without changing newPassword is an error.\n\n"+
+		"SendGrid never returns passwords, so refresh cannot detect a password changed elsewhere, and "+
+		"deleting the resource leaves the current password in place.")
+}
+
+// WireDependencies marks both passwords secret, as the current one is kept in state
+// to authorize the next rotation.
+func (a *AccountPassword) WireDependencies(f infer.FieldSelector, _ *AccountPasswordArgs, state *AccountPasswordState) {
+	f.OutputField(&state.OldPassword).AlwaysSecret()
+	f.OutputField(&state.NewPassword).AlwaysSecret()
+}
+
+// Check validates the AccountPassword inputs.
+func (a *AccountPassword) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[AccountPasswordArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[AccountPasswordArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[AccountPasswordArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[AccountPasswordArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid AccountPasswordArgs
+func (args *AccountPasswordArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("oldPassword", args.OldPassword)
+	v.required("newPassword", args.NewPassword)
+	return v.failures
+}
+
+// Diff compares the AccountPassword inputs with its state. The old password is only
+// used by the first rotation, so changing it afterwards is not reported as a change.
+func (a *AccountPassword) Diff(_ context.Context, req infer.DiffRequest[AccountPasswordArgs, AccountPasswordState]) (p.DiffResponse, error) {
+	olds := req.State.AccountPasswordArgs
+	olds.OldPassword = req.Inputs.OldPassword
+	return diffArgs(olds, req.Inputs), nil
+}
+
+// changePassword sets the account password
+func changePassword(ctx context.Context, oldPassword, newPassword string) error {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	reqBody := map[string]interface{}{
+		"old_password": oldPassword,
+		"new_password": newPassword,
+	}
+	if err := client.Put(ctx, "/v3/user/password", reqBody, nil); err != nil {
+		return fmt.Errorf("failed to change account password: %w", err)
+	}
+	return nil
+}
+
+// Create changes the account password from oldPassword to newPassword.
+func (a *AccountPassword) Create(ctx context.Context, req infer.CreateRequest[AccountPasswordArgs]) (infer.CreateResponse[AccountPasswordState], error) {
+	input := req.Inputs
+	state := AccountPasswordState{AccountPasswordArgs: input}
+
+	// During preview, return the expected state
+	if req.DryRun {
+		return infer.CreateResponse[AccountPasswordState]{ID: accountPasswordID, Output: state}, nil
+	}
+
+	if err := changePassword(ctx, input.OldPassword, input.NewPassword); err != nil {
+		return infer.CreateResponse[AccountPasswordState]{}, err
+	}
+
+	return infer.CreateResponse[AccountPasswordState]{ID: accountPasswordID, Output: state}, nil
+}
+
+// Read returns the recorded state, as SendGrid does not return passwords.
+func (a *AccountPassword) Read(_ context.Context, req infer.ReadRequest[AccountPasswordArgs, AccountPasswordState]) (infer.ReadResponse[AccountPasswordArgs, AccountPasswordState], error) {
+	return infer.ReadResponse[AccountPasswordArgs, AccountPasswordState]{
+		ID:     req.ID,
+		Inputs: req.Inputs,
+		State:  req.State,
+	}, nil
+}
+
+// Update rotates the account password from the one recorded in state to newPassword.
+func (a *AccountPassword) Update(ctx context.Context, req infer.UpdateRequest[AccountPasswordArgs, AccountPasswordState]) (infer.UpdateResponse[AccountPasswordState], error) {
+	input := req.Inputs
+	oldState := req.State
+
+	// The old password only matters for the first rotation, so keep the one recorded
+	state := AccountPasswordState{AccountPasswordArgs: input}
+	state.OldPassword = oldState.OldPassword
+
+	// During preview, return expected state; the new password may not be known yet
+	if req.DryRun {
+		return infer.UpdateResponse[AccountPasswordState]{Output: state}, nil
+	}
+
+	if input.NewPassword == oldState.NewPassword {
+		if !maps.Equal(input.Triggers, oldState.Triggers) {
+			return infer.UpdateResponse[AccountPasswordState]{}, fmt.Errorf("triggers changed but newPassword did not; " +
+				"rotating the account password requires a new password")
+		}
+		return infer.UpdateResponse[AccountPasswordState]{Output: state}, nil
+	}
+
+	if err := changePassword(ctx, oldState.NewPassword, input.NewPassword); err != nil {
+		return infer.UpdateResponse[AccountPasswordState]{}, err
+	}
+	return infer.UpdateResponse[AccountPasswordState]{Output: state}, nil
+}
+
+// Delete stops managing the account password. The current password is left in place.
+func (a *AccountPassword) Delete(ctx context.Context, _ infer.DeleteRequest[AccountPasswordState]) (infer.DeleteResponse, error) {
+	p.GetLogger(ctx).Infof("The account password is left unchanged")
+	return infer.DeleteResponse{}, nil
+}
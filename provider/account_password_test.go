@@ -0,0 +1,94 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestAccountPassword_Rotation(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var changes []map[string]string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if req.Method != http.MethodPut || req.URL.Path != "/v3/user/password" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`), nil
+		}
+		var body map[string]string
+		data, _ := io.ReadAll(req.Body)
+		assert.NoError(t, json.Unmarshal(data, &body))
+		changes = append(changes, body)
+		return fakeResponse(req, http.StatusOK, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("AccountPassword", "account")
+	inputs := property.NewMap(map[string]property.Value{
+		"oldPassword": property.New("initial").WithSecret(true),
+		"newPassword": property.New("first").WithSecret(true),
+		"triggers":    property.New(map[string]property.Value{"rotation": property.New("2026-01")}),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "password", created.ID)
+	assert.True(t, created.Properties.Get("newPassword").Secret())
+
+	// The old password only matters for the first rotation
+	diff, err := server.Diff(p.DiffRequest{
+		ID:     "password",
+		Urn:    urn,
+		State:  created.Properties,
+		Inputs: inputs.Set("oldPassword", property.New("forgotten").WithSecret(true)),
+	})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges)
+
+	// Later rotations change the password from the previous new password
+	rotated := inputs.
+		Set("newPassword", property.New("second").WithSecret(true)).
+		Set("triggers", property.New(map[string]property.Value{"rotation": property.New("2026-02")}))
+	updated, err := server.Update(p.UpdateRequest{ID: "password", Urn: urn, State: created.Properties, Inputs: rotated})
+	require.NoError(t, err)
+	assert.Equal(t, "second", updated.Properties.Get("newPassword").AsString())
+
+	// Changing only the triggers cannot rotate the password
+	_, err = server.Update(p.UpdateRequest{
+		ID:     "password",
+		Urn:    urn,
+		State:  updated.Properties,
+		Inputs: rotated.Set("triggers", property.New(map[string]property.Value{"rotation": property.New("2026-03")})),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires a new password")
+
+	assert.Equal(t, []map[string]string{
+		{"old_password": "initial", "new_password": "first"},
+		{"old_password": "first", "new_password": "second"},
+	}, changes)
+}
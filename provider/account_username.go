@@ -0,0 +1,225 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// accountUsernameID is the ID of the AccountUsername, as there is one per account
+const accountUsernameID = "username"
+
+// AccountUsername is the controller for the SendGrid account username resource.
+//
+// This resource manages the username of the account the provider is configured
+// for. SendGrid accounts always have a username, so deleting the resource leaves
+// it in place.
+type AccountUsername struct{}
+
+// AccountUsernameArgs are the inputs to the AccountUsername resource.
+type AccountUsernameArgs struct {
+	// Username is the username of the account (required)
+	Username string `pulumi:"username"`
+}
+
+// AccountUsernameState is the state of the AccountUsername resource.
+type AccountUsernameState struct {
+	// Embed the input args in the output state
+	AccountUsernameArgs
+
+	// UserID is the ID of the account
+	UserID int `pulumi:"userId"`
+}
+
+// Annotate provides descriptions for the AccountUsername resource.
+func (a *AccountUsername) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&a, "Manages the username of the SendGrid account.\n\n"+
+		"Sets the username of the account the provider authenticates as, or of the subuser given by "+
+		"onBehalfOf, so accounts provisioned programmatically get deterministic usernames. With "+
+		"validateOnPreview, previews fail when a subuser of the account already has the username. "+
+		"There is one username per account, so only one of these resources should exist per provider. "+
+		"Deleting the resource leaves the username unchanged, as an account always has one.")
+}
+
+// accountUsernameAPIResponse represents the SendGrid API response structure for the account username
+type accountUsernameAPIResponse struct {
+	Username string `json:"username"`
+	UserID   int    `json:"user_id"`
+}
+
+// Check validates the AccountUsername inputs. With validateOnPreview, it also
+// verifies that no subuser of the account already has the username.
+func (a *AccountUsername) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[AccountUsernameArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[AccountUsernameArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[AccountUsernameArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	if client := previewValidationClient(ctx); client != nil && len(failures) == 0 &&
+		inputsKnown(req.NewInputs, "username") && inputsChanged(req, "username") {
+		failures = append(failures, checkUsernameAvailable(ctx, client, inputs.Username)...)
+	}
+
+	return infer.CheckResponse[AccountUsernameArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid AccountUsernameArgs
+func (args *AccountUsernameArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("username", args.Username)
+	if v.known("username") && strings.ContainsAny(args.Username, " \t\n") {
+		v.fail("username", "must not contain whitespace (got %q)", args.Username)
+	}
+	return v.failures
+}
+
+// checkUsernameAvailable reports a failure if a subuser of the account already has the username.
+// Usernames are unique across SendGrid, but only the account's own subusers can be looked up.
+func checkUsernameAvailable(ctx context.Context, client SendGridAPI, username string) []p.CheckFailure {
+	var current accountUsernameAPIResponse
+	if err := client.Get(ctx, "/v3/user/username", &current); err != nil {
+		return lookupFailures(ctx, "username", "the account username", err)
+	}
+	if strings.EqualFold(current.Username, username) {
+		return nil
+	}
+
+	var subuser map[string]any
+	err := client.Get(ctx, fmt.Sprintf("/v3/subusers/%s", url.PathEscape(username)), &subuser)
+	var sgErr *SendGridError
+	switch {
+	case errors.As(err, &sgErr) && sgErr.IsNotFound():
+		return nil
+	case err != nil:
+		return lookupFailures(ctx, "username", "subusers", err)
+	}
+	return []p.CheckFailure{{
+		Property: "username",
+		Reason:   fmt.Sprintf("username %q is already used by a subuser of this account", username),
+	}}
+}
+
+// Create sets the account username.
+func (a *AccountUsername) Create(ctx context.Context, req infer.CreateRequest[AccountUsernameArgs]) (infer.CreateResponse[AccountUsernameState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, leave the outputs SendGrid assigns unset, so they are unknown
+	if preview {
+		return infer.CreateResponse[AccountUsernameState]{
+			ID:     accountUsernameID,
+			Output: AccountUsernameState{AccountUsernameArgs: input},
+		}, nil
+	}
+
+	state, err := a.put(ctx, input)
+	if err != nil {
+		return infer.CreateResponse[AccountUsernameState]{}, err
+	}
+
+	return infer.CreateResponse[AccountUsernameState]{
+		ID:     accountUsernameID,
+		Output: state,
+	}, nil
+}
+
+// put sets the account username to match the args and returns the resulting state
+func (a *AccountUsername) put(ctx context.Context, input AccountUsernameArgs) (AccountUsernameState, error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return AccountUsernameState{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	if err := client.Put(ctx, "/v3/user/username", map[string]interface{}{"username": input.Username}, nil); err != nil {
+		return AccountUsernameState{}, fmt.Errorf("failed to update account username: %w", err)
+	}
+
+	// PUT only echoes the username, so read back the user ID
+	var result accountUsernameAPIResponse
+	if err := client.Get(ctx, "/v3/user/username", &result); err != nil {
+		return AccountUsernameState{}, fmt.Errorf("failed to read account username: %w", err)
+	}
+	return result.toState(), nil
+}
+
+// toState converts an API response to AccountUsernameState
+func (r *accountUsernameAPIResponse) toState() AccountUsernameState {
+	return AccountUsernameState{
+		AccountUsernameArgs: AccountUsernameArgs{Username: r.Username},
+		UserID:              r.UserID,
+	}
+}
+
+// Read retrieves the current account username.
+func (a *AccountUsername) Read(ctx context.Context, req infer.ReadRequest[AccountUsernameArgs, AccountUsernameState]) (infer.ReadResponse[AccountUsernameArgs, AccountUsernameState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[AccountUsernameArgs, AccountUsernameState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// The account always has a username, so there is no not found case
+	var result accountUsernameAPIResponse
+	if err := client.Get(ctx, "/v3/user/username", &result); err != nil {
+		return infer.ReadResponse[AccountUsernameArgs, AccountUsernameState]{}, fmt.Errorf("failed to read account username: %w", err)
+	}
+
+	state := result.toState()
+	inputs := state.AccountUsernameArgs
+
+	return infer.ReadResponse[AccountUsernameArgs, AccountUsernameState]{
+		ID:     id,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// Update changes the account username.
+func (a *AccountUsername) Update(ctx context.Context, req infer.UpdateRequest[AccountUsernameArgs, AccountUsernameState]) (infer.UpdateResponse[AccountUsernameState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return expected state
+	if preview {
+		return infer.UpdateResponse[AccountUsernameState]{Output: AccountUsernameState{AccountUsernameArgs: input, UserID: req.State.UserID}}, nil
+	}
+
+	state, err := a.put(ctx, input)
+	if err != nil {
+		return infer.UpdateResponse[AccountUsernameState]{}, err
+	}
+	return infer.UpdateResponse[AccountUsernameState]{Output: state}, nil
+}
+
+// Delete stops managing the account username. SendGrid has no way to remove
+// it, so the username is left as it is.
+func (a *AccountUsername) Delete(ctx context.Context, req infer.DeleteRequest[AccountUsernameState]) (infer.DeleteResponse, error) {
+	p.GetLogger(ctx).Infof("The account username %s is left unchanged, as SendGrid accounts always have one", req.State.Username)
+	return infer.DeleteResponse{}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestAccountUsername_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	username := "parent"
+	var requests []string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method + " " + req.URL.Path {
+		case "PUT /v3/user/username":
+			var body map[string]string
+			data, _ := io.ReadAll(req.Body)
+			assert.NoError(t, json.Unmarshal(data, &body))
+			username = body["username"]
+			return fakeResponse(req, http.StatusOK, fmt.Sprintf(`{"username": %q}`, username)), nil
+		case "GET /v3/user/username":
+			return fakeResponse(req, http.StatusOK, fmt.Sprintf(`{"username": %q, "user_id": 1}`, username)), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("AccountUsername", "account")
+	inputs := property.NewMap(map[string]property.Value{
+		"username": property.New("acme-prod"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "username", created.ID)
+	assert.Equal(t, "acme-prod", created.Properties.Get("username").AsString())
+	assert.Equal(t, 1.0, created.Properties.Get("userId").AsNumber())
+
+	read, err := server.Read(p.ReadRequest{ID: "username", Urn: urn, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "acme-prod", read.Inputs.Get("username").AsString())
+
+	// Deleting leaves the username in place without calling SendGrid
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "username", Urn: urn, Properties: read.Properties}))
+	assert.Equal(t, []string{"PUT /v3/user/username", "GET /v3/user/username", "GET /v3/user/username"}, requests)
+	assert.Equal(t, "acme-prod", username)
+}
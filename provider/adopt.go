@@ -0,0 +1,103 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// alreadyExistsPhrases are the wordings SendGrid uses when a resource cannot be
+// created because an equivalent one exists, e.g. a webhook URL or group name in use
+var alreadyExistsPhrases = []string{"already exists", "already in use", "already been taken", "already taken", "duplicate"}
+
+// isAlreadyExistsError reports whether err is SendGrid refusing to create a
+// resource because an equivalent one already exists
+func isAlreadyExistsError(err error) bool {
+	var sgErr *SendGridError
+	if !errors.As(err, &sgErr) {
+		return false
+	}
+	if sgErr.StatusCode == http.StatusConflict {
+		return true
+	}
+	if sgErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+
+	messages := []string{sgErr.Message}
+	for _, detail := range sgErr.Errors {
+		messages = append(messages, detail.Message)
+	}
+	for _, message := range messages {
+		message = strings.ToLower(message)
+		for _, phrase := range alreadyExistsPhrases {
+			if strings.Contains(message, phrase) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// adoptExisting reports whether a resource should adopt an existing equivalent
+// when its creation fails with err
+func adoptExisting(adopt *bool, err error) bool {
+	return adopt != nil && *adopt && isAlreadyExistsError(err)
+}
+
+// adoptionFailed explains why a resource could be neither created nor adopted.
+// what names the resource, e.g. "unsubscribe group".
+func adoptionFailed(createErr, findErr error, what string) error {
+	if findErr != nil {
+		return fmt.Errorf("%w (looking up the existing %s to adopt failed: %v)", createErr, what, findErr)
+	}
+	return fmt.Errorf("%w (no existing %s to adopt was found)", createErr, what)
+}
+
+// createOrAdopt creates a resource with create unless adopt is set and find returns
+// an equivalent one, which is then returned for the caller to adopt. Looking first
+// keeps a retried deployment from creating a duplicate when an earlier attempt
+// created the resource but lost the response, e.g. to a network failure, on
+// endpoints that do not reject duplicates. A failed lookup only skips that check,
+// e.g. when the API key cannot list the resources. If create fails because the
+// resource exists, it is looked up again. what names the resource, e.g. "unsubscribe group".
+func createOrAdopt[T any](ctx context.Context, adopt *bool, what string, create func() error, find func() (*T, error)) (*T, error) {
+	if adopt == nil || !*adopt {
+		return nil, create()
+	}
+
+	existing, err := find()
+	if err != nil {
+		p.GetLogger(ctx).Warningf("Creating the %s without checking for an existing one to adopt, as looking it up failed: %v", what, err)
+	} else if existing != nil {
+		return existing, nil
+	}
+
+	createErr := create()
+	if createErr == nil || !isAlreadyExistsError(createErr) {
+		return nil, createErr
+	}
+	existing, findErr := find()
+	if findErr != nil || existing == nil {
+		return nil, adoptionFailed(createErr, findErr, what)
+	}
+	return existing, nil
+}
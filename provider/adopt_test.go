@@ -0,0 +1,342 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestIsAlreadyExistsError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "conflict", err: &SendGridError{StatusCode: http.StatusConflict}, want: true},
+		{
+			name: "webhook url in use",
+			err:  &SendGridError{StatusCode: http.StatusBadRequest, Errors: []SendGridErrorDetail{{Message: "URL is already in use by another webhook"}}},
+			want: true,
+		},
+		{
+			name: "group name exists",
+			err:  &SendGridError{StatusCode: http.StatusBadRequest, Message: "Name Already Exists"},
+			want: true,
+		},
+		{
+			name: "other validation error",
+			err:  &SendGridError{StatusCode: http.StatusBadRequest, Errors: []SendGridErrorDetail{{Message: "name is required"}}},
+		},
+		{name: "server error", err: &SendGridError{StatusCode: http.StatusInternalServerError, Message: "duplicate"}},
+		{name: "not a SendGrid error", err: io.EOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, isAlreadyExistsError(tt.err))
+		})
+	}
+}
+
+func TestCreate_AdoptExisting(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, handler func(*http.Request) *http.Response) integration.Server {
+		return newTestServer(t, RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return handler(req), nil
+		}), nil)
+	}
+	urn := func(typ string) resource.URN {
+		return resource.NewURN("test", "sendgrid", "", tokens.Type("sendgrid:index:"+typ), "adopted")
+	}
+
+	t.Run("event webhook", func(t *testing.T) {
+		t.Parallel()
+
+		var patched bool
+		server := newServer(t, func(req *http.Request) *http.Response {
+			switch req.Method + " " + req.URL.Path {
+			case "POST /v3/user/webhooks/event/settings":
+				return fakeResponse(req, http.StatusBadRequest, `{"errors": [{"message": "URL is already in use"}]}`)
+			case "GET /v3/user/webhooks/event/settings/all":
+				return fakeResponse(req, http.StatusOK, `{"max_allowed": 5, "webhooks": [
+					{"id": "wh-other", "url": "https://other.example.com"},
+					{"id": "wh-1", "url": "https://hooks.example.com/sendgrid", "enabled": false}
+				]}`)
+			case "PATCH /v3/user/webhooks/event/settings/wh-1":
+				patched = true
+				return fakeResponse(req, http.StatusOK, `{"id": "wh-1", "url": "https://hooks.example.com/sendgrid", "enabled": true, "bounce": true}`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`)
+		})
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: urn("EventWebhook"),
+			Properties: property.NewMap(map[string]property.Value{
+				"url":           property.New("https://hooks.example.com/sendgrid"),
+				"bounce":        property.New(true),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, patched)
+		assert.Equal(t, "wh-1", resp.ID)
+		assert.True(t, resp.Properties.Get("bounce").AsBool())
+		assert.True(t, resp.Properties.Get("adoptExisting").AsBool())
+	})
+
+	t.Run("unsubscribe group", func(t *testing.T) {
+		t.Parallel()
+
+		server := newServer(t, func(req *http.Request) *http.Response {
+			switch req.Method + " " + req.URL.Path {
+			case "POST /v3/asm/groups":
+				return fakeResponse(req, http.StatusBadRequest, `{"errors": [{"field": "name", "message": "This name already exists."}]}`)
+			case "GET /v3/asm/groups":
+				return fakeResponse(req, http.StatusOK, `[{"id": 42, "name": "Newsletter", "is_default": true}]`)
+			case "PATCH /v3/asm/groups/42":
+				body, _ := io.ReadAll(req.Body)
+				assert.JSONEq(t, `{"name": "Newsletter", "description": "Weekly news"}`, string(body))
+				return fakeResponse(req, http.StatusOK, `{"id": 42, "name": "Newsletter", "description": "Weekly news"}`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`)
+		})
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: urn("UnsubscribeGroup"),
+			Properties: property.NewMap(map[string]property.Value{
+				"name":          property.New("Newsletter"),
+				"description":   property.New("Weekly news"),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "42", resp.ID)
+		assert.True(t, resp.Properties.Get("isDefault").AsBool())
+	})
+
+	t.Run("unsubscribe group created concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		var lookups int
+		server := newServer(t, func(req *http.Request) *http.Response {
+			switch req.Method + " " + req.URL.Path {
+			case "GET /v3/asm/groups":
+				// The group appears between the lookup and the create
+				lookups++
+				if lookups == 1 {
+					return fakeResponse(req, http.StatusOK, `[]`)
+				}
+				return fakeResponse(req, http.StatusOK, `[{"id": 42, "name": "Newsletter"}]`)
+			case "POST /v3/asm/groups":
+				return fakeResponse(req, http.StatusBadRequest, `{"errors": [{"field": "name", "message": "This name already exists."}]}`)
+			case "PATCH /v3/asm/groups/42":
+				return fakeResponse(req, http.StatusOK, `{"id": 42, "name": "Newsletter"}`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`)
+		})
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: urn("UnsubscribeGroup"),
+			Properties: property.NewMap(map[string]property.Value{
+				"name":          property.New("Newsletter"),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "42", resp.ID)
+		assert.Equal(t, 2, lookups)
+	})
+
+	t.Run("alert created by an earlier attempt", func(t *testing.T) {
+		t.Parallel()
+
+		server := newServer(t, func(req *http.Request) *http.Response {
+			if req.Method+" "+req.URL.Path == "GET /v3/alerts" {
+				return fakeResponse(req, http.StatusOK, `[
+					{"id": 1, "type": "usage_limit", "email_to": "ops@example.com", "percentage": 80},
+					{"id": 2, "type": "usage_limit", "email_to": "ops@example.com", "percentage": 95, "created_at": 1700000000}
+				]`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`)
+		})
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: urn("Alert"),
+			Properties: property.NewMap(map[string]property.Value{
+				"type":          property.New("usage_limit"),
+				"emailTo":       property.New("ops@example.com"),
+				"percentage":    property.New(95.0),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2", resp.ID)
+		assert.Equal(t, 1700000000.0, resp.Properties.Get("createdAt").AsNumber())
+		assert.True(t, resp.Properties.Get("adoptExisting").AsBool())
+	})
+
+	t.Run("alert without an identical one", func(t *testing.T) {
+		t.Parallel()
+
+		var created bool
+		server := newServer(t, func(req *http.Request) *http.Response {
+			switch req.Method + " " + req.URL.Path {
+			case "GET /v3/alerts":
+				return fakeResponse(req, http.StatusOK, `[{"id": 1, "type": "usage_limit", "email_to": "ops@example.com", "percentage": 80}]`)
+			case "POST /v3/alerts":
+				created = true
+				return fakeResponse(req, http.StatusCreated, `{"id": 3, "type": "usage_limit", "email_to": "ops@example.com", "percentage": 95}`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`)
+		})
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: urn("Alert"),
+			Properties: property.NewMap(map[string]property.Value{
+				"type":          property.New("usage_limit"),
+				"emailTo":       property.New("ops@example.com"),
+				"percentage":    property.New(95.0),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "3", resp.ID)
+	})
+
+	t.Run("lookup before create fails", func(t *testing.T) {
+		t.Parallel()
+
+		var lookups int
+		server := newServer(t, func(req *http.Request) *http.Response {
+			switch req.Method + " " + req.URL.Path {
+			case "GET /v3/alerts":
+				// The key may create alerts without being allowed to list them
+				lookups++
+				return fakeResponse(req, http.StatusForbidden, `{"errors": [{"message": "access forbidden"}]}`)
+			case "POST /v3/alerts":
+				return fakeResponse(req, http.StatusCreated, `{"id": 3, "type": "usage_limit", "email_to": "ops@example.com", "percentage": 95}`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`)
+		})
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: urn("Alert"),
+			Properties: property.NewMap(map[string]property.Value{
+				"type":          property.New("usage_limit"),
+				"emailTo":       property.New("ops@example.com"),
+				"percentage":    property.New(95.0),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "3", resp.ID)
+		assert.Equal(t, 1, lookups)
+	})
+
+	t.Run("update of adopted resource fails", func(t *testing.T) {
+		t.Parallel()
+
+		server := newServer(t, func(req *http.Request) *http.Response {
+			switch req.Method + " " + req.URL.Path {
+			case "POST /v3/asm/groups":
+				return fakeResponse(req, http.StatusBadRequest, `{"errors": [{"field": "name", "message": "This name already exists."}]}`)
+			case "GET /v3/asm/groups":
+				return fakeResponse(req, http.StatusOK, `[{"id": 42, "name": "Newsletter", "description": "Old"}]`)
+			case "PATCH /v3/asm/groups/42":
+				return fakeResponse(req, http.StatusInternalServerError, `{"errors": [{"message": "internal error"}]}`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`)
+		})
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: urn("UnsubscribeGroup"),
+			Properties: property.NewMap(map[string]property.Value{
+				"name":          property.New("Newsletter"),
+				"description":   property.New("Weekly news"),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.Error(t, err)
+
+		// The group is recorded as found, so the next update retries the change
+		require.NotNil(t, resp.PartialState)
+		require.Len(t, resp.PartialState.Reasons, 1)
+		assert.Contains(t, resp.PartialState.Reasons[0], "failed to update adopted unsubscribe group 42")
+		assert.Equal(t, "42", resp.ID)
+		assert.Equal(t, "Old", resp.Properties.Get("description").AsString())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		server := newServer(t, func(req *http.Request) *http.Response {
+			assert.Equal(t, http.MethodPost, req.Method)
+			return fakeResponse(req, http.StatusBadRequest, `{"errors": [{"message": "This name already exists."}]}`)
+		})
+
+		_, err := server.Create(p.CreateRequest{
+			Urn: urn("UnsubscribeGroup"),
+			Properties: property.NewMap(map[string]property.Value{
+				"name": property.New("Newsletter"),
+			}),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create unsubscribe group")
+	})
+
+	t.Run("nothing to adopt", func(t *testing.T) {
+		t.Parallel()
+
+		server := newServer(t, func(req *http.Request) *http.Response {
+			if req.Method == http.MethodGet {
+				return fakeResponse(req, http.StatusOK, `[]`)
+			}
+			return fakeResponse(req, http.StatusBadRequest, `{"errors": [{"message": "This name already exists."}]}`)
+		})
+
+		_, err := server.Create(p.CreateRequest{
+			Urn: urn("UnsubscribeGroup"),
+			Properties: property.NewMap(map[string]property.Value{
+				"name":          property.New("Newsletter"),
+				"adoptExisting": property.New(true),
+			}),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no existing unsubscribe group to adopt was found")
+	})
+}
@@ -17,9 +17,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // Alert is the controller for the SendGrid Alert resource.
@@ -32,18 +35,26 @@ type Alert struct{}
 type AlertArgs struct {
 	// Type is the type of alert (required)
 	// Valid values: "usage_limit" or "stats_notification"
-	Type string `pulumi:"type"`
+	Type string `pulumi:"type" provider:"replaceOnChanges"`
 
 	// EmailTo is the email address to send alerts to (required)
 	EmailTo string `pulumi:"emailTo"`
 
-	// Percentage is the usage threshold for usage_limit alerts (required for usage_limit)
+	// Percentage is the usage threshold for usage_limit alerts (required for usage_limit, not allowed otherwise)
 	// Alerts are triggered when this percentage of your plan's email limit is reached
 	Percentage *int `pulumi:"percentage,optional"`
 
-	// Frequency is how often to send stats_notification alerts (required for stats_notification)
+	// Frequency is how often to send stats_notification alerts (required for stats_notification, not allowed otherwise)
 	// Valid values: "daily", "weekly", or "monthly"
 	Frequency *string `pulumi:"frequency,optional"`
+
+	// AdoptExisting takes over an alert with the same type, recipient and settings instead of
+	// creating a duplicate (optional, defaults to false)
+	AdoptExisting *bool `pulumi:"adoptExisting,optional"`
+
+	// DeletionProtection prevents the alert from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // AlertState is the state of the Alert resource.
@@ -67,7 +78,9 @@ func (a *Alert) Annotate(annotator infer.Annotator) {
 		"Alerts notify you via email about important account events. Two types are available:\n\n"+
 		"1. **usage_limit**: Notifies when your email usage reaches a specified percentage of your plan limit.\n"+
 		"2. **stats_notification**: Sends periodic email statistics (daily, weekly, or monthly).\n\n"+
-		"You can create multiple alerts of the same type with different email recipients.")
+		"You can create multiple alerts of the same type with different email recipients.\n\n"+
+		"SendGrid does not reject duplicate alerts, so with adoptExisting an identical alert is looked up "+
+		"before creating one, and a retried deployment takes it over instead of creating a second alert.")
 }
 
 // alertAPIResponse represents the SendGrid API response for alerts
@@ -106,6 +119,72 @@ func (r *alertAPIResponse) toState() AlertState {
 	}
 }
 
+// Check validates the Alert inputs.
+func (a *Alert) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[AlertArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[AlertArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[AlertArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[AlertArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid AlertArgs, including the settings required by each alert type
+func (args *AlertArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.oneOf("type", args.Type, "usage_limit", "stats_notification")
+	v.email("emailTo", args.EmailTo)
+	if args.Percentage != nil {
+		v.inRange("percentage", *args.Percentage, 1, 100)
+	}
+	if args.Frequency != nil {
+		v.oneOf("frequency", *args.Frequency, "daily", "weekly", "monthly")
+	}
+
+	// Each alert type requires its own setting and does not take the other type's
+	if v.known("type") {
+		switch args.Type {
+		case "usage_limit":
+			if args.Percentage == nil && v.known("percentage") {
+				v.fail("percentage", "is required for usage_limit alerts")
+			}
+			if args.Frequency != nil && v.known("frequency") {
+				v.fail("frequency", "is only allowed for stats_notification alerts")
+			}
+		case "stats_notification":
+			if args.Frequency == nil && v.known("frequency") {
+				v.fail("frequency", "is required for stats_notification alerts")
+			}
+			if args.Percentage != nil && v.known("percentage") {
+				v.fail("percentage", "is only allowed for usage_limit alerts")
+			}
+		}
+	}
+	return v.failures
+}
+
+// findAlert returns the alert with the type, recipient and settings of args, or nil if
+// there is none. Alerts differing only in settings, e.g. usage alerts at 80 and 95
+// percent, are separate alerts.
+func findAlert(ctx context.Context, client SendGridAPI, args AlertArgs) (*alertAPIResponse, error) {
+	// GET /v3/alerts returns a bare array of alerts
+	var result []alertAPIResponse
+	if err := client.Get(ctx, "/v3/alerts", &result); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		alert := result[i].toState().AlertArgs
+		if alert.Type == args.Type && alert.EmailTo == args.EmailTo &&
+			reflect.DeepEqual(alert.Percentage, args.Percentage) && reflect.DeepEqual(alert.Frequency, args.Frequency) {
+			return &result[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // Create creates a new SendGrid Alert.
 func (a *Alert) Create(ctx context.Context, req infer.CreateRequest[AlertArgs]) (infer.CreateResponse[AlertState], error) {
 	input := req.Inputs
@@ -119,7 +198,7 @@ func (a *Alert) Create(ctx context.Context, req infer.CreateRequest[AlertArgs])
 		return infer.CreateResponse[AlertState]{}, fmt.Errorf("frequency is required for stats_notification alerts")
 	}
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := AlertState{
 			AlertArgs: input,
@@ -128,7 +207,6 @@ func (a *Alert) Create(ctx context.Context, req infer.CreateRequest[AlertArgs])
 			UpdatedAt: 0,
 		}
 		return infer.CreateResponse[AlertState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -151,14 +229,24 @@ func (a *Alert) Create(ctx context.Context, req infer.CreateRequest[AlertArgs])
 		reqBody["frequency"] = *input.Frequency
 	}
 
-	// Make the API call to create alert
+	// Make the API call to create alert, or take over an identical one
 	// POST /v3/alerts
 	var result alertAPIResponse
-	if err := client.Post(ctx, "/v3/alerts", reqBody, &result); err != nil {
+	existing, err := createOrAdopt(ctx, input.AdoptExisting, "alert",
+		func() error { return client.Post(ctx, "/v3/alerts", reqBody, &result) },
+		func() (*alertAPIResponse, error) { return findAlert(ctx, client, input) })
+	if err != nil {
 		return infer.CreateResponse[AlertState]{}, fmt.Errorf("failed to create alert: %w", err)
 	}
+	if existing != nil {
+		p.GetLogger(ctx).Infof("Adopting existing %s alert %d for %s", existing.Type, existing.ID, existing.EmailTo)
+		result = *existing
+	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.AlertArgs, input, nil)
 
 	return infer.CreateResponse[AlertState]{
 		ID:     strconv.Itoa(result.ID),
@@ -178,16 +266,26 @@ func (a *Alert) Read(ctx context.Context, req infer.ReadRequest[AlertArgs, Alert
 
 	// Get alert details
 	// GET /v3/alerts/{alert_id}
-	var result alertAPIResponse
-	if err := client.Get(ctx, fmt.Sprintf("/v3/alerts/%s", id), &result); err != nil {
-		// Check if the resource was deleted out-of-band
-		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
-			return infer.ReadResponse[AlertArgs, AlertState]{}, nil
+	// Serve the alert from the cached list of all alerts when available,
+	// and fall back to reading it individually otherwise
+	result := findCached(ctx, client, "/v3/alerts", PageOptions{}, func(a *alertAPIResponse) bool {
+		return strconv.Itoa(a.ID) == id
+	})
+	if result == nil {
+		result = &alertAPIResponse{}
+		if err := client.Get(ctx, fmt.Sprintf("/v3/alerts/%s", id), result); err != nil {
+			// Check if the resource was deleted out-of-band
+			if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+				return infer.ReadResponse[AlertArgs, AlertState]{}, nil
+			}
+			return infer.ReadResponse[AlertArgs, AlertState]{}, fmt.Errorf("failed to read alert: %w", err)
 		}
-		return infer.ReadResponse[AlertArgs, AlertState]{}, fmt.Errorf("failed to read alert: %w", err)
 	}
 
 	state := result.toState()
+	state.AdoptExisting = req.Inputs.AdoptExisting
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	normalizeArgs(&state.AlertArgs, req.Inputs, nil)
 	inputs := state.AlertArgs
 
 	return infer.ReadResponse[AlertArgs, AlertState]{
@@ -248,6 +346,9 @@ func (a *Alert) Update(ctx context.Context, req infer.UpdateRequest[AlertArgs, A
 	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.AlertArgs, input, nil)
 
 	return infer.UpdateResponse[AlertState]{Output: state}, nil
 }
@@ -256,6 +357,10 @@ func (a *Alert) Update(ctx context.Context, req infer.UpdateRequest[AlertArgs, A
 func (a *Alert) Delete(ctx context.Context, req infer.DeleteRequest[AlertState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "alert", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
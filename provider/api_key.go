@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // ApiKey is the controller for the SendGrid API Key resource.
@@ -29,14 +31,18 @@ type ApiKey struct{} //nolint:revive // name matches Pulumi resource token
 
 // ApiKeyArgs are the inputs to the ApiKey resource.
 type ApiKeyArgs struct { //nolint:revive // name matches Pulumi resource token
-	// Name is the name of the API key (required)
-	Name string `pulumi:"name"`
+	// Name is the name of the API key (optional, defaults to an autoname from the resource name)
+	Name string `pulumi:"name,optional"`
 
 	// Scopes is the list of permissions for this API key (optional).
 	// If omitted, the key will have "Full Access" permissions by default.
 	// See https://www.twilio.com/docs/sendgrid/api-reference/api-key-permissions/api-key-permissions
 	// for available scopes.
 	Scopes []string `pulumi:"scopes,optional"`
+
+	// DeletionProtection prevents the API key from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // ApiKeyState is the state of the ApiKey resource.
@@ -62,20 +68,81 @@ func (a *ApiKey) Annotate(annotator infer.Annotator) {
 		"be retrieved again. Make sure to store it securely.")
 }
 
+// WireDependencies marks the API key value secret, as SendGrid only returns it on creation.
+func (a *ApiKey) WireDependencies(f infer.FieldSelector, _ *ApiKeyArgs, state *ApiKeyState) {
+	f.OutputField(&state.APIKeyValue).AlwaysSecret()
+}
+
+// Check validates the ApiKey inputs. With validateOnPreview, it also verifies
+// that no other key has the same name and that the provider's API key holds the
+// scopes the new key should be granted.
+func (a *ApiKey) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[ApiKeyArgs], error) {
+	newInputs, err := autoname(req, "name", 0)
+	if err != nil {
+		return infer.CheckResponse[ApiKeyArgs]{}, err
+	}
+	req.NewInputs = newInputs
+
+	inputs, failures, err := infer.DefaultCheck[ApiKeyArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[ApiKeyArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	if client := previewValidationClient(ctx); client != nil && len(failures) == 0 &&
+		inputsKnown(req.NewInputs, "scopes") && inputsChanged(req, "scopes") {
+		failures = append(failures, checkGrantableScopes(ctx, client, inputs.Scopes)...)
+	}
+	if client := previewValidationClient(ctx); client != nil && len(failures) == 0 &&
+		inputsKnown(req.NewInputs, "name") && inputsChanged(req, "name") {
+		failures = append(failures, checkAPIKeyNameAvailable(ctx, client, inputs.Name)...)
+	}
+
+	return infer.CheckResponse[ApiKeyArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid ApiKeyArgs
+func (args *ApiKeyArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("name", args.Name)
+	return v.failures
+}
+
+// checkAPIKeyNameAvailable reports a failure if an API key with the name already exists.
+// SendGrid allows duplicate names, but they make audits and key rotation ambiguous.
+func checkAPIKeyNameAvailable(ctx context.Context, client SendGridAPI, name string) []p.CheckFailure {
+	// GET /v3/api_keys lists the names and IDs of the account's keys
+	keys, err := GetCachedPages[struct {
+		APIKeyID string `json:"api_key_id"`
+		Name     string `json:"name"`
+	}](ctx, client, "/v3/api_keys", PageOptions{})
+	if err != nil {
+		return lookupFailures(ctx, "name", "API keys", err)
+	}
+	for _, key := range keys {
+		if key.Name == name {
+			return []p.CheckFailure{{
+				Property: "name",
+				Reason:   fmt.Sprintf("an API key named %q already exists (ID %s); choose a different name", name, key.APIKeyID),
+			}}
+		}
+	}
+	return nil
+}
+
 // Create creates a new SendGrid API Key.
 func (a *ApiKey) Create(ctx context.Context, req infer.CreateRequest[ApiKeyArgs]) (infer.CreateResponse[ApiKeyState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := ApiKeyState{
-			ApiKeyArgs:  input,
-			APIKeyID:    "[computed]",
-			APIKeyValue: "[computed]",
+			ApiKeyArgs: input,
 		}
 		return infer.CreateResponse[ApiKeyState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -109,12 +176,14 @@ func (a *ApiKey) Create(ctx context.Context, req infer.CreateRequest[ApiKeyArgs]
 	state := ApiKeyState{
 		ApiKeyArgs: ApiKeyArgs{
 			Name:   result.Name,
-			Scopes: result.Scopes,
+			Scopes: trackedScopes(input.Scopes, result.Scopes, true),
 		},
 		APIKeyID:    result.APIKeyID,
 		APIKeyValue: result.APIKey,
 	}
 
+	state.DeletionProtection = input.DeletionProtection
+
 	return infer.CreateResponse[ApiKeyState]{
 		ID:     result.APIKeyID,
 		Output: state,
@@ -148,11 +217,14 @@ func (a *ApiKey) Read(ctx context.Context, req infer.ReadRequest[ApiKeyArgs, Api
 		return infer.ReadResponse[ApiKeyArgs, ApiKeyState]{}, fmt.Errorf("failed to read API key: %w", err)
 	}
 
+	// Keys tracked without scopes have full access; an imported key records whatever it holds
+	scopes := trackedScopes(req.Inputs.Scopes, result.Scopes, oldState.APIKeyID != "")
+
 	// Update state with values from API
 	state := ApiKeyState{
 		ApiKeyArgs: ApiKeyArgs{
 			Name:   result.Name,
-			Scopes: result.Scopes,
+			Scopes: scopes,
 		},
 		APIKeyID: result.APIKeyID,
 		// Preserve the API key from old state since it can't be retrieved
@@ -161,9 +233,12 @@ func (a *ApiKey) Read(ctx context.Context, req infer.ReadRequest[ApiKeyArgs, Api
 
 	inputs := ApiKeyArgs{
 		Name:   result.Name,
-		Scopes: result.Scopes,
+		Scopes: scopes,
 	}
 
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	inputs.DeletionProtection = req.Inputs.DeletionProtection
+
 	return infer.ReadResponse[ApiKeyArgs, ApiKeyState]{
 		ID:     id,
 		Inputs: inputs,
@@ -218,13 +293,15 @@ func (a *ApiKey) Update(ctx context.Context, req infer.UpdateRequest[ApiKeyArgs,
 	state := ApiKeyState{
 		ApiKeyArgs: ApiKeyArgs{
 			Name:   result.Name,
-			Scopes: result.Scopes,
+			Scopes: trackedScopes(input.Scopes, result.Scopes, true),
 		},
 		APIKeyID: result.APIKeyID,
 		// Preserve the API key from old state since it can't be retrieved
 		APIKeyValue: oldState.APIKeyValue,
 	}
 
+	state.DeletionProtection = input.DeletionProtection
+
 	return infer.UpdateResponse[ApiKeyState]{Output: state}, nil
 }
 
@@ -232,6 +309,10 @@ func (a *ApiKey) Update(ctx context.Context, req infer.UpdateRequest[ApiKeyArgs,
 func (a *ApiKey) Delete(ctx context.Context, req infer.DeleteRequest[ApiKeyState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "API key", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
@@ -0,0 +1,112 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// apiKeyEnvVars are the environment variables checked for the API key, in order
+var apiKeyEnvVars = []string{"SENDGRID_API_KEY", "SG_API_KEY"}
+
+// apiKeyFileEnvVar names a file containing the API key, e.g. a mounted secret
+const apiKeyFileEnvVar = "SENDGRID_API_KEY_FILE"
+
+// resolveAPIKey returns the API key from, in order of precedence: the apiKey
+// config, the apiKeyFile config, the API key environment variables and the
+// file named by SENDGRID_API_KEY_FILE.
+func resolveAPIKey(apiKey, apiKeyFile *string, getenv func(string) string) (string, error) {
+	if apiKey != nil && *apiKey != "" {
+		return *apiKey, nil
+	}
+	if apiKeyFile != nil && *apiKeyFile != "" {
+		return readAPIKeyFile(*apiKeyFile)
+	}
+	for _, name := range apiKeyEnvVars {
+		if v := getenv(name); v != "" {
+			return v, nil
+		}
+	}
+	if path := getenv(apiKeyFileEnvVar); path != "" {
+		return readAPIKeyFile(path)
+	}
+
+	return "", fmt.Errorf("SendGrid API key is required. Set it via the 'apiKey' or 'apiKeyFile' provider config, "+
+		"or the %s or %s environment variables", strings.Join(apiKeyEnvVars, ", "), apiKeyFileEnvVar)
+}
+
+// readAPIKeyFile reads an API key from a file, ignoring surrounding whitespace
+func readAPIKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read SendGrid API key file: %w", err)
+	}
+	apiKey := strings.TrimSpace(string(data))
+	if apiKey == "" {
+		return "", fmt.Errorf("SendGrid API key file %s is empty", path)
+	}
+	return apiKey, nil
+}
+
+// apiKeyID returns the ID embedded in an API key of the usual SG.<id>.<secret>
+// form, or "" for keys of any other form
+func apiKeyID(key string) string {
+	parts := strings.Split(key, ".")
+	if len(parts) != 3 || parts[0] != "SG" {
+		return ""
+	}
+	return parts[1]
+}
+
+// validateAPIKey checks that the API key is accepted by SendGrid and grants
+// every required scope, so a bad key fails before any resource operation runs
+func validateAPIKey(ctx context.Context, client SendGridAPI, requiredScopes []string) error {
+	// GET /v3/scopes lists the scopes granted to the calling key
+	var result struct {
+		Scopes []string `json:"scopes"`
+	}
+	if err := client.Get(ctx, "/v3/scopes", &result); err != nil {
+		var sgErr *SendGridError
+		if errors.As(err, &sgErr) && (sgErr.StatusCode == http.StatusUnauthorized || sgErr.StatusCode == http.StatusForbidden) {
+			return fmt.Errorf("SendGrid API key is invalid or missing required scopes: %w", err)
+		}
+		return fmt.Errorf("failed to validate SendGrid API key: %w", err)
+	}
+
+	if missing := missingScopes(result.Scopes, requiredScopes); len(missing) > 0 {
+		return fmt.Errorf("SendGrid API key is invalid or missing required scopes: missing %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// missingScopes returns the required scopes that are not granted, in order
+func missingScopes(granted, required []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, scope := range granted {
+		grantedSet[scope] = true
+	}
+	var missing []string
+	for _, scope := range required {
+		if !grantedSet[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,136 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveAPIKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("SG.from-file\n"), 0o600))
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("  \n"), 0o600))
+
+	tests := []struct {
+		name       string
+		apiKey     *string
+		apiKeyFile *string
+		env        map[string]string
+		want       string
+		wantErr    string
+	}{
+		{name: "config wins", apiKey: strPtr("SG.config"), apiKeyFile: strPtr(keyFile), env: map[string]string{"SENDGRID_API_KEY": "SG.env"}, want: "SG.config"},
+		{name: "config file", apiKeyFile: strPtr(keyFile), env: map[string]string{"SENDGRID_API_KEY": "SG.env"}, want: "SG.from-file"},
+		{name: "primary env var", env: map[string]string{"SENDGRID_API_KEY": "SG.env", "SG_API_KEY": "SG.alt"}, want: "SG.env"},
+		{name: "alternate env var", env: map[string]string{"SG_API_KEY": "SG.alt"}, want: "SG.alt"},
+		{name: "file env var", env: map[string]string{"SENDGRID_API_KEY_FILE": keyFile}, want: "SG.from-file"},
+		{name: "empty file", apiKeyFile: strPtr(emptyFile), wantErr: "is empty"},
+		{name: "missing file", apiKeyFile: strPtr(filepath.Join(dir, "missing")), wantErr: "failed to read"},
+		{name: "nothing set", wantErr: "API key is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveAPIKey(tt.apiKey, tt.apiKeyFile, func(name string) string { return tt.env[name] })
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		responseStatus int
+		responseBody   string
+		requiredScopes []string
+		wantErr        string
+	}{
+		{
+			name:           "valid key",
+			responseStatus: http.StatusOK,
+			responseBody:   `{"scopes": ["templates.read", "templates.create"]}`,
+			requiredScopes: []string{"templates.create"},
+		},
+		{
+			name:           "missing scopes",
+			responseStatus: http.StatusOK,
+			responseBody:   `{"scopes": ["templates.read"]}`,
+			requiredScopes: []string{"templates.create", "alerts.read"},
+			wantErr:        "missing required scopes: missing templates.create, alerts.read",
+		},
+		{
+			name:           "invalid key",
+			responseStatus: http.StatusUnauthorized,
+			responseBody:   `{"errors": [{"message": "The provided authorization grant is invalid, expired, or revoked"}]}`,
+			wantErr:        "invalid or missing required scopes",
+		},
+		{
+			name:           "server error",
+			responseStatus: http.StatusInternalServerError,
+			wantErr:        "failed to validate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v3/scopes", r.URL.Path)
+				w.WriteHeader(tt.responseStatus)
+				_, _ = w.Write([]byte(tt.responseBody))
+			})
+
+			client := NewSendGridClient("test-api-key", server.URL)
+
+			err := validateAPIKey(context.Background(), client, tt.requiredScopes)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAPIKeyID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "abc123", apiKeyID("SG.abc123.secret"))
+	assert.Equal(t, "", apiKeyID("SG.fake"))
+	assert.Equal(t, "", apiKeyID("not.a.key"))
+}
@@ -0,0 +1,123 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// asyncJobState is the state of an asynchronous SendGrid job
+type asyncJobState int
+
+const (
+	// asyncJobPending means SendGrid is still working on the job
+	asyncJobPending asyncJobState = iota
+	// asyncJobDone means the job finished successfully
+	asyncJobDone
+	// asyncJobFailed means the job finished without doing its work
+	asyncJobFailed
+)
+
+// asyncJob describes an operation that SendGrid accepts with 202 Accepted and a job
+// ID, and then reports the progress of at a status endpoint, such as a marketing
+// contacts import or export. T is the status response of the job.
+type asyncJob[T any] struct {
+	// what describes the job in messages, e.g. "contacts import"
+	what string
+
+	// statusPath returns the path that reports the status of the job with the
+	// given ID, e.g. /v3/marketing/contacts/imports/{id}
+	statusPath func(id string) string
+
+	// state reports whether a status response is pending, done or failed, with the
+	// reason SendGrid gave for a failure
+	state func(status T) (asyncJobState, string)
+
+	// interval and timeout are the resource's polling settings, see waitSettings
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// asyncJobAccepted is the response SendGrid sends when it accepts a job. Imports
+// name the ID job_id, while exports name it id.
+type asyncJobAccepted struct {
+	JobID string `json:"job_id"`
+	ID    string `json:"id"`
+}
+
+// run sends the request that starts the job and waits for it to finish, returning
+// the job ID and its final status. A job that was started is reported with its ID
+// even when waiting fails, so the resource can record it.
+func (j asyncJob[T]) run(ctx context.Context, client SendGridAPI, method, path string, body interface{}) (string, T, error) {
+	var status T
+
+	var accepted asyncJobAccepted
+	var err error
+	switch method {
+	case http.MethodPost:
+		err = client.Post(ctx, path, body, &accepted)
+	case http.MethodPut:
+		err = client.Put(ctx, path, body, &accepted)
+	case http.MethodPatch:
+		err = client.Patch(ctx, path, body, &accepted)
+	default:
+		return "", status, fmt.Errorf("cannot start %s with %s", j.what, method)
+	}
+	if err != nil {
+		return "", status, fmt.Errorf("failed to start %s: %w", j.what, err)
+	}
+
+	id := accepted.JobID
+	if id == "" {
+		id = accepted.ID
+	}
+	if id == "" {
+		return "", status, fmt.Errorf("SendGrid accepted the %s without returning a job ID", j.what)
+	}
+
+	status, err = j.await(ctx, client, id)
+	return id, status, err
+}
+
+// await polls the status of the job with the given ID until it is done or failed,
+// returning its final status
+func (j asyncJob[T]) await(ctx context.Context, client SendGridAPI, id string) (T, error) {
+	var status T
+	what := fmt.Sprintf("%s %s", j.what, id)
+	err := pollUntil(ctx, j.interval, j.timeout, what, func(ctx context.Context) (bool, error) {
+		// Decode every response afresh, so fields left out of a later one do not linger
+		var current T
+		if err := client.Get(ctx, j.statusPath(url.PathEscape(id)), &current); err != nil {
+			return false, fmt.Errorf("failed to read the status of %s: %w", what, err)
+		}
+		status = current
+
+		switch state, reason := j.state(current); state {
+		case asyncJobDone:
+			return true, nil
+		case asyncJobFailed:
+			if reason == "" {
+				reason = "no reason given"
+			}
+			return false, fmt.Errorf("%s failed: %s", what, reason)
+		}
+		return false, nil
+	})
+	return status, err
+}
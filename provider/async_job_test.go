@@ -0,0 +1,133 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// contactsImportStatus is the status of a marketing contacts import
+type contactsImportStatus struct {
+	Status    string `json:"status"`
+	ErrorsURL string `json:"errors_url"`
+}
+
+// contactsImportJob follows marketing contacts imports, polling every millisecond
+func contactsImportJob(timeout time.Duration) asyncJob[contactsImportStatus] {
+	return asyncJob[contactsImportStatus]{
+		what:       "contacts import",
+		statusPath: func(id string) string { return "/v3/marketing/contacts/imports/" + id },
+		state: func(status contactsImportStatus) (asyncJobState, string) {
+			switch status.Status {
+			case "completed":
+				return asyncJobDone, ""
+			case "errored", "failed":
+				return asyncJobFailed, "the rejected contacts are listed at " + status.ErrorsURL
+			}
+			return asyncJobPending, ""
+		},
+		interval: time.Millisecond,
+		timeout:  timeout,
+	}
+}
+
+func TestAsyncJob(t *testing.T) {
+	t.Parallel()
+
+	// importServer accepts an import and reports the given statuses for it, one per poll
+	importServer := func(t *testing.T, statuses ...string) (SendGridAPI, func() []string) {
+		var mu sync.Mutex
+		var requests []string
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			switch r.Method + " " + r.URL.Path {
+			case "PUT /v3/marketing/contacts":
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"job_id": "job-1"}`))
+			case "GET /v3/marketing/contacts/imports/job-1":
+				status := statuses[0]
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+				_, _ = w.Write([]byte(`{"id": "job-1", "status": "` + status + `", "errors_url": "https://example.com/errors.csv"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		return NewSendGridClient("test-api-key", server.URL), func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), requests...)
+		}
+	}
+	body := map[string]interface{}{"contacts": []map[string]string{{"email": "ada@example.com"}}}
+
+	t.Run("polls until done", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := importServer(t, "pending", "pending", "completed")
+		id, status, err := contactsImportJob(time.Minute).run(context.Background(), client, http.MethodPut, "/v3/marketing/contacts", body)
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", id)
+		assert.Equal(t, "completed", status.Status)
+		assert.Equal(t, []string{
+			"PUT /v3/marketing/contacts",
+			"GET /v3/marketing/contacts/imports/job-1",
+			"GET /v3/marketing/contacts/imports/job-1",
+			"GET /v3/marketing/contacts/imports/job-1",
+		}, requests())
+	})
+
+	t.Run("reports failures with their reason", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := importServer(t, "pending", "errored")
+		id, status, err := contactsImportJob(time.Minute).run(context.Background(), client, http.MethodPut, "/v3/marketing/contacts", body)
+		require.EqualError(t, err, "contacts import job-1 failed: the rejected contacts are listed at https://example.com/errors.csv")
+		assert.Equal(t, "job-1", id)
+		assert.Equal(t, "errored", status.Status)
+	})
+
+	t.Run("gives up after the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := importServer(t, "pending")
+		id, _, err := contactsImportJob(5*time.Millisecond).run(context.Background(), client, http.MethodPut, "/v3/marketing/contacts", body)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "gave up waiting for contacts import job-1")
+		assert.Equal(t, "job-1", id)
+	})
+
+	t.Run("requires a job ID", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{}`))
+		})
+		client := NewSendGridClient("test-api-key", server.URL)
+		_, _, err := contactsImportJob(time.Minute).run(context.Background(), client, http.MethodPost, "/v3/marketing/contacts/exports", nil)
+		require.EqualError(t, err, "SendGrid accepted the contacts import without returning a job ID")
+	})
+}
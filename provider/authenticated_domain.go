@@ -0,0 +1,147 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
+)
+
+// AuthenticatedDomain is a component that authenticates a sending domain and brands
+// its links, creating a DomainAuthentication and a LinkBranding for the same domain.
+type AuthenticatedDomain struct {
+	pulumi.ResourceState
+
+	// DomainID is the ID of the domain authentication
+	DomainID pulumi.IntOutput `pulumi:"domainId"`
+
+	// LinkID is the ID of the link branding
+	LinkID pulumi.IntOutput `pulumi:"linkId"`
+
+	// Valid indicates if both the domain authentication and the link branding have been validated
+	Valid pulumi.BoolOutput `pulumi:"valid"`
+
+	// DNSRecords lists the DNS records of the domain authentication followed by those of the link branding
+	DNSRecords pulumix.Output[[]DomainDNSRecord] `pulumi:"dnsRecords"`
+
+	// ProviderRecords lists the same records as dnsRecords in the shape DNS provider resources expect
+	ProviderRecords pulumix.Output[[]DNSProviderRecord] `pulumi:"providerRecords"`
+}
+
+// AuthenticatedDomainArgs are the inputs to the AuthenticatedDomain component.
+type AuthenticatedDomainArgs struct {
+	// Domain is the domain to send from and brand links for (required)
+	Domain pulumi.StringInput `pulumi:"domain"`
+
+	// Subdomain is the subdomain used for domain authentication (optional)
+	Subdomain pulumi.StringPtrInput `pulumi:"subdomain,optional"`
+
+	// LinkSubdomain is the subdomain used for link branding (optional)
+	LinkSubdomain pulumi.StringPtrInput `pulumi:"linkSubdomain,optional"`
+
+	// AutomaticSecurity lets SendGrid manage the SPF and DKIM records (optional)
+	AutomaticSecurity pulumi.BoolPtrInput `pulumi:"automaticSecurity,optional"`
+
+	// Default makes the domain authentication and link branding the defaults (optional)
+	Default pulumi.BoolPtrInput `pulumi:"default,optional"`
+
+	// Region is the data-residency region of the domain: "global" or "eu" (optional)
+	Region pulumi.StringPtrInput `pulumi:"region,optional"`
+
+	// WaitForValidation makes both resources wait for their DNS records to validate (optional)
+	WaitForValidation pulumi.BoolPtrInput `pulumi:"waitForValidation,optional"`
+
+	// WaitTimeoutSeconds bounds each validation wait (optional)
+	WaitTimeoutSeconds pulumi.IntPtrInput `pulumi:"waitTimeoutSeconds,optional"`
+
+	// DeletionProtection prevents deleting either resource (optional)
+	DeletionProtection pulumi.BoolPtrInput `pulumi:"deletionProtection,optional"`
+}
+
+// Annotate provides descriptions for the AuthenticatedDomain component.
+func (a *AuthenticatedDomain) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&a, "Authenticates a SendGrid sending domain and brands its links.\n\n"+
+		"The component creates a DomainAuthentication and a LinkBranding for the same domain, "+
+		"as most sending domains need both, and exposes their DNS records as a single list. "+
+		"valid is true once both have been validated; set waitForValidation to wait for it "+
+		"during the deployment.")
+}
+
+// authenticatedDomainChild holds the outputs of a DomainAuthentication or LinkBranding
+// registered by an AuthenticatedDomain. Only one of domainId and linkId is set.
+type authenticatedDomainChild struct {
+	pulumi.CustomResourceState
+
+	DomainID        pulumi.IntOutput                    `pulumi:"domainId"`
+	LinkID          pulumi.IntOutput                    `pulumi:"linkId"`
+	Valid           pulumi.BoolOutput                   `pulumi:"valid"`
+	DNSRecords      pulumix.Output[[]DomainDNSRecord]   `pulumi:"dnsRecords"`
+	ProviderRecords pulumix.Output[[]DNSProviderRecord] `pulumi:"providerRecords"`
+}
+
+// NewAuthenticatedDomain registers an AuthenticatedDomain and its children.
+func NewAuthenticatedDomain(ctx *pulumi.Context, name string, args AuthenticatedDomainArgs, opts ...pulumi.ResourceOption) (*AuthenticatedDomain, error) {
+	comp := &AuthenticatedDomain{}
+	if err := ctx.RegisterComponentResource(p.GetTypeToken(ctx), name, comp, opts...); err != nil {
+		return nil, err
+	}
+
+	shared := pulumi.Map{"domain": args.Domain}
+	setInput(shared, "default", args.Default)
+	setInput(shared, "region", args.Region)
+	setInput(shared, "waitForValidation", args.WaitForValidation)
+	setInput(shared, "waitTimeoutSeconds", args.WaitTimeoutSeconds)
+	setInput(shared, "deletionProtection", args.DeletionProtection)
+
+	domainInputs := pulumi.Map{}
+	linkInputs := pulumi.Map{}
+	for k, v := range shared {
+		domainInputs[k] = v
+		linkInputs[k] = v
+	}
+	setInput(domainInputs, "subdomain", args.Subdomain)
+	setInput(domainInputs, "automaticSecurity", args.AutomaticSecurity)
+	setInput(linkInputs, "subdomain", args.LinkSubdomain)
+
+	var domain, link authenticatedDomainChild
+	if err := ctx.RegisterResource("sendgrid:index:DomainAuthentication", name, domainInputs, &domain, pulumi.Parent(comp)); err != nil {
+		return nil, err
+	}
+	if err := ctx.RegisterResource("sendgrid:index:LinkBranding", name, linkInputs, &link, pulumi.Parent(comp)); err != nil {
+		return nil, err
+	}
+
+	comp.DomainID = domain.DomainID
+	comp.LinkID = link.LinkID
+	comp.Valid = pulumi.All(domain.Valid, link.Valid).ApplyT(func(v []interface{}) bool {
+		return v[0].(bool) && v[1].(bool)
+	}).(pulumi.BoolOutput)
+	comp.DNSRecords = pulumix.Apply2(domain.DNSRecords, link.DNSRecords, func(a, b []DomainDNSRecord) []DomainDNSRecord {
+		return append(append([]DomainDNSRecord{}, a...), b...)
+	})
+	comp.ProviderRecords = pulumix.Apply2(domain.ProviderRecords, link.ProviderRecords, func(a, b []DNSProviderRecord) []DNSProviderRecord {
+		return append(append([]DNSProviderRecord{}, a...), b...)
+	})
+	return comp, nil
+}
+
+// setInput adds an optional input to inputs when it is set
+func setInput(inputs pulumi.Map, key string, value pulumi.Input) {
+	if value != nil {
+		inputs[key] = value
+	}
+}
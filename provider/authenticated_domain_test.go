@@ -0,0 +1,125 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func dnsRecordValue(name, recordType, host, data string, valid bool) property.Value {
+	return property.New(map[string]property.Value{
+		"name":  property.New(name),
+		"valid": property.New(valid),
+		"type":  property.New(recordType),
+		"host":  property.New(host),
+		"data":  property.New(data),
+	})
+}
+
+func providerRecordValue(recordType, host, data string) property.Value {
+	return property.New(map[string]property.Value{
+		"name":  property.New(host),
+		"type":  property.New(recordType),
+		"value": property.New(data),
+		"ttl":   property.New(3600.0),
+	})
+}
+
+func TestAuthenticatedDomain_Construct(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	registered := map[string]property.Map{}
+	monitor := &integration.MockResourceMonitor{
+		NewResourceF: func(args integration.MockResourceArgs) (string, property.Map, error) {
+			mu.Lock()
+			registered[string(args.TypeToken)] = args.Inputs
+			mu.Unlock()
+
+			state := args.Inputs.AsMap()
+			switch args.TypeToken {
+			case "sendgrid:index:DomainAuthentication":
+				state["domainId"] = property.New(11.0)
+				state["valid"] = property.New(true)
+				state["dnsRecords"] = property.New([]property.Value{
+					dnsRecordValue("mail_cname", "cname", "mail.example.com", "u1.wl.sendgrid.net", true),
+				})
+				state["providerRecords"] = property.New([]property.Value{
+					providerRecordValue("CNAME", "mail.example.com", "u1.wl.sendgrid.net"),
+				})
+				return "11", property.NewMap(state), nil
+			case "sendgrid:index:LinkBranding":
+				state["linkId"] = property.New(22.0)
+				state["valid"] = property.New(false)
+				state["dnsRecords"] = property.New([]property.Value{
+					dnsRecordValue("brand_cname", "cname", "links.example.com", "sendgrid.net", false),
+				})
+				state["providerRecords"] = property.New([]property.Value{
+					providerRecordValue("CNAME", "links.example.com", "sendgrid.net"),
+				})
+				return "22", property.NewMap(state), nil
+			}
+			return args.ID, property.Map{}, nil
+		},
+	}
+
+	server := newTestServer(t, nil, nil, integration.WithMocks(monitor))
+
+	resp, err := server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("stack", "project", "", "sendgrid:index:AuthenticatedDomain", "mail"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"domain":            property.New("example.com"),
+			"subdomain":         property.New("mail"),
+			"linkSubdomain":     property.New("links"),
+			"automaticSecurity": property.New(true),
+			"waitForValidation": property.New(true),
+		}),
+	})
+	require.NoError(t, err)
+
+	domainInputs := registered["sendgrid:index:DomainAuthentication"]
+	assert.Equal(t, "example.com", domainInputs.Get("domain").AsString())
+	assert.Equal(t, "mail", domainInputs.Get("subdomain").AsString())
+	assert.True(t, domainInputs.Get("automaticSecurity").AsBool())
+	assert.True(t, domainInputs.Get("waitForValidation").AsBool())
+
+	linkInputs := registered["sendgrid:index:LinkBranding"]
+	assert.Equal(t, "example.com", linkInputs.Get("domain").AsString())
+	assert.Equal(t, "links", linkInputs.Get("subdomain").AsString())
+	assert.True(t, linkInputs.Get("automaticSecurity").IsNull())
+	assert.True(t, linkInputs.Get("waitForValidation").AsBool())
+
+	assert.Equal(t, 11.0, resp.State.Get("domainId").AsNumber())
+	assert.Equal(t, 22.0, resp.State.Get("linkId").AsNumber())
+	assert.False(t, resp.State.Get("valid").AsBool(), "valid requires both children to be valid")
+
+	records := resp.State.Get("dnsRecords").AsArray()
+	require.Equal(t, 2, records.Len())
+	assert.Equal(t, "mail_cname", records.Get(0).AsMap().Get("name").AsString())
+	assert.Equal(t, "brand_cname", records.Get(1).AsMap().Get("name").AsString())
+
+	providerRecords := resp.State.Get("providerRecords").AsArray()
+	require.Equal(t, 2, providerRecords.Len())
+	assert.Equal(t, "links.example.com", providerRecords.Get(1).AsMap().Get("name").AsString())
+}
@@ -0,0 +1,49 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// autonameSuffixLength is the length of the random hex suffix of generated names
+const autonameSuffixLength = 7
+
+// autoname returns the new inputs with the given name input filled in when it is
+// left unset, Pulumi style: the resource name, a dash and a random suffix. The
+// name used by the previous inputs is kept, so a generated name stays stable
+// across updates. MaxLength is SendGrid's limit for the name, or 0 for none.
+func autoname(req infer.CheckRequest, key string, maxLength int) (property.Map, error) {
+	if v, ok := req.NewInputs.GetOk(key); ok && !v.IsNull() {
+		return req.NewInputs, nil
+	}
+	if old, ok := req.OldInputs.GetOk(key); ok && old.IsString() && old.AsString() != "" {
+		return req.NewInputs.Set(key, old), nil
+	}
+
+	prefix := req.Name + "-"
+	if maxLength > 0 && len(prefix)+autonameSuffixLength > maxLength {
+		prefix = prefix[:maxLength-autonameSuffixLength]
+	}
+	name, err := resource.NewUniqueHex(prefix, autonameSuffixLength, maxLength)
+	if err != nil {
+		return property.Map{}, fmt.Errorf("failed to generate %s: %w", key, err)
+	}
+	return req.NewInputs.Set(key, property.New(name)), nil
+}
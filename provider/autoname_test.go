@@ -0,0 +1,111 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestCheck_Autoname(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, nil, &calls)
+
+	tests := []struct {
+		typ    string
+		key    string
+		inputs map[string]property.Value
+	}{
+		{typ: "ApiKey", key: "name"},
+		{typ: "IpPool", key: "name"},
+		{typ: "Template", key: "name", inputs: map[string]property.Value{"generation": property.New("dynamic")}},
+		{typ: "UnsubscribeGroup", key: "name"},
+		{
+			typ: "VerifiedSender",
+			key: "nickname",
+			inputs: map[string]property.Value{
+				"fromEmail": property.New("support@example.com"),
+				"replyTo":   property.New("support@example.com"),
+				"address":   property.New("1 Main St"),
+				"city":      property.New("Denver"),
+				"country":   property.New("USA"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Check(p.CheckRequest{
+				Urn:    previewURN(tt.typ, "mail"),
+				Inputs: property.NewMap(tt.inputs),
+			})
+			require.NoError(t, err)
+			require.Empty(t, resp.Failures)
+			assert.Regexp(t, regexp.MustCompile(`^mail-[0-9a-f]{7}$`), resp.Inputs.Get(tt.key).AsString())
+		})
+	}
+}
+
+func TestCheck_AutonameKeepsNames(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, nil, &calls)
+
+	t.Run("explicit name", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Check(p.CheckRequest{
+			Urn:    previewURN("IpPool", "pool"),
+			Inputs: property.NewMap(map[string]property.Value{"name": property.New("marketing")}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "marketing", resp.Inputs.Get("name").AsString())
+	})
+
+	t.Run("previously generated name", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Check(p.CheckRequest{
+			Urn:    previewURN("IpPool", "pool"),
+			State:  property.NewMap(map[string]property.Value{"name": property.New("pool-1a2b3c4")}),
+			Inputs: property.Map{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "pool-1a2b3c4", resp.Inputs.Get("name").AsString())
+	})
+
+	t.Run("long resource name", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Check(p.CheckRequest{
+			Urn:    previewURN("UnsubscribeGroup", "weekly-product-and-company-newsletter"),
+			Inputs: property.Map{},
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Failures)
+		assert.Len(t, resp.Inputs.Get("name").AsString(), 30)
+	})
+}
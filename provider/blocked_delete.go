@@ -0,0 +1,119 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// blockedDeleteRetries is how many times a delete that SendGrid refuses because
+// other state still depends on the resource is retried
+const blockedDeleteRetries = 3
+
+// blockedDeleteInterval is the time between those retries, as SendGrid may take
+// a moment to notice that the dependent state is gone
+const blockedDeleteInterval = 2 * time.Second
+
+// permissionDeniedPhrases are the wordings of a 403 Forbidden that refuses a request
+// because of the API key's permissions rather than the state of the resource. They are
+// only consulted for endpoints whose required scope is not known.
+var permissionDeniedPhrases = []string{"access forbidden", "permission", "scope", "not authorized", "unauthorized"}
+
+// isBlockedDelete reports whether err refuses a delete with 409 Conflict, or with a
+// 403 Forbidden that is not about the API key's permissions, which SendGrid returns
+// for a resource that is still in use, e.g. a template with an active version
+func isBlockedDelete(err error) bool {
+	var sgErr *SendGridError
+	if !errors.As(err, &sgErr) {
+		return false
+	}
+	switch sgErr.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusForbidden:
+		return !isPermissionDenied(sgErr)
+	}
+	return false
+}
+
+// isPermissionDenied reports whether a SendGrid error refuses a request because the
+// API key is not allowed to make it: any 401 Unauthorized, and a 403 Forbidden from an
+// endpoint with a known required scope or whose message says so
+func isPermissionDenied(sgErr *SendGridError) bool {
+	switch {
+	case sgErr.StatusCode == http.StatusUnauthorized:
+		return true
+	case sgErr.StatusCode != http.StatusForbidden:
+		return false
+	case sgErr.RequiredScope != "":
+		return true
+	}
+
+	messages := []string{sgErr.Message}
+	for _, detail := range sgErr.Errors {
+		messages = append(messages, detail.Message)
+	}
+	for _, message := range messages {
+		message = strings.ToLower(message)
+		for _, phrase := range permissionDeniedPhrases {
+			if strings.Contains(message, phrase) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isNotFoundError reports whether err, or an error it wraps, is a 404 Not Found
+func isNotFoundError(err error) bool {
+	var sgErr *SendGridError
+	return errors.As(err, &sgErr) && sgErr.IsNotFound()
+}
+
+// deleteUnblocking deletes the resource at path. When SendGrid refuses because the
+// resource is still in use, unblock removes the dependent state, or returns an error
+// saying what has to be removed first, and the delete is retried a few times.
+// A resource that is already gone counts as deleted.
+func deleteUnblocking(ctx context.Context, client SendGridAPI, what, path string, interval time.Duration,
+	unblock func(context.Context) error,
+) error {
+	err := client.Delete(ctx, path)
+	for attempt := 0; attempt < blockedDeleteRetries && isBlockedDelete(err); attempt++ {
+		if unblockErr := unblock(ctx); unblockErr != nil {
+			if isNotFoundError(unblockErr) {
+				return nil
+			}
+			return fmt.Errorf("%v: %w", unblockErr, err)
+		}
+		if sleepErr := sleepContext(ctx, interval); sleepErr != nil {
+			return fmt.Errorf("stopped retrying the delete of %s: %w", what, err)
+		}
+		err = client.Delete(ctx, path)
+	}
+
+	if isNotFoundError(err) {
+		return nil
+	}
+	if isBlockedDelete(err) {
+		return fmt.Errorf("%s could not be deleted after %d retries, it may still be in use; "+
+			"remove what depends on it and try again: %w", what, blockedDeleteRetries, err)
+	}
+	return err
+}
@@ -0,0 +1,184 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteUnblocking(t *testing.T) {
+	t.Parallel()
+
+	// blockedServer refuses the first blocked deletes of /v3/things/1 with the given status
+	blockedServer := func(t *testing.T, status, blocked int) (*SendGridClient, func() []string) {
+		var mu sync.Mutex
+		var requests []string
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			if blocked > 0 {
+				blocked--
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"errors": [{"message": "resource is in use"}]}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+		return NewSendGridClient("test-api-key", server.URL), func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), requests...)
+		}
+	}
+
+	t.Run("retries after unblocking", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := blockedServer(t, http.StatusConflict, 2)
+		unblocked := 0
+		err := deleteUnblocking(context.Background(), client, "thing 1", "/v3/things/1", time.Millisecond,
+			func(context.Context) error { unblocked++; return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 2, unblocked)
+		assert.Len(t, requests(), 3)
+	})
+
+	t.Run("forbidden is retried too", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := blockedServer(t, http.StatusForbidden, 1)
+		err := deleteUnblocking(context.Background(), client, "thing 1", "/v3/things/1", time.Millisecond,
+			func(context.Context) error { return nil })
+		require.NoError(t, err)
+		assert.Len(t, requests(), 2)
+	})
+
+	t.Run("missing scope is not retried", func(t *testing.T) {
+		t.Parallel()
+
+		var requests int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": [{"field": null, "message": "access forbidden"}]}`))
+		})
+		client := NewSendGridClient("test-api-key", server.URL)
+		err := deleteUnblocking(context.Background(), client, "template d-1", "/v3/templates/d-1", time.Millisecond,
+			func(context.Context) error { t.Fatal("unblock called"); return nil })
+		assert.ErrorContains(t, err, "the API key may be missing the templates.delete scope")
+		assert.NotContains(t, err.Error(), "could not be deleted")
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("gone while unblocking", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := blockedServer(t, http.StatusConflict, 1)
+		err := deleteUnblocking(context.Background(), client, "thing 1", "/v3/things/1", time.Millisecond,
+			func(context.Context) error {
+				return fmt.Errorf("failed to list parts: %w", &SendGridError{StatusCode: http.StatusNotFound})
+			})
+		require.NoError(t, err)
+		assert.Len(t, requests(), 1)
+	})
+
+	t.Run("stops when unblocking needs the user", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := blockedServer(t, http.StatusConflict, 5)
+		err := deleteUnblocking(context.Background(), client, "thing 1", "/v3/things/1", time.Millisecond,
+			func(context.Context) error { return errors.New("thing 1 still has 2 parts; set forceDelete") })
+		assert.ErrorContains(t, err, "thing 1 still has 2 parts; set forceDelete")
+		assert.ErrorContains(t, err, "resource is in use")
+		var sgErr *SendGridError
+		assert.ErrorAs(t, err, &sgErr)
+		assert.Len(t, requests(), 1)
+	})
+
+	t.Run("gives up after the maximum number of retries", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := blockedServer(t, http.StatusConflict, 10)
+		err := deleteUnblocking(context.Background(), client, "thing 1", "/v3/things/1", time.Millisecond,
+			func(context.Context) error { return nil })
+		assert.ErrorContains(t, err, "thing 1 could not be deleted after 3 retries")
+		assert.Len(t, requests(), blockedDeleteRetries+1)
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := blockedServer(t, http.StatusNotFound, 1)
+		err := deleteUnblocking(context.Background(), client, "thing 1", "/v3/things/1", time.Millisecond,
+			func(context.Context) error { t.Fatal("unblock called"); return nil })
+		require.NoError(t, err)
+		assert.Len(t, requests(), 1)
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := blockedServer(t, http.StatusBadRequest, 1)
+		err := deleteUnblocking(context.Background(), client, "thing 1", "/v3/things/1", time.Millisecond,
+			func(context.Context) error { t.Fatal("unblock called"); return nil })
+		assert.ErrorContains(t, err, "resource is in use")
+		assert.Len(t, requests(), 1)
+	})
+}
+
+func TestIsBlockedDelete(t *testing.T) {
+	t.Parallel()
+
+	inUse := []SendGridErrorDetail{{Message: "resource is in use"}}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "conflict", err: &SendGridError{StatusCode: http.StatusConflict, Errors: inUse}, want: true},
+		{name: "wrapped conflict", err: fmt.Errorf("delete: %w", &SendGridError{StatusCode: http.StatusConflict}), want: true},
+		{name: "forbidden", err: &SendGridError{StatusCode: http.StatusForbidden, Errors: inUse}, want: true},
+		{
+			name: "forbidden with a known scope",
+			err:  &SendGridError{StatusCode: http.StatusForbidden, Errors: inUse, RequiredScope: "templates.delete"},
+		},
+		{
+			name: "forbidden for missing permissions",
+			err:  &SendGridError{StatusCode: http.StatusForbidden, Errors: []SendGridErrorDetail{{Message: "access forbidden"}}},
+		},
+		{name: "unauthorized", err: &SendGridError{StatusCode: http.StatusUnauthorized, Errors: inUse}},
+		{name: "not found", err: &SendGridError{StatusCode: http.StatusNotFound}},
+		{name: "not a SendGrid error", err: io.EOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, isBlockedDelete(tt.err))
+		})
+	}
+}
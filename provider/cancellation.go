@@ -0,0 +1,79 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// refreshHint tells users how to recover from an operation that stopped half-way
+const refreshHint = "run `pulumi refresh` to reconcile the stack with SendGrid"
+
+// interruptedError explains that a request was stopped because ctx is done, e.g.
+// because the user pressed Ctrl-C or the resource's custom timeout elapsed.
+// Interrupted writes may already have been applied by SendGrid.
+func interruptedError(ctx context.Context, method, path string, err error) error {
+	reason := "cancelled"
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		reason = "timed out"
+	}
+
+	// The query string is left out as it may contain email addresses
+	endpoint, _, _ := strings.Cut(path, "?")
+	if method == http.MethodGet {
+		return fmt.Errorf("%s %s %s: %w", method, endpoint, reason, err)
+	}
+	return fmt.Errorf("%s %s %s; the change may or may not have been applied, %s: %w", method, endpoint, reason, refreshHint, err)
+}
+
+// pollUntil calls check every interval until it reports done, returns an error,
+// or timeout elapses. Cancelling ctx stops polling promptly. what describes the
+// awaited condition for error messages, e.g. "domain validation".
+func pollUntil(ctx context.Context, interval, timeout time.Duration, what string, check func(context.Context) (bool, error)) error {
+	start := time.Now()
+	deadline := start.Add(timeout)
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if !time.Now().Add(interval).Before(deadline) {
+			return fmt.Errorf("gave up waiting for %s after %s", what, timeout)
+		}
+		if err := sleepContext(ctx, interval); err != nil {
+			return fmt.Errorf("stopped waiting for %s after %s; the resource exists but may not be ready, %s: %w",
+				what, time.Since(start).Round(time.Second), refreshHint, err)
+		}
+	}
+}
+
+// initFailed reports that a resource was created but a follow-up step failed.
+// Returned together with the resource's ID and state, it makes Pulumi record the
+// resource and retry the remaining steps on the next update, instead of losing
+// track of a resource that exists in SendGrid.
+func initFailed(step string, err error) error {
+	return infer.ResourceInitFailedError{Reasons: []string{fmt.Sprintf("failed to %s: %v", step, err)}}
+}
@@ -0,0 +1,182 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestSendGridClient_Cancellation(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+	t.Cleanup(func() { close(release) })
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	t.Run("read", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		start := time.Now()
+		err := client.Get(ctx, "/v3/teammates?email=someone@example.com", nil)
+		require.Error(t, err)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Contains(t, err.Error(), "GET /v3/teammates cancelled")
+	})
+
+	t.Run("write", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := client.Post(ctx, "/v3/templates", map[string]string{"name": "welcome"}, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "POST /v3/templates timed out; the change may or may not have been applied")
+		assert.Contains(t, err.Error(), "pulumi refresh")
+	})
+}
+
+func TestSendGridClient_CancellationKeepsAPIErrors(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client.observers = append(client.observers, observerFunc(func(RequestMeasurement) { cancel() }))
+
+	err := client.Get(ctx, "/v3/templates/missing", nil)
+	var sgErr *SendGridError
+	require.ErrorAs(t, err, &sgErr)
+	assert.True(t, sgErr.IsNotFound())
+}
+
+func TestPollUntil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("condition met", func(t *testing.T) {
+		t.Parallel()
+
+		var checks int32
+		err := pollUntil(context.Background(), time.Millisecond, time.Second, "validation", func(context.Context) (bool, error) {
+			return atomic.AddInt32(&checks, 1) == 3, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&checks))
+	})
+
+	t.Run("check fails", func(t *testing.T) {
+		t.Parallel()
+
+		err := pollUntil(context.Background(), time.Millisecond, time.Second, "validation", func(context.Context) (bool, error) {
+			return false, errors.New("boom")
+		})
+		require.EqualError(t, err, "boom")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		err := pollUntil(context.Background(), time.Millisecond, 5*time.Millisecond, "validation", func(context.Context) (bool, error) {
+			return false, nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "gave up waiting for validation after 5ms")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		start := time.Now()
+		err := pollUntil(ctx, time.Hour, 2*time.Hour, "validation", func(context.Context) (bool, error) {
+			return false, nil
+		})
+		require.Error(t, err)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Contains(t, err.Error(), "stopped waiting for validation")
+		assert.Contains(t, err.Error(), "pulumi refresh")
+	})
+}
+
+func TestInitFailed(t *testing.T) {
+	t.Parallel()
+
+	err := initFailed("disable subuser", errors.New("SendGrid API error (status 500)"))
+
+	var initErr infer.ResourceInitFailedError
+	require.ErrorAs(t, err, &initErr)
+	assert.Equal(t, []string{"failed to disable subuser: SendGrid API error (status 500)"}, initErr.Reasons)
+}
+
+func TestCreate_RecordsPartiallyCreatedSubuser(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.Method + " " + req.URL.Path {
+		case "POST /v3/subusers":
+			return fakeResponse(req, http.StatusCreated, `{"user_id": 3, "username": "tenant-a", "email": "a@example.com"}`), nil
+		case "PATCH /v3/subusers/tenant-a":
+			return fakeResponse(req, http.StatusInternalServerError, `{"errors": [{"message": "internal error"}]}`), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Create(p.CreateRequest{
+		Urn: previewURN("Subuser", "tenant"),
+		Properties: property.NewMap(map[string]property.Value{
+			"username": property.New("tenant-a"),
+			"email":    property.New("a@example.com"),
+			"password": property.New("pw"),
+			"disabled": property.New(true),
+		}),
+	})
+	require.Error(t, err)
+
+	// The subuser is recorded as enabled, so the next update disables it and a
+	// destroy deletes it
+	require.NotNil(t, resp.PartialState)
+	require.Len(t, resp.PartialState.Reasons, 1)
+	assert.Contains(t, resp.PartialState.Reasons[0], "failed to disable subuser")
+	assert.Equal(t, "tenant-a", resp.ID)
+	assert.Equal(t, 3.0, resp.Properties.Get("userId").AsNumber())
+	assert.False(t, resp.Properties.Get("disabled").AsBool())
+}
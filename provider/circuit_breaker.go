@@ -0,0 +1,132 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCircuitBreakerThreshold is the number of consecutive failures that trips the circuit breaker
+	DefaultCircuitBreakerThreshold = 5
+
+	// DefaultCircuitBreakerCooldown is how long the circuit breaker fails requests fast once tripped
+	DefaultCircuitBreakerCooldown = 30 * time.Second
+)
+
+// CircuitOpenError is returned without contacting SendGrid while the circuit breaker is open
+type CircuitOpenError struct {
+	// Failures is the number of consecutive failed requests that tripped the breaker
+	Failures int
+
+	// RetryAt is when the breaker will let a request through again
+	RetryAt time.Time
+
+	// LastError is the failure that tripped the breaker
+	LastError error
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("SendGrid API appears to be unavailable: %d consecutive requests failed (last error: %v); "+
+		"failing fast until %s, check https://status.sendgrid.com",
+		e.Failures, e.LastError, e.RetryAt.Format(time.RFC3339))
+}
+
+// circuitBreaker stops sending requests after consecutive server errors or
+// timeouts, so a degraded API is not hammered for the rest of a deployment.
+// After the cooldown a single probe request is let through; if it succeeds the
+// breaker closes, otherwise it stays open for another cooldown.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	lastErr   error
+	openUntil time.Time
+	probing   bool
+}
+
+// WithCircuitBreaker trips the client's circuit breaker after threshold consecutive
+// 5xx responses or network failures and fails requests fast for cooldown.
+// A non-positive threshold disables the breaker.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) ClientOption {
+	return func(c *SendGridClient) {
+		if threshold <= 0 {
+			c.breaker = nil
+			return
+		}
+		c.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown}
+	}
+}
+
+// allow reports whether a request may be sent, returning a CircuitOpenError if not
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+	if time.Now().Before(b.openUntil) || b.probing {
+		return &CircuitOpenError{Failures: b.failures, RetryAt: b.openUntil, LastError: b.lastErr}
+	}
+
+	// Cooldown has passed: let a single probe request through
+	b.probing = true
+	return nil
+}
+
+// record updates the breaker with the outcome of a request
+func (b *circuitBreaker) record(resp *http.Response, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !isServiceFailure(resp, err) {
+		b.failures = 0
+		b.lastErr = nil
+		return
+	}
+
+	b.failures++
+	if err != nil {
+		b.lastErr = err
+	} else {
+		b.lastErr = fmt.Errorf("status %d", resp.StatusCode)
+	}
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
+
+// abandon releases a probe whose request was cancelled before it completed
+func (b *circuitBreaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// isServiceFailure reports whether a request outcome indicates that SendGrid
+// itself is unhealthy. Client errors and rate limiting do not count.
+func isServiceFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= 500
+}
@@ -0,0 +1,146 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("trips after consecutive server errors", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL, WithCircuitBreaker(3, time.Minute))
+
+		for i := 0; i < 3; i++ {
+			err := client.Get(context.Background(), "/v3/templates", nil)
+			var sgErr *SendGridError
+			require.True(t, errors.As(err, &sgErr))
+		}
+
+		err := client.Get(context.Background(), "/v3/templates", nil)
+		var openErr *CircuitOpenError
+		require.True(t, errors.As(err, &openErr))
+		assert.Equal(t, 3, openErr.Failures)
+		assert.Contains(t, err.Error(), "appears to be unavailable")
+		assert.Contains(t, err.Error(), "status 503")
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("client errors do not trip the breaker", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL, WithCircuitBreaker(2, time.Minute))
+
+		for i := 0; i < 5; i++ {
+			err := client.Get(context.Background(), "/v3/templates/missing", nil)
+			var sgErr *SendGridError
+			require.True(t, errors.As(err, &sgErr))
+			assert.True(t, sgErr.IsNotFound())
+		}
+	})
+
+	t.Run("closes after a successful probe", func(t *testing.T) {
+		t.Parallel()
+
+		var healthy atomic.Bool
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			if healthy.Load() {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL, WithCircuitBreaker(1, 20*time.Millisecond))
+
+		require.Error(t, client.Get(context.Background(), "/v3/templates", nil))
+		var openErr *CircuitOpenError
+		require.True(t, errors.As(client.Get(context.Background(), "/v3/templates", nil), &openErr))
+
+		healthy.Store(true)
+		time.Sleep(30 * time.Millisecond)
+
+		require.NoError(t, client.Get(context.Background(), "/v3/templates", nil))
+		require.NoError(t, client.Get(context.Background(), "/v3/templates", nil))
+	})
+
+	t.Run("a request cancelled while waiting does not hold the probe", func(t *testing.T) {
+		t.Parallel()
+
+		var healthy atomic.Bool
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			if healthy.Load() {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL,
+			WithCircuitBreaker(1, 20*time.Millisecond), WithRateLimit(10, 1))
+
+		require.Error(t, client.Get(context.Background(), "/v3/templates", nil))
+		healthy.Store(true)
+		time.Sleep(30 * time.Millisecond)
+
+		// The breaker is half-open, and the rate limiter holds this request until it is cancelled
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := client.Get(ctx, "/v3/templates", nil)
+		require.Error(t, err)
+		var openErr *CircuitOpenError
+		assert.False(t, errors.As(err, &openErr))
+
+		require.NoError(t, client.Get(context.Background(), "/v3/templates", nil))
+	})
+}
+
+func TestCircuitBreaker_Probe(t *testing.T) {
+	t.Parallel()
+
+	breaker := &circuitBreaker{threshold: 1, cooldown: 0}
+	breaker.record(nil, errors.New("connection refused"))
+
+	// The first caller after the cooldown probes, others keep failing fast
+	require.NoError(t, breaker.allow())
+	var openErr *CircuitOpenError
+	require.True(t, errors.As(breaker.allow(), &openErr))
+	assert.Contains(t, openErr.Error(), "connection refused")
+
+	// An abandoned probe lets the next caller probe instead
+	breaker.abandon()
+	require.NoError(t, breaker.allow())
+}
@@ -0,0 +1,63 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultMaxConcurrentRequests is the number of requests the provider has in flight
+// at once when maxConcurrentRequests is not configured. Pulumi runs many resource
+// operations in parallel, which easily exceeds what SendGrid accepts.
+const DefaultMaxConcurrentRequests = 10
+
+// concurrencyLimiter bounds how many requests are in flight at once. One limiter is
+// shared by every resource using the same provider instance.
+type concurrencyLimiter struct {
+	slots chan struct{}
+}
+
+// newConcurrencyLimiter creates a limiter that allows up to maxConcurrent requests in flight
+func newConcurrencyLimiter(maxConcurrent int) *concurrencyLimiter {
+	return &concurrencyLimiter{slots: make(chan struct{}, maxConcurrent)}
+}
+
+// WithMaxConcurrentRequests limits the client to maxConcurrent requests in flight at once.
+// A non-positive value removes the limit.
+func WithMaxConcurrentRequests(maxConcurrent int) ClientOption {
+	return func(c *SendGridClient) {
+		if maxConcurrent <= 0 {
+			c.concurrency = nil
+			return
+		}
+		c.concurrency = newConcurrencyLimiter(maxConcurrent)
+	}
+}
+
+// acquire blocks until a request slot is free or the context is done
+func (l *concurrencyLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for a free request slot: %w", ctx.Err())
+	}
+}
+
+// release frees a slot taken by acquire
+func (l *concurrencyLimiter) release() {
+	<-l.slots
+}
@@ -0,0 +1,83 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyLimiter_Acquire(t *testing.T) {
+	t.Parallel()
+
+	limiter := newConcurrencyLimiter(1)
+	require.NoError(t, limiter.acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := limiter.acquire(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	limiter.release()
+	require.NoError(t, limiter.acquire(context.Background()))
+}
+
+func TestSendGridClient_MaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, peak int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL, WithMaxConcurrentRequests(3))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.Get(context.Background(), "/v3/templates", nil))
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+	assert.Positive(t, atomic.LoadInt32(&peak))
+}
+
+func TestWithMaxConcurrentRequests_Disabled(t *testing.T) {
+	t.Parallel()
+
+	client := NewSendGridClient("test-api-key", "", WithMaxConcurrentRequests(2), WithMaxConcurrentRequests(0))
+	assert.Nil(t, client.concurrency)
+}
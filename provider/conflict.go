@@ -0,0 +1,141 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxConflictRetries is how many times an update rejected with 409 Conflict or
+// 412 Precondition Failed is retried after re-reading the resource
+const maxConflictRetries = 2
+
+// ConflictError is returned when an update keeps conflicting with concurrent
+// changes to the resource, e.g. edits made in the SendGrid console
+type ConflictError struct {
+	// Endpoint is the path of the resource that was updated
+	Endpoint string
+
+	// Differences lists the updated fields whose current value differs from the requested one
+	Differences []FieldDifference
+
+	// Err is the last error returned by SendGrid
+	Err *SendGridError
+}
+
+// FieldDifference is a field whose current value differs from the value an update requested
+type FieldDifference struct {
+	Field     string
+	Current   interface{}
+	Requested interface{}
+}
+
+func (e *ConflictError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "update of %s conflicts with a concurrent change", e.Endpoint)
+	if len(e.Differences) > 0 {
+		parts := make([]string, len(e.Differences))
+		for i, d := range e.Differences {
+			parts[i] = fmt.Sprintf("%s (current %s, requested %s)", d.Field, formatJSONValue(d.Current), formatJSONValue(d.Requested))
+		}
+		fmt.Fprintf(&b, "; fields that differ: %s", strings.Join(parts, ", "))
+	}
+	fmt.Fprintf(&b, "; run `pulumi refresh` to review the current state: %v", e.Err)
+	return b.String()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// isConflict reports whether err rejects an update because the resource changed concurrently
+func isConflict(err error) bool {
+	var sgErr *SendGridError
+	return errors.As(err, &sgErr) &&
+		(sgErr.StatusCode == http.StatusConflict || sgErr.StatusCode == http.StatusPreconditionFailed)
+}
+
+// patchResolvingConflicts sends a PATCH request. When SendGrid rejects it because the
+// resource changed concurrently, the resource is re-read and the update retried.
+// If the conflict persists, a ConflictError describes how the resource differs.
+func (c *SendGridClient) patchResolvingConflicts(ctx context.Context, path string, body interface{}, result interface{}) error {
+	_, err := c.doRequest(ctx, http.MethodPatch, path, body, result)
+
+	var current json.RawMessage
+	for attempt := 0; attempt < maxConflictRetries && isConflict(err); attempt++ {
+		// Re-read the resource so the retry is applied on top of its latest version
+		current = nil
+		if _, getErr := c.doRequest(ctx, http.MethodGet, path, nil, &current); getErr != nil {
+			break
+		}
+		_, err = c.doRequest(ctx, http.MethodPatch, path, body, result)
+	}
+
+	if !isConflict(err) {
+		return err
+	}
+	var sgErr *SendGridError
+	errors.As(err, &sgErr)
+	endpoint, _, _ := strings.Cut(path, "?")
+	return &ConflictError{
+		Endpoint:    endpoint,
+		Differences: fieldDifferences(body, current),
+		Err:         sgErr,
+	}
+}
+
+// fieldDifferences compares the top-level fields of an update request body with
+// the current representation of the resource, returning the fields that differ
+func fieldDifferences(body interface{}, current json.RawMessage) []FieldDifference {
+	if len(current) == 0 {
+		return nil
+	}
+	requested, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	var requestedFields, currentFields map[string]interface{}
+	if json.Unmarshal(requested, &requestedFields) != nil || json.Unmarshal(current, &currentFields) != nil {
+		return nil
+	}
+
+	var differences []FieldDifference
+	for field, value := range requestedFields {
+		if !reflect.DeepEqual(currentFields[field], value) {
+			differences = append(differences, FieldDifference{Field: field, Current: currentFields[field], Requested: value})
+		}
+	}
+	sort.Slice(differences, func(i, j int) bool { return differences[i].Field < differences[j].Field })
+	return differences
+}
+
+// formatJSONValue formats a decoded JSON value for an error message
+func formatJSONValue(value interface{}) string {
+	if value == nil {
+		return "unset"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
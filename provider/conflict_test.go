@@ -0,0 +1,115 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_PatchConflict(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retried after re-reading", func(t *testing.T) {
+		t.Parallel()
+
+		var patches, reads int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v3/asm/groups/42", r.URL.Path)
+			if r.Method == http.MethodGet {
+				atomic.AddInt32(&reads, 1)
+				_, _ = w.Write([]byte(`{"id": 42, "name": "Edited in console"}`))
+				return
+			}
+			if atomic.AddInt32(&patches, 1) == 1 {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id": 42, "name": "Newsletter"}`))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		var result unsubscribeGroupAPIResponse
+		err := client.Patch(context.Background(), "/v3/asm/groups/42", map[string]interface{}{"name": "Newsletter"}, &result)
+		require.NoError(t, err)
+		assert.Equal(t, "Newsletter", result.Name)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&patches))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&reads))
+	})
+
+	t.Run("persistent conflict", func(t *testing.T) {
+		t.Parallel()
+
+		var patches int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`{"id": 42, "name": "Edited in console", "description": "Weekly news"}`))
+				return
+			}
+			atomic.AddInt32(&patches, 1)
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_, _ = w.Write([]byte(`{"errors": [{"message": "resource was modified"}]}`))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		err := client.Patch(context.Background(), "/v3/asm/groups/42", map[string]interface{}{
+			"name":        "Newsletter",
+			"description": "Weekly news",
+			"is_default":  true,
+		}, nil)
+		require.Error(t, err)
+		assert.Equal(t, int32(1+maxConflictRetries), atomic.LoadInt32(&patches))
+
+		var conflictErr *ConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		assert.Equal(t, []FieldDifference{
+			{Field: "is_default", Current: nil, Requested: true},
+			{Field: "name", Current: "Edited in console", Requested: "Newsletter"},
+		}, conflictErr.Differences)
+		assert.Contains(t, err.Error(), `name (current "Edited in console", requested "Newsletter")`)
+		assert.Contains(t, err.Error(), "is_default (current unset, requested true)")
+
+		// The underlying API error stays accessible
+		var sgErr *SendGridError
+		require.True(t, errors.As(err, &sgErr))
+		assert.Equal(t, http.StatusPreconditionFailed, sgErr.StatusCode)
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		err := client.Patch(context.Background(), "/v3/asm/groups/42", map[string]interface{}{"name": "x"}, nil)
+		var sgErr *SendGridError
+		require.ErrorAs(t, err, &sgErr)
+		assert.Equal(t, http.StatusBadRequest, sgErr.StatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// ContactDbList is the controller for the SendGrid Legacy Marketing Campaigns contact list resource.
+//
+// This resource manages a list in the legacy contact database (ContactDB), for
+// accounts that have not migrated to new Marketing Campaigns. It is only
+// available when the provider's enableLegacyContactDb setting is true.
+type ContactDbList struct{}
+
+// ContactDbListArgs are the inputs to the ContactDbList resource.
+type ContactDbListArgs struct {
+	// Name is the name of the list (required)
+	Name string `pulumi:"name"`
+
+	// DeletionProtection prevents the list from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// ContactDbListState is the state of the ContactDbList resource.
+type ContactDbListState struct {
+	// Embed the input args in the output state
+	ContactDbListArgs
+
+	// ListID is the ID assigned by SendGrid (returned from API)
+	ListID int `pulumi:"listId"`
+
+	// RecipientCount is the number of recipients on the list
+	RecipientCount int `pulumi:"recipientCount"`
+}
+
+// Annotate provides descriptions for the ContactDbList resource.
+func (l *ContactDbList) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&l, "Manages a list in the SendGrid Legacy Marketing Campaigns contact database.\n\n"+
+		"Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, "+
+		"so this resource requires the provider's `enableLegacyContactDb` setting. Deleting the list "+
+		"leaves its recipients in the contact database.")
+}
+
+// contactDbListAPIResponse represents the SendGrid API response structure for legacy contact lists
+type contactDbListAPIResponse struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	RecipientCount int    `json:"recipient_count"`
+}
+
+// toState converts an API response to ContactDbListState
+func (r *contactDbListAPIResponse) toState() ContactDbListState {
+	return ContactDbListState{
+		ContactDbListArgs: ContactDbListArgs{
+			Name: r.Name,
+		},
+		ListID:         r.ID,
+		RecipientCount: r.RecipientCount,
+	}
+}
+
+// checkLegacyContactDbEnabled returns an error unless the provider enables the
+// legacy contact database, which SendGrid rejects for migrated accounts
+func checkLegacyContactDbEnabled(ctx context.Context, resource string) error {
+	config := infer.GetConfig[Config](ctx)
+	if config.EnableLegacyContactDb == nil || !*config.EnableLegacyContactDb {
+		return fmt.Errorf("%s manages the Legacy Marketing Campaigns contact database; "+
+			"set the provider's enableLegacyContactDb to true to use it", resource)
+	}
+	return nil
+}
+
+// Check validates the ContactDbList inputs.
+func (l *ContactDbList) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[ContactDbListArgs], error) {
+	if err := checkLegacyContactDbEnabled(ctx, "ContactDbList"); err != nil {
+		return infer.CheckResponse[ContactDbListArgs]{}, err
+	}
+
+	inputs, failures, err := infer.DefaultCheck[ContactDbListArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[ContactDbListArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[ContactDbListArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid ContactDbListArgs
+func (args *ContactDbListArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("name", args.Name)
+	return v.failures
+}
+
+// Create creates a new SendGrid legacy contact list.
+func (l *ContactDbList) Create(ctx context.Context, req infer.CreateRequest[ContactDbListArgs]) (infer.CreateResponse[ContactDbListState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
+	if preview {
+		return infer.CreateResponse[ContactDbListState]{
+			Output: ContactDbListState{ContactDbListArgs: input},
+		}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.CreateResponse[ContactDbListState]{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	// Make the API call
+	reqBody := map[string]interface{}{
+		"name": input.Name,
+	}
+	var result contactDbListAPIResponse
+	if err := client.Post(ctx, "/v3/contactdb/lists", reqBody, &result); err != nil {
+		return infer.CreateResponse[ContactDbListState]{}, fmt.Errorf("failed to create contact list: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+
+	// Use the list ID as the Pulumi resource ID
+	return infer.CreateResponse[ContactDbListState]{
+		ID:     strconv.Itoa(result.ID),
+		Output: state,
+	}, nil
+}
+
+// Read retrieves the current state of a SendGrid legacy contact list.
+func (l *ContactDbList) Read(ctx context.Context, req infer.ReadRequest[ContactDbListArgs, ContactDbListState]) (infer.ReadResponse[ContactDbListArgs, ContactDbListState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[ContactDbListArgs, ContactDbListState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	var result contactDbListAPIResponse
+	if err := client.Get(ctx, fmt.Sprintf("/v3/contactdb/lists/%s", id), &result); err != nil {
+		// Check if the resource was deleted out-of-band
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			// Return empty response to indicate resource no longer exists
+			return infer.ReadResponse[ContactDbListArgs, ContactDbListState]{}, nil
+		}
+		return infer.ReadResponse[ContactDbListArgs, ContactDbListState]{}, fmt.Errorf("failed to read contact list: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	inputs := state.ContactDbListArgs
+
+	return infer.ReadResponse[ContactDbListArgs, ContactDbListState]{
+		ID:     id,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// Update renames a SendGrid legacy contact list.
+func (l *ContactDbList) Update(ctx context.Context, req infer.UpdateRequest[ContactDbListArgs, ContactDbListState]) (infer.UpdateResponse[ContactDbListState], error) {
+	id := req.ID
+	input := req.Inputs
+	oldState := req.State
+	preview := req.DryRun
+
+	// During preview, return expected state
+	if preview {
+		state := ContactDbListState{
+			ContactDbListArgs: input,
+			ListID:            oldState.ListID,
+			RecipientCount:    oldState.RecipientCount,
+		}
+		return infer.UpdateResponse[ContactDbListState]{Output: state}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.UpdateResponse[ContactDbListState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// Make the API call (PATCH to rename the list)
+	reqBody := map[string]interface{}{
+		"name": input.Name,
+	}
+	var result contactDbListAPIResponse
+	if err := client.Patch(ctx, fmt.Sprintf("/v3/contactdb/lists/%s", id), reqBody, &result); err != nil {
+		return infer.UpdateResponse[ContactDbListState]{}, fmt.Errorf("failed to update contact list: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+
+	return infer.UpdateResponse[ContactDbListState]{Output: state}, nil
+}
+
+// Delete removes a SendGrid legacy contact list, keeping its recipients.
+func (l *ContactDbList) Delete(ctx context.Context, req infer.DeleteRequest[ContactDbListState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "contact list", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// Make the API call
+	if err := client.Delete(ctx, fmt.Sprintf("/v3/contactdb/lists/%s", id)); err != nil {
+		// If already deleted, that's fine
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete contact list: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// contactDbServer starts a provider with the legacy contact database enabled
+// or not, whose SendGrid API is faked by handle
+func contactDbServer(t *testing.T, enabled bool, handle func(*http.Request) (int, string)) integration.Server {
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		status, body := handle(req)
+		return fakeResponse(req, status, body), nil
+	})
+
+	return newTestServer(t, transport, map[string]property.Value{"enableLegacyContactDb": property.New(enabled)})
+}
+
+func TestContactDb_FeatureFlag(t *testing.T) {
+	t.Parallel()
+
+	handle := func(*http.Request) (int, string) {
+		t.Error("Check must not call SendGrid")
+		return http.StatusNotFound, `{}`
+	}
+	inputs := map[string]property.Map{
+		"ContactDbList":      property.NewMap(map[string]property.Value{"name": property.New("legacy")}),
+		"ContactDbRecipient": property.NewMap(map[string]property.Value{"email": property.New("john@example.com")}),
+	}
+
+	disabled := contactDbServer(t, false, handle)
+	enabled := contactDbServer(t, true, handle)
+	for typ, news := range inputs {
+		_, err := disabled.Check(p.CheckRequest{Urn: previewURN(typ, "legacy"), Inputs: news})
+		require.Error(t, err, typ)
+		assert.Contains(t, err.Error(), "enableLegacyContactDb", typ)
+
+		resp, err := enabled.Check(p.CheckRequest{Urn: previewURN(typ, "legacy"), Inputs: news})
+		require.NoError(t, err, typ)
+		assert.Empty(t, resp.Failures, typ)
+	}
+}
+
+func TestContactDbList_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var requests []string
+	server := contactDbServer(t, true, func(req *http.Request) (int, string) {
+		mu.Lock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		mu.Unlock()
+		switch req.Method + " " + req.URL.Path {
+		case "POST /v3/contactdb/lists":
+			return http.StatusCreated, `{"id": 12, "name": "legacy", "recipient_count": 0}`
+		case "GET /v3/contactdb/lists/12":
+			return http.StatusOK, `{"id": 12, "name": "legacy", "recipient_count": 3}`
+		case "PATCH /v3/contactdb/lists/12":
+			return http.StatusOK, `{"id": 12, "name": "renamed", "recipient_count": 3}`
+		case "DELETE /v3/contactdb/lists/12":
+			return http.StatusAccepted, ``
+		}
+		return http.StatusNotFound, `{"errors": [{"message": "not found"}]}`
+	})
+
+	urn := previewURN("ContactDbList", "legacy")
+	created, err := server.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: property.NewMap(map[string]property.Value{"name": property.New("legacy")}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "12", created.ID)
+	assert.Equal(t, 12.0, created.Properties.Get("listId").AsNumber())
+
+	read, err := server.Read(p.ReadRequest{ID: "12", Urn: urn, Properties: created.Properties})
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, read.Properties.Get("recipientCount").AsNumber())
+
+	updated, err := server.Update(p.UpdateRequest{
+		ID:     "12",
+		Urn:    urn,
+		State:  read.Properties,
+		Inputs: property.NewMap(map[string]property.Value{"name": property.New("renamed")}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", updated.Properties.Get("name").AsString())
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "12", Urn: urn, Properties: updated.Properties}))
+
+	assert.Equal(t, []string{
+		"POST /v3/contactdb/lists",
+		"GET /v3/contactdb/lists/12",
+		"PATCH /v3/contactdb/lists/12",
+		"DELETE /v3/contactdb/lists/12",
+	}, requests)
+}
@@ -0,0 +1,346 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// ContactDbRecipient is the controller for the SendGrid Legacy Marketing Campaigns recipient resource.
+//
+// This resource manages a recipient in the legacy contact database (ContactDB)
+// and the lists it belongs to. It is only available when the provider's
+// enableLegacyContactDb setting is true.
+type ContactDbRecipient struct{}
+
+// ContactDbRecipientArgs are the inputs to the ContactDbRecipient resource.
+type ContactDbRecipientArgs struct {
+	// Email is the email address of the recipient (required, changing it replaces the recipient)
+	Email string `pulumi:"email" provider:"replaceOnChanges"`
+
+	// FirstName is the first name of the recipient (optional)
+	FirstName *string `pulumi:"firstName,optional"`
+
+	// LastName is the last name of the recipient (optional)
+	LastName *string `pulumi:"lastName,optional"`
+
+	// ListIDs are the IDs of the contact lists the recipient belongs to (optional)
+	ListIDs []int `pulumi:"listIds,optional"`
+
+	// DeletionProtection prevents the recipient from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// ContactDbRecipientState is the state of the ContactDbRecipient resource.
+type ContactDbRecipientState struct {
+	// Embed the input args in the output state
+	ContactDbRecipientArgs
+
+	// RecipientID is the ID assigned by SendGrid, derived from the email address
+	RecipientID string `pulumi:"recipientId"`
+}
+
+// Annotate provides descriptions for the ContactDbRecipient resource.
+func (r *ContactDbRecipient) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&r, "Manages a recipient in the SendGrid Legacy Marketing Campaigns contact database.\n\n"+
+		"Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, "+
+		"so this resource requires the provider's `enableLegacyContactDb` setting. The recipient is added to "+
+		"the lists in `listIds`, e.g. the `listId` of a `ContactDbList`, and removed from lists dropped from it.")
+}
+
+// contactDbRecipientAPIResponse represents the SendGrid API response structure for legacy recipients
+type contactDbRecipientAPIResponse struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+// contactDbRecipientsWriteResponse represents the SendGrid API response to adding or updating recipients
+type contactDbRecipientsWriteResponse struct {
+	PersistedRecipients []string `json:"persisted_recipients"`
+	ErrorCount          int      `json:"error_count"`
+	Errors              []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// toState converts an API response to ContactDbRecipientState
+func (r *contactDbRecipientAPIResponse) toState(listIDs []int) ContactDbRecipientState {
+	var firstName, lastName *string
+	if r.FirstName != "" {
+		firstName = &r.FirstName
+	}
+	if r.LastName != "" {
+		lastName = &r.LastName
+	}
+
+	return ContactDbRecipientState{
+		ContactDbRecipientArgs: ContactDbRecipientArgs{
+			Email:     r.Email,
+			FirstName: firstName,
+			LastName:  lastName,
+			ListIDs:   listIDs,
+		},
+		RecipientID: r.ID,
+	}
+}
+
+// Check validates the ContactDbRecipient inputs.
+func (r *ContactDbRecipient) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[ContactDbRecipientArgs], error) {
+	if err := checkLegacyContactDbEnabled(ctx, "ContactDbRecipient"); err != nil {
+		return infer.CheckResponse[ContactDbRecipientArgs]{}, err
+	}
+
+	inputs, failures, err := infer.DefaultCheck[ContactDbRecipientArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[ContactDbRecipientArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[ContactDbRecipientArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid ContactDbRecipientArgs
+func (args *ContactDbRecipientArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.email("email", args.Email)
+	return v.failures
+}
+
+// writeRequestBody builds the body of the requests adding or updating the recipient,
+// which take a list of recipients. Unset names are sent empty to clear them.
+func (args *ContactDbRecipientArgs) writeRequestBody() []map[string]interface{} {
+	return []map[string]interface{}{{
+		"email":      args.Email,
+		"first_name": stringValue(args.FirstName),
+		"last_name":  stringValue(args.LastName),
+	}}
+}
+
+// writeRecipient adds or updates the recipient with the given method and returns its ID.
+// SendGrid reports invalid recipients in the response body rather than with an error status.
+func writeRecipient(ctx context.Context, write func(context.Context, string, interface{}, interface{}) error, args ContactDbRecipientArgs) (string, error) {
+	var result contactDbRecipientsWriteResponse
+	if err := write(ctx, "/v3/contactdb/recipients", args.writeRequestBody(), &result); err != nil {
+		return "", err
+	}
+	if result.ErrorCount > 0 || len(result.PersistedRecipients) == 0 {
+		message := "the recipient was not saved"
+		if len(result.Errors) > 0 {
+			message = result.Errors[0].Message
+		}
+		return "", fmt.Errorf("SendGrid rejected recipient %s: %s", args.Email, message)
+	}
+	return result.PersistedRecipients[0], nil
+}
+
+// updateListMemberships adds the recipient to the lists only in newIDs and
+// removes it from the lists only in oldIDs
+func updateListMemberships(ctx context.Context, client SendGridAPI, recipientID string, oldIDs, newIDs []int) error {
+	for _, listID := range newIDs {
+		if slices.Contains(oldIDs, listID) {
+			continue
+		}
+		path := fmt.Sprintf("/v3/contactdb/lists/%d/recipients/%s", listID, url.PathEscape(recipientID))
+		if err := client.Post(ctx, path, nil, nil); err != nil {
+			return fmt.Errorf("failed to add recipient to contact list %d: %w", listID, err)
+		}
+	}
+	for _, listID := range oldIDs {
+		if slices.Contains(newIDs, listID) {
+			continue
+		}
+		path := fmt.Sprintf("/v3/contactdb/lists/%d/recipients/%s", listID, url.PathEscape(recipientID))
+		if err := client.Delete(ctx, path); err != nil {
+			// A list or membership removed out-of-band needs no removal
+			if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+				continue
+			}
+			return fmt.Errorf("failed to remove recipient from contact list %d: %w", listID, err)
+		}
+	}
+	return nil
+}
+
+// Create adds a recipient to the SendGrid legacy contact database.
+func (r *ContactDbRecipient) Create(ctx context.Context, req infer.CreateRequest[ContactDbRecipientArgs]) (infer.CreateResponse[ContactDbRecipientState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, leave the ID SendGrid assigns unset, so it is unknown
+	if preview {
+		return infer.CreateResponse[ContactDbRecipientState]{
+			Output: ContactDbRecipientState{ContactDbRecipientArgs: input},
+		}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.CreateResponse[ContactDbRecipientState]{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	// Make the API call
+	id, err := writeRecipient(ctx, client.Post, input)
+	if err != nil {
+		return infer.CreateResponse[ContactDbRecipientState]{}, fmt.Errorf("failed to create recipient: %w", err)
+	}
+
+	state := ContactDbRecipientState{ContactDbRecipientArgs: input, RecipientID: id}
+	if err := updateListMemberships(ctx, client, id, nil, input.ListIDs); err != nil {
+		// The recipient is recorded without its lists, so the next update adds it to them
+		state.ListIDs = nil
+		return infer.CreateResponse[ContactDbRecipientState]{ID: id, Output: state}, initFailed("add recipient to contact lists", err)
+	}
+
+	// Use the recipient ID as the Pulumi resource ID
+	return infer.CreateResponse[ContactDbRecipientState]{
+		ID:     id,
+		Output: state,
+	}, nil
+}
+
+// Read retrieves the current state of a SendGrid legacy recipient and its lists.
+func (r *ContactDbRecipient) Read(ctx context.Context, req infer.ReadRequest[ContactDbRecipientArgs, ContactDbRecipientState]) (infer.ReadResponse[ContactDbRecipientArgs, ContactDbRecipientState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[ContactDbRecipientArgs, ContactDbRecipientState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	var result contactDbRecipientAPIResponse
+	if err := client.Get(ctx, fmt.Sprintf("/v3/contactdb/recipients/%s", url.PathEscape(id)), &result); err != nil {
+		// Check if the resource was deleted out-of-band
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			// Return empty response to indicate resource no longer exists
+			return infer.ReadResponse[ContactDbRecipientArgs, ContactDbRecipientState]{}, nil
+		}
+		return infer.ReadResponse[ContactDbRecipientArgs, ContactDbRecipientState]{}, fmt.Errorf("failed to read recipient: %w", err)
+	}
+
+	var lists struct {
+		Lists []contactDbListAPIResponse `json:"lists"`
+	}
+	if err := client.Get(ctx, fmt.Sprintf("/v3/contactdb/recipients/%s/lists", url.PathEscape(id)), &lists); err != nil {
+		return infer.ReadResponse[ContactDbRecipientArgs, ContactDbRecipientState]{}, fmt.Errorf("failed to read recipient lists: %w", err)
+	}
+	listIDs := make([]int, 0, len(lists.Lists))
+	for _, list := range lists.Lists {
+		listIDs = append(listIDs, list.ID)
+	}
+	// Keep the order of the inputs when the recipient is on the same lists
+	if sameListIDs(listIDs, req.Inputs.ListIDs) {
+		listIDs = req.Inputs.ListIDs
+	} else {
+		slices.Sort(listIDs)
+	}
+
+	state := result.toState(listIDs)
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	normalizeArgs(&state.ContactDbRecipientArgs, req.Inputs, nil)
+	inputs := state.ContactDbRecipientArgs
+
+	return infer.ReadResponse[ContactDbRecipientArgs, ContactDbRecipientState]{
+		ID:     id,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// sameListIDs reports whether both lists hold the same IDs, in any order
+func sameListIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// Update updates the names of a SendGrid legacy recipient and the lists it belongs to.
+func (r *ContactDbRecipient) Update(ctx context.Context, req infer.UpdateRequest[ContactDbRecipientArgs, ContactDbRecipientState]) (infer.UpdateResponse[ContactDbRecipientState], error) {
+	id := req.ID
+	input := req.Inputs
+	oldState := req.State
+	preview := req.DryRun
+
+	state := ContactDbRecipientState{ContactDbRecipientArgs: input, RecipientID: oldState.RecipientID}
+
+	// During preview, return expected state
+	if preview {
+		return infer.UpdateResponse[ContactDbRecipientState]{Output: state}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.UpdateResponse[ContactDbRecipientState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// Make the API call (PATCH updates the recipients with the given email addresses)
+	if stringValue(input.FirstName) != stringValue(oldState.FirstName) || stringValue(input.LastName) != stringValue(oldState.LastName) {
+		if _, err := writeRecipient(ctx, client.Patch, input); err != nil {
+			return infer.UpdateResponse[ContactDbRecipientState]{}, fmt.Errorf("failed to update recipient: %w", err)
+		}
+	}
+
+	if err := updateListMemberships(ctx, client, id, oldState.ListIDs, input.ListIDs); err != nil {
+		return infer.UpdateResponse[ContactDbRecipientState]{}, err
+	}
+
+	return infer.UpdateResponse[ContactDbRecipientState]{Output: state}, nil
+}
+
+// Delete removes a recipient from the SendGrid legacy contact database.
+func (r *ContactDbRecipient) Delete(ctx context.Context, req infer.DeleteRequest[ContactDbRecipientState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "recipient", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// Make the API call
+	if err := client.Delete(ctx, fmt.Sprintf("/v3/contactdb/recipients/%s", url.PathEscape(id))); err != nil {
+		// If already deleted, that's fine
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete recipient: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestContactDbRecipient_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	const id = "am9obkBleGFtcGxlLmNvbQ=="
+	var mu sync.Mutex
+	var requests []string
+	server := contactDbServer(t, true, func(req *http.Request) (int, string) {
+		mu.Lock()
+		defer mu.Unlock()
+		call := req.Method + " " + req.URL.Path
+		requests = append(requests, call)
+		switch call {
+		case "POST /v3/contactdb/recipients", "PATCH /v3/contactdb/recipients":
+			var body []map[string]string
+			data, _ := io.ReadAll(req.Body)
+			assert.NoError(t, json.Unmarshal(data, &body))
+			assert.Equal(t, "john@example.com", body[0]["email"])
+			return http.StatusCreated, `{"error_count": 0, "new_count": 1, "persisted_recipients": ["` + id + `"]}`
+		case "GET /v3/contactdb/recipients/" + id:
+			return http.StatusOK, `{"id": "` + id + `", "email": "john@example.com", "first_name": "John", "last_name": ""}`
+		case "GET /v3/contactdb/recipients/" + id + "/lists":
+			return http.StatusOK, `{"lists": [{"id": 13}, {"id": 12}]}`
+		case "POST /v3/contactdb/lists/12/recipients/" + id,
+			"POST /v3/contactdb/lists/13/recipients/" + id,
+			"POST /v3/contactdb/lists/14/recipients/" + id:
+			return http.StatusCreated, ``
+		case "DELETE /v3/contactdb/lists/13/recipients/" + id,
+			"DELETE /v3/contactdb/recipients/" + id:
+			return http.StatusNoContent, ``
+		}
+		return http.StatusNotFound, `{"errors": [{"message": "not found"}]}`
+	})
+
+	listIDs := func(ids ...float64) property.Value {
+		values := make([]property.Value, len(ids))
+		for i, id := range ids {
+			values[i] = property.New(id)
+		}
+		return property.New(values)
+	}
+
+	urn := previewURN("ContactDbRecipient", "john")
+	inputs := property.NewMap(map[string]property.Value{
+		"email":     property.New("john@example.com"),
+		"firstName": property.New("John"),
+		"listIds":   listIDs(12, 13),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, id, created.ID)
+	assert.Equal(t, id, created.Properties.Get("recipientId").AsString())
+
+	// SendGrid lists the lists in its own order
+	read, err := server.Read(p.ReadRequest{ID: id, Urn: urn, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, listIDs(12, 13), read.Inputs.Get("listIds"))
+	assert.True(t, read.Inputs.Get("lastName").IsNull())
+
+	// Only the list memberships changed, so the recipient itself is not updated
+	updated, err := server.Update(p.UpdateRequest{
+		ID:     id,
+		Urn:    urn,
+		State:  read.Properties,
+		Inputs: inputs.Set("listIds", listIDs(12, 14)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, listIDs(12, 14), updated.Properties.Get("listIds"))
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: id, Urn: urn, Properties: updated.Properties}))
+
+	assert.Equal(t, []string{
+		"POST /v3/contactdb/recipients",
+		"POST /v3/contactdb/lists/12/recipients/" + id,
+		"POST /v3/contactdb/lists/13/recipients/" + id,
+		"GET /v3/contactdb/recipients/" + id,
+		"GET /v3/contactdb/recipients/" + id + "/lists",
+		"POST /v3/contactdb/lists/14/recipients/" + id,
+		"DELETE /v3/contactdb/lists/13/recipients/" + id,
+		"DELETE /v3/contactdb/recipients/" + id,
+	}, requests)
+}
+
+func TestContactDbRecipient_Rejected(t *testing.T) {
+	t.Parallel()
+
+	server := contactDbServer(t, true, func(*http.Request) (int, string) {
+		return http.StatusCreated, `{"error_count": 1, "error_indices": [0], "persisted_recipients": [],
+			"errors": [{"error_indices": [0], "message": "Invalid email."}]}`
+	})
+
+	_, err := server.Create(p.CreateRequest{
+		Urn:        previewURN("ContactDbRecipient", "john"),
+		Properties: property.NewMap(map[string]property.Value{"email": property.New("john@example.com")}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid email.")
+}
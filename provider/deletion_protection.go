@@ -0,0 +1,28 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import "fmt"
+
+// checkDeletionProtection returns an error if deletion protection is enabled for a
+// resource. The check uses the stored state, so turning protection off has to be
+// applied with `pulumi up` before the resource can be deleted or replaced.
+func checkDeletionProtection(protected *bool, what, id string) error {
+	if protected == nil || !*protected {
+		return nil
+	}
+	return fmt.Errorf("cannot delete %s %q because deletionProtection is enabled; "+
+		"set deletionProtection to false and run `pulumi up` before deleting it", what, id)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, checkDeletionProtection(nil, "template", "d-1"))
+	assert.NoError(t, checkDeletionProtection(boolPtr(false), "template", "d-1"))
+
+	err := checkDeletionProtection(boolPtr(true), "template", "d-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `cannot delete template "d-1"`)
+	assert.Contains(t, err.Error(), "set deletionProtection to false")
+}
+
+func TestDelete_DeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	var deletes int32
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			// The group is checked not to be the default before it is deleted
+			return fakeResponse(req, http.StatusOK, `{"id": 42, "name": "Newsletter", "is_default": false}`), nil
+		}
+		assert.Equal(t, http.MethodDelete, req.Method)
+		atomic.AddInt32(&deletes, 1)
+		return fakeResponse(req, http.StatusNoContent, ``), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	groupState := func(protected bool) property.Map {
+		return property.NewMap(map[string]property.Value{
+			"name":               property.New("Newsletter"),
+			"groupId":            property.New(42.0),
+			"unsubscribes":       property.New(0.0),
+			"deletionProtection": property.New(protected),
+		})
+	}
+
+	err := server.Delete(p.DeleteRequest{
+		ID:         "42",
+		Urn:        previewURN("UnsubscribeGroup", "newsletter"),
+		Properties: groupState(true),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deletionProtection is enabled")
+	assert.Zero(t, atomic.LoadInt32(&deletes), "a protected resource must not be deleted")
+
+	err = server.Delete(p.DeleteRequest{
+		ID:         "42",
+		Urn:        previewURN("UnsubscribeGroup", "newsletter"),
+		Properties: groupState(false),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&deletes))
+}
+
+func TestGlobalSuppression_UpdateDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	g := &GlobalSuppression{}
+	state := GlobalSuppressionState{GlobalSuppressionArgs: GlobalSuppressionArgs{Email: "gone@example.com"}}
+
+	resp, err := g.Update(context.Background(), infer.UpdateRequest[GlobalSuppressionArgs, GlobalSuppressionState]{
+		ID:     "gone@example.com",
+		Inputs: GlobalSuppressionArgs{Email: "gone@example.com", DeletionProtection: boolPtr(true)},
+		State:  state,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Output.DeletionProtection)
+	assert.True(t, *resp.Output.DeletionProtection)
+
+	_, err = g.Update(context.Background(), infer.UpdateRequest[GlobalSuppressionArgs, GlobalSuppressionState]{
+		ID:     "gone@example.com",
+		Inputs: GlobalSuppressionArgs{Email: "other@example.com"},
+		State:  state,
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// diffArgs compares the inputs recorded in state with new inputs, field by field.
+// Like the default diff, changes to fields tagged `provider:"replaceOnChanges"`
+// require replacement. Fields listed in serverDefaulted are filled in by SendGrid
+// when left unset, so leaving them unset is not a change.
+//
+// Resources only need a custom Diff, built on diffArgs, when they have such fields;
+// the default diff would otherwise report the server's value as removed on every
+// preview, which for a replaceOnChanges field means a replacement.
+func diffArgs[I any](olds, news I, serverDefaulted ...string) p.DiffResponse {
+	detailed := map[string]p.PropertyDiff{}
+
+	oldValue, newValue := reflect.ValueOf(olds), reflect.ValueOf(news)
+	argsType := oldValue.Type()
+	for i := range argsType.NumField() {
+		field := argsType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("pulumi"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		o, n := oldValue.Field(i), newValue.Field(i)
+		if isUnset(n) && slices.Contains(serverDefaulted, name) {
+			continue
+		}
+		if (isUnset(o) && isUnset(n)) || reflect.DeepEqual(o.Interface(), n.Interface()) {
+			continue
+		}
+
+		kind := p.Update
+		switch {
+		case isUnset(o):
+			kind = p.Add
+		case isUnset(n):
+			kind = p.Delete
+		}
+		if slices.Contains(strings.Split(field.Tag.Get("provider"), ","), "replaceOnChanges") {
+			kind = asReplace(kind)
+		}
+		detailed[name] = p.PropertyDiff{Kind: kind, InputDiff: true}
+	}
+
+	return p.DiffResponse{
+		HasChanges:   len(detailed) > 0,
+		DetailedDiff: detailed,
+	}
+}
+
+// asReplace returns the replacing variant of a diff kind
+func asReplace(kind p.DiffKind) p.DiffKind {
+	switch kind {
+	case p.Add:
+		return p.AddReplace
+	case p.Delete:
+		return p.DeleteReplace
+	default:
+		return p.UpdateReplace
+	}
+}
+
+// isUnset reports whether an optional input was left out: a nil pointer, or an
+// empty list or map
+func isUnset(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
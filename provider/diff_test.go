@@ -0,0 +1,175 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestDiffArgs(t *testing.T) {
+	t.Parallel()
+
+	olds := DomainAuthenticationArgs{
+		Domain:    "example.com",
+		Subdomain: strPtr("em1234"),
+		Ips:       []string{},
+		Default:   boolPtr(false),
+	}
+
+	tests := []struct {
+		name string
+		news DomainAuthenticationArgs
+		want map[string]p.PropertyDiff
+	}{
+		{
+			name: "unchanged, with generated subdomain left unset",
+			news: DomainAuthenticationArgs{Domain: "example.com", Default: boolPtr(false)},
+			want: map[string]p.PropertyDiff{},
+		},
+		{
+			name: "updatable field",
+			news: DomainAuthenticationArgs{Domain: "example.com", Default: boolPtr(true)},
+			want: map[string]p.PropertyDiff{"default": {Kind: p.Update, InputDiff: true}},
+		},
+		{
+			name: "removed field",
+			news: DomainAuthenticationArgs{Domain: "example.com"},
+			want: map[string]p.PropertyDiff{"default": {Kind: p.Delete, InputDiff: true}},
+		},
+		{
+			name: "added field",
+			news: DomainAuthenticationArgs{Domain: "example.com", Default: boolPtr(false), CustomSpf: boolPtr(true)},
+			want: map[string]p.PropertyDiff{"customSpf": {Kind: p.Add, InputDiff: true}},
+		},
+		{
+			name: "immutable fields",
+			news: DomainAuthenticationArgs{Domain: "example.org", Subdomain: strPtr("mail"), Default: boolPtr(false)},
+			want: map[string]p.PropertyDiff{
+				"domain":    {Kind: p.UpdateReplace, InputDiff: true},
+				"subdomain": {Kind: p.UpdateReplace, InputDiff: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := diffArgs(olds, tt.news, "subdomain")
+			assert.Equal(t, tt.want, resp.DetailedDiff)
+			assert.Equal(t, len(tt.want) > 0, resp.HasChanges)
+		})
+	}
+}
+
+func TestDiff_ReplaceOnChanges(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, nil, &calls)
+
+	tests := []struct {
+		name    string
+		typ     string
+		state   map[string]property.Value
+		inputs  map[string]property.Value
+		replace bool
+	}{
+		{
+			name:    "template generation",
+			typ:     "Template",
+			state:   map[string]property.Value{"name": property.New("welcome"), "generation": property.New("legacy"), "templateId": property.New("d-1")},
+			inputs:  map[string]property.Value{"name": property.New("welcome"), "generation": property.New("dynamic")},
+			replace: true,
+		},
+		{
+			name:   "template name",
+			typ:    "Template",
+			state:  map[string]property.Value{"name": property.New("welcome"), "generation": property.New("dynamic"), "templateId": property.New("d-1")},
+			inputs: map[string]property.Value{"name": property.New("hello"), "generation": property.New("dynamic")},
+		},
+		{
+			name:    "subuser username",
+			typ:     "Subuser",
+			state:   map[string]property.Value{"username": property.New("tenant-a"), "email": property.New("a@example.com"), "userId": property.New(1.0), "disabled": property.New(false)},
+			inputs:  map[string]property.Value{"username": property.New("tenant-b"), "email": property.New("a@example.com"), "password": property.New("pw")},
+			replace: true,
+		},
+		{
+			name:    "verified sender from address",
+			typ:     "VerifiedSender",
+			state:   map[string]property.Value{"nickname": property.New("support"), "fromEmail": property.New("old@example.com")},
+			inputs:  map[string]property.Value{"nickname": property.New("support"), "fromEmail": property.New("new@example.com")},
+			replace: true,
+		},
+		{
+			name: "domain authentication with generated subdomain",
+			typ:  "DomainAuthentication",
+			state: map[string]property.Value{
+				"domain":    property.New("example.com"),
+				"subdomain": property.New("em1234"),
+				"domainId":  property.New(7.0),
+				"userId":    property.New(1.0),
+				"username":  property.New("parent"),
+				"valid":     property.New(true),
+				"legacy":    property.New(false),
+			},
+			inputs: map[string]property.Value{"domain": property.New("example.com")},
+		},
+		{
+			name: "link branding domain",
+			typ:  "LinkBranding",
+			state: map[string]property.Value{
+				"domain":    property.New("example.com"),
+				"subdomain": property.New("url1234"),
+				"linkId":    property.New(9.0),
+				"userId":    property.New(1.0),
+				"username":  property.New("parent"),
+				"valid":     property.New(true),
+				"legacy":    property.New(false),
+			},
+			inputs:  map[string]property.Value{"domain": property.New("example.org")},
+			replace: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Diff(p.DiffRequest{
+				ID:     "id",
+				Urn:    previewURN(tt.typ, "test"),
+				State:  property.NewMap(tt.state),
+				Inputs: property.NewMap(tt.inputs),
+			})
+			require.NoError(t, err)
+
+			replace := false
+			for _, d := range resp.DetailedDiff {
+				if d.Kind == p.AddReplace || d.Kind == p.UpdateReplace || d.Kind == p.DeleteReplace {
+					replace = true
+				}
+			}
+			assert.Equal(t, tt.replace, replace, "%v", resp.DetailedDiff)
+		})
+	}
+}
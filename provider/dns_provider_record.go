@@ -0,0 +1,54 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// dnsProviderRecordTTL is the TTL of the records in providerRecords, in seconds
+const dnsProviderRecordTTL = 3600
+
+// mxRecordPriority is the priority SendGrid asks for on the MX record of a domain without automatic security
+const mxRecordPriority = 10
+
+// DNSProviderRecord is a DNS record in the shape DNS provider resources expect,
+// such as aws.route53.Record or cloudflare.Record
+type DNSProviderRecord struct {
+	// Name is the fully qualified name of the record
+	Name string `pulumi:"name"`
+	// Type is the upper-case DNS record type (CNAME, TXT, MX)
+	Type string `pulumi:"type"`
+	// Value is the record value; MX values are prefixed with their priority
+	Value string `pulumi:"value"`
+	// TTL is the suggested time to live, in seconds
+	TTL int `pulumi:"ttl"`
+}
+
+// newDNSProviderRecord converts a record SendGrid asks to be published to a DNSProviderRecord
+func newDNSProviderRecord(recordType, host, data string) DNSProviderRecord {
+	recordType = strings.ToUpper(recordType)
+	value := data
+	if recordType == "MX" && !strings.Contains(data, " ") {
+		value = fmt.Sprintf("%d %s", mxRecordPriority, data)
+	}
+	return DNSProviderRecord{
+		Name:  host,
+		Type:  recordType,
+		Value: value,
+		TTL:   dnsProviderRecordTTL,
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDNSProviderRecord(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		recordType string
+		host       string
+		data       string
+		expected   DNSProviderRecord
+	}{
+		{
+			name:       "cname",
+			recordType: "cname",
+			host:       "em123.example.com",
+			data:       "u123.wl.sendgrid.net",
+			expected:   DNSProviderRecord{Name: "em123.example.com", Type: "CNAME", Value: "u123.wl.sendgrid.net", TTL: 3600},
+		},
+		{
+			name:       "txt",
+			recordType: "txt",
+			host:       "example.com",
+			data:       "v=spf1 include:sendgrid.net ~all",
+			expected:   DNSProviderRecord{Name: "example.com", Type: "TXT", Value: "v=spf1 include:sendgrid.net ~all", TTL: 3600},
+		},
+		{
+			name:       "mx gets a priority",
+			recordType: "mx",
+			host:       "em.example.com",
+			data:       "mx.sendgrid.net",
+			expected:   DNSProviderRecord{Name: "em.example.com", Type: "MX", Value: "10 mx.sendgrid.net", TTL: 3600},
+		},
+		{
+			name:       "mx with a priority",
+			recordType: "MX",
+			host:       "em.example.com",
+			data:       "20 mx.sendgrid.net",
+			expected:   DNSProviderRecord{Name: "em.example.com", Type: "MX", Value: "20 mx.sendgrid.net", TTL: 3600},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, newDNSProviderRecord(tt.recordType, tt.host, tt.data))
+		})
+	}
+}
@@ -18,8 +18,11 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // DomainAuthentication is the controller for the SendGrid Domain Authentication resource.
@@ -31,11 +34,11 @@ type DomainAuthentication struct{}
 // DomainAuthenticationArgs are the inputs to the DomainAuthentication resource.
 type DomainAuthenticationArgs struct {
 	// Domain is the domain being authenticated (required)
-	Domain string `pulumi:"domain"`
+	Domain string `pulumi:"domain" provider:"replaceOnChanges"`
 
 	// Subdomain is the subdomain to use for the authenticated domain (optional)
 	// This is the custom return-path for the domain.
-	Subdomain *string `pulumi:"subdomain,optional"`
+	Subdomain *string `pulumi:"subdomain,optional" provider:"replaceOnChanges"`
 
 	// Ips is a list of IP addresses to associate with this domain for custom SPF (optional)
 	Ips []string `pulumi:"ips,optional"`
@@ -50,13 +53,40 @@ type DomainAuthenticationArgs struct {
 	// When enabled, SendGrid provides CNAME records. When disabled, you get TXT and MX records.
 	AutomaticSecurity *bool `pulumi:"automaticSecurity,optional"`
 
-	// CustomDkimSelector is a custom DKIM selector (max 3 characters) (optional)
+	// CustomDkimSelector is a custom DKIM selector of 1 to 3 letters or digits (optional)
 	CustomDkimSelector *string `pulumi:"customDkimSelector,optional"`
 
 	// Region is the region for the domain: "global" or "eu" (optional, default: global)
 	Region *string `pulumi:"region,optional"`
+
+	// AdoptExisting takes over an existing authentication of the same domain and subdomain
+	// instead of failing to create a duplicate (optional, defaults to false)
+	AdoptExisting *bool `pulumi:"adoptExisting,optional"`
+
+	// WaitForValidation asks SendGrid to validate the DNS records once the domain is created or
+	// updated, and waits until they are valid (optional, defaults to false). Validation only succeeds
+	// once the records are published, e.g. when they are managed outside of this stack.
+	WaitForValidation *bool `pulumi:"waitForValidation,optional"`
+
+	// WaitTimeoutSeconds is how long to wait for validation (optional, defaults to 600)
+	WaitTimeoutSeconds *int `pulumi:"waitTimeoutSeconds,optional"`
+
+	// WaitIntervalSeconds is the time between validation attempts (optional, defaults to 30)
+	WaitIntervalSeconds *int `pulumi:"waitIntervalSeconds,optional"`
+
+	// RevalidateOnRefresh asks SendGrid to validate the DNS records again on refresh while they
+	// are not valid, so valid reflects records published outside a deployment (optional, defaults to false)
+	RevalidateOnRefresh *bool `pulumi:"revalidateOnRefresh,optional"`
+
+	// DeletionProtection prevents the authenticated domain from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
+// domainAuthenticationInputOnlyFields are the inputs SendGrid does not return, which are carried over
+// from the inputs to the state read back
+var domainAuthenticationInputOnlyFields = []string{"customDkimSelector", "region", "waitForValidation", "waitTimeoutSeconds", "waitIntervalSeconds", "revalidateOnRefresh"}
+
 // DNSRecord represents a DNS record required for domain authentication
 type DNSRecord struct {
 	// Valid indicates if the record has been validated
@@ -69,6 +99,20 @@ type DNSRecord struct {
 	Data string `pulumi:"data"`
 }
 
+// DomainDNSRecord is a DNS record in the dnsRecords list of a domain authentication or link branding
+type DomainDNSRecord struct {
+	// Name is SendGrid's name for the record, e.g. "mail_cname", "dkim1", "mail_server" or "brand_cname"
+	Name string `pulumi:"name"`
+	// Valid indicates if the record has been validated
+	Valid bool `pulumi:"valid"`
+	// Type is the DNS record type (CNAME, TXT, MX)
+	Type string `pulumi:"type"`
+	// Host is the hostname for the record
+	Host string `pulumi:"host"`
+	// Data is the value/data for the record
+	Data string `pulumi:"data"`
+}
+
 // DomainAuthenticationState is the state of the DomainAuthentication resource.
 type DomainAuthenticationState struct {
 	// Embed the input args in the output state
@@ -97,6 +141,13 @@ type DomainAuthenticationState struct {
 
 	// Dkim2 is the second DKIM record
 	Dkim2 *DNSRecord `pulumi:"dkim2,optional"`
+
+	// DNSRecords lists every DNS record to publish, including the MX and TXT records
+	// SendGrid returns instead of mailCname, dkim1 and dkim2 when automaticSecurity is false
+	DNSRecords []DomainDNSRecord `pulumi:"dnsRecords"`
+
+	// ProviderRecords lists the same records as dnsRecords in the shape DNS provider resources expect
+	ProviderRecords []DNSProviderRecord `pulumi:"providerRecords,optional"`
 }
 
 // Annotate provides descriptions for the DomainAuthentication resource.
@@ -109,6 +160,77 @@ func (d *DomainAuthentication) Annotate(annotator infer.Annotator) {
 		"and then validate the domain using the SendGrid console or API.")
 }
 
+// StateMigrations upgrades state written by earlier versions of the provider
+func (d *DomainAuthentication) StateMigrations(context.Context) []infer.StateMigrationFunc[DomainAuthenticationState] {
+	return []infer.StateMigrationFunc[DomainAuthenticationState]{
+		infer.StateMigration(migrateDomainAuthenticationDNSRecords),
+	}
+}
+
+// domainAuthenticationStateV1 is DomainAuthenticationState as written before dnsRecords was added
+type domainAuthenticationStateV1 struct {
+	DomainAuthenticationArgs
+
+	DomainID   int               `pulumi:"domainId"`
+	UserID     int               `pulumi:"userId"`
+	Username   string            `pulumi:"username"`
+	Valid      bool              `pulumi:"valid"`
+	Legacy     bool              `pulumi:"legacy"`
+	MailCname  *DNSRecord        `pulumi:"mailCname,optional"`
+	Dkim1      *DNSRecord        `pulumi:"dkim1,optional"`
+	Dkim2      *DNSRecord        `pulumi:"dkim2,optional"`
+	DNSRecords []DomainDNSRecord `pulumi:"dnsRecords,optional"`
+}
+
+// migrateDomainAuthenticationDNSRecords fills in dnsRecords for state written before it was
+// added, from the mailCname, dkim1 and dkim2 records that were the only ones recorded then
+func migrateDomainAuthenticationDNSRecords(_ context.Context, old domainAuthenticationStateV1) (infer.MigrationResult[DomainAuthenticationState], error) {
+	if old.DNSRecords != nil {
+		// Already in the current shape
+		return infer.MigrationResult[DomainAuthenticationState]{}, nil
+	}
+
+	state := DomainAuthenticationState{
+		DomainAuthenticationArgs: old.DomainAuthenticationArgs,
+		DomainID:                 old.DomainID,
+		UserID:                   old.UserID,
+		Username:                 old.Username,
+		Valid:                    old.Valid,
+		Legacy:                   old.Legacy,
+		MailCname:                old.MailCname,
+		Dkim1:                    old.Dkim1,
+		Dkim2:                    old.Dkim2,
+		DNSRecords:               dnsRecordList(old.MailCname, old.Dkim1, old.Dkim2),
+	}
+	state.ProviderRecords = domainProviderRecords(state.DNSRecords)
+	return infer.MigrationResult[DomainAuthenticationState]{Result: &state}, nil
+}
+
+// dnsRecordList lists the mailCname, dkim1 and dkim2 records that are set, in that order
+func dnsRecordList(mailCname, dkim1, dkim2 *DNSRecord) []DomainDNSRecord {
+	records := []DomainDNSRecord{}
+	for _, record := range []struct {
+		name   string
+		record *DNSRecord
+	}{
+		{"mail_cname", mailCname},
+		{"dkim1", dkim1},
+		{"dkim2", dkim2},
+	} {
+		if record.record == nil {
+			continue
+		}
+		records = append(records, DomainDNSRecord{
+			Name:  record.name,
+			Valid: record.record.Valid,
+			Type:  record.record.Type,
+			Host:  record.record.Host,
+			Data:  record.record.Data,
+		})
+	}
+	return records
+}
+
 // domainAuthAPIResponse represents the SendGrid API response structure
 type domainAuthAPIResponse struct {
 	ID                int                   `json:"id"`
@@ -126,9 +248,54 @@ type domainAuthAPIResponse struct {
 }
 
 type domainAuthDNSResponse struct {
+	// Returned with automatic security
 	MailCname dnsRecordResponse `json:"mail_cname"`
 	Dkim1     dnsRecordResponse `json:"dkim1"`
 	Dkim2     dnsRecordResponse `json:"dkim2"`
+
+	// Returned without automatic security
+	MailServer   dnsRecordResponse `json:"mail_server"`
+	SubdomainSpf dnsRecordResponse `json:"subdomain_spf"`
+	DomainSpf    dnsRecordResponse `json:"domain_spf"`
+	Dkim         dnsRecordResponse `json:"dkim"`
+}
+
+// records returns the DNS records SendGrid returned, in a stable order
+func (r *domainAuthDNSResponse) records() []DomainDNSRecord {
+	records := []DomainDNSRecord{}
+	for _, record := range []struct {
+		name     string
+		response dnsRecordResponse
+	}{
+		{"mail_cname", r.MailCname},
+		{"dkim1", r.Dkim1},
+		{"dkim2", r.Dkim2},
+		{"mail_server", r.MailServer},
+		{"subdomain_spf", r.SubdomainSpf},
+		{"domain_spf", r.DomainSpf},
+		{"dkim", r.Dkim},
+	} {
+		if record.response.Host == "" {
+			continue
+		}
+		records = append(records, DomainDNSRecord{
+			Name:  record.name,
+			Valid: record.response.Valid,
+			Type:  record.response.Type,
+			Host:  record.response.Host,
+			Data:  record.response.Data,
+		})
+	}
+	return records
+}
+
+// domainProviderRecords converts the dnsRecords of a domain authentication to providerRecords
+func domainProviderRecords(records []DomainDNSRecord) []DNSProviderRecord {
+	providerRecords := []DNSProviderRecord{}
+	for _, record := range records {
+		providerRecords = append(providerRecords, newDNSProviderRecord(record.Type, record.Host, record.Data))
+	}
+	return providerRecords
 }
 
 type dnsRecordResponse struct {
@@ -145,12 +312,14 @@ func (r *domainAuthAPIResponse) toState() DomainAuthenticationState {
 			Domain: r.Domain,
 			Ips:    r.Ips,
 		},
-		DomainID: r.ID,
-		UserID:   r.UserID,
-		Username: r.Username,
-		Valid:    r.Valid,
-		Legacy:   r.Legacy,
+		DomainID:   r.ID,
+		UserID:     r.UserID,
+		Username:   r.Username,
+		Valid:      r.Valid,
+		Legacy:     r.Legacy,
+		DNSRecords: r.DNS.records(),
 	}
+	state.ProviderRecords = domainProviderRecords(state.DNSRecords)
 
 	// Handle optional fields
 	if r.Subdomain != "" {
@@ -195,12 +364,123 @@ func (r *domainAuthAPIResponse) toState() DomainAuthenticationState {
 	return state
 }
 
+// Check validates the DomainAuthentication inputs. With validateOnPreview, it also
+// verifies that the domain and subdomain are not already authenticated.
+func (d *DomainAuthentication) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[DomainAuthenticationArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[DomainAuthenticationArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[DomainAuthenticationArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	// Without a subdomain SendGrid generates a unique one, which cannot conflict
+	if client := previewValidationClient(ctx); client != nil && len(failures) == 0 && req.OldInputs.Len() == 0 &&
+		inputs.Subdomain != nil && (inputs.AdoptExisting == nil || !*inputs.AdoptExisting) &&
+		inputsKnown(req.NewInputs, "domain", "subdomain") {
+		failures = append(failures, checkDomainAvailable(ctx, client, inputs.Domain, *inputs.Subdomain)...)
+	}
+
+	return infer.CheckResponse[DomainAuthenticationArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// isDKIMSelector reports whether a custom DKIM selector has the 1 to 3 ASCII letters
+// or digits SendGrid accepts
+func isDKIMSelector(selector string) bool {
+	if len(selector) < 1 || len(selector) > 3 {
+		return false
+	}
+	for _, r := range selector {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// validate reports invalid DomainAuthenticationArgs
+func (args *DomainAuthenticationArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("domain", args.Domain)
+	v.domainName("domain", args.Domain, 2)
+	if args.Subdomain != nil {
+		v.domainName("subdomain", *args.Subdomain, 1)
+	}
+	v.ipAddresses("ips", args.Ips)
+	if args.CustomDkimSelector != nil && v.known("customDkimSelector") && !isDKIMSelector(*args.CustomDkimSelector) {
+		v.fail("customDkimSelector", "must be 1 to 3 letters or digits, e.g. \"s1\" for s1._domainkey (got %q)",
+			*args.CustomDkimSelector)
+	}
+	if args.Region != nil {
+		v.oneOf("region", *args.Region, "global", "eu")
+	}
+	if args.WaitTimeoutSeconds != nil {
+		v.inRange("waitTimeoutSeconds", *args.WaitTimeoutSeconds, 1, 86400)
+	}
+	if args.WaitIntervalSeconds != nil {
+		v.inRange("waitIntervalSeconds", *args.WaitIntervalSeconds, 1, 3600)
+	}
+	return v.failures
+}
+
+// checkDomainAvailable reports a failure if the domain is already authenticated with the given subdomain
+func checkDomainAvailable(ctx context.Context, client SendGridAPI, domain, subdomain string) []p.CheckFailure {
+	existing, err := findDomainAuthentication(ctx, client, domain, subdomain)
+	if err != nil {
+		return lookupFailures(ctx, "domain", "authenticated domains", err)
+	}
+	if existing == nil {
+		return nil
+	}
+	return []p.CheckFailure{{
+		Property: "domain",
+		Reason: fmt.Sprintf("%s.%s is already authenticated (ID %d); import it with `pulumi import sendgrid:index:DomainAuthentication <name> %d`",
+			subdomain, domain, existing.ID, existing.ID),
+	}}
+}
+
+// findDomainAuthentication returns the authentication of domain with the given subdomain,
+// or nil if there is none. An empty subdomain matches any authentication of the domain.
+func findDomainAuthentication(ctx context.Context, client SendGridAPI, domain, subdomain string) (*domainAuthAPIResponse, error) {
+	// GET /v3/whitelabel/domains?domain={domain} lists the authenticated domains matching the name
+	var result []domainAuthAPIResponse
+	if err := client.Get(ctx, NewQuery().Set("domain", domain).Path("/v3/whitelabel/domains"), &result); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		if strings.EqualFold(result[i].Domain, domain) && (subdomain == "" || strings.EqualFold(result[i].Subdomain, subdomain)) {
+			return &result[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// updateRequestBody builds the PATCH request body from the args.
+// Only default and custom_spf can be updated.
+func (args *DomainAuthenticationArgs) updateRequestBody() map[string]interface{} {
+	reqBody := map[string]interface{}{}
+	if args.Default != nil {
+		reqBody["default"] = *args.Default
+	}
+	if args.CustomSpf != nil {
+		reqBody["custom_spf"] = *args.CustomSpf
+	}
+	return reqBody
+}
+
+// Diff compares the DomainAuthentication inputs with its state. SendGrid generates a subdomain
+// when none is given, so leaving subdomain unset does not replace the authenticated domain.
+func (d *DomainAuthentication) Diff(_ context.Context, req infer.DiffRequest[DomainAuthenticationArgs, DomainAuthenticationState]) (p.DiffResponse, error) {
+	return diffArgs(req.State.DomainAuthenticationArgs, req.Inputs, "subdomain"), nil
+}
+
 // Create creates a new SendGrid Domain Authentication.
 func (d *DomainAuthentication) Create(ctx context.Context, req infer.CreateRequest[DomainAuthenticationArgs]) (infer.CreateResponse[DomainAuthenticationState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := DomainAuthenticationState{
 			DomainAuthenticationArgs: input,
@@ -210,7 +490,6 @@ func (d *DomainAuthentication) Create(ctx context.Context, req infer.CreateReque
 			Legacy:                   false,
 		}
 		return infer.CreateResponse[DomainAuthenticationState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -252,10 +531,42 @@ func (d *DomainAuthentication) Create(ctx context.Context, req infer.CreateReque
 	// Make the API call
 	var result domainAuthAPIResponse
 	if err := client.Post(ctx, "/v3/whitelabel/domains", reqBody, &result); err != nil {
-		return infer.CreateResponse[DomainAuthenticationState]{}, fmt.Errorf("failed to create domain authentication: %w", err)
+		if !adoptExisting(input.AdoptExisting, err) {
+			return infer.CreateResponse[DomainAuthenticationState]{}, fmt.Errorf("failed to create domain authentication: %w", err)
+		}
+
+		// Take over the existing authentication of the domain
+		existing, findErr := findDomainAuthentication(ctx, client, input.Domain, stringValue(input.Subdomain))
+		if findErr != nil || existing == nil {
+			return infer.CreateResponse[DomainAuthenticationState]{}, fmt.Errorf("failed to create domain authentication: %w", adoptionFailed(err, findErr, "domain authentication"))
+		}
+		p.GetLogger(ctx).Infof("Adopting existing domain authentication %d for %s", existing.ID, input.Domain)
+		if err := client.Patch(ctx, fmt.Sprintf("/v3/whitelabel/domains/%d", existing.ID), input.updateRequestBody(), &result); err != nil {
+			// The adopted domain authentication is recorded as found, so the next update applies the inputs to it
+			state := existing.toState()
+			state.AdoptExisting = input.AdoptExisting
+			state.DeletionProtection = input.DeletionProtection
+			normalizeArgs(&state.DomainAuthenticationArgs, input, nil)
+			preserveInputs(&state.DomainAuthenticationArgs, input, domainAuthenticationInputOnlyFields...)
+			return infer.CreateResponse[DomainAuthenticationState]{
+				ID:     strconv.Itoa(existing.ID),
+				Output: state,
+			}, initFailed(fmt.Sprintf("update adopted domain authentication %d", existing.ID), err)
+		}
 	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.DomainAuthenticationArgs, input, nil)
+	preserveInputs(&state.DomainAuthenticationArgs, input, domainAuthenticationInputOnlyFields...)
+
+	if err := d.awaitValidation(ctx, client, input, &state); err != nil {
+		return infer.CreateResponse[DomainAuthenticationState]{
+			ID:     strconv.Itoa(result.ID),
+			Output: state,
+		}, initFailed("validate DNS records", err)
+	}
 
 	return infer.CreateResponse[DomainAuthenticationState]{
 		ID:     strconv.Itoa(result.ID),
@@ -273,6 +584,11 @@ func (d *DomainAuthentication) Read(ctx context.Context, req infer.ReadRequest[D
 		return infer.ReadResponse[DomainAuthenticationArgs, DomainAuthenticationState]{}, fmt.Errorf("SendGrid client not configured")
 	}
 
+	// Check the DNS records again, so the details read below report whether they are valid now
+	if req.Inputs.RevalidateOnRefresh != nil && *req.Inputs.RevalidateOnRefresh && !req.State.Valid {
+		revalidateWhitelabel(ctx, client, fmt.Sprintf("/v3/whitelabel/domains/%s", id))
+	}
+
 	// Make the API call to get the domain authentication details
 	var result domainAuthAPIResponse
 	if err := client.Get(ctx, fmt.Sprintf("/v3/whitelabel/domains/%s", id), &result); err != nil {
@@ -285,6 +601,10 @@ func (d *DomainAuthentication) Read(ctx context.Context, req infer.ReadRequest[D
 	}
 
 	state := result.toState()
+	state.AdoptExisting = req.Inputs.AdoptExisting
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	normalizeArgs(&state.DomainAuthenticationArgs, req.Inputs, nil)
+	preserveInputs(&state.DomainAuthenticationArgs, req.Inputs, domainAuthenticationInputOnlyFields...)
 	inputs := state.DomainAuthenticationArgs
 
 	return infer.ReadResponse[DomainAuthenticationArgs, DomainAuthenticationState]{
@@ -313,6 +633,8 @@ func (d *DomainAuthentication) Update(ctx context.Context, req infer.UpdateReque
 			MailCname:                oldState.MailCname,
 			Dkim1:                    oldState.Dkim1,
 			Dkim2:                    oldState.Dkim2,
+			DNSRecords:               oldState.DNSRecords,
+			ProviderRecords:          oldState.ProviderRecords,
 		}
 		return infer.UpdateResponse[DomainAuthenticationState]{Output: state}, nil
 	}
@@ -323,31 +645,60 @@ func (d *DomainAuthentication) Update(ctx context.Context, req infer.UpdateReque
 		return infer.UpdateResponse[DomainAuthenticationState]{}, fmt.Errorf("SendGrid client not configured")
 	}
 
-	// Build the request body - only default and custom_spf can be updated
-	reqBody := map[string]interface{}{}
-
-	if input.Default != nil {
-		reqBody["default"] = *input.Default
-	}
-	if input.CustomSpf != nil {
-		reqBody["custom_spf"] = *input.CustomSpf
-	}
-
 	// Make the API call
 	var result domainAuthAPIResponse
-	if err := client.Patch(ctx, fmt.Sprintf("/v3/whitelabel/domains/%s", id), reqBody, &result); err != nil {
+	if err := client.Patch(ctx, fmt.Sprintf("/v3/whitelabel/domains/%s", id), input.updateRequestBody(), &result); err != nil {
 		return infer.UpdateResponse[DomainAuthenticationState]{}, fmt.Errorf("failed to update domain authentication: %w", err)
 	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.DomainAuthenticationArgs, input, nil)
+	preserveInputs(&state.DomainAuthenticationArgs, input, domainAuthenticationInputOnlyFields...)
+
+	if err := d.awaitValidation(ctx, client, input, &state); err != nil {
+		return infer.UpdateResponse[DomainAuthenticationState]{Output: state}, initFailed("validate DNS records", err)
+	}
 
 	return infer.UpdateResponse[DomainAuthenticationState]{Output: state}, nil
 }
 
+// awaitValidation validates the DNS records of the domain authentication when waitForValidation is set
+// and they are not valid yet, and records the outcome in state
+func (d *DomainAuthentication) awaitValidation(ctx context.Context, client SendGridAPI, input DomainAuthenticationArgs, state *DomainAuthenticationState) error {
+	if input.WaitForValidation == nil || !*input.WaitForValidation || state.Valid {
+		return nil
+	}
+	path := fmt.Sprintf("/v3/whitelabel/domains/%d", state.DomainID)
+	if err := validateWhitelabel(ctx, client, path, input.WaitTimeoutSeconds, input.WaitIntervalSeconds); err != nil {
+		return err
+	}
+
+	state.Valid = true
+	if state.MailCname != nil {
+		state.MailCname.Valid = true
+	}
+	if state.Dkim1 != nil {
+		state.Dkim1.Valid = true
+	}
+	if state.Dkim2 != nil {
+		state.Dkim2.Valid = true
+	}
+	for i := range state.DNSRecords {
+		state.DNSRecords[i].Valid = true
+	}
+	return nil
+}
+
 // Delete removes a SendGrid Domain Authentication.
 func (d *DomainAuthentication) Delete(ctx context.Context, req infer.DeleteRequest[DomainAuthenticationState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "authenticated domain", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
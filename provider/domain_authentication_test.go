@@ -22,6 +22,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 func TestSendGridClient_CreateDomainAuthentication(t *testing.T) {
@@ -522,6 +525,48 @@ func TestDomainAuthAPIResponse_ToState(t *testing.T) {
 		assert.NotNil(t, state.Dkim2)
 		assert.True(t, state.Dkim2.Valid)
 		assert.Equal(t, "s2._domainkey.example.com", state.Dkim2.Host)
+
+		require.Len(t, state.DNSRecords, 3)
+		assert.Equal(t, DomainDNSRecord{Name: "mail_cname", Valid: true, Type: "cname", Host: "mail.example.com", Data: "u12345.wl.sendgrid.net"}, state.DNSRecords[0])
+		assert.Equal(t, "dkim1", state.DNSRecords[1].Name)
+		assert.Equal(t, "dkim2", state.DNSRecords[2].Name)
+
+		require.Len(t, state.ProviderRecords, 3)
+		assert.Equal(t, DNSProviderRecord{Name: "mail.example.com", Type: "CNAME", Value: "u12345.wl.sendgrid.net", TTL: 3600}, state.ProviderRecords[0])
+	})
+
+	t.Run("without automatic security", func(t *testing.T) {
+		t.Parallel()
+
+		resp := domainAuthAPIResponse{
+			ID:     12347,
+			Domain: "manual.com",
+			DNS: domainAuthDNSResponse{
+				MailServer:   dnsRecordResponse{Type: "mx", Host: "em.manual.com", Data: "mx.sendgrid.net."},
+				SubdomainSpf: dnsRecordResponse{Type: "txt", Host: "em.manual.com", Data: "v=spf1 include:sendgrid.net ~all"},
+				Dkim:         dnsRecordResponse{Type: "txt", Host: "m1._domainkey.manual.com", Data: "k=rsa; t=s; p=MIGf"},
+			},
+		}
+
+		state := resp.toState()
+
+		// Only the records returned with automatic security have their own outputs
+		assert.Nil(t, state.MailCname)
+		assert.Nil(t, state.Dkim1)
+		assert.Nil(t, state.Dkim2)
+
+		require.Len(t, state.DNSRecords, 3)
+		assert.Equal(t, "mail_server", state.DNSRecords[0].Name)
+		assert.Equal(t, "mx", state.DNSRecords[0].Type)
+		assert.Equal(t, "subdomain_spf", state.DNSRecords[1].Name)
+		assert.Equal(t, "dkim", state.DNSRecords[2].Name)
+		assert.Equal(t, "m1._domainkey.manual.com", state.DNSRecords[2].Host)
+
+		assert.Equal(t, []DNSProviderRecord{
+			{Name: "em.manual.com", Type: "MX", Value: "10 mx.sendgrid.net.", TTL: 3600},
+			{Name: "em.manual.com", Type: "TXT", Value: "v=spf1 include:sendgrid.net ~all", TTL: 3600},
+			{Name: "m1._domainkey.manual.com", Type: "TXT", Value: "k=rsa; t=s; p=MIGf", TTL: 3600},
+		}, state.ProviderRecords)
 	})
 
 	t.Run("with minimal fields", func(t *testing.T) {
@@ -572,3 +617,87 @@ func TestDomainAuthAPIResponse_ToState(t *testing.T) {
 		assert.NotNil(t, state.Dkim2)
 	})
 }
+
+func TestDomainAuthentication_StateMigration(t *testing.T) {
+	t.Parallel()
+
+	mailCname := &DNSRecord{Valid: true, Type: "cname", Host: "em.example.com", Data: "u1.wl.sendgrid.net"}
+	dkim1 := &DNSRecord{Type: "cname", Host: "s1._domainkey.example.com", Data: "s1.domainkey.u1.wl.sendgrid.net"}
+
+	t.Run("state without dnsRecords", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := migrateDomainAuthenticationDNSRecords(context.Background(), domainAuthenticationStateV1{
+			DomainAuthenticationArgs: DomainAuthenticationArgs{Domain: "example.com"},
+			DomainID:                 7,
+			MailCname:                mailCname,
+			Dkim1:                    dkim1,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.Result)
+
+		state := result.Result
+		assert.Equal(t, "example.com", state.Domain)
+		assert.Equal(t, 7, state.DomainID)
+		assert.Equal(t, mailCname, state.MailCname)
+		assert.Equal(t, []DomainDNSRecord{
+			{Name: "mail_cname", Valid: true, Type: "cname", Host: "em.example.com", Data: "u1.wl.sendgrid.net"},
+			{Name: "dkim1", Type: "cname", Host: "s1._domainkey.example.com", Data: "s1.domainkey.u1.wl.sendgrid.net"},
+		}, state.DNSRecords)
+	})
+
+	t.Run("current state", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := migrateDomainAuthenticationDNSRecords(context.Background(), domainAuthenticationStateV1{
+			DomainAuthenticationArgs: DomainAuthenticationArgs{Domain: "example.com"},
+			DNSRecords:               []DomainDNSRecord{{Name: "mail_server", Type: "mx"}},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, result.Result)
+	})
+
+	t.Run("old state has no diff", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := previewValidationServer(t, false, nil, &calls)
+
+		resp, err := server.Diff(p.DiffRequest{
+			ID:  "7",
+			Urn: previewURN("DomainAuthentication", "test"),
+			State: property.NewMap(map[string]property.Value{
+				"domain":    property.New("example.com"),
+				"subdomain": property.New("em"),
+				"domainId":  property.New(7.0),
+				"userId":    property.New(1.0),
+				"username":  property.New("parent"),
+				"valid":     property.New(true),
+				"legacy":    property.New(false),
+				"mailCname": property.New(map[string]property.Value{
+					"valid": property.New(true),
+					"type":  property.New("cname"),
+					"host":  property.New("em.example.com"),
+					"data":  property.New("u1.wl.sendgrid.net"),
+				}),
+			}),
+			Inputs: property.NewMap(map[string]property.Value{
+				"domain":    property.New("example.com"),
+				"subdomain": property.New("em"),
+			}),
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.HasChanges, "%v", resp.DetailedDiff)
+	})
+}
+
+func TestIsDKIMSelector(t *testing.T) {
+	t.Parallel()
+
+	for _, selector := range []string{"s", "s1", "ABC", "123"} {
+		assert.True(t, isDKIMSelector(selector), selector)
+	}
+	for _, selector := range []string{"", "s123", "s-1", "s_1", "é1", "s1."} {
+		assert.False(t, isDKIMSelector(selector), selector)
+	}
+}
@@ -17,8 +17,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // EventWebhook is the controller for the SendGrid Event Webhook resource.
@@ -73,6 +79,28 @@ type EventWebhookArgs struct {
 
 	// GroupUnsubscribe - recipient unsubscribed from a group
 	GroupUnsubscribe *bool `pulumi:"groupUnsubscribe,optional"`
+
+	// OAuthClientID is the client ID SendGrid uses to request OAuth tokens for the webhook (optional)
+	OAuthClientID *string `pulumi:"oauthClientId,optional"`
+
+	// OAuthClientSecret is the client secret for OAuth token requests (optional, required with oauthClientId).
+	// SendGrid never returns it, so changes made outside Pulumi are not detected.
+	OAuthClientSecret *string `pulumi:"oauthClientSecret,optional" provider:"secret"`
+
+	// OAuthTokenURL is the URL SendGrid requests OAuth tokens from (optional, required with oauthClientId)
+	OAuthTokenURL *string `pulumi:"oauthTokenUrl,optional"`
+
+	// AdoptExisting takes over a webhook already registered for the URL instead of
+	// failing to create a duplicate (optional, defaults to false)
+	AdoptExisting *bool `pulumi:"adoptExisting,optional"`
+
+	// VerifyURLReachable makes Check post an empty batch of events to the URL when it changes,
+	// and fail if the URL cannot be reached or is not found (optional, defaults to false)
+	VerifyURLReachable *bool `pulumi:"verifyUrlReachable,optional"`
+
+	// DeletionProtection prevents the webhook from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // EventWebhookState is the state of the EventWebhook resource.
@@ -91,7 +119,7 @@ func (w *EventWebhook) Annotate(annotator infer.Annotator) {
 		"occur, such as delivery, opens, clicks, bounces, and more. Configure the URL "+
 		"endpoint and select which events to track.\n\n"+
 		"Note: Only one webhook can be configured per URL. Signature verification "+
-		"must be configured separately after webhook creation.")
+		"is enabled with the EventWebhookSignature resource.")
 }
 
 // eventWebhookAPIResponse represents the SendGrid API response structure for event webhooks
@@ -111,14 +139,28 @@ type eventWebhookAPIResponse struct {
 	Unsubscribe      bool   `json:"unsubscribe"`
 	GroupResubscribe bool   `json:"group_resubscribe"`
 	GroupUnsubscribe bool   `json:"group_unsubscribe"`
+	OAuthClientID    string `json:"oauth_client_id,omitempty"`
+	OAuthTokenURL    string `json:"oauth_token_url,omitempty"`
+}
+
+// eventWebhookDefaults are the values SendGrid gives webhook inputs that are left
+// unset, other than false for the event toggles
+var eventWebhookDefaults = map[string]any{
+	"enabled": true,
 }
 
 // toState converts an API response to EventWebhookState
 func (r *eventWebhookAPIResponse) toState() EventWebhookState {
-	var friendlyName *string
+	var friendlyName, oauthClientID, oauthTokenURL *string
 	if r.FriendlyName != "" {
 		friendlyName = &r.FriendlyName
 	}
+	if r.OAuthClientID != "" {
+		oauthClientID = &r.OAuthClientID
+	}
+	if r.OAuthTokenURL != "" {
+		oauthTokenURL = &r.OAuthTokenURL
+	}
 
 	enabled := r.Enabled
 	bounce := r.Bounce
@@ -149,6 +191,8 @@ func (r *eventWebhookAPIResponse) toState() EventWebhookState {
 			Unsubscribe:      &unsubscribe,
 			GroupResubscribe: &groupResubscribe,
 			GroupUnsubscribe: &groupUnsubscribe,
+			OAuthClientID:    oauthClientID,
+			OAuthTokenURL:    oauthTokenURL,
 		},
 		WebhookID: r.ID,
 	}
@@ -205,29 +249,99 @@ func (args *EventWebhookArgs) buildRequestBody() map[string]interface{} {
 		reqBody["group_unsubscribe"] = *args.GroupUnsubscribe
 	}
 
+	// OAuth settings are sent when set; the secret is write-only
+	if args.OAuthClientID != nil {
+		reqBody["oauth_client_id"] = *args.OAuthClientID
+	}
+	if args.OAuthClientSecret != nil {
+		reqBody["oauth_client_secret"] = *args.OAuthClientSecret
+	}
+	if args.OAuthTokenURL != nil {
+		reqBody["oauth_token_url"] = *args.OAuthTokenURL
+	}
+
 	return reqBody
 }
 
+// Check validates the EventWebhook inputs.
+func (w *EventWebhook) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[EventWebhookArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[EventWebhookArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[EventWebhookArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	if inputs.VerifyURLReachable != nil && *inputs.VerifyURLReachable && len(failures) == 0 &&
+		inputsKnown(req.NewInputs, "url") && inputsChanged(req, "url", "verifyUrlReachable") {
+		if err := probeWebhookURL(ctx, infer.GetConfig[Config](ctx).transport, inputs.URL); err != nil {
+			failures = append(failures, p.CheckFailure{Property: "url", Reason: err.Error()})
+		}
+	}
+
+	return infer.CheckResponse[EventWebhookArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// webhookProbeTimeout bounds the request verifyUrlReachable sends to a webhook URL
+const webhookProbeTimeout = 10 * time.Second
+
+// probeWebhookURL posts an empty batch of events to a webhook URL, as SendGrid would
+// with no events to deliver. Receivers may reject the unsigned request, so any response
+// but Not Found or Gone shows the URL is right. It is sent through the provider's transport,
+// so it takes the same proxy as requests to SendGrid.
+func probeWebhookURL(ctx context.Context, transport http.RoundTripper, rawURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, strings.NewReader("[]"))
+	if err != nil {
+		return fmt.Errorf("cannot probe %s: %w", rawURL, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", defaultUserAgent())
+
+	client := &http.Client{Transport: transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s is not reachable: %w", rawURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return fmt.Errorf("%s responded %s; check the URL for typos", rawURL, resp.Status)
+	}
+	p.GetLogger(ctx).Debugf("Webhook URL %s responded %s", rawURL, resp.Status)
+	return nil
+}
+
+// validate reports invalid EventWebhookArgs
+func (args *EventWebhookArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.httpURL("url", args.URL)
+	if args.OAuthTokenURL != nil {
+		v.httpURL("oauthTokenUrl", *args.OAuthTokenURL)
+	}
+	if args.OAuthClientID != nil || args.OAuthClientSecret != nil || args.OAuthTokenURL != nil {
+		v.required("oauthClientId", stringValue(args.OAuthClientID))
+		v.required("oauthClientSecret", stringValue(args.OAuthClientSecret))
+		v.required("oauthTokenUrl", stringValue(args.OAuthTokenURL))
+	}
+	return v.failures
+}
+
 // Create creates a new SendGrid Event Webhook.
 func (w *EventWebhook) Create(ctx context.Context, req infer.CreateRequest[EventWebhookArgs]) (infer.CreateResponse[EventWebhookState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
-		enabled := true
-		if input.Enabled != nil {
-			enabled = *input.Enabled
-		}
 		state := EventWebhookState{
 			EventWebhookArgs: input,
-			WebhookID:        "[computed]",
-		}
-		if state.Enabled == nil {
-			state.Enabled = &enabled
 		}
 		return infer.CreateResponse[EventWebhookState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -241,14 +355,38 @@ func (w *EventWebhook) Create(ctx context.Context, req infer.CreateRequest[Event
 	// Build the request body
 	reqBody := input.buildRequestBody()
 
-	// Make the API call
+	// Make the API call, or take over the webhook already registered for the URL
 	// POST /v3/user/webhooks/event/settings
 	var result eventWebhookAPIResponse
-	if err := client.Post(ctx, "/v3/user/webhooks/event/settings", reqBody, &result); err != nil {
+	existing, err := createOrAdopt(ctx, input.AdoptExisting, "webhook",
+		func() error { return client.Post(ctx, "/v3/user/webhooks/event/settings", reqBody, &result) },
+		func() (*eventWebhookAPIResponse, error) { return findEventWebhookByURL(ctx, client, input.URL) })
+	if err != nil {
 		return infer.CreateResponse[EventWebhookState]{}, fmt.Errorf("failed to create event webhook: %w", err)
 	}
+	if existing != nil {
+		p.GetLogger(ctx).Infof("Adopting existing event webhook %s for %s", existing.ID, input.URL)
+		if err := client.Patch(ctx, fmt.Sprintf("/v3/user/webhooks/event/settings/%s", existing.ID), reqBody, &result); err != nil {
+			// The adopted event webhook is recorded as found, so the next update applies the inputs to it
+			state := existing.toState()
+			state.AdoptExisting = input.AdoptExisting
+			state.VerifyURLReachable = input.VerifyURLReachable
+			state.DeletionProtection = input.DeletionProtection
+			normalizeArgs(&state.EventWebhookArgs, input, eventWebhookDefaults)
+			preserveInputs(&state.EventWebhookArgs, input, "oauthClientSecret")
+			return infer.CreateResponse[EventWebhookState]{
+				ID:     existing.ID,
+				Output: state,
+			}, initFailed(fmt.Sprintf("update adopted event webhook %s", existing.ID), err)
+		}
+	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.VerifyURLReachable = input.VerifyURLReachable
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.EventWebhookArgs, input, eventWebhookDefaults)
+	preserveInputs(&state.EventWebhookArgs, input, "oauthClientSecret")
 
 	return infer.CreateResponse[EventWebhookState]{
 		ID:     result.ID,
@@ -279,6 +417,11 @@ func (w *EventWebhook) Read(ctx context.Context, req infer.ReadRequest[EventWebh
 	}
 
 	state := result.toState()
+	state.AdoptExisting = req.Inputs.AdoptExisting
+	state.VerifyURLReachable = req.Inputs.VerifyURLReachable
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	normalizeArgs(&state.EventWebhookArgs, req.Inputs, eventWebhookDefaults)
+	preserveInputs(&state.EventWebhookArgs, req.Inputs, "oauthClientSecret")
 	inputs := state.EventWebhookArgs
 
 	return infer.ReadResponse[EventWebhookArgs, EventWebhookState]{
@@ -321,14 +464,38 @@ func (w *EventWebhook) Update(ctx context.Context, req infer.UpdateRequest[Event
 	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.VerifyURLReachable = input.VerifyURLReachable
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.EventWebhookArgs, input, eventWebhookDefaults)
+	preserveInputs(&state.EventWebhookArgs, input, "oauthClientSecret")
 
 	return infer.UpdateResponse[EventWebhookState]{Output: state}, nil
 }
 
+// findEventWebhookByURL returns the event webhook registered for url, or nil if there is none
+func findEventWebhookByURL(ctx context.Context, client SendGridAPI, url string) (*eventWebhookAPIResponse, error) {
+	// GET /v3/user/webhooks/event/settings/all
+	var result eventWebhookListAPIResponse
+	if err := client.Get(ctx, "/v3/user/webhooks/event/settings/all", &result); err != nil {
+		return nil, err
+	}
+	for i := range result.Webhooks {
+		if result.Webhooks[i].URL == url {
+			return &result.Webhooks[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // Delete removes a SendGrid Event Webhook.
 func (w *EventWebhook) Delete(ctx context.Context, req infer.DeleteRequest[EventWebhookState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "event webhook", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
@@ -0,0 +1,201 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// EventWebhookSignature is the controller for the SendGrid Event Webhook signature verification resource.
+//
+// This resource enables signing of the events SendGrid posts to an event webhook,
+// and returns the public key receivers verify the signatures with.
+type EventWebhookSignature struct{}
+
+// EventWebhookSignatureArgs are the inputs to the EventWebhookSignature resource.
+type EventWebhookSignatureArgs struct {
+	// WebhookID is the ID of the event webhook to sign events for (required)
+	WebhookID string `pulumi:"webhookId" provider:"replaceOnChanges"`
+
+	// DeletionProtection prevents signing from being disabled, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// EventWebhookSignatureState is the state of the EventWebhookSignature resource.
+type EventWebhookSignatureState struct {
+	// Embed the input args in the output state
+	EventWebhookSignatureArgs
+
+	// PublicKey is the ECDSA public key the webhook's events are signed with, base64 encoded
+	PublicKey string `pulumi:"publicKey"`
+}
+
+// Annotate provides descriptions for the EventWebhookSignature resource.
+func (s *EventWebhookSignature) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&s, "Enables signature verification of a SendGrid Event Webhook.\n\n"+
+		"SendGrid then signs each request to the webhook, so the receiving service can verify events "+
+		"with the publicKey output. The resource ID is the webhook ID. Deleting the resource disables "+
+		"signing; enabling it again generates a new key pair.")
+}
+
+// eventWebhookSignatureAPIResponse represents the SendGrid API response structure for signed webhooks
+type eventWebhookSignatureAPIResponse struct {
+	ID        string `json:"id"`
+	PublicKey string `json:"public_key"`
+}
+
+// Check validates the EventWebhookSignature inputs.
+func (s *EventWebhookSignature) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[EventWebhookSignatureArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[EventWebhookSignatureArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[EventWebhookSignatureArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[EventWebhookSignatureArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid EventWebhookSignatureArgs
+func (args *EventWebhookSignatureArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("webhookId", args.WebhookID)
+	return v.failures
+}
+
+// setEventWebhookSigning enables or disables signing for the webhook
+func setEventWebhookSigning(ctx context.Context, client SendGridAPI, webhookID string, enabled bool) (*eventWebhookSignatureAPIResponse, error) {
+	// PATCH /v3/user/webhooks/event/settings/signed/{id}
+	var result eventWebhookSignatureAPIResponse
+	reqBody := map[string]interface{}{
+		"enabled": enabled,
+	}
+	if err := client.Patch(ctx, fmt.Sprintf("/v3/user/webhooks/event/settings/signed/%s", webhookID), reqBody, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Create enables signature verification for the event webhook.
+func (s *EventWebhookSignature) Create(ctx context.Context, req infer.CreateRequest[EventWebhookSignatureArgs]) (infer.CreateResponse[EventWebhookSignatureState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, leave the public key unset, so it is unknown
+	if preview {
+		return infer.CreateResponse[EventWebhookSignatureState]{
+			ID:     input.WebhookID,
+			Output: EventWebhookSignatureState{EventWebhookSignatureArgs: input},
+		}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.CreateResponse[EventWebhookSignatureState]{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	result, err := setEventWebhookSigning(ctx, client, input.WebhookID, true)
+	if err != nil {
+		return infer.CreateResponse[EventWebhookSignatureState]{}, fmt.Errorf("failed to enable event webhook signature verification: %w", err)
+	}
+
+	return infer.CreateResponse[EventWebhookSignatureState]{
+		ID: input.WebhookID,
+		Output: EventWebhookSignatureState{
+			EventWebhookSignatureArgs: input,
+			PublicKey:                 result.PublicKey,
+		},
+	}, nil
+}
+
+// Read retrieves the public key of the event webhook, if signing is still enabled.
+func (s *EventWebhookSignature) Read(ctx context.Context, req infer.ReadRequest[EventWebhookSignatureArgs, EventWebhookSignatureState]) (infer.ReadResponse[EventWebhookSignatureArgs, EventWebhookSignatureState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[EventWebhookSignatureArgs, EventWebhookSignatureState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/user/webhooks/event/settings/signed/{id}
+	var result eventWebhookSignatureAPIResponse
+	if err := client.Get(ctx, fmt.Sprintf("/v3/user/webhooks/event/settings/signed/%s", id), &result); err != nil {
+		// Check if the webhook was deleted out-of-band
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			// Return empty response to indicate resource no longer exists
+			return infer.ReadResponse[EventWebhookSignatureArgs, EventWebhookSignatureState]{}, nil
+		}
+		return infer.ReadResponse[EventWebhookSignatureArgs, EventWebhookSignatureState]{}, fmt.Errorf("failed to read event webhook signature verification: %w", err)
+	}
+
+	// SendGrid returns no public key once signing is disabled
+	if result.PublicKey == "" {
+		return infer.ReadResponse[EventWebhookSignatureArgs, EventWebhookSignatureState]{}, nil
+	}
+
+	inputs := EventWebhookSignatureArgs{
+		WebhookID:          id,
+		DeletionProtection: req.Inputs.DeletionProtection,
+	}
+	return infer.ReadResponse[EventWebhookSignatureArgs, EventWebhookSignatureState]{
+		ID:     id,
+		Inputs: inputs,
+		State: EventWebhookSignatureState{
+			EventWebhookSignatureArgs: inputs,
+			PublicKey:                 result.PublicKey,
+		},
+	}, nil
+}
+
+// Update records a change of deletionProtection, as a new webhook ID replaces the resource.
+func (s *EventWebhookSignature) Update(_ context.Context, req infer.UpdateRequest[EventWebhookSignatureArgs, EventWebhookSignatureState]) (infer.UpdateResponse[EventWebhookSignatureState], error) {
+	state := req.State
+	state.EventWebhookSignatureArgs = req.Inputs
+	return infer.UpdateResponse[EventWebhookSignatureState]{Output: state}, nil
+}
+
+// Delete disables signature verification for the event webhook.
+func (s *EventWebhookSignature) Delete(ctx context.Context, req infer.DeleteRequest[EventWebhookSignatureState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "event webhook signature verification", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	if _, err := setEventWebhookSigning(ctx, client, id, false); err != nil {
+		// If the webhook is already deleted, so is its signing
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to disable event webhook signature verification: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
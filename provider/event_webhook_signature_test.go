@@ -0,0 +1,109 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestEventWebhookSignature_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	publicKey := ""
+	var requests []string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method + " " + req.URL.Path {
+		case "PATCH /v3/user/webhooks/event/settings/signed/wh-1":
+			var body map[string]bool
+			data, _ := io.ReadAll(req.Body)
+			require.NoError(t, json.Unmarshal(data, &body))
+			publicKey = ""
+			if body["enabled"] {
+				publicKey = "MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE"
+			}
+			return fakeResponse(req, http.StatusOK, `{"id": "wh-1", "public_key": "`+publicKey+`"}`), nil
+		case "GET /v3/user/webhooks/event/settings/signed/wh-1":
+			return fakeResponse(req, http.StatusOK, `{"id": "wh-1", "public_key": "`+publicKey+`"}`), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("EventWebhookSignature", "events")
+	inputs := property.NewMap(map[string]property.Value{
+		"webhookId": property.New("wh-1"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "wh-1", created.ID)
+	assert.Equal(t, "MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE", created.Properties.Get("publicKey").AsString())
+
+	read, err := server.Read(p.ReadRequest{ID: "wh-1", Urn: urn, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "wh-1", read.ID)
+	assert.Equal(t, "wh-1", read.Inputs.Get("webhookId").AsString())
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "wh-1", Urn: urn, Properties: read.Properties}))
+
+	// Once signing is disabled, SendGrid returns no public key and the resource is gone
+	gone, err := server.Read(p.ReadRequest{ID: "wh-1", Urn: urn, Properties: read.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Empty(t, gone.ID)
+
+	assert.Equal(t, []string{
+		"PATCH /v3/user/webhooks/event/settings/signed/wh-1",
+		"GET /v3/user/webhooks/event/settings/signed/wh-1",
+		"PATCH /v3/user/webhooks/event/settings/signed/wh-1",
+		"GET /v3/user/webhooks/event/settings/signed/wh-1",
+	}, requests)
+}
+
+func TestEventWebhookSignature_DeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	err := server.Delete(p.DeleteRequest{
+		ID:  "wh-1",
+		Urn: previewURN("EventWebhookSignature", "events"),
+		Properties: property.NewMap(map[string]property.Value{
+			"webhookId":          property.New("wh-1"),
+			"publicKey":          property.New("key"),
+			"deletionProtection": property.New(true),
+		}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deletionProtection")
+}
@@ -17,11 +17,16 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 func TestSendGridClient_CreateEventWebhook(t *testing.T) {
@@ -573,6 +578,25 @@ func TestEventWebhookArgs_BuildRequestBody(t *testing.T) {
 		assert.Equal(t, true, reqBody["enabled"])
 		_, hasFriendlyName := reqBody["friendly_name"]
 		assert.False(t, hasFriendlyName)
+		_, hasOAuth := reqBody["oauth_client_id"]
+		assert.False(t, hasOAuth)
+	})
+
+	t.Run("OAuth settings", func(t *testing.T) {
+		t.Parallel()
+
+		args := &EventWebhookArgs{
+			URL:               "https://example.com/webhook",
+			OAuthClientID:     strPtr("client"),
+			OAuthClientSecret: strPtr("secret"),
+			OAuthTokenURL:     strPtr("https://auth.example.com/token"),
+		}
+
+		reqBody := args.buildRequestBody()
+
+		assert.Equal(t, "client", reqBody["oauth_client_id"])
+		assert.Equal(t, "secret", reqBody["oauth_client_secret"])
+		assert.Equal(t, "https://auth.example.com/token", reqBody["oauth_token_url"])
 	})
 }
 
@@ -623,3 +647,57 @@ func TestEventWebhook_ServerErrors(t *testing.T) {
 		assert.Equal(t, http.StatusForbidden, sgErr.StatusCode)
 	})
 }
+
+func TestEventWebhook_VerifyURLReachable(t *testing.T) {
+	t.Parallel()
+
+	// The probe goes through the provider's transport, like requests to SendGrid
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "[]", string(body))
+		switch {
+		case req.URL.Host == "down.example.com":
+			return nil, errors.New("connection refused")
+		case req.URL.Path != "/sendgrid/events":
+			resp := fakeResponse(req, http.StatusNotFound, "")
+			resp.Status = "404 Not Found"
+			return resp, nil
+		}
+		// Receivers verifying signatures reject the unsigned probe, which still shows the URL is right
+		return fakeResponse(req, http.StatusUnauthorized, ""), nil
+	})
+
+	tests := []struct {
+		name    string
+		url     string
+		verify  bool
+		failure string
+	}{
+		{name: "reachable", url: "https://hooks.example.com/sendgrid/events", verify: true},
+		{name: "not found", url: "https://hooks.example.com/sendgrid/evnets", verify: true, failure: "404 Not Found; check the URL for typos"},
+		{name: "unreachable", url: "https://down.example.com/sendgrid/events", verify: true, failure: "is not reachable"},
+		{name: "not verified", url: "https://down.example.com/sendgrid/events"},
+	}
+
+	server := newTestServer(t, transport, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.Check(p.CheckRequest{
+				Urn: previewURN("EventWebhook", "events"),
+				Inputs: property.NewMap(map[string]property.Value{
+					"url":                property.New(tt.url),
+					"verifyUrlReachable": property.New(tt.verify),
+				}),
+			})
+			require.NoError(t, err)
+			if tt.failure == "" {
+				assert.Empty(t, resp.Failures)
+				return
+			}
+			require.Len(t, resp.Failures, 1)
+			assert.Equal(t, "url", resp.Failures[0].Property)
+			assert.Contains(t, resp.Failures[0].Reason, tt.failure)
+		})
+	}
+}
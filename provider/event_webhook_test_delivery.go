@@ -0,0 +1,169 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// EventWebhookTestDelivery is the controller for the SendGrid Event Webhook test delivery resource.
+//
+// This resource posts SendGrid's sample events to an event webhook when it is
+// created and whenever its inputs change, so a deployment checks that the
+// receiving service accepts events.
+type EventWebhookTestDelivery struct{}
+
+// EventWebhookTestDeliveryArgs are the inputs to the EventWebhookTestDelivery resource.
+type EventWebhookTestDeliveryArgs struct {
+	// WebhookID is the ID of the event webhook to test (required)
+	WebhookID string `pulumi:"webhookId" provider:"replaceOnChanges"`
+
+	// URL is the endpoint of the webhook (required)
+	URL string `pulumi:"url"`
+
+	// OAuthClientID is the client ID of the webhook's OAuth settings (optional)
+	OAuthClientID *string `pulumi:"oauthClientId,optional"`
+
+	// OAuthClientSecret is the client secret of the webhook's OAuth settings (optional)
+	OAuthClientSecret *string `pulumi:"oauthClientSecret,optional" provider:"secret"`
+
+	// OAuthTokenURL is the token URL of the webhook's OAuth settings (optional)
+	OAuthTokenURL *string `pulumi:"oauthTokenUrl,optional"`
+
+	// Triggers are arbitrary values that, when changed, send the test events again (optional)
+	Triggers map[string]string `pulumi:"triggers,optional"`
+}
+
+// EventWebhookTestDeliveryState is the state of the EventWebhookTestDelivery resource.
+type EventWebhookTestDeliveryState struct {
+	// Embed the input args in the output state
+	EventWebhookTestDeliveryArgs
+}
+
+// Annotate provides descriptions for the EventWebhookTestDelivery resource.
+func (d *EventWebhookTestDelivery) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&d, "Sends test events to a SendGrid Event Webhook.\n\n"+
+		"SendGrid posts its sample events to the webhook when the resource is created and again whenever "+
+		"any input changes, including triggers. The deployment fails if SendGrid refuses to send them, "+
+		"e.g. for an unknown webhook, but SendGrid does not report how the receiving service responded, "+
+		"so check the service's logs to see whether it accepted them. Deleting the resource sends nothing.")
+}
+
+// WireDependencies marks the OAuth client secret secret.
+func (d *EventWebhookTestDelivery) WireDependencies(f infer.FieldSelector, _ *EventWebhookTestDeliveryArgs, state *EventWebhookTestDeliveryState) {
+	f.OutputField(&state.OAuthClientSecret).AlwaysSecret()
+}
+
+// Check validates the EventWebhookTestDelivery inputs.
+func (d *EventWebhookTestDelivery) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[EventWebhookTestDeliveryArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[EventWebhookTestDeliveryArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[EventWebhookTestDeliveryArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[EventWebhookTestDeliveryArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid EventWebhookTestDeliveryArgs
+func (args *EventWebhookTestDeliveryArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("webhookId", args.WebhookID)
+	v.httpURL("url", args.URL)
+	return v.failures
+}
+
+// sendTestEvents posts SendGrid's sample events to the webhook
+func sendTestEvents(ctx context.Context, args EventWebhookTestDeliveryArgs) error {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	reqBody := map[string]interface{}{
+		"id":  args.WebhookID,
+		"url": args.URL,
+	}
+	if args.OAuthClientID != nil {
+		reqBody["oauth_client_id"] = *args.OAuthClientID
+	}
+	if args.OAuthClientSecret != nil {
+		reqBody["oauth_client_secret"] = *args.OAuthClientSecret
+	}
+	if args.OAuthTokenURL != nil {
+		reqBody["oauth_token_url"] = *args.OAuthTokenURL
+	}
+
+	// POST /v3/user/webhooks/event/test responds with no content once the events are accepted
+	if err := client.Post(ctx, "/v3/user/webhooks/event/test", reqBody, nil); err != nil {
+		return fmt.Errorf("failed to send test events to event webhook %s: %w", args.WebhookID, err)
+	}
+	return nil
+}
+
+// Create sends the test events.
+func (d *EventWebhookTestDelivery) Create(ctx context.Context, req infer.CreateRequest[EventWebhookTestDeliveryArgs]) (infer.CreateResponse[EventWebhookTestDeliveryState], error) {
+	input := req.Inputs
+	state := EventWebhookTestDeliveryState{EventWebhookTestDeliveryArgs: input}
+
+	// During preview, return the expected state
+	if req.DryRun {
+		return infer.CreateResponse[EventWebhookTestDeliveryState]{ID: input.WebhookID, Output: state}, nil
+	}
+
+	if err := sendTestEvents(ctx, input); err != nil {
+		return infer.CreateResponse[EventWebhookTestDeliveryState]{}, err
+	}
+
+	return infer.CreateResponse[EventWebhookTestDeliveryState]{ID: input.WebhookID, Output: state}, nil
+}
+
+// Read returns the recorded state, as a test delivery leaves nothing to read back.
+func (d *EventWebhookTestDelivery) Read(_ context.Context, req infer.ReadRequest[EventWebhookTestDeliveryArgs, EventWebhookTestDeliveryState]) (infer.ReadResponse[EventWebhookTestDeliveryArgs, EventWebhookTestDeliveryState], error) {
+	return infer.ReadResponse[EventWebhookTestDeliveryArgs, EventWebhookTestDeliveryState]{
+		ID:     req.ID,
+		Inputs: req.Inputs,
+		State:  req.State,
+	}, nil
+}
+
+// Update sends the test events again with the new inputs.
+func (d *EventWebhookTestDelivery) Update(ctx context.Context, req infer.UpdateRequest[EventWebhookTestDeliveryArgs, EventWebhookTestDeliveryState]) (infer.UpdateResponse[EventWebhookTestDeliveryState], error) {
+	input := req.Inputs
+	state := EventWebhookTestDeliveryState{EventWebhookTestDeliveryArgs: input}
+
+	// During preview, return expected state
+	if req.DryRun {
+		return infer.UpdateResponse[EventWebhookTestDeliveryState]{Output: state}, nil
+	}
+
+	if err := sendTestEvents(ctx, input); err != nil {
+		return infer.UpdateResponse[EventWebhookTestDeliveryState]{}, err
+	}
+	return infer.UpdateResponse[EventWebhookTestDeliveryState]{Output: state}, nil
+}
+
+// Delete stops tracking the test delivery. Nothing is sent.
+func (d *EventWebhookTestDelivery) Delete(_ context.Context, _ infer.DeleteRequest[EventWebhookTestDeliveryState]) (infer.DeleteResponse, error) {
+	return infer.DeleteResponse{}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestEventWebhookTestDelivery_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var bodies []map[string]string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if req.Method+" "+req.URL.Path != "POST /v3/user/webhooks/event/test" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return fakeResponse(req, http.StatusNotFound, `{}`), nil
+		}
+		var body map[string]string
+		data, _ := io.ReadAll(req.Body)
+		require.NoError(t, json.Unmarshal(data, &body))
+		bodies = append(bodies, body)
+		return fakeResponse(req, http.StatusNoContent, ``), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("EventWebhookTestDelivery", "events")
+	inputs := property.NewMap(map[string]property.Value{
+		"webhookId":         property.New("wh-1"),
+		"url":               property.New("https://hooks.example.com/sendgrid"),
+		"oauthClientId":     property.New("client"),
+		"oauthClientSecret": property.New("secret").WithSecret(true),
+		"oauthTokenUrl":     property.New("https://auth.example.com/token"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "wh-1", created.ID)
+	assert.True(t, created.Properties.Get("oauthClientSecret").Secret())
+
+	// Changing triggers sends the test events again
+	retest := inputs.Set("triggers", property.New(map[string]property.Value{"release": property.New("2")}))
+	_, err = server.Update(p.UpdateRequest{ID: "wh-1", Urn: urn, State: created.Properties, Inputs: retest})
+	require.NoError(t, err)
+
+	// Deleting sends nothing
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "wh-1", Urn: urn, Properties: created.Properties}))
+
+	require.Len(t, bodies, 2)
+	assert.Equal(t, map[string]string{
+		"id":                  "wh-1",
+		"url":                 "https://hooks.example.com/sendgrid",
+		"oauth_client_id":     "client",
+		"oauth_client_secret": "secret",
+		"oauth_token_url":     "https://auth.example.com/token",
+	}, bodies[0])
+}
+
+func TestEventWebhookTestDelivery_Failure(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return fakeResponse(req, http.StatusBadRequest, `{"errors": [{"message": "received a 500 from the webhook endpoint"}]}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	_, err := server.Create(p.CreateRequest{
+		Urn: previewURN("EventWebhookTestDelivery", "events"),
+		Properties: property.NewMap(map[string]property.Value{
+			"webhookId": property.New("wh-1"),
+			"url":       property.New("https://hooks.example.com/sendgrid"),
+		}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to send test events to event webhook wh-1")
+}
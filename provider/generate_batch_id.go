@@ -0,0 +1,69 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GenerateBatchId is the controller for the generateBatchId function.
+//
+// This function mints a new mail batch ID, which groups scheduled emails so
+// they can be paused or cancelled together with the ScheduledSend resource.
+type GenerateBatchId struct{} //nolint:revive // name matches Pulumi function token
+
+// GenerateBatchIdArgs are the inputs to the generateBatchId function.
+type GenerateBatchIdArgs struct{} //nolint:revive // name matches Pulumi function token
+
+// GenerateBatchIdResult is the output of the generateBatchId function.
+type GenerateBatchIdResult struct { //nolint:revive // name matches Pulumi function token
+	// BatchID is the new batch ID
+	BatchID string `pulumi:"batchId"`
+}
+
+// Annotate provides descriptions for the generateBatchId function.
+func (f *GenerateBatchId) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Generates a new mail batch ID.\n\n"+
+		"Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend "+
+		"resource to pause or cancel them. Every call mints a new ID, including during previews, "+
+		"so store the ID, e.g. in stack configuration, when it must stay the same across deployments.")
+}
+
+// batchIDAPIResponse represents the SendGrid API response structure for a new batch ID
+type batchIDAPIResponse struct {
+	BatchID string `json:"batch_id"`
+}
+
+// Invoke creates a new batch ID.
+func (f *GenerateBatchId) Invoke(ctx context.Context, _ infer.FunctionRequest[GenerateBatchIdArgs]) (infer.FunctionResponse[GenerateBatchIdResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GenerateBatchIdResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// POST /v3/mail/batch
+	var result batchIDAPIResponse
+	if err := client.Post(ctx, "/v3/mail/batch", nil, &result); err != nil {
+		return infer.FunctionResponse[GenerateBatchIdResult]{}, fmt.Errorf("failed to generate batch ID: %w", err)
+	}
+
+	return infer.FunctionResponse[GenerateBatchIdResult]{
+		Output: GenerateBatchIdResult{BatchID: result.BatchID},
+	}, nil
+}
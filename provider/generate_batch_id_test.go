@@ -0,0 +1,75 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGenerateBatchId(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		responseStatus int
+		responseBody   string
+		expectError    bool
+		expectBatchID  string
+	}{
+		{
+			name:           "new batch ID",
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"batch_id": "HkJ5yLYULb7Rj8GKSx7u025ouWVlMgAi"}`,
+			expectBatchID:  "HkJ5yLYULb7Rj8GKSx7u025ouWVlMgAi",
+		},
+		{
+			name:           "forbidden",
+			responseStatus: http.StatusForbidden,
+			responseBody:   `{"errors": [{"message": "access forbidden"}]}`,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/v3/mail/batch", req.URL.Path)
+				return fakeResponse(req, tt.responseStatus, tt.responseBody), nil
+			})
+			server := newTestServer(t, transport, nil)
+
+			resp, err := server.Invoke(p.InvokeRequest{
+				Token: "sendgrid:index:generateBatchId",
+				Args:  property.NewMap(nil),
+			})
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectBatchID, resp.Return.Get("batchId").AsString())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetAccountProfile is the controller for the getAccountProfile function.
+//
+// This function returns the plan type, reputation and profile of the SendGrid
+// account, so programs can adapt to the account, e.g. skip subusers on free plans.
+type GetAccountProfile struct{}
+
+// GetAccountProfileArgs are the inputs to the getAccountProfile function.
+type GetAccountProfileArgs struct{}
+
+// GetAccountProfileResult is the output of the getAccountProfile function.
+type GetAccountProfileResult struct {
+	// Type is the account type, "free" or "paid"
+	Type string `pulumi:"type"`
+
+	// Reputation is the sender reputation of the account, from 0 to 100
+	Reputation float64 `pulumi:"reputation"`
+
+	// FirstName is the first name on the profile
+	FirstName string `pulumi:"firstName"`
+
+	// LastName is the last name on the profile
+	LastName string `pulumi:"lastName"`
+
+	// Company is the company name on the profile
+	Company string `pulumi:"company"`
+
+	// Website is the website on the profile
+	Website string `pulumi:"website"`
+
+	// Phone is the phone number on the profile
+	Phone string `pulumi:"phone"`
+
+	// Address is the first line of the street address on the profile
+	Address string `pulumi:"address"`
+
+	// Address2 is the second line of the street address on the profile
+	Address2 string `pulumi:"address2"`
+
+	// City is the city on the profile
+	City string `pulumi:"city"`
+
+	// State is the state or region on the profile
+	State string `pulumi:"state"`
+
+	// Zip is the postal code on the profile
+	Zip string `pulumi:"zip"`
+
+	// Country is the country on the profile
+	Country string `pulumi:"country"`
+}
+
+// Annotate provides descriptions for the getAccountProfile function.
+func (f *GetAccountProfile) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Gets the plan type, sender reputation and profile of the SendGrid account.\n\n"+
+		"Useful for conditional logic, e.g. to skip resources such as subusers and IP pools "+
+		"that free plans do not support.")
+}
+
+// accountAPIResponse represents the SendGrid API response structure for the account type
+type accountAPIResponse struct {
+	Type       string  `json:"type"`
+	Reputation float64 `json:"reputation"`
+}
+
+// profileAPIResponse represents the SendGrid API response structure for the user profile
+type profileAPIResponse struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Company   string `json:"company"`
+	Website   string `json:"website"`
+	Phone     string `json:"phone"`
+	Address   string `json:"address"`
+	Address2  string `json:"address2"`
+	City      string `json:"city"`
+	State     string `json:"state"`
+	Zip       string `json:"zip"`
+	Country   string `json:"country"`
+}
+
+// Invoke gets the account type and profile of the SendGrid account.
+func (f *GetAccountProfile) Invoke(ctx context.Context, _ infer.FunctionRequest[GetAccountProfileArgs]) (infer.FunctionResponse[GetAccountProfileResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetAccountProfileResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/user/account
+	var account accountAPIResponse
+	if err := client.Get(ctx, "/v3/user/account", &account); err != nil {
+		return infer.FunctionResponse[GetAccountProfileResult]{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	// GET /v3/user/profile
+	var profile profileAPIResponse
+	if err := client.Get(ctx, "/v3/user/profile", &profile); err != nil {
+		return infer.FunctionResponse[GetAccountProfileResult]{}, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	return infer.FunctionResponse[GetAccountProfileResult]{
+		Output: GetAccountProfileResult{
+			Type:       account.Type,
+			Reputation: account.Reputation,
+			FirstName:  profile.FirstName,
+			LastName:   profile.LastName,
+			Company:    profile.Company,
+			Website:    profile.Website,
+			Phone:      profile.Phone,
+			Address:    profile.Address,
+			Address2:   profile.Address2,
+			City:       profile.City,
+			State:      profile.State,
+			Zip:        profile.Zip,
+			Country:    profile.Country,
+		},
+	}, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetAccountProfile(t *testing.T) {
+	t.Parallel()
+
+	responses := map[string]string{
+		"/v3/user/account": `{"type": "free", "reputation": 99.7}`,
+		"/v3/user/profile": `{"first_name": "Jane", "last_name": "Doe", "company": "Acme", "city": "Denver",
+			"country": "US", "website": "https://acme.example.com", "phone": "", "address": "1 Main St"}`,
+	}
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		if body, ok := responses[req.URL.Path]; ok {
+			return fakeResponse(req, http.StatusOK, body), nil
+		}
+		return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "not found"}]}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getAccountProfile",
+		Args:  property.NewMap(nil),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "free", resp.Return.Get("type").AsString())
+	assert.Equal(t, 99.7, resp.Return.Get("reputation").AsNumber())
+	assert.Equal(t, "Acme", resp.Return.Get("company").AsString())
+	assert.Equal(t, "Denver", resp.Return.Get("city").AsString())
+	assert.Equal(t, "", resp.Return.Get("zip").AsString())
+}
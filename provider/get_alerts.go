@@ -0,0 +1,106 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetAlerts is the controller for the getAlerts function.
+//
+// This function lists every alert configured on the SendGrid account, which
+// makes it possible to discover and import alerts created outside of Pulumi.
+type GetAlerts struct{}
+
+// GetAlertsArgs are the inputs to the getAlerts function.
+type GetAlertsArgs struct{}
+
+// AlertSummary describes a single alert returned by the getAlerts function.
+type AlertSummary struct {
+	// AlertID is the unique identifier assigned by SendGrid
+	AlertID int `pulumi:"alertId"`
+
+	// Type is the type of alert: "usage_limit" or "stats_notification"
+	Type string `pulumi:"type"`
+
+	// EmailTo is the email address alerts are sent to
+	EmailTo string `pulumi:"emailTo"`
+
+	// Percentage is the usage threshold (usage_limit alerts only)
+	Percentage *int `pulumi:"percentage,optional"`
+
+	// Frequency is how often stats are sent (stats_notification alerts only)
+	Frequency *string `pulumi:"frequency,optional"`
+
+	// CreatedAt is the Unix timestamp when the alert was created
+	CreatedAt int64 `pulumi:"createdAt"`
+
+	// UpdatedAt is the Unix timestamp when the alert was last updated
+	UpdatedAt int64 `pulumi:"updatedAt"`
+}
+
+// GetAlertsResult is the output of the getAlerts function.
+type GetAlertsResult struct {
+	// Alerts is the list of alerts configured on the account
+	Alerts []AlertSummary `pulumi:"alerts"`
+}
+
+// Annotate provides descriptions for the getAlerts function.
+func (f *GetAlerts) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists all SendGrid Alerts configured on the account.\n\n"+
+		"Returns the type, recipient, percentage and frequency of every alert. "+
+		"Use the `alertId` of an entry to import an existing alert with `pulumi import`.")
+}
+
+// toSummary converts an API response to an AlertSummary
+func (r *alertAPIResponse) toSummary() AlertSummary {
+	state := r.toState()
+	return AlertSummary{
+		AlertID:    state.AlertID,
+		Type:       state.Type,
+		EmailTo:    state.EmailTo,
+		Percentage: state.Percentage,
+		Frequency:  state.Frequency,
+		CreatedAt:  state.CreatedAt,
+		UpdatedAt:  state.UpdatedAt,
+	}
+}
+
+// Invoke lists the alerts configured on the SendGrid account.
+func (f *GetAlerts) Invoke(ctx context.Context, _ infer.FunctionRequest[GetAlertsArgs]) (infer.FunctionResponse[GetAlertsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetAlertsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/alerts returns a bare array of alerts
+	var result []alertAPIResponse
+	if err := client.Get(ctx, "/v3/alerts", &result); err != nil {
+		return infer.FunctionResponse[GetAlertsResult]{}, fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	alerts := make([]AlertSummary, len(result))
+	for i := range result {
+		alerts[i] = result[i].toSummary()
+	}
+
+	return infer.FunctionResponse[GetAlertsResult]{
+		Output: GetAlertsResult{Alerts: alerts},
+	}, nil
+}
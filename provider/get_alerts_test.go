@@ -0,0 +1,149 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListAlerts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		responseStatus int
+		responseBody   string
+		expectError    bool
+		expectCount    int
+	}{
+		{
+			name:           "successful list with both alert types",
+			responseStatus: http.StatusOK,
+			responseBody: `[
+				{
+					"id": 123,
+					"type": "usage_limit",
+					"email_to": "alerts@example.com",
+					"percentage": 90,
+					"created_at": 1680000000,
+					"updated_at": 1680000000
+				},
+				{
+					"id": 456,
+					"type": "stats_notification",
+					"email_to": "stats@example.com",
+					"frequency": "daily",
+					"created_at": 1680000000,
+					"updated_at": 1680001000
+				}
+			]`,
+			expectError: false,
+			expectCount: 2,
+		},
+		{
+			name:           "empty list",
+			responseStatus: http.StatusOK,
+			responseBody:   `[]`,
+			expectError:    false,
+			expectCount:    0,
+		},
+		{
+			name:           "error - unauthorized",
+			responseStatus: http.StatusUnauthorized,
+			responseBody: `{
+				"errors": [{"message": "authorization required"}]
+			}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/v3/alerts", r.URL.Path)
+				assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+
+				w.WriteHeader(tt.responseStatus)
+				_, _ = w.Write([]byte(tt.responseBody))
+			})
+
+			client := NewSendGridClient("test-api-key", server.URL)
+
+			var result []alertAPIResponse
+			err := client.Get(context.Background(), "/v3/alerts", &result)
+
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, result, tt.expectCount)
+			}
+		})
+	}
+}
+
+func TestAlertAPIResponse_ToSummary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("usage_limit alert", func(t *testing.T) {
+		t.Parallel()
+
+		resp := alertAPIResponse{
+			ID:         123,
+			Type:       "usage_limit",
+			EmailTo:    "alerts@example.com",
+			Percentage: 90,
+			CreatedAt:  1680000000,
+			UpdatedAt:  1680001000,
+		}
+
+		summary := resp.toSummary()
+
+		assert.Equal(t, 123, summary.AlertID)
+		assert.Equal(t, "usage_limit", summary.Type)
+		assert.Equal(t, "alerts@example.com", summary.EmailTo)
+		require.NotNil(t, summary.Percentage)
+		assert.Equal(t, 90, *summary.Percentage)
+		assert.Nil(t, summary.Frequency)
+		assert.Equal(t, int64(1680000000), summary.CreatedAt)
+		assert.Equal(t, int64(1680001000), summary.UpdatedAt)
+	})
+
+	t.Run("stats_notification alert", func(t *testing.T) {
+		t.Parallel()
+
+		resp := alertAPIResponse{
+			ID:        456,
+			Type:      "stats_notification",
+			EmailTo:   "stats@example.com",
+			Frequency: "weekly",
+		}
+
+		summary := resp.toSummary()
+
+		assert.Equal(t, 456, summary.AlertID)
+		assert.Nil(t, summary.Percentage)
+		require.NotNil(t, summary.Frequency)
+		assert.Equal(t, "weekly", *summary.Frequency)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetBlocks is the controller for the getBlocks function.
+//
+// This function lists the email addresses on the SendGrid blocks list.
+type GetBlocks struct{}
+
+// GetBlocksArgs are the inputs to the getBlocks function.
+type GetBlocksArgs struct {
+	// StartTime limits results to blocks created at or after this Unix timestamp (optional)
+	StartTime *int `pulumi:"startTime,optional"`
+
+	// EndTime limits results to blocks created at or before this Unix timestamp (optional)
+	EndTime *int `pulumi:"endTime,optional"`
+}
+
+// BlockEntry describes a single address on the blocks list.
+type BlockEntry struct {
+	// Email is the address that was blocked
+	Email string `pulumi:"email"`
+
+	// Created is the Unix timestamp when the block occurred
+	Created int64 `pulumi:"created"`
+
+	// Reason is the reason given by the receiving server
+	Reason string `pulumi:"reason"`
+
+	// Status is the SMTP status code of the block
+	Status string `pulumi:"status"`
+}
+
+// GetBlocksResult is the output of the getBlocks function.
+type GetBlocksResult struct {
+	// Blocks is the list of blocked addresses
+	Blocks []BlockEntry `pulumi:"blocks"`
+}
+
+// Annotate provides descriptions for the getBlocks function.
+func (f *GetBlocks) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the email addresses on the SendGrid blocks list.\n\n"+
+		"Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. "+
+		"Optionally filter by the Unix time range in which the block occurred.")
+}
+
+// blockAPIResponse represents a single entry returned by the blocks endpoint
+type blockAPIResponse struct {
+	Created int64  `json:"created"`
+	Email   string `json:"email"`
+	Reason  string `json:"reason"`
+	Status  string `json:"status"`
+}
+
+// Invoke lists the addresses on the blocks list.
+func (f *GetBlocks) Invoke(ctx context.Context, req infer.FunctionRequest[GetBlocksArgs]) (infer.FunctionResponse[GetBlocksResult], error) {
+	input := req.Input
+
+	query, err := suppressionTimeQuery(input.StartTime, input.EndTime)
+	if err != nil {
+		return infer.FunctionResponse[GetBlocksResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetBlocksResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/suppression/blocks
+	result, err := GetAllPages[blockAPIResponse](ctx, client, "/v3/suppression/blocks", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize, Query: query})
+	if err != nil {
+		return infer.FunctionResponse[GetBlocksResult]{}, fmt.Errorf("failed to list blocks: %w", err)
+	}
+
+	blocks := make([]BlockEntry, len(result))
+	for i, r := range result {
+		blocks[i] = BlockEntry{
+			Email:   r.Email,
+			Created: r.Created,
+			Reason:  r.Reason,
+			Status:  r.Status,
+		}
+	}
+
+	return infer.FunctionResponse[GetBlocksResult]{
+		Output: GetBlocksResult{Blocks: blocks},
+	}, nil
+}
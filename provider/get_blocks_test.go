@@ -0,0 +1,60 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListBlocks(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/suppression/blocks", r.URL.Path)
+		assert.Equal(t, "1700000000", r.URL.Query().Get("start_time"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		// Three blocked addresses in total
+		var page []string
+		for i := offset; i < offset+limit && i < 3; i++ {
+			page = append(page, fmt.Sprintf(`{"created": %d, "email": "blocked%d@example.com", "reason": "554 rejected", "status": "4.0.0"}`, 1700000000+i, i))
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "[%s]", strings.Join(page, ","))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := suppressionTimeQuery(intPtr(1700000000), nil)
+	require.NoError(t, err)
+
+	result, err := GetAllPages[blockAPIResponse](context.Background(), client, "/v3/suppression/blocks", PageOptions{Style: PaginateOffset, PageSize: 2, Query: query})
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.Equal(t, "blocked2@example.com", result[2].Email)
+	assert.Equal(t, int64(1700000002), result[2].Created)
+	assert.Equal(t, "554 rejected", result[0].Reason)
+	assert.Equal(t, "4.0.0", result[0].Status)
+}
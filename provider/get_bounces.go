@@ -0,0 +1,119 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetBounces is the controller for the getBounces function.
+//
+// This function lists the email addresses on the SendGrid bounce list.
+type GetBounces struct{}
+
+// GetBouncesArgs are the inputs to the getBounces function.
+type GetBouncesArgs struct {
+	// StartTime limits results to bounces created at or after this Unix timestamp (optional)
+	StartTime *int `pulumi:"startTime,optional"`
+
+	// EndTime limits results to bounces created at or before this Unix timestamp (optional)
+	EndTime *int `pulumi:"endTime,optional"`
+}
+
+// BounceEntry describes a single address on the bounce list.
+type BounceEntry struct {
+	// Email is the address that bounced
+	Email string `pulumi:"email"`
+
+	// Created is the Unix timestamp when the bounce occurred
+	Created int64 `pulumi:"created"`
+
+	// Reason is the reason given by the receiving server
+	Reason string `pulumi:"reason"`
+
+	// Status is the enhanced SMTP status code of the bounce
+	Status string `pulumi:"status"`
+}
+
+// GetBouncesResult is the output of the getBounces function.
+type GetBouncesResult struct {
+	// Bounces is the list of bounced addresses
+	Bounces []BounceEntry `pulumi:"bounces"`
+}
+
+// Annotate provides descriptions for the getBounces function.
+func (f *GetBounces) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the email addresses on the SendGrid bounce list.\n\n"+
+		"Optionally filter by the Unix time range in which the bounce occurred. "+
+		"Useful for cleanup jobs and audits without a second client library.")
+}
+
+// bounceAPIResponse represents a single entry returned by the bounces endpoint
+type bounceAPIResponse struct {
+	Created int64  `json:"created"`
+	Email   string `json:"email"`
+	Reason  string `json:"reason"`
+	Status  string `json:"status"`
+}
+
+// suppressionTimeQuery builds the start_time/end_time query parameters shared by
+// the /v3/suppression list endpoints
+func suppressionTimeQuery(startTime, endTime *int) (url.Values, error) {
+	if startTime != nil && endTime != nil && *startTime > *endTime {
+		return nil, fmt.Errorf("startTime (%d) must not be after endTime (%d)", *startTime, *endTime)
+	}
+
+	return NewQuery().OptionalInt("start_time", startTime).OptionalInt("end_time", endTime).Values(), nil
+}
+
+// Invoke lists the addresses on the bounce list.
+func (f *GetBounces) Invoke(ctx context.Context, req infer.FunctionRequest[GetBouncesArgs]) (infer.FunctionResponse[GetBouncesResult], error) {
+	input := req.Input
+
+	query, err := suppressionTimeQuery(input.StartTime, input.EndTime)
+	if err != nil {
+		return infer.FunctionResponse[GetBouncesResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetBouncesResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/suppression/bounces
+	result, err := GetAllPages[bounceAPIResponse](ctx, client, "/v3/suppression/bounces", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize, Query: query})
+	if err != nil {
+		return infer.FunctionResponse[GetBouncesResult]{}, fmt.Errorf("failed to list bounces: %w", err)
+	}
+
+	bounces := make([]BounceEntry, len(result))
+	for i, r := range result {
+		bounces[i] = BounceEntry{
+			Email:   r.Email,
+			Created: r.Created,
+			Reason:  r.Reason,
+			Status:  r.Status,
+		}
+	}
+
+	return infer.FunctionResponse[GetBouncesResult]{
+		Output: GetBouncesResult{Bounces: bounces},
+	}, nil
+}
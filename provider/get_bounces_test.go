@@ -0,0 +1,86 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListBounces(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/suppression/bounces", r.URL.Path)
+		assert.Equal(t, "1700000000", r.URL.Query().Get("start_time"))
+		assert.Equal(t, "1700086400", r.URL.Query().Get("end_time"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{"created": 1700000100, "email": "gone@example.com", "reason": "550 5.1.1 User unknown", "status": "5.1.1"}
+		]`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := suppressionTimeQuery(intPtr(1700000000), intPtr(1700086400))
+	require.NoError(t, err)
+
+	result, err := GetAllPages[bounceAPIResponse](context.Background(), client, "/v3/suppression/bounces", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize, Query: query})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+
+	assert.Equal(t, "gone@example.com", result[0].Email)
+	assert.Equal(t, int64(1700000100), result[0].Created)
+	assert.Equal(t, "550 5.1.1 User unknown", result[0].Reason)
+	assert.Equal(t, "5.1.1", result[0].Status)
+}
+
+func TestSuppressionTimeQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		startTime *int
+		endTime   *int
+		want      string
+		wantErr   bool
+	}{
+		{name: "no range", want: ""},
+		{name: "start only", startTime: intPtr(100), want: "start_time=100"},
+		{name: "end only", endTime: intPtr(200), want: "end_time=200"},
+		{name: "full range", startTime: intPtr(100), endTime: intPtr(200), want: "end_time=200&start_time=100"},
+		{name: "inverted range", startTime: intPtr(200), endTime: intPtr(100), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := suppressionTimeQuery(tt.startTime, tt.endTime)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query.Encode())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// categoryPageSize is the page size used when listing categories
+const categoryPageSize = 100
+
+// GetCategories is the controller for the getCategories function.
+//
+// This function lists the categories used on the SendGrid account so that
+// category names referenced by templates and campaigns can be validated.
+type GetCategories struct{}
+
+// GetCategoriesArgs are the inputs to the getCategories function.
+type GetCategoriesArgs struct {
+	// Search limits results to categories whose name starts with this prefix (optional)
+	Search *string `pulumi:"search,optional"`
+}
+
+// GetCategoriesResult is the output of the getCategories function.
+type GetCategoriesResult struct {
+	// Categories is the list of category names
+	Categories []string `pulumi:"categories"`
+}
+
+// Annotate provides descriptions for the getCategories function.
+func (f *GetCategories) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the categories used on the SendGrid account.\n\n"+
+		"Optionally filter by name prefix. Useful for validating category names "+
+		"used in templates and campaigns at plan time.")
+}
+
+// categoryAPIResponse represents a single entry returned by the categories endpoint
+type categoryAPIResponse struct {
+	Category string `json:"category"`
+}
+
+// Invoke lists the categories on the SendGrid account.
+func (f *GetCategories) Invoke(ctx context.Context, req infer.FunctionRequest[GetCategoriesArgs]) (infer.FunctionResponse[GetCategoriesResult], error) {
+	input := req.Input
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetCategoriesResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	query := url.Values{}
+	if input.Search != nil && *input.Search != "" {
+		query.Set("category", *input.Search)
+	}
+
+	// GET /v3/categories
+	result, err := GetAllPages[categoryAPIResponse](ctx, client, "/v3/categories", PageOptions{Style: PaginateOffset, PageSize: categoryPageSize, Query: query})
+	if err != nil {
+		return infer.FunctionResponse[GetCategoriesResult]{}, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	categories := make([]string, len(result))
+	for i, r := range result {
+		categories[i] = r.Category
+	}
+
+	return infer.FunctionResponse[GetCategoriesResult]{
+		Output: GetCategoriesResult{Categories: categories},
+	}, nil
+}
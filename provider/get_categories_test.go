@@ -0,0 +1,50 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListCategories(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/categories", r.URL.Path)
+		assert.Equal(t, "news", r.URL.Query().Get("category"))
+		assert.Equal(t, "100", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"category": "newsletter"}, {"category": "news-digest"}]`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query := url.Values{}
+	query.Set("category", "news")
+
+	result, err := GetAllPages[categoryAPIResponse](context.Background(), client, "/v3/categories", PageOptions{Style: PaginateOffset, PageSize: categoryPageSize, Query: query})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "newsletter", result[0].Category)
+	assert.Equal(t, "news-digest", result[1].Category)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// maxStatsCategories is the maximum number of categories SendGrid accepts per stats request
+const maxStatsCategories = 10
+
+// GetCategoryStats is the controller for the getCategoryStats function.
+//
+// This function retrieves email statistics for one or more categories
+// over a date range.
+type GetCategoryStats struct{}
+
+// GetCategoryStatsArgs are the inputs to the getCategoryStats function.
+type GetCategoryStatsArgs struct {
+	// Categories is the list of category names to retrieve statistics for (required, max 10)
+	Categories []string `pulumi:"categories"`
+
+	// StartDate is the first day to retrieve statistics for, in YYYY-MM-DD format (required)
+	StartDate string `pulumi:"startDate"`
+
+	// EndDate is the last day to retrieve statistics for, in YYYY-MM-DD format (optional)
+	EndDate *string `pulumi:"endDate,optional"`
+
+	// AggregatedBy groups the statistics: "day", "week" or "month" (optional, default: day)
+	AggregatedBy *string `pulumi:"aggregatedBy,optional"`
+}
+
+// GetCategoryStatsResult is the output of the getCategoryStats function.
+type GetCategoryStatsResult struct {
+	// Stats is the list of statistics, one entry per aggregation period.
+	// Each entry contains one set of metrics per requested category.
+	Stats []StatsEntry `pulumi:"stats"`
+}
+
+// Annotate provides descriptions for the getCategoryStats function.
+func (f *GetCategoryStats) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Retrieves email statistics for specific SendGrid categories.\n\n"+
+		"Returns metrics for each requested category (up to 10) and each day, week or month "+
+		"in the date range, for per-product email reporting.")
+}
+
+// categoryStatsQuery builds the query parameters for the category stats endpoint
+func categoryStatsQuery(args GetCategoryStatsArgs) (url.Values, error) {
+	if len(args.Categories) == 0 {
+		return nil, fmt.Errorf("at least one category is required")
+	}
+	if len(args.Categories) > maxStatsCategories {
+		return nil, fmt.Errorf("at most %d categories can be requested at once, got %d", maxStatsCategories, len(args.Categories))
+	}
+
+	query, err := statsQuery(args.StartDate, args.EndDate, args.AggregatedBy)
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range args.Categories {
+		query.Add("categories", category)
+	}
+	return query, nil
+}
+
+// Invoke retrieves email statistics for the requested categories.
+func (f *GetCategoryStats) Invoke(ctx context.Context, req infer.FunctionRequest[GetCategoryStatsArgs]) (infer.FunctionResponse[GetCategoryStatsResult], error) {
+	query, err := categoryStatsQuery(req.Input)
+	if err != nil {
+		return infer.FunctionResponse[GetCategoryStatsResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetCategoryStatsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/categories/stats
+	var result []statsAPIResponse
+	if err := client.Get(ctx, withQuery("/v3/categories/stats", query), &result); err != nil {
+		return infer.FunctionResponse[GetCategoryStatsResult]{}, fmt.Errorf("failed to get category stats: %w", err)
+	}
+
+	return infer.FunctionResponse[GetCategoryStatsResult]{
+		Output: GetCategoryStatsResult{Stats: toStatsEntries(result)},
+	}, nil
+}
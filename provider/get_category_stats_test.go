@@ -0,0 +1,107 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_GetCategoryStats(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/categories/stats", r.URL.Path)
+		assert.Equal(t, []string{"receipts", "welcome"}, r.URL.Query()["categories"])
+		assert.Equal(t, "2024-01-01", r.URL.Query().Get("start_date"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{
+				"date": "2024-01-01",
+				"stats": [
+					{"type": "category", "name": "receipts", "metrics": {"delivered": 50}},
+					{"type": "category", "name": "welcome", "metrics": {"delivered": 20, "opens": 5}}
+				]
+			}
+		]`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := categoryStatsQuery(GetCategoryStatsArgs{
+		Categories: []string{"receipts", "welcome"},
+		StartDate:  "2024-01-01",
+	})
+	require.NoError(t, err)
+
+	var result []statsAPIResponse
+	err = client.Get(context.Background(), "/v3/categories/stats?"+query.Encode(), &result)
+	require.NoError(t, err)
+
+	entries := toStatsEntries(result)
+	require.Len(t, entries, 1)
+	require.Len(t, entries[0].Stats, 2)
+
+	receipts := entries[0].Stats[0]
+	require.NotNil(t, receipts.Name)
+	assert.Equal(t, "receipts", *receipts.Name)
+	require.NotNil(t, receipts.Type)
+	assert.Equal(t, "category", *receipts.Type)
+	assert.Equal(t, 50, receipts.Metrics.Delivered)
+
+	welcome := entries[0].Stats[1]
+	assert.Equal(t, "welcome", *welcome.Name)
+	assert.Equal(t, 5, welcome.Metrics.Opens)
+}
+
+func TestCategoryStatsQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no categories", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := categoryStatsQuery(GetCategoryStatsArgs{StartDate: "2024-01-01"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one category")
+	})
+
+	t.Run("too many categories", func(t *testing.T) {
+		t.Parallel()
+
+		categories := make([]string, maxStatsCategories+1)
+		for i := range categories {
+			categories[i] = "category"
+		}
+		_, err := categoryStatsQuery(GetCategoryStatsArgs{
+			Categories: categories,
+			StartDate:  "2024-01-01",
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at most 10 categories")
+	})
+
+	t.Run("missing start date", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := categoryStatsQuery(GetCategoryStatsArgs{Categories: []string{"receipts"}})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetCurrentKeyInfo is the controller for the getCurrentKeyInfo function.
+//
+// This function reports the scopes of the API key the provider is configured
+// with, and its ID and name when the key may read them, so programs can fail
+// fast when the key cannot manage the resources they declare.
+type GetCurrentKeyInfo struct{}
+
+// GetCurrentKeyInfoArgs are the inputs to the getCurrentKeyInfo function.
+type GetCurrentKeyInfoArgs struct {
+	// RequiredScopes are scopes the key must grant, or the function fails (optional)
+	RequiredScopes []string `pulumi:"requiredScopes,optional"`
+}
+
+// GetCurrentKeyInfoResult is the output of the getCurrentKeyInfo function.
+type GetCurrentKeyInfoResult struct {
+	// Scopes are the scopes granted to the key
+	Scopes []string `pulumi:"scopes"`
+
+	// APIKeyID is the ID of the key, unset when the key does not have the usual SG.<id>.<secret> form
+	APIKeyID *string `pulumi:"apiKeyId,optional"`
+
+	// Name is the name of the key, unset when the key may not read it
+	Name *string `pulumi:"name,optional"`
+}
+
+// Annotate provides descriptions for the getCurrentKeyInfo function.
+func (f *GetCurrentKeyInfo) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Reports the scopes of the API key the provider is configured with.\n\n"+
+		"The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` "+
+		"to fail with a clear error when the key cannot manage the resources a program declares, "+
+		"e.g. [\"templates.create\", \"templates.update\"].")
+}
+
+// Invoke reports the scopes and metadata of the configured API key.
+func (f *GetCurrentKeyInfo) Invoke(ctx context.Context, req infer.FunctionRequest[GetCurrentKeyInfoArgs]) (infer.FunctionResponse[GetCurrentKeyInfoResult], error) {
+	input := req.Input
+
+	// Get the SendGrid client from context
+	config := infer.GetConfig[Config](ctx)
+	client := config.client
+	if client == nil {
+		return infer.FunctionResponse[GetCurrentKeyInfoResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/scopes lists the scopes granted to the calling key
+	var scopes struct {
+		Scopes []string `json:"scopes"`
+	}
+	if err := client.Get(ctx, "/v3/scopes", &scopes); err != nil {
+		return infer.FunctionResponse[GetCurrentKeyInfoResult]{}, fmt.Errorf("failed to get API key scopes: %w", err)
+	}
+	if missing := missingScopes(scopes.Scopes, input.RequiredScopes); len(missing) > 0 {
+		return infer.FunctionResponse[GetCurrentKeyInfoResult]{}, fmt.Errorf("SendGrid API key is missing required scopes: %s", strings.Join(missing, ", "))
+	}
+
+	result := GetCurrentKeyInfoResult{Scopes: scopes.Scopes}
+	if config.apiKeyID == "" {
+		return infer.FunctionResponse[GetCurrentKeyInfoResult]{Output: result}, nil
+	}
+	id := config.apiKeyID
+	result.APIKeyID = &id
+
+	// GET /v3/api_keys/{id} needs the api_keys.read scope, which many keys do not have
+	var key struct {
+		Name string `json:"name"`
+	}
+	if err := client.Get(ctx, fmt.Sprintf("/v3/api_keys/%s", url.PathEscape(id)), &key); err != nil {
+		var sgErr *SendGridError
+		if errors.As(err, &sgErr) && (sgErr.StatusCode == http.StatusUnauthorized || sgErr.StatusCode == http.StatusForbidden || sgErr.IsNotFound()) {
+			return infer.FunctionResponse[GetCurrentKeyInfoResult]{Output: result}, nil
+		}
+		return infer.FunctionResponse[GetCurrentKeyInfoResult]{}, fmt.Errorf("failed to read API key: %w", err)
+	}
+	result.Name = &key.Name
+
+	return infer.FunctionResponse[GetCurrentKeyInfoResult]{Output: result}, nil
+}
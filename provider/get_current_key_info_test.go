@@ -0,0 +1,96 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetCurrentKeyInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		apiKey         string
+		keyStatus      int
+		requiredScopes []string
+		wantID         string
+		wantName       string
+		wantErr        string
+	}{
+		{name: "with metadata", apiKey: "SG.key-1.secret", keyStatus: http.StatusOK, wantID: "key-1", wantName: "ci"},
+		{name: "metadata forbidden", apiKey: "SG.key-1.secret", keyStatus: http.StatusForbidden, wantID: "key-1"},
+		{name: "unusual key form", apiKey: "SG.fake"},
+		{name: "required scopes granted", apiKey: "SG.fake", requiredScopes: []string{"mail.send"}},
+		{name: "required scopes missing", apiKey: "SG.fake", requiredScopes: []string{"mail.send", "templates.create"}, wantErr: "missing required scopes: templates.create"},
+		{name: "metadata unavailable", apiKey: "SG.key-1.secret", keyStatus: http.StatusBadRequest, wantErr: "failed to read API key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				switch req.URL.Path {
+				case "/v3/scopes":
+					return fakeResponse(req, http.StatusOK, `{"scopes": ["mail.send", "api_keys.read"]}`), nil
+				case "/v3/api_keys/key-1":
+					return fakeResponse(req, tt.keyStatus, `{"api_key_id": "key-1", "name": "ci"}`), nil
+				}
+				t.Errorf("unexpected request to %s", req.URL.Path)
+				return fakeResponse(req, http.StatusNotFound, `{}`), nil
+			})
+			server := newTestServer(t, transport, map[string]property.Value{"apiKey": property.New(tt.apiKey)})
+
+			args := map[string]property.Value{}
+			if tt.requiredScopes != nil {
+				scopes := make([]property.Value, len(tt.requiredScopes))
+				for i, scope := range tt.requiredScopes {
+					scopes[i] = property.New(scope)
+				}
+				args["requiredScopes"] = property.New(scopes)
+			}
+			resp, err := server.Invoke(p.InvokeRequest{
+				Token: "sendgrid:index:getCurrentKeyInfo",
+				Args:  property.NewMap(args),
+			})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 2, resp.Return.Get("scopes").AsArray().Len())
+			if tt.wantID == "" {
+				assert.True(t, resp.Return.Get("apiKeyId").IsNull())
+			} else {
+				assert.Equal(t, tt.wantID, resp.Return.Get("apiKeyId").AsString())
+			}
+			if tt.wantName == "" {
+				assert.True(t, resp.Return.Get("name").IsNull())
+			} else {
+				assert.Equal(t, tt.wantName, resp.Return.Get("name").AsString())
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// designPageSize is the page size used when listing designs
+const designPageSize = 100
+
+// GetDesigns is the controller for the getDesigns function.
+//
+// This function lists the designs in the Design Library so that SingleSend
+// resources can reference existing design content by ID.
+type GetDesigns struct{}
+
+// GetDesignsArgs are the inputs to the getDesigns function.
+type GetDesignsArgs struct{}
+
+// DesignSummary describes a single design returned by the getDesigns function.
+type DesignSummary struct {
+	// DesignID is the unique identifier assigned by SendGrid
+	DesignID string `pulumi:"designId"`
+
+	// Name is the name of the design
+	Name string `pulumi:"name"`
+
+	// Editor is the editor used to build the design: "code" or "design"
+	Editor string `pulumi:"editor"`
+
+	// ThumbnailURL is the URL of the design's thumbnail image
+	ThumbnailURL string `pulumi:"thumbnailUrl"`
+
+	// Subject is the default subject line of the design
+	Subject string `pulumi:"subject"`
+
+	// Categories is the list of categories applied to the design
+	Categories []string `pulumi:"categories"`
+
+	// CreatedAt is the timestamp when the design was created
+	CreatedAt string `pulumi:"createdAt"`
+
+	// UpdatedAt is the timestamp when the design was last updated
+	UpdatedAt string `pulumi:"updatedAt"`
+}
+
+// GetDesignsResult is the output of the getDesigns function.
+type GetDesignsResult struct {
+	// Designs is the list of designs in the Design Library
+	Designs []DesignSummary `pulumi:"designs"`
+}
+
+// Annotate provides descriptions for the getDesigns function.
+func (f *GetDesigns) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the designs in the SendGrid Design Library.\n\n"+
+		"Returns the ID, name and thumbnail of every design, so that SingleSend resources "+
+		"can reference design-library content by `designId`.")
+}
+
+// designAPIResponse represents a single design returned by the designs endpoint
+type designAPIResponse struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Editor       string   `json:"editor"`
+	ThumbnailURL string   `json:"thumbnail_url"`
+	Subject      string   `json:"subject"`
+	Categories   []string `json:"categories"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+}
+
+// toSummary converts an API response to a DesignSummary
+func (r *designAPIResponse) toSummary() DesignSummary {
+	categories := r.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+	return DesignSummary{
+		DesignID:     r.ID,
+		Name:         r.Name,
+		Editor:       r.Editor,
+		ThumbnailURL: r.ThumbnailURL,
+		Subject:      r.Subject,
+		Categories:   categories,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
+
+// Invoke lists the designs in the Design Library.
+func (f *GetDesigns) Invoke(ctx context.Context, _ infer.FunctionRequest[GetDesignsArgs]) (infer.FunctionResponse[GetDesignsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetDesignsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/designs
+	result, err := GetAllPages[designAPIResponse](ctx, client, "/v3/designs", PageOptions{Style: PaginateToken, PageSize: designPageSize})
+	if err != nil {
+		return infer.FunctionResponse[GetDesignsResult]{}, fmt.Errorf("failed to list designs: %w", err)
+	}
+
+	designs := make([]DesignSummary, len(result))
+	for i := range result {
+		designs[i] = result[i].toSummary()
+	}
+
+	return infer.FunctionResponse[GetDesignsResult]{
+		Output: GetDesignsResult{Designs: designs},
+	}, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListDesigns(t *testing.T) {
+	t.Parallel()
+
+	t.Run("multiple pages", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/v3/designs", r.URL.Path)
+			assert.Equal(t, "100", r.URL.Query().Get("page_size"))
+
+			w.WriteHeader(http.StatusOK)
+			switch r.URL.Query().Get("page_token") {
+			case "":
+				_, _ = fmt.Fprintf(w, `{
+					"result": [{"id": "d-1", "name": "Welcome", "editor": "design", "thumbnail_url": "https://example.com/1.png"}],
+					"_metadata": {"next": "https://api.sendgrid.com/v3/designs?page_size=100&page_token=tok2"}
+				}`)
+			case "tok2":
+				_, _ = fmt.Fprintf(w, `{
+					"result": [{"id": "d-2", "name": "Receipt", "editor": "code", "categories": ["billing"]}],
+					"_metadata": {}
+				}`)
+			default:
+				t.Errorf("unexpected page token %q", r.URL.Query().Get("page_token"))
+			}
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		result, err := GetAllPages[designAPIResponse](context.Background(), client, "/v3/designs", PageOptions{Style: PaginateToken, PageSize: designPageSize})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "d-1", result[0].ID)
+		assert.Equal(t, "https://example.com/1.png", result[0].ThumbnailURL)
+		assert.Equal(t, []string{"billing"}, result[1].Categories)
+	})
+
+	t.Run("repeated token stops paging", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{
+				"result": [{"id": "d-1"}],
+				"_metadata": {"next": "https://api.sendgrid.com/v3/designs?page_token=same"}
+			}`)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		result, err := GetAllPages[designAPIResponse](context.Background(), client, "/v3/designs", PageOptions{Style: PaginateToken, PageSize: designPageSize})
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestDesignAPIResponse_ToSummary(t *testing.T) {
+	t.Parallel()
+
+	resp := designAPIResponse{
+		ID:           "d-1",
+		Name:         "Welcome",
+		Editor:       "design",
+		ThumbnailURL: "https://example.com/1.png",
+	}
+
+	summary := resp.toSummary()
+
+	assert.Equal(t, "d-1", summary.DesignID)
+	assert.Equal(t, "Welcome", summary.Name)
+	assert.Equal(t, "https://example.com/1.png", summary.ThumbnailURL)
+	assert.NotNil(t, summary.Categories)
+	assert.Empty(t, summary.Categories)
+}
@@ -0,0 +1,134 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetEventWebhooks is the controller for the getEventWebhooks function.
+//
+// This function lists every Event Webhook configured on the SendGrid account
+// along with the event types each one receives.
+type GetEventWebhooks struct{}
+
+// GetEventWebhooksArgs are the inputs to the getEventWebhooks function.
+type GetEventWebhooksArgs struct{}
+
+// EventWebhookSummary describes a single webhook returned by the getEventWebhooks function.
+type EventWebhookSummary struct {
+	// WebhookID is the unique identifier assigned by SendGrid
+	WebhookID string `pulumi:"webhookId"`
+
+	// URL is the endpoint where SendGrid POSTs event data
+	URL string `pulumi:"url"`
+
+	// Enabled indicates whether the webhook is active
+	Enabled bool `pulumi:"enabled"`
+
+	// FriendlyName is the human-readable name of the webhook
+	FriendlyName *string `pulumi:"friendlyName,optional"`
+
+	// Event type toggles
+	Bounce           bool `pulumi:"bounce"`
+	Click            bool `pulumi:"click"`
+	Deferred         bool `pulumi:"deferred"`
+	Delivered        bool `pulumi:"delivered"`
+	Dropped          bool `pulumi:"dropped"`
+	Open             bool `pulumi:"open"`
+	Processed        bool `pulumi:"processed"`
+	SpamReport       bool `pulumi:"spamReport"`
+	Unsubscribe      bool `pulumi:"unsubscribe"`
+	GroupResubscribe bool `pulumi:"groupResubscribe"`
+	GroupUnsubscribe bool `pulumi:"groupUnsubscribe"`
+}
+
+// GetEventWebhooksResult is the output of the getEventWebhooks function.
+type GetEventWebhooksResult struct {
+	// MaxAllowed is the maximum number of webhooks the account may configure
+	MaxAllowed int `pulumi:"maxAllowed"`
+
+	// Webhooks is the list of configured event webhooks
+	Webhooks []EventWebhookSummary `pulumi:"webhooks"`
+}
+
+// Annotate provides descriptions for the getEventWebhooks function.
+func (f *GetEventWebhooks) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists all SendGrid Event Webhooks configured on the account.\n\n"+
+		"Returns every webhook URL together with the event types it is subscribed to, "+
+		"which is useful for auditing which endpoints receive account data.")
+}
+
+// eventWebhookListAPIResponse represents the SendGrid API response for listing event webhooks
+type eventWebhookListAPIResponse struct {
+	MaxAllowed int                       `json:"max_allowed"`
+	Webhooks   []eventWebhookAPIResponse `json:"webhooks"`
+}
+
+// toSummary converts an API response to an EventWebhookSummary
+func (r *eventWebhookAPIResponse) toSummary() EventWebhookSummary {
+	var friendlyName *string
+	if r.FriendlyName != "" {
+		friendlyName = &r.FriendlyName
+	}
+
+	return EventWebhookSummary{
+		WebhookID:        r.ID,
+		URL:              r.URL,
+		Enabled:          r.Enabled,
+		FriendlyName:     friendlyName,
+		Bounce:           r.Bounce,
+		Click:            r.Click,
+		Deferred:         r.Deferred,
+		Delivered:        r.Delivered,
+		Dropped:          r.Dropped,
+		Open:             r.Open,
+		Processed:        r.Processed,
+		SpamReport:       r.SpamReport,
+		Unsubscribe:      r.Unsubscribe,
+		GroupResubscribe: r.GroupResubscribe,
+		GroupUnsubscribe: r.GroupUnsubscribe,
+	}
+}
+
+// Invoke lists the event webhooks configured on the SendGrid account.
+func (f *GetEventWebhooks) Invoke(ctx context.Context, _ infer.FunctionRequest[GetEventWebhooksArgs]) (infer.FunctionResponse[GetEventWebhooksResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetEventWebhooksResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/user/webhooks/event/settings/all
+	var result eventWebhookListAPIResponse
+	if err := client.Get(ctx, "/v3/user/webhooks/event/settings/all", &result); err != nil {
+		return infer.FunctionResponse[GetEventWebhooksResult]{}, fmt.Errorf("failed to list event webhooks: %w", err)
+	}
+
+	webhooks := make([]EventWebhookSummary, len(result.Webhooks))
+	for i := range result.Webhooks {
+		webhooks[i] = result.Webhooks[i].toSummary()
+	}
+
+	return infer.FunctionResponse[GetEventWebhooksResult]{
+		Output: GetEventWebhooksResult{
+			MaxAllowed: result.MaxAllowed,
+			Webhooks:   webhooks,
+		},
+	}, nil
+}
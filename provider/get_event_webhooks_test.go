@@ -0,0 +1,150 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListEventWebhooks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		responseStatus int
+		responseBody   string
+		expectError    bool
+		expectCount    int
+		expectMax      int
+	}{
+		{
+			name:           "successful list",
+			responseStatus: http.StatusOK,
+			responseBody: `{
+				"max_allowed": 5,
+				"webhooks": [
+					{
+						"id": "webhook-1",
+						"url": "https://example.com/events",
+						"enabled": true,
+						"friendly_name": "Primary",
+						"delivered": true,
+						"bounce": true
+					},
+					{
+						"id": "webhook-2",
+						"url": "https://example.com/opens",
+						"enabled": false,
+						"open": true
+					}
+				]
+			}`,
+			expectError: false,
+			expectCount: 2,
+			expectMax:   5,
+		},
+		{
+			name:           "no webhooks configured",
+			responseStatus: http.StatusOK,
+			responseBody:   `{"max_allowed": 5, "webhooks": []}`,
+			expectError:    false,
+			expectCount:    0,
+			expectMax:      5,
+		},
+		{
+			name:           "error - forbidden",
+			responseStatus: http.StatusForbidden,
+			responseBody: `{
+				"errors": [{"message": "access forbidden"}]
+			}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/v3/user/webhooks/event/settings/all", r.URL.Path)
+				assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+
+				w.WriteHeader(tt.responseStatus)
+				_, _ = w.Write([]byte(tt.responseBody))
+			})
+
+			client := NewSendGridClient("test-api-key", server.URL)
+
+			var result eventWebhookListAPIResponse
+			err := client.Get(context.Background(), "/v3/user/webhooks/event/settings/all", &result)
+
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, result.Webhooks, tt.expectCount)
+				assert.Equal(t, tt.expectMax, result.MaxAllowed)
+			}
+		})
+	}
+}
+
+func TestEventWebhookAPIResponse_ToSummary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("webhook with friendly name", func(t *testing.T) {
+		t.Parallel()
+
+		resp := eventWebhookAPIResponse{
+			ID:           "webhook-1",
+			URL:          "https://example.com/events",
+			Enabled:      true,
+			FriendlyName: "Primary",
+			Delivered:    true,
+			SpamReport:   true,
+		}
+
+		summary := resp.toSummary()
+
+		assert.Equal(t, "webhook-1", summary.WebhookID)
+		assert.Equal(t, "https://example.com/events", summary.URL)
+		assert.True(t, summary.Enabled)
+		require.NotNil(t, summary.FriendlyName)
+		assert.Equal(t, "Primary", *summary.FriendlyName)
+		assert.True(t, summary.Delivered)
+		assert.True(t, summary.SpamReport)
+		assert.False(t, summary.Bounce)
+	})
+
+	t.Run("webhook without friendly name", func(t *testing.T) {
+		t.Parallel()
+
+		resp := eventWebhookAPIResponse{
+			ID:  "webhook-2",
+			URL: "https://example.com/opens",
+		}
+
+		summary := resp.toSummary()
+
+		assert.Nil(t, summary.FriendlyName)
+		assert.False(t, summary.Enabled)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetGlobalSuppressions is the controller for the getGlobalSuppressions function.
+//
+// This function lists the email addresses on the global unsubscribe list.
+type GetGlobalSuppressions struct{}
+
+// GetGlobalSuppressionsArgs are the inputs to the getGlobalSuppressions function.
+type GetGlobalSuppressionsArgs struct {
+	// StartTime limits results to unsubscribes created at or after this Unix timestamp (optional)
+	StartTime *int `pulumi:"startTime,optional"`
+
+	// EndTime limits results to unsubscribes created at or before this Unix timestamp (optional)
+	EndTime *int `pulumi:"endTime,optional"`
+}
+
+// GlobalSuppressionEntry describes a single address on the global unsubscribe list.
+type GlobalSuppressionEntry struct {
+	// Email is the globally unsubscribed address
+	Email string `pulumi:"email"`
+
+	// Created is the Unix timestamp when the address was unsubscribed
+	Created int64 `pulumi:"created"`
+}
+
+// GetGlobalSuppressionsResult is the output of the getGlobalSuppressions function.
+type GetGlobalSuppressionsResult struct {
+	// Suppressions is the list of globally unsubscribed addresses
+	Suppressions []GlobalSuppressionEntry `pulumi:"suppressions"`
+}
+
+// Annotate provides descriptions for the getGlobalSuppressions function.
+func (f *GetGlobalSuppressions) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the email addresses on the SendGrid global unsubscribe list.\n\n"+
+		"Includes addresses added through the GlobalSuppression resource as well as recipients who "+
+		"unsubscribed from all email. Optionally filter by Unix time range.")
+}
+
+// globalSuppressionEntryAPIResponse represents a single entry returned by the unsubscribes endpoint
+type globalSuppressionEntryAPIResponse struct {
+	Created int64  `json:"created"`
+	Email   string `json:"email"`
+}
+
+// Invoke lists the addresses on the global unsubscribe list.
+func (f *GetGlobalSuppressions) Invoke(ctx context.Context, req infer.FunctionRequest[GetGlobalSuppressionsArgs]) (infer.FunctionResponse[GetGlobalSuppressionsResult], error) {
+	input := req.Input
+
+	query, err := suppressionTimeQuery(input.StartTime, input.EndTime)
+	if err != nil {
+		return infer.FunctionResponse[GetGlobalSuppressionsResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetGlobalSuppressionsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/suppression/unsubscribes
+	result, err := GetAllPages[globalSuppressionEntryAPIResponse](ctx, client, "/v3/suppression/unsubscribes", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize, Query: query})
+	if err != nil {
+		return infer.FunctionResponse[GetGlobalSuppressionsResult]{}, fmt.Errorf("failed to list global suppressions: %w", err)
+	}
+
+	suppressions := make([]GlobalSuppressionEntry, len(result))
+	for i, r := range result {
+		suppressions[i] = GlobalSuppressionEntry{
+			Email:   r.Email,
+			Created: r.Created,
+		}
+	}
+
+	return infer.FunctionResponse[GetGlobalSuppressionsResult]{
+		Output: GetGlobalSuppressionsResult{Suppressions: suppressions},
+	}, nil
+}
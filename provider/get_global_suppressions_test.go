@@ -0,0 +1,63 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListGlobalSuppressions(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/suppression/unsubscribes", r.URL.Path)
+		assert.Equal(t, "1700000000", r.URL.Query().Get("start_time"))
+		assert.Equal(t, "1700086400", r.URL.Query().Get("end_time"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		// Four unsubscribed addresses in total
+		var page []string
+		for i := offset; i < offset+limit && i < 4; i++ {
+			page = append(page, fmt.Sprintf(`{"created": %d, "email": "optout%d@example.com"}`, 1700000000+i, i))
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "[%s]", strings.Join(page, ","))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := suppressionTimeQuery(intPtr(1700000000), intPtr(1700086400))
+	require.NoError(t, err)
+
+	result, err := GetAllPages[globalSuppressionEntryAPIResponse](context.Background(), client, "/v3/suppression/unsubscribes", PageOptions{Style: PaginateOffset, PageSize: 3, Query: query})
+	require.NoError(t, err)
+	require.Len(t, result, 4)
+	assert.Equal(t, "optout3@example.com", result[3].Email)
+	assert.Equal(t, int64(1700000003), result[3].Created)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,79 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// suppressionPageSize is the page size used when listing suppressions
+const suppressionPageSize = 500
+
+// GetGroupSuppressions is the controller for the getGroupSuppressions function.
+//
+// This function lists the email addresses that have unsubscribed from a
+// specific unsubscribe group.
+type GetGroupSuppressions struct{}
+
+// GetGroupSuppressionsArgs are the inputs to the getGroupSuppressions function.
+type GetGroupSuppressionsArgs struct {
+	// GroupID is the ID of the unsubscribe group (required)
+	GroupID int `pulumi:"groupId"`
+}
+
+// GetGroupSuppressionsResult is the output of the getGroupSuppressions function.
+type GetGroupSuppressionsResult struct {
+	// Emails is the list of email addresses suppressed for the group
+	Emails []string `pulumi:"emails"`
+}
+
+// Annotate provides descriptions for the getGroupSuppressions function.
+func (f *GetGroupSuppressions) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the recipients suppressed for a SendGrid Unsubscribe Group.\n\n"+
+		"Returns every email address that has unsubscribed from the group. "+
+		"Large groups are retrieved page by page automatically.")
+}
+
+// Invoke lists the email addresses suppressed for an unsubscribe group.
+func (f *GetGroupSuppressions) Invoke(ctx context.Context, req infer.FunctionRequest[GetGroupSuppressionsArgs]) (infer.FunctionResponse[GetGroupSuppressionsResult], error) {
+	input := req.Input
+
+	if input.GroupID <= 0 {
+		return infer.FunctionResponse[GetGroupSuppressionsResult]{}, fmt.Errorf("groupId must be a positive integer")
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetGroupSuppressionsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/asm/groups/{group_id}/suppressions returns a bare array of email addresses
+	path := fmt.Sprintf("/v3/asm/groups/%d/suppressions", input.GroupID)
+	emails, err := GetAllPages[string](ctx, client, path, PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize})
+	if err != nil {
+		return infer.FunctionResponse[GetGroupSuppressionsResult]{}, fmt.Errorf("failed to list group suppressions: %w", err)
+	}
+	if emails == nil {
+		emails = []string{}
+	}
+
+	return infer.FunctionResponse[GetGroupSuppressionsResult]{
+		Output: GetGroupSuppressionsResult{Emails: emails},
+	}, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListGroupSuppressions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single page", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/v3/asm/groups/123/suppressions", r.URL.Path)
+			assert.Equal(t, "0", r.URL.Query().Get("offset"))
+
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`["a@example.com", "b@example.com"]`))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		emails, err := GetAllPages[string](context.Background(), client, "/v3/asm/groups/123/suppressions", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a@example.com", "b@example.com"}, emails)
+	})
+
+	t.Run("multiple pages", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			assert.Equal(t, 2, limit)
+
+			// Five suppressed addresses in total
+			var page []string
+			for i := offset; i < offset+limit && i < 5; i++ {
+				page = append(page, fmt.Sprintf(`"user%d@example.com"`, i))
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, "[%s]", strings.Join(page, ","))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		emails, err := GetAllPages[string](context.Background(), client, "/v3/asm/groups/123/suppressions", PageOptions{Style: PaginateOffset, PageSize: 2})
+		require.NoError(t, err)
+		assert.Len(t, emails, 5)
+		assert.Equal(t, "user4@example.com", emails[4])
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("group not found", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"message": "resource not found"}]}`))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		_, err := GetAllPages[string](context.Background(), client, "/v3/asm/groups/999/suppressions", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize})
+		require.Error(t, err)
+		sgErr, ok := err.(*SendGridError)
+		require.True(t, ok)
+		assert.True(t, sgErr.IsNotFound())
+	})
+}
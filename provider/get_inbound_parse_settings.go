@@ -0,0 +1,91 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetInboundParseSettings is the controller for the getInboundParseSettings function.
+//
+// This function lists the Inbound Parse settings of the SendGrid account, i.e.
+// the hostnames whose incoming email is posted to a URL.
+type GetInboundParseSettings struct{}
+
+// GetInboundParseSettingsArgs are the inputs to the getInboundParseSettings function.
+type GetInboundParseSettingsArgs struct{}
+
+// GetInboundParseSettingsResult is the output of the getInboundParseSettings function.
+type GetInboundParseSettingsResult struct {
+	// Settings is the list of Inbound Parse settings
+	Settings []InboundParseSetting `pulumi:"settings"`
+}
+
+// InboundParseSetting is an Inbound Parse setting returned by getInboundParseSettings.
+type InboundParseSetting struct {
+	// Hostname is the hostname whose incoming email is parsed, e.g. "parse.example.com"
+	Hostname string `pulumi:"hostname"`
+
+	// URL is the URL parsed email is posted to
+	URL string `pulumi:"url"`
+
+	// SpamCheck indicates if incoming email is checked for spam
+	SpamCheck bool `pulumi:"spamCheck"`
+
+	// SendRaw indicates if the full MIME message is posted instead of its parsed parts
+	SendRaw bool `pulumi:"sendRaw"`
+}
+
+// Annotate provides descriptions for the getInboundParseSettings function.
+func (f *GetInboundParseSettings) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the Inbound Parse settings of the SendGrid account.\n\n"+
+		"Each setting maps a receiving hostname to the URL that parsed email is posted to. "+
+		"Useful for auditing which hostnames receive email, and for finding settings to adopt.")
+}
+
+// inboundParseSettingAPIResponse represents the SendGrid API response structure for Inbound Parse settings
+type inboundParseSettingAPIResponse struct {
+	Hostname  string `json:"hostname"`
+	URL       string `json:"url"`
+	SpamCheck bool   `json:"spam_check"`
+	SendRaw   bool   `json:"send_raw"`
+}
+
+// Invoke lists the Inbound Parse settings of the SendGrid account.
+func (f *GetInboundParseSettings) Invoke(ctx context.Context, _ infer.FunctionRequest[GetInboundParseSettingsArgs]) (infer.FunctionResponse[GetInboundParseSettingsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetInboundParseSettingsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/user/webhooks/parse/settings
+	result, err := GetAllPages[inboundParseSettingAPIResponse](ctx, client, "/v3/user/webhooks/parse/settings", PageOptions{})
+	if err != nil {
+		return infer.FunctionResponse[GetInboundParseSettingsResult]{}, fmt.Errorf("failed to list inbound parse settings: %w", err)
+	}
+
+	settings := make([]InboundParseSetting, len(result))
+	for i, r := range result {
+		settings[i] = InboundParseSetting(r)
+	}
+
+	return infer.FunctionResponse[GetInboundParseSettingsResult]{
+		Output: GetInboundParseSettingsResult{Settings: settings},
+	}, nil
+}
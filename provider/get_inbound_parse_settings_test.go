@@ -0,0 +1,54 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetInboundParseSettings(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/v3/user/webhooks/parse/settings", req.URL.Path)
+		return fakeResponse(req, http.StatusOK, `{"result": [
+			{"hostname": "parse.example.com", "url": "https://hooks.example.com/parse", "spam_check": true, "send_raw": false},
+			{"hostname": "replies.example.com", "url": "https://hooks.example.com/replies", "spam_check": false, "send_raw": true}
+		]}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getInboundParseSettings",
+		Args:  property.NewMap(nil),
+	})
+	require.NoError(t, err)
+
+	settings := resp.Return.Get("settings").AsArray()
+	require.Equal(t, 2, settings.Len())
+	parse := settings.Get(0).AsMap()
+	assert.Equal(t, "parse.example.com", parse.Get("hostname").AsString())
+	assert.Equal(t, "https://hooks.example.com/parse", parse.Get("url").AsString())
+	assert.True(t, parse.Get("spamCheck").AsBool())
+	assert.True(t, settings.Get(1).AsMap().Get("sendRaw").AsBool())
+}
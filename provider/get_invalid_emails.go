@@ -0,0 +1,103 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetInvalidEmails is the controller for the getInvalidEmails function.
+//
+// This function lists the email addresses SendGrid has flagged as invalid.
+type GetInvalidEmails struct{}
+
+// GetInvalidEmailsArgs are the inputs to the getInvalidEmails function.
+type GetInvalidEmailsArgs struct {
+	// StartTime limits results to entries created at or after this Unix timestamp (optional)
+	StartTime *int `pulumi:"startTime,optional"`
+
+	// EndTime limits results to entries created at or before this Unix timestamp (optional)
+	EndTime *int `pulumi:"endTime,optional"`
+}
+
+// InvalidEmailEntry describes a single address on the invalid emails list.
+type InvalidEmailEntry struct {
+	// Email is the invalid address
+	Email string `pulumi:"email"`
+
+	// Created is the Unix timestamp when the address was flagged as invalid
+	Created int64 `pulumi:"created"`
+
+	// Reason explains why the address is considered invalid
+	Reason string `pulumi:"reason"`
+}
+
+// GetInvalidEmailsResult is the output of the getInvalidEmails function.
+type GetInvalidEmailsResult struct {
+	// InvalidEmails is the list of invalid addresses
+	InvalidEmails []InvalidEmailEntry `pulumi:"invalidEmails"`
+}
+
+// Annotate provides descriptions for the getInvalidEmails function.
+func (f *GetInvalidEmails) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the email addresses on the SendGrid invalid emails list.\n\n"+
+		"Invalid addresses are malformed or do not exist at the receiving server. "+
+		"Useful for address-hygiene reporting. Optionally filter by Unix time range.")
+}
+
+// invalidEmailAPIResponse represents a single entry returned by the invalid emails endpoint
+type invalidEmailAPIResponse struct {
+	Created int64  `json:"created"`
+	Email   string `json:"email"`
+	Reason  string `json:"reason"`
+}
+
+// Invoke lists the addresses on the invalid emails list.
+func (f *GetInvalidEmails) Invoke(ctx context.Context, req infer.FunctionRequest[GetInvalidEmailsArgs]) (infer.FunctionResponse[GetInvalidEmailsResult], error) {
+	input := req.Input
+
+	query, err := suppressionTimeQuery(input.StartTime, input.EndTime)
+	if err != nil {
+		return infer.FunctionResponse[GetInvalidEmailsResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetInvalidEmailsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/suppression/invalid_emails
+	result, err := GetAllPages[invalidEmailAPIResponse](ctx, client, "/v3/suppression/invalid_emails", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize, Query: query})
+	if err != nil {
+		return infer.FunctionResponse[GetInvalidEmailsResult]{}, fmt.Errorf("failed to list invalid emails: %w", err)
+	}
+
+	invalid := make([]InvalidEmailEntry, len(result))
+	for i, r := range result {
+		invalid[i] = InvalidEmailEntry{
+			Email:   r.Email,
+			Created: r.Created,
+			Reason:  r.Reason,
+		}
+	}
+
+	return infer.FunctionResponse[GetInvalidEmailsResult]{
+		Output: GetInvalidEmailsResult{InvalidEmails: invalid},
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListInvalidEmails(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/suppression/invalid_emails", r.URL.Path)
+		assert.Equal(t, "0", r.URL.Query().Get("offset"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{"created": 1700000100, "email": "not-an-address@", "reason": "Mail domain mentioned in email address is unknown"}
+		]`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	result, err := GetAllPages[invalidEmailAPIResponse](context.Background(), client, "/v3/suppression/invalid_emails", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "not-an-address@", result[0].Email)
+	assert.Equal(t, int64(1700000100), result[0].Created)
+	assert.Contains(t, result[0].Reason, "unknown")
+}
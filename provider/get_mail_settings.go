@@ -0,0 +1,104 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// mailSettingsPageSize is the page size used when listing mail settings
+const mailSettingsPageSize = 100
+
+// GetMailSettings is the controller for the getMailSettings function.
+//
+// This function lists the mail settings of the SendGrid account and whether
+// each is enabled, so compliance audits can compare them to the desired state.
+type GetMailSettings struct{}
+
+// GetMailSettingsArgs are the inputs to the getMailSettings function.
+type GetMailSettingsArgs struct{}
+
+// GetMailSettingsResult is the output of the getMailSettings function.
+type GetMailSettingsResult struct {
+	// Settings lists every mail setting of the account
+	Settings []MailSetting `pulumi:"settings"`
+
+	// Enabled maps each mail setting name to whether it is enabled
+	Enabled map[string]bool `pulumi:"enabled"`
+}
+
+// MailSetting is a mail setting returned by getMailSettings.
+type MailSetting struct {
+	// Name is the name of the setting, e.g. "bcc" or "footer"
+	Name string `pulumi:"name"`
+
+	// Title is the display name of the setting
+	Title string `pulumi:"title"`
+
+	// Description describes what the setting does
+	Description string `pulumi:"description"`
+
+	// Enabled is whether the setting is enabled
+	Enabled bool `pulumi:"enabled"`
+}
+
+// Annotate provides descriptions for the getMailSettings function.
+func (f *GetMailSettings) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the mail settings of the SendGrid account and whether each is enabled.\n\n"+
+		"Returns the settings both as a list and as a map from setting name to enabled, e.g. "+
+		"`{\"bcc\": false, \"footer\": true}`, so compliance audits can diff the account against the desired state.")
+}
+
+// mailSettingAPIResponse represents a single entry returned by the mail settings endpoint
+type mailSettingAPIResponse struct {
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// Invoke lists the mail settings of the SendGrid account.
+func (f *GetMailSettings) Invoke(ctx context.Context, _ infer.FunctionRequest[GetMailSettingsArgs]) (infer.FunctionResponse[GetMailSettingsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetMailSettingsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/mail_settings
+	result, err := GetAllPages[mailSettingAPIResponse](ctx, client, "/v3/mail_settings", PageOptions{Style: PaginateOffset, PageSize: mailSettingsPageSize})
+	if err != nil {
+		return infer.FunctionResponse[GetMailSettingsResult]{}, fmt.Errorf("failed to list mail settings: %w", err)
+	}
+
+	settings := make([]MailSetting, len(result))
+	enabled := make(map[string]bool, len(result))
+	for i, r := range result {
+		settings[i] = MailSetting{
+			Name:        r.Name,
+			Title:       r.Title,
+			Description: r.Description,
+			Enabled:     r.Enabled,
+		}
+		enabled[r.Name] = r.Enabled
+	}
+
+	return infer.FunctionResponse[GetMailSettingsResult]{
+		Output: GetMailSettingsResult{Settings: settings, Enabled: enabled},
+	}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetMailSettings(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/v3/mail_settings", req.URL.Path)
+		assert.Equal(t, "100", req.URL.Query().Get("limit"))
+		return fakeResponse(req, http.StatusOK, `{"result": [
+			{"name": "bcc", "title": "BCC", "description": "Sends a blind carbon copy", "enabled": false},
+			{"name": "footer", "title": "Footer", "description": "Appends a footer", "enabled": true}
+		]}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getMailSettings",
+		Args:  property.NewMap(nil),
+	})
+	require.NoError(t, err)
+
+	settings := resp.Return.Get("settings").AsArray()
+	require.Equal(t, 2, settings.Len())
+	assert.Equal(t, "footer", settings.Get(1).AsMap().Get("name").AsString())
+	assert.Equal(t, "Appends a footer", settings.Get(1).AsMap().Get("description").AsString())
+
+	enabled := resp.Return.Get("enabled").AsMap()
+	assert.False(t, enabled.Get("bcc").AsBool())
+	assert.True(t, enabled.Get("footer").AsBool())
+}
@@ -0,0 +1,94 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// marketingPageSize is the page size used when listing Marketing Campaigns resources
+const marketingPageSize = 1000
+
+// GetMarketingLists is the controller for the getMarketingLists function.
+//
+// This function lists the Marketing Campaigns contact lists, so that segments
+// and single sends can reference lists created outside of Pulumi.
+type GetMarketingLists struct{}
+
+// GetMarketingListsArgs are the inputs to the getMarketingLists function.
+type GetMarketingListsArgs struct{}
+
+// MarketingListSummary describes a single contact list returned by the getMarketingLists function.
+type MarketingListSummary struct {
+	// ListID is the unique identifier assigned by SendGrid
+	ListID string `pulumi:"listId"`
+
+	// Name is the name of the list
+	Name string `pulumi:"name"`
+
+	// ContactCount is the number of contacts in the list
+	ContactCount int `pulumi:"contactCount"`
+}
+
+// GetMarketingListsResult is the output of the getMarketingLists function.
+type GetMarketingListsResult struct {
+	// Lists is the list of Marketing Campaigns contact lists
+	Lists []MarketingListSummary `pulumi:"lists"`
+}
+
+// Annotate provides descriptions for the getMarketingLists function.
+func (f *GetMarketingLists) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the SendGrid Marketing Campaigns contact lists.\n\n"+
+		"Returns the ID, name and contact count of every list, so that segments and single sends "+
+		"can reference lists managed by the marketing team.")
+}
+
+// marketingListAPIResponse represents a single list returned by the marketing lists endpoint
+type marketingListAPIResponse struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ContactCount int    `json:"contact_count"`
+}
+
+// Invoke lists the Marketing Campaigns contact lists.
+func (f *GetMarketingLists) Invoke(ctx context.Context, _ infer.FunctionRequest[GetMarketingListsArgs]) (infer.FunctionResponse[GetMarketingListsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetMarketingListsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/marketing/lists
+	result, err := GetAllPages[marketingListAPIResponse](ctx, client, "/v3/marketing/lists", PageOptions{Style: PaginateToken, PageSize: marketingPageSize})
+	if err != nil {
+		return infer.FunctionResponse[GetMarketingListsResult]{}, fmt.Errorf("failed to list marketing lists: %w", err)
+	}
+
+	lists := make([]MarketingListSummary, len(result))
+	for i, r := range result {
+		lists[i] = MarketingListSummary{
+			ListID:       r.ID,
+			Name:         r.Name,
+			ContactCount: r.ContactCount,
+		}
+	}
+
+	return infer.FunctionResponse[GetMarketingListsResult]{
+		Output: GetMarketingListsResult{Lists: lists},
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListMarketingLists(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/marketing/lists", r.URL.Path)
+		assert.Equal(t, "1000", r.URL.Query().Get("page_size"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"result": [
+				{"id": "ca7a3796-e8a8-4029-9ccb-df8937940562", "name": "Newsletter", "contact_count": 1520},
+				{"id": "f2fe66a1-43f3-4e3a-87b1-c6a600d805f0", "name": "Beta testers", "contact_count": 0}
+			],
+			"_metadata": {"self": "https://api.sendgrid.com/v3/marketing/lists?page_size=1000", "count": 2}
+		}`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	result, err := GetAllPages[marketingListAPIResponse](context.Background(), client, "/v3/marketing/lists", PageOptions{Style: PaginateToken, PageSize: marketingPageSize})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "ca7a3796-e8a8-4029-9ccb-df8937940562", result[0].ID)
+	assert.Equal(t, "Newsletter", result[0].Name)
+	assert.Equal(t, 1520, result[0].ContactCount)
+}
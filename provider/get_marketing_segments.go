@@ -0,0 +1,128 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetMarketingSegments is the controller for the getMarketingSegments function.
+//
+// This function lists the Marketing Campaigns segments along with their
+// queries and contact counts.
+type GetMarketingSegments struct{}
+
+// GetMarketingSegmentsArgs are the inputs to the getMarketingSegments function.
+type GetMarketingSegmentsArgs struct{}
+
+// MarketingSegmentSummary describes a single segment returned by the getMarketingSegments function.
+type MarketingSegmentSummary struct {
+	// SegmentID is the unique identifier assigned by SendGrid
+	SegmentID string `pulumi:"segmentId"`
+
+	// Name is the name of the segment
+	Name string `pulumi:"name"`
+
+	// QueryDSL is the SQL query that defines the segment
+	QueryDSL string `pulumi:"queryDsl"`
+
+	// ContactsCount is the number of contacts in the segment
+	ContactsCount int `pulumi:"contactsCount"`
+
+	// ParentListIDs is the list of contact lists the segment is built from
+	ParentListIDs []string `pulumi:"parentListIds"`
+
+	// CreatedAt is the timestamp when the segment was created
+	CreatedAt string `pulumi:"createdAt"`
+
+	// UpdatedAt is the timestamp when the segment was last updated
+	UpdatedAt string `pulumi:"updatedAt"`
+}
+
+// GetMarketingSegmentsResult is the output of the getMarketingSegments function.
+type GetMarketingSegmentsResult struct {
+	// Segments is the list of Marketing Campaigns segments
+	Segments []MarketingSegmentSummary `pulumi:"segments"`
+}
+
+// Annotate provides descriptions for the getMarketingSegments function.
+func (f *GetMarketingSegments) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the SendGrid Marketing Campaigns segments.\n\n"+
+		"Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, "+
+		"so that single sends can reference existing segments.")
+}
+
+// marketingSegmentAPIResponse represents a single segment returned by the segments 2.0 API
+type marketingSegmentAPIResponse struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	QueryDSL      string   `json:"query_dsl"`
+	ContactsCount int      `json:"contacts_count"`
+	ParentListIDs []string `json:"parent_list_ids"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+}
+
+// toSummary converts an API response to a MarketingSegmentSummary
+func (r *marketingSegmentAPIResponse) toSummary() MarketingSegmentSummary {
+	parentListIDs := r.ParentListIDs
+	if parentListIDs == nil {
+		parentListIDs = []string{}
+	}
+	return MarketingSegmentSummary{
+		SegmentID:     r.ID,
+		Name:          r.Name,
+		QueryDSL:      r.QueryDSL,
+		ContactsCount: r.ContactsCount,
+		ParentListIDs: parentListIDs,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+// Invoke lists the Marketing Campaigns segments.
+func (f *GetMarketingSegments) Invoke(ctx context.Context, _ infer.FunctionRequest[GetMarketingSegmentsArgs]) (infer.FunctionResponse[GetMarketingSegmentsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetMarketingSegmentsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/marketing/segments/2.0
+	result, err := GetAllPages[marketingSegmentAPIResponse](ctx, client, "/v3/marketing/segments/2.0", PageOptions{Style: PaginateToken, PageSize: marketingPageSize})
+	if err != nil {
+		return infer.FunctionResponse[GetMarketingSegmentsResult]{}, fmt.Errorf("failed to list marketing segments: %w", err)
+	}
+
+	segments := make([]MarketingSegmentSummary, len(result))
+	for i := range result {
+		// The list endpoint omits the segment query, so fetch it from the segment itself
+		if result[i].QueryDSL == "" {
+			var detail marketingSegmentAPIResponse
+			if err := client.Get(ctx, fmt.Sprintf("/v3/marketing/segments/2.0/%s", result[i].ID), &detail); err != nil {
+				return infer.FunctionResponse[GetMarketingSegmentsResult]{}, fmt.Errorf("failed to read marketing segment %s: %w", result[i].ID, err)
+			}
+			result[i].QueryDSL = detail.QueryDSL
+		}
+		segments[i] = result[i].toSummary()
+	}
+
+	return infer.FunctionResponse[GetMarketingSegmentsResult]{
+		Output: GetMarketingSegmentsResult{Segments: segments},
+	}, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListMarketingSegments(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.WriteHeader(http.StatusOK)
+
+		switch r.URL.Path {
+		case "/v3/marketing/segments/2.0":
+			if r.URL.Query().Get("page_token") == "" {
+				_, _ = w.Write([]byte(`{
+					"results": [{"id": "seg-1", "name": "Active", "contacts_count": 42, "parent_list_ids": ["list-1"]}],
+					"_metadata": {"next": "https://api.sendgrid.com/v3/marketing/segments/2.0?page_token=next"}
+				}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"results": [{"id": "seg-2", "name": "Dormant", "contacts_count": 7}], "_metadata": {}}`))
+		case "/v3/marketing/segments/2.0/seg-1":
+			_, _ = w.Write([]byte(`{"id": "seg-1", "query_dsl": "SELECT contact_id FROM contact_data WHERE last_clicked > '2024-01-01'"}`))
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	result, err := GetAllPages[marketingSegmentAPIResponse](context.Background(), client, "/v3/marketing/segments/2.0", PageOptions{Style: PaginateToken, PageSize: marketingPageSize})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "seg-1", result[0].ID)
+	assert.Equal(t, 42, result[0].ContactsCount)
+	assert.Equal(t, "Dormant", result[1].Name)
+
+	var detail marketingSegmentAPIResponse
+	err = client.Get(context.Background(), "/v3/marketing/segments/2.0/seg-1", &detail)
+	require.NoError(t, err)
+	assert.Contains(t, detail.QueryDSL, "last_clicked")
+}
+
+func TestMarketingSegmentAPIResponse_ToSummary(t *testing.T) {
+	t.Parallel()
+
+	resp := marketingSegmentAPIResponse{
+		ID:            "seg-2",
+		Name:          "Dormant",
+		QueryDSL:      "SELECT contact_id FROM contact_data",
+		ContactsCount: 7,
+	}
+
+	summary := resp.toSummary()
+
+	assert.Equal(t, "seg-2", summary.SegmentID)
+	assert.Equal(t, "Dormant", summary.Name)
+	assert.Equal(t, "SELECT contact_id FROM contact_data", summary.QueryDSL)
+	assert.Equal(t, 7, summary.ContactsCount)
+	assert.NotNil(t, summary.ParentListIDs)
+	assert.Empty(t, summary.ParentListIDs)
+}
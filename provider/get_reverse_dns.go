@@ -0,0 +1,146 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetReverseDns is the controller for the getReverseDns function.
+//
+// This function looks up the reverse DNS entry of a dedicated IP, returning the
+// A record SendGrid asks to be published so it can be wired into DNS.
+type GetReverseDns struct{} //nolint:revive // name matches Pulumi function token
+
+// GetReverseDnsArgs are the inputs to the getReverseDns function.
+type GetReverseDnsArgs struct { //nolint:revive // name matches Pulumi function token
+	// IP is the dedicated IP address to look up (required)
+	IP string `pulumi:"ip"`
+}
+
+// GetReverseDnsResult is the output of the getReverseDns function.
+type GetReverseDnsResult struct { //nolint:revive // name matches Pulumi function token
+	// ReverseDNSID is the ID of the reverse DNS entry
+	ReverseDNSID int `pulumi:"reverseDnsId"`
+
+	// IP is the dedicated IP address
+	IP string `pulumi:"ip"`
+
+	// Rdns is the reverse DNS hostname of the IP, e.g. "o1.email.example.com"
+	Rdns string `pulumi:"rdns"`
+
+	// Domain is the root domain of the hostname
+	Domain string `pulumi:"domain"`
+
+	// Subdomain is the subdomain of the hostname
+	Subdomain string `pulumi:"subdomain"`
+
+	// Valid indicates if the A record has been validated
+	Valid bool `pulumi:"valid"`
+
+	// Legacy indicates if the entry was created with the legacy whitelabel API
+	Legacy bool `pulumi:"legacy"`
+
+	// DNSRecords holds the A record to publish, named "a_record"
+	DNSRecords []DomainDNSRecord `pulumi:"dnsRecords"`
+
+	// ProviderRecords holds the A record in the shape DNS provider resources expect
+	ProviderRecords []DNSProviderRecord `pulumi:"providerRecords"`
+}
+
+// Annotate provides descriptions for the getReverseDns function.
+func (f *GetReverseDns) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Looks up the reverse DNS entry of a dedicated IP address.\n\n"+
+		"Returns the rDNS hostname, whether it has been validated, and the A record to publish, "+
+		"also in the shape DNS provider resources expect, so it can be wired into DNS like the "+
+		"records of DomainAuthentication and LinkBranding.")
+}
+
+// reverseDNSAPIResponse represents the SendGrid API response structure for reverse DNS entries
+type reverseDNSAPIResponse struct {
+	ID        int    `json:"id"`
+	IP        string `json:"ip"`
+	Rdns      string `json:"rdns"`
+	Domain    string `json:"domain"`
+	Subdomain string `json:"subdomain"`
+	Valid     bool   `json:"valid"`
+	Legacy    bool   `json:"legacy"`
+	ARecord   *struct {
+		Valid bool   `json:"valid"`
+		Type  string `json:"type"`
+		Host  string `json:"host"`
+		Data  string `json:"data"`
+	} `json:"a_record"`
+}
+
+// Invoke looks up the reverse DNS entry of an IP address.
+func (f *GetReverseDns) Invoke(ctx context.Context, req infer.FunctionRequest[GetReverseDnsArgs]) (infer.FunctionResponse[GetReverseDnsResult], error) {
+	input := req.Input
+	if input.IP == "" {
+		return infer.FunctionResponse[GetReverseDnsResult]{}, fmt.Errorf("ip is required")
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetReverseDnsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/whitelabel/ips searches entries by IP prefix, so the results are matched exactly
+	query := url.Values{}
+	query.Set("ip", input.IP)
+	entries, err := GetAllPages[reverseDNSAPIResponse](ctx, client, "/v3/whitelabel/ips", PageOptions{Query: query})
+	if err != nil {
+		return infer.FunctionResponse[GetReverseDnsResult]{}, fmt.Errorf("failed to look up reverse DNS: %w", err)
+	}
+	var entry *reverseDNSAPIResponse
+	for i := range entries {
+		if entries[i].IP == input.IP {
+			entry = &entries[i]
+			break
+		}
+	}
+	if entry == nil {
+		return infer.FunctionResponse[GetReverseDnsResult]{}, fmt.Errorf("no reverse DNS entry found for IP %s", input.IP)
+	}
+
+	result := GetReverseDnsResult{
+		ReverseDNSID:    entry.ID,
+		IP:              entry.IP,
+		Rdns:            entry.Rdns,
+		Domain:          entry.Domain,
+		Subdomain:       entry.Subdomain,
+		Valid:           entry.Valid,
+		Legacy:          entry.Legacy,
+		DNSRecords:      []DomainDNSRecord{},
+		ProviderRecords: []DNSProviderRecord{},
+	}
+	if record := entry.ARecord; record != nil {
+		result.DNSRecords = append(result.DNSRecords, DomainDNSRecord{
+			Name:  "a_record",
+			Valid: record.Valid,
+			Type:  record.Type,
+			Host:  record.Host,
+			Data:  record.Data,
+		})
+		result.ProviderRecords = append(result.ProviderRecords, newDNSProviderRecord(record.Type, record.Host, record.Data))
+	}
+
+	return infer.FunctionResponse[GetReverseDnsResult]{Output: result}, nil
+}
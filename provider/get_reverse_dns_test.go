@@ -0,0 +1,66 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetReverseDns(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/v3/whitelabel/ips", req.URL.Path)
+		// The IP search matches by prefix
+		return fakeResponse(req, http.StatusOK, `[
+			{"id": 2, "ip": "192.0.2.10", "rdns": "o2.email.example.com", "domain": "example.com", "subdomain": "email", "valid": false},
+			{"id": 1, "ip": "192.0.2.1", "rdns": "o1.email.example.com", "domain": "example.com", "subdomain": "email",
+				"valid": true, "legacy": false, "a_record": {"valid": true, "type": "a", "host": "o1.email.example.com", "data": "192.0.2.1"}}
+		]`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	invoke := func(ip string) (p.InvokeResponse, error) {
+		return server.Invoke(p.InvokeRequest{
+			Token: "sendgrid:index:getReverseDns",
+			Args:  property.NewMap(map[string]property.Value{"ip": property.New(ip)}),
+		})
+	}
+
+	resp, err := invoke("192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, resp.Return.Get("reverseDnsId").AsNumber())
+	assert.Equal(t, "o1.email.example.com", resp.Return.Get("rdns").AsString())
+	assert.True(t, resp.Return.Get("valid").AsBool())
+
+	records := resp.Return.Get("dnsRecords").AsArray()
+	require.Equal(t, 1, records.Len())
+	assert.Equal(t, "a_record", records.Get(0).AsMap().Get("name").AsString())
+	providerRecords := resp.Return.Get("providerRecords").AsArray()
+	require.Equal(t, 1, providerRecords.Len())
+	assert.Equal(t, "A", providerRecords.Get(0).AsMap().Get("type").AsString())
+	assert.Equal(t, "192.0.2.1", providerRecords.Get(0).AsMap().Get("value").AsString())
+
+	_, err = invoke("192.0.2.99")
+	assert.ErrorContains(t, err, "no reverse DNS entry found for IP 192.0.2.99")
+}
@@ -0,0 +1,103 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetSpamReports is the controller for the getSpamReports function.
+//
+// This function lists the email addresses that have marked mail as spam.
+type GetSpamReports struct{}
+
+// GetSpamReportsArgs are the inputs to the getSpamReports function.
+type GetSpamReportsArgs struct {
+	// StartTime limits results to reports created at or after this Unix timestamp (optional)
+	StartTime *int `pulumi:"startTime,optional"`
+
+	// EndTime limits results to reports created at or before this Unix timestamp (optional)
+	EndTime *int `pulumi:"endTime,optional"`
+}
+
+// SpamReportEntry describes a single address on the spam reports list.
+type SpamReportEntry struct {
+	// Email is the address of the recipient who reported spam
+	Email string `pulumi:"email"`
+
+	// Created is the Unix timestamp when the spam report was made
+	Created int64 `pulumi:"created"`
+
+	// IP is the sending IP address the reported message was sent from
+	IP string `pulumi:"ip"`
+}
+
+// GetSpamReportsResult is the output of the getSpamReports function.
+type GetSpamReportsResult struct {
+	// SpamReports is the list of spam reports
+	SpamReports []SpamReportEntry `pulumi:"spamReports"`
+}
+
+// Annotate provides descriptions for the getSpamReports function.
+func (f *GetSpamReports) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the email addresses on the SendGrid spam reports list.\n\n"+
+		"Returns each recipient that marked a message as spam, when they reported it and the sending IP. "+
+		"Optionally filter by the Unix time range in which the report was made.")
+}
+
+// spamReportAPIResponse represents a single entry returned by the spam reports endpoint
+type spamReportAPIResponse struct {
+	Created int64  `json:"created"`
+	Email   string `json:"email"`
+	IP      string `json:"ip"`
+}
+
+// Invoke lists the addresses on the spam reports list.
+func (f *GetSpamReports) Invoke(ctx context.Context, req infer.FunctionRequest[GetSpamReportsArgs]) (infer.FunctionResponse[GetSpamReportsResult], error) {
+	input := req.Input
+
+	query, err := suppressionTimeQuery(input.StartTime, input.EndTime)
+	if err != nil {
+		return infer.FunctionResponse[GetSpamReportsResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetSpamReportsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/suppression/spam_reports
+	result, err := GetAllPages[spamReportAPIResponse](ctx, client, "/v3/suppression/spam_reports", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize, Query: query})
+	if err != nil {
+		return infer.FunctionResponse[GetSpamReportsResult]{}, fmt.Errorf("failed to list spam reports: %w", err)
+	}
+
+	reports := make([]SpamReportEntry, len(result))
+	for i, r := range result {
+		reports[i] = SpamReportEntry{
+			Email:   r.Email,
+			Created: r.Created,
+			IP:      r.IP,
+		}
+	}
+
+	return infer.FunctionResponse[GetSpamReportsResult]{
+		Output: GetSpamReportsResult{SpamReports: reports},
+	}, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ListSpamReports(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/suppression/spam_reports", r.URL.Path)
+		assert.Equal(t, "1700086400", r.URL.Query().Get("end_time"))
+		assert.Empty(t, r.URL.Query().Get("start_time"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{"created": 1700000100, "email": "angry@example.com", "ip": "203.0.113.10"},
+			{"created": 1700000200, "email": "annoyed@example.com", "ip": "203.0.113.11"}
+		]`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := suppressionTimeQuery(nil, intPtr(1700086400))
+	require.NoError(t, err)
+
+	result, err := GetAllPages[spamReportAPIResponse](context.Background(), client, "/v3/suppression/spam_reports", PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize, Query: query})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "angry@example.com", result[0].Email)
+	assert.Equal(t, int64(1700000100), result[0].Created)
+	assert.Equal(t, "203.0.113.11", result[1].IP)
+}
@@ -0,0 +1,136 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetSsoCertificates is the controller for the getSsoCertificates function.
+//
+// This function lists the certificates of the SendGrid account's SSO
+// integrations with their validity periods, to alert on upcoming expiries.
+type GetSsoCertificates struct{}
+
+// GetSsoCertificatesArgs are the inputs to the getSsoCertificates function.
+type GetSsoCertificatesArgs struct {
+	// IntegrationID limits results to the certificates of one SSO integration (optional)
+	IntegrationID *string `pulumi:"integrationId,optional"`
+
+	// ExpiringWithinDays limits results to certificates that expire within this many days (optional)
+	ExpiringWithinDays *int `pulumi:"expiringWithinDays,optional"`
+}
+
+// GetSsoCertificatesResult is the output of the getSsoCertificates function.
+type GetSsoCertificatesResult struct {
+	// Certificates is the list of SSO certificates
+	Certificates []SsoCertificate `pulumi:"certificates"`
+}
+
+// SsoCertificate is an SSO certificate returned by getSsoCertificates.
+type SsoCertificate struct {
+	// CertificateID is the ID of the certificate
+	CertificateID int `pulumi:"certificateId"`
+
+	// IntegrationID is the ID of the SSO integration the certificate belongs to
+	IntegrationID string `pulumi:"integrationId"`
+
+	// NotBefore is the Unix timestamp from which the certificate is valid
+	NotBefore int64 `pulumi:"notBefore"`
+
+	// NotAfter is the Unix timestamp at which the certificate expires
+	NotAfter int64 `pulumi:"notAfter"`
+
+	// PublicCertificate is the PEM-encoded public certificate
+	PublicCertificate string `pulumi:"publicCertificate"`
+}
+
+// Annotate provides descriptions for the getSsoCertificates function.
+func (f *GetSsoCertificates) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the certificates of the SendGrid account's SSO integrations.\n\n"+
+		"Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` "+
+		"to only list certificates that expire soon, e.g. to raise certificate rotation alerts.")
+}
+
+// ssoCertificateAPIResponse represents the SendGrid API response structure for SSO certificates
+type ssoCertificateAPIResponse struct {
+	ID                int    `json:"id"`
+	NotBefore         int64  `json:"not_before"`
+	NotAfter          int64  `json:"not_after"`
+	PublicCertificate string `json:"public_certificate"`
+}
+
+// Invoke lists the certificates of the requested SSO integrations.
+func (f *GetSsoCertificates) Invoke(ctx context.Context, req infer.FunctionRequest[GetSsoCertificatesArgs]) (infer.FunctionResponse[GetSsoCertificatesResult], error) {
+	input := req.Input
+	if input.ExpiringWithinDays != nil && *input.ExpiringWithinDays < 0 {
+		return infer.FunctionResponse[GetSsoCertificatesResult]{}, fmt.Errorf("expiringWithinDays must not be negative, got %d", *input.ExpiringWithinDays)
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetSsoCertificatesResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// Certificates are listed per integration, so list the integrations unless one is given
+	var integrationIDs []string
+	if input.IntegrationID != nil && *input.IntegrationID != "" {
+		integrationIDs = []string{*input.IntegrationID}
+	} else {
+		var integrations []ssoIntegrationAPIResponse
+		if err := client.Get(ctx, "/v3/sso/integrations", &integrations); err != nil {
+			return infer.FunctionResponse[GetSsoCertificatesResult]{}, fmt.Errorf("failed to list SSO integrations: %w", err)
+		}
+		for _, integration := range integrations {
+			integrationIDs = append(integrationIDs, integration.ID)
+		}
+	}
+
+	var expiringBefore int64
+	if input.ExpiringWithinDays != nil {
+		expiringBefore = time.Now().AddDate(0, 0, *input.ExpiringWithinDays).Unix()
+	}
+
+	certificates := []SsoCertificate{}
+	for _, integrationID := range integrationIDs {
+		// GET /v3/sso/integrations/{id}/certificates
+		var result []ssoCertificateAPIResponse
+		if err := client.Get(ctx, fmt.Sprintf("/v3/sso/integrations/%s/certificates", url.PathEscape(integrationID)), &result); err != nil {
+			return infer.FunctionResponse[GetSsoCertificatesResult]{}, fmt.Errorf("failed to list certificates of SSO integration %s: %w", integrationID, err)
+		}
+		for _, r := range result {
+			if input.ExpiringWithinDays != nil && r.NotAfter > expiringBefore {
+				continue
+			}
+			certificates = append(certificates, SsoCertificate{
+				CertificateID:     r.ID,
+				IntegrationID:     integrationID,
+				NotBefore:         r.NotBefore,
+				NotAfter:          r.NotAfter,
+				PublicCertificate: r.PublicCertificate,
+			})
+		}
+	}
+
+	return infer.FunctionResponse[GetSsoCertificatesResult]{
+		Output: GetSsoCertificatesResult{Certificates: certificates},
+	}, nil
+}
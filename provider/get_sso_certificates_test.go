@@ -0,0 +1,85 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetSsoCertificates(t *testing.T) {
+	t.Parallel()
+
+	soon := time.Now().AddDate(0, 0, 10).Unix()
+	later := time.Now().AddDate(1, 0, 0).Unix()
+	responses := map[string]string{
+		"/v3/sso/integrations":                    `[{"id": "okta"}, {"id": "azure"}]`,
+		"/v3/sso/integrations/okta/certificates":  fmt.Sprintf(`[{"id": 1, "not_before": 1700000000, "not_after": %d, "public_certificate": "okta-cert"}]`, soon),
+		"/v3/sso/integrations/azure/certificates": fmt.Sprintf(`[{"id": 2, "not_before": 1700000000, "not_after": %d, "public_certificate": "azure-cert"}]`, later),
+	}
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		if body, ok := responses[req.URL.Path]; ok {
+			return fakeResponse(req, http.StatusOK, body), nil
+		}
+		return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "not found"}]}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	tests := []struct {
+		name string
+		args map[string]property.Value
+		want []string
+	}{
+		{name: "all integrations", want: []string{"okta", "azure"}},
+		{name: "one integration", args: map[string]property.Value{"integrationId": property.New("azure")}, want: []string{"azure"}},
+		{name: "expiring soon", args: map[string]property.Value{"expiringWithinDays": property.New(30.0)}, want: []string{"okta"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Invoke(p.InvokeRequest{
+				Token: "sendgrid:index:getSsoCertificates",
+				Args:  property.NewMap(tt.args),
+			})
+			require.NoError(t, err)
+
+			var integrations []string
+			for _, certificate := range resp.Return.Get("certificates").AsArray().All {
+				integrations = append(integrations, certificate.AsMap().Get("integrationId").AsString())
+			}
+			assert.Equal(t, tt.want, integrations)
+		})
+	}
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getSsoCertificates",
+		Args:  property.NewMap(map[string]property.Value{"integrationId": property.New("okta")}),
+	})
+	require.NoError(t, err)
+	certificate := resp.Return.Get("certificates").AsArray().Get(0).AsMap()
+	assert.Equal(t, 1.0, certificate.Get("certificateId").AsNumber())
+	assert.Equal(t, float64(soon), certificate.Get("notAfter").AsNumber())
+	assert.Equal(t, "okta-cert", certificate.Get("publicCertificate").AsString())
+}
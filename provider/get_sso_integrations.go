@@ -0,0 +1,122 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetSsoIntegrations is the controller for the getSsoIntegrations function.
+//
+// This function lists the SSO integrations of the SendGrid account with the
+// audience and single sign-on URLs an identity provider must be configured with.
+type GetSsoIntegrations struct{}
+
+// GetSsoIntegrationsArgs are the inputs to the getSsoIntegrations function.
+type GetSsoIntegrationsArgs struct{}
+
+// GetSsoIntegrationsResult is the output of the getSsoIntegrations function.
+type GetSsoIntegrationsResult struct {
+	// Integrations is the list of SSO integrations
+	Integrations []SsoIntegration `pulumi:"integrations"`
+}
+
+// SsoIntegration is an SSO integration returned by getSsoIntegrations.
+type SsoIntegration struct {
+	// IntegrationID is the ID of the integration
+	IntegrationID string `pulumi:"integrationId"`
+
+	// Name is the name of the integration
+	Name string `pulumi:"name"`
+
+	// Enabled indicates if the integration is enabled
+	Enabled bool `pulumi:"enabled"`
+
+	// Completed indicates if the integration's setup has been completed
+	Completed bool `pulumi:"completed"`
+
+	// AudienceURL is the audience URL (SP entity ID) to configure in the identity provider
+	AudienceURL string `pulumi:"audienceUrl"`
+
+	// SingleSignonURL is the single sign-on (ACS) URL to configure in the identity provider
+	SingleSignonURL string `pulumi:"singleSignonUrl"`
+
+	// SigninURL is the identity provider's sign-in URL
+	SigninURL string `pulumi:"signinUrl"`
+
+	// SignoutURL is the identity provider's sign-out URL
+	SignoutURL string `pulumi:"signoutUrl"`
+
+	// EntityID is the identity provider's entity ID
+	EntityID string `pulumi:"entityId"`
+}
+
+// Annotate provides descriptions for the getSsoIntegrations function.
+func (f *GetSsoIntegrations) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Lists the SSO integrations of the SendGrid account.\n\n"+
+		"Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's "+
+		"SAML application must be configured with, so they can be passed to IdP resources or exported "+
+		"as stack outputs.")
+}
+
+// ssoIntegrationAPIResponse represents the SendGrid API response structure for SSO integrations
+type ssoIntegrationAPIResponse struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Enabled              bool   `json:"enabled"`
+	CompletedIntegration bool   `json:"completed_integration"`
+	AudienceURL          string `json:"audience_url"`
+	SingleSignonURL      string `json:"single_signon_url"`
+	SigninURL            string `json:"signin_url"`
+	SignoutURL           string `json:"signout_url"`
+	EntityID             string `json:"entity_id"`
+}
+
+// Invoke lists the SSO integrations of the SendGrid account.
+func (f *GetSsoIntegrations) Invoke(ctx context.Context, _ infer.FunctionRequest[GetSsoIntegrationsArgs]) (infer.FunctionResponse[GetSsoIntegrationsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetSsoIntegrationsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/sso/integrations
+	var result []ssoIntegrationAPIResponse
+	if err := client.Get(ctx, "/v3/sso/integrations", &result); err != nil {
+		return infer.FunctionResponse[GetSsoIntegrationsResult]{}, fmt.Errorf("failed to list SSO integrations: %w", err)
+	}
+
+	integrations := make([]SsoIntegration, len(result))
+	for i, r := range result {
+		integrations[i] = SsoIntegration{
+			IntegrationID:   r.ID,
+			Name:            r.Name,
+			Enabled:         r.Enabled,
+			Completed:       r.CompletedIntegration,
+			AudienceURL:     r.AudienceURL,
+			SingleSignonURL: r.SingleSignonURL,
+			SigninURL:       r.SigninURL,
+			SignoutURL:      r.SignoutURL,
+			EntityID:        r.EntityID,
+		}
+	}
+
+	return infer.FunctionResponse[GetSsoIntegrationsResult]{
+		Output: GetSsoIntegrationsResult{Integrations: integrations},
+	}, nil
+}
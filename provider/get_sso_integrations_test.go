@@ -0,0 +1,61 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetSsoIntegrations(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/v3/sso/integrations", req.URL.Path)
+		return fakeResponse(req, http.StatusOK, `[{
+			"id": "b0b98502-9408-4b24-9e3d-31ed7cb15312",
+			"name": "Okta",
+			"enabled": true,
+			"completed_integration": true,
+			"audience_url": "https://api.sendgrid.com/v3/public/sso/saml/response/id/b0b98502",
+			"single_signon_url": "https://api.sendgrid.com/v3/public/sso/saml/response/id/b0b98502",
+			"signin_url": "https://example.okta.com/app/sso/saml",
+			"signout_url": "https://example.okta.com/logout",
+			"entity_id": "http://www.okta.com/exk1"
+		}]`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getSsoIntegrations",
+		Args:  property.NewMap(nil),
+	})
+	require.NoError(t, err)
+
+	integrations := resp.Return.Get("integrations").AsArray()
+	require.Equal(t, 1, integrations.Len())
+	okta := integrations.Get(0).AsMap()
+	assert.Equal(t, "b0b98502-9408-4b24-9e3d-31ed7cb15312", okta.Get("integrationId").AsString())
+	assert.True(t, okta.Get("completed").AsBool())
+	assert.Equal(t, "https://api.sendgrid.com/v3/public/sso/saml/response/id/b0b98502", okta.Get("audienceUrl").AsString())
+	assert.Equal(t, "http://www.okta.com/exk1", okta.Get("entityId").AsString())
+}
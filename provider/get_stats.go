@@ -0,0 +1,205 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetStats is the controller for the getStats function.
+//
+// This function retrieves global email statistics for the SendGrid account
+// over a date range.
+type GetStats struct{}
+
+// GetStatsArgs are the inputs to the getStats function.
+type GetStatsArgs struct {
+	// StartDate is the first day to retrieve statistics for, in YYYY-MM-DD format (required)
+	StartDate string `pulumi:"startDate"`
+
+	// EndDate is the last day to retrieve statistics for, in YYYY-MM-DD format (optional)
+	// Defaults to today.
+	EndDate *string `pulumi:"endDate,optional"`
+
+	// AggregatedBy groups the statistics: "day", "week" or "month" (optional, default: day)
+	AggregatedBy *string `pulumi:"aggregatedBy,optional"`
+}
+
+// StatsMetrics contains the email metrics reported by the SendGrid stats endpoints.
+type StatsMetrics struct {
+	Blocks           int `pulumi:"blocks"`
+	BounceDrops      int `pulumi:"bounceDrops"`
+	Bounces          int `pulumi:"bounces"`
+	Clicks           int `pulumi:"clicks"`
+	Deferred         int `pulumi:"deferred"`
+	Delivered        int `pulumi:"delivered"`
+	InvalidEmails    int `pulumi:"invalidEmails"`
+	Opens            int `pulumi:"opens"`
+	Processed        int `pulumi:"processed"`
+	Requests         int `pulumi:"requests"`
+	SpamReportDrops  int `pulumi:"spamReportDrops"`
+	SpamReports      int `pulumi:"spamReports"`
+	UniqueClicks     int `pulumi:"uniqueClicks"`
+	UniqueOpens      int `pulumi:"uniqueOpens"`
+	UnsubscribeDrops int `pulumi:"unsubscribeDrops"`
+	Unsubscribes     int `pulumi:"unsubscribes"`
+}
+
+// StatsMetricsEntry is a set of metrics, optionally scoped to a named category or subuser.
+type StatsMetricsEntry struct {
+	// Name is the category or subuser the metrics belong to (empty for global stats)
+	Name *string `pulumi:"name,optional"`
+
+	// Type is the kind of entity the metrics belong to, e.g. "category" or "subuser"
+	Type *string `pulumi:"type,optional"`
+
+	// Metrics are the email metrics for this entry
+	Metrics StatsMetrics `pulumi:"metrics"`
+}
+
+// StatsEntry is the statistics for a single aggregation period.
+type StatsEntry struct {
+	// Date is the first day of the aggregation period (YYYY-MM-DD)
+	Date string `pulumi:"date"`
+
+	// Stats are the metrics recorded during the period
+	Stats []StatsMetricsEntry `pulumi:"stats"`
+}
+
+// GetStatsResult is the output of the getStats function.
+type GetStatsResult struct {
+	// Stats is the list of statistics, one entry per aggregation period
+	Stats []StatsEntry `pulumi:"stats"`
+}
+
+// Annotate provides descriptions for the getStats function.
+func (f *GetStats) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Retrieves global email statistics for the SendGrid account.\n\n"+
+		"Returns delivered, open, click, bounce and other metrics for each day, week or month "+
+		"in the requested date range, so dashboards and alert thresholds can be derived at deploy time.")
+}
+
+// statsMetricsAPIResponse represents the metrics object returned by the SendGrid stats endpoints
+type statsMetricsAPIResponse struct {
+	Blocks           int `json:"blocks"`
+	BounceDrops      int `json:"bounce_drops"`
+	Bounces          int `json:"bounces"`
+	Clicks           int `json:"clicks"`
+	Deferred         int `json:"deferred"`
+	Delivered        int `json:"delivered"`
+	InvalidEmails    int `json:"invalid_emails"`
+	Opens            int `json:"opens"`
+	Processed        int `json:"processed"`
+	Requests         int `json:"requests"`
+	SpamReportDrops  int `json:"spam_report_drops"`
+	SpamReports      int `json:"spam_reports"`
+	UniqueClicks     int `json:"unique_clicks"`
+	UniqueOpens      int `json:"unique_opens"`
+	UnsubscribeDrops int `json:"unsubscribe_drops"`
+	Unsubscribes     int `json:"unsubscribes"`
+}
+
+// statsAPIResponse represents a single aggregation period returned by the SendGrid stats endpoints
+type statsAPIResponse struct {
+	Date  string `json:"date"`
+	Stats []struct {
+		Name    string                  `json:"name,omitempty"`
+		Type    string                  `json:"type,omitempty"`
+		Metrics statsMetricsAPIResponse `json:"metrics"`
+	} `json:"stats"`
+}
+
+// toEntry converts an API response to a StatsEntry
+func (r *statsAPIResponse) toEntry() StatsEntry {
+	stats := make([]StatsMetricsEntry, len(r.Stats))
+	for i := range r.Stats {
+		s := r.Stats[i]
+		entry := StatsMetricsEntry{
+			Metrics: StatsMetrics(s.Metrics),
+		}
+		if s.Name != "" {
+			entry.Name = &s.Name
+		}
+		if s.Type != "" {
+			entry.Type = &s.Type
+		}
+		stats[i] = entry
+	}
+	return StatsEntry{
+		Date:  r.Date,
+		Stats: stats,
+	}
+}
+
+// statsQuery builds the common query parameters shared by the SendGrid stats endpoints
+func statsQuery(startDate string, endDate, aggregatedBy *string) (url.Values, error) {
+	if startDate == "" {
+		return nil, fmt.Errorf("startDate is required")
+	}
+
+	query := url.Values{}
+	query.Set("start_date", startDate)
+	if endDate != nil && *endDate != "" {
+		query.Set("end_date", *endDate)
+	}
+	if aggregatedBy != nil && *aggregatedBy != "" {
+		switch *aggregatedBy {
+		case "day", "week", "month":
+			query.Set("aggregated_by", *aggregatedBy)
+		default:
+			return nil, fmt.Errorf("aggregatedBy must be one of \"day\", \"week\" or \"month\", got %q", *aggregatedBy)
+		}
+	}
+	return query, nil
+}
+
+// toStatsEntries converts a list of API responses to StatsEntry values
+func toStatsEntries(result []statsAPIResponse) []StatsEntry {
+	entries := make([]StatsEntry, len(result))
+	for i := range result {
+		entries[i] = result[i].toEntry()
+	}
+	return entries
+}
+
+// Invoke retrieves global email statistics for the SendGrid account.
+func (f *GetStats) Invoke(ctx context.Context, req infer.FunctionRequest[GetStatsArgs]) (infer.FunctionResponse[GetStatsResult], error) {
+	input := req.Input
+
+	query, err := statsQuery(input.StartDate, input.EndDate, input.AggregatedBy)
+	if err != nil {
+		return infer.FunctionResponse[GetStatsResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetStatsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/stats
+	var result []statsAPIResponse
+	if err := client.Get(ctx, withQuery("/v3/stats", query), &result); err != nil {
+		return infer.FunctionResponse[GetStatsResult]{}, fmt.Errorf("failed to get stats: %w", err)
+	}
+
+	return infer.FunctionResponse[GetStatsResult]{
+		Output: GetStatsResult{Stats: toStatsEntries(result)},
+	}, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_GetStats(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/stats", r.URL.Path)
+		assert.Equal(t, "2024-01-01", r.URL.Query().Get("start_date"))
+		assert.Equal(t, "2024-01-31", r.URL.Query().Get("end_date"))
+		assert.Equal(t, "week", r.URL.Query().Get("aggregated_by"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{
+				"date": "2024-01-01",
+				"stats": [
+					{
+						"metrics": {
+							"delivered": 100,
+							"opens": 40,
+							"unique_opens": 30,
+							"clicks": 10,
+							"bounces": 2,
+							"spam_reports": 1
+						}
+					}
+				]
+			}
+		]`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := statsQuery("2024-01-01", strPtr("2024-01-31"), strPtr("week"))
+	require.NoError(t, err)
+
+	var result []statsAPIResponse
+	err = client.Get(context.Background(), "/v3/stats?"+query.Encode(), &result)
+	require.NoError(t, err)
+
+	entries := toStatsEntries(result)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "2024-01-01", entries[0].Date)
+	require.Len(t, entries[0].Stats, 1)
+
+	stat := entries[0].Stats[0]
+	assert.Nil(t, stat.Name)
+	assert.Nil(t, stat.Type)
+	assert.Equal(t, 100, stat.Metrics.Delivered)
+	assert.Equal(t, 40, stat.Metrics.Opens)
+	assert.Equal(t, 30, stat.Metrics.UniqueOpens)
+	assert.Equal(t, 10, stat.Metrics.Clicks)
+	assert.Equal(t, 2, stat.Metrics.Bounces)
+	assert.Equal(t, 1, stat.Metrics.SpamReports)
+}
+
+func TestStatsQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		startDate     string
+		endDate       *string
+		aggregatedBy  *string
+		expectError   bool
+		expectedQuery string
+	}{
+		{
+			name:          "start date only",
+			startDate:     "2024-01-01",
+			expectedQuery: "start_date=2024-01-01",
+		},
+		{
+			name:          "all parameters",
+			startDate:     "2024-01-01",
+			endDate:       strPtr("2024-02-01"),
+			aggregatedBy:  strPtr("month"),
+			expectedQuery: "aggregated_by=month&end_date=2024-02-01&start_date=2024-01-01",
+		},
+		{
+			name:        "missing start date",
+			expectError: true,
+		},
+		{
+			name:         "invalid aggregation",
+			startDate:    "2024-01-01",
+			aggregatedBy: strPtr("year"),
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := statsQuery(tt.startDate, tt.endDate, tt.aggregatedBy)
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedQuery, query.Encode())
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetSubuserReputation is the controller for the getSubuserReputation function.
+//
+// This function retrieves the sender reputation of one or more subusers,
+// e.g. to move subusers with a low reputation to another IP pool.
+type GetSubuserReputation struct{}
+
+// GetSubuserReputationArgs are the inputs to the getSubuserReputation function.
+type GetSubuserReputationArgs struct {
+	// Usernames are the subusers to retrieve the reputation of (required)
+	Usernames []string `pulumi:"usernames"`
+}
+
+// GetSubuserReputationResult is the output of the getSubuserReputation function.
+type GetSubuserReputationResult struct {
+	// Reputations lists the reputation of each subuser found
+	Reputations []SubuserReputation `pulumi:"reputations"`
+}
+
+// SubuserReputation is the reputation of a subuser returned by getSubuserReputation.
+type SubuserReputation struct {
+	// Username is the username of the subuser
+	Username string `pulumi:"username"`
+
+	// Reputation is the sender reputation of the subuser, from 0 to 100
+	Reputation float64 `pulumi:"reputation"`
+}
+
+// Annotate provides descriptions for the getSubuserReputation function.
+func (f *GetSubuserReputation) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Retrieves the sender reputation of SendGrid Subusers.\n\n"+
+		"The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. "+
+		"Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.")
+}
+
+// subuserReputationAPIResponse represents the SendGrid API response structure for subuser reputations
+type subuserReputationAPIResponse struct {
+	Username   string  `json:"username"`
+	Reputation float64 `json:"reputation"`
+}
+
+// Invoke retrieves the reputation of the requested subusers.
+func (f *GetSubuserReputation) Invoke(ctx context.Context, req infer.FunctionRequest[GetSubuserReputationArgs]) (infer.FunctionResponse[GetSubuserReputationResult], error) {
+	input := req.Input
+	if len(input.Usernames) == 0 {
+		return infer.FunctionResponse[GetSubuserReputationResult]{}, fmt.Errorf("at least one username is required")
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetSubuserReputationResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	query := url.Values{}
+	for _, username := range input.Usernames {
+		query.Add("usernames", username)
+	}
+
+	// GET /v3/subusers/reputations
+	var result []subuserReputationAPIResponse
+	if err := client.Get(ctx, withQuery("/v3/subusers/reputations", query), &result); err != nil {
+		return infer.FunctionResponse[GetSubuserReputationResult]{}, fmt.Errorf("failed to get subuser reputations: %w", err)
+	}
+
+	reputations := make([]SubuserReputation, len(result))
+	for i, r := range result {
+		reputations[i] = SubuserReputation(r)
+	}
+
+	return infer.FunctionResponse[GetSubuserReputationResult]{
+		Output: GetSubuserReputationResult{Reputations: reputations},
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetSubuserReputation(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/v3/subusers/reputations", req.URL.Path)
+		assert.Equal(t, []string{"tenant-a", "tenant-b"}, req.URL.Query()["usernames"])
+		return fakeResponse(req, http.StatusOK, `[{"username": "tenant-a", "reputation": 99.5}, {"username": "tenant-b", "reputation": 72}]`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getSubuserReputation",
+		Args: property.NewMap(map[string]property.Value{
+			"usernames": property.New([]property.Value{property.New("tenant-a"), property.New("tenant-b")}),
+		}),
+	})
+	require.NoError(t, err)
+
+	reputations := resp.Return.Get("reputations").AsArray()
+	require.Equal(t, 2, reputations.Len())
+	assert.Equal(t, "tenant-b", reputations.Get(1).AsMap().Get("username").AsString())
+	assert.Equal(t, 72.0, reputations.Get(1).AsMap().Get("reputation").AsNumber())
+
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getSubuserReputation",
+		Args:  property.NewMap(map[string]property.Value{"usernames": property.New([]property.Value{})}),
+	})
+	assert.ErrorContains(t, err, "at least one username is required")
+}
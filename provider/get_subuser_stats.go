@@ -0,0 +1,104 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// maxStatsSubusers is the maximum number of subusers SendGrid accepts per stats request
+const maxStatsSubusers = 10
+
+// GetSubuserStats is the controller for the getSubuserStats function.
+//
+// This function retrieves email statistics for one or more subusers
+// over a date range.
+type GetSubuserStats struct{}
+
+// GetSubuserStatsArgs are the inputs to the getSubuserStats function.
+type GetSubuserStatsArgs struct {
+	// Subusers is the list of subuser usernames to retrieve statistics for (required, max 10)
+	Subusers []string `pulumi:"subusers"`
+
+	// StartDate is the first day to retrieve statistics for, in YYYY-MM-DD format (required)
+	StartDate string `pulumi:"startDate"`
+
+	// EndDate is the last day to retrieve statistics for, in YYYY-MM-DD format (optional)
+	EndDate *string `pulumi:"endDate,optional"`
+
+	// AggregatedBy groups the statistics: "day", "week" or "month" (optional, default: day)
+	AggregatedBy *string `pulumi:"aggregatedBy,optional"`
+}
+
+// GetSubuserStatsResult is the output of the getSubuserStats function.
+type GetSubuserStatsResult struct {
+	// Stats is the list of statistics, one entry per aggregation period.
+	// Each entry contains one set of metrics per requested subuser.
+	Stats []StatsEntry `pulumi:"stats"`
+}
+
+// Annotate provides descriptions for the getSubuserStats function.
+func (f *GetSubuserStats) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Retrieves email statistics for specific SendGrid Subusers.\n\n"+
+		"Returns metrics for each requested subuser (up to 10) and each day, week or month "+
+		"in the date range, for per-tenant usage reporting.")
+}
+
+// subuserStatsQuery builds the query parameters for the subuser stats endpoint
+func subuserStatsQuery(args GetSubuserStatsArgs) (url.Values, error) {
+	if len(args.Subusers) == 0 {
+		return nil, fmt.Errorf("at least one subuser is required")
+	}
+	if len(args.Subusers) > maxStatsSubusers {
+		return nil, fmt.Errorf("at most %d subusers can be requested at once, got %d", maxStatsSubusers, len(args.Subusers))
+	}
+
+	query, err := statsQuery(args.StartDate, args.EndDate, args.AggregatedBy)
+	if err != nil {
+		return nil, err
+	}
+	for _, subuser := range args.Subusers {
+		query.Add("subusers", subuser)
+	}
+	return query, nil
+}
+
+// Invoke retrieves email statistics for the requested subusers.
+func (f *GetSubuserStats) Invoke(ctx context.Context, req infer.FunctionRequest[GetSubuserStatsArgs]) (infer.FunctionResponse[GetSubuserStatsResult], error) {
+	query, err := subuserStatsQuery(req.Input)
+	if err != nil {
+		return infer.FunctionResponse[GetSubuserStatsResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetSubuserStatsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/subusers/stats
+	var result []statsAPIResponse
+	if err := client.Get(ctx, withQuery("/v3/subusers/stats", query), &result); err != nil {
+		return infer.FunctionResponse[GetSubuserStatsResult]{}, fmt.Errorf("failed to get subuser stats: %w", err)
+	}
+
+	return infer.FunctionResponse[GetSubuserStatsResult]{
+		Output: GetSubuserStatsResult{Stats: toStatsEntries(result)},
+	}, nil
+}
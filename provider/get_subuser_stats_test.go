@@ -0,0 +1,105 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_GetSubuserStats(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/subusers/stats", r.URL.Path)
+		assert.Equal(t, []string{"tenant-a", "tenant-b"}, r.URL.Query()["subusers"])
+		assert.Equal(t, "month", r.URL.Query().Get("aggregated_by"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{
+				"date": "2024-01-01",
+				"stats": [
+					{"type": "subuser", "name": "tenant-a", "metrics": {"requests": 500, "delivered": 490}},
+					{"type": "subuser", "name": "tenant-b", "metrics": {"requests": 20, "bounces": 3}}
+				]
+			}
+		]`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := subuserStatsQuery(GetSubuserStatsArgs{
+		Subusers:     []string{"tenant-a", "tenant-b"},
+		StartDate:    "2024-01-01",
+		AggregatedBy: strPtr("month"),
+	})
+	require.NoError(t, err)
+
+	var result []statsAPIResponse
+	err = client.Get(context.Background(), "/v3/subusers/stats?"+query.Encode(), &result)
+	require.NoError(t, err)
+
+	entries := toStatsEntries(result)
+	require.Len(t, entries, 1)
+	require.Len(t, entries[0].Stats, 2)
+	assert.Equal(t, "tenant-a", *entries[0].Stats[0].Name)
+	assert.Equal(t, "subuser", *entries[0].Stats[0].Type)
+	assert.Equal(t, 490, entries[0].Stats[0].Metrics.Delivered)
+	assert.Equal(t, 3, entries[0].Stats[1].Metrics.Bounces)
+}
+
+func TestSubuserStatsQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no subusers", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := subuserStatsQuery(GetSubuserStatsArgs{StartDate: "2024-01-01"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one subuser")
+	})
+
+	t.Run("too many subusers", func(t *testing.T) {
+		t.Parallel()
+
+		subusers := make([]string, maxStatsSubusers+1)
+		for i := range subusers {
+			subusers[i] = "tenant"
+		}
+		_, err := subuserStatsQuery(GetSubuserStatsArgs{
+			Subusers:  subusers,
+			StartDate: "2024-01-01",
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at most 10 subusers")
+	})
+
+	t.Run("invalid aggregation", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := subuserStatsQuery(GetSubuserStatsArgs{
+			Subusers:     []string{"tenant-a"},
+			StartDate:    "2024-01-01",
+			AggregatedBy: strPtr("hour"),
+		})
+		require.Error(t, err)
+	})
+}
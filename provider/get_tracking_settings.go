@@ -0,0 +1,178 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetTrackingSettings is the controller for the getTrackingSettings function.
+//
+// This function reads the click, open, subscription and Google Analytics
+// tracking settings of the SendGrid account in a single call for auditing.
+type GetTrackingSettings struct{}
+
+// GetTrackingSettingsArgs are the inputs to the getTrackingSettings function.
+type GetTrackingSettingsArgs struct{}
+
+// GetTrackingSettingsResult is the output of the getTrackingSettings function.
+type GetTrackingSettingsResult struct {
+	// Click is the click tracking setting
+	Click ClickTrackingSetting `pulumi:"click"`
+
+	// Open is the open tracking setting
+	Open OpenTrackingSetting `pulumi:"open"`
+
+	// Subscription is the subscription tracking setting
+	Subscription SubscriptionTrackingSetting `pulumi:"subscription"`
+
+	// GoogleAnalytics is the Google Analytics tracking setting
+	GoogleAnalytics GoogleAnalyticsTrackingSetting `pulumi:"googleAnalytics"`
+}
+
+// ClickTrackingSetting is the click tracking setting returned by getTrackingSettings.
+type ClickTrackingSetting struct {
+	// Enabled is whether links in HTML emails are tracked
+	Enabled bool `pulumi:"enabled"`
+
+	// EnableText is whether links in plain text emails are tracked too
+	EnableText bool `pulumi:"enableText"`
+}
+
+// OpenTrackingSetting is the open tracking setting returned by getTrackingSettings.
+type OpenTrackingSetting struct {
+	// Enabled is whether opens are tracked
+	Enabled bool `pulumi:"enabled"`
+}
+
+// SubscriptionTrackingSetting is the subscription tracking setting returned by getTrackingSettings.
+type SubscriptionTrackingSetting struct {
+	// Enabled is whether an unsubscribe link is added to emails
+	Enabled bool `pulumi:"enabled"`
+
+	// HTMLContent is the HTML of the unsubscribe link
+	HTMLContent string `pulumi:"htmlContent"`
+
+	// PlainContent is the plain text of the unsubscribe link
+	PlainContent string `pulumi:"plainContent"`
+
+	// Replace is the tag in the email content that is replaced by the unsubscribe link
+	Replace string `pulumi:"replace"`
+
+	// Landing is the HTML of the page shown after unsubscribing
+	Landing string `pulumi:"landing"`
+
+	// URL is the custom page shown after unsubscribing, overriding Landing
+	URL string `pulumi:"url"`
+}
+
+// GoogleAnalyticsTrackingSetting is the Google Analytics tracking setting returned by getTrackingSettings.
+type GoogleAnalyticsTrackingSetting struct {
+	// Enabled is whether UTM parameters are added to links
+	Enabled bool `pulumi:"enabled"`
+
+	// UtmSource is the utm_source parameter
+	UtmSource string `pulumi:"utmSource"`
+
+	// UtmMedium is the utm_medium parameter
+	UtmMedium string `pulumi:"utmMedium"`
+
+	// UtmTerm is the utm_term parameter
+	UtmTerm string `pulumi:"utmTerm"`
+
+	// UtmContent is the utm_content parameter
+	UtmContent string `pulumi:"utmContent"`
+
+	// UtmCampaign is the utm_campaign parameter
+	UtmCampaign string `pulumi:"utmCampaign"`
+}
+
+// Annotate provides descriptions for the getTrackingSettings function.
+func (f *GetTrackingSettings) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Gets the click, open, subscription and Google Analytics tracking settings "+
+		"of the SendGrid account.\n\n"+
+		"Useful for auditing tracking across accounts or subusers in a single call.")
+}
+
+// clickTrackingAPIResponse represents the SendGrid API response structure for click tracking
+type clickTrackingAPIResponse struct {
+	Enabled    bool `json:"enabled"`
+	EnableText bool `json:"enable_text"`
+}
+
+// openTrackingAPIResponse represents the SendGrid API response structure for open tracking
+type openTrackingAPIResponse struct {
+	Enabled bool `json:"enabled"`
+}
+
+// subscriptionTrackingAPIResponse represents the SendGrid API response structure for subscription tracking
+type subscriptionTrackingAPIResponse struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`
+	PlainContent string `json:"plain_content"`
+	Replace      string `json:"replace"`
+	Landing      string `json:"landing"`
+	URL          string `json:"url"`
+}
+
+// googleAnalyticsAPIResponse represents the SendGrid API response structure for Google Analytics tracking
+type googleAnalyticsAPIResponse struct {
+	Enabled     bool   `json:"enabled"`
+	UtmSource   string `json:"utm_source"`
+	UtmMedium   string `json:"utm_medium"`
+	UtmTerm     string `json:"utm_term"`
+	UtmContent  string `json:"utm_content"`
+	UtmCampaign string `json:"utm_campaign"`
+}
+
+// Invoke reads the tracking settings of the SendGrid account.
+func (f *GetTrackingSettings) Invoke(ctx context.Context, _ infer.FunctionRequest[GetTrackingSettingsArgs]) (infer.FunctionResponse[GetTrackingSettingsResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetTrackingSettingsResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	var click clickTrackingAPIResponse
+	var open openTrackingAPIResponse
+	var subscription subscriptionTrackingAPIResponse
+	var googleAnalytics googleAnalyticsAPIResponse
+	for _, setting := range []struct {
+		name   string
+		result interface{}
+	}{
+		{"click", &click},
+		{"open", &open},
+		{"subscription", &subscription},
+		{"google_analytics", &googleAnalytics},
+	} {
+		// GET /v3/tracking_settings/{name}
+		if err := client.Get(ctx, "/v3/tracking_settings/"+setting.name, setting.result); err != nil {
+			return infer.FunctionResponse[GetTrackingSettingsResult]{}, fmt.Errorf("failed to get %s tracking settings: %w", setting.name, err)
+		}
+	}
+
+	return infer.FunctionResponse[GetTrackingSettingsResult]{
+		Output: GetTrackingSettingsResult{
+			Click:           ClickTrackingSetting(click),
+			Open:            OpenTrackingSetting(open),
+			Subscription:    SubscriptionTrackingSetting(subscription),
+			GoogleAnalytics: GoogleAnalyticsTrackingSetting(googleAnalytics),
+		},
+	}, nil
+}
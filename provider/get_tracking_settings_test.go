@@ -0,0 +1,65 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetTrackingSettings(t *testing.T) {
+	t.Parallel()
+
+	responses := map[string]string{
+		"/v3/tracking_settings/click":        `{"enabled": true, "enable_text": false}`,
+		"/v3/tracking_settings/open":         `{"enabled": true}`,
+		"/v3/tracking_settings/subscription": `{"enabled": true, "html_content": "<a href=\"<% %>\">Unsubscribe</a>", "plain_content": "Unsubscribe: <% %>", "replace": "[unsubscribe]", "landing": "", "url": "https://example.com/unsubscribed"}`,
+		"/v3/tracking_settings/google_analytics": `{"enabled": false, "utm_source": "sendgrid", "utm_medium": "email", "utm_term": "",
+			"utm_content": "", "utm_campaign": "newsletter"}`,
+	}
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		if body, ok := responses[req.URL.Path]; ok {
+			return fakeResponse(req, http.StatusOK, body), nil
+		}
+		return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "not found"}]}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getTrackingSettings",
+		Args:  property.NewMap(nil),
+	})
+	require.NoError(t, err)
+
+	click := resp.Return.Get("click").AsMap()
+	assert.True(t, click.Get("enabled").AsBool())
+	assert.False(t, click.Get("enableText").AsBool())
+	assert.True(t, resp.Return.Get("open").AsMap().Get("enabled").AsBool())
+
+	subscription := resp.Return.Get("subscription").AsMap()
+	assert.Equal(t, "[unsubscribe]", subscription.Get("replace").AsString())
+	assert.Equal(t, "https://example.com/unsubscribed", subscription.Get("url").AsString())
+
+	googleAnalytics := resp.Return.Get("googleAnalytics").AsMap()
+	assert.False(t, googleAnalytics.Get("enabled").AsBool())
+	assert.Equal(t, "newsletter", googleAnalytics.Get("utmCampaign").AsString())
+}
@@ -0,0 +1,100 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetUsage is the controller for the getUsage function.
+//
+// This function returns the email credits of the SendGrid account, so
+// usage-limit alerts can be set relative to the current plan size.
+type GetUsage struct{}
+
+// GetUsageArgs are the inputs to the getUsage function.
+type GetUsageArgs struct{}
+
+// GetUsageResult is the output of the getUsage function.
+type GetUsageResult struct {
+	// Total is the number of credits in the current period
+	Total int `pulumi:"total"`
+
+	// Used is the number of credits used in the current period
+	Used int `pulumi:"used"`
+
+	// Remain is the number of credits left in the current period
+	Remain int `pulumi:"remain"`
+
+	// Overage is the number of emails sent beyond the credits of the current period
+	Overage int `pulumi:"overage"`
+
+	// LastReset is the date the credits were last reset, e.g. "2026-10-01"
+	LastReset string `pulumi:"lastReset"`
+
+	// NextReset is the date the credits are next reset
+	NextReset string `pulumi:"nextReset"`
+
+	// ResetFrequency is how often the credits are reset, e.g. "monthly"
+	ResetFrequency string `pulumi:"resetFrequency"`
+}
+
+// Annotate provides descriptions for the getUsage function.
+func (f *GetUsage) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Gets the email credits of the SendGrid account for the current period.\n\n"+
+		"Useful for setting the percentage of a usage_limit Alert relative to the plan size, "+
+		"e.g. to be notified when a fixed number of emails remains.")
+}
+
+// creditsAPIResponse represents the SendGrid API response structure for account credits
+type creditsAPIResponse struct {
+	Total          int    `json:"total"`
+	Used           int    `json:"used"`
+	Remain         int    `json:"remain"`
+	Overage        int    `json:"overage"`
+	LastReset      string `json:"last_reset"`
+	NextReset      string `json:"next_reset"`
+	ResetFrequency string `json:"reset_frequency"`
+}
+
+// Invoke gets the credits of the SendGrid account.
+func (f *GetUsage) Invoke(ctx context.Context, _ infer.FunctionRequest[GetUsageArgs]) (infer.FunctionResponse[GetUsageResult], error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[GetUsageResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/user/credits
+	var result creditsAPIResponse
+	if err := client.Get(ctx, "/v3/user/credits", &result); err != nil {
+		return infer.FunctionResponse[GetUsageResult]{}, fmt.Errorf("failed to get account credits: %w", err)
+	}
+
+	return infer.FunctionResponse[GetUsageResult]{
+		Output: GetUsageResult{
+			Total:          result.Total,
+			Used:           result.Used,
+			Remain:         result.Remain,
+			Overage:        result.Overage,
+			LastReset:      result.LastReset,
+			NextReset:      result.NextReset,
+			ResetFrequency: result.ResetFrequency,
+		},
+	}, nil
+}
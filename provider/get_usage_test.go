@@ -0,0 +1,50 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetUsage(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/v3/user/credits", req.URL.Path)
+		return fakeResponse(req, http.StatusOK, `{"remain": 35000, "total": 50000, "overage": 0, "used": 15000,
+			"last_reset": "2026-10-01", "next_reset": "2026-11-01", "reset_frequency": "monthly"}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getUsage",
+		Args:  property.NewMap(nil),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 50000.0, resp.Return.Get("total").AsNumber())
+	assert.Equal(t, 15000.0, resp.Return.Get("used").AsNumber())
+	assert.Equal(t, 35000.0, resp.Return.Get("remain").AsNumber())
+	assert.Equal(t, 0.0, resp.Return.Get("overage").AsNumber())
+	assert.Equal(t, "2026-11-01", resp.Return.Get("nextReset").AsString())
+	assert.Equal(t, "monthly", resp.Return.Get("resetFrequency").AsString())
+}
@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"net/url"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // GlobalSuppression is the controller for the SendGrid Global Suppression resource.
@@ -32,7 +34,11 @@ type GlobalSuppression struct{}
 // GlobalSuppressionArgs are the inputs to the GlobalSuppression resource.
 type GlobalSuppressionArgs struct {
 	// Email is the email address to add to the global suppression list (required)
-	Email string `pulumi:"email"`
+	Email string `pulumi:"email" provider:"replaceOnChanges"`
+
+	// DeletionProtection prevents the suppression from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // GlobalSuppressionState is the state of the GlobalSuppression resource.
@@ -54,19 +60,38 @@ func (g *GlobalSuppression) Annotate(annotator infer.Annotator) {
 		"of all communications, or for test addresses that should never receive emails.")
 }
 
+// Check validates the GlobalSuppression inputs.
+func (g *GlobalSuppression) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[GlobalSuppressionArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[GlobalSuppressionArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[GlobalSuppressionArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[GlobalSuppressionArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid GlobalSuppressionArgs
+func (args *GlobalSuppressionArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.email("email", args.Email)
+	return v.failures
+}
+
 // Create adds an email address to the global suppression list.
 func (g *GlobalSuppression) Create(ctx context.Context, req infer.CreateRequest[GlobalSuppressionArgs]) (infer.CreateResponse[GlobalSuppressionState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := GlobalSuppressionState{
 			GlobalSuppressionArgs: input,
 			CreatedAt:             0,
 		}
 		return infer.CreateResponse[GlobalSuppressionState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -160,6 +185,9 @@ func (g *GlobalSuppression) Read(ctx context.Context, req infer.ReadRequest[Glob
 		Email: id,
 	}
 
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	inputs.DeletionProtection = req.Inputs.DeletionProtection
+
 	return infer.ReadResponse[GlobalSuppressionArgs, GlobalSuppressionState]{
 		ID:     id,
 		Inputs: inputs,
@@ -167,18 +195,26 @@ func (g *GlobalSuppression) Read(ctx context.Context, req infer.ReadRequest[Glob
 	}, nil
 }
 
-// Update is not supported for global suppressions since there's only one field (email).
-// Changing the email would require deleting and recreating.
-func (g *GlobalSuppression) Update(_ context.Context, _ infer.UpdateRequest[GlobalSuppressionArgs, GlobalSuppressionState]) (infer.UpdateResponse[GlobalSuppressionState], error) {
-	// Global suppressions don't support updates - if the email changes, it's a replace
-	// The Pulumi SDK handles this by checking for "replaceOnChanges" behavior
-	return infer.UpdateResponse[GlobalSuppressionState]{}, fmt.Errorf("global suppressions cannot be updated - email changes require replacement")
+// Update only records changes to deletionProtection, as SendGrid has nothing to update.
+// Email is marked replaceOnChanges, so changing it replaces the suppression instead.
+func (g *GlobalSuppression) Update(_ context.Context, req infer.UpdateRequest[GlobalSuppressionArgs, GlobalSuppressionState]) (infer.UpdateResponse[GlobalSuppressionState], error) {
+	if req.Inputs.Email != req.State.Email {
+		return infer.UpdateResponse[GlobalSuppressionState]{}, fmt.Errorf("global suppressions cannot be updated - email changes require replacement")
+	}
+
+	state := req.State
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	return infer.UpdateResponse[GlobalSuppressionState]{Output: state}, nil
 }
 
 // Delete removes an email address from the global suppression list.
 func (g *GlobalSuppression) Delete(ctx context.Context, req infer.DeleteRequest[GlobalSuppressionState]) (infer.DeleteResponse, error) {
 	id := req.ID // id is the email address
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "global suppression", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
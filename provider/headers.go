@@ -0,0 +1,74 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// defaultUserAgent returns the User-Agent sent with every request
+func defaultUserAgent() string {
+	version := Version
+	if version == "" {
+		version = "dev"
+	}
+	return "pulumi-resource-" + Name + "/" + version
+}
+
+// WithUserAgentSuffix appends a suffix to the User-Agent header, e.g. to
+// attribute traffic to a team at an egress gateway
+func WithUserAgentSuffix(suffix string) ClientOption {
+	return func(c *SendGridClient) {
+		if suffix = strings.TrimSpace(suffix); suffix != "" {
+			c.userAgent = defaultUserAgent() + " " + suffix
+		}
+	}
+}
+
+// WithHeaders adds extra headers to every request
+func WithHeaders(headers map[string]string) ClientOption {
+	return func(c *SendGridClient) {
+		if c.headers == nil {
+			c.headers = http.Header{}
+		}
+		for name, value := range headers {
+			c.headers.Set(name, value)
+		}
+	}
+}
+
+// WithOnBehalfOf sends every request on behalf of the given subuser, so the
+// parent account's API key manages the subuser's resources
+func WithOnBehalfOf(subuser string) ClientOption {
+	return WithHeaders(map[string]string{"On-Behalf-Of": subuser})
+}
+
+// validateHeaders rejects extra headers that would interfere with requests the provider builds itself
+func validateHeaders(headers map[string]string) error {
+	for name := range headers {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization", "Content-Type", "User-Agent":
+			return fmt.Errorf("headers must not set %q; use apiKey or userAgentSuffix instead", name)
+		case "On-Behalf-Of":
+			return fmt.Errorf("headers must not set %q; use onBehalfOf instead", name)
+		}
+		if name == "" || strings.ContainsAny(name, " \t\r\n:") {
+			return fmt.Errorf("headers contains an invalid header name %q", name)
+		}
+	}
+	return nil
+}
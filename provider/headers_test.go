@@ -0,0 +1,80 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_Headers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default user agent", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.True(t, strings.HasPrefix(r.Header.Get("User-Agent"), "pulumi-resource-sendgrid/"))
+			w.WriteHeader(http.StatusOK)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+		require.NoError(t, client.Get(context.Background(), "/v3/scopes", nil))
+	})
+
+	t.Run("user agent suffix and extra headers", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, defaultUserAgent()+" platform-team/1.2", r.Header.Get("User-Agent"))
+			assert.Equal(t, "email-infra", r.Header.Get("X-Team"))
+			assert.Equal(t, "cost-123", r.Header.Get("X-Cost-Center"))
+			assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusOK)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL,
+			WithUserAgentSuffix("platform-team/1.2"),
+			WithHeaders(map[string]string{"x-team": "email-infra", "X-Cost-Center": "cost-123"}))
+		require.NoError(t, client.Get(context.Background(), "/v3/scopes", nil))
+	})
+
+	t.Run("on behalf of a subuser", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "tenant-a", r.Header.Get("On-Behalf-Of"))
+			w.WriteHeader(http.StatusOK)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL, WithOnBehalfOf("tenant-a"))
+		require.NoError(t, client.Get(context.Background(), "/v3/scopes", nil))
+	})
+}
+
+func TestValidateHeaders(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, validateHeaders(map[string]string{"X-Team": "email-infra"}))
+
+	for _, name := range []string{"authorization", "Content-Type", "User-Agent", "on-behalf-of", "Bad Header", ""} {
+		assert.Error(t, validateHeaders(map[string]string{name: "value"}), name)
+	}
+}
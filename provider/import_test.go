@@ -0,0 +1,271 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// TestImport reads every resource with only an ID and no prior state, the way
+// `pulumi import` does, and checks the inputs are recovered from the API.
+func TestImport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		resource string
+		id       string
+		// responses maps request paths to JSON bodies; anything else is a 404
+		responses map[string]string
+		input     string
+		want      string
+	}{
+		{
+			resource:  "AccountUsername",
+			id:        "username",
+			responses: map[string]string{"/v3/user/username": `{"username": "parent", "user_id": 1}`},
+			input:     "username",
+			want:      "parent",
+		},
+		{
+			resource:  "Alert",
+			id:        "42",
+			responses: map[string]string{"/v3/alerts/42": `{"id": 42, "type": "usage_limit", "email_to": "ops@example.com", "percentage": 90}`},
+			input:     "emailTo",
+			want:      "ops@example.com",
+		},
+		{
+			resource:  "ApiKey",
+			id:        "key-1",
+			responses: map[string]string{"/v3/api_keys/key-1": `{"api_key_id": "key-1", "name": "ci", "scopes": ["mail.send"]}`},
+			input:     "name",
+			want:      "ci",
+		},
+		{
+			resource:  "ContactDbList",
+			id:        "12",
+			responses: map[string]string{"/v3/contactdb/lists/12": `{"id": 12, "name": "legacy-newsletter", "recipient_count": 3}`},
+			input:     "name",
+			want:      "legacy-newsletter",
+		},
+		{
+			resource: "ContactDbRecipient",
+			id:       "am9obkBleGFtcGxlLmNvbQ==",
+			responses: map[string]string{
+				"/v3/contactdb/recipients/am9obkBleGFtcGxlLmNvbQ==":       `{"id": "am9obkBleGFtcGxlLmNvbQ==", "email": "john@example.com"}`,
+				"/v3/contactdb/recipients/am9obkBleGFtcGxlLmNvbQ==/lists": `{"lists": [{"id": 12, "name": "legacy-newsletter"}]}`,
+			},
+			input: "email",
+			want:  "john@example.com",
+		},
+		{
+			resource:  "DomainAuthentication",
+			id:        "7",
+			responses: map[string]string{"/v3/whitelabel/domains/7": `{"id": 7, "domain": "example.com", "subdomain": "em", "valid": true}`},
+			input:     "domain",
+			want:      "example.com",
+		},
+		{
+			resource:  "EventWebhook",
+			id:        "wh-1",
+			responses: map[string]string{"/v3/user/webhooks/event/settings/wh-1": `{"id": "wh-1", "url": "https://hooks.example.com", "enabled": true}`},
+			input:     "url",
+			want:      "https://hooks.example.com",
+		},
+		{
+			resource:  "EventWebhookSignature",
+			id:        "wh-1",
+			responses: map[string]string{"/v3/user/webhooks/event/settings/signed/wh-1": `{"id": "wh-1", "public_key": "public-key"}`},
+			input:     "webhookId",
+			want:      "wh-1",
+		},
+		{
+			resource:  "GlobalSuppression",
+			id:        "gone@example.com",
+			responses: map[string]string{"/v3/asm/suppressions/global/gone@example.com": `{"recipient_email": "gone@example.com"}`},
+			input:     "email",
+			want:      "gone@example.com",
+		},
+		{
+			resource:  "IpPool",
+			id:        "marketing",
+			responses: map[string]string{"/v3/ips/pools/marketing": `{"pool_name": "marketing", "ips": []}`},
+			input:     "name",
+			want:      "marketing",
+		},
+		{
+			resource:  "LegacyTemplateMailSetting",
+			id:        "template",
+			responses: map[string]string{"/v3/mail_settings/template": `{"enabled": true, "html_content": "<div><% body %></div>"}`},
+			input:     "htmlContent",
+			want:      "<div><% body %></div>",
+		},
+		{
+			resource:  "LinkBranding",
+			id:        "9",
+			responses: map[string]string{"/v3/whitelabel/links/9": `{"id": 9, "domain": "example.com", "subdomain": "links", "valid": true}`},
+			input:     "domain",
+			want:      "example.com",
+		},
+		{
+			resource:  "NewRelicPartnerSetting",
+			id:        "new_relic",
+			responses: map[string]string{"/v3/partner_settings/new_relic": `{"enabled": true, "license_key": "nr-license", "enable_subuser_statistics": true}`},
+			input:     "licenseKey",
+			want:      "nr-license",
+		},
+		{
+			resource:  "ScheduledSend",
+			id:        "batch-1",
+			responses: map[string]string{"/v3/user/scheduled_sends/batch-1": `[{"batch_id": "batch-1", "status": "pause"}]`},
+			input:     "status",
+			want:      "pause",
+		},
+		{
+			resource:  "Subuser",
+			id:        "tenant-a",
+			responses: map[string]string{"/v3/subusers/tenant-a": `{"id": 3, "username": "tenant-a", "email": "tenant-a@example.com", "disabled": false}`},
+			input:     "username",
+			want:      "tenant-a",
+		},
+		{
+			resource:  "SubuserDomainAssociation",
+			id:        "tenant-a",
+			responses: map[string]string{"/v3/whitelabel/domains/subuser": `{"id": 11, "domain": "example.com"}`},
+			input:     "username",
+			want:      "tenant-a",
+		},
+		{
+			resource:  "SubuserLinkAssociation",
+			id:        "tenant-a",
+			responses: map[string]string{"/v3/whitelabel/links/subuser": `{"id": 22, "domain": "example.com"}`},
+			input:     "username",
+			want:      "tenant-a",
+		},
+		{
+			resource:  "Teammate",
+			id:        "jdoe",
+			responses: map[string]string{"/v3/teammates/jdoe": `{"username": "jdoe", "email": "jdoe@example.com", "is_admin": true}`},
+			input:     "email",
+			want:      "jdoe@example.com",
+		},
+		{
+			resource: "Teammate",
+			id:       "invitee@example.com",
+			responses: map[string]string{
+				"/v3/teammates/pending": `{"result": [{"email": "invitee@example.com", "scopes": ["mail.send"], "is_admin": false, "token": "tok"}]}`,
+			},
+			input: "email",
+			want:  "invitee@example.com",
+		},
+		{
+			resource:  "Template",
+			id:        "d-123",
+			responses: map[string]string{"/v3/templates/d-123": `{"id": "d-123", "name": "welcome", "generation": "dynamic"}`},
+			input:     "name",
+			want:      "welcome",
+		},
+		{
+			resource: "TemplateVersion",
+			id:       "d-123/v-456",
+			responses: map[string]string{
+				"/v3/templates/d-123/versions/v-456": `{"id": "v-456", "template_id": "d-123", "name": "v1", "active": 1, "editor": "code"}`,
+			},
+			input: "templateId",
+			want:  "d-123",
+		},
+		{
+			resource:  "UnsubscribeGroup",
+			id:        "11",
+			responses: map[string]string{"/v3/asm/groups/11": `{"id": 11, "name": "Newsletter", "description": "Weekly news"}`},
+			input:     "name",
+			want:      "Newsletter",
+		},
+		{
+			resource: "VerifiedSender",
+			id:       "5",
+			responses: map[string]string{
+				"/v3/verified_senders": `{"results": [{"id": 5, "nickname": "support", "from_email": "support@example.com", "from_name": "Support",` +
+					` "reply_to": "support@example.com", "address": "1 Main St", "city": "Denver", "country": "USA", "verified": true}]}`,
+			},
+			input: "fromEmail",
+			want:  "support@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resource+"/"+tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method, "import must not modify resources")
+				if body, ok := tt.responses[req.URL.Path]; ok {
+					return fakeResponse(req, http.StatusOK, body), nil
+				}
+				return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "not found"}]}`), nil
+			})
+
+			server := newTestServer(t, transport, nil)
+
+			resp, err := server.Read(p.ReadRequest{
+				ID:  tt.id,
+				Urn: resource.NewURN("test", "sendgrid", "", tokens.Type("sendgrid:index:"+tt.resource), "imported"),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.id, resp.ID)
+			assert.Equal(t, tt.want, resp.Inputs.Get(tt.input).AsString())
+		})
+	}
+}
+
+func TestTemplateVersionPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		templateID string
+		id         string
+		want       string
+		wantErr    bool
+	}{
+		{name: "version ID with template from state", templateID: "d-1", id: "v-1", want: "/v3/templates/d-1/versions/v-1"},
+		{name: "composite import ID", id: "d-1/v-1", want: "/v3/templates/d-1/versions/v-1"},
+		{name: "composite ID wins over state", templateID: "d-old", id: "d-1/v-1", want: "/v3/templates/d-1/versions/v-1"},
+		{name: "version ID without template", id: "v-1", wantErr: true},
+		{name: "missing version", id: "d-1/", wantErr: true},
+		{name: "missing template", id: "/v-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := templateVersionPath(tt.templateID, tt.id)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
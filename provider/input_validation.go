@@ -0,0 +1,290 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// inputValidator collects field-scoped check failures for a resource's inputs,
+// so that mistakes are reported during preview instead of by the API mid-update.
+// Inputs that are not known yet, because they depend on outputs of resources that
+// have not been created, are skipped.
+type inputValidator struct {
+	inputs   property.Map
+	failures []p.CheckFailure
+}
+
+// newInputValidator returns a validator for the raw inputs passed to Check
+func newInputValidator(inputs property.Map) *inputValidator {
+	return &inputValidator{inputs: inputs}
+}
+
+// fail records a failure for the input
+func (v *inputValidator) fail(key, format string, args ...any) {
+	v.failures = append(v.failures, p.CheckFailure{
+		Property: key,
+		Reason:   key + " " + fmt.Sprintf(format, args...),
+	})
+}
+
+// known reports whether the input's value is available for validation
+func (v *inputValidator) known(key string) bool {
+	return inputsKnown(v.inputs, key)
+}
+
+// required checks that a required string input is not empty
+func (v *inputValidator) required(key, value string) {
+	if v.known(key) && strings.TrimSpace(value) == "" {
+		v.fail(key, "must not be empty")
+	}
+}
+
+// maxLength checks that a string input has at most limit characters
+func (v *inputValidator) maxLength(key, value string, limit int) {
+	if n := utf8.RuneCountInString(value); v.known(key) && n > limit {
+		v.fail(key, "must be at most %d characters (got %d)", limit, n)
+	}
+}
+
+// maxBytes checks that a string input is at most limit bytes once UTF-8 encoded,
+// for limits SendGrid applies to the size of content rather than its characters
+func (v *inputValidator) maxBytes(key, value string, limit int) {
+	if v.known(key) && len(value) > limit {
+		v.fail(key, "must be at most %d bytes (got %d)", limit, len(value))
+	}
+}
+
+// oneOf checks that a string input is one of the allowed values
+func (v *inputValidator) oneOf(key, value string, allowed ...string) {
+	if v.known(key) && !slices.Contains(allowed, value) {
+		v.fail(key, "must be one of: %s (got %q)", strings.Join(allowed, ", "), value)
+	}
+}
+
+// inRange checks that a number input is between lowest and highest inclusive
+func (v *inputValidator) inRange(key string, value, lowest, highest int) {
+	if v.known(key) && (value < lowest || value > highest) {
+		v.fail(key, "must be between %d and %d (got %d)", lowest, highest, value)
+	}
+}
+
+// positive checks that a number input, such as an ID, is greater than zero
+func (v *inputValidator) positive(key string, value int) {
+	if v.known(key) && value <= 0 {
+		v.fail(key, "must be greater than 0 (got %d)", value)
+	}
+}
+
+// email checks that a string input is a bare email address
+func (v *inputValidator) email(key, value string) {
+	if !v.known(key) {
+		return
+	}
+	if problem := emailAddressProblem(value); problem != "" {
+		v.fail(key, "must be a valid email address (got %q): %s", value, problem)
+	}
+}
+
+// emailAddressProblem describes why a string is not a bare email address as defined
+// by RFC 5322, within the lengths RFC 5321 allows, or returns "" if it is one
+func emailAddressProblem(value string) string {
+	if strings.TrimSpace(value) != value {
+		return "leading and trailing spaces are not allowed"
+	}
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
+		return "expected local-part@domain"
+	}
+	// Quoted local parts, e.g. "john doe"@example.com, are parsed without their quotes
+	if addr.Name != "" || strings.Trim((&mail.Address{Address: addr.Address}).String(), "<>") != value {
+		return "only the address is allowed, without a display name, angle brackets or comments"
+	}
+	local := value[:strings.LastIndex(value, "@")]
+	if len(local) > 64 {
+		return fmt.Sprintf("the part before @ is %d bytes, more than 64", len(local))
+	}
+	if len(value) > 254 {
+		return fmt.Sprintf("the address is %d bytes, more than 254", len(value))
+	}
+	return ""
+}
+
+// password checks that a string input meets SendGrid's password policy. The value is
+// secret, so failures describe the problem without repeating it.
+func (v *inputValidator) password(key, value, username string) {
+	if !v.known(key) || value == "" {
+		return
+	}
+	if problem := passwordProblem(value, username); problem != "" {
+		v.fail(key, "does not meet the SendGrid password policy: %s", problem)
+	}
+}
+
+// passwordProblem describes why a password would be rejected by SendGrid, which
+// requires 8 to 128 characters including a letter and a digit, and a password that
+// differs from the username, or returns "" if it would be accepted
+func passwordProblem(value, username string) string {
+	if n := utf8.RuneCountInString(value); n < 8 || n > 128 {
+		return fmt.Sprintf("it must be 8 to 128 characters (got %d)", n)
+	}
+	if !strings.ContainsFunc(value, unicode.IsLetter) {
+		return "it must contain at least one letter"
+	}
+	if !strings.ContainsFunc(value, unicode.IsDigit) {
+		return "it must contain at least one digit"
+	}
+	if username != "" && strings.EqualFold(value, username) {
+		return "it must differ from the username"
+	}
+	return ""
+}
+
+// domainName checks that a non-empty string input is a DNS name of at least minLabels
+// labels, e.g. 2 for a domain such as example.com or 1 for a subdomain such as em
+func (v *inputValidator) domainName(key, value string, minLabels int) {
+	if !v.known(key) || strings.TrimSpace(value) == "" {
+		return
+	}
+	if problem := domainNameProblem(value, minLabels); problem != "" {
+		v.fail(key, "must be a domain name (got %q): %s", value, problem)
+	}
+}
+
+// domainNameProblem describes why a string is not a DNS name of at least minLabels
+// labels, or returns "" if it is one. Internationalized names are checked in the
+// ASCII form DNS uses, in which every label has at most 63 characters.
+func domainNameProblem(value string, minLabels int) string {
+	if strings.Contains(value, "://") || strings.ContainsAny(value, "/:?#@ ") {
+		return "only the name is allowed, without a scheme, port, path or spaces"
+	}
+	if strings.HasSuffix(value, ".") {
+		return "the trailing dot is not allowed"
+	}
+	labels := strings.Split(value, ".")
+	if len(labels) < minLabels {
+		return fmt.Sprintf("expected at least %d dot-separated labels", minLabels)
+	}
+	for _, label := range labels {
+		switch {
+		case label == "":
+			return "labels must not be empty"
+		case strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-"):
+			return fmt.Sprintf("the label %q starts or ends with a hyphen", label)
+		case strings.IndexFunc(label, func(r rune) bool {
+			return r < utf8.RuneSelf && !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-')
+		}) >= 0:
+			return fmt.Sprintf("the label %q may only contain letters, digits and hyphens", label)
+		}
+	}
+
+	ascii, err := idna.Lookup.ToASCII(value)
+	if err != nil {
+		return fmt.Sprintf("it is not a valid internationalized name (%v)", err)
+	}
+	for _, label := range strings.Split(ascii, ".") {
+		if len(label) > 63 {
+			return fmt.Sprintf("the label %q is %d characters, more than 63", label, len(label))
+		}
+	}
+	if len(ascii) > 253 {
+		return fmt.Sprintf("the name is %d characters, more than 253", len(ascii))
+	}
+	return ""
+}
+
+// ipAddresses checks that every entry of a list input is an IP address
+func (v *inputValidator) ipAddresses(key string, ips []string) {
+	if !v.known(key) {
+		return
+	}
+	for i, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			v.failures = append(v.failures, p.CheckFailure{
+				Property: fmt.Sprintf("%s[%d]", key, i),
+				Reason:   fmt.Sprintf("%s must contain IP addresses (got %q)", key, ip),
+			})
+		}
+	}
+}
+
+// httpURL checks that a string input is an absolute http or https URL
+func (v *inputValidator) httpURL(key, value string) {
+	if !v.known(key) {
+		return
+	}
+	if u, err := url.Parse(value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.fail(key, "must be an absolute http or https URL (got %q)", value)
+	}
+}
+
+// jsonObject checks that a string input holds a JSON object
+func (v *inputValidator) jsonObject(key, value string) {
+	if !v.known(key) {
+		return
+	}
+	if problem := jsonObjectProblem(value); problem != "" {
+		v.fail(key, "must be a JSON object: %s", problem)
+	}
+}
+
+// jsonObjectProblem describes why a string is not a JSON object, with the line and
+// column of a syntax error, or returns "" if it is one
+func jsonObjectProblem(value string) string {
+	var object map[string]any
+	err := json.Unmarshal([]byte(value), &object)
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		// The offset is past the invalid character, or at the end of truncated input
+		offset := syntaxErr.Offset
+		if strings.HasPrefix(syntaxErr.Error(), "invalid character") {
+			offset--
+		}
+		line, column := textPosition(value, offset)
+		return fmt.Sprintf("%v at line %d, column %d", err, line, column)
+	case errors.As(err, &typeErr) && typeErr.Field == "":
+		return fmt.Sprintf("got a JSON %s", typeErr.Value)
+	case err != nil:
+		return err.Error()
+	case object == nil:
+		return "got null"
+	}
+	return ""
+}
+
+// textPosition converts a byte offset into text, such as the offset of a JSON
+// syntax error, into a 1-based line and column
+func textPosition(text string, offset int64) (line, column int) {
+	before := text[:min(max(offset, 0), int64(len(text)))]
+	line = strings.Count(before, "\n") + 1
+	column = utf8.RuneCountInString(before[strings.LastIndex(before, "\n")+1:]) + 1
+	return line, column
+}
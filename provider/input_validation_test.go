@@ -0,0 +1,508 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestCheck_InputValidation(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, nil, &calls)
+
+	tests := []struct {
+		name   string
+		typ    string
+		inputs map[string]property.Value
+		// failing lists the properties expected to fail, in order
+		failing []string
+	}{
+		{
+			name: "valid usage alert",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":       property.New("usage_limit"),
+				"emailTo":    property.New("ops@example.com"),
+				"percentage": property.New(90.0),
+			},
+		},
+		{
+			name: "alert type and email",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":    property.New("weekly_digest"),
+				"emailTo": property.New("ops"),
+			},
+			failing: []string{"type", "emailTo"},
+		},
+		{
+			name: "alert percentage out of range",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":       property.New("usage_limit"),
+				"emailTo":    property.New("ops@example.com"),
+				"percentage": property.New(150.0),
+			},
+			failing: []string{"percentage"},
+		},
+		{
+			name: "usage alert without percentage",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":    property.New("usage_limit"),
+				"emailTo": property.New("ops@example.com"),
+			},
+			failing: []string{"percentage"},
+		},
+		{
+			name: "stats alert with invalid frequency",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":      property.New("stats_notification"),
+				"emailTo":   property.New("ops@example.com"),
+				"frequency": property.New("hourly"),
+			},
+			failing: []string{"frequency"},
+		},
+		{
+			name: "stats alert without frequency",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":    property.New("stats_notification"),
+				"emailTo": property.New("ops@example.com"),
+			},
+			failing: []string{"frequency"},
+		},
+		{
+			name: "usage alert with frequency",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":       property.New("usage_limit"),
+				"emailTo":    property.New("ops@example.com"),
+				"percentage": property.New(90.0),
+				"frequency":  property.New("daily"),
+			},
+			failing: []string{"frequency"},
+		},
+		{
+			name: "stats alert with percentage",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":       property.New("stats_notification"),
+				"emailTo":    property.New("ops@example.com"),
+				"frequency":  property.New("weekly"),
+				"percentage": property.New(90.0),
+			},
+			failing: []string{"percentage"},
+		},
+		{
+			name: "unknown inputs are not validated",
+			typ:  "Alert",
+			inputs: map[string]property.Value{
+				"type":    property.New(property.Computed),
+				"emailTo": property.New(property.Computed),
+			},
+		},
+		{
+			name:    "api key without name",
+			typ:     "ApiKey",
+			inputs:  map[string]property.Value{"name": property.New(" ")},
+			failing: []string{"name"},
+		},
+		{
+			name: "domain authentication settings",
+			typ:  "DomainAuthentication",
+			inputs: map[string]property.Value{
+				"domain":             property.New("example.com"),
+				"ips":                property.New([]property.Value{property.New("192.0.2.1"), property.New("not-an-ip")}),
+				"customDkimSelector": property.New("toolong"),
+				"region":             property.New("us"),
+			},
+			failing: []string{"ips[1]", "customDkimSelector", "region"},
+		},
+		{
+			name: "domain authentication domain with scheme",
+			typ:  "DomainAuthentication",
+			inputs: map[string]property.Value{
+				"domain":    property.New("https://example.com"),
+				"subdomain": property.New("mail_1"),
+			},
+			failing: []string{"domain", "subdomain"},
+		},
+		{
+			name: "domain authentication DKIM selector charset",
+			typ:  "DomainAuthentication",
+			inputs: map[string]property.Value{
+				"domain":             property.New("example.com"),
+				"customDkimSelector": property.New("s-1"),
+			},
+			failing: []string{"customDkimSelector"},
+		},
+		{
+			name:    "event webhook URL",
+			typ:     "EventWebhook",
+			inputs:  map[string]property.Value{"url": property.New("hooks.example.com/sendgrid")},
+			failing: []string{"url"},
+		},
+		{
+			name: "event webhook incomplete OAuth settings",
+			typ:  "EventWebhook",
+			inputs: map[string]property.Value{
+				"url":           property.New("https://hooks.example.com/sendgrid"),
+				"oauthClientId": property.New("client"),
+				"oauthTokenUrl": property.New("auth.example.com/token"),
+			},
+			failing: []string{"oauthTokenUrl", "oauthClientSecret"},
+		},
+		{
+			name:    "global suppression email",
+			typ:     "GlobalSuppression",
+			inputs:  map[string]property.Value{"email": property.New("Someone <someone@example.com>")},
+			failing: []string{"email"},
+		},
+		{
+			name:    "ip pool name too long",
+			typ:     "IpPool",
+			inputs:  map[string]property.Value{"name": property.New("pool-name-that-is-far-too-long-to-be-accepted-by-sendgrid-ip-pools")},
+			failing: []string{"name"},
+		},
+		{
+			name: "link branding region",
+			typ:  "LinkBranding",
+			inputs: map[string]property.Value{
+				"domain": property.New("example.com"),
+				"region": property.New("EU"),
+			},
+			failing: []string{"region"},
+		},
+		{
+			name: "link branding single-label domain",
+			typ:  "LinkBranding",
+			inputs: map[string]property.Value{
+				"domain":    property.New("localhost"),
+				"subdomain": property.New("links"),
+			},
+			failing: []string{"domain"},
+		},
+		{
+			name: "subuser settings",
+			typ:  "Subuser",
+			inputs: map[string]property.Value{
+				"username": property.New("tenant-a"),
+				"email":    property.New("tenant-a"),
+				"password": property.New("hunter2-hunter2").WithSecret(true),
+				"ips":      property.New([]property.Value{property.New("198.51.100.7")}),
+				"region":   property.New("eu"),
+			},
+			failing: []string{"email"},
+		},
+		{
+			name: "subuser password without a digit",
+			typ:  "Subuser",
+			inputs: map[string]property.Value{
+				"username": property.New("tenant-a"),
+				"email":    property.New("tenant-a@example.com"),
+				"password": property.New("correct-horse").WithSecret(true),
+			},
+			failing: []string{"password"},
+		},
+		{
+			name: "teammate email",
+			typ:  "Teammate",
+			inputs: map[string]property.Value{
+				"email":  property.New("jdoe@"),
+				"scopes": property.New([]property.Value{property.New("mail.send")}),
+			},
+			failing: []string{"email"},
+		},
+		{
+			name:    "teammate without scopes",
+			typ:     "Teammate",
+			inputs:  map[string]property.Value{"email": property.New("jdoe@example.com")},
+			failing: []string{"scopes"},
+		},
+		{
+			name: "teammate admin with scopes",
+			typ:  "Teammate",
+			inputs: map[string]property.Value{
+				"email":   property.New("admin@example.com"),
+				"isAdmin": property.New(true),
+				"scopes":  property.New([]property.Value{property.New("mail.send")}),
+			},
+			failing: []string{"scopes"},
+		},
+		{
+			name: "teammate admin",
+			typ:  "Teammate",
+			inputs: map[string]property.Value{
+				"email":   property.New("admin@example.com"),
+				"isAdmin": property.New(true),
+			},
+		},
+		{
+			name: "template generation",
+			typ:  "Template",
+			inputs: map[string]property.Value{
+				"name":       property.New("welcome"),
+				"generation": property.New("modern"),
+			},
+			failing: []string{"generation"},
+		},
+		{
+			name: "template version settings",
+			typ:  "TemplateVersion",
+			inputs: map[string]property.Value{
+				"templateId": property.New("d-123"),
+				"name":       property.New("v1"),
+				"active":     property.New(2.0),
+				"editor":     property.New("wysiwyg"),
+				"testData":   property.New("{name: 'Ada'}"),
+			},
+			failing: []string{"active", "editor", "testData"},
+		},
+		{
+			name: "valid template version",
+			typ:  "TemplateVersion",
+			inputs: map[string]property.Value{
+				"templateId": property.New("d-123"),
+				"name":       property.New("v1"),
+				"active":     property.New(1.0),
+				"editor":     property.New("design"),
+				"testData":   property.New(`{"name": "Ada"}`),
+			},
+		},
+		{
+			name: "template version content over 1 MiB",
+			typ:  "TemplateVersion",
+			inputs: map[string]property.Value{
+				"templateId":   property.New("d-123"),
+				"name":         property.New("v1"),
+				"htmlContent":  property.New("<p>" + strings.Repeat("a", 1<<20) + "</p>"),
+				"plainContent": property.New(strings.Repeat("a", 1<<20)),
+			},
+			failing: []string{"htmlContent"},
+		},
+		{
+			name: "account password without new password",
+			typ:  "AccountPassword",
+			inputs: map[string]property.Value{
+				"oldPassword": property.New("initial"),
+				"newPassword": property.New(""),
+			},
+			failing: []string{"newPassword"},
+		},
+		{
+			name: "account username with spaces",
+			typ:  "AccountUsername",
+			inputs: map[string]property.Value{
+				"username": property.New("ops team"),
+			},
+			failing: []string{"username"},
+		},
+		{
+			name: "legacy template without body tag",
+			typ:  "LegacyTemplateMailSetting",
+			inputs: map[string]property.Value{
+				"enabled":     property.New(true),
+				"htmlContent": property.New("<html><body></body></html>"),
+			},
+			failing: []string{"htmlContent"},
+		},
+		{
+			name: "new relic without license key",
+			typ:  "NewRelicPartnerSetting",
+			inputs: map[string]property.Value{
+				"enabled": property.New(true),
+			},
+			failing: []string{"licenseKey"},
+		},
+		{
+			name: "scheduled send status",
+			typ:  "ScheduledSend",
+			inputs: map[string]property.Value{
+				"batchId": property.New("YOUR_BATCH_ID"),
+				"status":  property.New("stop"),
+			},
+			failing: []string{"status"},
+		},
+		{
+			name: "unsubscribe group lengths",
+			typ:  "UnsubscribeGroup",
+			inputs: map[string]property.Value{
+				"name":        property.New("Weekly product and company newsletter"),
+				"description": property.New("Short"),
+			},
+			failing: []string{"name"},
+		},
+		{
+			name: "verified sender fields",
+			typ:  "VerifiedSender",
+			inputs: map[string]property.Value{
+				"nickname":  property.New("support"),
+				"fromEmail": property.New("support@example.com"),
+				"replyTo":   property.New("reply"),
+				"address":   property.New("1 Main St"),
+				"city":      property.New(""),
+				"country":   property.New("USA"),
+			},
+			failing: []string{"replyTo", "city"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Check(p.CheckRequest{
+				Urn:    previewURN(tt.typ, "test"),
+				Inputs: property.NewMap(tt.inputs),
+			})
+			require.NoError(t, err)
+
+			var failing []string
+			for _, failure := range resp.Failures {
+				failing = append(failing, failure.Property)
+				assert.NotEmpty(t, failure.Reason)
+			}
+			assert.Equal(t, tt.failing, failing)
+		})
+	}
+
+	// Static validation never calls the API
+	assert.Zero(t, atomic.LoadInt32(&calls))
+}
+
+func TestInputValidator_Reasons(t *testing.T) {
+	t.Parallel()
+
+	v := newInputValidator(property.NewMap(nil))
+	v.oneOf("region", "us", "global", "eu")
+	v.inRange("percentage", 0, 1, 100)
+	v.maxLength("name", "abcd", 3)
+
+	assert.Equal(t, []p.CheckFailure{
+		{Property: "region", Reason: `region must be one of: global, eu (got "us")`},
+		{Property: "percentage", Reason: "percentage must be between 1 and 100 (got 0)"},
+		{Property: "name", Reason: "name must be at most 3 characters (got 4)"},
+	}, v.failures)
+}
+
+func TestEmailAddressProblem(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"jdoe@example.com",
+		"a.b+tag@sub.example.co.uk",
+		`"john doe"@example.com`,
+		"ops@[192.0.2.1]",
+		"müller@example.com",
+	}
+	for _, address := range valid {
+		assert.Empty(t, emailAddressProblem(address), address)
+	}
+
+	invalid := map[string]string{
+		"jdoe":                                   "expected local-part@domain",
+		"jdoe@":                                  "expected local-part@domain",
+		"jdoe@example..com":                      "expected local-part@domain",
+		" jdoe@example.com":                      "leading and trailing spaces are not allowed",
+		"John Doe <jdoe@example.com>":            "without a display name",
+		"<jdoe@example.com>":                     "without a display name",
+		"jdoe@example.com (John)":                "without a display name",
+		strings.Repeat("a", 65) + "@example.com": "the part before @ is 65 bytes, more than 64",
+		"jdoe@" + strings.Repeat("a.", 125) + "com": "the address is 258 bytes, more than 254",
+	}
+	for address, problem := range invalid {
+		assert.Contains(t, emailAddressProblem(address), problem, address)
+	}
+}
+
+func TestDomainNameProblem(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"example.com",
+		"em123.mail.example.co.uk",
+		"bücher.de",
+		"xn--bcher-kva.de",
+	}
+	for _, domain := range valid {
+		assert.Empty(t, domainNameProblem(domain, 2), domain)
+	}
+	assert.Empty(t, domainNameProblem("links", 1))
+
+	invalid := map[string]string{
+		"https://example.com":             "without a scheme, port, path or spaces",
+		"example.com:443":                 "without a scheme, port, path or spaces",
+		"example.com/links":               "without a scheme, port, path or spaces",
+		"example.com.":                    "the trailing dot is not allowed",
+		"localhost":                       "expected at least 2 dot-separated labels",
+		"example..com":                    "labels must not be empty",
+		"-example.com":                    `the label "-example" starts or ends with a hyphen`,
+		"exa_mple.com":                    `the label "exa_mple" may only contain letters, digits and hyphens`,
+		"xn--zz.com":                      "it is not a valid internationalized name",
+		strings.Repeat("a", 64) + ".com":  "is 64 characters, more than 63",
+		strings.Repeat("a.", 127) + "com": "the name is 257 characters, more than 253",
+	}
+	for domain, problem := range invalid {
+		assert.Contains(t, domainNameProblem(domain, 2), problem, domain)
+	}
+}
+
+func TestPasswordProblem(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, passwordProblem("hunter2-hunter2", "tenant-a"))
+	assert.Empty(t, passwordProblem("Pässwort9", ""))
+
+	invalid := map[string]string{
+		"short1":                 "it must be 8 to 128 characters (got 6)",
+		strings.Repeat("a1", 65): "it must be 8 to 128 characters (got 130)",
+		"12345678":               "it must contain at least one letter",
+		"correct-horse":          "it must contain at least one digit",
+		"Tenant-A1":              "it must differ from the username",
+	}
+	for password, problem := range invalid {
+		assert.Equal(t, problem, passwordProblem(password, "tenant-a1"), password)
+	}
+}
+
+func TestJSONObjectProblem(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, jsonObjectProblem(`{"name": "Ada", "items": [1, 2]}`))
+
+	invalid := map[string]string{
+		"{name: 'Ada'}":                  "invalid character 'n' looking for beginning of object key string at line 1, column 2",
+		"{\n  \"a\": 1,\n  \"b\": 2,\n}": "invalid character '}' looking for beginning of object key string at line 4, column 1",
+		`{"name": "Ada"} {}`:             "invalid character '{' after top-level value at line 1, column 17",
+		`{"name": `:                      "unexpected end of JSON input at line 1, column 10",
+		`["Ada"]`:                        "got a JSON array",
+		`null`:                           "got null",
+	}
+	for value, problem := range invalid {
+		assert.Equal(t, problem, jsonObjectProblem(value), value)
+	}
+}
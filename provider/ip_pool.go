@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"net/url"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // IpPool is the controller for the SendGrid IP Pool resource.
@@ -31,8 +33,12 @@ type IpPool struct{} //nolint:revive // name matches Pulumi resource token
 
 // IpPoolArgs are the inputs to the IpPool resource.
 type IpPoolArgs struct { //nolint:revive // name matches Pulumi resource token
-	// Name is the name of the IP pool (required, max 64 chars)
-	Name string `pulumi:"name"`
+	// Name is the name of the IP pool (optional, max 64 chars, defaults to an autoname from the resource name)
+	Name string `pulumi:"name,optional"`
+
+	// DeletionProtection prevents the IP pool from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // IpPoolState is the state of the IpPool resource.
@@ -79,12 +85,39 @@ func (r *ipPoolAPIResponse) toState() IpPoolState {
 	}
 }
 
+// Check validates the IpPool inputs.
+func (p *IpPool) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[IpPoolArgs], error) {
+	newInputs, err := autoname(req, "name", 64)
+	if err != nil {
+		return infer.CheckResponse[IpPoolArgs]{}, err
+	}
+	req.NewInputs = newInputs
+
+	inputs, failures, err := infer.DefaultCheck[IpPoolArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[IpPoolArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[IpPoolArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid IpPoolArgs
+func (args *IpPoolArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("name", args.Name)
+	v.maxLength("name", args.Name, 64)
+	return v.failures
+}
+
 // Create creates a new SendGrid IP Pool.
 func (p *IpPool) Create(ctx context.Context, req infer.CreateRequest[IpPoolArgs]) (infer.CreateResponse[IpPoolState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := IpPoolState{
 			IpPoolArgs: input,
@@ -92,7 +125,6 @@ func (p *IpPool) Create(ctx context.Context, req infer.CreateRequest[IpPoolArgs]
 			Ips:        []string{},
 		}
 		return infer.CreateResponse[IpPoolState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -115,6 +147,7 @@ func (p *IpPool) Create(ctx context.Context, req infer.CreateRequest[IpPoolArgs]
 	}
 
 	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
 
 	// Use pool_name as the ID (URL encoded for safety)
 	return infer.CreateResponse[IpPoolState]{
@@ -148,6 +181,7 @@ func (p *IpPool) Read(ctx context.Context, req infer.ReadRequest[IpPoolArgs, IpP
 	}
 
 	state := result.toState()
+	state.DeletionProtection = req.Inputs.DeletionProtection
 	inputs := state.IpPoolArgs
 
 	return infer.ReadResponse[IpPoolArgs, IpPoolState]{
@@ -195,6 +229,7 @@ func (p *IpPool) Update(ctx context.Context, req infer.UpdateRequest[IpPoolArgs,
 	}
 
 	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
 
 	return infer.UpdateResponse[IpPoolState]{Output: state}, nil
 }
@@ -203,6 +238,10 @@ func (p *IpPool) Update(ctx context.Context, req infer.UpdateRequest[IpPoolArgs,
 func (p *IpPool) Delete(ctx context.Context, req infer.DeleteRequest[IpPoolState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "IP pool", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
@@ -0,0 +1,224 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// legacyTemplateMailSettingID is the ID of the LegacyTemplateMailSetting, as there is one per account
+const legacyTemplateMailSettingID = "template"
+
+// legacyTemplateBodyTag is the placeholder the legacy template wraps the email body with
+const legacyTemplateBodyTag = "<% body %>"
+
+// LegacyTemplateMailSetting is the controller for the SendGrid legacy template mail setting.
+//
+// This resource manages the account-wide HTML wrapper of the legacy templating
+// system. Deleting it disables the setting.
+type LegacyTemplateMailSetting struct{}
+
+// LegacyTemplateMailSettingArgs are the inputs to the LegacyTemplateMailSetting resource.
+type LegacyTemplateMailSettingArgs struct {
+	// Enabled turns the legacy template on or off (required)
+	Enabled bool `pulumi:"enabled"`
+
+	// HTMLContent is the HTML that wraps every email, with <% body %> where the content goes (optional)
+	HTMLContent *string `pulumi:"htmlContent,optional"`
+
+	// DeletionProtection prevents the setting from being disabled by deleting the resource,
+	// including by a replacement, while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// LegacyTemplateMailSettingState is the state of the LegacyTemplateMailSetting resource.
+type LegacyTemplateMailSettingState struct {
+	// Embed the input args in the output state
+	LegacyTemplateMailSettingArgs
+}
+
+// Annotate provides descriptions for the LegacyTemplateMailSetting resource.
+func (m *LegacyTemplateMailSetting) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&m, "Manages the SendGrid legacy template mail setting.\n\n"+
+		"For accounts still using legacy templates, this setting wraps every email in the same HTML, "+
+		"with the <% body %> tag where the content of each email goes. There is one setting per account, "+
+		"so only one of these resources should exist per provider; deleting it disables the setting.\n\n"+
+		"New accounts should use dynamic templates with the Template resource instead.")
+}
+
+// legacyTemplateMailSettingAPIResponse represents the SendGrid API response structure
+type legacyTemplateMailSettingAPIResponse struct {
+	Enabled     bool   `json:"enabled"`
+	HTMLContent string `json:"html_content"`
+}
+
+// toState converts an API response to LegacyTemplateMailSettingState
+func (r *legacyTemplateMailSettingAPIResponse) toState() LegacyTemplateMailSettingState {
+	state := LegacyTemplateMailSettingState{
+		LegacyTemplateMailSettingArgs: LegacyTemplateMailSettingArgs{
+			Enabled: r.Enabled,
+		},
+	}
+	if r.HTMLContent != "" {
+		state.HTMLContent = &r.HTMLContent
+	}
+	return state
+}
+
+// Check validates the LegacyTemplateMailSetting inputs.
+func (m *LegacyTemplateMailSetting) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[LegacyTemplateMailSettingArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[LegacyTemplateMailSettingArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[LegacyTemplateMailSettingArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[LegacyTemplateMailSettingArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid LegacyTemplateMailSettingArgs
+func (args *LegacyTemplateMailSettingArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	if args.HTMLContent != nil && v.known("htmlContent") && !strings.Contains(*args.HTMLContent, legacyTemplateBodyTag) {
+		v.fail("htmlContent", "must contain the %s tag", legacyTemplateBodyTag)
+	}
+	return v.failures
+}
+
+// requestBody builds the PATCH request body from the args
+func (args *LegacyTemplateMailSettingArgs) requestBody() map[string]interface{} {
+	reqBody := map[string]interface{}{
+		"enabled": args.Enabled,
+	}
+	if args.HTMLContent != nil {
+		reqBody["html_content"] = *args.HTMLContent
+	}
+	return reqBody
+}
+
+// Create applies the legacy template mail setting.
+func (m *LegacyTemplateMailSetting) Create(ctx context.Context, req infer.CreateRequest[LegacyTemplateMailSettingArgs]) (infer.CreateResponse[LegacyTemplateMailSettingState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return the expected state
+	if preview {
+		return infer.CreateResponse[LegacyTemplateMailSettingState]{
+			ID:     legacyTemplateMailSettingID,
+			Output: LegacyTemplateMailSettingState{LegacyTemplateMailSettingArgs: input},
+		}, nil
+	}
+
+	state, err := m.patch(ctx, input)
+	if err != nil {
+		return infer.CreateResponse[LegacyTemplateMailSettingState]{}, err
+	}
+
+	return infer.CreateResponse[LegacyTemplateMailSettingState]{
+		ID:     legacyTemplateMailSettingID,
+		Output: state,
+	}, nil
+}
+
+// patch updates the setting to match the args and returns the resulting state
+func (m *LegacyTemplateMailSetting) patch(ctx context.Context, input LegacyTemplateMailSettingArgs) (LegacyTemplateMailSettingState, error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return LegacyTemplateMailSettingState{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	var result legacyTemplateMailSettingAPIResponse
+	if err := client.Patch(ctx, "/v3/mail_settings/template", input.requestBody(), &result); err != nil {
+		return LegacyTemplateMailSettingState{}, fmt.Errorf("failed to update legacy template mail setting: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+	return state, nil
+}
+
+// Read retrieves the current legacy template mail setting.
+func (m *LegacyTemplateMailSetting) Read(ctx context.Context, req infer.ReadRequest[LegacyTemplateMailSettingArgs, LegacyTemplateMailSettingState]) (infer.ReadResponse[LegacyTemplateMailSettingArgs, LegacyTemplateMailSettingState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[LegacyTemplateMailSettingArgs, LegacyTemplateMailSettingState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// The setting always exists, so there is no not found case
+	var result legacyTemplateMailSettingAPIResponse
+	if err := client.Get(ctx, "/v3/mail_settings/template", &result); err != nil {
+		return infer.ReadResponse[LegacyTemplateMailSettingArgs, LegacyTemplateMailSettingState]{}, fmt.Errorf("failed to read legacy template mail setting: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	inputs := state.LegacyTemplateMailSettingArgs
+
+	return infer.ReadResponse[LegacyTemplateMailSettingArgs, LegacyTemplateMailSettingState]{
+		ID:     id,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// Update updates the legacy template mail setting.
+func (m *LegacyTemplateMailSetting) Update(ctx context.Context, req infer.UpdateRequest[LegacyTemplateMailSettingArgs, LegacyTemplateMailSettingState]) (infer.UpdateResponse[LegacyTemplateMailSettingState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return expected state
+	if preview {
+		return infer.UpdateResponse[LegacyTemplateMailSettingState]{Output: LegacyTemplateMailSettingState{LegacyTemplateMailSettingArgs: input}}, nil
+	}
+
+	state, err := m.patch(ctx, input)
+	if err != nil {
+		return infer.UpdateResponse[LegacyTemplateMailSettingState]{}, err
+	}
+	return infer.UpdateResponse[LegacyTemplateMailSettingState]{Output: state}, nil
+}
+
+// Delete disables the legacy template mail setting, leaving its HTML content in place.
+func (m *LegacyTemplateMailSetting) Delete(ctx context.Context, req infer.DeleteRequest[LegacyTemplateMailSettingState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "legacy template mail setting", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	if err := client.Patch(ctx, "/v3/mail_settings/template", map[string]interface{}{"enabled": false}, nil); err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("failed to disable legacy template mail setting: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
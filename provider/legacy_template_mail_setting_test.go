@@ -0,0 +1,82 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestLegacyTemplateMailSetting_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	setting := map[string]any{"enabled": false, "html_content": ""}
+	var patches []map[string]any
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, "/v3/mail_settings/template", req.URL.Path)
+
+		switch req.Method {
+		case http.MethodPatch:
+			var body map[string]any
+			data, _ := io.ReadAll(req.Body)
+			assert.NoError(t, json.Unmarshal(data, &body))
+			patches = append(patches, body)
+			for k, v := range body {
+				setting[k] = v
+			}
+		case http.MethodGet:
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		data, _ := json.Marshal(setting)
+		return fakeResponse(req, http.StatusOK, string(data)), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("LegacyTemplateMailSetting", "wrapper")
+	inputs := property.NewMap(map[string]property.Value{
+		"enabled":     property.New(true),
+		"htmlContent": property.New("<div><% body %></div>"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "template", created.ID)
+	assert.True(t, created.Properties.Get("enabled").AsBool())
+	assert.Equal(t, "<div><% body %></div>", created.Properties.Get("htmlContent").AsString())
+
+	read, err := server.Read(p.ReadRequest{ID: "template", Urn: urn, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "<div><% body %></div>", read.Inputs.Get("htmlContent").AsString())
+
+	// Deleting disables the setting and leaves the wrapper in place
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "template", Urn: urn, Properties: read.Properties}))
+	assert.Equal(t, []map[string]any{
+		{"enabled": true, "html_content": "<div><% body %></div>"},
+		{"enabled": false},
+	}, patches)
+	assert.Equal(t, "<div><% body %></div>", setting["html_content"])
+}
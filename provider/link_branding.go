@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"strconv"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // LinkBranding is the controller for the SendGrid Link Branding resource.
@@ -32,19 +34,42 @@ type LinkBranding struct{}
 // LinkBrandingArgs are the inputs to the LinkBranding resource.
 type LinkBrandingArgs struct {
 	// Domain is the root domain for the subdomain being used to brand links (required)
-	Domain string `pulumi:"domain"`
+	Domain string `pulumi:"domain" provider:"replaceOnChanges"`
 
 	// Subdomain is the subdomain to use for branded links (optional)
 	// If not provided, SendGrid will generate one.
-	Subdomain *string `pulumi:"subdomain,optional"`
+	Subdomain *string `pulumi:"subdomain,optional" provider:"replaceOnChanges"`
 
 	// Default marks this link branding as the default for the domain (optional)
 	Default *bool `pulumi:"default,optional"`
 
 	// Region is the region for the link branding: "global" or "eu" (optional, default: global)
 	Region *string `pulumi:"region,optional"`
+
+	// WaitForValidation asks SendGrid to validate the DNS records once the link branding is created or
+	// updated, and waits until they are valid (optional, defaults to false). Validation only succeeds
+	// once the records are published, e.g. when they are managed outside of this stack.
+	WaitForValidation *bool `pulumi:"waitForValidation,optional"`
+
+	// WaitTimeoutSeconds is how long to wait for validation (optional, defaults to 600)
+	WaitTimeoutSeconds *int `pulumi:"waitTimeoutSeconds,optional"`
+
+	// WaitIntervalSeconds is the time between validation attempts (optional, defaults to 30)
+	WaitIntervalSeconds *int `pulumi:"waitIntervalSeconds,optional"`
+
+	// RevalidateOnRefresh asks SendGrid to validate the DNS records again on refresh while they
+	// are not valid, so valid reflects records published outside a deployment (optional, defaults to false)
+	RevalidateOnRefresh *bool `pulumi:"revalidateOnRefresh,optional"`
+
+	// DeletionProtection prevents the link branding from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
+// linkBrandingInputOnlyFields are the inputs SendGrid does not return, which are carried over
+// from the inputs to the state read back
+var linkBrandingInputOnlyFields = []string{"region", "waitForValidation", "waitTimeoutSeconds", "waitIntervalSeconds", "revalidateOnRefresh"}
+
 // LinkBrandingDNSRecord represents a DNS record required for link branding
 type LinkBrandingDNSRecord struct {
 	// Valid indicates if the record has been validated
@@ -82,6 +107,12 @@ type LinkBrandingState struct {
 
 	// BrandCname is the CNAME record for branding
 	BrandCname *LinkBrandingDNSRecord `pulumi:"brandCname,optional"`
+
+	// DNSRecords lists ownerCname and brandCname in the same shape as the dnsRecords of a domain authentication
+	DNSRecords []DomainDNSRecord `pulumi:"dnsRecords"`
+
+	// ProviderRecords lists the same records as dnsRecords in the shape DNS provider resources expect
+	ProviderRecords []DNSProviderRecord `pulumi:"providerRecords,optional"`
 }
 
 // Annotate provides descriptions for the LinkBranding resource.
@@ -94,6 +125,74 @@ func (l *LinkBranding) Annotate(annotator infer.Annotator) {
 		"and then validate the link branding using the SendGrid console or API.")
 }
 
+// StateMigrations upgrades state written by earlier versions of the provider
+func (l *LinkBranding) StateMigrations(context.Context) []infer.StateMigrationFunc[LinkBrandingState] {
+	return []infer.StateMigrationFunc[LinkBrandingState]{
+		infer.StateMigration(migrateLinkBrandingDNSRecords),
+	}
+}
+
+// linkBrandingStateV1 is LinkBrandingState as written before dnsRecords was added
+type linkBrandingStateV1 struct {
+	LinkBrandingArgs
+
+	LinkID          int                    `pulumi:"linkId"`
+	UserID          int                    `pulumi:"userId"`
+	Username        string                 `pulumi:"username"`
+	Valid           bool                   `pulumi:"valid"`
+	Legacy          bool                   `pulumi:"legacy"`
+	OwnerCname      *LinkBrandingDNSRecord `pulumi:"ownerCname,optional"`
+	BrandCname      *LinkBrandingDNSRecord `pulumi:"brandCname,optional"`
+	DNSRecords      []DomainDNSRecord      `pulumi:"dnsRecords,optional"`
+	ProviderRecords []DNSProviderRecord    `pulumi:"providerRecords,optional"`
+}
+
+// migrateLinkBrandingDNSRecords fills in dnsRecords for state written before it was added
+func migrateLinkBrandingDNSRecords(_ context.Context, old linkBrandingStateV1) (infer.MigrationResult[LinkBrandingState], error) {
+	if old.DNSRecords != nil {
+		// Already in the current shape
+		return infer.MigrationResult[LinkBrandingState]{}, nil
+	}
+
+	state := LinkBrandingState{
+		LinkBrandingArgs: old.LinkBrandingArgs,
+		LinkID:           old.LinkID,
+		UserID:           old.UserID,
+		Username:         old.Username,
+		Valid:            old.Valid,
+		Legacy:           old.Legacy,
+		OwnerCname:       old.OwnerCname,
+		BrandCname:       old.BrandCname,
+	}
+	state.setDNSRecords()
+	return infer.MigrationResult[LinkBrandingState]{Result: &state}, nil
+}
+
+// setDNSRecords derives dnsRecords and providerRecords from ownerCname and brandCname
+func (s *LinkBrandingState) setDNSRecords() {
+	s.DNSRecords = []DomainDNSRecord{}
+	s.ProviderRecords = []DNSProviderRecord{}
+	for _, record := range []struct {
+		name   string
+		record *LinkBrandingDNSRecord
+	}{
+		{"owner_cname", s.OwnerCname},
+		{"brand_cname", s.BrandCname},
+	} {
+		if record.record == nil {
+			continue
+		}
+		s.DNSRecords = append(s.DNSRecords, DomainDNSRecord{
+			Name:  record.name,
+			Valid: record.record.Valid,
+			Type:  record.record.Type,
+			Host:  record.record.Host,
+			Data:  record.record.Data,
+		})
+		s.ProviderRecords = append(s.ProviderRecords, newDNSProviderRecord(record.record.Type, record.record.Host, record.record.Data))
+	}
+}
+
 // linkBrandingAPIResponse represents the SendGrid API response structure
 type linkBrandingAPIResponse struct {
 	ID        int                     `json:"id"`
@@ -158,15 +257,56 @@ func (r *linkBrandingAPIResponse) toState() LinkBrandingState {
 		}
 	}
 
+	state.setDNSRecords()
+
 	return state
 }
 
+// Check validates the LinkBranding inputs.
+func (l *LinkBranding) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[LinkBrandingArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[LinkBrandingArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[LinkBrandingArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[LinkBrandingArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid LinkBrandingArgs
+func (args *LinkBrandingArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("domain", args.Domain)
+	v.domainName("domain", args.Domain, 2)
+	if args.Subdomain != nil {
+		v.domainName("subdomain", *args.Subdomain, 1)
+	}
+	if args.Region != nil {
+		v.oneOf("region", *args.Region, "global", "eu")
+	}
+	if args.WaitTimeoutSeconds != nil {
+		v.inRange("waitTimeoutSeconds", *args.WaitTimeoutSeconds, 1, 86400)
+	}
+	if args.WaitIntervalSeconds != nil {
+		v.inRange("waitIntervalSeconds", *args.WaitIntervalSeconds, 1, 3600)
+	}
+	return v.failures
+}
+
+// Diff compares the LinkBranding inputs with its state. SendGrid generates a subdomain
+// when none is given, so leaving subdomain unset does not replace the link branding.
+func (l *LinkBranding) Diff(_ context.Context, req infer.DiffRequest[LinkBrandingArgs, LinkBrandingState]) (p.DiffResponse, error) {
+	return diffArgs(req.State.LinkBrandingArgs, req.Inputs, "subdomain"), nil
+}
+
 // Create creates a new SendGrid Link Branding.
 func (l *LinkBranding) Create(ctx context.Context, req infer.CreateRequest[LinkBrandingArgs]) (infer.CreateResponse[LinkBrandingState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := LinkBrandingState{
 			LinkBrandingArgs: input,
@@ -176,7 +316,6 @@ func (l *LinkBranding) Create(ctx context.Context, req infer.CreateRequest[LinkB
 			Legacy:           false,
 		}
 		return infer.CreateResponse[LinkBrandingState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -210,6 +349,16 @@ func (l *LinkBranding) Create(ctx context.Context, req infer.CreateRequest[LinkB
 	}
 
 	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.LinkBrandingArgs, input, nil)
+	preserveInputs(&state.LinkBrandingArgs, input, linkBrandingInputOnlyFields...)
+
+	if err := l.awaitValidation(ctx, client, input, &state); err != nil {
+		return infer.CreateResponse[LinkBrandingState]{
+			ID:     strconv.Itoa(result.ID),
+			Output: state,
+		}, initFailed("validate DNS records", err)
+	}
 
 	return infer.CreateResponse[LinkBrandingState]{
 		ID:     strconv.Itoa(result.ID),
@@ -227,6 +376,11 @@ func (l *LinkBranding) Read(ctx context.Context, req infer.ReadRequest[LinkBrand
 		return infer.ReadResponse[LinkBrandingArgs, LinkBrandingState]{}, fmt.Errorf("SendGrid client not configured")
 	}
 
+	// Check the DNS records again, so the details read below report whether they are valid now
+	if req.Inputs.RevalidateOnRefresh != nil && *req.Inputs.RevalidateOnRefresh && !req.State.Valid {
+		revalidateWhitelabel(ctx, client, fmt.Sprintf("/v3/whitelabel/links/%s", id))
+	}
+
 	// Make the API call to get the link branding details
 	var result linkBrandingAPIResponse
 	if err := client.Get(ctx, fmt.Sprintf("/v3/whitelabel/links/%s", id), &result); err != nil {
@@ -239,6 +393,9 @@ func (l *LinkBranding) Read(ctx context.Context, req infer.ReadRequest[LinkBrand
 	}
 
 	state := result.toState()
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	normalizeArgs(&state.LinkBrandingArgs, req.Inputs, nil)
+	preserveInputs(&state.LinkBrandingArgs, req.Inputs, linkBrandingInputOnlyFields...)
 	inputs := state.LinkBrandingArgs
 
 	return infer.ReadResponse[LinkBrandingArgs, LinkBrandingState]{
@@ -266,6 +423,8 @@ func (l *LinkBranding) Update(ctx context.Context, req infer.UpdateRequest[LinkB
 			Legacy:           oldState.Legacy,
 			OwnerCname:       oldState.OwnerCname,
 			BrandCname:       oldState.BrandCname,
+			DNSRecords:       oldState.DNSRecords,
+			ProviderRecords:  oldState.ProviderRecords,
 		}
 		return infer.UpdateResponse[LinkBrandingState]{Output: state}, nil
 	}
@@ -290,14 +449,49 @@ func (l *LinkBranding) Update(ctx context.Context, req infer.UpdateRequest[LinkB
 	}
 
 	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.LinkBrandingArgs, input, nil)
+	preserveInputs(&state.LinkBrandingArgs, input, linkBrandingInputOnlyFields...)
+
+	if err := l.awaitValidation(ctx, client, input, &state); err != nil {
+		return infer.UpdateResponse[LinkBrandingState]{Output: state}, initFailed("validate DNS records", err)
+	}
 
 	return infer.UpdateResponse[LinkBrandingState]{Output: state}, nil
 }
 
+// awaitValidation validates the DNS records of the link branding when waitForValidation is set
+// and they are not valid yet, and records the outcome in state
+func (l *LinkBranding) awaitValidation(ctx context.Context, client SendGridAPI, input LinkBrandingArgs, state *LinkBrandingState) error {
+	if input.WaitForValidation == nil || !*input.WaitForValidation || state.Valid {
+		return nil
+	}
+	path := fmt.Sprintf("/v3/whitelabel/links/%d", state.LinkID)
+	if err := validateWhitelabel(ctx, client, path, input.WaitTimeoutSeconds, input.WaitIntervalSeconds); err != nil {
+		return err
+	}
+
+	state.Valid = true
+	if state.OwnerCname != nil {
+		state.OwnerCname.Valid = true
+	}
+	if state.BrandCname != nil {
+		state.BrandCname.Valid = true
+	}
+	for i := range state.DNSRecords {
+		state.DNSRecords[i].Valid = true
+	}
+	return nil
+}
+
 // Delete removes a SendGrid Link Branding.
 func (l *LinkBranding) Delete(ctx context.Context, req infer.DeleteRequest[LinkBrandingState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "link branding", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
@@ -22,6 +22,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 func TestSendGridClient_CreateLinkBranding(t *testing.T) {
@@ -469,6 +472,16 @@ func TestLinkBrandingAPIResponse_ToState(t *testing.T) {
 		assert.NotNil(t, state.BrandCname)
 		assert.True(t, state.BrandCname.Valid)
 		assert.Equal(t, "12345.email.example.com", state.BrandCname.Host)
+
+		assert.Equal(t, []DomainDNSRecord{
+			{Name: "owner_cname", Valid: true, Type: "cname", Host: state.OwnerCname.Host, Data: state.OwnerCname.Data},
+			{Name: "brand_cname", Valid: true, Type: "cname", Host: state.BrandCname.Host, Data: state.BrandCname.Data},
+		}, state.DNSRecords)
+
+		require.Len(t, state.ProviderRecords, 2)
+		assert.Equal(t, "email.example.com", state.ProviderRecords[0].Name)
+		assert.Equal(t, "CNAME", state.ProviderRecords[0].Type)
+		assert.Equal(t, "12345.email.example.com", state.ProviderRecords[1].Name)
 	})
 
 	t.Run("with minimal fields", func(t *testing.T) {
@@ -590,3 +603,77 @@ func TestLinkBrandingValidation(t *testing.T) {
 		assert.False(t, result.Valid)
 	})
 }
+
+func TestLinkBranding_StateMigration(t *testing.T) {
+	t.Parallel()
+
+	ownerCname := &LinkBrandingDNSRecord{Valid: true, Type: "cname", Host: "12.example.com", Data: "sendgrid.net"}
+	brandCname := &LinkBrandingDNSRecord{Type: "cname", Host: "links.example.com", Data: "sendgrid.net"}
+
+	t.Run("state without dnsRecords", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := migrateLinkBrandingDNSRecords(context.Background(), linkBrandingStateV1{
+			LinkBrandingArgs: LinkBrandingArgs{Domain: "example.com"},
+			LinkID:           12,
+			OwnerCname:       ownerCname,
+			BrandCname:       brandCname,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.Result)
+
+		state := result.Result
+		assert.Equal(t, "example.com", state.Domain)
+		assert.Equal(t, 12, state.LinkID)
+		assert.Equal(t, ownerCname, state.OwnerCname)
+		assert.Equal(t, []DomainDNSRecord{
+			{Name: "owner_cname", Valid: true, Type: "cname", Host: "12.example.com", Data: "sendgrid.net"},
+			{Name: "brand_cname", Type: "cname", Host: "links.example.com", Data: "sendgrid.net"},
+		}, state.DNSRecords)
+		assert.Len(t, state.ProviderRecords, 2)
+	})
+
+	t.Run("current state", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := migrateLinkBrandingDNSRecords(context.Background(), linkBrandingStateV1{
+			LinkBrandingArgs: LinkBrandingArgs{Domain: "example.com"},
+			DNSRecords:       []DomainDNSRecord{},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, result.Result)
+	})
+
+	t.Run("old state has no diff", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := previewValidationServer(t, false, nil, &calls)
+
+		resp, err := server.Diff(p.DiffRequest{
+			ID:  "12",
+			Urn: previewURN("LinkBranding", "test"),
+			State: property.NewMap(map[string]property.Value{
+				"domain":    property.New("example.com"),
+				"subdomain": property.New("links"),
+				"linkId":    property.New(12.0),
+				"userId":    property.New(1.0),
+				"username":  property.New("parent"),
+				"valid":     property.New(true),
+				"legacy":    property.New(false),
+				"ownerCname": property.New(map[string]property.Value{
+					"valid": property.New(true),
+					"type":  property.New("cname"),
+					"host":  property.New("12.example.com"),
+					"data":  property.New("sendgrid.net"),
+				}),
+			}),
+			Inputs: property.NewMap(map[string]property.Value{
+				"domain":    property.New("example.com"),
+				"subdomain": property.New("links"),
+			}),
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.HasChanges, "%v", resp.DetailedDiff)
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultListCacheTTL is how long the provider reuses a list response. Refreshing
+// many resources of the same type then lists the account once instead of once per
+// resource, while a long-running deployment still sees changes made elsewhere.
+const DefaultListCacheTTL = time.Minute
+
+// listCache holds list-endpoint results for the duration of a Pulumi operation.
+// Concurrent lookups of the same list share a single fetch, and any write request
+// sent by the client clears the cache so later reads observe the change.
+type listCache struct {
+	ttl time.Duration
+
+	mu         sync.Mutex
+	entries    map[string]*listCacheEntry
+	generation uint64 // incremented on every invalidation
+}
+
+// listCacheEntry is a cached or in-flight list result
+type listCacheEntry struct {
+	done    chan struct{} // closed once value and err are set
+	value   any
+	err     error
+	expires time.Time
+}
+
+// WithListCache caches the results of GetCachedPages for ttl. A non-positive ttl disables caching.
+func WithListCache(ttl time.Duration) ClientOption {
+	return func(c *SendGridClient) {
+		if ttl <= 0 {
+			c.lists = nil
+			return
+		}
+		c.lists = &listCache{ttl: ttl, entries: map[string]*listCacheEntry{}}
+	}
+}
+
+// GetCachedPages is GetAllPages for list endpoints that many resources read in
+// the same operation, e.g. the teammates list. When the client has a list cache,
+// the result is shared with other callers until the cache expires or the client
+// sends a write request. The returned slice may be modified, its items may not.
+func GetCachedPages[T any](ctx context.Context, c SendGridAPI, path string, opts PageOptions) ([]T, error) {
+	sg, ok := c.(*SendGridClient)
+	if !ok || sg.lists == nil {
+		return GetAllPages[T](ctx, c, path, opts)
+	}
+
+	key := fmt.Sprintf("%s|%d|%d|%s", path, opts.Style, opts.PageSize, opts.Query.Encode())
+	value, err := sg.lists.get(ctx, key, func() (any, error) {
+		return GetAllPages[T](ctx, c, path, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	items, ok := value.([]T)
+	if !ok {
+		// The same endpoint was cached with a different item type
+		return GetAllPages[T](ctx, c, path, opts)
+	}
+	return slices.Clone(items), nil
+}
+
+// findCached looks up a single item in a cached list, so that refreshing many
+// resources of the same type lists them once instead of reading each one. It
+// returns nil when the client has no list cache, the list cannot be fetched, or
+// the item is not in it, in which case the caller reads the item individually.
+func findCached[T any](ctx context.Context, c SendGridAPI, path string, opts PageOptions, match func(*T) bool) *T {
+	if sg, ok := c.(*SendGridClient); !ok || sg.lists == nil {
+		return nil
+	}
+
+	items, err := GetCachedPages[T](ctx, c, path, opts)
+	if err != nil {
+		return nil
+	}
+	for i := range items {
+		if match(&items[i]) {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+// get returns the cached value for key, calling fetch if there is none.
+// Errors are returned to everyone waiting on the fetch but are not cached.
+func (l *listCache) get(ctx context.Context, key string, fetch func() (any, error)) (any, error) {
+	l.mu.Lock()
+	if entry, ok := l.entries[key]; ok {
+		select {
+		case <-entry.done:
+			if time.Now().Before(entry.expires) {
+				l.mu.Unlock()
+				return entry.value, nil
+			}
+		default:
+			// Another caller is fetching this list, wait for its result
+			l.mu.Unlock()
+			select {
+			case <-entry.done:
+				if entry.err != nil && ctx.Err() == nil && (errors.Is(entry.err, context.Canceled) || errors.Is(entry.err, context.DeadlineExceeded)) {
+					// The fetch was cancelled by the caller that started it, not by us
+					return l.get(ctx, key, fetch)
+				}
+				return entry.value, entry.err
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	entry := &listCacheEntry{done: make(chan struct{})}
+	l.entries[key] = entry
+	generation := l.generation
+	l.mu.Unlock()
+
+	entry.value, entry.err = fetch()
+	entry.expires = time.Now().Add(l.ttl)
+
+	l.mu.Lock()
+	// Drop failed fetches, and results that may predate a write sent while fetching
+	if (entry.err != nil || l.generation != generation) && l.entries[key] == entry {
+		delete(l.entries, key)
+	}
+	l.mu.Unlock()
+	close(entry.done)
+
+	return entry.value, entry.err
+}
+
+// invalidate clears the cache after the client sent a write request
+func (l *listCache) invalidate() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.generation++
+	for key, entry := range l.entries {
+		select {
+		case <-entry.done:
+			delete(l.entries, key)
+		default:
+			// In-flight fetches are dropped when they complete
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestGetCachedPages(t *testing.T) {
+	t.Parallel()
+
+	type teammate struct {
+		Email string `json:"email"`
+	}
+
+	newServer := func(t *testing.T, calls *int32) string {
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			atomic.AddInt32(calls, 1)
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"result": [{"email": "a@example.com"}, {"email": "b@example.com"}]}`))
+		})
+		return server.URL
+	}
+
+	t.Run("concurrent reads share one fetch", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		client := NewSendGridClient("test-api-key", newServer(t, &calls), WithListCache(time.Minute))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				items, err := GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+				assert.NoError(t, err)
+				assert.Len(t, items, 2)
+			}()
+		}
+		wg.Wait()
+
+		_, err := GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("callers get their own slice", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		client := NewSendGridClient("test-api-key", newServer(t, &calls), WithListCache(time.Minute))
+
+		first, err := GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+		require.NoError(t, err)
+		first[0] = teammate{Email: "changed@example.com"}
+
+		second, err := GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "a@example.com", second[0].Email)
+	})
+
+	t.Run("writes invalidate the cache", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		client := NewSendGridClient("test-api-key", newServer(t, &calls), WithListCache(time.Minute))
+
+		_, err := GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+		require.NoError(t, err)
+		require.NoError(t, client.Delete(context.Background(), "/v3/teammates/a"))
+		_, err = GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		client := NewSendGridClient("test-api-key", newServer(t, &calls), WithListCache(time.Millisecond))
+
+		_, err := GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+		_, err = GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("without a cache", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		client := NewSendGridClient("test-api-key", newServer(t, &calls))
+
+		for i := 0; i < 2; i++ {
+			_, err := GetCachedPages[teammate](context.Background(), client, "/v3/teammates", PageOptions{})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+}
+
+func TestListCache_ErrorsAreNotCached(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"results": []}`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL, WithListCache(time.Minute))
+
+	_, err := GetCachedPages[verifiedSenderAPIResponse](context.Background(), client, "/v3/verified_senders", PageOptions{})
+	require.Error(t, err)
+
+	senders, err := GetCachedPages[verifiedSenderAPIResponse](context.Background(), client, "/v3/verified_senders", PageOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, senders)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestFindCached(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`[{"id": 1, "name": "a"}, {"id": 2, "name": "b"}]`))
+	})
+	byID := func(id int) func(*unsubscribeGroupAPIResponse) bool {
+		return func(g *unsubscribeGroupAPIResponse) bool { return g.ID == id }
+	}
+
+	cached := NewSendGridClient("test-api-key", server.URL, WithListCache(time.Minute))
+	found := findCached(context.Background(), cached, "/v3/asm/groups", PageOptions{}, byID(2))
+	require.NotNil(t, found)
+	assert.Equal(t, "b", found.Name)
+	assert.Nil(t, findCached(context.Background(), cached, "/v3/asm/groups", PageOptions{}, byID(3)))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Without a list cache, the item is read individually instead
+	uncached := NewSendGridClient("test-api-key", server.URL)
+	assert.Nil(t, findCached(context.Background(), uncached, "/v3/asm/groups", PageOptions{}, byID(2)))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRead_ServedFromList(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	responses := map[string]string{
+		"/v3/templates": `{"result": [
+			{"id": "d-1", "name": "welcome", "generation": "dynamic", "versions": [{"id": "v-1", "template_id": "d-1", "active": 1}]},
+			{"id": "d-2", "name": "receipt", "generation": "dynamic"}
+		], "_metadata": {}}`,
+		"/v3/alerts":     `[{"id": 1, "type": "usage_limit", "email_to": "ops@example.com", "percentage": 90}, {"id": 2, "type": "stats_notification", "email_to": "ops@example.com", "frequency": "daily"}]`,
+		"/v3/asm/groups": `[{"id": 1, "name": "Newsletter", "description": "News"}, {"id": 2, "name": "Offers", "description": "Deals"}]`,
+	}
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		calls[req.URL.Path]++
+		mu.Unlock()
+		if body, ok := responses[req.URL.Path]; ok {
+			return fakeResponse(req, http.StatusOK, body), nil
+		}
+		return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "resource not found"}]}`), nil
+	})
+
+	server := newTestServer(t, transport, nil)
+
+	reads := []struct {
+		typ, id string
+		exists  bool
+	}{
+		{"Template", "d-1", true},
+		{"Template", "d-2", true},
+		{"Template", "d-3", false},
+		{"Alert", "1", true},
+		{"Alert", "2", true},
+		{"UnsubscribeGroup", "1", true},
+		{"UnsubscribeGroup", "2", true},
+	}
+	for _, r := range reads {
+		resp, err := server.Read(p.ReadRequest{
+			ID:         r.id,
+			Urn:        previewURN(r.typ, "test-"+r.id),
+			Properties: property.Map{},
+			Inputs:     property.Map{},
+		})
+		require.NoError(t, err, "%s %s", r.typ, r.id)
+		if r.exists {
+			assert.Equal(t, r.id, resp.ID, "%s %s", r.typ, r.id)
+		} else {
+			assert.Empty(t, resp.ID, "%s %s", r.typ, r.id)
+		}
+	}
+
+	// Each list is fetched once, and only the template missing from it is read individually
+	assert.Equal(t, map[string]int{
+		"/v3/templates":     1,
+		"/v3/templates/d-3": 1,
+		"/v3/alerts":        1,
+		"/v3/asm/groups":    1,
+	}, calls)
+}
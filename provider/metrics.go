@@ -0,0 +1,238 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// RequestMeasurement describes a single request attempt sent to the SendGrid API
+type RequestMeasurement struct {
+	// Method and Endpoint identify the API call, e.g. "GET" and "/v3/templates".
+	// The query string is left out as it may contain email addresses.
+	Method   string
+	Endpoint string
+
+	// StatusCode is the HTTP status of the response, or 0 if no response was received
+	StatusCode int
+
+	// Duration is how long the attempt took, including reading the response body
+	Duration time.Duration
+
+	// Err is the transport error, if no response was received
+	Err error
+}
+
+// RequestObserver receives a measurement for every request attempt the client sends.
+// Retried requests are reported once per attempt.
+type RequestObserver interface {
+	ObserveRequest(RequestMeasurement)
+}
+
+// WithRequestObserver reports every request attempt to observer, e.g. to export
+// metrics. It may be given more than once to register several observers.
+func WithRequestObserver(observer RequestObserver) ClientOption {
+	return func(c *SendGridClient) {
+		c.observers = append(c.observers, observer)
+	}
+}
+
+// latencyBuckets are the upper bounds of the request latency histogram buckets
+var latencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// RequestMetrics summarizes the request attempts sent to the SendGrid API
+type RequestMetrics struct {
+	// Requests is the number of request attempts
+	Requests int
+
+	// Errors counts failed attempts by HTTP status code, with 0 for attempts that received no response
+	Errors map[int]int
+
+	// TotalLatency is the time spent in all attempts combined
+	TotalLatency time.Duration
+
+	// LatencyHistogram counts attempts per latency bucket. Entry i counts attempts that took
+	// at most latencyBuckets[i]; the last entry counts attempts slower than every bucket.
+	LatencyHistogram []int
+}
+
+// String formats the metrics as a one-line summary
+func (m RequestMetrics) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d SendGrid API requests in %s", m.Requests, m.TotalLatency.Round(time.Millisecond))
+
+	if len(m.Errors) > 0 {
+		statuses := make([]int, 0, len(m.Errors))
+		for status := range m.Errors {
+			statuses = append(statuses, status)
+		}
+		sort.Ints(statuses)
+
+		parts := make([]string, len(statuses))
+		for i, status := range statuses {
+			name := "network"
+			if status != 0 {
+				name = fmt.Sprint(status)
+			}
+			parts[i] = fmt.Sprintf("%s=%d", name, m.Errors[status])
+		}
+		fmt.Fprintf(&b, ", errors: %s", strings.Join(parts, " "))
+	}
+
+	var buckets []string
+	for i, count := range m.LatencyHistogram {
+		if count == 0 {
+			continue
+		}
+		if i < len(latencyBuckets) {
+			buckets = append(buckets, fmt.Sprintf("<=%s=%d", latencyBuckets[i], count))
+		} else {
+			buckets = append(buckets, fmt.Sprintf(">%s=%d", latencyBuckets[len(latencyBuckets)-1], count))
+		}
+	}
+	if len(buckets) > 0 {
+		fmt.Fprintf(&b, ", latency: %s", strings.Join(buckets, " "))
+	}
+	return b.String()
+}
+
+// metricsRecorder is a RequestObserver that aggregates measurements into RequestMetrics
+type metricsRecorder struct {
+	mu      sync.Mutex
+	metrics RequestMetrics
+}
+
+// ObserveRequest adds a measurement to the recorded metrics
+func (r *metricsRecorder) ObserveRequest(m RequestMeasurement) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.metrics.LatencyHistogram == nil {
+		r.metrics.LatencyHistogram = make([]int, len(latencyBuckets)+1)
+	}
+
+	r.metrics.Requests++
+	r.metrics.TotalLatency += m.Duration
+	if m.Err != nil || m.StatusCode >= 400 {
+		if r.metrics.Errors == nil {
+			r.metrics.Errors = map[int]int{}
+		}
+		r.metrics.Errors[m.StatusCode]++
+	}
+
+	bucket := sort.Search(len(latencyBuckets), func(i int) bool { return m.Duration <= latencyBuckets[i] })
+	r.metrics.LatencyHistogram[bucket]++
+}
+
+// snapshot returns a copy of the recorded metrics
+func (r *metricsRecorder) snapshot() RequestMetrics {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := r.metrics
+	if m.Errors != nil {
+		m.Errors = make(map[int]int, len(r.metrics.Errors))
+		for status, count := range r.metrics.Errors {
+			m.Errors[status] = count
+		}
+	}
+	m.LatencyHistogram = append([]int(nil), r.metrics.LatencyHistogram...)
+	return m
+}
+
+// operationMetricsKey is the context key of the metricsRecorder for the current provider operation
+type operationMetricsKey struct{}
+
+// operationMetrics returns the recorder for the provider operation running in ctx, if any
+func operationMetrics(ctx context.Context) *metricsRecorder {
+	recorder, _ := ctx.Value(operationMetricsKey{}).(*metricsRecorder)
+	return recorder
+}
+
+// operationMetricsLog controls whether a summary of the requests sent during each
+// provider operation is logged. It is shared by the provider and its configuration,
+// as logging is enabled by configuration but done around every operation.
+type operationMetricsLog struct {
+	enabled atomic.Bool
+}
+
+// wrap records the requests sent during each resource and function operation of
+// provider, logging a summary when enabled
+func (l *operationMetricsLog) wrap(provider p.Provider) p.Provider {
+	provider.Create = recordOperation(l, "create", provider.Create)
+	provider.Read = recordOperation(l, "read", provider.Read)
+	provider.Update = recordOperation(l, "update", provider.Update)
+	provider.Invoke = recordOperation(l, "invoke", provider.Invoke)
+	if deleteFn := provider.Delete; deleteFn != nil {
+		provider.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			ctx, recorder := l.start(ctx)
+			err := deleteFn(ctx, req)
+			l.report(ctx, "delete", recorder)
+			return err
+		}
+	}
+	return provider
+}
+
+// recordOperation wraps a provider method so the requests it sends are recorded
+func recordOperation[I, O any](l *operationMetricsLog, operation string, method func(context.Context, I) (O, error)) func(context.Context, I) (O, error) {
+	if method == nil {
+		return nil
+	}
+	return func(ctx context.Context, req I) (O, error) {
+		ctx, recorder := l.start(ctx)
+		resp, err := method(ctx, req)
+		l.report(ctx, operation, recorder)
+		return resp, err
+	}
+}
+
+// start attaches a new recorder to ctx when logging is enabled
+func (l *operationMetricsLog) start(ctx context.Context) (context.Context, *metricsRecorder) {
+	if !l.enabled.Load() {
+		return ctx, nil
+	}
+	recorder := &metricsRecorder{}
+	return context.WithValue(ctx, operationMetricsKey{}, recorder), recorder
+}
+
+// report logs the requests recorded during an operation
+func (l *operationMetricsLog) report(ctx context.Context, operation string, recorder *metricsRecorder) {
+	if recorder == nil {
+		return
+	}
+	metrics := recorder.snapshot()
+	if metrics.Requests == 0 {
+		return
+	}
+	p.GetLogger(ctx).Infof("%s: %s", operation, metrics)
+}
@@ -0,0 +1,133 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder := &metricsRecorder{}
+	recorder.ObserveRequest(RequestMeasurement{StatusCode: 200, Duration: 40 * time.Millisecond})
+	recorder.ObserveRequest(RequestMeasurement{StatusCode: 429, Duration: 80 * time.Millisecond})
+	recorder.ObserveRequest(RequestMeasurement{Err: errors.New("connection reset"), Duration: time.Second})
+	recorder.ObserveRequest(RequestMeasurement{StatusCode: 201, Duration: time.Minute})
+
+	metrics := recorder.snapshot()
+	assert.Equal(t, 4, metrics.Requests)
+	assert.Equal(t, map[int]int{0: 1, 429: 1}, metrics.Errors)
+	assert.Equal(t, time.Minute+1120*time.Millisecond, metrics.TotalLatency)
+	assert.Equal(t, []int{1, 1, 0, 0, 1, 0, 0, 0, 1}, metrics.LatencyHistogram)
+	assert.Equal(t, "4 SendGrid API requests in 1m1.12s, errors: network=1 429=1, "+
+		"latency: <=50ms=1 <=100ms=1 <=1s=1 >10s=1", metrics.String())
+
+	// Snapshots are not affected by later measurements
+	recorder.ObserveRequest(RequestMeasurement{StatusCode: 500})
+	assert.Equal(t, 4, metrics.Requests)
+	assert.Equal(t, map[int]int{0: 1, 429: 1}, metrics.Errors)
+}
+
+func TestSendGridClient_RequestObserver(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	recorder := &metricsRecorder{}
+	client := newRetryingTestClient(server.URL, 1)
+	WithRequestObserver(recorder)(client)
+
+	ctx := context.WithValue(context.Background(), operationMetricsKey{}, &metricsRecorder{})
+	require.NoError(t, client.Get(ctx, "/v3/suppression/bounces?email=someone@example.com", nil))
+
+	// Each attempt is reported to both the client's observer and the operation's recorder
+	for _, metrics := range []RequestMetrics{recorder.snapshot(), operationMetrics(ctx).snapshot()} {
+		assert.Equal(t, 2, metrics.Requests)
+		assert.Equal(t, map[int]int{503: 1}, metrics.Errors)
+	}
+}
+
+func TestSendGridClient_RequestObserverEndpoint(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var measured RequestMeasurement
+	client := NewSendGridClient("test-api-key", server.URL, WithRequestObserver(observerFunc(func(m RequestMeasurement) {
+		measured = m
+	})))
+	require.NoError(t, client.Get(context.Background(), "/v3/suppression/bounces?email=someone@example.com", nil))
+
+	assert.Equal(t, "GET", measured.Method)
+	assert.Equal(t, "/v3/suppression/bounces", measured.Endpoint)
+	assert.Equal(t, http.StatusOK, measured.StatusCode)
+	assert.NoError(t, measured.Err)
+}
+
+func TestOperationMetricsLog_Wrap(t *testing.T) {
+	t.Parallel()
+
+	var recorded *metricsRecorder
+	provider := p.Provider{
+		Create: func(ctx context.Context, _ p.CreateRequest) (p.CreateResponse, error) {
+			recorded = operationMetrics(ctx)
+			return p.CreateResponse{ID: "123"}, nil
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		log := &operationMetricsLog{}
+		resp, err := log.wrap(provider).Create(context.Background(), p.CreateRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "123", resp.ID)
+		assert.Nil(t, recorded)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		log := &operationMetricsLog{}
+		log.enabled.Store(true)
+		wrapped := log.wrap(provider)
+		assert.Nil(t, wrapped.Delete)
+
+		resp, err := wrapped.Create(context.Background(), p.CreateRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "123", resp.ID)
+		assert.NotNil(t, recorded)
+	})
+}
+
+// observerFunc adapts a function to a RequestObserver
+type observerFunc func(RequestMeasurement)
+
+func (f observerFunc) ObserveRequest(m RequestMeasurement) { f(m) }
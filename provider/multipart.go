@@ -0,0 +1,101 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"strings"
+)
+
+// requestBody is an encoded request body and its content type
+type requestBody struct {
+	data        []byte
+	contentType string
+}
+
+// MultipartForm is the body of a multipart/form-data upload
+type MultipartForm struct {
+	// Fields are plain form fields, sent in key order before the files
+	Fields map[string]string
+
+	// Files are the files to upload, sent in order
+	Files []MultipartFile
+}
+
+// MultipartFile is a file in a multipart/form-data upload
+type MultipartFile struct {
+	// FieldName is the form field the file is sent as, e.g. "upload"
+	FieldName string
+
+	// FileName is the name the file is uploaded with
+	FileName string
+
+	// ContentType is the media type of the file, defaulting to application/octet-stream
+	ContentType string
+
+	// Content is the file content. It is held in memory so the upload can be retried.
+	Content []byte
+}
+
+// quoteEscaper escapes the characters that cannot appear in a quoted header parameter
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// encode writes the form as a multipart/form-data body
+func (f MultipartForm) encode() (*requestBody, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	keys := make([]string, 0, len(f.Fields))
+	for key := range f.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := w.WriteField(key, f.Fields[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, file := range f.Files {
+		if file.FieldName == "" {
+			return nil, fmt.Errorf("file %q has no field name", file.FileName)
+		}
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(file.FieldName), quoteEscaper.Replace(file.FileName)))
+		header.Set("Content-Type", contentType)
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(file.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return &requestBody{data: buf.Bytes(), contentType: w.FormDataContentType()}, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_PostMultipart(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "multipart/form-data", mediaType)
+
+		reader := multipart.NewReader(r.Body, params["boundary"])
+
+		part, err := reader.NextPart()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "list_ids", part.FormName())
+		value, _ := io.ReadAll(part)
+		assert.Equal(t, "abc", string(value))
+
+		part, err = reader.NextPart()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "file", part.FormName())
+		assert.Equal(t, `contacts "new".csv`, part.FileName())
+		assert.Equal(t, "text/csv", part.Header.Get("Content-Type"))
+		content, _ := io.ReadAll(part)
+		assert.Equal(t, "email\na@example.com\n", string(content))
+
+		_, err = reader.NextPart()
+		assert.ErrorIs(t, err, io.EOF)
+
+		// Rate limit the first attempt to check the body is resent in full
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "job-1"}`))
+	})
+
+	client := newRetryingTestClient(server.URL, 1)
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	err := client.PostMultipart(context.Background(), "/v3/uploads", MultipartForm{
+		Fields: map[string]string{"list_ids": "abc"},
+		Files: []MultipartFile{{
+			FieldName:   "file",
+			FileName:    `contacts "new".csv`,
+			ContentType: "text/csv",
+			Content:     []byte("email\na@example.com\n"),
+		}},
+	}, &result)
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", result.ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestMultipartForm_Encode(t *testing.T) {
+	t.Parallel()
+
+	body, err := MultipartForm{Files: []MultipartFile{{FileName: "image.png"}}}.encode()
+	require.Error(t, err)
+	assert.Nil(t, body)
+
+	body, err = MultipartForm{Files: []MultipartFile{{FieldName: "upload", FileName: "image.png"}}}.encode()
+	require.NoError(t, err)
+	assert.Contains(t, string(body.data), "Content-Type: application/octet-stream")
+}
@@ -0,0 +1,233 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// newRelicPartnerSettingID is the ID of the NewRelicPartnerSetting, as there is one per account
+const newRelicPartnerSettingID = "new_relic"
+
+// NewRelicPartnerSetting is the controller for the SendGrid New Relic partner setting.
+//
+// This resource manages the integration that sends email statistics to New Relic.
+// Deleting it disables the integration.
+type NewRelicPartnerSetting struct{}
+
+// NewRelicPartnerSettingArgs are the inputs to the NewRelicPartnerSetting resource.
+type NewRelicPartnerSettingArgs struct {
+	// Enabled turns the New Relic integration on or off (required)
+	Enabled bool `pulumi:"enabled"`
+
+	// LicenseKey is the New Relic license key statistics are sent with (required when enabled)
+	LicenseKey *string `pulumi:"licenseKey,optional" provider:"secret"`
+
+	// EnableSubuserStatistics also sends the statistics of subusers (optional, defaults to false)
+	EnableSubuserStatistics *bool `pulumi:"enableSubuserStatistics,optional"`
+
+	// DeletionProtection prevents the integration from being disabled by deleting the resource,
+	// including by a replacement, while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// NewRelicPartnerSettingState is the state of the NewRelicPartnerSetting resource.
+type NewRelicPartnerSettingState struct {
+	// Embed the input args in the output state
+	NewRelicPartnerSettingArgs
+}
+
+// Annotate provides descriptions for the NewRelicPartnerSetting resource.
+func (n *NewRelicPartnerSetting) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&n, "Manages the SendGrid New Relic partner setting.\n\n"+
+		"When enabled, SendGrid sends your email statistics to New Relic using the given license key. "+
+		"There is one setting per account, so only one of these resources should exist per provider; "+
+		"deleting it disables the integration.")
+}
+
+// newRelicPartnerSettingAPIResponse represents the SendGrid API response structure
+type newRelicPartnerSettingAPIResponse struct {
+	Enabled                 bool   `json:"enabled"`
+	LicenseKey              string `json:"license_key"`
+	EnableSubuserStatistics bool   `json:"enable_subuser_statistics"`
+}
+
+// toState converts an API response to NewRelicPartnerSettingState
+func (r *newRelicPartnerSettingAPIResponse) toState() NewRelicPartnerSettingState {
+	state := NewRelicPartnerSettingState{
+		NewRelicPartnerSettingArgs: NewRelicPartnerSettingArgs{
+			Enabled: r.Enabled,
+		},
+	}
+	if r.LicenseKey != "" {
+		state.LicenseKey = &r.LicenseKey
+	}
+	if r.EnableSubuserStatistics {
+		state.EnableSubuserStatistics = &r.EnableSubuserStatistics
+	}
+	return state
+}
+
+// Check validates the NewRelicPartnerSetting inputs.
+func (n *NewRelicPartnerSetting) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[NewRelicPartnerSettingArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[NewRelicPartnerSettingArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[NewRelicPartnerSettingArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[NewRelicPartnerSettingArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid NewRelicPartnerSettingArgs
+func (args *NewRelicPartnerSettingArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	if args.Enabled && args.LicenseKey == nil && v.known("licenseKey") {
+		v.fail("licenseKey", "is required when enabled is true")
+	}
+	return v.failures
+}
+
+// requestBody builds the PATCH request body from the args
+func (args *NewRelicPartnerSettingArgs) requestBody() map[string]interface{} {
+	reqBody := map[string]interface{}{
+		"enabled": args.Enabled,
+	}
+	if args.LicenseKey != nil {
+		reqBody["license_key"] = *args.LicenseKey
+	}
+	if args.EnableSubuserStatistics != nil {
+		reqBody["enable_subuser_statistics"] = *args.EnableSubuserStatistics
+	}
+	return reqBody
+}
+
+// Create applies the New Relic partner setting.
+func (n *NewRelicPartnerSetting) Create(ctx context.Context, req infer.CreateRequest[NewRelicPartnerSettingArgs]) (infer.CreateResponse[NewRelicPartnerSettingState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return the expected state
+	if preview {
+		return infer.CreateResponse[NewRelicPartnerSettingState]{
+			ID:     newRelicPartnerSettingID,
+			Output: NewRelicPartnerSettingState{NewRelicPartnerSettingArgs: input},
+		}, nil
+	}
+
+	state, err := n.patch(ctx, input)
+	if err != nil {
+		return infer.CreateResponse[NewRelicPartnerSettingState]{}, err
+	}
+
+	return infer.CreateResponse[NewRelicPartnerSettingState]{
+		ID:     newRelicPartnerSettingID,
+		Output: state,
+	}, nil
+}
+
+// patch updates the setting to match the args and returns the resulting state
+func (n *NewRelicPartnerSetting) patch(ctx context.Context, input NewRelicPartnerSettingArgs) (NewRelicPartnerSettingState, error) {
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return NewRelicPartnerSettingState{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	var result newRelicPartnerSettingAPIResponse
+	if err := client.Patch(ctx, "/v3/partner_settings/new_relic", input.requestBody(), &result); err != nil {
+		return NewRelicPartnerSettingState{}, fmt.Errorf("failed to update New Relic partner setting: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.NewRelicPartnerSettingArgs, input, nil)
+	preserveInputs(&state.NewRelicPartnerSettingArgs, input, "licenseKey")
+	return state, nil
+}
+
+// Read retrieves the current New Relic partner setting.
+func (n *NewRelicPartnerSetting) Read(ctx context.Context, req infer.ReadRequest[NewRelicPartnerSettingArgs, NewRelicPartnerSettingState]) (infer.ReadResponse[NewRelicPartnerSettingArgs, NewRelicPartnerSettingState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[NewRelicPartnerSettingArgs, NewRelicPartnerSettingState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// The setting always exists, so there is no not found case
+	var result newRelicPartnerSettingAPIResponse
+	if err := client.Get(ctx, "/v3/partner_settings/new_relic", &result); err != nil {
+		return infer.ReadResponse[NewRelicPartnerSettingArgs, NewRelicPartnerSettingState]{}, fmt.Errorf("failed to read New Relic partner setting: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	normalizeArgs(&state.NewRelicPartnerSettingArgs, req.Inputs, nil)
+	preserveInputs(&state.NewRelicPartnerSettingArgs, req.Inputs, "licenseKey")
+	inputs := state.NewRelicPartnerSettingArgs
+
+	return infer.ReadResponse[NewRelicPartnerSettingArgs, NewRelicPartnerSettingState]{
+		ID:     id,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// Update updates the New Relic partner setting.
+func (n *NewRelicPartnerSetting) Update(ctx context.Context, req infer.UpdateRequest[NewRelicPartnerSettingArgs, NewRelicPartnerSettingState]) (infer.UpdateResponse[NewRelicPartnerSettingState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return expected state
+	if preview {
+		return infer.UpdateResponse[NewRelicPartnerSettingState]{Output: NewRelicPartnerSettingState{NewRelicPartnerSettingArgs: input}}, nil
+	}
+
+	state, err := n.patch(ctx, input)
+	if err != nil {
+		return infer.UpdateResponse[NewRelicPartnerSettingState]{}, err
+	}
+	return infer.UpdateResponse[NewRelicPartnerSettingState]{Output: state}, nil
+}
+
+// Delete disables the New Relic integration.
+func (n *NewRelicPartnerSetting) Delete(ctx context.Context, req infer.DeleteRequest[NewRelicPartnerSettingState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "New Relic partner setting", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	if err := client.Patch(ctx, "/v3/partner_settings/new_relic", map[string]interface{}{"enabled": false}, nil); err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("failed to disable New Relic partner setting: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
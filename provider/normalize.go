@@ -0,0 +1,105 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// implicitScopes are scopes SendGrid adds to API keys and teammates on its own
+var implicitScopes = []string{"2fa_exempt", "2fa_required", "sender_verification_eligible", "sender_verification_exempt"}
+
+// normalizeArgs aligns inputs read back from SendGrid with the inputs they were
+// written from, so that a preview right after an apply shows no changes. SendGrid
+// reports optional fields whether or not they were set, while toState leaves out
+// empty values, so:
+//   - an optional input left unset stays unset while SendGrid reports its default
+//   - an optional input explicitly set to its zero value, such as false or "",
+//     keeps that value when SendGrid leaves it out
+//   - an empty list and an unset list are alike
+//
+// Defaults lists the fields whose default is not the zero value, by Pulumi name.
+func normalizeArgs[I any](actual *I, inputs I, defaults map[string]any) {
+	actualValue, inputValue := reflect.ValueOf(actual).Elem(), reflect.ValueOf(inputs)
+	for i := range actualValue.NumField() {
+		name, _, _ := strings.Cut(actualValue.Type().Field(i).Tag.Get("pulumi"), ",")
+		a, in := actualValue.Field(i), inputValue.Field(i)
+
+		switch a.Kind() {
+		case reflect.Pointer:
+			switch {
+			case in.IsNil() && !a.IsNil() && isDefaultValue(a.Elem(), defaults, name):
+				a.SetZero()
+			case !in.IsNil() && a.IsNil() && in.Elem().IsZero():
+				a.Set(in)
+			}
+		case reflect.Slice:
+			if a.Len() == 0 && in.Len() == 0 {
+				a.Set(in)
+			}
+		}
+	}
+}
+
+// preserveInputs carries the named inputs over to state read back from SendGrid
+// when SendGrid leaves them out, as it does for fields it only accepts on writes.
+func preserveInputs[I any](actual *I, inputs I, names ...string) {
+	actualValue, inputValue := reflect.ValueOf(actual).Elem(), reflect.ValueOf(inputs)
+	for i := range actualValue.NumField() {
+		name, _, _ := strings.Cut(actualValue.Type().Field(i).Tag.Get("pulumi"), ",")
+		if slices.Contains(names, name) && isUnset(actualValue.Field(i)) {
+			actualValue.Field(i).Set(inputValue.Field(i))
+		}
+	}
+}
+
+// isDefaultValue reports whether v is the default value of the named field
+func isDefaultValue(v reflect.Value, defaults map[string]any, name string) bool {
+	if d, ok := defaults[name]; ok {
+		return reflect.DeepEqual(v.Interface(), d)
+	}
+	return v.IsZero()
+}
+
+// normalizeScopes returns the scopes SendGrid reports in the order they were
+// requested, as SendGrid returns them in its own order. Scopes SendGrid adds on
+// its own are left out unless requested; other unrequested scopes follow in the
+// reported order, so real drift still shows.
+func normalizeScopes(requested, actual []string) []string {
+	var result []string
+	for _, scope := range requested {
+		if slices.Contains(actual, scope) && !slices.Contains(result, scope) {
+			result = append(result, scope)
+		}
+	}
+	for _, scope := range actual {
+		if !slices.Contains(result, scope) && !slices.Contains(implicitScopes, scope) {
+			result = append(result, scope)
+		}
+	}
+	return result
+}
+
+// trackedScopes normalizes the scopes SendGrid reports for an API key or
+// teammate. SendGrid reports every scope the account holds for full access, so
+// full access granted without listing scopes keeps recording no scopes.
+func trackedScopes(requested, actual []string, fullAccess bool) []string {
+	if fullAccess && len(requested) == 0 {
+		return nil
+	}
+	return normalizeScopes(requested, actual)
+}
@@ -0,0 +1,345 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestNormalizeArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults of unset inputs are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		actual := EventWebhookArgs{URL: "https://example.com", Enabled: boolPtr(true), Bounce: boolPtr(false)}
+		normalizeArgs(&actual, EventWebhookArgs{URL: "https://example.com"}, eventWebhookDefaults)
+
+		assert.Nil(t, actual.Enabled)
+		assert.Nil(t, actual.Bounce)
+	})
+
+	t.Run("non-default values of unset inputs are kept", func(t *testing.T) {
+		t.Parallel()
+
+		actual := EventWebhookArgs{URL: "https://example.com", Enabled: boolPtr(false), Bounce: boolPtr(true)}
+		normalizeArgs(&actual, EventWebhookArgs{URL: "https://example.com"}, eventWebhookDefaults)
+
+		assert.Equal(t, boolPtr(false), actual.Enabled)
+		assert.Equal(t, boolPtr(true), actual.Bounce)
+	})
+
+	t.Run("explicit zero values are kept", func(t *testing.T) {
+		t.Parallel()
+
+		actual := EventWebhookArgs{URL: "https://example.com"}
+		normalizeArgs(&actual, EventWebhookArgs{URL: "https://example.com", Click: boolPtr(false), FriendlyName: strPtr("")}, eventWebhookDefaults)
+
+		assert.Equal(t, boolPtr(false), actual.Click)
+		assert.Equal(t, strPtr(""), actual.FriendlyName)
+	})
+
+	t.Run("empty lists match the inputs", func(t *testing.T) {
+		t.Parallel()
+
+		actual := DomainAuthenticationArgs{Domain: "example.com", Ips: []string{}}
+		normalizeArgs(&actual, DomainAuthenticationArgs{Domain: "example.com"}, nil)
+		assert.Nil(t, actual.Ips)
+
+		actual = DomainAuthenticationArgs{Domain: "example.com"}
+		normalizeArgs(&actual, DomainAuthenticationArgs{Domain: "example.com", Ips: []string{}}, nil)
+		assert.Equal(t, []string{}, actual.Ips)
+	})
+}
+
+func TestNormalizeScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		requested []string
+		actual    []string
+		want      []string
+	}{
+		{
+			name:      "requested order",
+			requested: []string{"mail.send", "alerts.read"},
+			actual:    []string{"alerts.read", "mail.send"},
+			want:      []string{"mail.send", "alerts.read"},
+		},
+		{
+			name:      "implicit scopes dropped",
+			requested: []string{"mail.send"},
+			actual:    []string{"2fa_required", "mail.send", "sender_verification_eligible"},
+			want:      []string{"mail.send"},
+		},
+		{
+			name:      "requested implicit scopes kept",
+			requested: []string{"2fa_exempt", "mail.send"},
+			actual:    []string{"mail.send", "2fa_exempt"},
+			want:      []string{"2fa_exempt", "mail.send"},
+		},
+		{
+			name:      "drift kept",
+			requested: []string{"mail.send", "alerts.read"},
+			actual:    []string{"templates.read", "mail.send"},
+			want:      []string{"mail.send", "templates.read"},
+		},
+		{
+			name:   "nothing granted",
+			actual: []string{"2fa_required"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, normalizeScopes(tt.requested, tt.actual))
+		})
+	}
+}
+
+func TestTrackedScopes(t *testing.T) {
+	t.Parallel()
+
+	all := []string{"alerts.read", "mail.send", "2fa_required"}
+
+	assert.Nil(t, trackedScopes(nil, all, true))
+	assert.Equal(t, []string{"alerts.read", "mail.send"}, trackedScopes(nil, all, false))
+	assert.Equal(t, []string{"mail.send"}, trackedScopes([]string{"mail.send"}, []string{"mail.send"}, true))
+}
+
+func TestDiff_NoChangesAfterApply(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, nil, &calls)
+
+	tests := []struct {
+		name   string
+		typ    string
+		state  map[string]property.Value
+		inputs map[string]property.Value
+	}{
+		{
+			name:   "teammate without isAdmin",
+			typ:    "Teammate",
+			state:  map[string]property.Value{"email": property.New("a@example.com"), "isAdmin": property.New(false)},
+			inputs: map[string]property.Value{"email": property.New("a@example.com")},
+		},
+		{
+			name: "subuser without disabled",
+			typ:  "Subuser",
+			state: map[string]property.Value{
+				"username": property.New("tenant-a"),
+				"email":    property.New("a@example.com"),
+				"userId":   property.New(1.0),
+				"disabled": property.New(false),
+			},
+			inputs: map[string]property.Value{
+				"username": property.New("tenant-a"),
+				"email":    property.New("a@example.com"),
+				"password": property.New("pw"),
+			},
+		},
+		{
+			name: "event webhook with defaults left unset",
+			typ:  "EventWebhook",
+			state: map[string]property.Value{
+				"url":       property.New("https://example.com"),
+				"webhookId": property.New("wh-1"),
+			},
+			inputs: map[string]property.Value{"url": property.New("https://example.com")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Diff(p.DiffRequest{
+				ID:     "id",
+				Urn:    previewURN(tt.typ, "test"),
+				State:  property.NewMap(tt.state),
+				Inputs: property.NewMap(tt.inputs),
+			})
+			require.NoError(t, err)
+			assert.False(t, resp.HasChanges, "%v", resp.DetailedDiff)
+		})
+	}
+}
+
+func TestPreserveInputs(t *testing.T) {
+	t.Parallel()
+
+	actual := DomainAuthenticationArgs{Domain: "example.com", Region: strPtr("global")}
+	normalizeArgs(&actual, DomainAuthenticationArgs{}, nil)
+	preserveInputs(&actual, DomainAuthenticationArgs{
+		Domain:             "example.org",
+		CustomDkimSelector: strPtr("s1"),
+		Region:             strPtr("eu"),
+		Ips:                []string{"192.0.2.1"},
+	}, "customDkimSelector", "region")
+
+	assert.Equal(t, "example.com", actual.Domain)
+	assert.Equal(t, strPtr("s1"), actual.CustomDkimSelector)
+	assert.Equal(t, strPtr("global"), actual.Region, "returned values win")
+	assert.Nil(t, actual.Ips, "only the named inputs are carried over")
+}
+
+func TestNormalizeTestData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		actual *string
+		input  *string
+		want   *string
+	}{
+		{name: "stripped", actual: nil, input: strPtr(`{"a":1}`), want: strPtr(`{"a":1}`)},
+		{name: "reformatted", actual: strPtr(`{"a": 1}`), input: strPtr(`{"a":1}`), want: strPtr(`{"a":1}`)},
+		{name: "changed", actual: strPtr(`{"a":2}`), input: strPtr(`{"a":1}`), want: strPtr(`{"a":2}`)},
+		{name: "not an input", actual: strPtr(`{"a":2}`), input: nil, want: strPtr(`{"a":2}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := TemplateVersionArgs{TestData: tt.actual}
+			normalizeTestData(&actual, TemplateVersionArgs{TestData: tt.input})
+			assert.Equal(t, tt.want, actual.TestData)
+		})
+	}
+}
+
+func TestRead_PreservesInputs(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, map[string]string{
+		"/v3/whitelabel/domains/7": `{"id": 7, "user_id": 1, "username": "parent", "domain": "example.com", "subdomain": "em", "valid": true}`,
+		"/v3/whitelabel/links/9":   `{"id": 9, "user_id": 1, "username": "parent", "domain": "example.com", "subdomain": "url", "valid": true}`,
+		"/v3/subusers/tenant-a":    `{"id": 3, "username": "tenant-a", "email": "a@example.com", "disabled": false}`,
+		"/v3/templates/d-1/versions/v-1": `{"id": "v-1", "template_id": "d-1", "name": "v1", "active": 1,
+			"editor": "code", "generate_plain_content": true, "test_data": "{\"name\": \"Ada\"}"}`,
+	}, &calls)
+
+	tests := []struct {
+		name   string
+		typ    string
+		id     string
+		state  map[string]property.Value
+		inputs map[string]property.Value
+		keep   []string
+	}{
+		{
+			name: "domain authentication write-only fields",
+			typ:  "DomainAuthentication",
+			id:   "7",
+			state: map[string]property.Value{
+				"domain":             property.New("example.com"),
+				"subdomain":          property.New("em"),
+				"customDkimSelector": property.New("s1"),
+				"region":             property.New("eu"),
+			},
+			inputs: map[string]property.Value{
+				"domain":             property.New("example.com"),
+				"subdomain":          property.New("em"),
+				"customDkimSelector": property.New("s1"),
+				"region":             property.New("eu"),
+			},
+			keep: []string{"customDkimSelector", "region"},
+		},
+		{
+			name:   "link branding region",
+			typ:    "LinkBranding",
+			id:     "9",
+			state:  map[string]property.Value{"domain": property.New("example.com"), "region": property.New("eu")},
+			inputs: map[string]property.Value{"domain": property.New("example.com"), "region": property.New("eu")},
+			keep:   []string{"region"},
+		},
+		{
+			name: "subuser password, ips and region",
+			typ:  "Subuser",
+			id:   "tenant-a",
+			state: map[string]property.Value{
+				"username": property.New("tenant-a"),
+				"email":    property.New("a@example.com"),
+				"userId":   property.New(3.0),
+				"ips":      property.New([]property.Value{property.New("192.0.2.1")}),
+				"region":   property.New("eu"),
+				"disabled": property.New(false),
+			},
+			inputs: map[string]property.Value{
+				"username": property.New("tenant-a"),
+				"email":    property.New("a@example.com"),
+				"password": property.New("pw"),
+				"ips":      property.New([]property.Value{property.New("192.0.2.1")}),
+				"region":   property.New("eu"),
+			},
+			keep: []string{"password", "ips", "region"},
+		},
+		{
+			name: "template version test data",
+			typ:  "TemplateVersion",
+			id:   "v-1",
+			state: map[string]property.Value{
+				"templateId": property.New("d-1"),
+				"versionId":  property.New("v-1"),
+				"name":       property.New("v1"),
+				"testData":   property.New(`{"name":"Ada"}`),
+			},
+			inputs: map[string]property.Value{
+				"templateId": property.New("d-1"),
+				"name":       property.New("v1"),
+				"testData":   property.New(`{"name":"Ada"}`),
+			},
+			keep: []string{"testData"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Read(p.ReadRequest{
+				ID:         tt.id,
+				Urn:        previewURN(tt.typ, "test"),
+				Properties: property.NewMap(tt.state),
+				Inputs:     property.NewMap(tt.inputs),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.id, resp.ID)
+
+			for _, key := range tt.keep {
+				assert.Equal(t, tt.inputs[key], resp.Inputs.Get(key), "input %s", key)
+			}
+			for key := range tt.inputs {
+				if _, ok := resp.Inputs.GetOk(key); !ok {
+					t.Errorf("input %s was dropped", key)
+				}
+			}
+			assert.NotContains(t, resp.Inputs.AsMap(), "disabled")
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PaginationStyle selects the query parameters GetAllPages uses to request pages
+type PaginationStyle int
+
+const (
+	// PaginateNone sends no paging parameters and only follows Link headers
+	PaginateNone PaginationStyle = iota
+
+	// PaginateOffset requests pages with limit/offset query parameters.
+	// Paging stops at the first page with fewer than PageSize items.
+	PaginateOffset
+
+	// PaginateToken requests pages with page_size/page_token query parameters,
+	// taking the token for the next page from the "_metadata.next" URL.
+	PaginateToken
+)
+
+// PageOptions configures how GetAllPages requests a list endpoint
+type PageOptions struct {
+	// Style is the pagination scheme used by the endpoint
+	Style PaginationStyle
+
+	// PageSize is the number of items requested per page (ignored for PaginateNone)
+	PageSize int
+
+	// Query holds additional query parameters sent with every page request
+	Query url.Values
+}
+
+// listPage is a single page of a list endpoint that wraps its items in an object.
+// Most endpoints use "result", some newer ones use "results"; whichever is present is used.
+type listPage[T any] struct {
+	Result   []T `json:"result"`
+	Results  []T `json:"results"`
+	Metadata struct {
+		Next string `json:"next"`
+	} `json:"_metadata"`
+}
+
+// GetAllPages retrieves every page of a SendGrid list endpoint and returns the
+// combined items, so large accounts are not silently truncated to the first page.
+// Pages may be bare JSON arrays or objects wrapping the items in "result" or
+// "results". A Link header with rel="next" is followed when present; otherwise
+// the endpoint's pagination style decides how the next page is requested.
+func GetAllPages[T any](ctx context.Context, c SendGridAPI, path string, opts PageOptions) ([]T, error) {
+	query := url.Values{}
+	for k, v := range opts.Query {
+		query[k] = append([]string(nil), v...)
+	}
+
+	offset := 0
+	switch opts.Style {
+	case PaginateOffset:
+		query.Set("limit", strconv.Itoa(opts.PageSize))
+		query.Set("offset", "0")
+	case PaginateToken:
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+
+	var all []T
+	var previous json.RawMessage
+	next := withQuery(path, query)
+	seen := map[string]bool{}
+	for {
+		seen[next] = true
+
+		var raw json.RawMessage
+		header, err := c.GetWithHeaders(ctx, next, &raw)
+		if err != nil {
+			if len(all) > 0 && ctx.Err() != nil {
+				return nil, fmt.Errorf("listing %s stopped after %d items: %w", path, len(all), err)
+			}
+			return nil, err
+		}
+		items, nextToken, err := decodePage[T](raw)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		candidate := ""
+		if link, err := nextLink(header); err != nil {
+			return nil, err
+		} else if link != "" {
+			candidate = link
+		} else {
+			switch opts.Style {
+			case PaginateOffset:
+				// A short page is the last page. A full page identical to the previous
+				// one means the endpoint ignores the offset parameter.
+				if len(items) == opts.PageSize && !bytes.Equal(raw, previous) {
+					offset += opts.PageSize
+					query.Set("offset", strconv.Itoa(offset))
+					candidate = withQuery(path, query)
+				}
+			case PaginateToken:
+				token, err := nextPageToken(nextToken)
+				if err != nil {
+					return nil, err
+				}
+				if token != "" {
+					query.Set("page_token", token)
+					candidate = withQuery(path, query)
+				}
+			}
+		}
+
+		// Guard against endpoints that keep pointing at a page already retrieved
+		if candidate == "" || seen[candidate] {
+			return all, nil
+		}
+		next = candidate
+		previous = raw
+	}
+}
+
+// decodePage extracts the items and "_metadata.next" URL from a page of a list endpoint
+func decodePage[T any](raw json.RawMessage) ([]T, string, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, "", nil
+	}
+
+	if trimmed[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, "", fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return items, "", nil
+	}
+
+	var page listPage[T]
+	if err := json.Unmarshal(trimmed, &page); err != nil {
+		return nil, "", fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return append(page.Result, page.Results...), page.Metadata.Next, nil
+}
+
+// nextLink returns the path and query of the rel="next" entry of a Link header,
+// e.g. <https://api.sendgrid.com/v3/teammates?limit=500&offset=500>; rel="next"
+func nextLink(header http.Header) (string, error) {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			for _, param := range parts[1:] {
+				if strings.ReplaceAll(strings.TrimSpace(param), " ", "") != `rel="next"` {
+					continue
+				}
+				u, err := url.Parse(target)
+				if err != nil {
+					return "", fmt.Errorf("failed to parse next page link: %w", err)
+				}
+				return u.RequestURI(), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// nextPageToken extracts the page_token query parameter from a "_metadata.next" URL
+func nextPageToken(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse next page URL: %w", err)
+	}
+	return u.Query().Get("page_token"), nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllPages(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		ID int `json:"id"`
+	}
+
+	t.Run("follows link header", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v3/teammates", r.URL.Path)
+			switch r.URL.Query().Get("offset") {
+			case "0":
+				w.Header().Set("Link", `<https://api.sendgrid.com/v3/teammates?limit=2&offset=2>; rel="next"; title="2", `+
+					`<https://api.sendgrid.com/v3/teammates?limit=2&offset=0>; rel="first"; title="1"`)
+				_, _ = w.Write([]byte(`{"result": [{"id": 1}, {"id": 2}]}`))
+			case "2":
+				w.Header().Set("Link", `<https://api.sendgrid.com/v3/teammates?limit=2&offset=0>; rel="prev"; title="1"`)
+				_, _ = w.Write([]byte(`{"result": [{"id": 3}, {"id": 4}]}`))
+			default:
+				t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+			}
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		// A full last page would normally trigger another request; the Link header says there is none
+		items, err := GetAllPages[item](context.Background(), client, "/v3/teammates", PageOptions{Style: PaginateOffset, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []item{{1}, {2}, {3}, {4}}, items)
+	})
+
+	t.Run("link header without paging parameters", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "" {
+				assert.Empty(t, r.URL.RawQuery)
+				w.Header().Set("Link", `<https://api.sendgrid.com/v3/verified_senders?page=2>; rel="next"`)
+				_, _ = w.Write([]byte(`{"results": [{"id": 10}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"results": [{"id": 11}]}`))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		items, err := GetAllPages[item](context.Background(), client, "/v3/verified_senders", PageOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []item{{10}, {11}}, items)
+	})
+
+	t.Run("endpoint ignoring offset", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			_, _ = w.Write([]byte(`[{"id": 1}, {"id": 2}]`))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		items, err := GetAllPages[item](context.Background(), client, "/v3/things", PageOptions{Style: PaginateOffset, PageSize: 2})
+		require.NoError(t, err)
+		assert.Len(t, items, 4)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("link cycle", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/v3/things>; rel="next"`, r.Host))
+			_, _ = w.Write([]byte(`[{"id": 1}]`))
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		items, err := GetAllPages[item](context.Background(), client, "/v3/things", PageOptions{})
+		require.NoError(t, err)
+		assert.Len(t, items, 1)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		items, err := GetAllPages[item](context.Background(), client, "/v3/things", PageOptions{Style: PaginateOffset, PageSize: 10})
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+}
+
+func TestNextLink(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	assert.Empty(t, mustNextLink(t, header))
+
+	header.Set("Link", `<https://api.sendgrid.com/v3/templates?page_size=10&page_token=abc>; rel="next"`)
+	assert.Equal(t, "/v3/templates?page_size=10&page_token=abc", mustNextLink(t, header))
+
+	header.Set("Link", `<https://api.sendgrid.com/v3/templates?offset=0>; rel="prev"`)
+	assert.Empty(t, mustNextLink(t, header))
+}
+
+func mustNextLink(t *testing.T, header http.Header) string {
+	link, err := nextLink(header)
+	require.NoError(t, err)
+	return link
+}
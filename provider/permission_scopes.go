@@ -0,0 +1,131 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+)
+
+// scopeRule maps the endpoints matching pattern to the scope that guards them.
+// A "*" segment in pattern matches any one segment, and a "*" in scope is replaced
+// by the segment it matched, e.g. /v3/mail_settings/* guarded by mail_settings.*.
+type scopeRule struct {
+	pattern string
+	scope   string
+
+	// exact means the scope is complete, rather than a prefix that the request
+	// method completes with .create, .read, .update or .delete
+	exact bool
+}
+
+// scopeRules lists the scopes of the endpoints the provider calls, the most
+// specific patterns first, following SendGrid's API key permissions list
+var scopeRules = []scopeRule{
+	{pattern: "/v3/alerts", scope: "alerts"},
+	{pattern: "/v3/api_keys", scope: "api_keys"},
+	{pattern: "/v3/asm/groups/*/suppressions", scope: "asm.groups.suppressions"},
+	{pattern: "/v3/asm/groups", scope: "asm.groups"},
+	{pattern: "/v3/asm/suppressions/global", scope: "asm.suppressions.global"},
+	{pattern: "/v3/asm/suppressions", scope: "asm.groups.suppressions"},
+	{pattern: "/v3/categories/stats", scope: "categories.stats"},
+	{pattern: "/v3/categories", scope: "categories"},
+	{pattern: "/v3/contactdb", scope: "marketing_campaigns"},
+	{pattern: "/v3/ips/pools", scope: "ips.pools"},
+	{pattern: "/v3/ips", scope: "ips"},
+	{pattern: "/v3/mail/batch", scope: "mail.batch"},
+	{pattern: "/v3/mail/send", scope: "mail.send", exact: true},
+	{pattern: "/v3/mail_settings/*", scope: "mail_settings.*"},
+	{pattern: "/v3/mail_settings", scope: "mail_settings"},
+	{pattern: "/v3/messages", scope: "messages"},
+	{pattern: "/v3/partner_settings/*", scope: "partner_settings.*"},
+	{pattern: "/v3/partner_settings", scope: "partner_settings"},
+	{pattern: "/v3/stats", scope: "stats.global"},
+	{pattern: "/v3/subusers/reputations", scope: "subusers.reputations"},
+	{pattern: "/v3/subusers/stats", scope: "subusers.stats"},
+	{pattern: "/v3/subusers", scope: "subusers"},
+	{pattern: "/v3/suppression/*", scope: "suppression.*"},
+	{pattern: "/v3/teammates", scope: "teammates"},
+	{pattern: "/v3/templates/*/versions/*/activate", scope: "templates.versions.activate"},
+	{pattern: "/v3/templates/*/versions", scope: "templates.versions"},
+	{pattern: "/v3/templates", scope: "templates"},
+	{pattern: "/v3/tracking_settings/*", scope: "tracking_settings.*"},
+	{pattern: "/v3/tracking_settings", scope: "tracking_settings"},
+	{pattern: "/v3/user/account", scope: "user.account"},
+	{pattern: "/v3/user/credits", scope: "user.credits"},
+	{pattern: "/v3/user/email", scope: "user.email"},
+	{pattern: "/v3/user/password", scope: "user.password"},
+	{pattern: "/v3/user/profile", scope: "user.profile"},
+	{pattern: "/v3/user/scheduled_sends", scope: "user.scheduled_sends"},
+	{pattern: "/v3/user/username", scope: "user.username"},
+	{pattern: "/v3/user/webhooks/event/test", scope: "user.webhooks.event.test"},
+	{pattern: "/v3/user/webhooks/event", scope: "user.webhooks.event.settings"},
+	{pattern: "/v3/user/webhooks/parse", scope: "user.webhooks.parse.settings"},
+	{pattern: "/v3/validation/email", scope: "validations.email"},
+	{pattern: "/v3/validations/email", scope: "validations.email"},
+	{pattern: "/v3/whitelabel/*/*/validate", scope: "whitelabel.update", exact: true},
+	{pattern: "/v3/whitelabel", scope: "whitelabel"},
+}
+
+// requiredScope returns the scope an API key needs to call method on endpoint,
+// e.g. whitelabel.create for POST /v3/whitelabel/domains, or "" if it is not known
+func requiredScope(method, endpoint string) string {
+	// A baseUrl with a path, e.g. for a proxy, prefixes the endpoint
+	if i := strings.Index(endpoint, "/v3/"); i > 0 {
+		endpoint = endpoint[i:]
+	}
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+	for _, rule := range scopeRules {
+		scope, ok := rule.match(segments)
+		if !ok {
+			continue
+		}
+		if rule.exact {
+			return scope
+		}
+		switch method {
+		case http.MethodGet:
+			return scope + ".read"
+		case http.MethodPost:
+			return scope + ".create"
+		case http.MethodPut, http.MethodPatch:
+			return scope + ".update"
+		case http.MethodDelete:
+			return scope + ".delete"
+		}
+		return ""
+	}
+	return ""
+}
+
+// match reports whether the rule's pattern is a prefix of segments, and returns
+// its scope with any "*" replaced by the segment it matched
+func (r scopeRule) match(segments []string) (string, bool) {
+	pattern := strings.Split(strings.Trim(r.pattern, "/"), "/")
+	if len(pattern) > len(segments) {
+		return "", false
+	}
+	scope := r.scope
+	for i, part := range pattern {
+		switch part {
+		case "*":
+			scope = strings.Replace(scope, "*", segments[i], 1)
+		case segments[i]:
+		default:
+			return "", false
+		}
+	}
+	return scope, true
+}
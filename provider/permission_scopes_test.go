@@ -0,0 +1,86 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method, endpoint, scope string
+	}{
+		{http.MethodPost, "/v3/whitelabel/domains", "whitelabel.create"},
+		{http.MethodPost, "/v3/whitelabel/links/9/validate", "whitelabel.update"},
+		{http.MethodGet, "/v3/api_keys/key-1", "api_keys.read"},
+		{http.MethodPut, "/v3/api_keys/key-1", "api_keys.update"},
+		{http.MethodDelete, "/v3/asm/groups/42", "asm.groups.delete"},
+		{http.MethodPost, "/v3/asm/groups/42/suppressions", "asm.groups.suppressions.create"},
+		{http.MethodDelete, "/v3/asm/suppressions/global/jdoe@example.com", "asm.suppressions.global.delete"},
+		{http.MethodPatch, "/v3/mail_settings/bcc", "mail_settings.bcc.update"},
+		{http.MethodGet, "/v3/mail_settings", "mail_settings.read"},
+		{http.MethodPatch, "/v3/partner_settings/new_relic", "partner_settings.new_relic.update"},
+		{http.MethodGet, "/v3/suppression/bounces", "suppression.bounces.read"},
+		{http.MethodPatch, "/v3/tracking_settings/open", "tracking_settings.open.update"},
+		{http.MethodPost, "/v3/templates/d-1/versions", "templates.versions.create"},
+		{http.MethodPatch, "/v3/templates/d-1/versions/v-1", "templates.versions.update"},
+		{http.MethodPost, "/v3/templates/d-1/versions/v-1/activate", "templates.versions.activate.create"},
+		{http.MethodGet, "/v3/teammates/pending", "teammates.read"},
+		{http.MethodPost, "/v3/user/webhooks/event/test", "user.webhooks.event.test.create"},
+		{http.MethodPatch, "/v3/user/webhooks/event/settings/signed/wh-1", "user.webhooks.event.settings.update"},
+		{http.MethodPost, "/v3/mail/send", "mail.send"},
+		{http.MethodPost, "/sendgrid/v3/whitelabel/domains", "whitelabel.create"},
+		{http.MethodGet, "/v3/scopes", ""},
+		{http.MethodGet, "/v3/unknown", ""},
+		{http.MethodHead, "/v3/alerts", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.scope, requiredScope(tt.method, tt.endpoint), "%s %s", tt.method, tt.endpoint)
+	}
+}
+
+func TestSendGridClient_ForbiddenScope(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": [{"message": "access forbidden"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	err := client.Post(context.Background(), "/v3/whitelabel/domains", map[string]string{}, nil)
+	var sgErr *SendGridError
+	require.True(t, errors.As(err, &sgErr))
+	assert.Equal(t, "whitelabel.create", sgErr.RequiredScope)
+	assert.Contains(t, err.Error(), "access forbidden [the API key may be missing the whitelabel.create scope]")
+
+	// Only permission errors point at a scope
+	err = client.Get(context.Background(), "/v3/whitelabel/domains", nil)
+	require.True(t, errors.As(err, &sgErr))
+	assert.Empty(t, sgErr.RequiredScope)
+	assert.NotContains(t, err.Error(), "scope")
+}
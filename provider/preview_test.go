@@ -0,0 +1,87 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestCreate_PreviewOutputsUnknown(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, nil, &calls)
+
+	tests := []struct {
+		typ      string
+		inputs   map[string]property.Value
+		computed []string
+	}{
+		{
+			typ:      "ApiKey",
+			inputs:   map[string]property.Value{"name": property.New("ci")},
+			computed: []string{"apiKeyId"},
+		},
+		{
+			typ:      "Template",
+			inputs:   map[string]property.Value{"name": property.New("welcome"), "generation": property.New("dynamic")},
+			computed: []string{"templateId"},
+		},
+		{
+			typ:      "EventWebhook",
+			inputs:   map[string]property.Value{"url": property.New("https://example.com/hook")},
+			computed: []string{"webhookId"},
+		},
+		{
+			typ: "TemplateVersion",
+			inputs: map[string]property.Value{
+				"templateId": property.New("d-1"),
+				"name":       property.New("v1"),
+				"subject":    property.New("Hello"),
+			},
+			computed: []string{"versionId"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Create(p.CreateRequest{
+				Urn:        previewURN(tt.typ, "test"),
+				Properties: property.NewMap(tt.inputs),
+				DryRun:     true,
+			})
+			require.NoError(t, err)
+
+			assert.Empty(t, resp.ID)
+			for _, key := range tt.computed {
+				assert.True(t, resp.Properties.Get(key).IsComputed(), "%s should be unknown", key)
+			}
+			for key, v := range resp.Properties.All {
+				if v.IsString() {
+					assert.False(t, strings.HasPrefix(v.AsString(), "["), "%s = %q", key, v.AsString())
+				}
+			}
+		})
+	}
+}
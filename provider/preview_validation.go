@@ -0,0 +1,138 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// previewValidationClient returns the client to use for read-only lookups
+// during Check, or nil unless validateOnPreview is enabled
+func previewValidationClient(ctx context.Context) SendGridAPI {
+	config := infer.GetConfig[Config](ctx)
+	if config.ValidateOnPreview == nil || !*config.ValidateOnPreview {
+		return nil
+	}
+	return config.client
+}
+
+// inputsKnown reports whether the given inputs are known, i.e. do not depend on
+// outputs of resources that have not been created yet
+func inputsKnown(inputs property.Map, keys ...string) bool {
+	for _, key := range keys {
+		if value, ok := inputs.GetOk(key); ok && value.HasComputed() {
+			return false
+		}
+	}
+	return true
+}
+
+// inputsChanged reports whether any of the given inputs differ between the old
+// and new inputs. All inputs have changed when the resource is being created.
+func inputsChanged(req infer.CheckRequest, keys ...string) bool {
+	if req.OldInputs.Len() == 0 {
+		return true
+	}
+	for _, key := range keys {
+		if !req.OldInputs.Get(key).Equals(req.NewInputs.Get(key)) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupFailures turns a failed validation lookup into check failures. A key that
+// may not read the resource will not be able to manage it either, so permission
+// errors fail the check. Other errors are only logged, as validation is best effort.
+func lookupFailures(ctx context.Context, property, what string, err error) []p.CheckFailure {
+	var sgErr *SendGridError
+	if errors.As(err, &sgErr) && (sgErr.StatusCode == http.StatusUnauthorized || sgErr.StatusCode == http.StatusForbidden) {
+		return []p.CheckFailure{{
+			Property: property,
+			Reason:   fmt.Sprintf("the provider's API key is not allowed to read %s: %v", what, err),
+		}}
+	}
+	p.GetLogger(ctx).Warningf("Skipping validation of %s: %v", what, err)
+	return nil
+}
+
+// heldScopes returns the scopes of the provider's API key. They are cached like list
+// responses, so checking many API keys and teammates in one operation reads them once.
+func heldScopes(ctx context.Context, client SendGridAPI) ([]string, error) {
+	fetch := func() (any, error) {
+		// GET /v3/scopes lists the scopes granted to the calling key
+		var result struct {
+			Scopes []string `json:"scopes"`
+		}
+		if err := client.Get(ctx, "/v3/scopes", &result); err != nil {
+			return nil, err
+		}
+		return result.Scopes, nil
+	}
+
+	sg, ok := client.(*SendGridClient)
+	if !ok || sg.lists == nil {
+		value, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		return value.([]string), nil
+	}
+	value, err := sg.lists.get(ctx, "/v3/scopes", fetch)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(value.([]string)), nil
+}
+
+// checkGrantableScopes verifies that the provider's API key holds every scope it
+// is asked to grant, as SendGrid rejects API keys with scopes their creator lacks
+func checkGrantableScopes(ctx context.Context, client SendGridAPI, scopes []string) []p.CheckFailure {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	held, err := heldScopes(ctx, client)
+	if err != nil {
+		return lookupFailures(ctx, "scopes", "its own scopes", err)
+	}
+
+	granted := make(map[string]bool, len(held))
+	for _, scope := range held {
+		granted[scope] = true
+	}
+	var missing []string
+	for _, scope := range scopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return []p.CheckFailure{{
+		Property: "scopes",
+		Reason:   fmt.Sprintf("the provider's API key cannot grant scopes it does not hold: %s", strings.Join(missing, ", ")),
+	}}
+}
@@ -0,0 +1,274 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// previewValidationServer starts a provider whose SendGrid API is faked by responses,
+// keyed by request path. Requests are counted in calls.
+func previewValidationServer(t *testing.T, validateOnPreview bool, responses map[string]string, calls *int32) integration.Server {
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(calls, 1)
+		assert.Equal(t, http.MethodGet, req.Method)
+		if body, ok := responses[req.URL.Path]; ok {
+			return fakeResponse(req, http.StatusOK, body), nil
+		}
+		return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "resource not found"}]}`), nil
+	})
+
+	return newTestServer(t, transport, map[string]property.Value{"validateOnPreview": property.New(validateOnPreview)})
+}
+
+func previewURN(typ, name string) resource.URN {
+	return resource.NewURN("test", "sendgrid", "", tokens.Type("sendgrid:index:"+typ), name)
+}
+
+func TestCheck_ValidateOnPreview(t *testing.T) {
+	t.Parallel()
+
+	responses := map[string]string{
+		"/v3/scopes":             `{"scopes": ["mail.send", "templates.read"]}`,
+		"/v3/api_keys":           `{"result": [{"api_key_id": "key-1", "name": "ci"}]}`,
+		"/v3/templates/d-exists": `{"id": "d-exists", "name": "welcome"}`,
+		"/v3/asm/groups":         `[{"id": 42, "name": "Newsletter"}]`,
+		"/v3/whitelabel/domains": `[{"id": 7, "domain": "example.com", "subdomain": "em"}]`,
+		"/v3/user/username":      `{"username": "parent", "user_id": 1}`,
+		"/v3/subusers/tenant-a":  `{"id": 3, "username": "tenant-a", "email": "tenant-a@example.com"}`,
+	}
+
+	tests := []struct {
+		name     string
+		typ      string
+		inputs   map[string]property.Value
+		failures []p.CheckFailure
+	}{
+		{
+			name: "api key scopes granted",
+			typ:  "ApiKey",
+			inputs: map[string]property.Value{
+				"name":   property.New("app"),
+				"scopes": property.New([]property.Value{property.New("mail.send")}),
+			},
+		},
+		{
+			name: "api key scopes not granted",
+			typ:  "ApiKey",
+			inputs: map[string]property.Value{
+				"name":   property.New("app"),
+				"scopes": property.New([]property.Value{property.New("mail.send"), property.New("user.account.read")}),
+			},
+			failures: []p.CheckFailure{{
+				Property: "scopes",
+				Reason:   "the provider's API key cannot grant scopes it does not hold: user.account.read",
+			}},
+		},
+		{
+			name: "teammate scopes not granted",
+			typ:  "Teammate",
+			inputs: map[string]property.Value{
+				"email":  property.New("jdoe@example.com"),
+				"scopes": property.New([]property.Value{property.New("templates.read"), property.New("billing.read")}),
+			},
+			failures: []p.CheckFailure{{
+				Property: "scopes",
+				Reason:   "the provider's API key cannot grant scopes it does not hold: billing.read",
+			}},
+		},
+		{
+			name: "teammate admin",
+			typ:  "Teammate",
+			inputs: map[string]property.Value{
+				"email":   property.New("admin@example.com"),
+				"isAdmin": property.New(true),
+			},
+		},
+		{
+			name: "api key name taken",
+			typ:  "ApiKey",
+			inputs: map[string]property.Value{
+				"name": property.New("ci"),
+			},
+			failures: []p.CheckFailure{{
+				Property: "name",
+				Reason:   `an API key named "ci" already exists (ID key-1); choose a different name`,
+			}},
+		},
+		{
+			name: "template exists",
+			typ:  "TemplateVersion",
+			inputs: map[string]property.Value{
+				"templateId": property.New("d-exists"),
+				"name":       property.New("v1"),
+			},
+		},
+		{
+			name: "template missing",
+			typ:  "TemplateVersion",
+			inputs: map[string]property.Value{
+				"templateId": property.New("d-missing"),
+				"name":       property.New("v1"),
+			},
+			failures: []p.CheckFailure{{Property: "templateId", Reason: `template "d-missing" does not exist`}},
+		},
+		{
+			name: "template not yet created",
+			typ:  "TemplateVersion",
+			inputs: map[string]property.Value{
+				"templateId": property.New(property.Computed),
+				"name":       property.New("v1"),
+			},
+		},
+		{
+			name: "group name taken",
+			typ:  "UnsubscribeGroup",
+			inputs: map[string]property.Value{
+				"name":        property.New("Newsletter"),
+				"description": property.New("Weekly news"),
+			},
+			failures: []p.CheckFailure{{
+				Property: "name",
+				Reason:   "an unsubscribe group named \"Newsletter\" already exists (ID 42); import it with `pulumi import sendgrid:index:UnsubscribeGroup <name> 42`",
+			}},
+		},
+		{
+			name: "domain taken",
+			typ:  "DomainAuthentication",
+			inputs: map[string]property.Value{
+				"domain":    property.New("example.com"),
+				"subdomain": property.New("em"),
+			},
+			failures: []p.CheckFailure{{
+				Property: "domain",
+				Reason:   "em.example.com is already authenticated (ID 7); import it with `pulumi import sendgrid:index:DomainAuthentication <name> 7`",
+			}},
+		},
+		{
+			name: "username available",
+			typ:  "AccountUsername",
+			inputs: map[string]property.Value{
+				"username": property.New("parent-renamed"),
+			},
+		},
+		{
+			name: "username unchanged",
+			typ:  "AccountUsername",
+			inputs: map[string]property.Value{
+				"username": property.New("parent"),
+			},
+		},
+		{
+			name: "username taken by a subuser",
+			typ:  "AccountUsername",
+			inputs: map[string]property.Value{
+				"username": property.New("tenant-a"),
+			},
+			failures: []p.CheckFailure{{Property: "username", Reason: `username "tenant-a" is already used by a subuser of this account`}},
+		},
+		{
+			name: "domain with generated subdomain",
+			typ:  "DomainAuthentication",
+			inputs: map[string]property.Value{
+				"domain": property.New("example.com"),
+			},
+		},
+	}
+
+	var calls int32
+	server := previewValidationServer(t, true, responses, &calls)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.Check(p.CheckRequest{
+				Urn:    previewURN(tt.typ, "test"),
+				Inputs: property.NewMap(tt.inputs),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.failures, resp.Failures)
+		})
+	}
+}
+
+func TestCheck_ScopesReadOnce(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, true, map[string]string{
+		"/v3/scopes":   `{"scopes": ["mail.send"]}`,
+		"/v3/api_keys": `{"result": []}`,
+	}, &calls)
+
+	scopes := property.New([]property.Value{property.New("mail.send")})
+	for _, check := range []struct{ typ, key, value string }{
+		{"ApiKey", "name", "app"},
+		{"Teammate", "email", "jdoe@example.com"},
+		{"ApiKey", "name", "worker"},
+	} {
+		resp, err := server.Check(p.CheckRequest{
+			Urn: previewURN(check.typ, "test"),
+			Inputs: property.NewMap(map[string]property.Value{
+				check.key: property.New(check.value),
+				"scopes":  scopes,
+			}),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Failures)
+	}
+	// The scopes and the API key names are each read once
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestCheck_ValidateOnPreviewDisabled(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := previewValidationServer(t, false, map[string]string{"/v3/scopes": `{"scopes": []}`}, &calls)
+
+	resp, err := server.Check(p.CheckRequest{
+		Urn: previewURN("ApiKey", "test"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"name":   property.New("app"),
+			"scopes": property.New([]property.Value{property.New("mail.send")}),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Failures)
+	assert.Zero(t, atomic.LoadInt32(&calls))
+}
+
+func TestLookupFailures(t *testing.T) {
+	t.Parallel()
+
+	failures := lookupFailures(context.Background(), "name", "unsubscribe groups", &SendGridError{StatusCode: http.StatusForbidden, Message: "access forbidden"})
+	require.Len(t, failures, 1)
+	assert.Equal(t, "name", failures[0].Property)
+	assert.Contains(t, failures[0].Reason, "not allowed to read unsubscribe groups")
+
+	assert.Empty(t, lookupFailures(context.Background(), "name", "unsubscribe groups", &SendGridError{StatusCode: http.StatusInternalServerError}))
+	assert.Empty(t, lookupFailures(context.Background(), "name", "unsubscribe groups", errors.New("connection reset")))
+}
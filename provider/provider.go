@@ -18,7 +18,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -33,6 +38,14 @@ const Name string = "sendgrid"
 
 // Provider creates a new instance of the SendGrid provider.
 func Provider() p.Provider {
+	return NewProvider()
+}
+
+// NewProvider creates a new instance of the SendGrid provider whose API client
+// is built with the given options in addition to those derived from the
+// provider configuration, e.g. WithTransport to fake the SendGrid API in tests.
+func NewProvider(opts ...ClientOption) p.Provider {
+	metricsLog := &operationMetricsLog{}
 	prov, err := infer.NewProviderBuilder().
 		WithDisplayName("SendGrid").
 		WithDescription("A Pulumi provider for managing SendGrid resources.").
@@ -77,62 +90,452 @@ func Provider() p.Provider {
 			infer.Resource(&Subuser{}),
 			infer.Resource(&Teammate{}),
 			infer.Resource(&Alert{}),
+			infer.Resource(&ScheduledSend{}),
+			infer.Resource(&LegacyTemplateMailSetting{}),
+			infer.Resource(&NewRelicPartnerSetting{}),
+			infer.Resource(&AccountEmail{}),
+			infer.Resource(&AccountUsername{}),
+			infer.Resource(&AccountPassword{}),
+			infer.Resource(&ContactDbList{}),
+			infer.Resource(&ContactDbRecipient{}),
+			infer.Resource(&SubuserDomainAssociation{}),
+			infer.Resource(&SubuserLinkAssociation{}),
+			infer.Resource(&EventWebhookSignature{}),
+			infer.Resource(&EventWebhookTestDelivery{}),
+		).
+		WithFunctions(
+			infer.Function(&GetAlerts{}),
+			infer.Function(&GetEventWebhooks{}),
+			infer.Function(&GetStats{}),
+			infer.Function(&GetCategoryStats{}),
+			infer.Function(&GetSubuserStats{}),
+			infer.Function(&GetGroupSuppressions{}),
+			infer.Function(&GetBounces{}),
+			infer.Function(&GetBlocks{}),
+			infer.Function(&GetSpamReports{}),
+			infer.Function(&GetInvalidEmails{}),
+			infer.Function(&GetGlobalSuppressions{}),
+			infer.Function(&SearchEmailActivity{}),
+			infer.Function(&GetCategories{}),
+			infer.Function(&GetDesigns{}),
+			infer.Function(&GetMarketingLists{}),
+			infer.Function(&GetMarketingSegments{}),
+			infer.Function(&GenerateBatchId{}),
+			infer.Function(&SendTestEmail{}),
+			infer.Function(&ValidateEmail{}),
+			infer.Function(&GetUsage{}),
+			infer.Function(&GetAccountProfile{}),
+			infer.Function(&GetMailSettings{}),
+			infer.Function(&GetTrackingSettings{}),
+			infer.Function(&GetCurrentKeyInfo{}),
+			infer.Function(&GetSubuserReputation{}),
+			infer.Function(&GetReverseDns{}),
+			infer.Function(&GetSsoIntegrations{}),
+			infer.Function(&GetSsoCertificates{}),
+			infer.Function(&GetInboundParseSettings{}),
 		).
-		WithConfig(infer.Config(&Config{})).
+		WithComponents(
+			infer.ComponentF(NewAuthenticatedDomain),
+			infer.ComponentF(NewSubuserOnboarding),
+			infer.ComponentF(NewWebhookEndpoint),
+			infer.ComponentF(NewTemplateDirectory),
+		).
+		WithConfig(infer.Config(&Config{
+			clientOptions:       opts,
+			metricsLog:          metricsLog,
+			templateActivations: &templateActivationLocks{},
+		})).
 		WithModuleMap(map[tokens.ModuleName]tokens.ModuleName{
 			"provider": "index",
 		}).Build()
 	if err != nil {
 		panic(fmt.Errorf("unable to build provider: %w", err))
 	}
-	return prov
+	return metricsLog.wrap(withCustomTimeouts(withRenamedFields(prov, renamedFields)))
 }
 
 // Config defines provider-level configuration for SendGrid.
 type Config struct {
 	// APIKey is the SendGrid API key used for authentication.
-	// Can also be set via the SENDGRID_API_KEY environment variable.
+	// Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
 	APIKey *string `pulumi:"apiKey,optional" provider:"secret"`
 
+	// APIKeyFile is the path of a file containing the SendGrid API key.
+	// Can also be set via the SENDGRID_API_KEY_FILE environment variable.
+	APIKeyFile *string `pulumi:"apiKeyFile,optional"`
+
+	// ValidateAPIKey checks the API key against SendGrid when the provider is configured. Defaults to true.
+	ValidateAPIKey *bool `pulumi:"validateApiKey,optional"`
+
+	// ValidateOnPreview makes Check perform read-only lookups against SendGrid, so previews
+	// catch conflicts and permission errors. Defaults to false.
+	ValidateOnPreview *bool `pulumi:"validateOnPreview,optional"`
+
+	// RequiredScopes are API key scopes that must be granted for configuration to succeed.
+	RequiredScopes []string `pulumi:"requiredScopes,optional"`
+
+	// EnableLegacyContactDb enables the ContactDbList and ContactDbRecipient resources,
+	// for accounts still on Legacy Marketing Campaigns. Defaults to false.
+	EnableLegacyContactDb *bool `pulumi:"enableLegacyContactDb,optional"`
+
+	// OnBehalfOf is the subuser username whose account the provider manages, using the parent account's API key.
+	OnBehalfOf *string `pulumi:"onBehalfOf,optional"`
+
 	// BaseURL is the SendGrid API base URL. Defaults to https://api.sendgrid.com.
 	// Can be overridden for testing or for EU regional endpoints.
 	BaseURL *string `pulumi:"baseUrl,optional"`
 
+	// Region selects the SendGrid data-residency endpoint: "global" or "eu".
+	// Can also be set via the SENDGRID_REGION environment variable. Ignored when BaseURL is set.
+	Region *string `pulumi:"region,optional"`
+
+	// HTTPProxy is the proxy used for plain HTTP requests.
+	// Falls back to the HTTP_PROXY environment variable.
+	HTTPProxy *string `pulumi:"httpProxy,optional" provider:"secret"`
+
+	// HTTPSProxy is the proxy used for HTTPS requests.
+	// Falls back to the HTTPS_PROXY environment variable.
+	HTTPSProxy *string `pulumi:"httpsProxy,optional" provider:"secret"`
+
+	// NoProxy is a comma-separated list of hosts that bypass the proxy.
+	// Falls back to the NO_PROXY environment variable.
+	NoProxy *string `pulumi:"noProxy,optional"`
+
+	// RequestTimeoutSeconds is the time limit for a single API request. Defaults to 30.
+	RequestTimeoutSeconds *int `pulumi:"requestTimeoutSeconds,optional"`
+
+	// ReadTimeoutSeconds overrides RequestTimeoutSeconds for read (GET) requests.
+	ReadTimeoutSeconds *int `pulumi:"readTimeoutSeconds,optional"`
+
+	// WriteTimeoutSeconds overrides RequestTimeoutSeconds for create, update and delete requests.
+	WriteTimeoutSeconds *int `pulumi:"writeTimeoutSeconds,optional"`
+
+	// RateLimitPerSecond limits how many requests per second the provider sends on average.
+	// Unset or 0 disables client-side rate limiting.
+	RateLimitPerSecond *float64 `pulumi:"rateLimitPerSecond,optional"`
+
+	// RateLimitBurst is the number of requests that may be sent at once before
+	// RateLimitPerSecond applies. Defaults to RateLimitPerSecond rounded up.
+	RateLimitBurst *int `pulumi:"rateLimitBurst,optional"`
+
+	// MaxConcurrentRequests bounds how many requests the provider has in flight at once,
+	// across all resources. Defaults to 10. Set to 0 to remove the limit.
+	MaxConcurrentRequests *int `pulumi:"maxConcurrentRequests,optional"`
+
+	// CircuitBreakerThreshold is the number of consecutive 5xx responses or network
+	// failures after which requests fail fast. Defaults to 5. Set to 0 to disable.
+	CircuitBreakerThreshold *int `pulumi:"circuitBreakerThreshold,optional"`
+
+	// CircuitBreakerCooldownSeconds is how long requests fail fast once the circuit breaker trips. Defaults to 30.
+	CircuitBreakerCooldownSeconds *int `pulumi:"circuitBreakerCooldownSeconds,optional"`
+
+	// UserAgentSuffix is appended to the User-Agent header of every request.
+	UserAgentSuffix *string `pulumi:"userAgentSuffix,optional"`
+
+	// Headers are extra HTTP headers added to every request.
+	Headers map[string]string `pulumi:"headers,optional"`
+
+	// MaxRetries is the number of times a rate-limited (429) or transiently failed (5xx)
+	// request is retried with exponential backoff. A 404 shortly after the provider wrote
+	// to the same path is retried too. Defaults to 3. Set to 0 to disable retries.
+	MaxRetries *int `pulumi:"maxRetries,optional"`
+
+	// RetryBudget is the number of retries shared by all resources, which also back off
+	// together while rate limited. Defaults to 20. Set to 0 to disable.
+	RetryBudget *int `pulumi:"retryBudget,optional"`
+
+	// LogMetrics logs a summary of the SendGrid requests sent during each resource or function operation.
+	LogMetrics *bool `pulumi:"logMetrics,optional"`
+
+	// StatsdAddress is the host:port of a statsd server that request metrics are pushed to over UDP.
+	StatsdAddress *string `pulumi:"statsdAddress,optional"`
+
+	// clientOptions are applied after the options derived from the configuration (not exposed to Pulumi)
+	clientOptions []ClientOption
+
+	// metricsLog is shared with the provider, which logs metrics around each operation (not exposed to Pulumi)
+	metricsLog *operationMetricsLog
+
 	// client is the initialized SendGrid client (not exposed to Pulumi)
-	client *SendGridClient
+	client SendGridAPI
+
+	// apiKeyID is the ID of the configured API key, when it has one (not exposed to Pulumi)
+	apiKeyID string
+
+	// templateActivations serializes version activations per template (not exposed to Pulumi)
+	templateActivations *templateActivationLocks
+
+	// transport is the client's transport, for requests sent outside the SendGrid API (not exposed to Pulumi)
+	transport http.RoundTripper
 }
 
 // Annotate provides descriptions for the Config fields.
 func (c *Config) Annotate(annotator infer.Annotator) {
 	annotator.Describe(&c.APIKey, "The SendGrid API key for authentication. "+
-		"Can also be set via the SENDGRID_API_KEY environment variable.")
+		"Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.")
+	annotator.Describe(&c.APIKeyFile, "The path of a file containing the SendGrid API key, e.g. a mounted secret. "+
+		"Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.")
+	annotator.Describe(&c.ValidateAPIKey, "Whether to check the API key against SendGrid when the provider is configured, "+
+		"so an invalid key fails before any resource operations run. Defaults to true.")
+	annotator.SetDefault(&c.ValidateAPIKey, true)
+	annotator.Describe(&c.ValidateOnPreview, "Whether to validate resource inputs against SendGrid with read-only lookups "+
+		"during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, "+
+		"and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. "+
+		"Defaults to false.")
+	annotator.Describe(&c.RequiredScopes, "API key scopes that must be granted, e.g. [\"templates.create\"]. "+
+		"Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.")
+	annotator.Describe(&c.EnableLegacyContactDb, "Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, "+
+		"which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to "+
+		"new Marketing Campaigns can use it. Defaults to false.")
+	annotator.Describe(&c.OnBehalfOf, "The username of a subuser whose account this provider manages, "+
+		"authenticating with the parent account's API key. Use one explicit provider instance per subuser "+
+		"to manage several subusers from a single program.")
 	annotator.Describe(&c.BaseURL, "The SendGrid API base URL. "+
-		"Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.")
-	annotator.SetDefault(&c.BaseURL, DefaultBaseURL)
+		"Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. "+
+		"Takes precedence over `region`, e.g. to target a mock server in CI.")
+	annotator.Describe(&c.Region, "The SendGrid data-residency region to send API calls to: "+
+		"\"global\" (https://api.sendgrid.com) or \"eu\" (https://api.eu.sendgrid.com). "+
+		"Can also be set via the SENDGRID_REGION environment variable. Defaults to \"global\".")
+	annotator.Describe(&c.HTTPProxy, "The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. "+
+		"Falls back to the HTTP_PROXY environment variable.")
+	annotator.Describe(&c.HTTPSProxy, "The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. "+
+		"Falls back to the HTTPS_PROXY environment variable.")
+	annotator.Describe(&c.NoProxy, "A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. "+
+		"Falls back to the NO_PROXY environment variable.")
+	annotator.Describe(&c.RequestTimeoutSeconds, "The time limit in seconds for a single SendGrid API request. "+
+		"Defaults to 30. Set to 0 to disable the limit.")
+	annotator.SetDefault(&c.RequestTimeoutSeconds, int(DefaultRequestTimeout/time.Second))
+	annotator.Describe(&c.ReadTimeoutSeconds, "The time limit in seconds for read (GET) requests, "+
+		"overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.")
+	annotator.Describe(&c.WriteTimeoutSeconds, "The time limit in seconds for create, update and delete requests, "+
+		"overriding `requestTimeoutSeconds`. Use a long value for large template uploads.")
+	annotator.Describe(&c.RateLimitPerSecond, "The average number of requests per second the provider sends to SendGrid, "+
+		"shared across all resources in the deployment. Use this to stay below your account's rate limits "+
+		"instead of relying on retries. Unset or 0 disables client-side rate limiting.")
+	annotator.Describe(&c.RateLimitBurst, "The number of requests that may be sent at once before `rateLimitPerSecond` applies. "+
+		"Defaults to `rateLimitPerSecond` rounded up.")
+	annotator.Describe(&c.MaxConcurrentRequests, "The maximum number of requests the provider has in flight to SendGrid at once, "+
+		"shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; "+
+		"further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.")
+	annotator.SetDefault(&c.MaxConcurrentRequests, DefaultMaxConcurrentRequests)
+	annotator.Describe(&c.CircuitBreakerThreshold, "The number of consecutive 5xx responses or network failures "+
+		"after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, "+
+		"instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.")
+	annotator.SetDefault(&c.CircuitBreakerThreshold, DefaultCircuitBreakerThreshold)
+	annotator.Describe(&c.CircuitBreakerCooldownSeconds, "How long in seconds requests fail fast once the circuit breaker trips, "+
+		"before a single request is let through to check whether SendGrid has recovered. Defaults to 30.")
+	annotator.SetDefault(&c.CircuitBreakerCooldownSeconds, int(DefaultCircuitBreakerCooldown/time.Second))
+	annotator.Describe(&c.UserAgentSuffix, "A suffix appended to the User-Agent header of every request, "+
+		"e.g. to attribute traffic to a team at an egress gateway.")
+	annotator.Describe(&c.Headers, "Extra HTTP headers added to every request, e.g. for egress gateway attribution. "+
+		"Cannot override the Authorization, Content-Type or User-Agent headers.")
+	annotator.Describe(&c.MaxRetries, "The number of times a rate-limited (429) or transiently failed (5xx) request "+
+		"is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. "+
+		"A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, "+
+		"as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.")
+	annotator.SetDefault(&c.MaxRetries, DefaultMaxRetries)
+	annotator.Describe(&c.RetryBudget, "The number of retries shared by all resources in the deployment. "+
+		"Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. "+
+		"While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. "+
+		"Defaults to 20. Set to 0 to let each request retry on its own.")
+	annotator.SetDefault(&c.RetryBudget, DefaultRetryBudget)
+	annotator.Describe(&c.LogMetrics, "Log a summary after each resource or function operation of the SendGrid requests it sent: "+
+		"the number of requests, errors by HTTP status and a latency histogram. Defaults to false.")
+	annotator.Describe(&c.StatsdAddress, "The host:port of a statsd server to push request metrics to over UDP: "+
+		"a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.")
 }
 
 // Configure initializes the SendGrid client based on the provided configuration.
-func (c *Config) Configure(_ context.Context) error {
-	// Get API key from config or environment
-	apiKey := ""
-	if c.APIKey != nil && *c.APIKey != "" {
-		apiKey = *c.APIKey
+func (c *Config) Configure(ctx context.Context) error {
+	// Get API key from config, a key file or the environment
+	apiKey, err := resolveAPIKey(c.APIKey, c.APIKeyFile, os.Getenv)
+	if err != nil {
+		return err
+	}
+
+	// Get base URL from config, falling back to the region endpoint
+	region := ""
+	if c.Region != nil && *c.Region != "" {
+		region = *c.Region
 	} else {
-		apiKey = os.Getenv("SENDGRID_API_KEY")
+		region = os.Getenv("SENDGRID_REGION")
+	}
+	baseURL, err := resolveBaseURL(c.BaseURL, region)
+	if err != nil {
+		return err
+	}
+
+	// Get retry count from config or use default
+	maxRetries := DefaultMaxRetries
+	if c.MaxRetries != nil {
+		if *c.MaxRetries < 0 {
+			return fmt.Errorf("maxRetries must not be negative, got %d", *c.MaxRetries)
+		}
+		maxRetries = *c.MaxRetries
+	}
+
+	// Share retries between all resources so a rate-limited deployment backs off together
+	retryBudget := DefaultRetryBudget
+	if c.RetryBudget != nil {
+		if *c.RetryBudget < 0 {
+			return fmt.Errorf("retryBudget must not be negative, got %d", *c.RetryBudget)
+		}
+		retryBudget = *c.RetryBudget
+	}
+
+	opts := []ClientOption{WithMaxRetries(maxRetries), WithRetryBudget(retryBudget)}
+
+	// Apply request timeouts, falling back to the client default
+	for _, timeout := range []struct {
+		name   string
+		value  *int
+		option func(time.Duration) ClientOption
+	}{
+		{"requestTimeoutSeconds", c.RequestTimeoutSeconds, WithRequestTimeout},
+		{"readTimeoutSeconds", c.ReadTimeoutSeconds, WithReadTimeout},
+		{"writeTimeoutSeconds", c.WriteTimeoutSeconds, WithWriteTimeout},
+	} {
+		if timeout.value == nil {
+			continue
+		}
+		if *timeout.value < 0 {
+			return fmt.Errorf("%s must not be negative, got %d", timeout.name, *timeout.value)
+		}
+		opts = append(opts, timeout.option(time.Duration(*timeout.value)*time.Second))
+	}
+
+	// Throttle requests when a client-side rate limit is configured
+	if c.RateLimitPerSecond != nil && *c.RateLimitPerSecond != 0 {
+		if *c.RateLimitPerSecond < 0 {
+			return fmt.Errorf("rateLimitPerSecond must not be negative, got %g", *c.RateLimitPerSecond)
+		}
+		burst := int(math.Ceil(*c.RateLimitPerSecond))
+		if c.RateLimitBurst != nil {
+			if *c.RateLimitBurst < 1 {
+				return fmt.Errorf("rateLimitBurst must be at least 1, got %d", *c.RateLimitBurst)
+			}
+			burst = *c.RateLimitBurst
+		}
+		opts = append(opts, WithRateLimit(*c.RateLimitPerSecond, burst))
+	}
+
+	// Bound the number of requests in flight across all resources
+	maxConcurrent := DefaultMaxConcurrentRequests
+	if c.MaxConcurrentRequests != nil {
+		if *c.MaxConcurrentRequests < 0 {
+			return fmt.Errorf("maxConcurrentRequests must not be negative, got %d", *c.MaxConcurrentRequests)
+		}
+		maxConcurrent = *c.MaxConcurrentRequests
+	}
+	opts = append(opts, WithMaxConcurrentRequests(maxConcurrent))
+
+	// Fail fast during SendGrid outages
+	threshold, cooldown := DefaultCircuitBreakerThreshold, DefaultCircuitBreakerCooldown
+	if c.CircuitBreakerThreshold != nil {
+		if *c.CircuitBreakerThreshold < 0 {
+			return fmt.Errorf("circuitBreakerThreshold must not be negative, got %d", *c.CircuitBreakerThreshold)
+		}
+		threshold = *c.CircuitBreakerThreshold
+	}
+	if c.CircuitBreakerCooldownSeconds != nil {
+		if *c.CircuitBreakerCooldownSeconds < 0 {
+			return fmt.Errorf("circuitBreakerCooldownSeconds must not be negative, got %d", *c.CircuitBreakerCooldownSeconds)
+		}
+		cooldown = time.Duration(*c.CircuitBreakerCooldownSeconds) * time.Second
+	}
+	opts = append(opts, WithCircuitBreaker(threshold, cooldown))
+
+	// Identify requests to SendGrid and any gateways in between
+	if c.UserAgentSuffix != nil {
+		opts = append(opts, WithUserAgentSuffix(*c.UserAgentSuffix))
+	}
+	if len(c.Headers) > 0 {
+		if err := validateHeaders(c.Headers); err != nil {
+			return err
+		}
+		opts = append(opts, WithHeaders(c.Headers))
 	}
 
-	if apiKey == "" {
-		return fmt.Errorf("SendGrid API key is required. Set it via the 'apiKey' provider config or SENDGRID_API_KEY environment variable")
+	// Manage a subuser's account with the parent account's key
+	if onBehalfOf := strings.TrimSpace(stringValue(c.OnBehalfOf)); onBehalfOf != "" {
+		opts = append(opts, WithOnBehalfOf(onBehalfOf))
 	}
 
-	// Get base URL from config or use default
-	baseURL := DefaultBaseURL
-	if c.BaseURL != nil && *c.BaseURL != "" {
-		baseURL = *c.BaseURL
+	// Route requests through a proxy when one is configured explicitly.
+	// Otherwise the default transport already honors the proxy environment variables.
+	httpProxy, httpsProxy, noProxy := stringValue(c.HTTPProxy), stringValue(c.HTTPSProxy), stringValue(c.NoProxy)
+	if err := validateProxyURL("httpProxy", httpProxy); err != nil {
+		return err
+	}
+	if err := validateProxyURL("httpsProxy", httpsProxy); err != nil {
+		return err
+	}
+	if httpProxy != "" || httpsProxy != "" || noProxy != "" {
+		opts = append(opts, WithProxy(httpProxy, httpsProxy, noProxy))
 	}
 
+	// Export request metrics when asked to
+	if c.StatsdAddress != nil && *c.StatsdAddress != "" {
+		observer, err := newStatsdObserver(*c.StatsdAddress)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, WithRequestObserver(observer))
+	}
+	if c.metricsLog != nil {
+		c.metricsLog.enabled.Store(c.LogMetrics != nil && *c.LogMetrics)
+	}
+
+	// Share list responses between resources reading the same list, e.g. during a refresh
+	opts = append(opts, WithListCache(DefaultListCacheTTL))
+
+	// Give SendGrid time to make what the provider just created visible before a 404 is final
+	opts = append(opts, WithReadAfterWriteRetries(DefaultReadAfterWriteWindow))
+
 	// Initialize the client
-	c.client = NewSendGridClient(apiKey, baseURL)
+	opts = append(opts, c.clientOptions...)
+	client := NewSendGridClient(apiKey, baseURL, opts...)
+	c.client = client
+	c.transport = client.httpClient.Transport
+	c.apiKeyID = apiKeyID(apiKey)
+
+	// Fail fast on an invalid key instead of during the first resource operation
+	if c.ValidateAPIKey == nil || *c.ValidateAPIKey {
+		if err := validateAPIKey(ctx, c.client, c.RequiredScopes); err != nil {
+			return err
+		}
+	} else if len(c.RequiredScopes) > 0 {
+		return fmt.Errorf("requiredScopes cannot be checked when validateApiKey is false")
+	}
 
 	return nil
 }
+
+// resolveBaseURL returns the API base URL to use. An explicit base URL takes
+// precedence over the region, which defaults to the global endpoint.
+func resolveBaseURL(baseURL *string, region string) (string, error) {
+	if baseURL != nil && *baseURL != "" {
+		u, err := url.Parse(*baseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return "", fmt.Errorf("baseUrl must be an absolute URL such as %s, got %q", DefaultBaseURL, *baseURL)
+		}
+		return strings.TrimRight(*baseURL, "/"), nil
+	}
+
+	switch strings.ToLower(region) {
+	case "", "global":
+		return DefaultBaseURL, nil
+	case "eu":
+		return EUBaseURL, nil
+	default:
+		return "", fmt.Errorf("region must be one of: global, eu (got %q)", region)
+	}
+}
+
+// stringValue returns the value of an optional string, or "" if it is unset
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,58 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveBaseURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		baseURL *string
+		region  string
+		want    string
+		wantErr bool
+	}{
+		{name: "defaults to global", want: DefaultBaseURL},
+		{name: "global region", region: "global", want: DefaultBaseURL},
+		{name: "eu region", region: "eu", want: EUBaseURL},
+		{name: "region is case insensitive", region: "EU", want: EUBaseURL},
+		{name: "unknown region", region: "apac", wantErr: true},
+		{name: "base url wins over region", baseURL: strPtr("http://localhost:8080"), region: "eu", want: "http://localhost:8080"},
+		{name: "trailing slash trimmed", baseURL: strPtr("https://api.eu.sendgrid.com/"), want: EUBaseURL},
+		{name: "empty base url uses region", baseURL: strPtr(""), region: "eu", want: EUBaseURL},
+		{name: "relative base url", baseURL: strPtr("api.sendgrid.com"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveBaseURL(tt.baseURL, tt.region)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// WithProxy routes requests through the given proxies. Empty values fall back
+// to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxy(httpProxy, httpsProxy, noProxy string) ClientOption {
+	return func(c *SendGridClient) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = proxyFunc(httpProxy, httpsProxy, noProxy)
+		c.httpClient.Transport = transport
+	}
+}
+
+// proxyFunc returns a function that selects the proxy for a request, combining
+// explicit settings with the standard proxy environment variables
+func proxyFunc(httpProxy, httpsProxy, noProxy string) func(*http.Request) (*url.URL, error) {
+	cfg := httpproxy.FromEnvironment()
+	if httpProxy != "" {
+		cfg.HTTPProxy = httpProxy
+	}
+	if httpsProxy != "" {
+		cfg.HTTPSProxy = httpsProxy
+	}
+	if noProxy != "" {
+		cfg.NoProxy = noProxy
+	}
+
+	proxy := cfg.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+// validateProxyURL checks that a configured proxy address can be parsed
+func validateProxyURL(name, proxy string) error {
+	if proxy == "" {
+		return nil
+	}
+	u, err := url.Parse(proxy)
+	if err != nil || u.Host == "" {
+		// Proxy addresses without a scheme, e.g. "proxy.internal:3128", are treated as http
+		if u, err = url.Parse("http://" + proxy); err != nil || u.Host == "" {
+			return fmt.Errorf("%s must be a proxy URL such as http://proxy.internal:3128, got %q", name, proxy)
+		}
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+		return nil
+	default:
+		return fmt.Errorf("%s must use the http, https or socks5 scheme, got %q", name, u.Scheme)
+	}
+}
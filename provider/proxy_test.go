@@ -0,0 +1,95 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_Proxy(t *testing.T) {
+	t.Parallel()
+
+	// The proxy receives the request with the absolute URL of the target
+	proxy := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "sendgrid.test", r.URL.Host)
+		assert.Equal(t, "/v3/scopes", r.URL.Path)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"scopes": ["mail.send"]}`))
+	})
+
+	client := NewSendGridClient("test-api-key", "http://sendgrid.test", WithProxy(proxy.URL, "", ""))
+
+	var result struct {
+		Scopes []string `json:"scopes"`
+	}
+	err := client.Get(context.Background(), "/v3/scopes", &result)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mail.send"}, result.Scopes)
+}
+
+func TestProxyFunc(t *testing.T) {
+	t.Parallel()
+
+	selectProxy := proxyFunc("http://http-proxy:3128", "http://https-proxy:3128", "internal.example.com")
+
+	tests := []struct {
+		name   string
+		target string
+		want   string
+	}{
+		{name: "http request", target: "http://api.sendgrid.com/v3/scopes", want: "http://http-proxy:3128"},
+		{name: "https request", target: "https://api.sendgrid.com/v3/scopes", want: "http://https-proxy:3128"},
+		{name: "no proxy host", target: "https://internal.example.com/v3/scopes", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			target, err := url.Parse(tt.target)
+			require.NoError(t, err)
+
+			got, err := selectProxy(&http.Request{URL: target})
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, got)
+			} else {
+				require.NotNil(t, got)
+				assert.Equal(t, tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestValidateProxyURL(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, validateProxyURL("httpProxy", ""))
+	assert.NoError(t, validateProxyURL("httpProxy", "http://proxy.internal:3128"))
+	assert.NoError(t, validateProxyURL("httpProxy", "proxy.internal:3128"))
+	assert.NoError(t, validateProxyURL("httpsProxy", "socks5://proxy.internal:1080"))
+
+	err := validateProxyURL("httpsProxy", "ftp://proxy.internal")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "httpsProxy")
+}
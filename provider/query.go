@@ -0,0 +1,94 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Query builds the query parameters of a request. The Optional setters skip nil
+// and empty values, so resource and function inputs can be passed as they are:
+//
+//	path := NewQuery().Set("domain", domain).OptionalInt("limit", args.Limit).Path("/v3/whitelabel/domains")
+type Query struct {
+	values url.Values
+}
+
+// NewQuery returns an empty Query
+func NewQuery() *Query {
+	return &Query{values: url.Values{}}
+}
+
+// Set sets key to value, replacing any existing values
+func (q *Query) Set(key, value string) *Query {
+	q.values.Set(key, value)
+	return q
+}
+
+// Add appends values to key, for parameters SendGrid accepts more than once
+func (q *Query) Add(key string, values ...string) *Query {
+	for _, value := range values {
+		q.values.Add(key, value)
+	}
+	return q
+}
+
+// OptionalString sets key to value unless it is nil or empty
+func (q *Query) OptionalString(key string, value *string) *Query {
+	if value != nil && *value != "" {
+		q.values.Set(key, *value)
+	}
+	return q
+}
+
+// OptionalInt sets key to value unless it is nil
+func (q *Query) OptionalInt(key string, value *int) *Query {
+	if value != nil {
+		q.values.Set(key, strconv.Itoa(*value))
+	}
+	return q
+}
+
+// OptionalBool sets key to value unless it is nil
+func (q *Query) OptionalBool(key string, value *bool) *Query {
+	if value != nil {
+		q.values.Set(key, strconv.FormatBool(*value))
+	}
+	return q
+}
+
+// Values returns the parameters, e.g. for PageOptions.Query
+func (q *Query) Values() url.Values {
+	return q.values
+}
+
+// Path appends the encoded parameters to path
+func (q *Query) Path(path string) string {
+	return withQuery(path, q.values)
+}
+
+// withQuery appends encoded query parameters to a path, if there are any.
+// Parameters already in the path are kept.
+func withQuery(path string, query url.Values) string {
+	if len(query) == 0 {
+		return path
+	}
+	if strings.Contains(path, "?") {
+		return path + "&" + query.Encode()
+	}
+	return path + "?" + query.Encode()
+}
@@ -0,0 +1,49 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	empty := ""
+	query := NewQuery().
+		Set("domain", "example.com").
+		Add("generations", "legacy", "dynamic").
+		OptionalString("subuser", nil).
+		OptionalString("end_date", &empty).
+		OptionalInt("limit", intPtr(50)).
+		OptionalInt("offset", nil).
+		OptionalBool("exclude_subusers", boolPtr(true)).
+		OptionalBool("username", nil)
+
+	assert.Equal(t, "domain=example.com&exclude_subusers=true&generations=legacy&generations=dynamic&limit=50", query.Values().Encode())
+	assert.Equal(t, "/v3/whitelabel/domains?domain=example.com&exclude_subusers=true&generations=legacy&generations=dynamic&limit=50",
+		query.Path("/v3/whitelabel/domains"))
+	assert.Equal(t, "/v3/templates", NewQuery().OptionalInt("page_size", nil).Path("/v3/templates"))
+}
+
+func TestWithQuery(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "/v3/stats", withQuery("/v3/stats", nil))
+	assert.Equal(t, "/v3/stats?start_date=2024-01-01", withQuery("/v3/stats", NewQuery().Set("start_date", "2024-01-01").Values()))
+	assert.Equal(t, "/v3/bounces?limit=10&offset=20", withQuery("/v3/bounces?limit=10", NewQuery().Set("offset", "20").Values()))
+}
@@ -0,0 +1,86 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that limits how many requests the client starts
+// per second. One limiter is shared by every resource using the same provider
+// instance, so a large stack throttles itself below the account's rate limit.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // maximum number of tokens in the bucket
+	tokens float64 // may go negative while callers wait for reserved tokens
+	last   time.Time
+}
+
+// newRateLimiter creates a full token bucket that allows requestsPerSecond
+// requests on average, with bursts of up to burst requests
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// WithRateLimit limits the client to requestsPerSecond requests on average,
+// with bursts of up to burst requests. A non-positive rate disables the limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *SendGridClient) {
+		if requestsPerSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// wait blocks until the caller may send a request or the context is done.
+// Tokens are reserved in call order so waiting callers are served fairly.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	deficit := -l.tokens
+	l.mu.Unlock()
+
+	if deficit <= 0 {
+		return nil
+	}
+
+	delay := time.Duration(deficit / l.rate * float64(time.Second))
+	if err := sleepContext(ctx, delay); err != nil {
+		// Return the reserved token so other callers are not delayed by a request that was never sent
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return fmt.Errorf("waiting for rate limiter: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("burst is served immediately", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newRateLimiter(1, 5)
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			require.NoError(t, limiter.wait(context.Background()))
+		}
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("requests beyond the burst are throttled", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newRateLimiter(50, 1)
+
+		start := time.Now()
+		for i := 0; i < 6; i++ {
+			require.NoError(t, limiter.wait(context.Background()))
+		}
+		// One request from the burst, then five at 50/s
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+
+	t.Run("cancelled wait returns its token", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newRateLimiter(0.1, 1)
+		require.NoError(t, limiter.wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := limiter.wait(ctx)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		assert.Greater(t, limiter.tokens, -1.0)
+	})
+}
+
+func TestSendGridClient_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL, WithRateLimit(100, 2))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.Get(context.Background(), "/v3/templates", nil))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(6), atomic.LoadInt32(&calls))
+	// Two requests from the burst, then four at 100/s
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
@@ -0,0 +1,137 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultReadAfterWriteWindow is how long after creating a resource the provider treats
+// a 404 for it as SendGrid not having caught up yet. Template versions and subusers, for
+// example, can briefly be missing right after they are created.
+const DefaultReadAfterWriteWindow = 30 * time.Second
+
+// createdIDFields are the response fields that identify a created resource in its path,
+// e.g. the id of a template version or the username of a subuser
+var createdIDFields = []string{"id", "username"}
+
+// recentWrites remembers the resources the client recently created. A GET or PATCH
+// that finds one of them missing is retried like a transient failure: after
+// POST /v3/subusers creates tenant-a, for instance, GET /v3/subusers/tenant-a may not
+// find the subuser at first. Other 404s, e.g. for a sibling of the created resource
+// or one that was deleted, are expected and returned right away.
+type recentWrites struct {
+	window time.Duration
+
+	mu    sync.Mutex
+	paths map[string]time.Time // when the resource at each path was created
+}
+
+// WithReadAfterWriteRetries retries GET and PATCH requests that fail with a 404 within
+// window of creating the resource they request, up to the client's maximum number of
+// retries. A non-positive window disables these retries.
+func WithReadAfterWriteRetries(window time.Duration) ClientOption {
+	return func(c *SendGridClient) {
+		if window <= 0 {
+			c.writes = nil
+			return
+		}
+		c.writes = &recentWrites{window: window, paths: map[string]time.Time{}}
+	}
+}
+
+// record notes the resource a successful POST to path created, and forgets the
+// resource a successful DELETE removed
+func (w *recentWrites) record(method, path string, resp *http.Response, body []byte) {
+	if resp == nil || resp.StatusCode >= 300 {
+		return
+	}
+	path, _, _ = strings.Cut(path, "?")
+
+	switch method {
+	case http.MethodPost:
+		created := createdPath(path, resp, body)
+		if created == "" {
+			return
+		}
+		now := time.Now()
+
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		for written, at := range w.paths {
+			if now.Sub(at) > w.window {
+				delete(w.paths, written)
+			}
+		}
+		w.paths[created] = now
+	case http.MethodDelete:
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.paths, path)
+	}
+}
+
+// createdPath returns the path of the resource a POST to path created, from the
+// Location header or the ID in the response, or "" if it is not known
+func createdPath(path string, resp *http.Response, body []byte) string {
+	if location, err := url.Parse(resp.Header.Get("Location")); err == nil && location.Path != "" {
+		created := location.Path
+		// A baseUrl with a path, e.g. for a proxy, prefixes the location
+		if i := strings.Index(created, "/v3/"); i > 0 {
+			created = created[i:]
+		}
+		return created
+	}
+
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) != nil {
+		return ""
+	}
+	for _, name := range createdIDFields {
+		var id any
+		if json.Unmarshal(fields[name], &id) != nil {
+			continue
+		}
+		switch id := id.(type) {
+		case string:
+			if id != "" {
+				return path + "/" + url.PathEscape(id)
+			}
+		case float64:
+			return path + "/" + strconv.FormatFloat(id, 'f', -1, 64)
+		}
+	}
+	return ""
+}
+
+// notVisibleYet reports whether resp is a 404 for a GET or PATCH of a resource the
+// client created within the window
+func (w *recentWrites) notVisibleYet(method, path string, resp *http.Response) bool {
+	if resp == nil || resp.StatusCode != http.StatusNotFound || (method != http.MethodGet && method != http.MethodPatch) {
+		return false
+	}
+	path, _, _ = strings.Cut(path, "?")
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	at, ok := w.paths[path]
+	return ok && time.Since(at) <= w.window
+}
@@ -0,0 +1,174 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_ReadAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	// readAfterWriteServer serves a subuser that the first missing GETs do not find yet
+	readAfterWriteServer := func(t *testing.T, missing int) (*SendGridClient, func() []string) {
+		var mu sync.Mutex
+		var requests []string
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/v3/subusers":
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"username": "tenant-a"}`))
+			case r.Method == http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			case r.URL.Path == "/v3/subusers/tenant-a" && missing == 0:
+				_, _ = w.Write([]byte(`{"username": "tenant-a"}`))
+			default:
+				if r.URL.Path == "/v3/subusers/tenant-a" {
+					missing--
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errors": [{"message": "not found"}]}`))
+			}
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+		WithReadAfterWriteRetries(time.Minute)(client)
+		return client, func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), requests...)
+		}
+	}
+
+	t.Run("retries a 404 for the resource just created", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := readAfterWriteServer(t, 2)
+		require.NoError(t, client.Post(context.Background(), "/v3/subusers", map[string]string{}, nil))
+		require.NoError(t, client.Get(context.Background(), "/v3/subusers/tenant-a", nil))
+		assert.Equal(t, []string{
+			"POST /v3/subusers",
+			"GET /v3/subusers/tenant-a",
+			"GET /v3/subusers/tenant-a",
+			"GET /v3/subusers/tenant-a",
+		}, requests())
+	})
+
+	t.Run("gives up after the maximum number of retries", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := readAfterWriteServer(t, 10)
+		require.NoError(t, client.Post(context.Background(), "/v3/subusers", map[string]string{}, nil))
+		err := client.Get(context.Background(), "/v3/subusers/tenant-a", nil)
+		var sgErr *SendGridError
+		require.ErrorAs(t, err, &sgErr)
+		assert.True(t, sgErr.IsNotFound())
+		assert.Len(t, requests(), 5)
+	})
+
+	t.Run("does not draw on the retry budget", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := readAfterWriteServer(t, 2)
+		WithRetryBudget(1)(client)
+		require.NoError(t, client.Post(context.Background(), "/v3/subusers", map[string]string{}, nil))
+		require.NoError(t, client.Get(context.Background(), "/v3/subusers/tenant-a", nil))
+		assert.Len(t, requests(), 4)
+		assert.True(t, client.budget.withdraw(), "the budget is still available for failures of the API")
+	})
+
+	t.Run("does not retry a 404 for a sibling of the resource just created", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := readAfterWriteServer(t, 0)
+		require.NoError(t, client.Post(context.Background(), "/v3/subusers", map[string]string{}, nil))
+		require.Error(t, client.Get(context.Background(), "/v3/subusers/tenant-b", nil))
+		require.Error(t, client.Get(context.Background(), "/v3/subusers-other", nil))
+		require.Error(t, client.Get(context.Background(), "/v3/teammates/jdoe", nil))
+		assert.Len(t, requests(), 4)
+	})
+
+	t.Run("does not retry a 404 for other methods", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := readAfterWriteServer(t, 10)
+		require.NoError(t, client.Post(context.Background(), "/v3/subusers", map[string]string{}, nil))
+		require.Error(t, client.Put(context.Background(), "/v3/subusers/tenant-a", map[string]string{}, nil))
+		assert.Len(t, requests(), 2)
+	})
+
+	t.Run("forgets a resource once it is deleted", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := readAfterWriteServer(t, 10)
+		require.NoError(t, client.Post(context.Background(), "/v3/subusers", map[string]string{}, nil))
+		require.NoError(t, client.Delete(context.Background(), "/v3/subusers/tenant-a"))
+		require.Error(t, client.Get(context.Background(), "/v3/subusers/tenant-a", nil))
+		assert.Len(t, requests(), 3)
+	})
+
+	t.Run("does not retry a 404 without recent writes", func(t *testing.T) {
+		t.Parallel()
+
+		client, requests := readAfterWriteServer(t, 10)
+		require.Error(t, client.Get(context.Background(), "/v3/subusers/tenant-a", nil))
+		assert.Len(t, requests(), 1)
+	})
+}
+
+func TestRecentWrites_Window(t *testing.T) {
+	t.Parallel()
+
+	created := &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}}
+	writes := &recentWrites{window: time.Minute, paths: map[string]time.Time{}}
+	writes.record(http.MethodPost, "/v3/templates?on-behalf-of=tenant-a", created, []byte(`{"id": "d-1"}`))
+	writes.record(http.MethodPost, "/v3/alerts", created, []byte(`{"id": 3, "type": "usage_limit"}`))
+	writes.paths["/v3/subusers/tenant-a"] = time.Now().Add(-2 * time.Minute)
+
+	notFound := &http.Response{StatusCode: http.StatusNotFound}
+	assert.True(t, writes.notVisibleYet(http.MethodGet, "/v3/templates/d-1", notFound))
+	assert.True(t, writes.notVisibleYet(http.MethodPatch, "/v3/alerts/3", notFound))
+	assert.False(t, writes.notVisibleYet(http.MethodDelete, "/v3/templates/d-1", notFound))
+	assert.False(t, writes.notVisibleYet(http.MethodGet, "/v3/templates/d-1/versions", notFound))
+	assert.False(t, writes.notVisibleYet(http.MethodGet, "/v3/templates/d-1", &http.Response{StatusCode: http.StatusBadRequest}))
+	assert.False(t, writes.notVisibleYet(http.MethodGet, "/v3/subusers/tenant-a", notFound), "the write is older than the window")
+
+	writes.record(http.MethodPost, "/v3/teammates", &http.Response{StatusCode: http.StatusBadRequest}, []byte(`{"id": "jdoe"}`))
+	assert.False(t, writes.notVisibleYet(http.MethodGet, "/v3/teammates/jdoe", notFound), "failed writes are not recorded")
+
+	writes.record(http.MethodPatch, "/v3/scopes", &http.Response{StatusCode: http.StatusOK}, []byte(`{"id": "x"}`))
+	assert.NotContains(t, writes.paths, "/v3/scopes/x", "only created resources are recorded")
+
+	// A Location header names the created resource, even behind a proxy path
+	located := &http.Response{StatusCode: http.StatusCreated, Header: http.Header{
+		"Location": []string{"https://proxy.internal/sendgrid/v3/templates/d-1/versions/v-1"},
+	}}
+	writes.record(http.MethodPost, "/v3/templates/d-1/versions", located, nil)
+	assert.True(t, writes.notVisibleYet(http.MethodGet, "/v3/templates/d-1/versions/v-1", notFound))
+	assert.NotContains(t, writes.paths, "/v3/subusers/tenant-a", "expired writes are pruned")
+
+	writes.record(http.MethodDelete, "/v3/templates/d-1", &http.Response{StatusCode: http.StatusNoContent}, nil)
+	assert.False(t, writes.notVisibleYet(http.MethodGet, "/v3/templates/d-1", notFound), "deleted resources are forgotten")
+}
@@ -0,0 +1,162 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// renamedField is a resource property that was renamed, e.g. emailTo to emailsTo.
+// The old field stays in the args struct, marked with annotator.Deprecate, so
+// existing programs and SDKs keep working until it is removed in a major release.
+type renamedField struct {
+	// from is the deprecated name
+	from string
+
+	// to is the name that replaced it
+	to string
+
+	// convert turns a value set under the old name into one for the new name,
+	// e.g. wrapping a single email in a list. Nil if the type did not change.
+	convert func(property.Value) property.Value
+}
+
+// renamedFields lists the renamed properties of each resource by type token,
+// e.g. "sendgrid:index:Alert". Entries are applied by withRenamedFields.
+var renamedFields = map[tokens.Type][]renamedField{}
+
+// withRenamedFields lets resources rename properties without breaking existing stacks:
+//
+//   - Check moves inputs set under a deprecated name to the new name with a
+//     warning, and fails if both names are set.
+//   - State written by earlier versions is translated to the new names before
+//     Diff, Update, Read and Delete see it.
+//   - Outputs are also returned under the deprecated name, unless the type changed,
+//     so programs reading the old output keep working.
+func withRenamedFields(provider p.Provider, renames map[tokens.Type][]renamedField) p.Provider {
+	if check := provider.Check; check != nil {
+		provider.Check = func(ctx context.Context, req p.CheckRequest) (p.CheckResponse, error) {
+			fields := renames[req.Urn.Type()]
+			if len(fields) == 0 {
+				return check(ctx, req)
+			}
+
+			var failures []p.CheckFailure
+			for _, field := range fields {
+				if !isSetProperty(req.Inputs, field.from) {
+					continue
+				}
+				if isSetProperty(req.Inputs, field.to) {
+					failures = append(failures, p.CheckFailure{
+						Property: field.from,
+						Reason:   fmt.Sprintf("%s is deprecated and replaced by %s, set only %s", field.from, field.to, field.to),
+					})
+					continue
+				}
+				p.GetLogger(ctx).Warningf("%s is deprecated, use %s instead", field.from, field.to)
+			}
+			req.Inputs = renameProperties(req.Inputs, fields)
+			req.State = renameProperties(req.State, fields)
+
+			resp, err := check(ctx, req)
+			resp.Failures = append(resp.Failures, failures...)
+			return resp, err
+		}
+	}
+	if diff := provider.Diff; diff != nil {
+		provider.Diff = func(ctx context.Context, req p.DiffRequest) (p.DiffResponse, error) {
+			req.State = renameProperties(req.State, renames[req.Urn.Type()])
+			return diff(ctx, req)
+		}
+	}
+	if create := provider.Create; create != nil {
+		provider.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			resp, err := create(ctx, req)
+			resp.Properties = aliasProperties(resp.Properties, renames[req.Urn.Type()])
+			return resp, err
+		}
+	}
+	if read := provider.Read; read != nil {
+		provider.Read = func(ctx context.Context, req p.ReadRequest) (p.ReadResponse, error) {
+			fields := renames[req.Urn.Type()]
+			req.Properties = renameProperties(req.Properties, fields)
+			req.Inputs = renameProperties(req.Inputs, fields)
+			resp, err := read(ctx, req)
+			resp.Properties = aliasProperties(resp.Properties, fields)
+			return resp, err
+		}
+	}
+	if update := provider.Update; update != nil {
+		provider.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			fields := renames[req.Urn.Type()]
+			req.State = renameProperties(req.State, fields)
+			resp, err := update(ctx, req)
+			resp.Properties = aliasProperties(resp.Properties, fields)
+			return resp, err
+		}
+	}
+	if deleteFn := provider.Delete; deleteFn != nil {
+		provider.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			req.Properties = renameProperties(req.Properties, renames[req.Urn.Type()])
+			return deleteFn(ctx, req)
+		}
+	}
+	return provider
+}
+
+// renameProperties moves values set under deprecated names to their new names.
+// A deprecated value is dropped if the new name is already set, e.g. when it
+// was only returned as an alias of the new output.
+func renameProperties(props property.Map, fields []renamedField) property.Map {
+	for _, field := range fields {
+		value, ok := props.GetOk(field.from)
+		if !ok {
+			continue
+		}
+		props = props.Delete(field.from)
+		if isSetProperty(props, field.to) {
+			continue
+		}
+		if field.convert != nil && !value.IsNull() && !value.IsComputed() {
+			value = field.convert(value)
+		}
+		props = props.Set(field.to, value)
+	}
+	return props
+}
+
+// aliasProperties also returns outputs under their deprecated names, unless their type changed
+func aliasProperties(props property.Map, fields []renamedField) property.Map {
+	for _, field := range fields {
+		if field.convert != nil {
+			continue
+		}
+		if value, ok := props.GetOk(field.to); ok {
+			props = props.Set(field.from, value)
+		}
+	}
+	return props
+}
+
+// isSetProperty reports whether key is set to a non-null value
+func isSetProperty(props property.Map, key string) bool {
+	value, ok := props.GetOk(key)
+	return ok && !value.IsNull()
+}
@@ -0,0 +1,160 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// testRenames renames emailTo to emailsTo, wrapping the email in a list, and label to name
+var testRenames = map[tokens.Type][]renamedField{
+	"sendgrid:index:Alert": {
+		{from: "emailTo", to: "emailsTo", convert: func(v property.Value) property.Value {
+			return property.New([]property.Value{v})
+		}},
+		{from: "label", to: "name"},
+	},
+}
+
+func TestRenameProperties(t *testing.T) {
+	t.Parallel()
+
+	fields := testRenames["sendgrid:index:Alert"]
+	emails := property.New([]property.Value{property.New("a@example.com")})
+
+	tests := []struct {
+		name string
+		in   map[string]property.Value
+		want map[string]property.Value
+	}{
+		{
+			name: "deprecated names are moved",
+			in:   map[string]property.Value{"emailTo": property.New("a@example.com"), "label": property.New("ops")},
+			want: map[string]property.Value{"emailsTo": emails, "name": property.New("ops")},
+		},
+		{
+			name: "new names win",
+			in:   map[string]property.Value{"label": property.New("old"), "name": property.New("new")},
+			want: map[string]property.Value{"name": property.New("new")},
+		},
+		{
+			name: "unknown values are not converted",
+			in:   map[string]property.Value{"emailTo": property.New(property.Computed)},
+			want: map[string]property.Value{"emailsTo": property.New(property.Computed)},
+		},
+		{
+			name: "nothing to rename",
+			in:   map[string]property.Value{"type": property.New("usage_limit")},
+			want: map[string]property.Value{"type": property.New("usage_limit")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := renameProperties(property.NewMap(tt.in), fields)
+			assert.Equal(t, property.NewMap(tt.want), got)
+		})
+	}
+}
+
+func TestWithRenamedFields(t *testing.T) {
+	t.Parallel()
+
+	urn := previewURN("Alert", "test")
+	var checked p.CheckRequest
+	var updated p.UpdateRequest
+	inner := p.Provider{
+		Check: func(_ context.Context, req p.CheckRequest) (p.CheckResponse, error) {
+			checked = req
+			return p.CheckResponse{Inputs: req.Inputs}, nil
+		},
+		Create: func(_ context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			return p.CreateResponse{ID: "1", Properties: req.Properties}, nil
+		},
+		Update: func(_ context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			updated = req
+			return p.UpdateResponse{Properties: req.Inputs}, nil
+		},
+	}
+	provider := withRenamedFields(inner, testRenames)
+
+	t.Run("check moves deprecated inputs", func(t *testing.T) {
+		resp, err := provider.Check(context.Background(), p.CheckRequest{
+			Urn:    urn,
+			Inputs: property.NewMap(map[string]property.Value{"emailTo": property.New("a@example.com")}),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Failures)
+		assert.Equal(t, property.NewMap(map[string]property.Value{
+			"emailsTo": property.New([]property.Value{property.New("a@example.com")}),
+		}), checked.Inputs)
+	})
+
+	t.Run("check fails when both names are set", func(t *testing.T) {
+		resp, err := provider.Check(context.Background(), p.CheckRequest{
+			Urn:    urn,
+			Inputs: property.NewMap(map[string]property.Value{"label": property.New("old"), "name": property.New("new")}),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Failures, 1)
+		assert.Equal(t, "label", resp.Failures[0].Property)
+		assert.Contains(t, resp.Failures[0].Reason, "label is deprecated and replaced by name")
+	})
+
+	t.Run("outputs are aliased unless their type changed", func(t *testing.T) {
+		resp, err := provider.Create(context.Background(), p.CreateRequest{
+			Urn: urn,
+			Properties: property.NewMap(map[string]property.Value{
+				"name":     property.New("ops"),
+				"emailsTo": property.New([]property.Value{property.New("a@example.com")}),
+			}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, property.New("ops"), resp.Properties.Get("label"))
+		_, ok := resp.Properties.GetOk("emailTo")
+		assert.False(t, ok)
+	})
+
+	t.Run("old state is translated", func(t *testing.T) {
+		_, err := provider.Update(context.Background(), p.UpdateRequest{
+			ID:     "1",
+			Urn:    urn,
+			State:  property.NewMap(map[string]property.Value{"label": property.New("ops"), "emailTo": property.New("a@example.com")}),
+			Inputs: property.NewMap(map[string]property.Value{"name": property.New("ops")}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, property.NewMap(map[string]property.Value{
+			"name":     property.New("ops"),
+			"emailsTo": property.New([]property.Value{property.New("a@example.com")}),
+		}), updated.State)
+	})
+
+	t.Run("other resources are untouched", func(t *testing.T) {
+		inputs := property.NewMap(map[string]property.Value{"label": property.New("ops")})
+		_, err := provider.Check(context.Background(), p.CheckRequest{Urn: previewURN("Template", "test"), Inputs: inputs})
+		require.NoError(t, err)
+		assert.Equal(t, inputs, checked.Inputs)
+	})
+}
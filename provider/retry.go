@@ -0,0 +1,159 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times the provider retries a failed request by default
+	DefaultMaxRetries = 3
+
+	// defaultRetryBaseDelay is the initial backoff delay, doubled on every attempt
+	defaultRetryBaseDelay = 500 * time.Millisecond
+
+	// defaultRetryMaxDelay caps the exponential backoff delay
+	defaultRetryMaxDelay = 30 * time.Second
+
+	// maxRetryAfter caps delays requested by the server so a bad header cannot stall a deployment
+	maxRetryAfter = 2 * time.Minute
+)
+
+// retryPolicy controls how the client retries rate-limited and transient failures
+type retryPolicy struct {
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+}
+
+// defaultRetryPolicy returns a policy that does not retry. The provider enables
+// retries through WithMaxRetries based on its configuration.
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		maxRetries: 0,
+		baseDelay:  defaultRetryBaseDelay,
+		maxDelay:   defaultRetryMaxDelay,
+	}
+}
+
+// WithMaxRetries sets how many times a rate-limited or transiently failed request is retried
+func WithMaxRetries(maxRetries int) ClientOption {
+	return func(c *SendGridClient) {
+		if maxRetries < 0 {
+			maxRetries = 0
+		}
+		c.retry.maxRetries = maxRetries
+	}
+}
+
+// shouldRetry reports whether a request should be retried based on its outcome.
+// Rate-limited (429) requests were not processed by SendGrid and are always retried.
+// Transient 5xx responses and network failures are only retried for idempotent
+// methods, since the original request may already have taken effect.
+func (p retryPolicy) shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the next attempt. Server-provided
+// Retry-After and X-RateLimit-Reset headers take precedence over exponential
+// backoff with jitter.
+func (p retryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfterDelay(resp.Header, time.Now()); ok {
+			return d
+		}
+	}
+
+	backoff := p.maxDelay
+	if attempt < 30 {
+		if d := p.baseDelay << attempt; d > 0 && d < p.maxDelay {
+			backoff = d
+		}
+	}
+
+	// Equal jitter: wait at least half the backoff so retries stay spread out
+	half := backoff / 2
+	return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// retryAfterDelay reads the delay requested by the server from the Retry-After
+// header (seconds or HTTP date) or SendGrid's X-RateLimit-Reset header (Unix timestamp)
+func retryAfterDelay(header http.Header, now time.Time) (time.Duration, bool) {
+	var d time.Duration
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			d = time.Duration(seconds) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			d = t.Sub(now)
+		} else {
+			return 0, false
+		}
+	} else if v := header.Get("X-RateLimit-Reset"); v != "" {
+		reset, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		d = time.Unix(reset, 0).Sub(now)
+	} else {
+		return 0, false
+	}
+
+	if d < 0 {
+		d = 0
+	}
+	if d > maxRetryAfter {
+		d = maxRetryAfter
+	}
+	return d, true
+}
+
+// isIdempotent reports whether repeating a request with this method has the same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRetryBudget is the number of retries the provider may make in a burst,
+	// shared by every resource using the same provider instance
+	DefaultRetryBudget = 20
+
+	// retryBudgetRefill is the share of a retry earned back by each successful request,
+	// i.e. every eight successful requests earn one retry
+	retryBudgetRefill = 0.125
+)
+
+// retryBudget coordinates retries across all requests sent by a client, so that
+// a rate-limited deployment backs off as a whole instead of every resource
+// retrying on its own and keeping the account over its limit:
+//
+//   - Every retry spends a token from a shared budget, and successful requests
+//     slowly earn tokens back. Once the budget is spent, failed requests are
+//     returned to the caller instead of being retried.
+//   - A 429 response holds back every request, not just the one that was
+//     rate limited, until the delay requested by SendGrid has passed.
+type retryBudget struct {
+	mu          sync.Mutex
+	capacity    float64
+	tokens      float64
+	pausedUntil time.Time
+}
+
+// WithRetryBudget shares a budget of retries between all requests sent by the
+// client and pauses all requests while SendGrid is rate limiting them.
+// A non-positive budget disables both, leaving each request to retry on its own.
+func WithRetryBudget(retries int) ClientOption {
+	return func(c *SendGridClient) {
+		if retries <= 0 {
+			c.budget = nil
+			return
+		}
+		c.budget = &retryBudget{capacity: float64(retries), tokens: float64(retries)}
+	}
+}
+
+// wait blocks while requests are paused after a 429 response, or until the context is done
+func (b *retryBudget) wait(ctx context.Context) error {
+	b.mu.Lock()
+	delay := time.Until(b.pausedUntil)
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, delay); err != nil {
+		return fmt.Errorf("waiting for rate limit to reset: %w", err)
+	}
+	return nil
+}
+
+// withdraw spends a token for a retry, reporting false if the budget is spent
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// record earns back part of a retry for every request SendGrid handled
+func (b *retryBudget) record(resp *http.Response, err error) {
+	if err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.capacity, b.tokens+retryBudgetRefill)
+}
+
+// pause holds back all requests for d, extending any pause already in place
+func (b *retryBudget) pause(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
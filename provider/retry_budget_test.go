@@ -0,0 +1,134 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_RetryBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stops retrying once the budget is spent", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+		WithRetryBudget(4)(client)
+
+		// The first request retries three times, the second only once
+		for i := 0; i < 2; i++ {
+			err := client.Get(context.Background(), "/v3/templates", nil)
+			var sgErr *SendGridError
+			require.True(t, errors.As(err, &sgErr))
+		}
+		assert.Equal(t, int32(6), atomic.LoadInt32(&calls))
+
+		// Nothing is left for further requests
+		require.Error(t, client.Get(context.Background(), "/v3/templates", nil))
+		assert.Equal(t, int32(7), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("successful requests earn retries back", func(t *testing.T) {
+		t.Parallel()
+
+		budget := &retryBudget{capacity: 2, tokens: 0}
+		assert.False(t, budget.withdraw())
+
+		ok := &http.Response{StatusCode: http.StatusOK}
+		for i := 0; i < 8; i++ {
+			budget.record(ok, nil)
+		}
+		budget.record(&http.Response{StatusCode: http.StatusTooManyRequests}, nil)
+		budget.record(nil, errors.New("connection reset"))
+		assert.True(t, budget.withdraw())
+		assert.False(t, budget.withdraw())
+
+		for i := 0; i < 100; i++ {
+			budget.record(ok, nil)
+		}
+		assert.Equal(t, 2.0, budget.tokens)
+	})
+
+	t.Run("rate limiting pauses every request", func(t *testing.T) {
+		t.Parallel()
+
+		var limited atomic.Bool
+		limited.Store(true)
+		var mu sync.Mutex
+		var sent []time.Time
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v3/limited" && limited.CompareAndSwap(true, false) {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			mu.Lock()
+			sent = append(sent, time.Now())
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+		WithRetryBudget(DefaultRetryBudget)(client)
+
+		start := time.Now()
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.Get(context.Background(), "/v3/limited", nil))
+		}()
+
+		// Wait for the 429 before sending an unrelated request
+		require.Eventually(t, func() bool { return !limited.Load() }, time.Second, time.Millisecond)
+		require.NoError(t, client.Get(context.Background(), "/v3/other", nil))
+		wg.Wait()
+
+		require.Len(t, sent, 2)
+		for _, at := range sent {
+			assert.GreaterOrEqual(t, at.Sub(start), 900*time.Millisecond)
+		}
+	})
+
+	t.Run("cancellation while paused", func(t *testing.T) {
+		t.Parallel()
+
+		client := NewSendGridClient("test-api-key", "http://127.0.0.1:0", WithRetryBudget(1))
+		client.budget.pause(time.Minute)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := client.Get(ctx, "/v3/templates", nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "waiting for rate limit to reset")
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRetryingTestClient creates a client that retries without meaningful backoff delays
+func newRetryingTestClient(serverURL string, maxRetries int) *SendGridClient {
+	client := NewSendGridClient("test-api-key", serverURL, WithMaxRetries(maxRetries))
+	client.retry.baseDelay = time.Millisecond
+	client.retry.maxDelay = 5 * time.Millisecond
+	return client
+}
+
+func TestSendGridClient_Retry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retries 429 until success", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name": "ok"}`))
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+
+		var result struct {
+			Name string `json:"name"`
+		}
+		err := client.Get(context.Background(), "/v3/test", &result)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", result.Name)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("retries 429 for POST and resends body", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			buf := make([]byte, 64)
+			n, _ := r.Body.Read(buf)
+			assert.Equal(t, `{"name":"test"}`, string(buf[:n]))
+
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+
+		err := client.Post(context.Background(), "/v3/test", map[string]string{"name": "test"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("does not retry 5xx for POST", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+
+		err := client.Post(context.Background(), "/v3/test", map[string]string{"name": "test"}, nil)
+		require.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		client := newRetryingTestClient(server.URL, 2)
+
+		err := client.Delete(context.Background(), "/v3/test")
+		require.Error(t, err)
+		sgErr, ok := err.(*SendGridError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadGateway, sgErr.StatusCode)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+
+		err := client.Get(context.Background(), "/v3/test", nil)
+		require.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("stops waiting when context is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+
+		client := newRetryingTestClient(server.URL, 3)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := client.Get(ctx, "/v3/test", nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    time.Duration
+		wantOK  bool
+	}{
+		{name: "no headers", wantOK: false},
+		{name: "retry-after seconds", headers: map[string]string{"Retry-After": "7"}, want: 7 * time.Second, wantOK: true},
+		{name: "retry-after date", headers: map[string]string{"Retry-After": now.Add(10 * time.Second).UTC().Format(http.TimeFormat)}, want: 10 * time.Second, wantOK: true},
+		{name: "rate limit reset", headers: map[string]string{"X-RateLimit-Reset": strconv.FormatInt(now.Unix()+4, 10)}, want: 4 * time.Second, wantOK: true},
+		{name: "reset in the past", headers: map[string]string{"X-RateLimit-Reset": strconv.FormatInt(now.Unix()-4, 10)}, want: 0, wantOK: true},
+		{name: "capped", headers: map[string]string{"Retry-After": "3600"}, want: maxRetryAfter, wantOK: true},
+		{name: "malformed", headers: map[string]string{"Retry-After": "soon"}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			header := http.Header{}
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+
+			got, ok := retryAfterDelay(header, now)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	policy := retryPolicy{baseDelay: 100 * time.Millisecond, maxDelay: time.Second}
+
+	for attempt := 0; attempt < 40; attempt++ {
+		d := policy.delay(attempt, nil)
+		assert.LessOrEqual(t, d, time.Second)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// ScheduledSend is the controller for the SendGrid Scheduled Send resource.
+//
+// This resource cancels or pauses the emails scheduled with a batch ID.
+// Deleting it removes the status, which resumes sending the batch.
+type ScheduledSend struct{}
+
+// ScheduledSendArgs are the inputs to the ScheduledSend resource.
+type ScheduledSendArgs struct {
+	// BatchID is the batch ID of the scheduled emails (required)
+	BatchID string `pulumi:"batchId" provider:"replaceOnChanges"`
+
+	// Status is "cancel" or "pause" (required)
+	Status string `pulumi:"status"`
+
+	// DeletionProtection prevents the status from being removed, which would resume the batch,
+	// including by a replacement, while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// ScheduledSendState is the state of the ScheduledSend resource.
+type ScheduledSendState struct {
+	// Embed the input args in the output state
+	ScheduledSendArgs
+}
+
+// Annotate provides descriptions for the ScheduledSend resource.
+func (s *ScheduledSend) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&s, "Manages the status of a SendGrid scheduled send.\n\n"+
+		"Emails sent with a batch ID and a send_at time can be paused or cancelled until they are sent. "+
+		"Setting the status to \"pause\" holds the batch, and \"cancel\" discards it when its send time "+
+		"arrives. Deleting this resource removes the status, so a paused batch is sent again.\n\n"+
+		"Batch IDs can be created with the SendGrid API and passed to the mail send requests of the batch.")
+}
+
+// scheduledSendAPIResponse represents the SendGrid API response structure for scheduled sends
+type scheduledSendAPIResponse struct {
+	BatchID string `json:"batch_id"`
+	Status  string `json:"status"`
+}
+
+// toState converts an API response to ScheduledSendState
+func (r *scheduledSendAPIResponse) toState() ScheduledSendState {
+	return ScheduledSendState{
+		ScheduledSendArgs: ScheduledSendArgs{
+			BatchID: r.BatchID,
+			Status:  r.Status,
+		},
+	}
+}
+
+// Check validates the ScheduledSend inputs.
+func (s *ScheduledSend) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[ScheduledSendArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[ScheduledSendArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[ScheduledSendArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[ScheduledSendArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid ScheduledSendArgs
+func (args *ScheduledSendArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("batchId", args.BatchID)
+	v.oneOf("status", args.Status, "cancel", "pause")
+	return v.failures
+}
+
+// Create cancels or pauses a SendGrid scheduled send.
+func (s *ScheduledSend) Create(ctx context.Context, req infer.CreateRequest[ScheduledSendArgs]) (infer.CreateResponse[ScheduledSendState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return the expected state
+	if preview {
+		return infer.CreateResponse[ScheduledSendState]{
+			Output: ScheduledSendState{ScheduledSendArgs: input},
+		}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.CreateResponse[ScheduledSendState]{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	// Build the request body
+	reqBody := map[string]interface{}{
+		"batch_id": input.BatchID,
+		"status":   input.Status,
+	}
+
+	// Make the API call
+	var result scheduledSendAPIResponse
+	if err := client.Post(ctx, "/v3/user/scheduled_sends", reqBody, &result); err != nil {
+		return infer.CreateResponse[ScheduledSendState]{}, fmt.Errorf("failed to create scheduled send: %w", err)
+	}
+
+	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+
+	return infer.CreateResponse[ScheduledSendState]{
+		ID:     state.BatchID,
+		Output: state,
+	}, nil
+}
+
+// Read retrieves the current status of a SendGrid scheduled send.
+func (s *ScheduledSend) Read(ctx context.Context, req infer.ReadRequest[ScheduledSendArgs, ScheduledSendState]) (infer.ReadResponse[ScheduledSendArgs, ScheduledSendState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[ScheduledSendArgs, ScheduledSendState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/user/scheduled_sends/{batch_id} returns a list with the status of the batch, if it has one
+	var result []scheduledSendAPIResponse
+	if err := client.Get(ctx, fmt.Sprintf("/v3/user/scheduled_sends/%s", url.PathEscape(id)), &result); err != nil {
+		// Check if the resource was deleted out-of-band
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			// Return empty response to indicate resource no longer exists
+			return infer.ReadResponse[ScheduledSendArgs, ScheduledSendState]{}, nil
+		}
+		return infer.ReadResponse[ScheduledSendArgs, ScheduledSendState]{}, fmt.Errorf("failed to read scheduled send: %w", err)
+	}
+	if len(result) == 0 {
+		return infer.ReadResponse[ScheduledSendArgs, ScheduledSendState]{}, nil
+	}
+
+	state := result[0].toState()
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	inputs := state.ScheduledSendArgs
+
+	return infer.ReadResponse[ScheduledSendArgs, ScheduledSendState]{
+		ID:     id,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// Update changes the status of a SendGrid scheduled send.
+func (s *ScheduledSend) Update(ctx context.Context, req infer.UpdateRequest[ScheduledSendArgs, ScheduledSendState]) (infer.UpdateResponse[ScheduledSendState], error) {
+	id := req.ID
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return expected state
+	if preview {
+		return infer.UpdateResponse[ScheduledSendState]{Output: ScheduledSendState{ScheduledSendArgs: input}}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.UpdateResponse[ScheduledSendState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// Make the API call; SendGrid responds with no content
+	reqBody := map[string]interface{}{
+		"status": input.Status,
+	}
+	if err := client.Patch(ctx, fmt.Sprintf("/v3/user/scheduled_sends/%s", url.PathEscape(id)), reqBody, nil); err != nil {
+		return infer.UpdateResponse[ScheduledSendState]{}, fmt.Errorf("failed to update scheduled send: %w", err)
+	}
+
+	return infer.UpdateResponse[ScheduledSendState]{Output: ScheduledSendState{ScheduledSendArgs: input}}, nil
+}
+
+// Delete removes the status of a SendGrid scheduled send, resuming the batch.
+func (s *ScheduledSend) Delete(ctx context.Context, req infer.DeleteRequest[ScheduledSendState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "scheduled send", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// Make the API call
+	if err := client.Delete(ctx, fmt.Sprintf("/v3/user/scheduled_sends/%s", url.PathEscape(id))); err != nil {
+		// If already deleted, that's fine
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete scheduled send: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
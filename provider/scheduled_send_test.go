@@ -0,0 +1,125 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestScheduledSend_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	status := ""
+	var requests []string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		var body map[string]string
+		if req.Body != nil {
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &body)
+		}
+		switch req.Method + " " + req.URL.Path {
+		case "POST /v3/user/scheduled_sends":
+			assert.Equal(t, "batch-1", body["batch_id"])
+			status = body["status"]
+			return fakeResponse(req, http.StatusCreated, `{"batch_id": "batch-1", "status": "`+status+`"}`), nil
+		case "PATCH /v3/user/scheduled_sends/batch-1":
+			status = body["status"]
+			return fakeResponse(req, http.StatusNoContent, ``), nil
+		case "GET /v3/user/scheduled_sends/batch-1":
+			if status == "" {
+				return fakeResponse(req, http.StatusOK, `[]`), nil
+			}
+			return fakeResponse(req, http.StatusOK, `[{"batch_id": "batch-1", "status": "`+status+`"}]`), nil
+		case "DELETE /v3/user/scheduled_sends/batch-1":
+			status = ""
+			return fakeResponse(req, http.StatusNoContent, ``), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("ScheduledSend", "incident")
+	paused := property.NewMap(map[string]property.Value{
+		"batchId": property.New("batch-1"),
+		"status":  property.New("pause"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: paused})
+	require.NoError(t, err)
+	assert.Equal(t, "batch-1", created.ID)
+	assert.Equal(t, "pause", created.Properties.Get("status").AsString())
+
+	cancelled := paused.Set("status", property.New("cancel"))
+	updated, err := server.Update(p.UpdateRequest{ID: "batch-1", Urn: urn, State: created.Properties, Inputs: cancelled})
+	require.NoError(t, err)
+	assert.Equal(t, "cancel", updated.Properties.Get("status").AsString())
+
+	read, err := server.Read(p.ReadRequest{ID: "batch-1", Urn: urn, Properties: updated.Properties, Inputs: cancelled})
+	require.NoError(t, err)
+	assert.Equal(t, "batch-1", read.ID)
+	assert.Equal(t, "cancel", read.Inputs.Get("status").AsString())
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "batch-1", Urn: urn, Properties: read.Properties}))
+
+	// Once the status is removed, SendGrid returns an empty list and the resource is gone
+	gone, err := server.Read(p.ReadRequest{ID: "batch-1", Urn: urn, Properties: read.Properties, Inputs: cancelled})
+	require.NoError(t, err)
+	assert.Empty(t, gone.ID)
+
+	assert.Equal(t, []string{
+		"POST /v3/user/scheduled_sends",
+		"PATCH /v3/user/scheduled_sends/batch-1",
+		"GET /v3/user/scheduled_sends/batch-1",
+		"DELETE /v3/user/scheduled_sends/batch-1",
+		"GET /v3/user/scheduled_sends/batch-1",
+	}, requests)
+}
+
+func TestScheduledSend_DeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	err := server.Delete(p.DeleteRequest{
+		ID:  "batch-1",
+		Urn: previewURN("ScheduledSend", "incident"),
+		Properties: property.NewMap(map[string]property.Value{
+			"batchId":            property.New("batch-1"),
+			"status":             property.New("pause"),
+			"deletionProtection": property.New(true),
+		}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deletionProtection")
+}
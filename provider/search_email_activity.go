@@ -0,0 +1,199 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// maxEmailActivityResults is the maximum number of messages the Email Activity API returns per request
+const maxEmailActivityResults = 1000
+
+// SearchEmailActivity is the controller for the searchEmailActivity function.
+//
+// This function queries the Email Activity feed for recently sent messages,
+// which lets deploy-time smoke tests confirm that a test send was delivered.
+type SearchEmailActivity struct{}
+
+// SearchEmailActivityArgs are the inputs to the searchEmailActivity function.
+type SearchEmailActivityArgs struct {
+	// ToEmail filters messages by recipient address (optional)
+	ToEmail *string `pulumi:"toEmail,optional"`
+
+	// Status filters messages by delivery status: "processed", "delivered" or "not_delivered" (optional)
+	Status *string `pulumi:"status,optional"`
+
+	// MsgID filters messages by SendGrid message ID (optional)
+	MsgID *string `pulumi:"msgId,optional"`
+
+	// Limit is the maximum number of messages to return (optional, default: 10, max: 1000)
+	Limit *int `pulumi:"limit,optional"`
+}
+
+// EmailActivityMessage describes a single message returned by the searchEmailActivity function.
+type EmailActivityMessage struct {
+	// MsgID is the SendGrid message ID
+	MsgID string `pulumi:"msgId"`
+
+	// FromEmail is the sender address
+	FromEmail string `pulumi:"fromEmail"`
+
+	// ToEmail is the recipient address
+	ToEmail string `pulumi:"toEmail"`
+
+	// Subject is the message subject
+	Subject string `pulumi:"subject"`
+
+	// Status is the delivery status: "processed", "delivered" or "not_delivered"
+	Status string `pulumi:"status"`
+
+	// OpensCount is the number of times the message was opened
+	OpensCount int `pulumi:"opensCount"`
+
+	// ClicksCount is the number of link clicks in the message
+	ClicksCount int `pulumi:"clicksCount"`
+
+	// LastEventTime is the timestamp of the most recent event for the message
+	LastEventTime string `pulumi:"lastEventTime"`
+}
+
+// SearchEmailActivityResult is the output of the searchEmailActivity function.
+type SearchEmailActivityResult struct {
+	// Messages is the list of messages matching the filters
+	Messages []EmailActivityMessage `pulumi:"messages"`
+}
+
+// Annotate provides descriptions for the searchEmailActivity function.
+func (f *SearchEmailActivity) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Searches the SendGrid Email Activity feed.\n\n"+
+		"Filter by recipient, delivery status or message ID to verify that a message was delivered, "+
+		"for example as a deploy-time smoke test. Requires the Email Activity add-on.")
+}
+
+// emailActivityMessageAPIResponse represents a single message returned by the Email Activity API
+type emailActivityMessageAPIResponse struct {
+	MsgID         string `json:"msg_id"`
+	FromEmail     string `json:"from_email"`
+	ToEmail       string `json:"to_email"`
+	Subject       string `json:"subject"`
+	Status        string `json:"status"`
+	OpensCount    int    `json:"opens_count"`
+	ClicksCount   int    `json:"clicks_count"`
+	LastEventTime string `json:"last_event_time"`
+}
+
+// emailActivityAPIResponse represents the response from GET /v3/messages
+type emailActivityAPIResponse struct {
+	Messages []emailActivityMessageAPIResponse `json:"messages"`
+}
+
+// emailActivityQuery builds the query parameters for the Email Activity endpoint,
+// translating the filters into the query DSL, e.g. to_email="a@example.com" AND status="delivered"
+func emailActivityQuery(args SearchEmailActivityArgs) (url.Values, error) {
+	var clauses []string
+	addClause := func(field string, value *string) error {
+		if value == nil {
+			return nil
+		}
+		if strings.Contains(*value, `"`) {
+			return fmt.Errorf("%s must not contain double quotes", field)
+		}
+		clauses = append(clauses, fmt.Sprintf(`%s="%s"`, field, *value))
+		return nil
+	}
+
+	if args.Status != nil {
+		switch *args.Status {
+		case "processed", "delivered", "not_delivered":
+		default:
+			return nil, fmt.Errorf("status must be one of: processed, delivered, not_delivered (got %q)", *args.Status)
+		}
+	}
+
+	if err := addClause("to_email", args.ToEmail); err != nil {
+		return nil, err
+	}
+	if args.ToEmail != nil {
+		if problem := emailAddressProblem(*args.ToEmail); problem != "" {
+			return nil, fmt.Errorf("toEmail must be a valid email address (got %q): %s", *args.ToEmail, problem)
+		}
+	}
+	if err := addClause("status", args.Status); err != nil {
+		return nil, err
+	}
+	if err := addClause("msg_id", args.MsgID); err != nil {
+		return nil, err
+	}
+	if len(clauses) == 0 {
+		return nil, fmt.Errorf("at least one of toEmail, status or msgId is required")
+	}
+
+	limit := 10
+	if args.Limit != nil {
+		if *args.Limit < 1 || *args.Limit > maxEmailActivityResults {
+			return nil, fmt.Errorf("limit must be between 1 and %d (got %d)", maxEmailActivityResults, *args.Limit)
+		}
+		limit = *args.Limit
+	}
+
+	query := url.Values{}
+	query.Set("query", strings.Join(clauses, " AND "))
+	query.Set("limit", strconv.Itoa(limit))
+	return query, nil
+}
+
+// Invoke searches the Email Activity feed.
+func (f *SearchEmailActivity) Invoke(ctx context.Context, req infer.FunctionRequest[SearchEmailActivityArgs]) (infer.FunctionResponse[SearchEmailActivityResult], error) {
+	query, err := emailActivityQuery(req.Input)
+	if err != nil {
+		return infer.FunctionResponse[SearchEmailActivityResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[SearchEmailActivityResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// GET /v3/messages
+	var result emailActivityAPIResponse
+	if err := client.Get(ctx, withQuery("/v3/messages", query), &result); err != nil {
+		return infer.FunctionResponse[SearchEmailActivityResult]{}, fmt.Errorf("failed to search email activity: %w", err)
+	}
+
+	messages := make([]EmailActivityMessage, len(result.Messages))
+	for i, m := range result.Messages {
+		messages[i] = EmailActivityMessage{
+			MsgID:         m.MsgID,
+			FromEmail:     m.FromEmail,
+			ToEmail:       m.ToEmail,
+			Subject:       m.Subject,
+			Status:        m.Status,
+			OpensCount:    m.OpensCount,
+			ClicksCount:   m.ClicksCount,
+			LastEventTime: m.LastEventTime,
+		}
+	}
+
+	return infer.FunctionResponse[SearchEmailActivityResult]{
+		Output: SearchEmailActivityResult{Messages: messages},
+	}, nil
+}
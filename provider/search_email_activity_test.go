@@ -0,0 +1,134 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridClient_SearchEmailActivity(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/messages", r.URL.Path)
+		assert.Equal(t, `to_email="smoke@example.com" AND status="delivered"`, r.URL.Query().Get("query"))
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"messages": [
+				{
+					"from_email": "noreply@example.com",
+					"msg_id": "abc123.filter0001",
+					"subject": "Smoke test",
+					"to_email": "smoke@example.com",
+					"status": "delivered",
+					"opens_count": 1,
+					"clicks_count": 0,
+					"last_event_time": "2024-01-01T00:00:05Z"
+				}
+			]
+		}`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	query, err := emailActivityQuery(SearchEmailActivityArgs{
+		ToEmail: strPtr("smoke@example.com"),
+		Status:  strPtr("delivered"),
+		Limit:   intPtr(5),
+	})
+	require.NoError(t, err)
+
+	var result emailActivityAPIResponse
+	err = client.Get(context.Background(), "/v3/messages?"+query.Encode(), &result)
+	require.NoError(t, err)
+	require.Len(t, result.Messages, 1)
+	assert.Equal(t, "abc123.filter0001", result.Messages[0].MsgID)
+	assert.Equal(t, "delivered", result.Messages[0].Status)
+	assert.Equal(t, 1, result.Messages[0].OpensCount)
+}
+
+func TestEmailActivityQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		args      SearchEmailActivityArgs
+		wantQuery string
+		wantLimit string
+		wantErr   string
+	}{
+		{
+			name:      "message id with default limit",
+			args:      SearchEmailActivityArgs{MsgID: strPtr("abc123")},
+			wantQuery: `msg_id="abc123"`,
+			wantLimit: "10",
+		},
+		{
+			name:      "all filters",
+			args:      SearchEmailActivityArgs{ToEmail: strPtr("a@example.com"), Status: strPtr("not_delivered"), MsgID: strPtr("abc123")},
+			wantQuery: `to_email="a@example.com" AND status="not_delivered" AND msg_id="abc123"`,
+			wantLimit: "10",
+		},
+		{
+			name:    "no filters",
+			args:    SearchEmailActivityArgs{},
+			wantErr: "at least one of",
+		},
+		{
+			name:    "invalid status",
+			args:    SearchEmailActivityArgs{Status: strPtr("bounced")},
+			wantErr: "status must be one of",
+		},
+		{
+			name:    "quote in value",
+			args:    SearchEmailActivityArgs{ToEmail: strPtr(`a" OR status="delivered`)},
+			wantErr: "double quotes",
+		},
+		{
+			name:    "invalid recipient",
+			args:    SearchEmailActivityArgs{ToEmail: strPtr("smoke@")},
+			wantErr: "toEmail must be a valid email address",
+		},
+		{
+			name:    "limit out of range",
+			args:    SearchEmailActivityArgs{MsgID: strPtr("abc123"), Limit: intPtr(1001)},
+			wantErr: "limit must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := emailActivityQuery(tt.args)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query.Get("query"))
+			assert.Equal(t, tt.wantLimit, query.Get("limit"))
+		})
+	}
+}
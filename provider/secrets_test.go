@@ -0,0 +1,156 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// secretsServer starts a provider whose SendGrid API is faked by responses,
+// keyed by method and path
+func secretsServer(t *testing.T, responses map[string]string) integration.Server {
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if body, ok := responses[req.Method+" "+req.URL.Path]; ok {
+			return fakeResponse(req, http.StatusOK, body), nil
+		}
+		return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "resource not found"}]}`), nil
+	})
+
+	return newTestServer(t, transport, nil)
+}
+
+func TestSensitiveOutputsAreSecret(t *testing.T) {
+	t.Parallel()
+
+	server := secretsServer(t, map[string]string{
+		"POST /v3/api_keys":                    `{"api_key_id": "key-1", "api_key": "SG.new-key", "name": "ci", "scopes": ["mail.send"]}`,
+		"POST /v3/teammates":                   `{"email": "jdoe@example.com", "scopes": ["mail.send"], "is_admin": false, "token": "invite-token"}`,
+		"GET /v3/subusers/tenant":              `{"id": 3, "username": "tenant", "email": "tenant@example.com", "disabled": false}`,
+		"PATCH /v3/partner_settings/new_relic": `{"enabled": true, "license_key": "nr-license", "enable_subuser_statistics": false}`,
+		"PUT /v3/user/password":                `{}`,
+		"GET /v3/user/webhooks/event/settings/wh-1": `{"id": "wh-1", "url": "https://hooks.example.com/sendgrid", "enabled": true,` +
+			` "oauth_client_id": "client", "oauth_token_url": "https://auth.example.com/token"}`,
+	})
+
+	t.Run("api key value", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: previewURN("ApiKey", "ci"),
+			Properties: property.NewMap(map[string]property.Value{
+				"name": property.New("ci"),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Properties.Get("apiKeyValue").Secret())
+		assert.Equal(t, "SG.new-key", resp.Properties.Get("apiKeyValue").AsString())
+	})
+
+	t.Run("teammate invitation token", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: previewURN("Teammate", "jdoe"),
+			Properties: property.NewMap(map[string]property.Value{
+				"email":  property.New("jdoe@example.com"),
+				"scopes": property.New([]property.Value{property.New("mail.send")}),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Properties.Get("token").Secret())
+		assert.False(t, resp.Properties.Get("email").Secret())
+	})
+
+	t.Run("subuser password kept by read", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Read(p.ReadRequest{
+			ID:  "tenant",
+			Urn: previewURN("Subuser", "tenant"),
+			Inputs: property.NewMap(map[string]property.Value{
+				"username": property.New("tenant"),
+				"email":    property.New("tenant@example.com"),
+				"password": property.New("hunter2").WithSecret(true),
+			}),
+			Properties: property.NewMap(map[string]property.Value{
+				"username": property.New("tenant"),
+				"email":    property.New("tenant@example.com"),
+				"userId":   property.New(3.0),
+				"disabled": property.New(false),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Inputs.Get("password").Secret())
+		assert.Equal(t, "hunter2", resp.Inputs.Get("password").AsString())
+	})
+	t.Run("event webhook OAuth client secret kept by read", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Read(p.ReadRequest{
+			ID:  "wh-1",
+			Urn: previewURN("EventWebhook", "events"),
+			Inputs: property.NewMap(map[string]property.Value{
+				"url":               property.New("https://hooks.example.com/sendgrid"),
+				"oauthClientId":     property.New("client"),
+				"oauthClientSecret": property.New("oauth-secret").WithSecret(true),
+				"oauthTokenUrl":     property.New("https://auth.example.com/token"),
+			}),
+			Properties: property.NewMap(map[string]property.Value{
+				"url":       property.New("https://hooks.example.com/sendgrid"),
+				"webhookId": property.New("wh-1"),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Inputs.Get("oauthClientSecret").Secret())
+		assert.Equal(t, "oauth-secret", resp.Inputs.Get("oauthClientSecret").AsString())
+		assert.Equal(t, "client", resp.Inputs.Get("oauthClientId").AsString())
+	})
+	t.Run("new relic license key", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: previewURN("NewRelicPartnerSetting", "stats"),
+			Properties: property.NewMap(map[string]property.Value{
+				"enabled":    property.New(true),
+				"licenseKey": property.New("nr-license").WithSecret(true),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Properties.Get("licenseKey").Secret())
+		assert.Equal(t, "nr-license", resp.Properties.Get("licenseKey").AsString())
+	})
+	t.Run("account passwords", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Create(p.CreateRequest{
+			Urn: previewURN("AccountPassword", "account"),
+			Properties: property.NewMap(map[string]property.Value{
+				"oldPassword": property.New("initial"),
+				"newPassword": property.New("rotated"),
+			}),
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Properties.Get("oldPassword").Secret())
+		assert.True(t, resp.Properties.Get("newPassword").Secret())
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// SendTestEmail is the controller for the sendTestEmail function.
+//
+// This function sends a single email, e.g. from a template, so a deployment
+// can be smoke tested end to end: the template renders, the sending domain is
+// authenticated and the API key is allowed to send mail.
+type SendTestEmail struct{}
+
+// SendTestEmailArgs are the inputs to the sendTestEmail function.
+type SendTestEmailArgs struct {
+	// To is the recipient email address (required)
+	To string `pulumi:"to"`
+
+	// From is the sender email address, which must be a verified sender or on an authenticated domain (required)
+	From string `pulumi:"from"`
+
+	// FromName is the sender display name (optional)
+	FromName *string `pulumi:"fromName,optional"`
+
+	// TemplateID is the dynamic template to send (optional; subject and content are required without it)
+	TemplateID *string `pulumi:"templateId,optional"`
+
+	// DynamicTemplateData is a JSON object with the data the template is rendered with (optional)
+	DynamicTemplateData *string `pulumi:"dynamicTemplateData,optional"`
+
+	// Subject is the subject line (optional with a template)
+	Subject *string `pulumi:"subject,optional"`
+
+	// Content is the plain text body (optional with a template)
+	Content *string `pulumi:"content,optional"`
+
+	// SandboxMode validates the email without delivering it (optional, defaults to false)
+	SandboxMode *bool `pulumi:"sandboxMode,optional"`
+}
+
+// SendTestEmailResult is the output of the sendTestEmail function.
+type SendTestEmailResult struct {
+	// MessageID is the X-Message-Id SendGrid assigned to the email, for looking it up with searchEmailActivity.
+	// It is empty in sandbox mode.
+	MessageID string `pulumi:"messageId"`
+}
+
+// Annotate provides descriptions for the sendTestEmail function.
+func (f *SendTestEmail) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Sends a single test email through the Mail Send API.\n\n"+
+		"Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. "+
+		"An email is sent every time the function is called, including during previews, so call it from "+
+		"a dedicated smoke test program, or set sandboxMode to only validate the request.")
+}
+
+// testEmailRequest builds the /v3/mail/send request body for the args
+func testEmailRequest(args SendTestEmailArgs) (map[string]interface{}, error) {
+	if args.To == "" || args.From == "" {
+		return nil, fmt.Errorf("to and from are required")
+	}
+
+	personalization := map[string]interface{}{
+		"to": []map[string]string{{"email": args.To}},
+	}
+	from := map[string]string{"email": args.From}
+	if args.FromName != nil {
+		from["name"] = *args.FromName
+	}
+	body := map[string]interface{}{
+		"personalizations": []map[string]interface{}{personalization},
+		"from":             from,
+	}
+
+	if args.TemplateID != nil {
+		body["template_id"] = *args.TemplateID
+	} else if args.Subject == nil || args.Content == nil {
+		return nil, fmt.Errorf("subject and content are required without a templateId")
+	}
+	if args.DynamicTemplateData != nil {
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(*args.DynamicTemplateData), &data); err != nil {
+			return nil, fmt.Errorf("dynamicTemplateData must be a JSON object: %w", err)
+		}
+		personalization["dynamic_template_data"] = data
+	}
+	if args.Subject != nil {
+		body["subject"] = *args.Subject
+	}
+	if args.Content != nil {
+		body["content"] = []map[string]string{{"type": "text/plain", "value": *args.Content}}
+	}
+	if args.SandboxMode != nil && *args.SandboxMode {
+		body["mail_settings"] = map[string]interface{}{
+			"sandbox_mode": map[string]bool{"enable": true},
+		}
+	}
+	return body, nil
+}
+
+// Invoke sends the test email.
+func (f *SendTestEmail) Invoke(ctx context.Context, req infer.FunctionRequest[SendTestEmailArgs]) (infer.FunctionResponse[SendTestEmailResult], error) {
+	body, err := testEmailRequest(req.Input)
+	if err != nil {
+		return infer.FunctionResponse[SendTestEmailResult]{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[SendTestEmailResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	// POST /v3/mail/send responds with 202 Accepted and the message ID in a header
+	header, err := client.PostWithHeaders(ctx, "/v3/mail/send", body, nil)
+	if err != nil {
+		return infer.FunctionResponse[SendTestEmailResult]{}, fmt.Errorf("failed to send test email: %w", err)
+	}
+
+	return infer.FunctionResponse[SendTestEmailResult]{
+		Output: SendTestEmailResult{MessageID: header.Get("X-Message-Id")},
+	}, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestTestEmailRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		args        SendTestEmailArgs
+		expected    string
+		expectError string
+	}{
+		{
+			name: "template with data",
+			args: SendTestEmailArgs{
+				To:                  "qa@example.com",
+				From:                "noreply@example.com",
+				FromName:            strPtr("Example"),
+				TemplateID:          strPtr("d-123"),
+				DynamicTemplateData: strPtr(`{"name": "Ada"}`),
+			},
+			expected: `{"from": {"email": "noreply@example.com", "name": "Example"}, "template_id": "d-123",
+				"personalizations": [{"to": [{"email": "qa@example.com"}], "dynamic_template_data": {"name": "Ada"}}]}`,
+		},
+		{
+			name: "plain text in sandbox mode",
+			args: SendTestEmailArgs{
+				To:          "qa@example.com",
+				From:        "noreply@example.com",
+				Subject:     strPtr("Smoke test"),
+				Content:     strPtr("It works"),
+				SandboxMode: boolPtr(true),
+			},
+			expected: `{"from": {"email": "noreply@example.com"}, "subject": "Smoke test",
+				"personalizations": [{"to": [{"email": "qa@example.com"}]}],
+				"content": [{"type": "text/plain", "value": "It works"}],
+				"mail_settings": {"sandbox_mode": {"enable": true}}}`,
+		},
+		{
+			name:        "no template or content",
+			args:        SendTestEmailArgs{To: "qa@example.com", From: "noreply@example.com", Subject: strPtr("Smoke test")},
+			expectError: "subject and content are required",
+		},
+		{
+			name:        "data is not an object",
+			args:        SendTestEmailArgs{To: "qa@example.com", From: "noreply@example.com", TemplateID: strPtr("d-1"), DynamicTemplateData: strPtr("[1]")},
+			expectError: "dynamicTemplateData must be a JSON object",
+		},
+		{
+			name:        "no recipient",
+			args:        SendTestEmailArgs{From: "noreply@example.com", TemplateID: strPtr("d-1")},
+			expectError: "to and from are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := testEmailRequest(tt.args)
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			actual, err := json.Marshal(body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(actual))
+		})
+	}
+}
+
+func TestSendTestEmail(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/v3/mail/send", req.URL.Path)
+		body, _ := io.ReadAll(req.Body)
+		assert.Contains(t, string(body), `"template_id":"d-123"`)
+
+		resp := fakeResponse(req, http.StatusAccepted, ``)
+		resp.Header.Set("X-Message-Id", "msg-1")
+		return resp, nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:sendTestEmail",
+		Args: property.NewMap(map[string]property.Value{
+			"to":         property.New("qa@example.com"),
+			"from":       property.New("noreply@example.com"),
+			"templateId": property.New("d-123"),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "msg-1", resp.Return.Get("messageId").AsString())
+}
@@ -18,36 +18,78 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
 	// DefaultBaseURL is the default SendGrid API base URL
 	DefaultBaseURL = "https://api.sendgrid.com"
+
+	// EUBaseURL is the SendGrid API base URL for EU data residency
+	EUBaseURL = "https://api.eu.sendgrid.com"
 )
 
+// SendGridAPI is the set of SendGrid API operations used by resources and functions.
+// It is implemented by SendGridClient and can be replaced with a fake in unit tests.
+type SendGridAPI interface {
+	Get(ctx context.Context, path string, result interface{}) error
+	GetWithHeaders(ctx context.Context, path string, result interface{}) (http.Header, error)
+	Post(ctx context.Context, path string, body interface{}, result interface{}) error
+	PostWithHeaders(ctx context.Context, path string, body interface{}, result interface{}) (http.Header, error)
+	Put(ctx context.Context, path string, body interface{}, result interface{}) error
+	Patch(ctx context.Context, path string, body interface{}, result interface{}) error
+	Delete(ctx context.Context, path string) error
+	DeleteWithBody(ctx context.Context, path string, body interface{}, result interface{}) error
+	PostMultipart(ctx context.Context, path string, form MultipartForm, result interface{}) error
+}
+
+var _ SendGridAPI = (*SendGridClient)(nil)
+
 // SendGridClient is an HTTP client for the SendGrid API
 type SendGridClient struct {
-	apiKey     string
-	baseURL    string
-	httpClient *http.Client
+	apiKey      string
+	baseURL     string
+	httpClient  *http.Client
+	retry       retryPolicy
+	timeouts    requestTimeouts
+	limiter     *rateLimiter
+	breaker     *circuitBreaker
+	budget      *retryBudget
+	concurrency *concurrencyLimiter
+	userAgent   string
+	headers     http.Header
+	observers   []RequestObserver
+	lists       *listCache
+	writes      *recentWrites
 }
 
+// ClientOption configures optional behavior of a SendGridClient
+type ClientOption func(*SendGridClient)
+
 // NewSendGridClient creates a new SendGrid API client
-func NewSendGridClient(apiKey string, baseURL string) *SendGridClient {
+func NewSendGridClient(apiKey string, baseURL string, opts ...ClientOption) *SendGridClient {
 	if baseURL == "" {
 		baseURL = DefaultBaseURL
 	}
-	return &SendGridClient{
+	c := &SendGridClient{
 		apiKey:  apiKey,
 		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		// Timeouts are applied per request attempt, see requestTimeouts
+		httpClient: &http.Client{},
+		retry:      defaultRetryPolicy(),
+		timeouts:   requestTimeouts{request: DefaultRequestTimeout},
+		userAgent:  defaultUserAgent(),
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 // SendGridError represents an error response from the SendGrid API
@@ -55,6 +97,20 @@ type SendGridError struct {
 	StatusCode int
 	Message    string
 	Errors     []SendGridErrorDetail `json:"errors,omitempty"`
+
+	// Method and Endpoint identify the API call that failed, e.g. "POST" and "/v3/templates"
+	Method   string
+	Endpoint string
+
+	// RequestID is the request identifier returned by SendGrid, to quote when contacting support
+	RequestID string
+
+	// RateLimit is the rate-limit state reported with the response, if any
+	RateLimit *RateLimitInfo
+
+	// RequiredScope is the scope the API key most likely lacks when SendGrid
+	// answers 403 Forbidden, e.g. "whitelabel.create", if it is known
+	RequiredScope string
 }
 
 // SendGridErrorDetail represents a detailed error from SendGrid
@@ -64,11 +120,53 @@ type SendGridErrorDetail struct {
 	Help    string `json:"help,omitempty"`
 }
 
+// RateLimitInfo is the rate-limit state SendGrid reports in response headers
+type RateLimitInfo struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
 func (e *SendGridError) Error() string {
-	if len(e.Errors) > 0 {
-		return fmt.Sprintf("SendGrid API error (status %d): %s", e.StatusCode, e.Errors[0].Message)
+	var b strings.Builder
+	fmt.Fprintf(&b, "SendGrid API error (status %d)", e.StatusCode)
+	if e.Endpoint != "" {
+		fmt.Fprintf(&b, " on %s %s", e.Method, e.Endpoint)
+	}
+	b.WriteString(": ")
+
+	if len(e.Errors) == 0 {
+		b.WriteString(e.Message)
+	}
+	for i, detail := range e.Errors {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(detail.Message)
+		if detail.Field != "" {
+			fmt.Fprintf(&b, " (field: %s)", detail.Field)
+		}
+		if detail.Help != "" {
+			fmt.Fprintf(&b, " (help: %s)", detail.Help)
+		}
+	}
+
+	if e.RequiredScope != "" {
+		fmt.Fprintf(&b, " [the API key may be missing the %s scope]", e.RequiredScope)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " [request ID: %s]", e.RequestID)
+	}
+	// Both are reported whenever SendGrid sent them, so wrapped errors carry what
+	// support and retry decisions need
+	if e.RateLimit != nil {
+		fmt.Fprintf(&b, " [rate limit: %d of %d remaining", e.RateLimit.Remaining, e.RateLimit.Limit)
+		if !e.RateLimit.Reset.IsZero() {
+			fmt.Fprintf(&b, ", resets at %s", e.RateLimit.Reset.UTC().Format(time.RFC3339))
+		}
+		b.WriteString("]")
 	}
-	return fmt.Sprintf("SendGrid API error (status %d): %s", e.StatusCode, e.Message)
+	return b.String()
 }
 
 // IsNotFound returns true if the error is a 404 Not Found
@@ -76,51 +174,219 @@ func (e *SendGridError) IsNotFound() bool {
 	return e.StatusCode == http.StatusNotFound
 }
 
-// doRequest performs an HTTP request to the SendGrid API
-func (c *SendGridClient) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+// doRequest performs an HTTP request to the SendGrid API, retrying
+// rate-limited and transient failures according to the client's retry policy,
+// and returns the response headers
+func (c *SendGridClient) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) (header http.Header, err error) {
+	defer func() {
+		// Responses received before cancellation are reported as they are
+		var sgErr *SendGridError
+		if err != nil && ctx.Err() != nil && !errors.As(err, &sgErr) {
+			err = interruptedError(ctx, method, path, err)
+		}
+	}()
+
 	url := c.baseURL + path
 
-	var reqBody io.Reader
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
+	// Bodies are encoded once, so retries resend the same bytes
+	var payload *requestBody
+	switch b := body.(type) {
+	case nil:
+	case *requestBody:
+		payload = b
+	default:
+		data, err := json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonBody)
+		payload = &requestBody{data: data, contentType: "application/json"}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if c.budget != nil {
+			if err := c.budget.wait(ctx); err != nil {
+				return nil, fmt.Errorf("failed to execute request: %w", err)
+			}
+		}
+
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return nil, fmt.Errorf("failed to execute request: %w", err)
+			}
+		}
+
+		// The slot is held for a single attempt, so retry backoff does not block other requests
+		if c.concurrency != nil {
+			if err := c.concurrency.acquire(ctx); err != nil {
+				return nil, fmt.Errorf("failed to execute request: %w", err)
+			}
+		}
+
+		// The breaker is asked last, as a probe it lets through must be sent
+		// for its outcome to be recorded, and the waits above may be cancelled
+		if c.breaker != nil {
+			if err := c.breaker.allow(); err != nil {
+				if c.concurrency != nil {
+					c.concurrency.release()
+				}
+				return nil, err
+			}
+		}
+		start := time.Now()
+		resp, respBody, err := c.send(ctx, method, url, payload)
+		if c.concurrency != nil {
+			c.concurrency.release()
+		}
+		c.observe(ctx, method, path, resp, err, time.Since(start))
+		if c.lists != nil && method != http.MethodGet {
+			// Even a failed write may have changed the account
+			c.lists.invalidate()
+		}
+		if c.writes != nil && err == nil {
+			c.writes.record(method, path, resp, respBody)
+		}
+		if c.breaker != nil {
+			// Cancellation by the caller says nothing about the health of the API
+			if ctx.Err() == nil {
+				c.breaker.record(resp, err)
+			} else {
+				c.breaker.abandon()
+			}
+		}
+
+		if c.budget != nil {
+			c.budget.record(resp, err)
+		}
+
+		// A 404 for a resource just created is retried too, as it may only mean SendGrid has
+		// not caught up yet. It says nothing about the health of the API, so it does not
+		// draw on the retries shared by all requests.
+		notVisibleYet := c.writes != nil && err == nil && c.writes.notVisibleYet(method, path, resp)
+
+		// Stop retrying once the caller's context is done, e.g. on cancellation,
+		// or once the retries shared by all requests are spent
+		if attempt < c.retry.maxRetries && ctx.Err() == nil && (notVisibleYet ||
+			(c.retry.shouldRetry(method, resp, err) && (c.budget == nil || c.budget.withdraw()))) {
+			delay := c.retry.delay(attempt, resp)
+			if c.budget != nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+				// Hold back every request, not just this one, until the rate limit resets
+				c.budget.pause(delay)
+			}
+			if waitErr := sleepContext(ctx, delay); waitErr != nil {
+				return nil, fmt.Errorf("failed to execute request: %w", waitErr)
+			}
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		return resp.Header, parseResponse(resp, respBody, result)
+	}
+}
+
+// observe reports a request attempt to the client's observers and to the
+// recorder of the provider operation it belongs to, if any
+func (c *SendGridClient) observe(ctx context.Context, method, path string, resp *http.Response, err error, duration time.Duration) {
+	recorder := operationMetrics(ctx)
+	if len(c.observers) == 0 && recorder == nil {
+		return
+	}
+
+	// The query string is left out as it may contain email addresses
+	endpoint, _, _ := strings.Cut(path, "?")
+	m := RequestMeasurement{Method: method, Endpoint: endpoint, Duration: duration, Err: err}
+	if resp != nil {
+		m.StatusCode = resp.StatusCode
+	}
+
+	for _, observer := range c.observers {
+		observer.ObserveRequest(m)
+	}
+	if recorder != nil {
+		recorder.ObserveRequest(m)
+	}
+}
+
+// send performs a single HTTP request and reads the full response body
+func (c *SendGridClient) send(ctx context.Context, method, url string, payload *requestBody) (*http.Response, []byte, error) {
+	timeout := c.timeouts.forMethod(method)
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var reqBody io.Reader
+	contentType := "application/json"
+	if payload != nil {
+		reqBody = bytes.NewReader(payload.data)
+		contentType = payload.contentType
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	for name, values := range c.headers {
+		req.Header[name] = values
+	}
 	req.Header.Set("Authorization", "Bearer "+c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", c.userAgent)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		if timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, nil, fmt.Errorf("failed to execute request: timed out after %s: %w", timeout, err)
+		}
+		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		if timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, nil, fmt.Errorf("failed to read response body: timed out after %s: %w", timeout, err)
+		}
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	return resp, respBody, nil
+}
+
+// parseResponse converts an error status into a SendGridError or decodes a
+// successful response body into result
+func parseResponse(resp *http.Response, respBody []byte, result interface{}) error {
 	// Check for error status codes
 	if resp.StatusCode >= 400 {
 		sgErr := &SendGridError{
 			StatusCode: resp.StatusCode,
 			Message:    http.StatusText(resp.StatusCode),
+			RequestID:  resp.Header.Get("X-Request-Id"),
+			RateLimit:  parseRateLimit(resp.Header),
+		}
+		if resp.Request != nil {
+			// The query string is left out as it may contain email addresses
+			sgErr.Method = resp.Request.Method
+			sgErr.Endpoint = resp.Request.URL.Path
+			if resp.StatusCode == http.StatusForbidden {
+				sgErr.RequiredScope = requiredScope(sgErr.Method, sgErr.Endpoint)
+			}
 		}
 		// Try to parse the error response
 		if len(respBody) > 0 {
 			var errResp struct {
 				Errors []SendGridErrorDetail `json:"errors"`
+				Error  string                `json:"error"`
 			}
-			if json.Unmarshal(respBody, &errResp) == nil && len(errResp.Errors) > 0 {
-				sgErr.Errors = errResp.Errors
+			if json.Unmarshal(respBody, &errResp) == nil {
+				if len(errResp.Errors) > 0 {
+					sgErr.Errors = errResp.Errors
+				} else if errResp.Error != "" {
+					sgErr.Message = errResp.Error
+				}
 			}
 		}
 		return sgErr
@@ -136,27 +402,77 @@ func (c *SendGridClient) doRequest(ctx context.Context, method, path string, bod
 	return nil
 }
 
+// parseRateLimit reads SendGrid's X-RateLimit-* response headers, returning nil if they are absent
+func parseRateLimit(header http.Header) *RateLimitInfo {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return nil
+	}
+	info := &RateLimitInfo{Limit: limit, Remaining: limit}
+	if remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil {
+		info.Remaining = remaining
+	}
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		info.Reset = time.Unix(reset, 0)
+	}
+	return info
+}
+
 // Get performs a GET request
 func (c *SendGridClient) Get(ctx context.Context, path string, result interface{}) error {
+	_, err := c.doRequest(ctx, http.MethodGet, path, nil, result)
+	return err
+}
+
+// GetWithHeaders performs a GET request and returns the response headers,
+// e.g. to follow pagination links
+func (c *SendGridClient) GetWithHeaders(ctx context.Context, path string, result interface{}) (http.Header, error) {
 	return c.doRequest(ctx, http.MethodGet, path, nil, result)
 }
 
 // Post performs a POST request
 func (c *SendGridClient) Post(ctx context.Context, path string, body interface{}, result interface{}) error {
+	_, err := c.doRequest(ctx, http.MethodPost, path, body, result)
+	return err
+}
+
+// PostWithHeaders performs a POST request and returns the response headers,
+// e.g. to read the X-Message-Id of a sent email
+func (c *SendGridClient) PostWithHeaders(ctx context.Context, path string, body interface{}, result interface{}) (http.Header, error) {
 	return c.doRequest(ctx, http.MethodPost, path, body, result)
 }
 
 // Put performs a PUT request
 func (c *SendGridClient) Put(ctx context.Context, path string, body interface{}, result interface{}) error {
-	return c.doRequest(ctx, http.MethodPut, path, body, result)
+	_, err := c.doRequest(ctx, http.MethodPut, path, body, result)
+	return err
 }
 
-// Patch performs a PATCH request
+// Patch performs a PATCH request, retrying it after re-reading the resource
+// when SendGrid reports a conflicting concurrent change
 func (c *SendGridClient) Patch(ctx context.Context, path string, body interface{}, result interface{}) error {
-	return c.doRequest(ctx, http.MethodPatch, path, body, result)
+	return c.patchResolvingConflicts(ctx, path, body, result)
 }
 
 // Delete performs a DELETE request
 func (c *SendGridClient) Delete(ctx context.Context, path string) error {
-	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
+	_, err := c.doRequest(ctx, http.MethodDelete, path, nil, nil)
+	return err
+}
+
+// DeleteWithBody performs a DELETE request with a JSON body, as used by the
+// bulk delete endpoints, e.g. DELETE /v3/suppression/blocks with a list of emails
+func (c *SendGridClient) DeleteWithBody(ctx context.Context, path string, body interface{}, result interface{}) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, path, body, result)
+	return err
+}
+
+// PostMultipart performs a POST request with a multipart/form-data body, e.g. to upload a file
+func (c *SendGridClient) PostMultipart(ctx context.Context, path string, form MultipartForm, result interface{}) error {
+	body, err := form.encode()
+	if err != nil {
+		return fmt.Errorf("failed to encode multipart body: %w", err)
+	}
+	_, err = c.doRequest(ctx, http.MethodPost, path, body, result)
+	return err
 }
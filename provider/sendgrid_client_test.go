@@ -0,0 +1,159 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestSendGridClient_ErrorContext(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Request-Id", "req-abc123")
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1700000060")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors": [
+			{"message": "name is required", "field": "name", "help": "https://docs.sendgrid.com/names"},
+			{"message": "invalid scope", "field": "scopes"}
+		]}`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	err := client.Post(context.Background(), "/v3/api_keys?on-behalf-of=someone@example.com", map[string]string{}, nil)
+	wrapped := fmt.Errorf("failed to create API key: %w", err)
+
+	var sgErr *SendGridError
+	require.True(t, errors.As(wrapped, &sgErr))
+	assert.Equal(t, "POST", sgErr.Method)
+	assert.Equal(t, "/v3/api_keys", sgErr.Endpoint)
+	assert.Equal(t, "req-abc123", sgErr.RequestID)
+	require.Len(t, sgErr.Errors, 2)
+	require.NotNil(t, sgErr.RateLimit)
+	assert.Equal(t, 600, sgErr.RateLimit.Limit)
+	assert.Equal(t, 0, sgErr.RateLimit.Remaining)
+	assert.Equal(t, time.Unix(1700000060, 0), sgErr.RateLimit.Reset)
+
+	msg := wrapped.Error()
+	assert.Contains(t, msg, "failed to create API key: SendGrid API error (status 400) on POST /v3/api_keys")
+	assert.Contains(t, msg, "name is required (field: name) (help: https://docs.sendgrid.com/names); invalid scope (field: scopes)")
+	assert.Contains(t, msg, "[request ID: req-abc123]")
+	assert.Contains(t, msg, "[rate limit: 0 of 600 remaining, resets at 2023-11-14T22:14:20Z]")
+	assert.NotContains(t, msg, "someone@example.com")
+}
+
+func TestResourceErrors_RequestContext(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp := fakeResponse(req, http.StatusBadRequest, `{"errors": [{"message": "rejected"}]}`)
+		resp.Header.Set("X-Request-Id", "req-"+req.Method)
+		resp.Header.Set("X-RateLimit-Limit", "600")
+		resp.Header.Set("X-RateLimit-Remaining", "598")
+		return resp, nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("ApiKey", "ci")
+	state := property.NewMap(map[string]property.Value{
+		"name":        property.New("ci"),
+		"apiKeyId":    property.New("key-1"),
+		"apiKeyValue": property.New("SG.key"),
+	})
+	inputs := property.NewMap(map[string]property.Value{"name": property.New("renamed")})
+
+	_, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create API key")
+	assert.Contains(t, err.Error(), "[request ID: req-POST] [rate limit: 598 of 600 remaining]")
+
+	_, err = server.Update(p.UpdateRequest{ID: "key-1", Urn: urn, State: state, Inputs: inputs})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to update API key")
+	assert.Contains(t, err.Error(), "[request ID: req-PUT] [rate limit: 598 of 600 remaining]")
+
+	err = server.Delete(p.DeleteRequest{ID: "key-1", Urn: urn, Properties: state})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to delete API key")
+	assert.Contains(t, err.Error(), "[request ID: req-DELETE] [rate limit: 598 of 600 remaining]")
+}
+
+func TestSendGridClient_ErrorField(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error": "access forbidden"}`))
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	err := client.Get(context.Background(), "/v3/subusers", nil)
+	var sgErr *SendGridError
+	require.True(t, errors.As(err, &sgErr))
+	assert.Equal(t, "access forbidden", sgErr.Message)
+	assert.Nil(t, sgErr.RateLimit)
+	assert.NotContains(t, err.Error(), "rate limit")
+}
+
+func TestParseRateLimit(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, parseRateLimit(http.Header{}))
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "100")
+	info := parseRateLimit(header)
+	require.NotNil(t, info)
+	assert.Equal(t, 100, info.Remaining)
+	assert.True(t, info.Reset.IsZero())
+}
+
+func TestSendGridClient_DeleteWithBody(t *testing.T) {
+	t.Parallel()
+
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v3/suppression/blocks", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"delete_all": false, "emails": ["a@example.com"]}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	err := client.DeleteWithBody(context.Background(), "/v3/suppression/blocks", map[string]interface{}{
+		"delete_all": false,
+		"emails":     []string{"a@example.com"},
+	}, nil)
+	require.NoError(t, err)
+}
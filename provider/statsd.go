@@ -0,0 +1,58 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// statsdPrefix is prepended to the names of the metrics pushed to statsd
+const statsdPrefix = "sendgrid"
+
+// statsdObserver is a RequestObserver that pushes a request counter, an error
+// counter by status and a latency timer to a statsd server over UDP
+type statsdObserver struct {
+	conn net.Conn
+}
+
+// newStatsdObserver creates an observer that sends metrics to the statsd server at address (host:port)
+func newStatsdObserver(address string) (*statsdObserver, error) {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return nil, fmt.Errorf("invalid statsdAddress %q: %w", address, err)
+	}
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statsdAddress %q: %w", address, err)
+	}
+	return &statsdObserver{conn: conn}, nil
+}
+
+// ObserveRequest sends the metrics for a single request attempt in one packet.
+// Delivery is best effort: telemetry must never fail a deployment.
+func (o *statsdObserver) ObserveRequest(m RequestMeasurement) {
+	lines := []string{
+		fmt.Sprintf("%s.requests:1|c", statsdPrefix),
+		fmt.Sprintf("%s.latency:%d|ms", statsdPrefix, m.Duration/time.Millisecond),
+	}
+	if m.Err != nil {
+		lines = append(lines, fmt.Sprintf("%s.errors.network:1|c", statsdPrefix))
+	} else if m.StatusCode >= 400 {
+		lines = append(lines, fmt.Sprintf("%s.errors.%d:1|c", statsdPrefix, m.StatusCode))
+	}
+	_, _ = o.conn.Write([]byte(strings.Join(lines, "\n")))
+}
@@ -0,0 +1,62 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsdObserver(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	observer, err := newStatsdObserver(listener.LocalAddr().String())
+	require.NoError(t, err)
+
+	receive := func() []string {
+		buf := make([]byte, 1024)
+		require.NoError(t, listener.SetReadDeadline(time.Now().Add(time.Second)))
+		n, _, err := listener.ReadFrom(buf)
+		require.NoError(t, err)
+		return strings.Split(string(buf[:n]), "\n")
+	}
+
+	observer.ObserveRequest(RequestMeasurement{StatusCode: 200, Duration: 120 * time.Millisecond})
+	assert.Equal(t, []string{"sendgrid.requests:1|c", "sendgrid.latency:120|ms"}, receive())
+
+	observer.ObserveRequest(RequestMeasurement{StatusCode: 429, Duration: 5 * time.Millisecond})
+	assert.Equal(t, []string{"sendgrid.requests:1|c", "sendgrid.latency:5|ms", "sendgrid.errors.429:1|c"}, receive())
+
+	observer.ObserveRequest(RequestMeasurement{Err: errors.New("connection refused")})
+	assert.Equal(t, []string{"sendgrid.requests:1|c", "sendgrid.latency:0|ms", "sendgrid.errors.network:1|c"}, receive())
+}
+
+func TestNewStatsdObserver_InvalidAddress(t *testing.T) {
+	t.Parallel()
+
+	_, err := newStatsdObserver("localhost")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid statsdAddress")
+}
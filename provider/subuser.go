@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"net/url"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // Subuser is the controller for the SendGrid Subuser resource.
@@ -33,12 +35,13 @@ type Subuser struct{}
 type SubuserArgs struct {
 	// Username is the username for the subuser (required)
 	// This will be used as the resource ID
-	Username string `pulumi:"username"`
+	Username string `pulumi:"username" provider:"replaceOnChanges"`
 
 	// Email is the email address of the subuser (required)
 	Email string `pulumi:"email"`
 
 	// Password is the password for the subuser to log into SendGrid (required)
+	// It must be 8 to 128 characters, include a letter and a digit, and differ from the username.
 	// This is only used during creation and cannot be retrieved
 	Password string `pulumi:"password" provider:"secret"`
 
@@ -52,6 +55,10 @@ type SubuserArgs struct {
 
 	// Disabled indicates whether the subuser is disabled (optional)
 	Disabled *bool `pulumi:"disabled,optional"`
+
+	// DeletionProtection prevents the subuser from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // SubuserState is the state of the Subuser resource.
@@ -73,6 +80,9 @@ type SubuserState struct {
 
 	// Disabled indicates whether the subuser is disabled
 	Disabled bool `pulumi:"disabled"`
+
+	// DeletionProtection prevents the subuser from being deleted while set to true
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // Annotate provides descriptions for the Subuser resource.
@@ -103,12 +113,55 @@ type subuserGetResponse struct {
 	Disabled bool   `json:"disabled"`
 }
 
+// Check validates the Subuser inputs.
+func (s *Subuser) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[SubuserArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[SubuserArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[SubuserArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[SubuserArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid SubuserArgs
+func (args *SubuserArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("username", args.Username)
+	v.email("email", args.Email)
+	v.required("password", args.Password)
+	v.password("password", args.Password, args.Username)
+	v.ipAddresses("ips", args.Ips)
+	if args.Region != nil {
+		v.oneOf("region", *args.Region, "global", "eu")
+	}
+	return v.failures
+}
+
+// Diff compares the Subuser inputs with its state. The password is only used
+// on creation and is not kept in state, so it is never reported as changed.
+func (s *Subuser) Diff(_ context.Context, req infer.DiffRequest[SubuserArgs, SubuserState]) (p.DiffResponse, error) {
+	olds := SubuserArgs{
+		Username:           req.State.Username,
+		Email:              req.State.Email,
+		Password:           req.Inputs.Password,
+		Ips:                req.State.Ips,
+		Region:             req.State.Region,
+		Disabled:           &req.State.Disabled,
+		DeletionProtection: req.State.DeletionProtection,
+	}
+	normalizeArgs(&olds, req.Inputs, nil)
+	return diffArgs(olds, req.Inputs), nil
+}
+
 // Create creates a new SendGrid Subuser.
 func (s *Subuser) Create(ctx context.Context, req infer.CreateRequest[SubuserArgs]) (infer.CreateResponse[SubuserState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		disabled := false
 		if input.Disabled != nil {
@@ -121,9 +174,10 @@ func (s *Subuser) Create(ctx context.Context, req infer.CreateRequest[SubuserArg
 			Ips:      input.Ips,
 			Region:   input.Region,
 			Disabled: disabled,
+
+			DeletionProtection: input.DeletionProtection,
 		}
 		return infer.CreateResponse[SubuserState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -167,12 +221,18 @@ func (s *Subuser) Create(ctx context.Context, req infer.CreateRequest[SubuserArg
 		Ips:      result.Ips,
 		Region:   region,
 		Disabled: false, // New subusers are enabled by default
+
+		DeletionProtection: input.DeletionProtection,
 	}
 
 	// If disabled is requested, update the subuser to disable it
 	if input.Disabled != nil && *input.Disabled {
 		if err := s.setDisabled(ctx, client, input.Username, true); err != nil {
-			return infer.CreateResponse[SubuserState]{}, fmt.Errorf("subuser created but failed to disable: %w", err)
+			// The subuser exists, so it is recorded and disabled again on the next update
+			return infer.CreateResponse[SubuserState]{
+				ID:     input.Username,
+				Output: state,
+			}, initFailed("disable subuser", err)
 		}
 		state.Disabled = true
 	}
@@ -184,7 +244,7 @@ func (s *Subuser) Create(ctx context.Context, req infer.CreateRequest[SubuserArg
 }
 
 // setDisabled enables or disables a subuser
-func (s *Subuser) setDisabled(ctx context.Context, client *SendGridClient, username string, disabled bool) error {
+func (s *Subuser) setDisabled(ctx context.Context, client SendGridAPI, username string, disabled bool) error {
 	encodedUsername := url.PathEscape(username)
 	reqBody := map[string]interface{}{
 		"disabled": disabled,
@@ -223,8 +283,9 @@ func (s *Subuser) Read(ctx context.Context, req infer.ReadRequest[SubuserArgs, S
 		UserID:   result.ID,
 		Disabled: result.Disabled,
 		// Preserve IPs and Region from old state as they're not returned by GET
-		Ips:    oldState.Ips,
-		Region: oldState.Region,
+		Ips:                oldState.Ips,
+		Region:             oldState.Region,
+		DeletionProtection: req.Inputs.DeletionProtection,
 	}
 
 	inputs := SubuserArgs{
@@ -234,8 +295,11 @@ func (s *Subuser) Read(ctx context.Context, req infer.ReadRequest[SubuserArgs, S
 		Region:   oldState.Region,
 		Disabled: &result.Disabled,
 		// Password is not returned by the API; preserve the old input value to avoid perpetual diffs
-		Password: req.Inputs.Password,
+		Password:           req.Inputs.Password,
+		DeletionProtection: req.Inputs.DeletionProtection,
 	}
+	normalizeArgs(&inputs, req.Inputs, nil)
+	preserveInputs(&inputs, req.Inputs, "ips", "region")
 
 	return infer.ReadResponse[SubuserArgs, SubuserState]{
 		ID:     id,
@@ -264,6 +328,8 @@ func (s *Subuser) Update(ctx context.Context, req infer.UpdateRequest[SubuserArg
 			Ips:      input.Ips,
 			Region:   input.Region,
 			Disabled: disabled,
+
+			DeletionProtection: input.DeletionProtection,
 		}
 		return infer.UpdateResponse[SubuserState]{Output: state}, nil
 	}
@@ -306,6 +372,8 @@ func (s *Subuser) Update(ctx context.Context, req infer.UpdateRequest[SubuserArg
 		Ips:      input.Ips,
 		Region:   input.Region,
 		Disabled: disabled,
+
+		DeletionProtection: input.DeletionProtection,
 	}
 
 	return infer.UpdateResponse[SubuserState]{Output: state}, nil
@@ -315,6 +383,10 @@ func (s *Subuser) Update(ctx context.Context, req infer.UpdateRequest[SubuserArg
 func (s *Subuser) Delete(ctx context.Context, req infer.DeleteRequest[SubuserState]) (infer.DeleteResponse, error) {
 	id := req.ID // id is the username
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "subuser", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
@@ -0,0 +1,194 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// SubuserDomainAssociation is the controller for the SendGrid subuser domain association resource.
+//
+// This resource lets a subuser send from a domain authenticated by the parent account.
+// A subuser has at most one associated domain, so the resource is identified by the subuser's username.
+type SubuserDomainAssociation struct{}
+
+// SubuserDomainAssociationArgs are the inputs to the SubuserDomainAssociation resource.
+type SubuserDomainAssociationArgs struct {
+	// DomainID is the ID of the parent account's domain authentication (required)
+	DomainID int `pulumi:"domainId" provider:"replaceOnChanges"`
+
+	// Username is the username of the subuser (required)
+	Username string `pulumi:"username" provider:"replaceOnChanges"`
+
+	// DeletionProtection prevents the association from being removed, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// SubuserDomainAssociationState is the state of the SubuserDomainAssociation resource.
+type SubuserDomainAssociationState struct {
+	// Embed the input args in the output state
+	SubuserDomainAssociationArgs
+
+	// Domain is the authenticated domain the subuser sends from
+	Domain string `pulumi:"domain"`
+}
+
+// Annotate provides descriptions for the SubuserDomainAssociation resource.
+func (s *SubuserDomainAssociation) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&s, "Associates a domain authentication of the parent account with a subuser.\n\n"+
+		"The subuser can then send from the authenticated domain without authenticating it itself. "+
+		"A subuser has at most one associated domain, so the resource ID is the subuser's username. "+
+		"Deleting the resource removes the association.")
+}
+
+// subuserDomainAPIResponse represents the parts of the SendGrid domain authentication
+// returned for a subuser that the association needs
+type subuserDomainAPIResponse struct {
+	ID     int    `json:"id"`
+	Domain string `json:"domain"`
+}
+
+// Check validates the SubuserDomainAssociation inputs.
+func (s *SubuserDomainAssociation) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[SubuserDomainAssociationArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[SubuserDomainAssociationArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[SubuserDomainAssociationArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[SubuserDomainAssociationArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid SubuserDomainAssociationArgs
+func (args *SubuserDomainAssociationArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.positive("domainId", args.DomainID)
+	v.required("username", args.Username)
+	return v.failures
+}
+
+// Create associates the domain authentication with the subuser.
+func (s *SubuserDomainAssociation) Create(ctx context.Context, req infer.CreateRequest[SubuserDomainAssociationArgs]) (infer.CreateResponse[SubuserDomainAssociationState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return the expected state
+	if preview {
+		return infer.CreateResponse[SubuserDomainAssociationState]{
+			ID:     input.Username,
+			Output: SubuserDomainAssociationState{SubuserDomainAssociationArgs: input},
+		}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.CreateResponse[SubuserDomainAssociationState]{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	reqBody := map[string]interface{}{
+		"username": input.Username,
+	}
+	var result subuserDomainAPIResponse
+	if err := client.Post(ctx, fmt.Sprintf("/v3/whitelabel/domains/%d/subuser", input.DomainID), reqBody, &result); err != nil {
+		return infer.CreateResponse[SubuserDomainAssociationState]{}, fmt.Errorf("failed to associate domain with subuser: %w", err)
+	}
+
+	return infer.CreateResponse[SubuserDomainAssociationState]{
+		ID: input.Username,
+		Output: SubuserDomainAssociationState{
+			SubuserDomainAssociationArgs: input,
+			Domain:                       result.Domain,
+		},
+	}, nil
+}
+
+// Read retrieves the domain authentication associated with the subuser.
+func (s *SubuserDomainAssociation) Read(ctx context.Context, req infer.ReadRequest[SubuserDomainAssociationArgs, SubuserDomainAssociationState]) (infer.ReadResponse[SubuserDomainAssociationArgs, SubuserDomainAssociationState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[SubuserDomainAssociationArgs, SubuserDomainAssociationState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	var result subuserDomainAPIResponse
+	path := withQuery("/v3/whitelabel/domains/subuser", url.Values{"username": {id}})
+	if err := client.Get(ctx, path, &result); err != nil {
+		// Check if the association was removed out-of-band
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			// Return empty response to indicate resource no longer exists
+			return infer.ReadResponse[SubuserDomainAssociationArgs, SubuserDomainAssociationState]{}, nil
+		}
+		return infer.ReadResponse[SubuserDomainAssociationArgs, SubuserDomainAssociationState]{}, fmt.Errorf("failed to read subuser domain association: %w", err)
+	}
+
+	inputs := SubuserDomainAssociationArgs{
+		DomainID:           result.ID,
+		Username:           id,
+		DeletionProtection: req.Inputs.DeletionProtection,
+	}
+	return infer.ReadResponse[SubuserDomainAssociationArgs, SubuserDomainAssociationState]{
+		ID:     id,
+		Inputs: inputs,
+		State: SubuserDomainAssociationState{
+			SubuserDomainAssociationArgs: inputs,
+			Domain:                       result.Domain,
+		},
+	}, nil
+}
+
+// Update records a change of deletionProtection, as every other input replaces the association.
+func (s *SubuserDomainAssociation) Update(_ context.Context, req infer.UpdateRequest[SubuserDomainAssociationArgs, SubuserDomainAssociationState]) (infer.UpdateResponse[SubuserDomainAssociationState], error) {
+	state := req.State
+	state.SubuserDomainAssociationArgs = req.Inputs
+	return infer.UpdateResponse[SubuserDomainAssociationState]{Output: state}, nil
+}
+
+// Delete removes the domain association of the subuser.
+func (s *SubuserDomainAssociation) Delete(ctx context.Context, req infer.DeleteRequest[SubuserDomainAssociationState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "subuser domain association", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	path := withQuery("/v3/whitelabel/domains/subuser", url.Values{"username": {id}})
+	if err := client.Delete(ctx, path); err != nil {
+		// If already removed, that's fine
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete subuser domain association: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
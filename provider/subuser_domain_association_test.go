@@ -0,0 +1,116 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestSubuserDomainAssociation_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	associated := false
+	var requests []string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method + " " + req.URL.Path {
+		case "POST /v3/whitelabel/domains/11/subuser":
+			var body map[string]string
+			data, _ := io.ReadAll(req.Body)
+			require.NoError(t, json.Unmarshal(data, &body))
+			assert.Equal(t, "tenant-a", body["username"])
+			associated = true
+			return fakeResponse(req, http.StatusCreated, `{"id": 11, "domain": "example.com", "username": "parent"}`), nil
+		case "GET /v3/whitelabel/domains/subuser":
+			assert.Equal(t, "tenant-a", req.URL.Query().Get("username"))
+			if !associated {
+				return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "no domain associated"}]}`), nil
+			}
+			return fakeResponse(req, http.StatusOK, `{"id": 11, "domain": "example.com", "username": "parent"}`), nil
+		case "DELETE /v3/whitelabel/domains/subuser":
+			assert.Equal(t, "tenant-a", req.URL.Query().Get("username"))
+			associated = false
+			return fakeResponse(req, http.StatusNoContent, ``), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("SubuserDomainAssociation", "tenant-a")
+	inputs := property.NewMap(map[string]property.Value{
+		"domainId": property.New(11.0),
+		"username": property.New("tenant-a"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", created.ID)
+	assert.Equal(t, "example.com", created.Properties.Get("domain").AsString())
+
+	read, err := server.Read(p.ReadRequest{ID: "tenant-a", Urn: urn, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, 11.0, read.Inputs.Get("domainId").AsNumber())
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "tenant-a", Urn: urn, Properties: read.Properties}))
+
+	// Once the association is removed, SendGrid returns 404 and the resource is gone
+	gone, err := server.Read(p.ReadRequest{ID: "tenant-a", Urn: urn, Properties: read.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Empty(t, gone.ID)
+
+	assert.Equal(t, []string{
+		"POST /v3/whitelabel/domains/11/subuser",
+		"GET /v3/whitelabel/domains/subuser",
+		"DELETE /v3/whitelabel/domains/subuser",
+		"GET /v3/whitelabel/domains/subuser",
+	}, requests)
+}
+
+func TestSubuserDomainAssociation_DeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	err := server.Delete(p.DeleteRequest{
+		ID:  "tenant-a",
+		Urn: previewURN("SubuserDomainAssociation", "tenant-a"),
+		Properties: property.NewMap(map[string]property.Value{
+			"domainId":           property.New(11.0),
+			"username":           property.New("tenant-a"),
+			"domain":             property.New("example.com"),
+			"deletionProtection": property.New(true),
+		}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deletionProtection")
+}
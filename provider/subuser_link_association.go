@@ -0,0 +1,194 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// SubuserLinkAssociation is the controller for the SendGrid subuser link branding association resource.
+//
+// This resource lets a subuser brand its links with a link branding of the parent account.
+// A subuser has at most one associated link branding, so the resource is identified by the subuser's username.
+type SubuserLinkAssociation struct{}
+
+// SubuserLinkAssociationArgs are the inputs to the SubuserLinkAssociation resource.
+type SubuserLinkAssociationArgs struct {
+	// LinkID is the ID of the parent account's link branding (required)
+	LinkID int `pulumi:"linkId" provider:"replaceOnChanges"`
+
+	// Username is the username of the subuser (required)
+	Username string `pulumi:"username" provider:"replaceOnChanges"`
+
+	// DeletionProtection prevents the association from being removed, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+}
+
+// SubuserLinkAssociationState is the state of the SubuserLinkAssociation resource.
+type SubuserLinkAssociationState struct {
+	// Embed the input args in the output state
+	SubuserLinkAssociationArgs
+
+	// Domain is the domain of the subuser's branded links
+	Domain string `pulumi:"domain"`
+}
+
+// Annotate provides descriptions for the SubuserLinkAssociation resource.
+func (s *SubuserLinkAssociation) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&s, "Associates a link branding of the parent account with a subuser.\n\n"+
+		"The links in the subuser's emails then use the branded domain without the subuser branding it itself. "+
+		"A subuser has at most one associated link branding, so the resource ID is the subuser's username. "+
+		"Deleting the resource removes the association.")
+}
+
+// subuserLinkAPIResponse represents the parts of the SendGrid link branding
+// returned for a subuser that the association needs
+type subuserLinkAPIResponse struct {
+	ID     int    `json:"id"`
+	Domain string `json:"domain"`
+}
+
+// Check validates the SubuserLinkAssociation inputs.
+func (s *SubuserLinkAssociation) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[SubuserLinkAssociationArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[SubuserLinkAssociationArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[SubuserLinkAssociationArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[SubuserLinkAssociationArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid SubuserLinkAssociationArgs
+func (args *SubuserLinkAssociationArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.positive("linkId", args.LinkID)
+	v.required("username", args.Username)
+	return v.failures
+}
+
+// Create associates the link branding with the subuser.
+func (s *SubuserLinkAssociation) Create(ctx context.Context, req infer.CreateRequest[SubuserLinkAssociationArgs]) (infer.CreateResponse[SubuserLinkAssociationState], error) {
+	input := req.Inputs
+	preview := req.DryRun
+
+	// During preview, return the expected state
+	if preview {
+		return infer.CreateResponse[SubuserLinkAssociationState]{
+			ID:     input.Username,
+			Output: SubuserLinkAssociationState{SubuserLinkAssociationArgs: input},
+		}, nil
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.CreateResponse[SubuserLinkAssociationState]{}, fmt.Errorf("SendGrid client not configured - ensure apiKey is set in provider configuration")
+	}
+
+	reqBody := map[string]interface{}{
+		"username": input.Username,
+	}
+	var result subuserLinkAPIResponse
+	if err := client.Post(ctx, fmt.Sprintf("/v3/whitelabel/links/%d/subuser", input.LinkID), reqBody, &result); err != nil {
+		return infer.CreateResponse[SubuserLinkAssociationState]{}, fmt.Errorf("failed to associate link branding with subuser: %w", err)
+	}
+
+	return infer.CreateResponse[SubuserLinkAssociationState]{
+		ID: input.Username,
+		Output: SubuserLinkAssociationState{
+			SubuserLinkAssociationArgs: input,
+			Domain:                     result.Domain,
+		},
+	}, nil
+}
+
+// Read retrieves the link branding associated with the subuser.
+func (s *SubuserLinkAssociation) Read(ctx context.Context, req infer.ReadRequest[SubuserLinkAssociationArgs, SubuserLinkAssociationState]) (infer.ReadResponse[SubuserLinkAssociationArgs, SubuserLinkAssociationState], error) {
+	id := req.ID
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.ReadResponse[SubuserLinkAssociationArgs, SubuserLinkAssociationState]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	var result subuserLinkAPIResponse
+	path := withQuery("/v3/whitelabel/links/subuser", url.Values{"username": {id}})
+	if err := client.Get(ctx, path, &result); err != nil {
+		// Check if the association was removed out-of-band
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			// Return empty response to indicate resource no longer exists
+			return infer.ReadResponse[SubuserLinkAssociationArgs, SubuserLinkAssociationState]{}, nil
+		}
+		return infer.ReadResponse[SubuserLinkAssociationArgs, SubuserLinkAssociationState]{}, fmt.Errorf("failed to read subuser link association: %w", err)
+	}
+
+	inputs := SubuserLinkAssociationArgs{
+		LinkID:             result.ID,
+		Username:           id,
+		DeletionProtection: req.Inputs.DeletionProtection,
+	}
+	return infer.ReadResponse[SubuserLinkAssociationArgs, SubuserLinkAssociationState]{
+		ID:     id,
+		Inputs: inputs,
+		State: SubuserLinkAssociationState{
+			SubuserLinkAssociationArgs: inputs,
+			Domain:                     result.Domain,
+		},
+	}, nil
+}
+
+// Update records a change of deletionProtection, as every other input replaces the association.
+func (s *SubuserLinkAssociation) Update(_ context.Context, req infer.UpdateRequest[SubuserLinkAssociationArgs, SubuserLinkAssociationState]) (infer.UpdateResponse[SubuserLinkAssociationState], error) {
+	state := req.State
+	state.SubuserLinkAssociationArgs = req.Inputs
+	return infer.UpdateResponse[SubuserLinkAssociationState]{Output: state}, nil
+}
+
+// Delete removes the link branding association of the subuser.
+func (s *SubuserLinkAssociation) Delete(ctx context.Context, req infer.DeleteRequest[SubuserLinkAssociationState]) (infer.DeleteResponse, error) {
+	id := req.ID
+
+	if err := checkDeletionProtection(req.State.DeletionProtection, "subuser link association", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	path := withQuery("/v3/whitelabel/links/subuser", url.Values{"username": {id}})
+	if err := client.Delete(ctx, path); err != nil {
+		// If already removed, that's fine
+		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete subuser link association: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
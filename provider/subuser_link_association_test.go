@@ -0,0 +1,104 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestSubuserLinkAssociation_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	linkID := 0
+	var requests []string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method + " " + req.URL.Path {
+		case "POST /v3/whitelabel/links/22/subuser":
+			var body map[string]string
+			data, _ := io.ReadAll(req.Body)
+			require.NoError(t, json.Unmarshal(data, &body))
+			assert.Equal(t, "tenant-a", body["username"])
+			linkID = 22
+			return fakeResponse(req, http.StatusOK, `{"id": 22, "domain": "example.com", "subdomain": "links"}`), nil
+		case "GET /v3/whitelabel/links/subuser":
+			assert.Equal(t, "tenant-a", req.URL.Query().Get("username"))
+			if linkID == 0 {
+				return fakeResponse(req, http.StatusNotFound, `{"errors": [{"message": "no link branding associated"}]}`), nil
+			}
+			return fakeResponse(req, http.StatusOK, fmt.Sprintf(`{"id": %d, "domain": "example.com", "subdomain": "links"}`, linkID)), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	urn := previewURN("SubuserLinkAssociation", "tenant-a")
+	inputs := property.NewMap(map[string]property.Value{
+		"linkId":   property.New(22.0),
+		"username": property.New("tenant-a"),
+	})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", created.ID)
+	assert.Equal(t, "example.com", created.Properties.Get("domain").AsString())
+
+	// A link branding associated elsewhere shows up as a changed linkId on refresh
+	mu.Lock()
+	linkID = 23
+	mu.Unlock()
+	read, err := server.Read(p.ReadRequest{ID: "tenant-a", Urn: urn, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, 23.0, read.Inputs.Get("linkId").AsNumber())
+	assert.Equal(t, "tenant-a", read.Inputs.Get("username").AsString())
+
+	assert.Equal(t, []string{
+		"POST /v3/whitelabel/links/22/subuser",
+		"GET /v3/whitelabel/links/subuser",
+	}, requests)
+}
+
+func TestSubuserLinkAssociation_Check(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, nil, nil)
+
+	resp, err := server.Check(p.CheckRequest{
+		Urn: previewURN("SubuserLinkAssociation", "tenant-a"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"linkId":   property.New(0.0),
+			"username": property.New(" "),
+		}),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Failures, 2)
+	assert.Equal(t, "linkId", resp.Failures[0].Property)
+	assert.Equal(t, "username", resp.Failures[1].Property)
+}
@@ -0,0 +1,214 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// SubuserOnboarding is a component that provisions a subuser for a tenant: the Subuser
+// with its IPs, an API key created on its behalf, and optionally the association of
+// the parent account's domain authentication and link branding.
+type SubuserOnboarding struct {
+	pulumi.ResourceState
+
+	// Username is the username of the subuser
+	Username pulumi.StringOutput `pulumi:"username"`
+
+	// UserID is the ID of the subuser
+	UserID pulumi.IntOutput `pulumi:"userId"`
+
+	// APIKeyID is the ID of the subuser's API key
+	APIKeyID pulumi.StringOutput `pulumi:"apiKeyId"`
+
+	// APIKeyValue is the subuser's API key
+	APIKeyValue pulumi.StringOutput `pulumi:"apiKeyValue" provider:"secret"`
+}
+
+// SubuserOnboardingArgs are the inputs to the SubuserOnboarding component.
+type SubuserOnboardingArgs struct {
+	// Username is the username of the subuser (required)
+	Username pulumi.StringInput `pulumi:"username"`
+
+	// Email is the contact email of the subuser (required)
+	Email pulumi.StringInput `pulumi:"email"`
+
+	// Password is the password of the subuser (required)
+	Password pulumi.StringInput `pulumi:"password" provider:"secret"`
+
+	// Ips are the IP addresses assigned to the subuser (optional)
+	Ips pulumi.StringArrayInput `pulumi:"ips,optional"`
+
+	// Region is the data-residency region of the subuser: "global" or "eu" (optional)
+	Region pulumi.StringPtrInput `pulumi:"region,optional"`
+
+	// APIKeyName is the name of the subuser's API key (optional, generated when unset)
+	APIKeyName pulumi.StringPtrInput `pulumi:"apiKeyName,optional"`
+
+	// APIKeyScopes are the scopes of the subuser's API key (optional, defaults to full access)
+	APIKeyScopes pulumi.StringArrayInput `pulumi:"apiKeyScopes,optional"`
+
+	// DomainID is the ID of a domain authentication to associate with the subuser (optional)
+	DomainID pulumi.IntPtrInput `pulumi:"domainId,optional"`
+
+	// LinkID is the ID of a link branding to associate with the subuser (optional)
+	LinkID pulumi.IntPtrInput `pulumi:"linkId,optional"`
+
+	// DeletionProtection prevents deleting the subuser and its API key and associations (optional)
+	DeletionProtection pulumi.BoolPtrInput `pulumi:"deletionProtection,optional"`
+}
+
+// Annotate provides descriptions for the SubuserOnboarding component.
+func (s *SubuserOnboarding) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&s, "Provisions a SendGrid subuser for a tenant.\n\n"+
+		"The component creates the Subuser with its IPs, then an ApiKey in the subuser's account "+
+		"through a provider configured with onBehalfOf, and associates the given domain authentication "+
+		"and link branding with the subuser, in the order SendGrid requires. The on-behalf-of provider "+
+		"takes the settings of the provider constructing the component, such as its credentials, proxy, "+
+		"timeouts, retries and headers, except onBehalfOf, validateApiKey and requiredScopes.")
+}
+
+// onboardedSubuser holds the outputs of the Subuser registered by a SubuserOnboarding
+type onboardedSubuser struct {
+	pulumi.CustomResourceState
+
+	Username pulumi.StringOutput `pulumi:"username"`
+	UserID   pulumi.IntOutput    `pulumi:"userId"`
+}
+
+// onboardedAPIKey holds the outputs of the ApiKey registered by a SubuserOnboarding
+type onboardedAPIKey struct {
+	pulumi.CustomResourceState
+
+	APIKeyID    pulumi.StringOutput `pulumi:"apiKeyId"`
+	APIKeyValue pulumi.StringOutput `pulumi:"apiKeyValue"`
+}
+
+// onboardedAssociation is a domain or link branding association registered by a SubuserOnboarding
+type onboardedAssociation struct {
+	pulumi.CustomResourceState
+}
+
+// onBehalfOfProvider is the provider that manages the subuser's account for a SubuserOnboarding
+type onBehalfOfProvider struct {
+	pulumi.ProviderResourceState
+}
+
+// NewSubuserOnboarding registers a SubuserOnboarding and its children.
+func NewSubuserOnboarding(ctx *pulumi.Context, name string, args SubuserOnboardingArgs, opts ...pulumi.ResourceOption) (*SubuserOnboarding, error) {
+	comp := &SubuserOnboarding{}
+	if err := ctx.RegisterComponentResource(p.GetTypeToken(ctx), name, comp, opts...); err != nil {
+		return nil, err
+	}
+
+	subuserInputs := pulumi.Map{
+		"username": args.Username,
+		"email":    args.Email,
+		"password": args.Password,
+	}
+	setInput(subuserInputs, "ips", args.Ips)
+	setInput(subuserInputs, "region", args.Region)
+	setInput(subuserInputs, "deletionProtection", args.DeletionProtection)
+
+	var subuser onboardedSubuser
+	if err := ctx.RegisterResource("sendgrid:index:Subuser", name, subuserInputs, &subuser, pulumi.Parent(comp)); err != nil {
+		return nil, err
+	}
+
+	// The API key is created in the subuser's account, by a provider sending requests on its behalf
+	config := infer.GetConfig[Config](ctx.Context())
+	providerInputs := forwardedConfig(config)
+	providerInputs["onBehalfOf"] = subuser.Username
+	var subuserProvider onBehalfOfProvider
+	if err := ctx.RegisterResource("pulumi:providers:sendgrid", name, providerInputs, &subuserProvider, pulumi.Parent(comp)); err != nil {
+		return nil, err
+	}
+
+	keyInputs := pulumi.Map{}
+	setInput(keyInputs, "name", args.APIKeyName)
+	setInput(keyInputs, "scopes", args.APIKeyScopes)
+	setInput(keyInputs, "deletionProtection", args.DeletionProtection)
+	var key onboardedAPIKey
+	if err := ctx.RegisterResource("sendgrid:index:ApiKey", name, keyInputs, &key,
+		pulumi.Parent(comp), pulumi.Provider(&subuserProvider)); err != nil {
+		return nil, err
+	}
+
+	associations := []struct {
+		token, key string
+		id         pulumi.IntPtrInput
+	}{
+		{"sendgrid:index:SubuserDomainAssociation", "domainId", args.DomainID},
+		{"sendgrid:index:SubuserLinkAssociation", "linkId", args.LinkID},
+	}
+	for _, a := range associations {
+		if a.id == nil {
+			continue
+		}
+		inputs := pulumi.Map{a.key: a.id, "username": subuser.Username}
+		setInput(inputs, "deletionProtection", args.DeletionProtection)
+		var association onboardedAssociation
+		if err := ctx.RegisterResource(a.token, name, inputs, &association, pulumi.Parent(comp)); err != nil {
+			return nil, err
+		}
+	}
+
+	comp.Username = subuser.Username
+	comp.UserID = subuser.UserID
+	comp.APIKeyID = key.APIKeyID
+	comp.APIKeyValue = pulumi.ToSecret(key.APIKeyValue).(pulumi.StringOutput)
+	return comp, nil
+}
+
+// unforwardedConfig are the provider settings a nested provider does not take from its parent:
+// onBehalfOf, which the caller sets, and the checks of the parent key's identity and scopes,
+// as the nested provider acts as a subuser whose scopes are usually narrower
+var unforwardedConfig = []string{"onBehalfOf", "validateApiKey", "requiredScopes"}
+
+// forwardedConfig returns the settings of config as provider inputs, so that a nested provider
+// talks to SendGrid the same way its parent does, through the same proxy and with the same
+// timeouts, retries and headers. Secret settings stay secret.
+func forwardedConfig(config Config) pulumi.Map {
+	inputs := pulumi.Map{}
+	configValue := reflect.ValueOf(config)
+	configType := configValue.Type()
+	for i := range configType.NumField() {
+		field := configType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("pulumi"), ",")
+		if name == "" || name == "-" || slices.Contains(unforwardedConfig, name) {
+			continue
+		}
+
+		value := configValue.Field(i)
+		if isUnset(value) {
+			continue
+		}
+		if value.Kind() == reflect.Pointer {
+			value = value.Elem()
+		}
+		var input pulumi.Input = pulumi.Any(value.Interface())
+		if slices.Contains(strings.Split(field.Tag.Get("provider"), ","), "secret") {
+			input = pulumi.ToSecret(input)
+		}
+		inputs[name] = input
+	}
+	return inputs
+}
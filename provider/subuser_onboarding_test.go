@@ -0,0 +1,130 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestSubuserOnboarding_Construct(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	registered := map[string]integration.MockResourceArgs{}
+	monitor := &integration.MockResourceMonitor{
+		NewResourceF: func(args integration.MockResourceArgs) (string, property.Map, error) {
+			mu.Lock()
+			registered[string(args.TypeToken)] = args
+			mu.Unlock()
+
+			state := args.Inputs.AsMap()
+			switch args.TypeToken {
+			case "sendgrid:index:Subuser":
+				state["userId"] = property.New(3.0)
+				return "tenant-a", property.NewMap(state), nil
+			case "sendgrid:index:ApiKey":
+				state["apiKeyId"] = property.New("key-1")
+				state["apiKeyValue"] = property.New("SG.tenant-key")
+				return "key-1", property.NewMap(state), nil
+			}
+			return args.Name, property.NewMap(state), nil
+		},
+	}
+
+	// The parent key is checked for the scopes it requires
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/v3/scopes", req.URL.Path)
+		return fakeResponse(req, http.StatusOK, `{"scopes": ["subusers.create", "api_keys.create"]}`), nil
+	})
+	server := newTestServer(t, transport, map[string]property.Value{
+		"apiKey":                property.New("SG.parent"),
+		"region":                property.New("eu"),
+		"onBehalfOf":            property.New("reseller"),
+		"validateApiKey":        property.New(true),
+		"requiredScopes":        property.New([]property.Value{property.New("subusers.create")}),
+		"httpsProxy":            property.New("http://proxy.internal:3128"),
+		"requestTimeoutSeconds": property.New(45.0),
+		"maxRetries":            property.New(5.0),
+		"userAgentSuffix":       property.New("acme-ci"),
+		"headers": property.New(map[string]property.Value{
+			"X-Team": property.New("platform"),
+		}),
+	}, integration.WithMocks(monitor))
+
+	resp, err := server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("stack", "project", "", "sendgrid:index:SubuserOnboarding", "tenant-a"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"username":     property.New("tenant-a"),
+			"email":        property.New("tenant-a@example.com"),
+			"password":     property.New("hunter2").WithSecret(true),
+			"ips":          property.New([]property.Value{property.New("192.0.2.10")}),
+			"apiKeyScopes": property.New([]property.Value{property.New("mail.send")}),
+			"domainId":     property.New(11.0),
+		}),
+	})
+	require.NoError(t, err)
+
+	subuser := registered["sendgrid:index:Subuser"]
+	assert.Equal(t, "tenant-a", subuser.Inputs.Get("username").AsString())
+	assert.Equal(t, "192.0.2.10", subuser.Inputs.Get("ips").AsArray().Get(0).AsString())
+
+	// The API key is created through a provider acting on behalf of the subuser
+	provider := registered["pulumi:providers:sendgrid"]
+	assert.Equal(t, "tenant-a", provider.Inputs.Get("onBehalfOf").AsString())
+	assert.Equal(t, "SG.parent", provider.Inputs.Get("apiKey").AsString())
+	assert.True(t, provider.Inputs.Get("apiKey").Secret())
+	assert.Equal(t, "eu", provider.Inputs.Get("region").AsString())
+
+	// It talks to SendGrid the same way as the parent provider
+	assert.Equal(t, "http://proxy.internal:3128", provider.Inputs.Get("httpsProxy").AsString())
+	assert.True(t, provider.Inputs.Get("httpsProxy").Secret())
+	assert.Equal(t, 45.0, provider.Inputs.Get("requestTimeoutSeconds").AsNumber())
+	assert.Equal(t, 5.0, provider.Inputs.Get("maxRetries").AsNumber())
+	assert.Equal(t, "acme-ci", provider.Inputs.Get("userAgentSuffix").AsString())
+	assert.Equal(t, "platform", provider.Inputs.Get("headers").AsMap().Get("X-Team").AsString())
+	_, forwarded := provider.Inputs.GetOk("validateOnPreview")
+	assert.False(t, forwarded)
+
+	// The subuser's key is not held to the parent key's scopes
+	_, forwarded = provider.Inputs.GetOk("requiredScopes")
+	assert.False(t, forwarded)
+	_, forwarded = provider.Inputs.GetOk("validateApiKey")
+	assert.False(t, forwarded)
+
+	key := registered["sendgrid:index:ApiKey"]
+	assert.Contains(t, string(key.Provider.Urn), "pulumi:providers:sendgrid")
+	assert.Equal(t, "mail.send", key.Inputs.Get("scopes").AsArray().Get(0).AsString())
+
+	association := registered["sendgrid:index:SubuserDomainAssociation"]
+	assert.Equal(t, 11.0, association.Inputs.Get("domainId").AsNumber())
+	assert.Equal(t, "tenant-a", association.Inputs.Get("username").AsString())
+	assert.NotContains(t, registered, "sendgrid:index:SubuserLinkAssociation")
+
+	assert.Equal(t, "tenant-a", resp.State.Get("username").AsString())
+	assert.Equal(t, 3.0, resp.State.Get("userId").AsNumber())
+	assert.Equal(t, "key-1", resp.State.Get("apiKeyId").AsString())
+	assert.True(t, resp.State.Get("apiKeyValue").Secret())
+	assert.Equal(t, "SG.tenant-key", resp.State.Get("apiKeyValue").AsString())
+}
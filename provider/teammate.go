@@ -18,8 +18,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // Teammate is the controller for the SendGrid Teammate resource.
@@ -31,16 +34,20 @@ type Teammate struct{}
 // TeammateArgs are the inputs to the Teammate resource.
 type TeammateArgs struct {
 	// Email is the email address of the teammate to invite (required)
-	Email string `pulumi:"email"`
+	Email string `pulumi:"email" provider:"replaceOnChanges"`
 
-	// Scopes is the list of permissions for this teammate (optional)
+	// Scopes is the list of permissions for this teammate (required unless isAdmin is true)
 	// See https://docs.sendgrid.com/api-reference/how-to-use-the-sendgrid-v3-api/authorization
 	// for available scopes.
 	Scopes []string `pulumi:"scopes,optional"`
 
 	// IsAdmin indicates whether the teammate should have full admin access (optional)
-	// When true, the teammate has all permissions
+	// When true, the teammate has all permissions and scopes must be left empty
 	IsAdmin *bool `pulumi:"isAdmin,optional"`
+
+	// DeletionProtection prevents the teammate from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // TeammateState is the state of the Teammate resource.
@@ -68,7 +75,11 @@ type TeammateState struct {
 	UserType string `pulumi:"userType,optional"`
 
 	// Token is the invitation token (available for pending invitations)
-	Token string `pulumi:"token,optional"`
+	// It is secret, as anyone holding it can accept the invitation
+	Token string `pulumi:"token,optional" provider:"secret"`
+
+	// DeletionProtection prevents the teammate from being deleted while set to true
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // Annotate provides descriptions for the Teammate resource.
@@ -100,12 +111,78 @@ type teammateGetResponse struct {
 	IsAdmin   bool     `json:"is_admin"`
 }
 
+// teammatePendingResponse represents a pending teammate invitation
+type teammatePendingResponse struct {
+	Email   string   `json:"email"`
+	Scopes  []string `json:"scopes,omitempty"`
+	IsAdmin bool     `json:"is_admin"`
+	Token   string   `json:"token"`
+}
+
+// teammatePageSize is the page size used when listing teammates
+const teammatePageSize = 500
+
+// WireDependencies marks the invitation token secret, as it lets anyone accept the invitation.
+func (t *Teammate) WireDependencies(f infer.FieldSelector, _ *TeammateArgs, state *TeammateState) {
+	f.OutputField(&state.Token).AlwaysSecret()
+}
+
+// Check validates the Teammate inputs. With validateOnPreview, it also verifies
+// that the provider's API key holds the scopes the teammate should be granted.
+func (t *Teammate) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[TeammateArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[TeammateArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[TeammateArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	// Admins are granted every scope, so they list none
+	if client := previewValidationClient(ctx); client != nil && len(failures) == 0 &&
+		(inputs.IsAdmin == nil || !*inputs.IsAdmin) &&
+		inputsKnown(req.NewInputs, "scopes", "isAdmin") && inputsChanged(req, "scopes", "isAdmin") {
+		failures = append(failures, checkGrantableScopes(ctx, client, inputs.Scopes)...)
+	}
+
+	return infer.CheckResponse[TeammateArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid TeammateArgs
+func (args *TeammateArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.email("email", args.Email)
+	// SendGrid rejects scopes for admins, who hold every scope, and requires them otherwise
+	if v.known("scopes") && v.known("isAdmin") {
+		isAdmin := args.IsAdmin != nil && *args.IsAdmin
+		switch {
+		case isAdmin && len(args.Scopes) > 0:
+			v.fail("scopes", "must not be set when isAdmin is true, as admins are granted every scope")
+		case !isAdmin && len(args.Scopes) == 0:
+			v.fail("scopes", "must list at least one scope unless isAdmin is true")
+		}
+	}
+	return v.failures
+}
+
+// Diff compares the Teammate inputs with its state, which always records isAdmin.
+func (t *Teammate) Diff(_ context.Context, req infer.DiffRequest[TeammateArgs, TeammateState]) (p.DiffResponse, error) {
+	olds := TeammateArgs{
+		Email:              req.State.Email,
+		Scopes:             req.State.Scopes,
+		IsAdmin:            &req.State.IsAdmin,
+		DeletionProtection: req.State.DeletionProtection,
+	}
+	normalizeArgs(&olds, req.Inputs, nil)
+	return diffArgs(olds, req.Inputs), nil
+}
+
 // Create creates a new SendGrid Teammate (sends invitation).
 func (t *Teammate) Create(ctx context.Context, req infer.CreateRequest[TeammateArgs]) (infer.CreateResponse[TeammateState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		isAdmin := false
 		if input.IsAdmin != nil {
@@ -115,10 +192,10 @@ func (t *Teammate) Create(ctx context.Context, req infer.CreateRequest[TeammateA
 			Email:   input.Email,
 			Scopes:  input.Scopes,
 			IsAdmin: isAdmin,
-			Token:   "[computed]",
+
+			DeletionProtection: input.DeletionProtection,
 		}
 		return infer.CreateResponse[TeammateState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -149,9 +226,11 @@ func (t *Teammate) Create(ctx context.Context, req infer.CreateRequest[TeammateA
 
 	state := TeammateState{
 		Email:   result.Email,
-		Scopes:  result.Scopes,
+		Scopes:  trackedScopes(input.Scopes, result.Scopes, result.IsAdmin),
 		IsAdmin: result.IsAdmin,
 		Token:   result.Token,
+
+		DeletionProtection: input.DeletionProtection,
 	}
 
 	// Use email as the resource ID since username isn't assigned until invite is accepted
@@ -163,7 +242,7 @@ func (t *Teammate) Create(ctx context.Context, req infer.CreateRequest[TeammateA
 
 // Read retrieves the current state of a SendGrid Teammate.
 func (t *Teammate) Read(ctx context.Context, req infer.ReadRequest[TeammateArgs, TeammateState]) (infer.ReadResponse[TeammateArgs, TeammateState], error) {
-	id := req.ID // id is the email
+	id := req.ID // id is the email, or the username when imported by username
 	oldState := req.State
 
 	// Get the SendGrid client from context
@@ -172,9 +251,14 @@ func (t *Teammate) Read(ctx context.Context, req infer.ReadRequest[TeammateArgs,
 		return infer.ReadResponse[TeammateArgs, TeammateState]{}, fmt.Errorf("SendGrid client not configured")
 	}
 
-	// First try to find the teammate by username if we have one
-	if oldState.Username != "" {
-		encodedUsername := url.PathEscape(oldState.Username)
+	// First try to find the teammate by username if we have one.
+	// Email addresses always contain "@", so any other ID is a username.
+	username := oldState.Username
+	if username == "" && !strings.Contains(id, "@") {
+		username = id
+	}
+	if username != "" {
+		encodedUsername := url.PathEscape(username)
 		var result teammateGetResponse
 		if err := client.Get(ctx, fmt.Sprintf("/v3/teammates/%s", encodedUsername), &result); err != nil {
 			if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
@@ -186,18 +270,22 @@ func (t *Teammate) Read(ctx context.Context, req infer.ReadRequest[TeammateArgs,
 
 		state := TeammateState{
 			Email:     result.Email,
-			Scopes:    result.Scopes,
+			Scopes:    trackedScopes(req.Inputs.Scopes, result.Scopes, result.IsAdmin),
 			IsAdmin:   result.IsAdmin,
 			Username:  result.Username,
 			FirstName: result.FirstName,
 			LastName:  result.LastName,
 			UserType:  result.UserType,
+
+			DeletionProtection: req.Inputs.DeletionProtection,
 		}
 
 		inputs := TeammateArgs{
 			Email:   result.Email,
-			Scopes:  result.Scopes,
+			Scopes:  trackedScopes(req.Inputs.Scopes, result.Scopes, result.IsAdmin),
 			IsAdmin: &result.IsAdmin,
+
+			DeletionProtection: req.Inputs.DeletionProtection,
 		}
 
 		return infer.ReadResponse[TeammateArgs, TeammateState]{
@@ -209,34 +297,29 @@ func (t *Teammate) Read(ctx context.Context, req infer.ReadRequest[TeammateArgs,
 
 	// If no username, check pending invitations
 	// The API returns {"result": [...]} not a bare array
-	var pendingWrapper struct {
-		Result []struct {
-			Email   string   `json:"email"`
-			Scopes  []string `json:"scopes,omitempty"`
-			IsAdmin bool     `json:"is_admin"`
-			Token   string   `json:"token"`
-		} `json:"result"`
-	}
-
-	if err := client.Get(ctx, "/v3/teammates/pending", &pendingWrapper); err != nil {
+	pendingList, err := GetCachedPages[teammatePendingResponse](ctx, client, "/v3/teammates/pending", PageOptions{})
+	if err != nil {
 		return infer.ReadResponse[TeammateArgs, TeammateState]{}, fmt.Errorf("failed to read pending teammates: %w", err)
 	}
-	pendingList := pendingWrapper.Result
 
 	// Look for the pending invitation by email
 	for _, pending := range pendingList {
 		if pending.Email == id {
 			state := TeammateState{
 				Email:   pending.Email,
-				Scopes:  pending.Scopes,
+				Scopes:  trackedScopes(req.Inputs.Scopes, pending.Scopes, pending.IsAdmin),
 				IsAdmin: pending.IsAdmin,
 				Token:   pending.Token,
+
+				DeletionProtection: req.Inputs.DeletionProtection,
 			}
 
 			inputs := TeammateArgs{
 				Email:   pending.Email,
-				Scopes:  pending.Scopes,
+				Scopes:  trackedScopes(req.Inputs.Scopes, pending.Scopes, pending.IsAdmin),
 				IsAdmin: &pending.IsAdmin,
+
+				DeletionProtection: req.Inputs.DeletionProtection,
 			}
 
 			return infer.ReadResponse[TeammateArgs, TeammateState]{
@@ -249,30 +332,31 @@ func (t *Teammate) Read(ctx context.Context, req infer.ReadRequest[TeammateArgs,
 
 	// Also check active teammates by listing all
 	// The API returns {"result": [...]} not a bare array
-	var teammatesWrapper struct {
-		Result []teammateGetResponse `json:"result"`
-	}
-	if err := client.Get(ctx, "/v3/teammates", &teammatesWrapper); err != nil {
+	teammatesList, err := GetCachedPages[teammateGetResponse](ctx, client, "/v3/teammates", PageOptions{Style: PaginateOffset, PageSize: teammatePageSize})
+	if err != nil {
 		return infer.ReadResponse[TeammateArgs, TeammateState]{}, fmt.Errorf("failed to list teammates: %w", err)
 	}
-	teammatesList := teammatesWrapper.Result
 
 	for _, teammate := range teammatesList {
 		if teammate.Email == id {
 			state := TeammateState{
 				Email:     teammate.Email,
-				Scopes:    teammate.Scopes,
+				Scopes:    trackedScopes(req.Inputs.Scopes, teammate.Scopes, teammate.IsAdmin),
 				IsAdmin:   teammate.IsAdmin,
 				Username:  teammate.Username,
 				FirstName: teammate.FirstName,
 				LastName:  teammate.LastName,
 				UserType:  teammate.UserType,
+
+				DeletionProtection: req.Inputs.DeletionProtection,
 			}
 
 			inputs := TeammateArgs{
 				Email:   teammate.Email,
-				Scopes:  teammate.Scopes,
+				Scopes:  trackedScopes(req.Inputs.Scopes, teammate.Scopes, teammate.IsAdmin),
 				IsAdmin: &teammate.IsAdmin,
+
+				DeletionProtection: req.Inputs.DeletionProtection,
 			}
 
 			return infer.ReadResponse[TeammateArgs, TeammateState]{
@@ -308,6 +392,8 @@ func (t *Teammate) Update(ctx context.Context, req infer.UpdateRequest[TeammateA
 			LastName:  oldState.LastName,
 			UserType:  oldState.UserType,
 			Token:     oldState.Token,
+
+			DeletionProtection: input.DeletionProtection,
 		}
 		return infer.UpdateResponse[TeammateState]{Output: state}, nil
 	}
@@ -321,7 +407,9 @@ func (t *Teammate) Update(ctx context.Context, req infer.UpdateRequest[TeammateA
 	// Can only update scopes if the teammate has accepted the invitation
 	if oldState.Username == "" {
 		// For pending invitations, we can't update - return current state
-		return infer.UpdateResponse[TeammateState]{Output: oldState}, nil
+		state := oldState
+		state.DeletionProtection = input.DeletionProtection
+		return infer.UpdateResponse[TeammateState]{Output: state}, nil
 	}
 
 	// Update teammate scopes
@@ -342,12 +430,14 @@ func (t *Teammate) Update(ctx context.Context, req infer.UpdateRequest[TeammateA
 
 	state := TeammateState{
 		Email:     result.Email,
-		Scopes:    result.Scopes,
+		Scopes:    trackedScopes(input.Scopes, result.Scopes, result.IsAdmin),
 		IsAdmin:   result.IsAdmin,
 		Username:  result.Username,
 		FirstName: result.FirstName,
 		LastName:  result.LastName,
 		UserType:  result.UserType,
+
+		DeletionProtection: input.DeletionProtection,
 	}
 
 	return infer.UpdateResponse[TeammateState]{Output: state}, nil
@@ -357,6 +447,10 @@ func (t *Teammate) Update(ctx context.Context, req infer.UpdateRequest[TeammateA
 func (t *Teammate) Delete(ctx context.Context, req infer.DeleteRequest[TeammateState]) (infer.DeleteResponse, error) {
 	state := req.State
 
+	if err := checkDeletionProtection(state.DeletionProtection, "teammate", req.ID); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
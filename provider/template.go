@@ -15,10 +15,16 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // Template is the controller for the SendGrid Template resource.
@@ -41,14 +47,22 @@ const (
 
 // TemplateArgs are the inputs to the Template resource.
 type TemplateArgs struct {
-	// Name is the name of the template (required, max 100 characters)
-	Name string `pulumi:"name"`
+	// Name is the name of the template (optional, max 100 characters, defaults to an autoname from the resource name)
+	Name string `pulumi:"name,optional"`
 
 	// Generation is the type of template: "legacy" or "dynamic" (required)
 	// - "legacy": Supports plain text and HTML content
 	// - "dynamic": Supports handlebars syntax for dynamic content
 	// Once set, this cannot be changed.
-	Generation TemplateGeneration `pulumi:"generation"`
+	Generation TemplateGeneration `pulumi:"generation" provider:"replaceOnChanges"`
+
+	// DeletionProtection prevents the template from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+
+	// ForceDelete deletes the template's versions along with it (optional, defaults to false).
+	// Otherwise deleting a template that still has versions fails.
+	ForceDelete *bool `pulumi:"forceDelete,optional"`
 }
 
 // TemplateVersionSummary represents a summary of a template version (read-only).
@@ -86,6 +100,27 @@ type TemplateState struct {
 	Versions []TemplateVersionSummary `pulumi:"versions,optional"`
 }
 
+// templatePageSize is the largest page GET /v3/templates returns
+const templatePageSize = 200
+
+// templateAPIResponse is a template as returned by GET /v3/templates and GET /v3/templates/{id}
+type templateAPIResponse struct {
+	ID         string               `json:"id"`
+	Name       string               `json:"name"`
+	Generation string               `json:"generation"`
+	UpdatedAt  string               `json:"updated_at"`
+	Versions   []templateAPIVersion `json:"versions"`
+}
+
+// templateAPIVersion is a version summary in a templateAPIResponse
+type templateAPIVersion struct {
+	ID         string `json:"id"`
+	TemplateID string `json:"template_id"`
+	Name       string `json:"name"`
+	Active     int    `json:"active"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
 // Annotate provides descriptions and default values for the Template resource.
 func (t *Template) Annotate(annotator infer.Annotator) {
 	annotator.Describe(&t, "Manages a SendGrid Transactional Template.\n\n"+
@@ -93,7 +128,36 @@ func (t *Template) Annotate(annotator infer.Annotator) {
 		"for transactional emails like receipts, password resets, etc.\n\n"+
 		"Templates can be either 'legacy' (plain text/HTML) or 'dynamic' "+
 		"(supporting handlebars syntax for personalization).\n\n"+
-		"**Note:** Template versions are managed separately via the TemplateVersion resource.")
+		"**Note:** Template versions are managed separately via the TemplateVersion resource. Deleting a "+
+		"template that still has versions, e.g. ones created outside of Pulumi, fails unless forceDelete is set.")
+}
+
+// Check validates the Template inputs.
+func (t *Template) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[TemplateArgs], error) {
+	newInputs, err := autoname(req, "name", 100)
+	if err != nil {
+		return infer.CheckResponse[TemplateArgs]{}, err
+	}
+	req.NewInputs = newInputs
+
+	inputs, failures, err := infer.DefaultCheck[TemplateArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[TemplateArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[TemplateArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid TemplateArgs
+func (args *TemplateArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("name", args.Name)
+	v.maxLength("name", args.Name, 100)
+	v.oneOf("generation", string(args.Generation), string(TemplateGenerationLegacy), string(TemplateGenerationDynamic))
+	return v.failures
 }
 
 // Create creates a new SendGrid Template.
@@ -101,16 +165,13 @@ func (t *Template) Create(ctx context.Context, req infer.CreateRequest[TemplateA
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := TemplateState{
 			TemplateArgs: input,
-			TemplateID:   "[computed]",
-			UpdatedAt:    "[computed]",
 			Versions:     []TemplateVersionSummary{},
 		}
 		return infer.CreateResponse[TemplateState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -168,6 +229,9 @@ func (t *Template) Create(ctx context.Context, req infer.CreateRequest[TemplateA
 		Versions:   versions,
 	}
 
+	state.DeletionProtection = input.DeletionProtection
+	state.ForceDelete = input.ForceDelete
+
 	return infer.CreateResponse[TemplateState]{
 		ID:     result.ID,
 		Output: state,
@@ -184,28 +248,23 @@ func (t *Template) Read(ctx context.Context, req infer.ReadRequest[TemplateArgs,
 		return infer.ReadResponse[TemplateArgs, TemplateState]{}, fmt.Errorf("SendGrid client not configured")
 	}
 
-	// Make the API call to get the template details
-	var result struct {
-		ID         string `json:"id"`
-		Name       string `json:"name"`
-		Generation string `json:"generation"`
-		UpdatedAt  string `json:"updated_at"`
-		Versions   []struct {
-			ID         string `json:"id"`
-			TemplateID string `json:"template_id"`
-			Name       string `json:"name"`
-			Active     int    `json:"active"`
-			UpdatedAt  string `json:"updated_at"`
-		} `json:"versions"`
-	}
-
-	if err := client.Get(ctx, fmt.Sprintf("/v3/templates/%s", id), &result); err != nil {
-		// Check if the resource was deleted out-of-band
-		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
-			// Return empty response to indicate resource no longer exists
-			return infer.ReadResponse[TemplateArgs, TemplateState]{}, nil
+	// Serve the template from the cached list of all templates when available,
+	// and fall back to reading it individually otherwise
+	result := findCached(ctx, client, "/v3/templates", PageOptions{
+		Style:    PaginateToken,
+		PageSize: templatePageSize,
+		Query:    url.Values{"generations": {"legacy,dynamic"}},
+	}, func(t *templateAPIResponse) bool { return t.ID == id })
+	if result == nil {
+		result = &templateAPIResponse{}
+		if err := client.Get(ctx, fmt.Sprintf("/v3/templates/%s", url.PathEscape(id)), result); err != nil {
+			// Check if the resource was deleted out-of-band
+			if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+				// Return empty response to indicate resource no longer exists
+				return infer.ReadResponse[TemplateArgs, TemplateState]{}, nil
+			}
+			return infer.ReadResponse[TemplateArgs, TemplateState]{}, fmt.Errorf("failed to read template: %w", err)
 		}
-		return infer.ReadResponse[TemplateArgs, TemplateState]{}, fmt.Errorf("failed to read template: %w", err)
 	}
 
 	// Convert versions to summary format
@@ -236,6 +295,11 @@ func (t *Template) Read(ctx context.Context, req infer.ReadRequest[TemplateArgs,
 		Generation: TemplateGeneration(result.Generation),
 	}
 
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	inputs.DeletionProtection = req.Inputs.DeletionProtection
+	state.ForceDelete = req.Inputs.ForceDelete
+	inputs.ForceDelete = req.Inputs.ForceDelete
+
 	return infer.ReadResponse[TemplateArgs, TemplateState]{
 		ID:     id,
 		Inputs: inputs,
@@ -287,7 +351,7 @@ func (t *Template) Update(ctx context.Context, req infer.UpdateRequest[TemplateA
 		} `json:"versions"`
 	}
 
-	if err := client.Patch(ctx, fmt.Sprintf("/v3/templates/%s", id), reqBody, &result); err != nil {
+	if err := client.Patch(ctx, fmt.Sprintf("/v3/templates/%s", url.PathEscape(id)), reqBody, &result); err != nil {
 		return infer.UpdateResponse[TemplateState]{}, fmt.Errorf("failed to update template: %w", err)
 	}
 
@@ -313,6 +377,9 @@ func (t *Template) Update(ctx context.Context, req infer.UpdateRequest[TemplateA
 		Versions:   versions,
 	}
 
+	state.DeletionProtection = input.DeletionProtection
+	state.ForceDelete = input.ForceDelete
+
 	return infer.UpdateResponse[TemplateState]{Output: state}, nil
 }
 
@@ -320,20 +387,67 @@ func (t *Template) Update(ctx context.Context, req infer.UpdateRequest[TemplateA
 func (t *Template) Delete(ctx context.Context, req infer.DeleteRequest[TemplateState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "template", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
 		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
 	}
 
-	// Make the API call
-	if err := client.Delete(ctx, fmt.Sprintf("/v3/templates/%s", id)); err != nil {
-		// If already deleted, that's fine
+	// SendGrid refuses to delete a template with an active version, so versions go first
+	force := req.State.ForceDelete != nil && *req.State.ForceDelete
+	if err := deleteTemplateVersions(ctx, client, id, force); err != nil {
 		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
 			return infer.DeleteResponse{}, nil
 		}
+		return infer.DeleteResponse{}, err
+	}
+
+	// Make the API call. A version created in the meantime blocks it, so versions are checked again.
+	err := deleteUnblocking(ctx, client, "template "+id, fmt.Sprintf("/v3/templates/%s", url.PathEscape(id)), blockedDeleteInterval,
+		func(ctx context.Context) error { return deleteTemplateVersions(ctx, client, id, force) })
+	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("failed to delete template: %w", err)
 	}
 
 	return infer.DeleteResponse{}, nil
 }
+
+// deleteTemplateVersions deletes the versions of a template, the active one last,
+// or fails with guidance when the template has versions and force is not set
+func deleteTemplateVersions(ctx context.Context, client SendGridAPI, templateID string, force bool) error {
+	// GET /v3/templates/{template_id}
+	var result templateAPIResponse
+	if err := client.Get(ctx, fmt.Sprintf("/v3/templates/%s", url.PathEscape(templateID)), &result); err != nil {
+		return err
+	}
+	if len(result.Versions) == 0 {
+		return nil
+	}
+	if !force {
+		names := make([]string, len(result.Versions))
+		for i, v := range result.Versions {
+			names[i] = fmt.Sprintf("%q (%s)", v.Name, v.ID)
+		}
+		return fmt.Errorf("template %s still has %d version(s): %s; delete them first, "+
+			"or set forceDelete to true to delete them with the template", templateID, len(names), strings.Join(names, ", "))
+	}
+
+	versions := slices.Clone(result.Versions)
+	slices.SortStableFunc(versions, func(a, b templateAPIVersion) int { return cmp.Compare(a.Active, b.Active) })
+	for _, v := range versions {
+		// DELETE /v3/templates/{template_id}/versions/{version_id}
+		path := fmt.Sprintf("/v3/templates/%s/versions/%s", url.PathEscape(templateID), url.PathEscape(v.ID))
+		if err := client.Delete(ctx, path); err != nil {
+			if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+				continue
+			}
+			return fmt.Errorf("failed to delete version %s of template %s: %w", v.ID, templateID, err)
+		}
+		p.GetLogger(ctx).Infof("Deleted version %q (%s) of template %s", v.Name, v.ID, templateID)
+	}
+	return nil
+}
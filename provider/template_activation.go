@@ -0,0 +1,113 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// templateActivationLocks holds a mutex per template ID while versions of the template
+// are being activated. Activating a version implicitly deactivates the template's active
+// one, so versions of the same template are activated one at a time to know which
+// version each replaced. Each provider instance has its own locks.
+type templateActivationLocks struct {
+	mu    sync.Mutex
+	locks map[string]*templateActivationLock
+}
+
+// templateActivationLock is the mutex of a template, with the number of activations
+// holding or waiting for it
+type templateActivationLock struct {
+	sync.Mutex
+	users int
+}
+
+// lock serializes version activations of a template and returns the function that
+// releases the lock. The template's mutex is dropped once no activation needs it.
+func (l *templateActivationLocks) lock(templateID string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*templateActivationLock{}
+	}
+	lock, ok := l.locks[templateID]
+	if !ok {
+		lock = &templateActivationLock{}
+		l.locks[templateID] = lock
+	}
+	lock.users++
+	l.mu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if lock.users--; lock.users == 0 {
+			delete(l.locks, templateID)
+		}
+	}
+}
+
+// isActive reports whether an active input or state value marks the version active
+func isActive(active *int) bool {
+	return active != nil && *active == 1
+}
+
+// activeTemplateVersion returns the ID of the active version of a template, or ""
+// when it has none
+func activeTemplateVersion(ctx context.Context, client SendGridAPI, templateID string) (string, error) {
+	// GET /v3/templates/{template_id}
+	var result templateAPIResponse
+	if err := client.Get(ctx, fmt.Sprintf("/v3/templates/%s", url.PathEscape(templateID)), &result); err != nil {
+		return "", fmt.Errorf("failed to read template %s: %w", templateID, err)
+	}
+	for _, v := range result.Versions {
+		if v.Active == 1 {
+			return v.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// activateTemplateVersion runs write, a request that activates a version of the
+// template, while no other version of it is being activated, and logs the version
+// it deactivates. write returns the ID of the activated version.
+func activateTemplateVersion(ctx context.Context, client SendGridAPI, locks *templateActivationLocks, templateID string,
+	write func() (string, error),
+) error {
+	unlock := locks.lock(templateID)
+	defer unlock()
+
+	previous, err := activeTemplateVersion(ctx, client, templateID)
+	if err != nil {
+		// Only the log message needs the previous version
+		p.GetLogger(ctx).Debugf("Could not look up the active version of template %s: %v", templateID, err)
+	}
+
+	activated, err := write()
+	if err != nil {
+		return err
+	}
+	if previous != "" && previous != activated {
+		p.GetLogger(ctx).Infof("Activated version %s of template %s, which deactivates version %s", activated, templateID, previous)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// templateActivationServer starts a provider whose SendGrid API is faked by handle
+func templateActivationServer(t *testing.T, handle func(req *http.Request) *http.Response) integration.Server {
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return handle(req), nil
+	})
+
+	return newTestServer(t, transport, nil)
+}
+
+func TestTemplateVersion_ActivationsAreSerialized(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	active, inFlight, created := "v-0", 0, 0
+	server := templateActivationServer(t, func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v3/templates/d-serial":
+			// An activation reads the active version, then activates its own
+			inFlight++
+			assert.Equal(t, 1, inFlight, "activations of one template overlap")
+			return fakeResponse(req, http.StatusOK, fmt.Sprintf(`{"id": "d-serial", "versions": [{"id": %q, "active": 1}]}`, active))
+		case "POST /v3/templates/d-serial/versions":
+			inFlight--
+			created++
+			active = fmt.Sprintf("v-%d", created)
+			return fakeResponse(req, http.StatusCreated, fmt.Sprintf(`{"id": %q, "template_id": "d-serial", "name": "v", "active": 1}`, active))
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := server.Create(p.CreateRequest{
+				Urn: previewURN("TemplateVersion", fmt.Sprintf("v%d", i)),
+				Properties: property.NewMap(map[string]property.Value{
+					"templateId": property.New("d-serial"),
+					"name":       property.New("v"),
+					"active":     property.New(1.0),
+				}),
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 5, created)
+}
+
+func TestTemplateVersion_ImplicitDeactivation(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var patches []map[string]any
+	server := templateActivationServer(t, func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v3/templates/d-tmpl/versions/v-old":
+			return fakeResponse(req, http.StatusOK, `{"id": "v-old", "template_id": "d-tmpl", "name": "old", "active": 0,`+
+				` "html_content": "<p>old</p>", "editor": "code", "generate_plain_content": true}`)
+		case "GET /v3/templates/d-tmpl":
+			return fakeResponse(req, http.StatusOK, `{"id": "d-tmpl", "versions": [{"id": "v-old", "active": 0}, {"id": "v-new", "active": 1}]}`)
+		case "PATCH /v3/templates/d-tmpl/versions/v-old":
+			var body map[string]any
+			data, _ := io.ReadAll(req.Body)
+			require.NoError(t, json.Unmarshal(data, &body))
+			patches = append(patches, body)
+			active := 0
+			if body["active"] == 1.0 {
+				active = 1
+			}
+			return fakeResponse(req, http.StatusOK, fmt.Sprintf(`{"id": "v-old", "template_id": "d-tmpl", "name": "old", "active": %d,`+
+				` "html_content": %q, "editor": "code", "generate_plain_content": true}`, active, body["html_content"]))
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`)
+	})
+
+	urn := previewURN("TemplateVersion", "old")
+	inputs := func(active float64, html string) property.Map {
+		return property.NewMap(map[string]property.Value{
+			"templateId":  property.New("d-tmpl"),
+			"name":        property.New("old"),
+			"htmlContent": property.New(html),
+			"active":      property.New(active),
+		})
+	}
+
+	// Refresh finds the version deactivated by the activation of v-new
+	read, err := server.Read(p.ReadRequest{
+		ID:         "v-old",
+		Urn:        urn,
+		Inputs:     inputs(1, "<p>old</p>"),
+		Properties: inputs(1, "<p>old</p>").Set("versionId", property.New("v-old")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, read.Properties.Get("active").AsNumber())
+	assert.Equal(t, "v-new", read.Properties.Get("deactivatedBy").AsString())
+	assert.Equal(t, 1.0, read.Inputs.Get("active").AsNumber())
+
+	// Keeping active set is not a change, so the version does not take over again
+	diff, err := server.Diff(p.DiffRequest{ID: "v-old", Urn: urn, State: read.Properties, Inputs: inputs(1, "<p>old</p>")})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges)
+
+	// Other changes leave it inactive
+	updated, err := server.Update(p.UpdateRequest{ID: "v-old", Urn: urn, State: read.Properties, Inputs: inputs(1, "<p>edited</p>")})
+	require.NoError(t, err)
+	assert.Equal(t, "v-new", updated.Properties.Get("deactivatedBy").AsString())
+	require.Len(t, patches, 1)
+	assert.NotContains(t, patches[0], "active")
+
+	// Setting active to 0 clears the record, so setting it to 1 later activates the version again
+	diff, err = server.Diff(p.DiffRequest{ID: "v-old", Urn: urn, State: updated.Properties, Inputs: inputs(0, "<p>edited</p>")})
+	require.NoError(t, err)
+	assert.Contains(t, diff.DetailedDiff, "active")
+	cleared, err := server.Update(p.UpdateRequest{ID: "v-old", Urn: urn, State: updated.Properties, Inputs: inputs(0, "<p>edited</p>")})
+	require.NoError(t, err)
+	assert.True(t, cleared.Properties.Get("deactivatedBy").IsNull())
+	require.Len(t, patches, 2)
+	assert.Equal(t, 0.0, patches[1]["active"])
+}
+
+func TestTemplateActivationLocks(t *testing.T) {
+	t.Parallel()
+
+	var locks templateActivationLocks
+	unlock := locks.lock("d-1")
+
+	acquired := make(chan func())
+	go func() { acquired <- locks.lock("d-1") }()
+	unlockOther := locks.lock("d-2")
+	unlockOther()
+
+	select {
+	case <-acquired:
+		t.Fatal("a second activation of the template did not wait")
+	default:
+	}
+	unlock()
+	(<-acquired)()
+
+	// Templates are forgotten once no activation needs them
+	assert.Empty(t, locks.locks)
+}
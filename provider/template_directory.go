@@ -0,0 +1,166 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// TemplateDirectory is a component that syncs a directory of HTML files to versions
+// of a template, one TemplateVersion per file, with the newest file active.
+type TemplateDirectory struct {
+	pulumi.ResourceState
+
+	// VersionIDs maps each version name, the file name without its extension, to its version ID
+	VersionIDs pulumi.StringMapOutput `pulumi:"versionIds"`
+
+	// ContentHashes maps each version name to the SHA-256 hash of its file, hex encoded
+	ContentHashes pulumi.StringMapOutput `pulumi:"contentHashes"`
+
+	// ActiveVersionID is the ID of the version created from the newest file
+	ActiveVersionID pulumi.StringOutput `pulumi:"activeVersionId"`
+}
+
+// TemplateDirectoryArgs are the inputs to the TemplateDirectory component.
+type TemplateDirectoryArgs struct {
+	// TemplateID is the ID of the template the versions belong to (required)
+	TemplateID pulumi.StringInput `pulumi:"templateId"`
+
+	// Directory is the directory holding the HTML files, relative to the Pulumi project (required)
+	Directory string `pulumi:"directory"`
+
+	// Subject is the subject line of every version (optional)
+	Subject pulumi.StringPtrInput `pulumi:"subject,optional"`
+
+	// GeneratePlainContent generates the plain text content of every version from its HTML (optional)
+	GeneratePlainContent pulumi.BoolPtrInput `pulumi:"generatePlainContent,optional"`
+
+	// DeletionProtection prevents deleting the versions (optional)
+	DeletionProtection pulumi.BoolPtrInput `pulumi:"deletionProtection,optional"`
+}
+
+// Annotate provides descriptions for the TemplateDirectory component.
+func (t *TemplateDirectory) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&t, "Syncs a directory of HTML files to versions of a SendGrid template.\n\n"+
+		"Each .html file in the directory becomes a TemplateVersion named after the file without its "+
+		"extension. Files are ordered by name, and the last one is the newest and is made the active "+
+		"version, so names should sort by age, e.g. with a date or version prefix. A version is only "+
+		"updated when the content of its file changes; adding a file adds a version and removing one "+
+		"deletes its version.")
+}
+
+// templateDirectoryFile is an HTML file of a TemplateDirectory
+type templateDirectoryFile struct {
+	name    string
+	content string
+	hash    string
+}
+
+// readTemplateDirectory returns the HTML files of dir ordered by name
+func readTemplateDirectory(dir string) ([]templateDirectoryFile, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template files in %s: %w", dir, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no .html files found in %s", dir)
+	}
+	sort.Strings(paths)
+
+	files := make([]templateDirectoryFile, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template file: %w", err)
+		}
+		sum := sha256.Sum256(data)
+		files = append(files, templateDirectoryFile{
+			name:    strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+			content: string(data),
+			hash:    hex.EncodeToString(sum[:]),
+		})
+	}
+	return files, nil
+}
+
+// templateDirectoryVersion holds the outputs of a TemplateVersion registered by a TemplateDirectory
+type templateDirectoryVersion struct {
+	pulumi.CustomResourceState
+
+	VersionID pulumi.StringOutput `pulumi:"versionId"`
+}
+
+// NewTemplateDirectory registers a TemplateDirectory and a TemplateVersion for each of its files.
+func NewTemplateDirectory(ctx *pulumi.Context, name string, args TemplateDirectoryArgs, opts ...pulumi.ResourceOption) (*TemplateDirectory, error) {
+	files, err := readTemplateDirectory(args.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &TemplateDirectory{}
+	if err := ctx.RegisterComponentResource(p.GetTypeToken(ctx), name, comp, opts...); err != nil {
+		return nil, err
+	}
+
+	versionIDs := pulumi.StringMap{}
+	hashes := pulumi.StringMap{}
+	var older []pulumi.Resource
+	for i, file := range files {
+		newest := i == len(files)-1
+		inputs := pulumi.Map{
+			"templateId":  args.TemplateID,
+			"name":        pulumi.String(file.name),
+			"htmlContent": pulumi.String(file.content),
+			"active":      pulumi.Int(0),
+		}
+		if newest {
+			inputs["active"] = pulumi.Int(1)
+		}
+		setInput(inputs, "subject", args.Subject)
+		setInput(inputs, "generatePlainContent", args.GeneratePlainContent)
+		setInput(inputs, "deletionProtection", args.DeletionProtection)
+
+		// The newest version is activated last, so activating an older one cannot override it
+		versionOpts := []pulumi.ResourceOption{pulumi.Parent(comp)}
+		if newest {
+			versionOpts = append(versionOpts, pulumi.DependsOn(older))
+		}
+		var version templateDirectoryVersion
+		if err := ctx.RegisterResource("sendgrid:index:TemplateVersion", name+"-"+file.name, inputs, &version, versionOpts...); err != nil {
+			return nil, err
+		}
+		older = append(older, &version)
+
+		versionIDs[file.name] = version.VersionID
+		hashes[file.name] = pulumi.String(file.hash)
+		if newest {
+			comp.ActiveVersionID = version.VersionID
+		}
+	}
+
+	comp.VersionIDs = versionIDs.ToStringMapOutput()
+	comp.ContentHashes = hashes.ToStringMapOutput()
+	return comp, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestTemplateDirectory_Construct(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2024-06-welcome.html"), []byte("<p>Welcome back</p>"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2024-01-welcome.html"), []byte("<p>Welcome</p>"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a template"), 0o600))
+
+	var mu sync.Mutex
+	registered := map[string]integration.MockResourceArgs{}
+	monitor := &integration.MockResourceMonitor{
+		NewResourceF: func(args integration.MockResourceArgs) (string, property.Map, error) {
+			if args.TypeToken != "sendgrid:index:TemplateVersion" {
+				return args.Name, property.Map{}, nil
+			}
+			mu.Lock()
+			registered[args.Name] = args
+			mu.Unlock()
+
+			state := args.Inputs.AsMap()
+			state["versionId"] = property.New("v-" + args.Inputs.Get("name").AsString())
+			return "v-" + args.Inputs.Get("name").AsString(), property.NewMap(state), nil
+		},
+	}
+	server := newTestServer(t, nil, nil, integration.WithMocks(monitor))
+
+	resp, err := server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("stack", "project", "", "sendgrid:index:TemplateDirectory", "welcome"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"templateId": property.New("d-123"),
+			"directory":  property.New(dir),
+			"subject":    property.New("Welcome to Example"),
+		}),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, registered, 2)
+	older := registered["welcome-2024-01-welcome"]
+	assert.Equal(t, "d-123", older.Inputs.Get("templateId").AsString())
+	assert.Equal(t, "<p>Welcome</p>", older.Inputs.Get("htmlContent").AsString())
+	assert.Equal(t, "Welcome to Example", older.Inputs.Get("subject").AsString())
+	assert.Equal(t, 0.0, older.Inputs.Get("active").AsNumber())
+
+	newest := registered["welcome-2024-06-welcome"]
+	assert.Equal(t, 1.0, newest.Inputs.Get("active").AsNumber())
+	assert.Len(t, newest.RegisterRPC.GetDependencies(), 1, "the newest version is activated last")
+
+	assert.Equal(t, "v-2024-06-welcome", resp.State.Get("activeVersionId").AsString())
+	assert.Equal(t, "v-2024-01-welcome", resp.State.Get("versionIds").AsMap().Get("2024-01-welcome").AsString())
+	assert.Equal(t, "06b216894c2940203e3595b1bb49d36b80b8eb7667e6aa91317f4fdfb2d8d1fb",
+		resp.State.Get("contentHashes").AsMap().Get("2024-01-welcome").AsString())
+}
+
+func TestTemplateDirectory_Empty(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, nil, nil, integration.WithMocks(&integration.MockResourceMonitor{}))
+
+	_, err := server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("stack", "project", "", "sendgrid:index:TemplateDirectory", "welcome"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"templateId": property.New("d-123"),
+			"directory":  property.New(t.TempDir()),
+		}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no .html files found")
+}
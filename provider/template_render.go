@@ -0,0 +1,186 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aymerick/raymond"
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// renderDiffContextLines is the number of changed lines a render summary shows
+const renderDiffContextLines = 5
+
+// renderDiffMaxCells bounds the work of diffing rendered lines; longer content is
+// summarized by line counts only
+const renderDiffMaxCells = 1_000_000
+
+// sendGridHelpers are the SendGrid handlebars helpers the render preview supports,
+// beyond the built-in if, unless, each and with
+var sendGridHelpers = map[string]interface{}{
+	"equals": func(a, b interface{}, options *raymond.Options) interface{} {
+		if raymond.Str(a) == raymond.Str(b) {
+			return options.Fn()
+		}
+		return options.Inverse()
+	},
+	"notEquals": func(a, b interface{}, options *raymond.Options) interface{} {
+		if raymond.Str(a) != raymond.Str(b) {
+			return options.Fn()
+		}
+		return options.Inverse()
+	},
+	"insert": func(value interface{}, options *raymond.Options) string {
+		if s := raymond.Str(value); s != "" {
+			return s
+		}
+		return options.HashStr("default")
+	},
+	"length": func(value interface{}) int {
+		switch v := value.(type) {
+		case []interface{}:
+			return len(v)
+		case string:
+			return len(v)
+		}
+		return 0
+	},
+}
+
+// renderTemplate renders handlebars content with the JSON test data
+func renderTemplate(content, testData string) (string, error) {
+	data := map[string]interface{}{}
+	if strings.TrimSpace(testData) != "" {
+		if err := json.Unmarshal([]byte(testData), &data); err != nil {
+			return "", fmt.Errorf("testData is not a JSON object: %w", err)
+		}
+	}
+
+	tpl, err := raymond.Parse(content)
+	if err != nil {
+		return "", err
+	}
+	tpl.RegisterHelpers(sendGridHelpers)
+	return tpl.Exec(data)
+}
+
+// renderedTemplateVersion is the email a template version renders with its test data
+type renderedTemplateVersion struct {
+	subject, html, plain string
+}
+
+// renderTemplateVersion renders the subject and content of a template version with its test data
+func renderTemplateVersion(args TemplateVersionArgs) (renderedTemplateVersion, error) {
+	var rendered renderedTemplateVersion
+	var err error
+	testData := stringValue(args.TestData)
+	if rendered.subject, err = renderTemplate(stringValue(args.Subject), testData); err != nil {
+		return rendered, fmt.Errorf("subject: %w", err)
+	}
+	if rendered.html, err = renderTemplate(stringValue(args.HTMLContent), testData); err != nil {
+		return rendered, fmt.Errorf("htmlContent: %w", err)
+	}
+	if rendered.plain, err = renderTemplate(stringValue(args.PlainContent), testData); err != nil {
+		return rendered, fmt.Errorf("plainContent: %w", err)
+	}
+	return rendered, nil
+}
+
+// logRenderPreview logs how the email rendered with the test data changes from olds to news
+func logRenderPreview(ctx context.Context, olds, news TemplateVersionArgs) {
+	before, err := renderTemplateVersion(olds)
+	if err == nil {
+		var after renderedTemplateVersion
+		if after, err = renderTemplateVersion(news); err == nil {
+			if summary := summarizeRenderDiff(before, after); summary != "" {
+				p.GetLogger(ctx).Infof("Rendered with testData, the email changes:\n%s", summary)
+			} else {
+				p.GetLogger(ctx).Infof("Rendered with testData, the email is unchanged")
+			}
+			return
+		}
+	}
+	p.GetLogger(ctx).Warningf("renderPreview could not render the template: %v", err)
+}
+
+// summarizeRenderDiff describes how the rendered email changes from olds to news,
+// or returns "" when recipients would get the same email
+func summarizeRenderDiff(olds, news renderedTemplateVersion) string {
+	var parts []string
+	if olds.subject != news.subject {
+		parts = append(parts, fmt.Sprintf("subject: %q -> %q", olds.subject, news.subject))
+	}
+	if summary := summarizeLineDiff(olds.html, news.html); summary != "" {
+		parts = append(parts, "html:\n"+summary)
+	}
+	if summary := summarizeLineDiff(olds.plain, news.plain); summary != "" {
+		parts = append(parts, "plain text:\n"+summary)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// summarizeLineDiff counts the lines added and removed from olds to news and
+// shows the first few of them, or returns "" when they are the same
+func summarizeLineDiff(olds, news string) string {
+	if olds == news {
+		return ""
+	}
+	a, b := strings.Split(olds, "\n"), strings.Split(news, "\n")
+	if len(a)*len(b) > renderDiffMaxCells {
+		return fmt.Sprintf("  %d lines -> %d lines", len(a), len(b))
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var changes []string
+	added, removed := 0, 0
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i, j = i+1, j+1
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			added++
+			changes = append(changes, "  + "+strings.TrimSpace(b[j]))
+			j++
+		default:
+			removed++
+			changes = append(changes, "  - "+strings.TrimSpace(a[i]))
+			i++
+		}
+	}
+
+	summary := fmt.Sprintf("  %d lines added, %d removed", added, removed)
+	if len(changes) > renderDiffContextLines {
+		changes = append(changes[:renderDiffContextLines], fmt.Sprintf("  ... %d more", len(changes)-renderDiffContextLines))
+	}
+	return summary + "\n" + strings.Join(changes, "\n")
+}
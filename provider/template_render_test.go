@@ -0,0 +1,117 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		content  string
+		testData string
+		expected string
+	}{
+		{"substitution", "Hello {{name}}", `{"name": "Ada"}`, "Hello Ada"},
+		{"no test data", "Hello {{name}}", "", "Hello "},
+		{"html escaping", "{{name}}", `{"name": "<b>"}`, "&lt;b&gt;"},
+		{"each", "{{#each items}}[{{this}}]{{/each}}", `{"items": ["a", "b"]}`, "[a][b]"},
+		{"equals", `{{#equals plan "pro"}}Pro{{else}}Free{{/equals}}`, `{"plan": "pro"}`, "Pro"},
+		{"notEquals", `{{#notEquals plan "pro"}}Free{{/notEquals}}`, `{"plan": "pro"}`, ""},
+		{"insert with default", `{{insert name default="customer"}}`, `{}`, "customer"},
+		{"insert with value", `{{insert name default="customer"}}`, `{"name": "Ada"}`, "Ada"},
+		{"length", "{{length items}}", `{"items": [1, 2, 3]}`, "3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rendered, err := renderTemplate(tt.content, tt.testData)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rendered)
+		})
+	}
+
+	t.Run("invalid test data", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := renderTemplate("Hello", `["not", "an", "object"]`)
+		assert.ErrorContains(t, err, "testData is not a JSON object")
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := renderTemplate("{{#if name}}unclosed", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestRenderTemplateVersion(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := renderTemplateVersion(TemplateVersionArgs{
+		Subject:      strPtr("Welcome {{name}}"),
+		HTMLContent:  strPtr("<p>Hi {{name}}</p>"),
+		PlainContent: strPtr("Hi {{name}}"),
+		TestData:     strPtr(`{"name": "Ada"}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, renderedTemplateVersion{subject: "Welcome Ada", html: "<p>Hi Ada</p>", plain: "Hi Ada"}, rendered)
+
+	_, err = renderTemplateVersion(TemplateVersionArgs{HTMLContent: strPtr("{{#each}}")})
+	assert.ErrorContains(t, err, "htmlContent:")
+}
+
+func TestSummarizeRenderDiff(t *testing.T) {
+	t.Parallel()
+
+	same := renderedTemplateVersion{subject: "Hi", html: "<p>a</p>", plain: "a"}
+	assert.Empty(t, summarizeRenderDiff(same, same))
+
+	summary := summarizeRenderDiff(same, renderedTemplateVersion{subject: "Hello", html: "<p>a</p>\n<p>b</p>", plain: "a"})
+	assert.Equal(t, "subject: \"Hi\" -> \"Hello\"\nhtml:\n  1 lines added, 0 removed\n  + <p>b</p>", summary)
+}
+
+func TestSummarizeLineDiff(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, summarizeLineDiff("a\nb", "a\nb"))
+	assert.Equal(t, "  1 lines added, 1 removed\n  + c\n  - b", summarizeLineDiff("a\nb", "a\nc"))
+
+	t.Run("long diffs are truncated", func(t *testing.T) {
+		t.Parallel()
+
+		news := strings.Repeat("x\n", renderDiffContextLines+2) + "x"
+		summary := summarizeLineDiff("", news)
+		assert.Contains(t, summary, "8 lines added, 1 removed")
+		assert.Contains(t, summary, "  ... 4 more")
+	})
+
+	t.Run("large content is counted only", func(t *testing.T) {
+		t.Parallel()
+
+		lines := strings.Repeat("x\n", 1001)
+		assert.Equal(t, "  1002 lines -> 1003 lines", summarizeLineDiff(lines, lines+"y\n"))
+	})
+}
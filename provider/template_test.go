@@ -18,10 +18,14 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 func TestSendGridClient_CreateTemplate(t *testing.T) {
@@ -512,3 +516,104 @@ func TestTemplateVersionSummary_ActiveConversion(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_DeleteWithVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		forceDelete bool
+		versions    string
+		// createdLater are the versions a GET returns once deleting the template was refused
+		createdLater string
+		wantErr      string
+		want         []string
+	}{
+		{
+			name:     "no versions",
+			versions: `[]`,
+			want:     []string{"GET /v3/templates/d-1", "DELETE /v3/templates/d-1"},
+		},
+		{
+			name:     "versions without forceDelete",
+			versions: `[{"id": "v-1", "name": "welcome", "active": 1}]`,
+			wantErr:  "set forceDelete to true",
+			want:     []string{"GET /v3/templates/d-1"},
+		},
+		{
+			name:        "versions with forceDelete",
+			forceDelete: true,
+			versions:    `[{"id": "v-1", "name": "welcome", "active": 1}, {"id": "v-2", "name": "draft", "active": 0}]`,
+			want: []string{
+				"GET /v3/templates/d-1",
+				"DELETE /v3/templates/d-1/versions/v-2",
+				"DELETE /v3/templates/d-1/versions/v-1",
+				"DELETE /v3/templates/d-1",
+			},
+		},
+		{
+			name:         "version created while deleting",
+			versions:     `[]`,
+			createdLater: `[{"id": "v-3", "name": "hotfix", "active": 1}]`,
+			wantErr:      `still has 1 version(s): "hotfix" (v-3)`,
+			want:         []string{"GET /v3/templates/d-1", "DELETE /v3/templates/d-1", "GET /v3/templates/d-1"},
+		},
+		{
+			name:         "version created while deleting with forceDelete",
+			forceDelete:  true,
+			versions:     `[]`,
+			createdLater: `[{"id": "v-3", "name": "hotfix", "active": 1}]`,
+			want: []string{
+				"GET /v3/templates/d-1",
+				"DELETE /v3/templates/d-1",
+				"GET /v3/templates/d-1",
+				"DELETE /v3/templates/d-1/versions/v-3",
+				"DELETE /v3/templates/d-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var requests []string
+			refused := false
+			server := templateActivationServer(t, func(req *http.Request) *http.Response {
+				mu.Lock()
+				defer mu.Unlock()
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				if req.Method == http.MethodGet {
+					versions := tt.versions
+					if refused {
+						versions = tt.createdLater
+					}
+					return fakeResponse(req, http.StatusOK, `{"id": "d-1", "versions": `+versions+`}`)
+				}
+				if req.URL.Path == "/v3/templates/d-1" && tt.createdLater != "" && !refused {
+					refused = true
+					return fakeResponse(req, http.StatusConflict, `{"errors": [{"message": "template has an active version"}]}`)
+				}
+				return fakeResponse(req, http.StatusNoContent, ``)
+			})
+
+			err := server.Delete(p.DeleteRequest{
+				ID:  "d-1",
+				Urn: previewURN("Template", "welcome"),
+				Properties: property.NewMap(map[string]property.Value{
+					"name":        property.New("welcome"),
+					"generation":  property.New("dynamic"),
+					"templateId":  property.New("d-1"),
+					"forceDelete": property.New(tt.forceDelete),
+				}),
+			})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, requests)
+		})
+	}
+}
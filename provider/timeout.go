@@ -0,0 +1,110 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// DefaultRequestTimeout is the default time limit for a single API request
+const DefaultRequestTimeout = 30 * time.Second
+
+// requestTimeouts holds the time limits applied to each request attempt.
+// A zero read or write timeout falls back to the request timeout, and a zero
+// request timeout disables the limit.
+type requestTimeouts struct {
+	request time.Duration
+	read    time.Duration
+	write   time.Duration
+}
+
+// forMethod returns the timeout for a request with the given HTTP method.
+// GET requests are reads; all other methods are writes.
+func (t requestTimeouts) forMethod(method string) time.Duration {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		if t.read > 0 {
+			return t.read
+		}
+	default:
+		if t.write > 0 {
+			return t.write
+		}
+	}
+	return t.request
+}
+
+// WithRequestTimeout sets the default time limit for a single request attempt
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *SendGridClient) {
+		c.timeouts.request = timeout
+	}
+}
+
+// WithReadTimeout overrides the time limit for GET requests, e.g. to make reads fail fast
+func WithReadTimeout(timeout time.Duration) ClientOption {
+	return func(c *SendGridClient) {
+		c.timeouts.read = timeout
+	}
+}
+
+// WithWriteTimeout overrides the time limit for POST, PUT, PATCH and DELETE requests,
+// e.g. to allow large template uploads to complete
+func WithWriteTimeout(timeout time.Duration) ClientOption {
+	return func(c *SendGridClient) {
+		c.timeouts.write = timeout
+	}
+}
+
+// withCustomTimeouts bounds creates, updates and deletes by the customTimeouts
+// resource option, which Pulumi sends with each request, so that retries and
+// waits stop when it elapses instead of running on after the engine gave up.
+func withCustomTimeouts(provider p.Provider) p.Provider {
+	if create := provider.Create; create != nil {
+		provider.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			ctx, cancel := contextWithTimeout(ctx, req.Timeout)
+			defer cancel()
+			return create(ctx, req)
+		}
+	}
+	if update := provider.Update; update != nil {
+		provider.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			ctx, cancel := contextWithTimeout(ctx, req.Timeout)
+			defer cancel()
+			return update(ctx, req)
+		}
+	}
+	if deleteFn := provider.Delete; deleteFn != nil {
+		provider.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			ctx, cancel := contextWithTimeout(ctx, req.Timeout)
+			defer cancel()
+			return deleteFn(ctx, req)
+		}
+	}
+	return provider
+}
+
+// contextWithTimeout derives a context that is done after the given number of
+// seconds. A non-positive timeout, meaning none was set, leaves ctx unbounded.
+func contextWithTimeout(ctx context.Context, seconds float64) (context.Context, context.CancelFunc) {
+	if seconds <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(seconds*float64(time.Second)))
+}
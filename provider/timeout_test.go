@@ -0,0 +1,128 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+func TestSendGridClient_Timeouts(t *testing.T) {
+	t.Parallel()
+
+	// slowServer responds after the given delay unless the client gives up first
+	slowServer := func(t *testing.T, delay time.Duration) string {
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(delay):
+				w.WriteHeader(http.StatusOK)
+			case <-r.Context().Done():
+			}
+		})
+		return server.URL
+	}
+
+	t.Run("read timeout fails fast", func(t *testing.T) {
+		t.Parallel()
+
+		client := NewSendGridClient("test-api-key", slowServer(t, time.Second),
+			WithReadTimeout(20*time.Millisecond))
+
+		err := client.Get(context.Background(), "/v3/templates", nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "timed out after 20ms")
+	})
+
+	t.Run("write timeout allows slow uploads", func(t *testing.T) {
+		t.Parallel()
+
+		client := NewSendGridClient("test-api-key", slowServer(t, 50*time.Millisecond),
+			WithRequestTimeout(10*time.Millisecond),
+			WithWriteTimeout(5*time.Second))
+
+		err := client.Post(context.Background(), "/v3/templates", map[string]string{"name": "large"}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("timed out reads are retried", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make(chan struct{}, 10)
+		server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls <- struct{}{}
+			if len(calls) == 1 {
+				<-r.Context().Done()
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+
+		client := newRetryingTestClient(server.URL, 2)
+		client.timeouts.read = 20 * time.Millisecond
+
+		err := client.Get(context.Background(), "/v3/templates", nil)
+		require.NoError(t, err)
+		assert.Len(t, calls, 2)
+	})
+}
+
+func TestRequestTimeouts_ForMethod(t *testing.T) {
+	t.Parallel()
+
+	timeouts := requestTimeouts{request: 30 * time.Second, read: 5 * time.Second}
+
+	assert.Equal(t, 5*time.Second, timeouts.forMethod(http.MethodGet))
+	assert.Equal(t, 30*time.Second, timeouts.forMethod(http.MethodPost))
+	assert.Equal(t, 30*time.Second, timeouts.forMethod(http.MethodDelete))
+
+	timeouts.write = 2 * time.Minute
+	assert.Equal(t, 2*time.Minute, timeouts.forMethod(http.MethodPatch))
+	assert.Equal(t, time.Duration(0), requestTimeouts{}.forMethod(http.MethodGet))
+}
+
+func TestWithCustomTimeouts(t *testing.T) {
+	t.Parallel()
+
+	var deadline time.Time
+	var hasDeadline bool
+	provider := withCustomTimeouts(p.Provider{
+		Create: func(ctx context.Context, _ p.CreateRequest) (p.CreateResponse, error) {
+			deadline, hasDeadline = ctx.Deadline()
+			return p.CreateResponse{}, nil
+		},
+		Delete: func(ctx context.Context, _ p.DeleteRequest) error {
+			deadline, hasDeadline = ctx.Deadline()
+			return nil
+		},
+	})
+
+	_, err := provider.Create(context.Background(), p.CreateRequest{Timeout: 120})
+	require.NoError(t, err)
+	require.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), deadline, 5*time.Second)
+
+	require.NoError(t, provider.Delete(context.Background(), p.DeleteRequest{}))
+	assert.False(t, hasDeadline, "no customTimeouts leaves the operation unbounded")
+
+	assert.Nil(t, provider.Update, "methods the provider lacks stay unset")
+}
@@ -0,0 +1,46 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import "net/http"
+
+// RoundTripperFunc adapts an ordinary function to the http.RoundTripper
+// interface, e.g. to fake SendGrid responses in unit tests.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithTransport replaces the HTTP transport used to send requests
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *SendGridClient) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithMiddleware wraps the HTTP transport used to send requests, e.g. to add
+// auth proxy headers, caching or fault injection. Middleware added later wraps
+// middleware added earlier.
+func WithMiddleware(middleware func(http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *SendGridClient) {
+		next := c.httpClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		c.httpClient.Transport = middleware(next)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"io"
+	"maps"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// fakeResponse builds an HTTP response with a JSON body for a fake transport
+func fakeResponse(req *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// newTestServer starts a provider whose SendGrid API is served by transport, or that
+// talks to the mocks in opts when transport is nil. It is configured with a fake API
+// key that is not validated, which extraConfig can override or add to.
+func newTestServer(t *testing.T, transport http.RoundTripper, extraConfig map[string]property.Value,
+	opts ...integration.ServerOption,
+) integration.Server {
+	t.Helper()
+
+	var clientOpts []ClientOption
+	if transport != nil {
+		clientOpts = append(clientOpts, WithTransport(transport))
+	}
+	server, err := integration.NewServer(context.Background(), Name, semver.MustParse("1.0.0"),
+		append([]integration.ServerOption{integration.WithProvider(NewProvider(clientOpts...))}, opts...)...)
+	require.NoError(t, err)
+
+	config := map[string]property.Value{
+		"apiKey":         property.New("SG.fake"),
+		"validateApiKey": property.New(false),
+	}
+	maps.Copy(config, extraConfig)
+	require.NoError(t, server.Configure(p.ConfigureRequest{Args: property.NewMap(config)}))
+	return server
+}
+
+func TestSendGridClient_WithTransport(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "https://api.sendgrid.com/v3/alerts", req.URL.String())
+		assert.Equal(t, "Bearer test-api-key", req.Header.Get("Authorization"))
+		return fakeResponse(req, http.StatusOK, `[{"id": 1, "type": "usage_limit", "email_to": "ops@example.com"}]`), nil
+	})
+
+	client := NewSendGridClient("test-api-key", "", WithTransport(transport))
+
+	var result []alertAPIResponse
+	err := client.Get(context.Background(), "/v3/alerts", &result)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "ops@example.com", result[0].EmailTo)
+}
+
+func TestSendGridClient_WithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		order = append(order, "transport")
+		assert.Equal(t, "gateway-token", req.Header.Get("X-Gateway-Auth"))
+		return fakeResponse(req, http.StatusOK, `{}`), nil
+	})
+	middleware := func(name string) func(http.RoundTripper) http.RoundTripper {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				req.Header.Set("X-Gateway-Auth", "gateway-token")
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	client := NewSendGridClient("test-api-key", "",
+		WithTransport(transport),
+		WithMiddleware(middleware("inner")),
+		WithMiddleware(middleware("outer")))
+
+	require.NoError(t, client.Get(context.Background(), "/v3/scopes", nil))
+	assert.Equal(t, []string{"outer", "inner", "transport"}, order)
+}
+
+func TestNewProvider_WithTransport(t *testing.T) {
+	t.Parallel()
+
+	// Exercise a function end to end through the provider without a live server
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "Bearer SG.fake", req.Header.Get("Authorization"))
+		switch req.URL.Path {
+		case "/v3/scopes":
+			return fakeResponse(req, http.StatusOK, `{"scopes": ["alerts.read"]}`), nil
+		case "/v3/alerts":
+			return fakeResponse(req, http.StatusOK, `[{"id": 7, "type": "stats_notification", "email_to": "stats@example.com", "frequency": "daily"}]`), nil
+		default:
+			return fakeResponse(req, http.StatusNotFound, `{}`), nil
+		}
+	})
+
+	server := newTestServer(t, transport, map[string]property.Value{"validateApiKey": property.New(true)})
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:getAlerts",
+		Args:  property.NewMap(nil),
+	})
+	require.NoError(t, err)
+
+	alerts := resp.Return.Get("alerts").AsArray()
+	require.Equal(t, 1, alerts.Len())
+	alert := alerts.Get(0).AsMap()
+	assert.Equal(t, 7.0, alert.Get("alertId").AsNumber())
+	assert.Equal(t, "daily", alert.Get("frequency").AsString())
+}
+
+func TestNewProvider_IsolatedInstances(t *testing.T) {
+	t.Parallel()
+
+	// Two explicitly configured providers in one program, e.g. one per subuser
+	newInstance := func(apiKey, onBehalfOf string) integration.Server {
+		transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "Bearer "+apiKey, req.Header.Get("Authorization"))
+			assert.Equal(t, onBehalfOf, req.Header.Get("On-Behalf-Of"))
+			if req.URL.Path == "/v3/scopes" {
+				return fakeResponse(req, http.StatusOK, `{"scopes": ["alerts.read"]}`), nil
+			}
+			return fakeResponse(req, http.StatusOK, `[{"id": 1, "type": "usage_limit", "email_to": "`+onBehalfOf+`@example.com"}]`), nil
+		})
+
+		return newTestServer(t, transport, map[string]property.Value{
+			"apiKey":         property.New(apiKey),
+			"onBehalfOf":     property.New(onBehalfOf),
+			"validateApiKey": property.New(true),
+		})
+	}
+
+	tenantA := newInstance("SG.parent-a", "tenant-a")
+	tenantB := newInstance("SG.parent-b", "tenant-b")
+
+	for server, want := range map[integration.Server]string{tenantA: "tenant-a@example.com", tenantB: "tenant-b@example.com"} {
+		resp, err := server.Invoke(p.InvokeRequest{
+			Token: "sendgrid:index:getAlerts",
+			Args:  property.NewMap(nil),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want, resp.Return.Get("alerts").AsArray().Get(0).AsMap().Get("emailTo").AsString())
+	}
+}
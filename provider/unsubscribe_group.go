@@ -17,9 +17,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // UnsubscribeGroup is the controller for the SendGrid Unsubscribe Group resource.
@@ -30,8 +33,8 @@ type UnsubscribeGroup struct{}
 
 // UnsubscribeGroupArgs are the inputs to the UnsubscribeGroup resource.
 type UnsubscribeGroupArgs struct {
-	// Name is the name of the unsubscribe group (required, max 30 chars)
-	Name string `pulumi:"name"`
+	// Name is the name of the unsubscribe group (optional, max 30 chars, defaults to an autoname from the resource name)
+	Name string `pulumi:"name,optional"`
 
 	// Description is a description of the unsubscribe group (optional, max 100 chars)
 	Description *string `pulumi:"description,optional"`
@@ -39,6 +42,18 @@ type UnsubscribeGroupArgs struct {
 	// IsDefault indicates whether this is the default unsubscribe group (optional)
 	// When true, this group is used when no other group is specified
 	IsDefault *bool `pulumi:"isDefault,optional"`
+
+	// AdoptExisting takes over a group with the same name instead of failing to
+	// create a duplicate (optional, defaults to false)
+	AdoptExisting *bool `pulumi:"adoptExisting,optional"`
+
+	// DeletionProtection prevents the group from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
+
+	// ForceDelete removes the group's suppressions when they block deleting it
+	// (optional, defaults to false). Otherwise the delete fails listing how many remain.
+	ForceDelete *bool `pulumi:"forceDelete,optional"`
 }
 
 // UnsubscribeGroupState is the state of the UnsubscribeGroup resource.
@@ -61,7 +76,11 @@ func (g *UnsubscribeGroup) Annotate(annotator infer.Annotator) {
 		"newsletters, and product updates, allowing users to choose which types of emails "+
 		"they want to receive.\n\n"+
 		"When a recipient unsubscribes from a group, they will no longer receive emails "+
-		"that are associated with that group.")
+		"that are associated with that group.\n\n"+
+		"Deleting the account's default group fails, so emails sent without a group keep an unsubscribe "+
+		"option. Make another group the default first, e.g. by creating one with isDefault set to true.\n\n"+
+		"When SendGrid refuses to delete a group because it still has suppressions, the delete fails "+
+		"unless forceDelete is set, in which case the suppressions are removed and the delete retried.")
 }
 
 // unsubscribeGroupAPIResponse represents the SendGrid API response structure for unsubscribe groups
@@ -93,12 +112,94 @@ func (r *unsubscribeGroupAPIResponse) toState() UnsubscribeGroupState {
 	}
 }
 
+// Check validates the UnsubscribeGroup inputs. With validateOnPreview, it also
+// verifies that no other group has the same name.
+func (g *UnsubscribeGroup) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[UnsubscribeGroupArgs], error) {
+	newInputs, err := autoname(req, "name", 30)
+	if err != nil {
+		return infer.CheckResponse[UnsubscribeGroupArgs]{}, err
+	}
+	req.NewInputs = newInputs
+
+	inputs, failures, err := infer.DefaultCheck[UnsubscribeGroupArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[UnsubscribeGroupArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	if client := previewValidationClient(ctx); client != nil && len(failures) == 0 &&
+		(inputs.AdoptExisting == nil || !*inputs.AdoptExisting) &&
+		inputsKnown(req.NewInputs, "name") && inputsChanged(req, "name") {
+		failures = append(failures, checkGroupNameAvailable(ctx, client, inputs.Name)...)
+	}
+
+	return infer.CheckResponse[UnsubscribeGroupArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid UnsubscribeGroupArgs
+func (args *UnsubscribeGroupArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("name", args.Name)
+	v.maxLength("name", args.Name, 30)
+	if args.Description != nil {
+		v.maxLength("description", *args.Description, 100)
+	}
+	return v.failures
+}
+
+// checkGroupNameAvailable reports a failure if an unsubscribe group with the name already exists
+func checkGroupNameAvailable(ctx context.Context, client SendGridAPI, name string) []p.CheckFailure {
+	group, err := findUnsubscribeGroupByName(ctx, client, name)
+	if err != nil {
+		return lookupFailures(ctx, "name", "unsubscribe groups", err)
+	}
+	if group == nil {
+		return nil
+	}
+	return []p.CheckFailure{{
+		Property: "name",
+		Reason: fmt.Sprintf("an unsubscribe group named %q already exists (ID %d); import it with `pulumi import sendgrid:index:UnsubscribeGroup <name> %d`",
+			name, group.ID, group.ID),
+	}}
+}
+
+// updateRequestBody builds the PATCH request body from the args.
+// PATCH requires name and optionally description.
+func (args *UnsubscribeGroupArgs) updateRequestBody() map[string]interface{} {
+	reqBody := map[string]interface{}{
+		"name": args.Name,
+	}
+	if args.Description != nil {
+		reqBody["description"] = *args.Description
+	}
+	// Note: is_default can only be changed via a separate API call or during creation
+	// The PATCH endpoint does not support changing is_default
+	return reqBody
+}
+
+// findUnsubscribeGroupByName returns the unsubscribe group with the given name, or nil if there is none
+func findUnsubscribeGroupByName(ctx context.Context, client SendGridAPI, name string) (*unsubscribeGroupAPIResponse, error) {
+	// GET /v3/asm/groups returns a bare array of all groups
+	var result []unsubscribeGroupAPIResponse
+	if err := client.Get(ctx, "/v3/asm/groups", &result); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		if result[i].Name == name {
+			return &result[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // Create creates a new SendGrid Unsubscribe Group.
 func (g *UnsubscribeGroup) Create(ctx context.Context, req infer.CreateRequest[UnsubscribeGroupArgs]) (infer.CreateResponse[UnsubscribeGroupState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		isDefault := false
 		if input.IsDefault != nil {
@@ -113,7 +214,6 @@ func (g *UnsubscribeGroup) Create(ctx context.Context, req infer.CreateRequest[U
 			state.IsDefault = &isDefault
 		}
 		return infer.CreateResponse[UnsubscribeGroupState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -135,13 +235,39 @@ func (g *UnsubscribeGroup) Create(ctx context.Context, req infer.CreateRequest[U
 		reqBody["is_default"] = *input.IsDefault
 	}
 
-	// Make the API call
+	// Make the API call, or take over the group that already has the name
 	var result unsubscribeGroupAPIResponse
-	if err := client.Post(ctx, "/v3/asm/groups", reqBody, &result); err != nil {
+	existing, err := createOrAdopt(ctx, input.AdoptExisting, "unsubscribe group",
+		func() error { return client.Post(ctx, "/v3/asm/groups", reqBody, &result) },
+		func() (*unsubscribeGroupAPIResponse, error) {
+			return findUnsubscribeGroupByName(ctx, client, input.Name)
+		})
+	if err != nil {
 		return infer.CreateResponse[UnsubscribeGroupState]{}, fmt.Errorf("failed to create unsubscribe group: %w", err)
 	}
+	if existing != nil {
+		p.GetLogger(ctx).Infof("Adopting existing unsubscribe group %d named %q", existing.ID, input.Name)
+		if err := client.Patch(ctx, fmt.Sprintf("/v3/asm/groups/%d", existing.ID), input.updateRequestBody(), &result); err != nil {
+			// The adopted unsubscribe group is recorded as found, so the next update applies the inputs to it
+			state := existing.toState()
+			state.AdoptExisting = input.AdoptExisting
+			state.DeletionProtection = input.DeletionProtection
+			state.ForceDelete = input.ForceDelete
+			normalizeArgs(&state.UnsubscribeGroupArgs, input, nil)
+			return infer.CreateResponse[UnsubscribeGroupState]{
+				ID:     strconv.Itoa(existing.ID),
+				Output: state,
+			}, initFailed(fmt.Sprintf("update adopted unsubscribe group %d", existing.ID), err)
+		}
+		// is_default cannot be changed by PATCH, so the adopted group keeps its own
+		result.IsDefault = existing.IsDefault
+	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.DeletionProtection = input.DeletionProtection
+	state.ForceDelete = input.ForceDelete
+	normalizeArgs(&state.UnsubscribeGroupArgs, input, nil)
 
 	// Use the group ID as the Pulumi resource ID
 	return infer.CreateResponse[UnsubscribeGroupState]{
@@ -160,18 +286,28 @@ func (g *UnsubscribeGroup) Read(ctx context.Context, req infer.ReadRequest[Unsub
 		return infer.ReadResponse[UnsubscribeGroupArgs, UnsubscribeGroupState]{}, fmt.Errorf("SendGrid client not configured")
 	}
 
-	// Make the API call to get the unsubscribe group details
-	var result unsubscribeGroupAPIResponse
-	if err := client.Get(ctx, fmt.Sprintf("/v3/asm/groups/%s", id), &result); err != nil {
-		// Check if the resource was deleted out-of-band
-		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
-			// Return empty response to indicate resource no longer exists
-			return infer.ReadResponse[UnsubscribeGroupArgs, UnsubscribeGroupState]{}, nil
+	// Serve the group from the cached list of all groups when available,
+	// and fall back to reading it individually otherwise
+	result := findCached(ctx, client, "/v3/asm/groups", PageOptions{}, func(g *unsubscribeGroupAPIResponse) bool {
+		return strconv.Itoa(g.ID) == id
+	})
+	if result == nil {
+		result = &unsubscribeGroupAPIResponse{}
+		if err := client.Get(ctx, fmt.Sprintf("/v3/asm/groups/%s", id), result); err != nil {
+			// Check if the resource was deleted out-of-band
+			if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+				// Return empty response to indicate resource no longer exists
+				return infer.ReadResponse[UnsubscribeGroupArgs, UnsubscribeGroupState]{}, nil
+			}
+			return infer.ReadResponse[UnsubscribeGroupArgs, UnsubscribeGroupState]{}, fmt.Errorf("failed to read unsubscribe group: %w", err)
 		}
-		return infer.ReadResponse[UnsubscribeGroupArgs, UnsubscribeGroupState]{}, fmt.Errorf("failed to read unsubscribe group: %w", err)
 	}
 
 	state := result.toState()
+	state.AdoptExisting = req.Inputs.AdoptExisting
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	state.ForceDelete = req.Inputs.ForceDelete
+	normalizeArgs(&state.UnsubscribeGroupArgs, req.Inputs, nil)
 	inputs := state.UnsubscribeGroupArgs
 
 	return infer.ReadResponse[UnsubscribeGroupArgs, UnsubscribeGroupState]{
@@ -204,23 +340,17 @@ func (g *UnsubscribeGroup) Update(ctx context.Context, req infer.UpdateRequest[U
 		return infer.UpdateResponse[UnsubscribeGroupState]{}, fmt.Errorf("SendGrid client not configured")
 	}
 
-	// Build the request body - PATCH requires name and optionally description
-	reqBody := map[string]interface{}{
-		"name": input.Name,
-	}
-	if input.Description != nil {
-		reqBody["description"] = *input.Description
-	}
-	// Note: is_default can only be changed via a separate API call or during creation
-	// The PATCH endpoint does not support changing is_default
-
 	// Make the API call (PATCH to update the group)
 	var result unsubscribeGroupAPIResponse
-	if err := client.Patch(ctx, fmt.Sprintf("/v3/asm/groups/%s", id), reqBody, &result); err != nil {
+	if err := client.Patch(ctx, fmt.Sprintf("/v3/asm/groups/%s", id), input.updateRequestBody(), &result); err != nil {
 		return infer.UpdateResponse[UnsubscribeGroupState]{}, fmt.Errorf("failed to update unsubscribe group: %w", err)
 	}
 
 	state := result.toState()
+	state.AdoptExisting = input.AdoptExisting
+	state.DeletionProtection = input.DeletionProtection
+	state.ForceDelete = input.ForceDelete
+	normalizeArgs(&state.UnsubscribeGroupArgs, input, nil)
 
 	// Preserve the IsDefault value from input if the API doesn't return it in PATCH response
 	if input.IsDefault != nil {
@@ -234,20 +364,67 @@ func (g *UnsubscribeGroup) Update(ctx context.Context, req infer.UpdateRequest[U
 func (g *UnsubscribeGroup) Delete(ctx context.Context, req infer.DeleteRequest[UnsubscribeGroupState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "unsubscribe group", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
 		return infer.DeleteResponse{}, fmt.Errorf("SendGrid client not configured")
 	}
 
-	// Make the API call
-	if err := client.Delete(ctx, fmt.Sprintf("/v3/asm/groups/%s", id)); err != nil {
-		// If already deleted, that's fine
+	// Check the group is not the default now, as another group may have taken over since the last refresh
+	var current unsubscribeGroupAPIResponse
+	if err := client.Get(ctx, fmt.Sprintf("/v3/asm/groups/%s", id), &current); err != nil {
 		if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
 			return infer.DeleteResponse{}, nil
 		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to read unsubscribe group: %w", err)
+	}
+	if current.IsDefault {
+		return infer.DeleteResponse{}, fmt.Errorf("unsubscribe group %s (%q) is the account's default group; "+
+			"make another group the default first, e.g. by creating an UnsubscribeGroup with isDefault set to true, "+
+			"so emails sent without a group keep an unsubscribe option", id, current.Name)
+	}
+
+	// Make the API call, removing suppressions that block it
+	force := req.State.ForceDelete != nil && *req.State.ForceDelete
+	err := deleteUnblocking(ctx, client, "unsubscribe group "+id, fmt.Sprintf("/v3/asm/groups/%s", id), blockedDeleteInterval,
+		func(ctx context.Context) error { return deleteGroupSuppressions(ctx, client, id, force) })
+	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("failed to delete unsubscribe group: %w", err)
 	}
 
 	return infer.DeleteResponse{}, nil
 }
+
+// deleteGroupSuppressions removes the suppressions of an unsubscribe group,
+// or fails with guidance when the group has suppressions and force is not set
+func deleteGroupSuppressions(ctx context.Context, client SendGridAPI, groupID string, force bool) error {
+	// GET /v3/asm/groups/{group_id}/suppressions returns a bare array of email addresses
+	path := fmt.Sprintf("/v3/asm/groups/%s/suppressions", url.PathEscape(groupID))
+	emails, err := GetAllPages[string](ctx, client, path, PageOptions{Style: PaginateOffset, PageSize: suppressionPageSize})
+	if err != nil {
+		return err
+	}
+	if len(emails) == 0 {
+		return nil
+	}
+	if !force {
+		return fmt.Errorf("unsubscribe group %s still has %d suppression(s); remove them first, "+
+			"or set forceDelete to true to remove them with the group", groupID, len(emails))
+	}
+
+	for _, email := range emails {
+		// DELETE /v3/asm/groups/{group_id}/suppressions/{email}
+		if err := client.Delete(ctx, path+"/"+url.PathEscape(email)); err != nil {
+			if sgErr, ok := err.(*SendGridError); ok && sgErr.IsNotFound() {
+				continue
+			}
+			return fmt.Errorf("failed to remove suppressions of unsubscribe group %s: %w", groupID, err)
+		}
+	}
+	p.GetLogger(ctx).Infof("Removed %d suppression(s) from unsubscribe group %s", len(emails), groupID)
+	return nil
+}
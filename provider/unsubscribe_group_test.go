@@ -17,11 +17,18 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 func TestSendGridClient_CreateUnsubscribeGroup(t *testing.T) {
@@ -525,6 +532,145 @@ func TestUnsubscribeGroup_ServerErrors(t *testing.T) {
 	})
 }
 
+func TestUnsubscribeGroup_DeleteDefaultGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		isDefault bool
+		wantErr   string
+		want      []string
+	}{
+		{
+			name: "not the default",
+			want: []string{"GET /v3/asm/groups/42", "DELETE /v3/asm/groups/42"},
+		},
+		{
+			name:      "the default",
+			isDefault: true,
+			wantErr:   "is the account's default group",
+			want:      []string{"GET /v3/asm/groups/42"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var requests []string
+			transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				if req.Method == http.MethodGet {
+					return fakeResponse(req, http.StatusOK, fmt.Sprintf(`{"id": 42, "name": "Newsletter", "is_default": %t}`, tt.isDefault)), nil
+				}
+				return fakeResponse(req, http.StatusNoContent, ``), nil
+			})
+			server := newTestServer(t, transport, nil)
+
+			// State recorded before another group became the default is not trusted
+			err := server.Delete(p.DeleteRequest{
+				ID:  "42",
+				Urn: previewURN("UnsubscribeGroup", "newsletter"),
+				Properties: property.NewMap(map[string]property.Value{
+					"name":         property.New("Newsletter"),
+					"isDefault":    property.New(!tt.isDefault),
+					"groupId":      property.New(42.0),
+					"unsubscribes": property.New(0.0),
+				}),
+			})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, requests)
+		})
+	}
+}
+
+func TestUnsubscribeGroup_DeleteWithSuppressions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		forceDelete bool
+		wantErr     string
+		want        []string
+	}{
+		{
+			name:    "suppressions without forceDelete",
+			wantErr: "still has 2 suppression(s)",
+			want: []string{
+				"GET /v3/asm/groups/42",
+				"DELETE /v3/asm/groups/42",
+				"GET /v3/asm/groups/42/suppressions",
+			},
+		},
+		{
+			name:        "suppressions with forceDelete",
+			forceDelete: true,
+			want: []string{
+				"GET /v3/asm/groups/42",
+				"DELETE /v3/asm/groups/42",
+				"GET /v3/asm/groups/42/suppressions",
+				"DELETE /v3/asm/groups/42/suppressions/a@example.com",
+				"DELETE /v3/asm/groups/42/suppressions/b@example.com",
+				"DELETE /v3/asm/groups/42",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var requests []string
+			suppressions := []string{"a@example.com", "b@example.com"}
+			transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/v3/asm/groups/42/suppressions":
+					body, _ := json.Marshal(suppressions)
+					return fakeResponse(req, http.StatusOK, string(body)), nil
+				case req.Method == http.MethodGet:
+					return fakeResponse(req, http.StatusOK, `{"id": 42, "name": "Newsletter", "is_default": false}`), nil
+				case req.URL.Path == "/v3/asm/groups/42" && len(suppressions) > 0:
+					return fakeResponse(req, http.StatusConflict, `{"errors": [{"message": "group has suppressions"}]}`), nil
+				case req.Method == http.MethodDelete:
+					suppressions = slices.DeleteFunc(suppressions, func(email string) bool {
+						return strings.HasSuffix(req.URL.Path, "/"+email)
+					})
+				}
+				return fakeResponse(req, http.StatusNoContent, ``), nil
+			})
+			server := newTestServer(t, transport, nil)
+
+			err := server.Delete(p.DeleteRequest{
+				ID:  "42",
+				Urn: previewURN("UnsubscribeGroup", "newsletter"),
+				Properties: property.NewMap(map[string]property.Value{
+					"name":         property.New("Newsletter"),
+					"groupId":      property.New(42.0),
+					"unsubscribes": property.New(0.0),
+					"forceDelete":  property.New(tt.forceDelete),
+				}),
+			})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, requests)
+		})
+	}
+}
+
 // Helper functions
 func strPtr(s string) *string {
 	return &s
@@ -0,0 +1,155 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// ValidateEmail is the controller for the validateEmail function.
+//
+// This function checks an email address with the Email Address Validation API,
+// so that addresses used for alerts, teammates and verified senders can be
+// vetted before the resources are created.
+type ValidateEmail struct{}
+
+// ValidateEmailArgs are the inputs to the validateEmail function.
+type ValidateEmailArgs struct {
+	// Email is the email address to validate (required)
+	Email string `pulumi:"email"`
+
+	// Source is a label for where the validation comes from, shown in SendGrid's reports (optional)
+	Source *string `pulumi:"source,optional"`
+}
+
+// EmailValidationChecks are the individual checks behind a validation verdict.
+type EmailValidationChecks struct {
+	// HasValidAddressSyntax indicates the address is syntactically valid
+	HasValidAddressSyntax bool `pulumi:"hasValidAddressSyntax"`
+	// HasMxOrARecord indicates the domain has an MX or A record
+	HasMxOrARecord bool `pulumi:"hasMxOrARecord"`
+	// IsSuspectedDisposableAddress indicates the domain is a disposable email provider
+	IsSuspectedDisposableAddress bool `pulumi:"isSuspectedDisposableAddress"`
+	// IsSuspectedRoleAddress indicates the local part is a role, e.g. admin or support
+	IsSuspectedRoleAddress bool `pulumi:"isSuspectedRoleAddress"`
+	// HasKnownBounces indicates mail to the address has bounced before
+	HasKnownBounces bool `pulumi:"hasKnownBounces"`
+	// HasSuspectedBounces indicates mail to the address is likely to bounce
+	HasSuspectedBounces bool `pulumi:"hasSuspectedBounces"`
+}
+
+// ValidateEmailResult is the output of the validateEmail function.
+type ValidateEmailResult struct {
+	// Verdict is "Valid", "Risky" or "Invalid"
+	Verdict string `pulumi:"verdict"`
+	// Score is the likelihood the address is valid, from 0 to 1
+	Score float64 `pulumi:"score"`
+	// Local is the part of the address before the @
+	Local string `pulumi:"local"`
+	// Host is the domain of the address
+	Host string `pulumi:"host"`
+	// Suggestion is a corrected domain when the host looks like a typo (optional)
+	Suggestion *string `pulumi:"suggestion,optional"`
+	// Checks are the individual checks behind the verdict
+	Checks EmailValidationChecks `pulumi:"checks"`
+}
+
+// Annotate provides descriptions for the validateEmail function.
+func (f *ValidateEmail) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&f, "Validates an email address with the SendGrid Email Address Validation API.\n\n"+
+		"Returns a verdict, a score and the checks behind them, so provisioning programs can vet "+
+		"alert recipients, teammate emails and verified sender addresses before creating resources.\n\n"+
+		"Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.")
+}
+
+// emailValidationAPIResponse represents the response from POST /v3/validation/email
+type emailValidationAPIResponse struct {
+	Result struct {
+		Verdict    string  `json:"verdict"`
+		Score      float64 `json:"score"`
+		Local      string  `json:"local"`
+		Host       string  `json:"host"`
+		Suggestion string  `json:"suggestion"`
+		Checks     struct {
+			Domain struct {
+				HasValidAddressSyntax        bool `json:"has_valid_address_syntax"`
+				HasMxOrARecord               bool `json:"has_mx_or_a_record"`
+				IsSuspectedDisposableAddress bool `json:"is_suspected_disposable_address"`
+			} `json:"domain"`
+			LocalPart struct {
+				IsSuspectedRoleAddress bool `json:"is_suspected_role_address"`
+			} `json:"local_part"`
+			Additional struct {
+				HasKnownBounces     bool `json:"has_known_bounces"`
+				HasSuspectedBounces bool `json:"has_suspected_bounces"`
+			} `json:"additional"`
+		} `json:"checks"`
+	} `json:"result"`
+}
+
+// toResult converts an API response to ValidateEmailResult
+func (r *emailValidationAPIResponse) toResult() ValidateEmailResult {
+	checks := r.Result.Checks
+	result := ValidateEmailResult{
+		Verdict: r.Result.Verdict,
+		Score:   r.Result.Score,
+		Local:   r.Result.Local,
+		Host:    r.Result.Host,
+		Checks: EmailValidationChecks{
+			HasValidAddressSyntax:        checks.Domain.HasValidAddressSyntax,
+			HasMxOrARecord:               checks.Domain.HasMxOrARecord,
+			IsSuspectedDisposableAddress: checks.Domain.IsSuspectedDisposableAddress,
+			IsSuspectedRoleAddress:       checks.LocalPart.IsSuspectedRoleAddress,
+			HasKnownBounces:              checks.Additional.HasKnownBounces,
+			HasSuspectedBounces:          checks.Additional.HasSuspectedBounces,
+		},
+	}
+	if r.Result.Suggestion != "" {
+		result.Suggestion = &r.Result.Suggestion
+	}
+	return result
+}
+
+// Invoke validates the email address.
+func (f *ValidateEmail) Invoke(ctx context.Context, req infer.FunctionRequest[ValidateEmailArgs]) (infer.FunctionResponse[ValidateEmailResult], error) {
+	input := req.Input
+	if input.Email == "" {
+		return infer.FunctionResponse[ValidateEmailResult]{}, fmt.Errorf("email is required")
+	}
+
+	// Get the SendGrid client from context
+	client := infer.GetConfig[Config](ctx).client
+	if client == nil {
+		return infer.FunctionResponse[ValidateEmailResult]{}, fmt.Errorf("SendGrid client not configured")
+	}
+
+	reqBody := map[string]interface{}{
+		"email": input.Email,
+	}
+	if input.Source != nil {
+		reqBody["source"] = *input.Source
+	}
+
+	// POST /v3/validation/email
+	var result emailValidationAPIResponse
+	if err := client.Post(ctx, "/v3/validation/email", reqBody, &result); err != nil {
+		return infer.FunctionResponse[ValidateEmailResult]{}, fmt.Errorf("failed to validate email: %w", err)
+	}
+
+	return infer.FunctionResponse[ValidateEmailResult]{Output: result.toResult()}, nil
+}
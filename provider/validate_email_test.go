@@ -0,0 +1,91 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestEmailValidationAPIResponse_ToResult(t *testing.T) {
+	t.Parallel()
+
+	var resp emailValidationAPIResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"result": {
+		"email": "admin@gmial.com",
+		"verdict": "Risky",
+		"score": 0.35,
+		"local": "admin",
+		"host": "gmial.com",
+		"suggestion": "gmail.com",
+		"checks": {
+			"domain": {"has_valid_address_syntax": true, "has_mx_or_a_record": true, "is_suspected_disposable_address": false},
+			"local_part": {"is_suspected_role_address": true},
+			"additional": {"has_known_bounces": false, "has_suspected_bounces": true}
+		}
+	}}`), &resp))
+
+	result := resp.toResult()
+	assert.Equal(t, "Risky", result.Verdict)
+	assert.InDelta(t, 0.35, result.Score, 1e-9)
+	assert.Equal(t, "admin", result.Local)
+	assert.Equal(t, "gmial.com", result.Host)
+	require.NotNil(t, result.Suggestion)
+	assert.Equal(t, "gmail.com", *result.Suggestion)
+	assert.Equal(t, EmailValidationChecks{
+		HasValidAddressSyntax:  true,
+		HasMxOrARecord:         true,
+		IsSuspectedRoleAddress: true,
+		HasSuspectedBounces:    true,
+	}, result.Checks)
+
+	// Without a suggestion, none is reported
+	resp.Result.Suggestion = ""
+	assert.Nil(t, resp.toResult().Suggestion)
+}
+
+func TestValidateEmail(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/v3/validation/email", req.URL.Path)
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{"email": "ops@example.com", "source": "provisioning"}`, string(body))
+		return fakeResponse(req, http.StatusOK, `{"result": {"email": "ops@example.com", "verdict": "Valid", "score": 0.97,
+			"local": "ops", "host": "example.com", "checks": {"domain": {"has_valid_address_syntax": true, "has_mx_or_a_record": true}}}}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "sendgrid:index:validateEmail",
+		Args: property.NewMap(map[string]property.Value{
+			"email":  property.New("ops@example.com"),
+			"source": property.New("provisioning"),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Valid", resp.Return.Get("verdict").AsString())
+	assert.Equal(t, 0.97, resp.Return.Get("score").AsNumber())
+	assert.True(t, resp.Return.Get("checks").AsMap().Get("hasMxOrARecord").AsBool())
+}
@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"strconv"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // VerifiedSender is the controller for the SendGrid Verified Sender resource.
@@ -30,11 +32,11 @@ type VerifiedSender struct{}
 
 // VerifiedSenderArgs are the inputs to the VerifiedSender resource.
 type VerifiedSenderArgs struct {
-	// Nickname is a label for the sender identity (required)
-	Nickname string `pulumi:"nickname"`
+	// Nickname is a label for the sender identity (optional, defaults to an autoname from the resource name)
+	Nickname string `pulumi:"nickname,optional"`
 
 	// FromEmail is the email address to send from (required)
-	FromEmail string `pulumi:"fromEmail"`
+	FromEmail string `pulumi:"fromEmail" provider:"replaceOnChanges"`
 
 	// FromName is the name that appears in the "From" field (optional)
 	FromName *string `pulumi:"fromName,optional"`
@@ -62,6 +64,20 @@ type VerifiedSenderArgs struct {
 
 	// Country is the country for the sender address (required)
 	Country string `pulumi:"country"`
+
+	// WaitForVerification waits, once the sender is created or updated, until it has been verified
+	// through the link SendGrid emails to fromEmail (optional, defaults to false)
+	WaitForVerification *bool `pulumi:"waitForVerification,optional"`
+
+	// WaitTimeoutSeconds is how long to wait for verification (optional, defaults to 600)
+	WaitTimeoutSeconds *int `pulumi:"waitTimeoutSeconds,optional"`
+
+	// WaitIntervalSeconds is the time between verification checks (optional, defaults to 30)
+	WaitIntervalSeconds *int `pulumi:"waitIntervalSeconds,optional"`
+
+	// DeletionProtection prevents the sender from being deleted, including by a replacement,
+	// while set to true (optional, defaults to false)
+	DeletionProtection *bool `pulumi:"deletionProtection,optional"`
 }
 
 // VerifiedSenderState is the state of the VerifiedSender resource.
@@ -144,12 +160,49 @@ func (r *verifiedSenderAPIResponse) toState() VerifiedSenderState {
 	return state
 }
 
+// Check validates the VerifiedSender inputs.
+func (v *VerifiedSender) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[VerifiedSenderArgs], error) {
+	newInputs, err := autoname(req, "nickname", 0)
+	if err != nil {
+		return infer.CheckResponse[VerifiedSenderArgs]{}, err
+	}
+	req.NewInputs = newInputs
+
+	inputs, failures, err := infer.DefaultCheck[VerifiedSenderArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[VerifiedSenderArgs]{}, err
+	}
+	if len(failures) == 0 {
+		failures = inputs.validate(req.NewInputs)
+	}
+
+	return infer.CheckResponse[VerifiedSenderArgs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// validate reports invalid VerifiedSenderArgs
+func (args *VerifiedSenderArgs) validate(inputs property.Map) []p.CheckFailure {
+	v := newInputValidator(inputs)
+	v.required("nickname", args.Nickname)
+	v.email("fromEmail", args.FromEmail)
+	v.email("replyTo", args.ReplyTo)
+	v.required("address", args.Address)
+	v.required("city", args.City)
+	v.required("country", args.Country)
+	if args.WaitTimeoutSeconds != nil {
+		v.inRange("waitTimeoutSeconds", *args.WaitTimeoutSeconds, 1, 86400)
+	}
+	if args.WaitIntervalSeconds != nil {
+		v.inRange("waitIntervalSeconds", *args.WaitIntervalSeconds, 1, 3600)
+	}
+	return v.failures
+}
+
 // Create creates a new SendGrid Verified Sender.
 func (v *VerifiedSender) Create(ctx context.Context, req infer.CreateRequest[VerifiedSenderArgs]) (infer.CreateResponse[VerifiedSenderState], error) {
 	input := req.Inputs
 	preview := req.DryRun
 
-	// During preview, return placeholder state
+	// During preview, leave the ID and the outputs SendGrid assigns unset, so they are unknown
 	if preview {
 		state := VerifiedSenderState{
 			VerifiedSenderArgs: input,
@@ -158,7 +211,6 @@ func (v *VerifiedSender) Create(ctx context.Context, req infer.CreateRequest[Ver
 			Locked:             false,
 		}
 		return infer.CreateResponse[VerifiedSenderState]{
-			ID:     "[preview]",
 			Output: state,
 		}, nil
 	}
@@ -203,6 +255,16 @@ func (v *VerifiedSender) Create(ctx context.Context, req infer.CreateRequest[Ver
 	}
 
 	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.VerifiedSenderArgs, input, nil)
+	preserveInputs(&state.VerifiedSenderArgs, input, "waitForVerification", "waitTimeoutSeconds", "waitIntervalSeconds")
+
+	if err := v.awaitVerification(ctx, client, input, &state); err != nil {
+		return infer.CreateResponse[VerifiedSenderState]{
+			ID:     strconv.Itoa(result.ID),
+			Output: state,
+		}, initFailed("wait for sender verification", err)
+	}
 
 	return infer.CreateResponse[VerifiedSenderState]{
 		ID:     strconv.Itoa(result.ID),
@@ -222,11 +284,8 @@ func (v *VerifiedSender) Read(ctx context.Context, req infer.ReadRequest[Verifie
 
 	// SendGrid doesn't have a GET /verified_senders/{id} endpoint
 	// We need to list all and find the one we want
-	var listResult struct {
-		Results []verifiedSenderAPIResponse `json:"results"`
-	}
-
-	if err := client.Get(ctx, "/v3/verified_senders", &listResult); err != nil {
+	senders, err := GetCachedPages[verifiedSenderAPIResponse](ctx, client, "/v3/verified_senders", PageOptions{})
+	if err != nil {
 		return infer.ReadResponse[VerifiedSenderArgs, VerifiedSenderState]{}, fmt.Errorf("failed to list verified senders: %w", err)
 	}
 
@@ -237,9 +296,9 @@ func (v *VerifiedSender) Read(ctx context.Context, req infer.ReadRequest[Verifie
 	}
 
 	var found *verifiedSenderAPIResponse
-	for i := range listResult.Results {
-		if listResult.Results[i].ID == idInt {
-			found = &listResult.Results[i]
+	for i := range senders {
+		if senders[i].ID == idInt {
+			found = &senders[i]
 			break
 		}
 	}
@@ -250,6 +309,9 @@ func (v *VerifiedSender) Read(ctx context.Context, req infer.ReadRequest[Verifie
 	}
 
 	state := found.toState()
+	state.DeletionProtection = req.Inputs.DeletionProtection
+	normalizeArgs(&state.VerifiedSenderArgs, req.Inputs, nil)
+	preserveInputs(&state.VerifiedSenderArgs, req.Inputs, "waitForVerification", "waitTimeoutSeconds", "waitIntervalSeconds")
 	inputs := state.VerifiedSenderArgs
 
 	return infer.ReadResponse[VerifiedSenderArgs, VerifiedSenderState]{
@@ -326,14 +388,38 @@ func (v *VerifiedSender) Update(ctx context.Context, req infer.UpdateRequest[Ver
 	}
 
 	state := result.toState()
+	state.DeletionProtection = input.DeletionProtection
+	normalizeArgs(&state.VerifiedSenderArgs, input, nil)
+	preserveInputs(&state.VerifiedSenderArgs, input, "waitForVerification", "waitTimeoutSeconds", "waitIntervalSeconds")
+
+	if err := v.awaitVerification(ctx, client, input, &state); err != nil {
+		return infer.UpdateResponse[VerifiedSenderState]{Output: state}, initFailed("wait for sender verification", err)
+	}
 
 	return infer.UpdateResponse[VerifiedSenderState]{Output: state}, nil
 }
 
+// awaitVerification waits for the sender to be verified when waitForVerification is set
+// and it is not verified yet, and records the outcome in state
+func (v *VerifiedSender) awaitVerification(ctx context.Context, client SendGridAPI, input VerifiedSenderArgs, state *VerifiedSenderState) error {
+	if input.WaitForVerification == nil || !*input.WaitForVerification || state.Verified {
+		return nil
+	}
+	if err := waitForSenderVerification(ctx, client, state.SenderID, input.WaitTimeoutSeconds, input.WaitIntervalSeconds); err != nil {
+		return err
+	}
+	state.Verified = true
+	return nil
+}
+
 // Delete removes a SendGrid Verified Sender.
 func (v *VerifiedSender) Delete(ctx context.Context, req infer.DeleteRequest[VerifiedSenderState]) (infer.DeleteResponse, error) {
 	id := req.ID
 
+	if err := checkDeletionProtection(req.State.DeletionProtection, "verified sender", id); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
 	// Get the SendGrid client from context
 	client := infer.GetConfig[Config](ctx).client
 	if client == nil {
@@ -0,0 +1,86 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// DefaultWaitTimeout is how long a resource waits to become ready when waiting is
+// enabled. A shorter customTimeouts setting of the resource takes precedence.
+const DefaultWaitTimeout = 10 * time.Minute
+
+// DefaultWaitInterval is the time between readiness checks
+const DefaultWaitInterval = 30 * time.Second
+
+// waitSettings returns the timeout and polling interval a resource waits with
+func waitSettings(timeoutSeconds, intervalSeconds *int) (time.Duration, time.Duration) {
+	timeout, interval := DefaultWaitTimeout, DefaultWaitInterval
+	if timeoutSeconds != nil {
+		timeout = time.Duration(*timeoutSeconds) * time.Second
+	}
+	if intervalSeconds != nil {
+		interval = time.Duration(*intervalSeconds) * time.Second
+	}
+	return timeout, interval
+}
+
+// validateWhitelabel asks SendGrid to check the DNS records of a domain authentication
+// or link branding, given its path, e.g. /v3/whitelabel/domains/1, until they are valid
+func validateWhitelabel(ctx context.Context, client SendGridAPI, path string, timeoutSeconds, intervalSeconds *int) error {
+	timeout, interval := waitSettings(timeoutSeconds, intervalSeconds)
+	return pollUntil(ctx, interval, timeout, "DNS validation of "+path, func(ctx context.Context) (bool, error) {
+		var result struct {
+			Valid bool `json:"valid"`
+		}
+		if err := client.Post(ctx, path+"/validate", nil, &result); err != nil {
+			return false, fmt.Errorf("failed to validate DNS records: %w", err)
+		}
+		return result.Valid, nil
+	})
+}
+
+// revalidateWhitelabel asks SendGrid once to check the DNS records of a domain authentication
+// or link branding, so that reading it afterwards reports whether they are valid now. Failures
+// are only logged, as the read that follows still reports the last known validity.
+func revalidateWhitelabel(ctx context.Context, client SendGridAPI, path string) {
+	if err := client.Post(ctx, path+"/validate", nil, nil); err != nil {
+		p.GetLogger(ctx).Warningf("Failed to revalidate the DNS records of %s: %v", path, err)
+	}
+}
+
+// waitForSenderVerification waits until the sender with the given ID has been
+// verified through the link SendGrid emails to its from address
+func waitForSenderVerification(ctx context.Context, client SendGridAPI, id int, timeoutSeconds, intervalSeconds *int) error {
+	timeout, interval := waitSettings(timeoutSeconds, intervalSeconds)
+	return pollUntil(ctx, interval, timeout, "verification of sender "+strconv.Itoa(id), func(ctx context.Context) (bool, error) {
+		// The cached list would return the same answer on every check
+		senders, err := GetAllPages[verifiedSenderAPIResponse](ctx, client, "/v3/verified_senders", PageOptions{})
+		if err != nil {
+			return false, fmt.Errorf("failed to list verified senders: %w", err)
+		}
+		for _, sender := range senders {
+			if sender.ID == id {
+				return sender.Verified, nil
+			}
+		}
+		return false, fmt.Errorf("verified sender %d no longer exists", id)
+	})
+}
@@ -0,0 +1,197 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestWaitSettings(t *testing.T) {
+	t.Parallel()
+
+	timeout, interval := waitSettings(nil, nil)
+	assert.Equal(t, DefaultWaitTimeout, timeout)
+	assert.Equal(t, DefaultWaitInterval, interval)
+
+	timeout, interval = waitSettings(intPtr(60), intPtr(5))
+	assert.Equal(t, time.Minute, timeout)
+	assert.Equal(t, 5*time.Second, interval)
+}
+
+func TestValidateWhitelabel(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+	server := mockSendGridServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v3/whitelabel/domains/7/validate", r.URL.Path)
+		valid := atomic.AddInt32(&attempts, 1) == 3
+		_, _ = fmt.Fprintf(w, `{"id": 7, "valid": %t}`, valid)
+	})
+	client := NewSendGridClient("test-api-key", server.URL)
+
+	require.NoError(t, validateWhitelabel(context.Background(), client, "/v3/whitelabel/domains/7", intPtr(10), intPtr(1)))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestWaitForSenderVerification(t *testing.T) {
+	t.Parallel()
+
+	t.Run("verified", func(t *testing.T) {
+		t.Parallel()
+
+		var lists int32
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			verified := atomic.AddInt32(&lists, 1) >= 2
+			_, _ = fmt.Fprintf(w, `{"results": [{"id": 5, "verified": %t}]}`, verified)
+		})
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		require.NoError(t, waitForSenderVerification(context.Background(), client, 5, intPtr(10), intPtr(1)))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&lists))
+	})
+
+	t.Run("deleted while waiting", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"results": []}`))
+		})
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		err := waitForSenderVerification(context.Background(), client, 5, intPtr(10), intPtr(1))
+		require.EqualError(t, err, "verified sender 5 no longer exists")
+	})
+
+	t.Run("customTimeouts elapses", func(t *testing.T) {
+		t.Parallel()
+
+		server := mockSendGridServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"results": [{"id": 5, "verified": false}]}`))
+		})
+		client := NewSendGridClient("test-api-key", server.URL)
+
+		ctx, cancel := contextWithTimeout(context.Background(), 0.05)
+		defer cancel()
+		err := waitForSenderVerification(ctx, client, 5, nil, intPtr(1))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestCreate_WaitForValidation(t *testing.T) {
+	t.Parallel()
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.Method + " " + req.URL.Path {
+		case "POST /v3/whitelabel/links":
+			return fakeResponse(req, http.StatusCreated, `{"id": 9, "user_id": 1, "username": "parent", "domain": "example.com",
+				"subdomain": "url", "valid": false, "dns": {"owner_cname": {"valid": false, "type": "cname", "host": "9.example.com", "data": "sendgrid.net"}}}`), nil
+		case "POST /v3/whitelabel/links/9/validate":
+			return fakeResponse(req, http.StatusOK, `{"id": 9, "valid": true}`), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		return fakeResponse(req, http.StatusNotFound, `{}`), nil
+	})
+	server := newTestServer(t, transport, nil)
+
+	resp, err := server.Create(p.CreateRequest{
+		Urn: previewURN("LinkBranding", "links"),
+		Properties: property.NewMap(map[string]property.Value{
+			"domain":              property.New("example.com"),
+			"waitForValidation":   property.New(true),
+			"waitIntervalSeconds": property.New(1.0),
+		}),
+		Timeout: 60,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "9", resp.ID)
+	assert.True(t, resp.Properties.Get("valid").AsBool())
+	assert.True(t, resp.Properties.Get("ownerCname").AsMap().Get("valid").AsBool())
+	assert.True(t, resp.Properties.Get("waitForValidation").AsBool())
+}
+
+func TestRead_RevalidateOnRefresh(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		revalidate     bool
+		stateValid     bool
+		validateStatus int
+		expectValidate bool
+		expectValid    bool
+	}{
+		{name: "revalidates invalid records", revalidate: true, validateStatus: http.StatusOK, expectValidate: true, expectValid: true},
+		{name: "skips valid records", revalidate: true, stateValid: true, expectValid: false},
+		{name: "disabled", expectValid: false},
+		{name: "failed validation still reads", revalidate: true, validateStatus: http.StatusBadRequest, expectValidate: true, expectValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var validated, valid atomic.Bool
+			transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				switch req.Method + " " + req.URL.Path {
+				case "POST /v3/whitelabel/links/9/validate":
+					validated.Store(true)
+					valid.Store(tt.validateStatus == http.StatusOK)
+					return fakeResponse(req, tt.validateStatus, `{"id": 9, "valid": true}`), nil
+				case "GET /v3/whitelabel/links/9":
+					return fakeResponse(req, http.StatusOK, fmt.Sprintf(`{"id": 9, "domain": "example.com", "subdomain": "url", "valid": %t,
+						"dns": {"owner_cname": {"valid": %t, "type": "cname", "host": "9.example.com", "data": "sendgrid.net"}}}`,
+						valid.Load(), valid.Load())), nil
+				}
+				t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+				return fakeResponse(req, http.StatusNotFound, `{}`), nil
+			})
+			server := newTestServer(t, transport, nil)
+
+			inputs := property.NewMap(map[string]property.Value{
+				"domain":              property.New("example.com"),
+				"subdomain":           property.New("url"),
+				"revalidateOnRefresh": property.New(tt.revalidate),
+			})
+			resp, err := server.Read(p.ReadRequest{
+				ID:  "9",
+				Urn: previewURN("LinkBranding", "links"),
+				Properties: inputs.Set("linkId", property.New(9.0)).
+					Set("userId", property.New(1.0)).
+					Set("username", property.New("parent")).
+					Set("valid", property.New(tt.stateValid)).
+					Set("legacy", property.New(false)).
+					Set("dnsRecords", property.New([]property.Value{})),
+				Inputs: inputs,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectValidate, validated.Load())
+			assert.Equal(t, tt.expectValid, resp.Properties.Get("valid").AsBool())
+			assert.Equal(t, tt.revalidate, resp.Inputs.Get("revalidateOnRefresh").AsBool())
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// webhookEndpointEvents are the events a WebhookEndpoint can subscribe to, by EventWebhook input name
+var webhookEndpointEvents = []string{
+	"bounce", "click", "deferred", "delivered", "dropped", "open", "processed",
+	"spamReport", "unsubscribe", "groupResubscribe", "groupUnsubscribe",
+}
+
+// WebhookEndpoint is a component that sets up an event webhook for a receiving service:
+// the EventWebhook itself, its signature verification and a test delivery.
+type WebhookEndpoint struct {
+	pulumi.ResourceState
+
+	// WebhookID is the ID of the event webhook
+	WebhookID pulumi.StringOutput `pulumi:"webhookId"`
+
+	// PublicKey is the key the receiving service verifies event signatures with, empty without signature verification
+	PublicKey pulumi.StringOutput `pulumi:"publicKey"`
+}
+
+// WebhookEndpointArgs are the inputs to the WebhookEndpoint component.
+type WebhookEndpointArgs struct {
+	// URL is the endpoint of the receiving service (required)
+	URL pulumi.StringInput `pulumi:"url"`
+
+	// Events are the events to post, e.g. "delivered" or "spamReport" (required)
+	Events []string `pulumi:"events"`
+
+	// FriendlyName is a human-readable name for the webhook (optional)
+	FriendlyName pulumi.StringPtrInput `pulumi:"friendlyName,optional"`
+
+	// SignatureVerification signs the events posted to the webhook (optional, defaults to true)
+	SignatureVerification *bool `pulumi:"signatureVerification,optional"`
+
+	// OAuthClientID is the client ID SendGrid uses to request OAuth tokens (optional)
+	OAuthClientID pulumi.StringPtrInput `pulumi:"oauthClientId,optional"`
+
+	// OAuthClientSecret is the client secret for OAuth token requests (optional)
+	OAuthClientSecret pulumi.StringPtrInput `pulumi:"oauthClientSecret,optional" provider:"secret"`
+
+	// OAuthTokenURL is the URL SendGrid requests OAuth tokens from (optional)
+	OAuthTokenURL pulumi.StringPtrInput `pulumi:"oauthTokenUrl,optional"`
+
+	// TestDelivery sends test events once the webhook is set up and whenever it changes (optional, defaults to true)
+	TestDelivery *bool `pulumi:"testDelivery,optional"`
+
+	// DeletionProtection prevents deleting the webhook or disabling its signature verification (optional)
+	DeletionProtection pulumi.BoolPtrInput `pulumi:"deletionProtection,optional"`
+}
+
+// Annotate provides descriptions for the WebhookEndpoint component.
+func (w *WebhookEndpoint) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&w, "Sets up a SendGrid Event Webhook for a receiving service.\n\n"+
+		"The component creates an EventWebhook posting the given events, with optional OAuth settings, "+
+		"enables its signature verification and sends test events once it is set up, which the service's "+
+		"logs show it received. The webhookId and publicKey outputs are what the receiving "+
+		"service needs to verify events.")
+}
+
+// webhookEndpointChild holds the outputs of the resources registered by a WebhookEndpoint
+type webhookEndpointChild struct {
+	pulumi.CustomResourceState
+
+	WebhookID pulumi.StringOutput `pulumi:"webhookId"`
+	PublicKey pulumi.StringOutput `pulumi:"publicKey"`
+}
+
+// NewWebhookEndpoint registers a WebhookEndpoint and its children.
+func NewWebhookEndpoint(ctx *pulumi.Context, name string, args WebhookEndpointArgs, opts ...pulumi.ResourceOption) (*WebhookEndpoint, error) {
+	if len(args.Events) == 0 {
+		return nil, fmt.Errorf("events must list at least one of: %s", strings.Join(webhookEndpointEvents, ", "))
+	}
+	for _, event := range args.Events {
+		if !slices.Contains(webhookEndpointEvents, event) {
+			return nil, fmt.Errorf("events must only list: %s (got %q)", strings.Join(webhookEndpointEvents, ", "), event)
+		}
+	}
+
+	comp := &WebhookEndpoint{}
+	if err := ctx.RegisterComponentResource(p.GetTypeToken(ctx), name, comp, opts...); err != nil {
+		return nil, err
+	}
+
+	oauth := pulumi.Map{}
+	setInput(oauth, "oauthClientId", args.OAuthClientID)
+	setInput(oauth, "oauthClientSecret", args.OAuthClientSecret)
+	setInput(oauth, "oauthTokenUrl", args.OAuthTokenURL)
+
+	webhookInputs := pulumi.Map{"url": args.URL}
+	for _, event := range args.Events {
+		webhookInputs[event] = pulumi.Bool(true)
+	}
+	for k, v := range oauth {
+		webhookInputs[k] = v
+	}
+	setInput(webhookInputs, "friendlyName", args.FriendlyName)
+	setInput(webhookInputs, "deletionProtection", args.DeletionProtection)
+
+	var webhook webhookEndpointChild
+	if err := ctx.RegisterResource("sendgrid:index:EventWebhook", name, webhookInputs, &webhook, pulumi.Parent(comp)); err != nil {
+		return nil, err
+	}
+	comp.WebhookID = webhook.WebhookID
+	comp.PublicKey = pulumi.String("").ToStringOutput()
+
+	// Test events are sent once signing is enabled, so the service sees signed events
+	var dependsOn []pulumi.Resource
+	if args.SignatureVerification == nil || *args.SignatureVerification {
+		signatureInputs := pulumi.Map{"webhookId": webhook.WebhookID}
+		setInput(signatureInputs, "deletionProtection", args.DeletionProtection)
+		var signature webhookEndpointChild
+		if err := ctx.RegisterResource("sendgrid:index:EventWebhookSignature", name, signatureInputs, &signature, pulumi.Parent(comp)); err != nil {
+			return nil, err
+		}
+		comp.PublicKey = signature.PublicKey
+		dependsOn = append(dependsOn, &signature)
+	}
+
+	if args.TestDelivery == nil || *args.TestDelivery {
+		testInputs := pulumi.Map{"webhookId": webhook.WebhookID, "url": args.URL}
+		for k, v := range oauth {
+			testInputs[k] = v
+		}
+		var test webhookEndpointChild
+		if err := ctx.RegisterResource("sendgrid:index:EventWebhookTestDelivery", name, testInputs, &test,
+			pulumi.Parent(comp), pulumi.DependsOn(dependsOn)); err != nil {
+			return nil, err
+		}
+	}
+
+	return comp, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// webhookEndpointServer starts a provider whose resource registrations are mocked,
+// recording them by type token
+func webhookEndpointServer(t *testing.T) (integration.Server, func() map[string]integration.MockResourceArgs) {
+	var mu sync.Mutex
+	registered := map[string]integration.MockResourceArgs{}
+	monitor := &integration.MockResourceMonitor{
+		NewResourceF: func(args integration.MockResourceArgs) (string, property.Map, error) {
+			mu.Lock()
+			registered[string(args.TypeToken)] = args
+			mu.Unlock()
+
+			state := args.Inputs.AsMap()
+			switch args.TypeToken {
+			case "sendgrid:index:EventWebhook":
+				state["webhookId"] = property.New("wh-1")
+				return "wh-1", property.NewMap(state), nil
+			case "sendgrid:index:EventWebhookSignature":
+				state["publicKey"] = property.New("public-key")
+			}
+			return "wh-1", property.NewMap(state), nil
+		},
+	}
+
+	server := newTestServer(t, nil, nil, integration.WithMocks(monitor))
+	return server, func() map[string]integration.MockResourceArgs {
+		mu.Lock()
+		defer mu.Unlock()
+		return registered
+	}
+}
+
+func TestWebhookEndpoint_Construct(t *testing.T) {
+	t.Parallel()
+
+	server, registered := webhookEndpointServer(t)
+	resp, err := server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("stack", "project", "", "sendgrid:index:WebhookEndpoint", "events"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"url":               property.New("https://hooks.example.com/sendgrid"),
+			"events":            property.New([]property.Value{property.New("delivered"), property.New("spamReport")}),
+			"oauthClientId":     property.New("client"),
+			"oauthClientSecret": property.New("secret").WithSecret(true),
+			"oauthTokenUrl":     property.New("https://auth.example.com/token"),
+		}),
+	})
+	require.NoError(t, err)
+
+	webhook := registered()["sendgrid:index:EventWebhook"].Inputs
+	assert.True(t, webhook.Get("delivered").AsBool())
+	assert.True(t, webhook.Get("spamReport").AsBool())
+	assert.True(t, webhook.Get("bounce").IsNull())
+	assert.Equal(t, "client", webhook.Get("oauthClientId").AsString())
+
+	signature := registered()["sendgrid:index:EventWebhookSignature"].Inputs
+	assert.Equal(t, "wh-1", signature.Get("webhookId").AsString())
+
+	test := registered()["sendgrid:index:EventWebhookTestDelivery"]
+	assert.Equal(t, "wh-1", test.Inputs.Get("webhookId").AsString())
+	assert.Equal(t, "https://hooks.example.com/sendgrid", test.Inputs.Get("url").AsString())
+	assert.Equal(t, "secret", test.Inputs.Get("oauthClientSecret").AsString())
+	require.NotNil(t, test.RegisterRPC)
+	assert.Len(t, test.RegisterRPC.GetDependencies(), 2, "test events are sent after signing is enabled")
+
+	assert.Equal(t, "wh-1", resp.State.Get("webhookId").AsString())
+	assert.Equal(t, "public-key", resp.State.Get("publicKey").AsString())
+}
+
+func TestWebhookEndpoint_Optional(t *testing.T) {
+	t.Parallel()
+
+	server, registered := webhookEndpointServer(t)
+	resp, err := server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("stack", "project", "", "sendgrid:index:WebhookEndpoint", "events"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"url":                   property.New("https://hooks.example.com/sendgrid"),
+			"events":                property.New([]property.Value{property.New("bounce")}),
+			"signatureVerification": property.New(false),
+			"testDelivery":          property.New(false),
+		}),
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, registered(), "sendgrid:index:EventWebhook")
+	assert.NotContains(t, registered(), "sendgrid:index:EventWebhookSignature")
+	assert.NotContains(t, registered(), "sendgrid:index:EventWebhookTestDelivery")
+	assert.Equal(t, "", resp.State.Get("publicKey").AsString())
+
+	_, err = server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("stack", "project", "", "sendgrid:index:WebhookEndpoint", "typo"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"url":    property.New("https://hooks.example.com/sendgrid"),
+			"events": property.New([]property.Value{property.New("spam_report")}),
+		}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `got "spam_report"`)
+}
@@ -34,7 +34,7 @@ namespace Community.Pulumi.Sendgrid
 
         private static readonly __Value<string?> _apiKey = new __Value<string?>(() => __config.Get("apiKey"));
         /// <summary>
-        /// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+        /// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
         /// </summary>
         public static string? ApiKey
         {
@@ -42,9 +42,19 @@ namespace Community.Pulumi.Sendgrid
             set => _apiKey.Set(value);
         }
 
-        private static readonly __Value<string?> _baseUrl = new __Value<string?>(() => __config.Get("baseUrl") ?? "https://api.sendgrid.com");
+        private static readonly __Value<string?> _apiKeyFile = new __Value<string?>(() => __config.Get("apiKeyFile"));
         /// <summary>
-        /// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+        /// The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+        /// </summary>
+        public static string? ApiKeyFile
+        {
+            get => _apiKeyFile.Get();
+            set => _apiKeyFile.Set(value);
+        }
+
+        private static readonly __Value<string?> _baseUrl = new __Value<string?>(() => __config.Get("baseUrl"));
+        /// <summary>
+        /// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
         /// </summary>
         public static string? BaseUrl
         {
@@ -52,5 +62,235 @@ namespace Community.Pulumi.Sendgrid
             set => _baseUrl.Set(value);
         }
 
+        private static readonly __Value<int?> _circuitBreakerCooldownSeconds = new __Value<int?>(() => __config.GetInt32("circuitBreakerCooldownSeconds") ?? 30);
+        /// <summary>
+        /// How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+        /// </summary>
+        public static int? CircuitBreakerCooldownSeconds
+        {
+            get => _circuitBreakerCooldownSeconds.Get();
+            set => _circuitBreakerCooldownSeconds.Set(value);
+        }
+
+        private static readonly __Value<int?> _circuitBreakerThreshold = new __Value<int?>(() => __config.GetInt32("circuitBreakerThreshold") ?? 5);
+        /// <summary>
+        /// The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+        /// </summary>
+        public static int? CircuitBreakerThreshold
+        {
+            get => _circuitBreakerThreshold.Get();
+            set => _circuitBreakerThreshold.Set(value);
+        }
+
+        private static readonly __Value<bool?> _enableLegacyContactDb = new __Value<bool?>(() => __config.GetBoolean("enableLegacyContactDb"));
+        /// <summary>
+        /// Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+        /// </summary>
+        public static bool? EnableLegacyContactDb
+        {
+            get => _enableLegacyContactDb.Get();
+            set => _enableLegacyContactDb.Set(value);
+        }
+
+        private static readonly __Value<ImmutableDictionary<string, string>?> _headers = new __Value<ImmutableDictionary<string, string>?>(() => __config.GetObject<ImmutableDictionary<string, string>>("headers"));
+        /// <summary>
+        /// Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+        /// </summary>
+        public static ImmutableDictionary<string, string>? Headers
+        {
+            get => _headers.Get();
+            set => _headers.Set(value);
+        }
+
+        private static readonly __Value<string?> _httpProxy = new __Value<string?>(() => __config.Get("httpProxy"));
+        /// <summary>
+        /// The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+        /// </summary>
+        public static string? HttpProxy
+        {
+            get => _httpProxy.Get();
+            set => _httpProxy.Set(value);
+        }
+
+        private static readonly __Value<string?> _httpsProxy = new __Value<string?>(() => __config.Get("httpsProxy"));
+        /// <summary>
+        /// The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+        /// </summary>
+        public static string? HttpsProxy
+        {
+            get => _httpsProxy.Get();
+            set => _httpsProxy.Set(value);
+        }
+
+        private static readonly __Value<bool?> _logMetrics = new __Value<bool?>(() => __config.GetBoolean("logMetrics"));
+        /// <summary>
+        /// Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+        /// </summary>
+        public static bool? LogMetrics
+        {
+            get => _logMetrics.Get();
+            set => _logMetrics.Set(value);
+        }
+
+        private static readonly __Value<int?> _maxConcurrentRequests = new __Value<int?>(() => __config.GetInt32("maxConcurrentRequests") ?? 10);
+        /// <summary>
+        /// The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+        /// </summary>
+        public static int? MaxConcurrentRequests
+        {
+            get => _maxConcurrentRequests.Get();
+            set => _maxConcurrentRequests.Set(value);
+        }
+
+        private static readonly __Value<int?> _maxRetries = new __Value<int?>(() => __config.GetInt32("maxRetries") ?? 3);
+        /// <summary>
+        /// The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+        /// </summary>
+        public static int? MaxRetries
+        {
+            get => _maxRetries.Get();
+            set => _maxRetries.Set(value);
+        }
+
+        private static readonly __Value<string?> _noProxy = new __Value<string?>(() => __config.Get("noProxy"));
+        /// <summary>
+        /// A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+        /// </summary>
+        public static string? NoProxy
+        {
+            get => _noProxy.Get();
+            set => _noProxy.Set(value);
+        }
+
+        private static readonly __Value<string?> _onBehalfOf = new __Value<string?>(() => __config.Get("onBehalfOf"));
+        /// <summary>
+        /// The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+        /// </summary>
+        public static string? OnBehalfOf
+        {
+            get => _onBehalfOf.Get();
+            set => _onBehalfOf.Set(value);
+        }
+
+        private static readonly __Value<int?> _rateLimitBurst = new __Value<int?>(() => __config.GetInt32("rateLimitBurst"));
+        /// <summary>
+        /// The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+        /// </summary>
+        public static int? RateLimitBurst
+        {
+            get => _rateLimitBurst.Get();
+            set => _rateLimitBurst.Set(value);
+        }
+
+        private static readonly __Value<double?> _rateLimitPerSecond = new __Value<double?>(() => __config.GetDouble("rateLimitPerSecond"));
+        /// <summary>
+        /// The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+        /// </summary>
+        public static double? RateLimitPerSecond
+        {
+            get => _rateLimitPerSecond.Get();
+            set => _rateLimitPerSecond.Set(value);
+        }
+
+        private static readonly __Value<int?> _readTimeoutSeconds = new __Value<int?>(() => __config.GetInt32("readTimeoutSeconds"));
+        /// <summary>
+        /// The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+        /// </summary>
+        public static int? ReadTimeoutSeconds
+        {
+            get => _readTimeoutSeconds.Get();
+            set => _readTimeoutSeconds.Set(value);
+        }
+
+        private static readonly __Value<string?> _region = new __Value<string?>(() => __config.Get("region"));
+        /// <summary>
+        /// The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+        /// </summary>
+        public static string? Region
+        {
+            get => _region.Get();
+            set => _region.Set(value);
+        }
+
+        private static readonly __Value<int?> _requestTimeoutSeconds = new __Value<int?>(() => __config.GetInt32("requestTimeoutSeconds") ?? 30);
+        /// <summary>
+        /// The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+        /// </summary>
+        public static int? RequestTimeoutSeconds
+        {
+            get => _requestTimeoutSeconds.Get();
+            set => _requestTimeoutSeconds.Set(value);
+        }
+
+        private static readonly __Value<ImmutableArray<string>> _requiredScopes = new __Value<ImmutableArray<string>>(() => __config.GetObject<ImmutableArray<string>>("requiredScopes"));
+        /// <summary>
+        /// API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+        /// </summary>
+        public static ImmutableArray<string> RequiredScopes
+        {
+            get => _requiredScopes.Get();
+            set => _requiredScopes.Set(value);
+        }
+
+        private static readonly __Value<int?> _retryBudget = new __Value<int?>(() => __config.GetInt32("retryBudget") ?? 20);
+        /// <summary>
+        /// The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+        /// </summary>
+        public static int? RetryBudget
+        {
+            get => _retryBudget.Get();
+            set => _retryBudget.Set(value);
+        }
+
+        private static readonly __Value<string?> _statsdAddress = new __Value<string?>(() => __config.Get("statsdAddress"));
+        /// <summary>
+        /// The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.&lt;status&gt;` counters and a `sendgrid.latency` timer.
+        /// </summary>
+        public static string? StatsdAddress
+        {
+            get => _statsdAddress.Get();
+            set => _statsdAddress.Set(value);
+        }
+
+        private static readonly __Value<string?> _userAgentSuffix = new __Value<string?>(() => __config.Get("userAgentSuffix"));
+        /// <summary>
+        /// A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+        /// </summary>
+        public static string? UserAgentSuffix
+        {
+            get => _userAgentSuffix.Get();
+            set => _userAgentSuffix.Set(value);
+        }
+
+        private static readonly __Value<bool?> _validateApiKey = new __Value<bool?>(() => __config.GetBoolean("validateApiKey") ?? true);
+        /// <summary>
+        /// Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+        /// </summary>
+        public static bool? ValidateApiKey
+        {
+            get => _validateApiKey.Get();
+            set => _validateApiKey.Set(value);
+        }
+
+        private static readonly __Value<bool?> _validateOnPreview = new __Value<bool?>(() => __config.GetBoolean("validateOnPreview"));
+        /// <summary>
+        /// Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+        /// </summary>
+        public static bool? ValidateOnPreview
+        {
+            get => _validateOnPreview.Get();
+            set => _validateOnPreview.Set(value);
+        }
+
+        private static readonly __Value<int?> _writeTimeoutSeconds = new __Value<int?>(() => __config.GetInt32("writeTimeoutSeconds"));
+        /// <summary>
+        /// The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
+        /// </summary>
+        public static int? WriteTimeoutSeconds
+        {
+            get => _writeTimeoutSeconds.Get();
+            set => _writeTimeoutSeconds.Set(value);
+        }
+
     }
 }
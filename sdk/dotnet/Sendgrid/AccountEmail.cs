@@ -0,0 +1,78 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Manages the contact email address of the SendGrid account.
+    /// 
+    /// Sets the email address of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get the right contact address. There is one address per account, so only one of these resources should exist per provider. Deleting the resource leaves the address unchanged, as an account always has one.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:AccountEmail")]
+    public partial class AccountEmail : global::Pulumi.CustomResource
+    {
+        [Output("email")]
+        public Output<string> Email { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AccountEmail resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AccountEmail(string name, AccountEmailArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:AccountEmail", name, args ?? new AccountEmailArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private AccountEmail(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:AccountEmail", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing AccountEmail resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static AccountEmail Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new AccountEmail(name, id, options);
+        }
+    }
+
+    public sealed class AccountEmailArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("email", required: true)]
+        public Input<string> Email { get; set; } = null!;
+
+        public AccountEmailArgs()
+        {
+        }
+        public static new AccountEmailArgs Empty => new AccountEmailArgs();
+    }
+}
@@ -0,0 +1,120 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Rotates the password of the SendGrid account.
+    /// 
+    /// Creating the resource changes the password from oldPassword to newPassword. Each later change of newPassword rotates it again, using the previous newPassword as the old one, so account credentials can be rotated alongside API keys. Changing triggers without changing newPassword is an error.
+    /// 
+    /// SendGrid never returns passwords, so refresh cannot detect a password changed elsewhere, and deleting the resource leaves the current password in place.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:AccountPassword")]
+    public partial class AccountPassword : global::Pulumi.CustomResource
+    {
+        [Output("newPassword")]
+        public Output<string> NewPassword { get; private set; } = null!;
+
+        [Output("oldPassword")]
+        public Output<string> OldPassword { get; private set; } = null!;
+
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, string>?> Triggers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AccountPassword resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AccountPassword(string name, AccountPasswordArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:AccountPassword", name, args ?? new AccountPasswordArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private AccountPassword(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:AccountPassword", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                AdditionalSecretOutputs =
+                {
+                    "newPassword",
+                    "oldPassword",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing AccountPassword resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static AccountPassword Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new AccountPassword(name, id, options);
+        }
+    }
+
+    public sealed class AccountPasswordArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("newPassword", required: true)]
+        private Input<string>? _newPassword;
+        public Input<string>? NewPassword
+        {
+            get => _newPassword;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _newPassword = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("oldPassword", required: true)]
+        private Input<string>? _oldPassword;
+        public Input<string>? OldPassword
+        {
+            get => _oldPassword;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _oldPassword = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("triggers")]
+        private InputMap<string>? _triggers;
+        public InputMap<string> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<string>());
+            set => _triggers = value;
+        }
+
+        public AccountPasswordArgs()
+        {
+        }
+        public static new AccountPasswordArgs Empty => new AccountPasswordArgs();
+    }
+}
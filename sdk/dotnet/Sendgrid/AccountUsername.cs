@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Manages the username of the SendGrid account.
+    /// 
+    /// Sets the username of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get deterministic usernames. With validateOnPreview, previews fail when a subuser of the account already has the username. There is one username per account, so only one of these resources should exist per provider. Deleting the resource leaves the username unchanged, as an account always has one.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:AccountUsername")]
+    public partial class AccountUsername : global::Pulumi.CustomResource
+    {
+        [Output("userId")]
+        public Output<int> UserId { get; private set; } = null!;
+
+        [Output("username")]
+        public Output<string> Username { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AccountUsername resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AccountUsername(string name, AccountUsernameArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:AccountUsername", name, args ?? new AccountUsernameArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private AccountUsername(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:AccountUsername", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing AccountUsername resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static AccountUsername Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new AccountUsername(name, id, options);
+        }
+    }
+
+    public sealed class AccountUsernameArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("username", required: true)]
+        public Input<string> Username { get; set; } = null!;
+
+        public AccountUsernameArgs()
+        {
+        }
+        public static new AccountUsernameArgs Empty => new AccountUsernameArgs();
+    }
+}
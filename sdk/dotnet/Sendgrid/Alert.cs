@@ -19,16 +19,24 @@ namespace Community.Pulumi.Sendgrid
     /// 2. **stats_notification**: Sends periodic email statistics (daily, weekly, or monthly).
     /// 
     /// You can create multiple alerts of the same type with different email recipients.
+    /// 
+    /// SendGrid does not reject duplicate alerts, so with adoptExisting an identical alert is looked up before creating one, and a retried deployment takes it over instead of creating a second alert.
     /// </summary>
     [SendgridResourceType("sendgrid:index:Alert")]
     public partial class Alert : global::Pulumi.CustomResource
     {
+        [Output("adoptExisting")]
+        public Output<bool?> AdoptExisting { get; private set; } = null!;
+
         [Output("alertId")]
         public Output<int> AlertId { get; private set; } = null!;
 
         [Output("createdAt")]
         public Output<int> CreatedAt { get; private set; } = null!;
 
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("emailTo")]
         public Output<string> EmailTo { get; private set; } = null!;
 
@@ -68,6 +76,10 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "type",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -90,6 +102,12 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class AlertArgs : global::Pulumi.ResourceArgs
     {
+        [Input("adoptExisting")]
+        public Input<bool>? AdoptExisting { get; set; }
+
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("emailTo", required: true)]
         public Input<string> EmailTo { get; set; } = null!;
 
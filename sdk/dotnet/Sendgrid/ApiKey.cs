@@ -26,8 +26,11 @@ namespace Community.Pulumi.Sendgrid
         [Output("apiKeyValue")]
         public Output<string?> ApiKeyValue { get; private set; } = null!;
 
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("name")]
-        public Output<string> Name { get; private set; } = null!;
+        public Output<string?> Name { get; private set; } = null!;
 
         [Output("scopes")]
         public Output<ImmutableArray<string>> Scopes { get; private set; } = null!;
@@ -40,7 +43,7 @@ namespace Community.Pulumi.Sendgrid
         /// <param name="name">The unique name of the resource</param>
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
-        public ApiKey(string name, ApiKeyArgs args, CustomResourceOptions? options = null)
+        public ApiKey(string name, ApiKeyArgs? args = null, CustomResourceOptions? options = null)
             : base("sendgrid:index:ApiKey", name, args ?? new ApiKeyArgs(), MakeResourceOptions(options, ""))
         {
         }
@@ -82,8 +85,11 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class ApiKeyArgs : global::Pulumi.ResourceArgs
     {
-        [Input("name", required: true)]
-        public Input<string> Name { get; set; } = null!;
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("name")]
+        public Input<string>? Name { get; set; }
 
         [Input("scopes")]
         private InputList<string>? _scopes;
@@ -0,0 +1,97 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Authenticates a SendGrid sending domain and brands its links.
+    /// 
+    /// The component creates a DomainAuthentication and a LinkBranding for the same domain, as most sending domains need both, and exposes their DNS records as a single list. valid is true once both have been validated; set waitForValidation to wait for it during the deployment.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:AuthenticatedDomain")]
+    public partial class AuthenticatedDomain : global::Pulumi.ComponentResource
+    {
+        [Output("dnsRecords")]
+        public Output<ImmutableArray<Outputs.DomainDNSRecord>> DnsRecords { get; private set; } = null!;
+
+        [Output("domainId")]
+        public Output<int> DomainId { get; private set; } = null!;
+
+        [Output("linkId")]
+        public Output<int> LinkId { get; private set; } = null!;
+
+        [Output("providerRecords")]
+        public Output<ImmutableArray<Outputs.DNSProviderRecord>> ProviderRecords { get; private set; } = null!;
+
+        [Output("valid")]
+        public Output<bool> Valid { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AuthenticatedDomain resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AuthenticatedDomain(string name, AuthenticatedDomainArgs args, ComponentResourceOptions? options = null)
+            : base("sendgrid:index:AuthenticatedDomain", name, args ?? new AuthenticatedDomainArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class AuthenticatedDomainArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("automaticSecurity")]
+        public Input<bool>? AutomaticSecurity { get; set; }
+
+        [Input("default")]
+        public Input<bool>? Default { get; set; }
+
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        [Input("linkSubdomain")]
+        public Input<string>? LinkSubdomain { get; set; }
+
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
+        [Input("subdomain")]
+        public Input<string>? Subdomain { get; set; }
+
+        [Input("waitForValidation")]
+        public Input<bool>? WaitForValidation { get; set; }
+
+        [Input("waitTimeoutSeconds")]
+        public Input<int>? WaitTimeoutSeconds { get; set; }
+
+        public AuthenticatedDomainArgs()
+        {
+        }
+        public static new AuthenticatedDomainArgs Empty => new AuthenticatedDomainArgs();
+    }
+}
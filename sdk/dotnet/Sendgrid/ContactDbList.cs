@@ -0,0 +1,90 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Manages a list in the SendGrid Legacy Marketing Campaigns contact database.
+    /// 
+    /// Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. Deleting the list leaves its recipients in the contact database.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:ContactDbList")]
+    public partial class ContactDbList : global::Pulumi.CustomResource
+    {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("listId")]
+        public Output<int> ListId { get; private set; } = null!;
+
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        [Output("recipientCount")]
+        public Output<int> RecipientCount { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ContactDbList resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ContactDbList(string name, ContactDbListArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:ContactDbList", name, args ?? new ContactDbListArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ContactDbList(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:ContactDbList", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ContactDbList resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ContactDbList Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ContactDbList(name, id, options);
+        }
+    }
+
+    public sealed class ContactDbListArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        public ContactDbListArgs()
+        {
+        }
+        public static new ContactDbListArgs Empty => new ContactDbListArgs();
+    }
+}
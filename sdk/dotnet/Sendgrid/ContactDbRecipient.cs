@@ -0,0 +1,114 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Manages a recipient in the SendGrid Legacy Marketing Campaigns contact database.
+    /// 
+    /// Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. The recipient is added to the lists in `listIds`, e.g. the `listId` of a `ContactDbList`, and removed from lists dropped from it.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:ContactDbRecipient")]
+    public partial class ContactDbRecipient : global::Pulumi.CustomResource
+    {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("email")]
+        public Output<string> Email { get; private set; } = null!;
+
+        [Output("firstName")]
+        public Output<string?> FirstName { get; private set; } = null!;
+
+        [Output("lastName")]
+        public Output<string?> LastName { get; private set; } = null!;
+
+        [Output("listIds")]
+        public Output<ImmutableArray<int>> ListIds { get; private set; } = null!;
+
+        [Output("recipientId")]
+        public Output<string> RecipientId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ContactDbRecipient resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ContactDbRecipient(string name, ContactDbRecipientArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:ContactDbRecipient", name, args ?? new ContactDbRecipientArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ContactDbRecipient(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:ContactDbRecipient", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "email",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ContactDbRecipient resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ContactDbRecipient Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ContactDbRecipient(name, id, options);
+        }
+    }
+
+    public sealed class ContactDbRecipientArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("email", required: true)]
+        public Input<string> Email { get; set; } = null!;
+
+        [Input("firstName")]
+        public Input<string>? FirstName { get; set; }
+
+        [Input("lastName")]
+        public Input<string>? LastName { get; set; }
+
+        [Input("listIds")]
+        private InputList<int>? _listIds;
+        public InputList<int> ListIds
+        {
+            get => _listIds ?? (_listIds = new InputList<int>());
+            set => _listIds = value;
+        }
+
+        public ContactDbRecipientArgs()
+        {
+        }
+        public static new ContactDbRecipientArgs Empty => new ContactDbRecipientArgs();
+    }
+}
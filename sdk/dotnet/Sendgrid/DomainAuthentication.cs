@@ -20,6 +20,9 @@ namespace Community.Pulumi.Sendgrid
     [SendgridResourceType("sendgrid:index:DomainAuthentication")]
     public partial class DomainAuthentication : global::Pulumi.CustomResource
     {
+        [Output("adoptExisting")]
+        public Output<bool?> AdoptExisting { get; private set; } = null!;
+
         [Output("automaticSecurity")]
         public Output<bool?> AutomaticSecurity { get; private set; } = null!;
 
@@ -32,12 +35,18 @@ namespace Community.Pulumi.Sendgrid
         [Output("default")]
         public Output<bool?> Default { get; private set; } = null!;
 
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("dkim1")]
         public Output<Outputs.DNSRecord?> Dkim1 { get; private set; } = null!;
 
         [Output("dkim2")]
         public Output<Outputs.DNSRecord?> Dkim2 { get; private set; } = null!;
 
+        [Output("dnsRecords")]
+        public Output<ImmutableArray<Outputs.DomainDNSRecord>> DnsRecords { get; private set; } = null!;
+
         [Output("domain")]
         public Output<string> Domain { get; private set; } = null!;
 
@@ -53,9 +62,15 @@ namespace Community.Pulumi.Sendgrid
         [Output("mailCname")]
         public Output<Outputs.DNSRecord?> MailCname { get; private set; } = null!;
 
+        [Output("providerRecords")]
+        public Output<ImmutableArray<Outputs.DNSProviderRecord>> ProviderRecords { get; private set; } = null!;
+
         [Output("region")]
         public Output<string?> Region { get; private set; } = null!;
 
+        [Output("revalidateOnRefresh")]
+        public Output<bool?> RevalidateOnRefresh { get; private set; } = null!;
+
         [Output("subdomain")]
         public Output<string?> Subdomain { get; private set; } = null!;
 
@@ -68,6 +83,15 @@ namespace Community.Pulumi.Sendgrid
         [Output("valid")]
         public Output<bool> Valid { get; private set; } = null!;
 
+        [Output("waitForValidation")]
+        public Output<bool?> WaitForValidation { get; private set; } = null!;
+
+        [Output("waitIntervalSeconds")]
+        public Output<int?> WaitIntervalSeconds { get; private set; } = null!;
+
+        [Output("waitTimeoutSeconds")]
+        public Output<int?> WaitTimeoutSeconds { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a DomainAuthentication resource with the given unique name, arguments, and options.
@@ -92,6 +116,11 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "domain",
+                    "subdomain",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -114,6 +143,9 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class DomainAuthenticationArgs : global::Pulumi.ResourceArgs
     {
+        [Input("adoptExisting")]
+        public Input<bool>? AdoptExisting { get; set; }
+
         [Input("automaticSecurity")]
         public Input<bool>? AutomaticSecurity { get; set; }
 
@@ -126,6 +158,9 @@ namespace Community.Pulumi.Sendgrid
         [Input("default")]
         public Input<bool>? Default { get; set; }
 
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("domain", required: true)]
         public Input<string> Domain { get; set; } = null!;
 
@@ -140,9 +175,21 @@ namespace Community.Pulumi.Sendgrid
         [Input("region")]
         public Input<string>? Region { get; set; }
 
+        [Input("revalidateOnRefresh")]
+        public Input<bool>? RevalidateOnRefresh { get; set; }
+
         [Input("subdomain")]
         public Input<string>? Subdomain { get; set; }
 
+        [Input("waitForValidation")]
+        public Input<bool>? WaitForValidation { get; set; }
+
+        [Input("waitIntervalSeconds")]
+        public Input<int>? WaitIntervalSeconds { get; set; }
+
+        [Input("waitTimeoutSeconds")]
+        public Input<int>? WaitTimeoutSeconds { get; set; }
+
         public DomainAuthenticationArgs()
         {
         }
@@ -15,11 +15,14 @@ namespace Community.Pulumi.Sendgrid
     /// 
     /// Event Webhooks allow you to receive HTTP POST notifications when email events occur, such as delivery, opens, clicks, bounces, and more. Configure the URL endpoint and select which events to track.
     /// 
-    /// Note: Only one webhook can be configured per URL. Signature verification must be configured separately after webhook creation.
+    /// Note: Only one webhook can be configured per URL. Signature verification is enabled with the EventWebhookSignature resource.
     /// </summary>
     [SendgridResourceType("sendgrid:index:EventWebhook")]
     public partial class EventWebhook : global::Pulumi.CustomResource
     {
+        [Output("adoptExisting")]
+        public Output<bool?> AdoptExisting { get; private set; } = null!;
+
         [Output("bounce")]
         public Output<bool?> Bounce { get; private set; } = null!;
 
@@ -29,6 +32,9 @@ namespace Community.Pulumi.Sendgrid
         [Output("deferred")]
         public Output<bool?> Deferred { get; private set; } = null!;
 
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("delivered")]
         public Output<bool?> Delivered { get; private set; } = null!;
 
@@ -47,6 +53,15 @@ namespace Community.Pulumi.Sendgrid
         [Output("groupUnsubscribe")]
         public Output<bool?> GroupUnsubscribe { get; private set; } = null!;
 
+        [Output("oauthClientId")]
+        public Output<string?> OauthClientId { get; private set; } = null!;
+
+        [Output("oauthClientSecret")]
+        public Output<string?> OauthClientSecret { get; private set; } = null!;
+
+        [Output("oauthTokenUrl")]
+        public Output<string?> OauthTokenUrl { get; private set; } = null!;
+
         [Output("open")]
         public Output<bool?> Open { get; private set; } = null!;
 
@@ -62,6 +77,9 @@ namespace Community.Pulumi.Sendgrid
         [Output("url")]
         public Output<string> Url { get; private set; } = null!;
 
+        [Output("verifyUrlReachable")]
+        public Output<bool?> VerifyUrlReachable { get; private set; } = null!;
+
         [Output("webhookId")]
         public Output<string> WebhookId { get; private set; } = null!;
 
@@ -89,6 +107,10 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                AdditionalSecretOutputs =
+                {
+                    "oauthClientSecret",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -111,6 +133,9 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class EventWebhookArgs : global::Pulumi.ResourceArgs
     {
+        [Input("adoptExisting")]
+        public Input<bool>? AdoptExisting { get; set; }
+
         [Input("bounce")]
         public Input<bool>? Bounce { get; set; }
 
@@ -120,6 +145,9 @@ namespace Community.Pulumi.Sendgrid
         [Input("deferred")]
         public Input<bool>? Deferred { get; set; }
 
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("delivered")]
         public Input<bool>? Delivered { get; set; }
 
@@ -138,6 +166,24 @@ namespace Community.Pulumi.Sendgrid
         [Input("groupUnsubscribe")]
         public Input<bool>? GroupUnsubscribe { get; set; }
 
+        [Input("oauthClientId")]
+        public Input<string>? OauthClientId { get; set; }
+
+        [Input("oauthClientSecret")]
+        private Input<string>? _oauthClientSecret;
+        public Input<string>? OauthClientSecret
+        {
+            get => _oauthClientSecret;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _oauthClientSecret = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("oauthTokenUrl")]
+        public Input<string>? OauthTokenUrl { get; set; }
+
         [Input("open")]
         public Input<bool>? Open { get; set; }
 
@@ -153,6 +199,9 @@ namespace Community.Pulumi.Sendgrid
         [Input("url", required: true)]
         public Input<string> Url { get; set; } = null!;
 
+        [Input("verifyUrlReachable")]
+        public Input<bool>? VerifyUrlReachable { get; set; }
+
         public EventWebhookArgs()
         {
         }
@@ -0,0 +1,91 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Enables signature verification of a SendGrid Event Webhook.
+    /// 
+    /// SendGrid then signs each request to the webhook, so the receiving service can verify events with the publicKey output. The resource ID is the webhook ID. Deleting the resource disables signing; enabling it again generates a new key pair.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:EventWebhookSignature")]
+    public partial class EventWebhookSignature : global::Pulumi.CustomResource
+    {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("publicKey")]
+        public Output<string> PublicKey { get; private set; } = null!;
+
+        [Output("webhookId")]
+        public Output<string> WebhookId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a EventWebhookSignature resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public EventWebhookSignature(string name, EventWebhookSignatureArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:EventWebhookSignature", name, args ?? new EventWebhookSignatureArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private EventWebhookSignature(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:EventWebhookSignature", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "webhookId",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing EventWebhookSignature resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static EventWebhookSignature Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new EventWebhookSignature(name, id, options);
+        }
+    }
+
+    public sealed class EventWebhookSignatureArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("webhookId", required: true)]
+        public Input<string> WebhookId { get; set; } = null!;
+
+        public EventWebhookSignatureArgs()
+        {
+        }
+        public static new EventWebhookSignatureArgs Empty => new EventWebhookSignatureArgs();
+    }
+}
@@ -0,0 +1,130 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Sends test events to a SendGrid Event Webhook.
+    /// 
+    /// SendGrid posts its sample events to the webhook when the resource is created and again whenever any input changes, including triggers. The deployment fails if SendGrid refuses to send them, e.g. for an unknown webhook, but SendGrid does not report how the receiving service responded, so check the service's logs to see whether it accepted them. Deleting the resource sends nothing.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:EventWebhookTestDelivery")]
+    public partial class EventWebhookTestDelivery : global::Pulumi.CustomResource
+    {
+        [Output("oauthClientId")]
+        public Output<string?> OauthClientId { get; private set; } = null!;
+
+        [Output("oauthClientSecret")]
+        public Output<string?> OauthClientSecret { get; private set; } = null!;
+
+        [Output("oauthTokenUrl")]
+        public Output<string?> OauthTokenUrl { get; private set; } = null!;
+
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, string>?> Triggers { get; private set; } = null!;
+
+        [Output("url")]
+        public Output<string> Url { get; private set; } = null!;
+
+        [Output("webhookId")]
+        public Output<string> WebhookId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a EventWebhookTestDelivery resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public EventWebhookTestDelivery(string name, EventWebhookTestDeliveryArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:EventWebhookTestDelivery", name, args ?? new EventWebhookTestDeliveryArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private EventWebhookTestDelivery(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:EventWebhookTestDelivery", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                AdditionalSecretOutputs =
+                {
+                    "oauthClientSecret",
+                },
+                ReplaceOnChanges =
+                {
+                    "webhookId",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing EventWebhookTestDelivery resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static EventWebhookTestDelivery Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new EventWebhookTestDelivery(name, id, options);
+        }
+    }
+
+    public sealed class EventWebhookTestDeliveryArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("oauthClientId")]
+        public Input<string>? OauthClientId { get; set; }
+
+        [Input("oauthClientSecret")]
+        private Input<string>? _oauthClientSecret;
+        public Input<string>? OauthClientSecret
+        {
+            get => _oauthClientSecret;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _oauthClientSecret = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("oauthTokenUrl")]
+        public Input<string>? OauthTokenUrl { get; set; }
+
+        [Input("triggers")]
+        private InputMap<string>? _triggers;
+        public InputMap<string> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<string>());
+            set => _triggers = value;
+        }
+
+        [Input("url", required: true)]
+        public Input<string> Url { get; set; } = null!;
+
+        [Input("webhookId", required: true)]
+        public Input<string> WebhookId { get; set; } = null!;
+
+        public EventWebhookTestDeliveryArgs()
+        {
+        }
+        public static new EventWebhookTestDeliveryArgs Empty => new EventWebhookTestDeliveryArgs();
+    }
+}
@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GenerateBatchId
+    {
+        /// <summary>
+        /// Generates a new mail batch ID.
+        /// 
+        /// Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend resource to pause or cancel them. Every call mints a new ID, including during previews, so store the ID, e.g. in stack configuration, when it must stay the same across deployments.
+        /// </summary>
+        public static Task<GenerateBatchIdResult> InvokeAsync(GenerateBatchIdArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GenerateBatchIdResult>("sendgrid:index:generateBatchId", args ?? new GenerateBatchIdArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Generates a new mail batch ID.
+        /// 
+        /// Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend resource to pause or cancel them. Every call mints a new ID, including during previews, so store the ID, e.g. in stack configuration, when it must stay the same across deployments.
+        /// </summary>
+        public static Output<GenerateBatchIdResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GenerateBatchIdResult>("sendgrid:index:generateBatchId", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Generates a new mail batch ID.
+        /// 
+        /// Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend resource to pause or cancel them. Every call mints a new ID, including during previews, so store the ID, e.g. in stack configuration, when it must stay the same across deployments.
+        /// </summary>
+        public static Output<GenerateBatchIdResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GenerateBatchIdResult>("sendgrid:index:generateBatchId", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GenerateBatchIdArgs : global::Pulumi.InvokeArgs
+    {
+        public GenerateBatchIdArgs()
+        {
+        }
+        public static new GenerateBatchIdArgs Empty => new GenerateBatchIdArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GenerateBatchIdResult
+    {
+        public readonly string BatchId;
+
+        [OutputConstructor]
+        private GenerateBatchIdResult(string batchId)
+        {
+            BatchId = batchId;
+        }
+    }
+}
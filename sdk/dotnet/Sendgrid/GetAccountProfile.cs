@@ -0,0 +1,110 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetAccountProfile
+    {
+        /// <summary>
+        /// Gets the plan type, sender reputation and profile of the SendGrid account.
+        /// 
+        /// Useful for conditional logic, e.g. to skip resources such as subusers and IP pools that free plans do not support.
+        /// </summary>
+        public static Task<GetAccountProfileResult> InvokeAsync(GetAccountProfileArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetAccountProfileResult>("sendgrid:index:getAccountProfile", args ?? new GetAccountProfileArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Gets the plan type, sender reputation and profile of the SendGrid account.
+        /// 
+        /// Useful for conditional logic, e.g. to skip resources such as subusers and IP pools that free plans do not support.
+        /// </summary>
+        public static Output<GetAccountProfileResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAccountProfileResult>("sendgrid:index:getAccountProfile", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Gets the plan type, sender reputation and profile of the SendGrid account.
+        /// 
+        /// Useful for conditional logic, e.g. to skip resources such as subusers and IP pools that free plans do not support.
+        /// </summary>
+        public static Output<GetAccountProfileResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAccountProfileResult>("sendgrid:index:getAccountProfile", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetAccountProfileArgs : global::Pulumi.InvokeArgs
+    {
+        public GetAccountProfileArgs()
+        {
+        }
+        public static new GetAccountProfileArgs Empty => new GetAccountProfileArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetAccountProfileResult
+    {
+        public readonly string Address;
+        public readonly string Address2;
+        public readonly string City;
+        public readonly string Company;
+        public readonly string Country;
+        public readonly string FirstName;
+        public readonly string LastName;
+        public readonly string Phone;
+        public readonly double Reputation;
+        public readonly string State;
+        public readonly string Type;
+        public readonly string Website;
+        public readonly string Zip;
+
+        [OutputConstructor]
+        private GetAccountProfileResult(
+            string address,
+
+            string address2,
+
+            string city,
+
+            string company,
+
+            string country,
+
+            string firstName,
+
+            string lastName,
+
+            string phone,
+
+            double reputation,
+
+            string state,
+
+            string type,
+
+            string website,
+
+            string zip)
+        {
+            Address = address;
+            Address2 = address2;
+            City = city;
+            Company = company;
+            Country = country;
+            FirstName = firstName;
+            LastName = lastName;
+            Phone = phone;
+            Reputation = reputation;
+            State = state;
+            Type = type;
+            Website = website;
+            Zip = zip;
+        }
+    }
+}
@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetAlerts
+    {
+        /// <summary>
+        /// Lists all SendGrid Alerts configured on the account.
+        /// 
+        /// Returns the type, recipient, percentage and frequency of every alert. Use the `alertId` of an entry to import an existing alert with `pulumi import`.
+        /// </summary>
+        public static Task<GetAlertsResult> InvokeAsync(GetAlertsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetAlertsResult>("sendgrid:index:getAlerts", args ?? new GetAlertsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists all SendGrid Alerts configured on the account.
+        /// 
+        /// Returns the type, recipient, percentage and frequency of every alert. Use the `alertId` of an entry to import an existing alert with `pulumi import`.
+        /// </summary>
+        public static Output<GetAlertsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAlertsResult>("sendgrid:index:getAlerts", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists all SendGrid Alerts configured on the account.
+        /// 
+        /// Returns the type, recipient, percentage and frequency of every alert. Use the `alertId` of an entry to import an existing alert with `pulumi import`.
+        /// </summary>
+        public static Output<GetAlertsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAlertsResult>("sendgrid:index:getAlerts", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetAlertsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetAlertsArgs()
+        {
+        }
+        public static new GetAlertsArgs Empty => new GetAlertsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetAlertsResult
+    {
+        public readonly ImmutableArray<Outputs.AlertSummary> Alerts;
+
+        [OutputConstructor]
+        private GetAlertsResult(ImmutableArray<Outputs.AlertSummary> alerts)
+        {
+            Alerts = alerts;
+        }
+    }
+}
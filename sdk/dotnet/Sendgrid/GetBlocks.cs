@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetBlocks
+    {
+        /// <summary>
+        /// Lists the email addresses on the SendGrid blocks list.
+        /// 
+        /// Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. Optionally filter by the Unix time range in which the block occurred.
+        /// </summary>
+        public static Task<GetBlocksResult> InvokeAsync(GetBlocksArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetBlocksResult>("sendgrid:index:getBlocks", args ?? new GetBlocksArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid blocks list.
+        /// 
+        /// Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. Optionally filter by the Unix time range in which the block occurred.
+        /// </summary>
+        public static Output<GetBlocksResult> Invoke(GetBlocksInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetBlocksResult>("sendgrid:index:getBlocks", args ?? new GetBlocksInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid blocks list.
+        /// 
+        /// Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. Optionally filter by the Unix time range in which the block occurred.
+        /// </summary>
+        public static Output<GetBlocksResult> Invoke(GetBlocksInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetBlocksResult>("sendgrid:index:getBlocks", args ?? new GetBlocksInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetBlocksArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public int? EndTime { get; set; }
+
+        [Input("startTime")]
+        public int? StartTime { get; set; }
+
+        public GetBlocksArgs()
+        {
+        }
+        public static new GetBlocksArgs Empty => new GetBlocksArgs();
+    }
+
+    public sealed class GetBlocksInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public Input<int>? EndTime { get; set; }
+
+        [Input("startTime")]
+        public Input<int>? StartTime { get; set; }
+
+        public GetBlocksInvokeArgs()
+        {
+        }
+        public static new GetBlocksInvokeArgs Empty => new GetBlocksInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetBlocksResult
+    {
+        public readonly ImmutableArray<Outputs.BlockEntry> Blocks;
+
+        [OutputConstructor]
+        private GetBlocksResult(ImmutableArray<Outputs.BlockEntry> blocks)
+        {
+            Blocks = blocks;
+        }
+    }
+}
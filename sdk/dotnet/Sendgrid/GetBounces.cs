@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetBounces
+    {
+        /// <summary>
+        /// Lists the email addresses on the SendGrid bounce list.
+        /// 
+        /// Optionally filter by the Unix time range in which the bounce occurred. Useful for cleanup jobs and audits without a second client library.
+        /// </summary>
+        public static Task<GetBouncesResult> InvokeAsync(GetBouncesArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetBouncesResult>("sendgrid:index:getBounces", args ?? new GetBouncesArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid bounce list.
+        /// 
+        /// Optionally filter by the Unix time range in which the bounce occurred. Useful for cleanup jobs and audits without a second client library.
+        /// </summary>
+        public static Output<GetBouncesResult> Invoke(GetBouncesInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetBouncesResult>("sendgrid:index:getBounces", args ?? new GetBouncesInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid bounce list.
+        /// 
+        /// Optionally filter by the Unix time range in which the bounce occurred. Useful for cleanup jobs and audits without a second client library.
+        /// </summary>
+        public static Output<GetBouncesResult> Invoke(GetBouncesInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetBouncesResult>("sendgrid:index:getBounces", args ?? new GetBouncesInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetBouncesArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public int? EndTime { get; set; }
+
+        [Input("startTime")]
+        public int? StartTime { get; set; }
+
+        public GetBouncesArgs()
+        {
+        }
+        public static new GetBouncesArgs Empty => new GetBouncesArgs();
+    }
+
+    public sealed class GetBouncesInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public Input<int>? EndTime { get; set; }
+
+        [Input("startTime")]
+        public Input<int>? StartTime { get; set; }
+
+        public GetBouncesInvokeArgs()
+        {
+        }
+        public static new GetBouncesInvokeArgs Empty => new GetBouncesInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetBouncesResult
+    {
+        public readonly ImmutableArray<Outputs.BounceEntry> Bounces;
+
+        [OutputConstructor]
+        private GetBouncesResult(ImmutableArray<Outputs.BounceEntry> bounces)
+        {
+            Bounces = bounces;
+        }
+    }
+}
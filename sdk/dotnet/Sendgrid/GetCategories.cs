@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetCategories
+    {
+        /// <summary>
+        /// Lists the categories used on the SendGrid account.
+        /// 
+        /// Optionally filter by name prefix. Useful for validating category names used in templates and campaigns at plan time.
+        /// </summary>
+        public static Task<GetCategoriesResult> InvokeAsync(GetCategoriesArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetCategoriesResult>("sendgrid:index:getCategories", args ?? new GetCategoriesArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the categories used on the SendGrid account.
+        /// 
+        /// Optionally filter by name prefix. Useful for validating category names used in templates and campaigns at plan time.
+        /// </summary>
+        public static Output<GetCategoriesResult> Invoke(GetCategoriesInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCategoriesResult>("sendgrid:index:getCategories", args ?? new GetCategoriesInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the categories used on the SendGrid account.
+        /// 
+        /// Optionally filter by name prefix. Useful for validating category names used in templates and campaigns at plan time.
+        /// </summary>
+        public static Output<GetCategoriesResult> Invoke(GetCategoriesInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCategoriesResult>("sendgrid:index:getCategories", args ?? new GetCategoriesInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetCategoriesArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("search")]
+        public string? Search { get; set; }
+
+        public GetCategoriesArgs()
+        {
+        }
+        public static new GetCategoriesArgs Empty => new GetCategoriesArgs();
+    }
+
+    public sealed class GetCategoriesInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("search")]
+        public Input<string>? Search { get; set; }
+
+        public GetCategoriesInvokeArgs()
+        {
+        }
+        public static new GetCategoriesInvokeArgs Empty => new GetCategoriesInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetCategoriesResult
+    {
+        public readonly ImmutableArray<string> Categories;
+
+        [OutputConstructor]
+        private GetCategoriesResult(ImmutableArray<string> categories)
+        {
+            Categories = categories;
+        }
+    }
+}
@@ -0,0 +1,103 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetCategoryStats
+    {
+        /// <summary>
+        /// Retrieves email statistics for specific SendGrid categories.
+        /// 
+        /// Returns metrics for each requested category (up to 10) and each day, week or month in the date range, for per-product email reporting.
+        /// </summary>
+        public static Task<GetCategoryStatsResult> InvokeAsync(GetCategoryStatsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetCategoryStatsResult>("sendgrid:index:getCategoryStats", args ?? new GetCategoryStatsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves email statistics for specific SendGrid categories.
+        /// 
+        /// Returns metrics for each requested category (up to 10) and each day, week or month in the date range, for per-product email reporting.
+        /// </summary>
+        public static Output<GetCategoryStatsResult> Invoke(GetCategoryStatsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCategoryStatsResult>("sendgrid:index:getCategoryStats", args ?? new GetCategoryStatsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves email statistics for specific SendGrid categories.
+        /// 
+        /// Returns metrics for each requested category (up to 10) and each day, week or month in the date range, for per-product email reporting.
+        /// </summary>
+        public static Output<GetCategoryStatsResult> Invoke(GetCategoryStatsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCategoryStatsResult>("sendgrid:index:getCategoryStats", args ?? new GetCategoryStatsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetCategoryStatsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("aggregatedBy")]
+        public string? AggregatedBy { get; set; }
+
+        [Input("categories", required: true)]
+        private List<string>? _categories;
+        public List<string> Categories
+        {
+            get => _categories ?? (_categories = new List<string>());
+            set => _categories = value;
+        }
+
+        [Input("endDate")]
+        public string? EndDate { get; set; }
+
+        [Input("startDate", required: true)]
+        public string StartDate { get; set; } = null!;
+
+        public GetCategoryStatsArgs()
+        {
+        }
+        public static new GetCategoryStatsArgs Empty => new GetCategoryStatsArgs();
+    }
+
+    public sealed class GetCategoryStatsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("aggregatedBy")]
+        public Input<string>? AggregatedBy { get; set; }
+
+        [Input("categories", required: true)]
+        private InputList<string>? _categories;
+        public InputList<string> Categories
+        {
+            get => _categories ?? (_categories = new InputList<string>());
+            set => _categories = value;
+        }
+
+        [Input("endDate")]
+        public Input<string>? EndDate { get; set; }
+
+        [Input("startDate", required: true)]
+        public Input<string> StartDate { get; set; } = null!;
+
+        public GetCategoryStatsInvokeArgs()
+        {
+        }
+        public static new GetCategoryStatsInvokeArgs Empty => new GetCategoryStatsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetCategoryStatsResult
+    {
+        public readonly ImmutableArray<Outputs.StatsEntry> Stats;
+
+        [OutputConstructor]
+        private GetCategoryStatsResult(ImmutableArray<Outputs.StatsEntry> stats)
+        {
+            Stats = stats;
+        }
+    }
+}
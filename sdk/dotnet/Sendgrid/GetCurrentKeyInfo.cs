@@ -0,0 +1,94 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetCurrentKeyInfo
+    {
+        /// <summary>
+        /// Reports the scopes of the API key the provider is configured with.
+        /// 
+        /// The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` to fail with a clear error when the key cannot manage the resources a program declares, e.g. ["templates.create", "templates.update"].
+        /// </summary>
+        public static Task<GetCurrentKeyInfoResult> InvokeAsync(GetCurrentKeyInfoArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetCurrentKeyInfoResult>("sendgrid:index:getCurrentKeyInfo", args ?? new GetCurrentKeyInfoArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Reports the scopes of the API key the provider is configured with.
+        /// 
+        /// The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` to fail with a clear error when the key cannot manage the resources a program declares, e.g. ["templates.create", "templates.update"].
+        /// </summary>
+        public static Output<GetCurrentKeyInfoResult> Invoke(GetCurrentKeyInfoInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCurrentKeyInfoResult>("sendgrid:index:getCurrentKeyInfo", args ?? new GetCurrentKeyInfoInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Reports the scopes of the API key the provider is configured with.
+        /// 
+        /// The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` to fail with a clear error when the key cannot manage the resources a program declares, e.g. ["templates.create", "templates.update"].
+        /// </summary>
+        public static Output<GetCurrentKeyInfoResult> Invoke(GetCurrentKeyInfoInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCurrentKeyInfoResult>("sendgrid:index:getCurrentKeyInfo", args ?? new GetCurrentKeyInfoInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetCurrentKeyInfoArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("requiredScopes")]
+        private List<string>? _requiredScopes;
+        public List<string> RequiredScopes
+        {
+            get => _requiredScopes ?? (_requiredScopes = new List<string>());
+            set => _requiredScopes = value;
+        }
+
+        public GetCurrentKeyInfoArgs()
+        {
+        }
+        public static new GetCurrentKeyInfoArgs Empty => new GetCurrentKeyInfoArgs();
+    }
+
+    public sealed class GetCurrentKeyInfoInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("requiredScopes")]
+        private InputList<string>? _requiredScopes;
+        public InputList<string> RequiredScopes
+        {
+            get => _requiredScopes ?? (_requiredScopes = new InputList<string>());
+            set => _requiredScopes = value;
+        }
+
+        public GetCurrentKeyInfoInvokeArgs()
+        {
+        }
+        public static new GetCurrentKeyInfoInvokeArgs Empty => new GetCurrentKeyInfoInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetCurrentKeyInfoResult
+    {
+        public readonly string? ApiKeyId;
+        public readonly string? Name;
+        public readonly ImmutableArray<string> Scopes;
+
+        [OutputConstructor]
+        private GetCurrentKeyInfoResult(
+            string? apiKeyId,
+
+            string? name,
+
+            ImmutableArray<string> scopes)
+        {
+            ApiKeyId = apiKeyId;
+            Name = name;
+            Scopes = scopes;
+        }
+    }
+}
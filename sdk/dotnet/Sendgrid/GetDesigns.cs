@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetDesigns
+    {
+        /// <summary>
+        /// Lists the designs in the SendGrid Design Library.
+        /// 
+        /// Returns the ID, name and thumbnail of every design, so that SingleSend resources can reference design-library content by `designId`.
+        /// </summary>
+        public static Task<GetDesignsResult> InvokeAsync(GetDesignsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetDesignsResult>("sendgrid:index:getDesigns", args ?? new GetDesignsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the designs in the SendGrid Design Library.
+        /// 
+        /// Returns the ID, name and thumbnail of every design, so that SingleSend resources can reference design-library content by `designId`.
+        /// </summary>
+        public static Output<GetDesignsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetDesignsResult>("sendgrid:index:getDesigns", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists the designs in the SendGrid Design Library.
+        /// 
+        /// Returns the ID, name and thumbnail of every design, so that SingleSend resources can reference design-library content by `designId`.
+        /// </summary>
+        public static Output<GetDesignsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetDesignsResult>("sendgrid:index:getDesigns", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetDesignsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetDesignsArgs()
+        {
+        }
+        public static new GetDesignsArgs Empty => new GetDesignsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetDesignsResult
+    {
+        public readonly ImmutableArray<Outputs.DesignSummary> Designs;
+
+        [OutputConstructor]
+        private GetDesignsResult(ImmutableArray<Outputs.DesignSummary> designs)
+        {
+            Designs = designs;
+        }
+    }
+}
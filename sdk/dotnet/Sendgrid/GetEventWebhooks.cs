@@ -0,0 +1,66 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetEventWebhooks
+    {
+        /// <summary>
+        /// Lists all SendGrid Event Webhooks configured on the account.
+        /// 
+        /// Returns every webhook URL together with the event types it is subscribed to, which is useful for auditing which endpoints receive account data.
+        /// </summary>
+        public static Task<GetEventWebhooksResult> InvokeAsync(GetEventWebhooksArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetEventWebhooksResult>("sendgrid:index:getEventWebhooks", args ?? new GetEventWebhooksArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists all SendGrid Event Webhooks configured on the account.
+        /// 
+        /// Returns every webhook URL together with the event types it is subscribed to, which is useful for auditing which endpoints receive account data.
+        /// </summary>
+        public static Output<GetEventWebhooksResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetEventWebhooksResult>("sendgrid:index:getEventWebhooks", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists all SendGrid Event Webhooks configured on the account.
+        /// 
+        /// Returns every webhook URL together with the event types it is subscribed to, which is useful for auditing which endpoints receive account data.
+        /// </summary>
+        public static Output<GetEventWebhooksResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetEventWebhooksResult>("sendgrid:index:getEventWebhooks", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetEventWebhooksArgs : global::Pulumi.InvokeArgs
+    {
+        public GetEventWebhooksArgs()
+        {
+        }
+        public static new GetEventWebhooksArgs Empty => new GetEventWebhooksArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetEventWebhooksResult
+    {
+        public readonly int MaxAllowed;
+        public readonly ImmutableArray<Outputs.EventWebhookSummary> Webhooks;
+
+        [OutputConstructor]
+        private GetEventWebhooksResult(
+            int maxAllowed,
+
+            ImmutableArray<Outputs.EventWebhookSummary> webhooks)
+        {
+            MaxAllowed = maxAllowed;
+            Webhooks = webhooks;
+        }
+    }
+}
@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetGlobalSuppressions
+    {
+        /// <summary>
+        /// Lists the email addresses on the SendGrid global unsubscribe list.
+        /// 
+        /// Includes addresses added through the GlobalSuppression resource as well as recipients who unsubscribed from all email. Optionally filter by Unix time range.
+        /// </summary>
+        public static Task<GetGlobalSuppressionsResult> InvokeAsync(GetGlobalSuppressionsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetGlobalSuppressionsResult>("sendgrid:index:getGlobalSuppressions", args ?? new GetGlobalSuppressionsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid global unsubscribe list.
+        /// 
+        /// Includes addresses added through the GlobalSuppression resource as well as recipients who unsubscribed from all email. Optionally filter by Unix time range.
+        /// </summary>
+        public static Output<GetGlobalSuppressionsResult> Invoke(GetGlobalSuppressionsInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetGlobalSuppressionsResult>("sendgrid:index:getGlobalSuppressions", args ?? new GetGlobalSuppressionsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid global unsubscribe list.
+        /// 
+        /// Includes addresses added through the GlobalSuppression resource as well as recipients who unsubscribed from all email. Optionally filter by Unix time range.
+        /// </summary>
+        public static Output<GetGlobalSuppressionsResult> Invoke(GetGlobalSuppressionsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetGlobalSuppressionsResult>("sendgrid:index:getGlobalSuppressions", args ?? new GetGlobalSuppressionsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetGlobalSuppressionsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public int? EndTime { get; set; }
+
+        [Input("startTime")]
+        public int? StartTime { get; set; }
+
+        public GetGlobalSuppressionsArgs()
+        {
+        }
+        public static new GetGlobalSuppressionsArgs Empty => new GetGlobalSuppressionsArgs();
+    }
+
+    public sealed class GetGlobalSuppressionsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public Input<int>? EndTime { get; set; }
+
+        [Input("startTime")]
+        public Input<int>? StartTime { get; set; }
+
+        public GetGlobalSuppressionsInvokeArgs()
+        {
+        }
+        public static new GetGlobalSuppressionsInvokeArgs Empty => new GetGlobalSuppressionsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetGlobalSuppressionsResult
+    {
+        public readonly ImmutableArray<Outputs.GlobalSuppressionEntry> Suppressions;
+
+        [OutputConstructor]
+        private GetGlobalSuppressionsResult(ImmutableArray<Outputs.GlobalSuppressionEntry> suppressions)
+        {
+            Suppressions = suppressions;
+        }
+    }
+}
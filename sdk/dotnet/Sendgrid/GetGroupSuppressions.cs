@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetGroupSuppressions
+    {
+        /// <summary>
+        /// Lists the recipients suppressed for a SendGrid Unsubscribe Group.
+        /// 
+        /// Returns every email address that has unsubscribed from the group. Large groups are retrieved page by page automatically.
+        /// </summary>
+        public static Task<GetGroupSuppressionsResult> InvokeAsync(GetGroupSuppressionsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetGroupSuppressionsResult>("sendgrid:index:getGroupSuppressions", args ?? new GetGroupSuppressionsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the recipients suppressed for a SendGrid Unsubscribe Group.
+        /// 
+        /// Returns every email address that has unsubscribed from the group. Large groups are retrieved page by page automatically.
+        /// </summary>
+        public static Output<GetGroupSuppressionsResult> Invoke(GetGroupSuppressionsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetGroupSuppressionsResult>("sendgrid:index:getGroupSuppressions", args ?? new GetGroupSuppressionsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the recipients suppressed for a SendGrid Unsubscribe Group.
+        /// 
+        /// Returns every email address that has unsubscribed from the group. Large groups are retrieved page by page automatically.
+        /// </summary>
+        public static Output<GetGroupSuppressionsResult> Invoke(GetGroupSuppressionsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetGroupSuppressionsResult>("sendgrid:index:getGroupSuppressions", args ?? new GetGroupSuppressionsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetGroupSuppressionsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("groupId", required: true)]
+        public int GroupId { get; set; }
+
+        public GetGroupSuppressionsArgs()
+        {
+        }
+        public static new GetGroupSuppressionsArgs Empty => new GetGroupSuppressionsArgs();
+    }
+
+    public sealed class GetGroupSuppressionsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("groupId", required: true)]
+        public Input<int> GroupId { get; set; } = null!;
+
+        public GetGroupSuppressionsInvokeArgs()
+        {
+        }
+        public static new GetGroupSuppressionsInvokeArgs Empty => new GetGroupSuppressionsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetGroupSuppressionsResult
+    {
+        public readonly ImmutableArray<string> Emails;
+
+        [OutputConstructor]
+        private GetGroupSuppressionsResult(ImmutableArray<string> emails)
+        {
+            Emails = emails;
+        }
+    }
+}
@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetInboundParseSettings
+    {
+        /// <summary>
+        /// Lists the Inbound Parse settings of the SendGrid account.
+        /// 
+        /// Each setting maps a receiving hostname to the URL that parsed email is posted to. Useful for auditing which hostnames receive email, and for finding settings to adopt.
+        /// </summary>
+        public static Task<GetInboundParseSettingsResult> InvokeAsync(GetInboundParseSettingsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetInboundParseSettingsResult>("sendgrid:index:getInboundParseSettings", args ?? new GetInboundParseSettingsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the Inbound Parse settings of the SendGrid account.
+        /// 
+        /// Each setting maps a receiving hostname to the URL that parsed email is posted to. Useful for auditing which hostnames receive email, and for finding settings to adopt.
+        /// </summary>
+        public static Output<GetInboundParseSettingsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInboundParseSettingsResult>("sendgrid:index:getInboundParseSettings", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists the Inbound Parse settings of the SendGrid account.
+        /// 
+        /// Each setting maps a receiving hostname to the URL that parsed email is posted to. Useful for auditing which hostnames receive email, and for finding settings to adopt.
+        /// </summary>
+        public static Output<GetInboundParseSettingsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInboundParseSettingsResult>("sendgrid:index:getInboundParseSettings", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetInboundParseSettingsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetInboundParseSettingsArgs()
+        {
+        }
+        public static new GetInboundParseSettingsArgs Empty => new GetInboundParseSettingsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetInboundParseSettingsResult
+    {
+        public readonly ImmutableArray<Outputs.InboundParseSetting> Settings;
+
+        [OutputConstructor]
+        private GetInboundParseSettingsResult(ImmutableArray<Outputs.InboundParseSetting> settings)
+        {
+            Settings = settings;
+        }
+    }
+}
@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetInvalidEmails
+    {
+        /// <summary>
+        /// Lists the email addresses on the SendGrid invalid emails list.
+        /// 
+        /// Invalid addresses are malformed or do not exist at the receiving server. Useful for address-hygiene reporting. Optionally filter by Unix time range.
+        /// </summary>
+        public static Task<GetInvalidEmailsResult> InvokeAsync(GetInvalidEmailsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetInvalidEmailsResult>("sendgrid:index:getInvalidEmails", args ?? new GetInvalidEmailsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid invalid emails list.
+        /// 
+        /// Invalid addresses are malformed or do not exist at the receiving server. Useful for address-hygiene reporting. Optionally filter by Unix time range.
+        /// </summary>
+        public static Output<GetInvalidEmailsResult> Invoke(GetInvalidEmailsInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInvalidEmailsResult>("sendgrid:index:getInvalidEmails", args ?? new GetInvalidEmailsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid invalid emails list.
+        /// 
+        /// Invalid addresses are malformed or do not exist at the receiving server. Useful for address-hygiene reporting. Optionally filter by Unix time range.
+        /// </summary>
+        public static Output<GetInvalidEmailsResult> Invoke(GetInvalidEmailsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInvalidEmailsResult>("sendgrid:index:getInvalidEmails", args ?? new GetInvalidEmailsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetInvalidEmailsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public int? EndTime { get; set; }
+
+        [Input("startTime")]
+        public int? StartTime { get; set; }
+
+        public GetInvalidEmailsArgs()
+        {
+        }
+        public static new GetInvalidEmailsArgs Empty => new GetInvalidEmailsArgs();
+    }
+
+    public sealed class GetInvalidEmailsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public Input<int>? EndTime { get; set; }
+
+        [Input("startTime")]
+        public Input<int>? StartTime { get; set; }
+
+        public GetInvalidEmailsInvokeArgs()
+        {
+        }
+        public static new GetInvalidEmailsInvokeArgs Empty => new GetInvalidEmailsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetInvalidEmailsResult
+    {
+        public readonly ImmutableArray<Outputs.InvalidEmailEntry> InvalidEmails;
+
+        [OutputConstructor]
+        private GetInvalidEmailsResult(ImmutableArray<Outputs.InvalidEmailEntry> invalidEmails)
+        {
+            InvalidEmails = invalidEmails;
+        }
+    }
+}
@@ -0,0 +1,66 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetMailSettings
+    {
+        /// <summary>
+        /// Lists the mail settings of the SendGrid account and whether each is enabled.
+        /// 
+        /// Returns the settings both as a list and as a map from setting name to enabled, e.g. `{"bcc": false, "footer": true}`, so compliance audits can diff the account against the desired state.
+        /// </summary>
+        public static Task<GetMailSettingsResult> InvokeAsync(GetMailSettingsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetMailSettingsResult>("sendgrid:index:getMailSettings", args ?? new GetMailSettingsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the mail settings of the SendGrid account and whether each is enabled.
+        /// 
+        /// Returns the settings both as a list and as a map from setting name to enabled, e.g. `{"bcc": false, "footer": true}`, so compliance audits can diff the account against the desired state.
+        /// </summary>
+        public static Output<GetMailSettingsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMailSettingsResult>("sendgrid:index:getMailSettings", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists the mail settings of the SendGrid account and whether each is enabled.
+        /// 
+        /// Returns the settings both as a list and as a map from setting name to enabled, e.g. `{"bcc": false, "footer": true}`, so compliance audits can diff the account against the desired state.
+        /// </summary>
+        public static Output<GetMailSettingsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMailSettingsResult>("sendgrid:index:getMailSettings", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetMailSettingsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetMailSettingsArgs()
+        {
+        }
+        public static new GetMailSettingsArgs Empty => new GetMailSettingsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetMailSettingsResult
+    {
+        public readonly ImmutableDictionary<string, bool> Enabled;
+        public readonly ImmutableArray<Outputs.MailSetting> Settings;
+
+        [OutputConstructor]
+        private GetMailSettingsResult(
+            ImmutableDictionary<string, bool> enabled,
+
+            ImmutableArray<Outputs.MailSetting> settings)
+        {
+            Enabled = enabled;
+            Settings = settings;
+        }
+    }
+}
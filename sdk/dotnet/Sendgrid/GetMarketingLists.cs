@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetMarketingLists
+    {
+        /// <summary>
+        /// Lists the SendGrid Marketing Campaigns contact lists.
+        /// 
+        /// Returns the ID, name and contact count of every list, so that segments and single sends can reference lists managed by the marketing team.
+        /// </summary>
+        public static Task<GetMarketingListsResult> InvokeAsync(GetMarketingListsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetMarketingListsResult>("sendgrid:index:getMarketingLists", args ?? new GetMarketingListsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the SendGrid Marketing Campaigns contact lists.
+        /// 
+        /// Returns the ID, name and contact count of every list, so that segments and single sends can reference lists managed by the marketing team.
+        /// </summary>
+        public static Output<GetMarketingListsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMarketingListsResult>("sendgrid:index:getMarketingLists", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists the SendGrid Marketing Campaigns contact lists.
+        /// 
+        /// Returns the ID, name and contact count of every list, so that segments and single sends can reference lists managed by the marketing team.
+        /// </summary>
+        public static Output<GetMarketingListsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMarketingListsResult>("sendgrid:index:getMarketingLists", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetMarketingListsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetMarketingListsArgs()
+        {
+        }
+        public static new GetMarketingListsArgs Empty => new GetMarketingListsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetMarketingListsResult
+    {
+        public readonly ImmutableArray<Outputs.MarketingListSummary> Lists;
+
+        [OutputConstructor]
+        private GetMarketingListsResult(ImmutableArray<Outputs.MarketingListSummary> lists)
+        {
+            Lists = lists;
+        }
+    }
+}
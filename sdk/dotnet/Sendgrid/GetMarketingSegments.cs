@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetMarketingSegments
+    {
+        /// <summary>
+        /// Lists the SendGrid Marketing Campaigns segments.
+        /// 
+        /// Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, so that single sends can reference existing segments.
+        /// </summary>
+        public static Task<GetMarketingSegmentsResult> InvokeAsync(GetMarketingSegmentsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetMarketingSegmentsResult>("sendgrid:index:getMarketingSegments", args ?? new GetMarketingSegmentsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the SendGrid Marketing Campaigns segments.
+        /// 
+        /// Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, so that single sends can reference existing segments.
+        /// </summary>
+        public static Output<GetMarketingSegmentsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMarketingSegmentsResult>("sendgrid:index:getMarketingSegments", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists the SendGrid Marketing Campaigns segments.
+        /// 
+        /// Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, so that single sends can reference existing segments.
+        /// </summary>
+        public static Output<GetMarketingSegmentsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMarketingSegmentsResult>("sendgrid:index:getMarketingSegments", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetMarketingSegmentsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetMarketingSegmentsArgs()
+        {
+        }
+        public static new GetMarketingSegmentsArgs Empty => new GetMarketingSegmentsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetMarketingSegmentsResult
+    {
+        public readonly ImmutableArray<Outputs.MarketingSegmentSummary> Segments;
+
+        [OutputConstructor]
+        private GetMarketingSegmentsResult(ImmutableArray<Outputs.MarketingSegmentSummary> segments)
+        {
+            Segments = segments;
+        }
+    }
+}
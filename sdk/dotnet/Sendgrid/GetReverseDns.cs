@@ -0,0 +1,108 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetReverseDns
+    {
+        /// <summary>
+        /// Looks up the reverse DNS entry of a dedicated IP address.
+        /// 
+        /// Returns the rDNS hostname, whether it has been validated, and the A record to publish, also in the shape DNS provider resources expect, so it can be wired into DNS like the records of DomainAuthentication and LinkBranding.
+        /// </summary>
+        public static Task<GetReverseDnsResult> InvokeAsync(GetReverseDnsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetReverseDnsResult>("sendgrid:index:getReverseDns", args ?? new GetReverseDnsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Looks up the reverse DNS entry of a dedicated IP address.
+        /// 
+        /// Returns the rDNS hostname, whether it has been validated, and the A record to publish, also in the shape DNS provider resources expect, so it can be wired into DNS like the records of DomainAuthentication and LinkBranding.
+        /// </summary>
+        public static Output<GetReverseDnsResult> Invoke(GetReverseDnsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetReverseDnsResult>("sendgrid:index:getReverseDns", args ?? new GetReverseDnsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Looks up the reverse DNS entry of a dedicated IP address.
+        /// 
+        /// Returns the rDNS hostname, whether it has been validated, and the A record to publish, also in the shape DNS provider resources expect, so it can be wired into DNS like the records of DomainAuthentication and LinkBranding.
+        /// </summary>
+        public static Output<GetReverseDnsResult> Invoke(GetReverseDnsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetReverseDnsResult>("sendgrid:index:getReverseDns", args ?? new GetReverseDnsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetReverseDnsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("ip", required: true)]
+        public string Ip { get; set; } = null!;
+
+        public GetReverseDnsArgs()
+        {
+        }
+        public static new GetReverseDnsArgs Empty => new GetReverseDnsArgs();
+    }
+
+    public sealed class GetReverseDnsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("ip", required: true)]
+        public Input<string> Ip { get; set; } = null!;
+
+        public GetReverseDnsInvokeArgs()
+        {
+        }
+        public static new GetReverseDnsInvokeArgs Empty => new GetReverseDnsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetReverseDnsResult
+    {
+        public readonly ImmutableArray<Outputs.DomainDNSRecord> DnsRecords;
+        public readonly string Domain;
+        public readonly string Ip;
+        public readonly bool Legacy;
+        public readonly ImmutableArray<Outputs.DNSProviderRecord> ProviderRecords;
+        public readonly string Rdns;
+        public readonly int ReverseDnsId;
+        public readonly string Subdomain;
+        public readonly bool Valid;
+
+        [OutputConstructor]
+        private GetReverseDnsResult(
+            ImmutableArray<Outputs.DomainDNSRecord> dnsRecords,
+
+            string domain,
+
+            string ip,
+
+            bool legacy,
+
+            ImmutableArray<Outputs.DNSProviderRecord> providerRecords,
+
+            string rdns,
+
+            int reverseDnsId,
+
+            string subdomain,
+
+            bool valid)
+        {
+            DnsRecords = dnsRecords;
+            Domain = domain;
+            Ip = ip;
+            Legacy = legacy;
+            ProviderRecords = providerRecords;
+            Rdns = rdns;
+            ReverseDnsId = reverseDnsId;
+            Subdomain = subdomain;
+            Valid = valid;
+        }
+    }
+}
@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetSpamReports
+    {
+        /// <summary>
+        /// Lists the email addresses on the SendGrid spam reports list.
+        /// 
+        /// Returns each recipient that marked a message as spam, when they reported it and the sending IP. Optionally filter by the Unix time range in which the report was made.
+        /// </summary>
+        public static Task<GetSpamReportsResult> InvokeAsync(GetSpamReportsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetSpamReportsResult>("sendgrid:index:getSpamReports", args ?? new GetSpamReportsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid spam reports list.
+        /// 
+        /// Returns each recipient that marked a message as spam, when they reported it and the sending IP. Optionally filter by the Unix time range in which the report was made.
+        /// </summary>
+        public static Output<GetSpamReportsResult> Invoke(GetSpamReportsInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSpamReportsResult>("sendgrid:index:getSpamReports", args ?? new GetSpamReportsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the email addresses on the SendGrid spam reports list.
+        /// 
+        /// Returns each recipient that marked a message as spam, when they reported it and the sending IP. Optionally filter by the Unix time range in which the report was made.
+        /// </summary>
+        public static Output<GetSpamReportsResult> Invoke(GetSpamReportsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSpamReportsResult>("sendgrid:index:getSpamReports", args ?? new GetSpamReportsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetSpamReportsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public int? EndTime { get; set; }
+
+        [Input("startTime")]
+        public int? StartTime { get; set; }
+
+        public GetSpamReportsArgs()
+        {
+        }
+        public static new GetSpamReportsArgs Empty => new GetSpamReportsArgs();
+    }
+
+    public sealed class GetSpamReportsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("endTime")]
+        public Input<int>? EndTime { get; set; }
+
+        [Input("startTime")]
+        public Input<int>? StartTime { get; set; }
+
+        public GetSpamReportsInvokeArgs()
+        {
+        }
+        public static new GetSpamReportsInvokeArgs Empty => new GetSpamReportsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetSpamReportsResult
+    {
+        public readonly ImmutableArray<Outputs.SpamReportEntry> SpamReports;
+
+        [OutputConstructor]
+        private GetSpamReportsResult(ImmutableArray<Outputs.SpamReportEntry> spamReports)
+        {
+            SpamReports = spamReports;
+        }
+    }
+}
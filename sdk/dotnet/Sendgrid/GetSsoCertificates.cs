@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetSsoCertificates
+    {
+        /// <summary>
+        /// Lists the certificates of the SendGrid account's SSO integrations.
+        /// 
+        /// Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` to only list certificates that expire soon, e.g. to raise certificate rotation alerts.
+        /// </summary>
+        public static Task<GetSsoCertificatesResult> InvokeAsync(GetSsoCertificatesArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetSsoCertificatesResult>("sendgrid:index:getSsoCertificates", args ?? new GetSsoCertificatesArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the certificates of the SendGrid account's SSO integrations.
+        /// 
+        /// Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` to only list certificates that expire soon, e.g. to raise certificate rotation alerts.
+        /// </summary>
+        public static Output<GetSsoCertificatesResult> Invoke(GetSsoCertificatesInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSsoCertificatesResult>("sendgrid:index:getSsoCertificates", args ?? new GetSsoCertificatesInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the certificates of the SendGrid account's SSO integrations.
+        /// 
+        /// Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` to only list certificates that expire soon, e.g. to raise certificate rotation alerts.
+        /// </summary>
+        public static Output<GetSsoCertificatesResult> Invoke(GetSsoCertificatesInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSsoCertificatesResult>("sendgrid:index:getSsoCertificates", args ?? new GetSsoCertificatesInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetSsoCertificatesArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("expiringWithinDays")]
+        public int? ExpiringWithinDays { get; set; }
+
+        [Input("integrationId")]
+        public string? IntegrationId { get; set; }
+
+        public GetSsoCertificatesArgs()
+        {
+        }
+        public static new GetSsoCertificatesArgs Empty => new GetSsoCertificatesArgs();
+    }
+
+    public sealed class GetSsoCertificatesInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("expiringWithinDays")]
+        public Input<int>? ExpiringWithinDays { get; set; }
+
+        [Input("integrationId")]
+        public Input<string>? IntegrationId { get; set; }
+
+        public GetSsoCertificatesInvokeArgs()
+        {
+        }
+        public static new GetSsoCertificatesInvokeArgs Empty => new GetSsoCertificatesInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetSsoCertificatesResult
+    {
+        public readonly ImmutableArray<Outputs.SsoCertificate> Certificates;
+
+        [OutputConstructor]
+        private GetSsoCertificatesResult(ImmutableArray<Outputs.SsoCertificate> certificates)
+        {
+            Certificates = certificates;
+        }
+    }
+}
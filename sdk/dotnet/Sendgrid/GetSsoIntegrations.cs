@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetSsoIntegrations
+    {
+        /// <summary>
+        /// Lists the SSO integrations of the SendGrid account.
+        /// 
+        /// Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's SAML application must be configured with, so they can be passed to IdP resources or exported as stack outputs.
+        /// </summary>
+        public static Task<GetSsoIntegrationsResult> InvokeAsync(GetSsoIntegrationsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetSsoIntegrationsResult>("sendgrid:index:getSsoIntegrations", args ?? new GetSsoIntegrationsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the SSO integrations of the SendGrid account.
+        /// 
+        /// Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's SAML application must be configured with, so they can be passed to IdP resources or exported as stack outputs.
+        /// </summary>
+        public static Output<GetSsoIntegrationsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSsoIntegrationsResult>("sendgrid:index:getSsoIntegrations", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Lists the SSO integrations of the SendGrid account.
+        /// 
+        /// Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's SAML application must be configured with, so they can be passed to IdP resources or exported as stack outputs.
+        /// </summary>
+        public static Output<GetSsoIntegrationsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSsoIntegrationsResult>("sendgrid:index:getSsoIntegrations", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetSsoIntegrationsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetSsoIntegrationsArgs()
+        {
+        }
+        public static new GetSsoIntegrationsArgs Empty => new GetSsoIntegrationsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetSsoIntegrationsResult
+    {
+        public readonly ImmutableArray<Outputs.SsoIntegration> Integrations;
+
+        [OutputConstructor]
+        private GetSsoIntegrationsResult(ImmutableArray<Outputs.SsoIntegration> integrations)
+        {
+            Integrations = integrations;
+        }
+    }
+}
@@ -0,0 +1,87 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetStats
+    {
+        /// <summary>
+        /// Retrieves global email statistics for the SendGrid account.
+        /// 
+        /// Returns delivered, open, click, bounce and other metrics for each day, week or month in the requested date range, so dashboards and alert thresholds can be derived at deploy time.
+        /// </summary>
+        public static Task<GetStatsResult> InvokeAsync(GetStatsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetStatsResult>("sendgrid:index:getStats", args ?? new GetStatsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves global email statistics for the SendGrid account.
+        /// 
+        /// Returns delivered, open, click, bounce and other metrics for each day, week or month in the requested date range, so dashboards and alert thresholds can be derived at deploy time.
+        /// </summary>
+        public static Output<GetStatsResult> Invoke(GetStatsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetStatsResult>("sendgrid:index:getStats", args ?? new GetStatsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves global email statistics for the SendGrid account.
+        /// 
+        /// Returns delivered, open, click, bounce and other metrics for each day, week or month in the requested date range, so dashboards and alert thresholds can be derived at deploy time.
+        /// </summary>
+        public static Output<GetStatsResult> Invoke(GetStatsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetStatsResult>("sendgrid:index:getStats", args ?? new GetStatsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetStatsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("aggregatedBy")]
+        public string? AggregatedBy { get; set; }
+
+        [Input("endDate")]
+        public string? EndDate { get; set; }
+
+        [Input("startDate", required: true)]
+        public string StartDate { get; set; } = null!;
+
+        public GetStatsArgs()
+        {
+        }
+        public static new GetStatsArgs Empty => new GetStatsArgs();
+    }
+
+    public sealed class GetStatsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("aggregatedBy")]
+        public Input<string>? AggregatedBy { get; set; }
+
+        [Input("endDate")]
+        public Input<string>? EndDate { get; set; }
+
+        [Input("startDate", required: true)]
+        public Input<string> StartDate { get; set; } = null!;
+
+        public GetStatsInvokeArgs()
+        {
+        }
+        public static new GetStatsInvokeArgs Empty => new GetStatsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetStatsResult
+    {
+        public readonly ImmutableArray<Outputs.StatsEntry> Stats;
+
+        [OutputConstructor]
+        private GetStatsResult(ImmutableArray<Outputs.StatsEntry> stats)
+        {
+            Stats = stats;
+        }
+    }
+}
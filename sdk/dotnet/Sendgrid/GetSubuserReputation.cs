@@ -0,0 +1,85 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetSubuserReputation
+    {
+        /// <summary>
+        /// Retrieves the sender reputation of SendGrid Subusers.
+        /// 
+        /// The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.
+        /// </summary>
+        public static Task<GetSubuserReputationResult> InvokeAsync(GetSubuserReputationArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetSubuserReputationResult>("sendgrid:index:getSubuserReputation", args ?? new GetSubuserReputationArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves the sender reputation of SendGrid Subusers.
+        /// 
+        /// The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.
+        /// </summary>
+        public static Output<GetSubuserReputationResult> Invoke(GetSubuserReputationInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSubuserReputationResult>("sendgrid:index:getSubuserReputation", args ?? new GetSubuserReputationInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves the sender reputation of SendGrid Subusers.
+        /// 
+        /// The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.
+        /// </summary>
+        public static Output<GetSubuserReputationResult> Invoke(GetSubuserReputationInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSubuserReputationResult>("sendgrid:index:getSubuserReputation", args ?? new GetSubuserReputationInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetSubuserReputationArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("usernames", required: true)]
+        private List<string>? _usernames;
+        public List<string> Usernames
+        {
+            get => _usernames ?? (_usernames = new List<string>());
+            set => _usernames = value;
+        }
+
+        public GetSubuserReputationArgs()
+        {
+        }
+        public static new GetSubuserReputationArgs Empty => new GetSubuserReputationArgs();
+    }
+
+    public sealed class GetSubuserReputationInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("usernames", required: true)]
+        private InputList<string>? _usernames;
+        public InputList<string> Usernames
+        {
+            get => _usernames ?? (_usernames = new InputList<string>());
+            set => _usernames = value;
+        }
+
+        public GetSubuserReputationInvokeArgs()
+        {
+        }
+        public static new GetSubuserReputationInvokeArgs Empty => new GetSubuserReputationInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetSubuserReputationResult
+    {
+        public readonly ImmutableArray<Outputs.SubuserReputation> Reputations;
+
+        [OutputConstructor]
+        private GetSubuserReputationResult(ImmutableArray<Outputs.SubuserReputation> reputations)
+        {
+            Reputations = reputations;
+        }
+    }
+}
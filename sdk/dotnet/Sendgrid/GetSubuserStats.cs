@@ -0,0 +1,103 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetSubuserStats
+    {
+        /// <summary>
+        /// Retrieves email statistics for specific SendGrid Subusers.
+        /// 
+        /// Returns metrics for each requested subuser (up to 10) and each day, week or month in the date range, for per-tenant usage reporting.
+        /// </summary>
+        public static Task<GetSubuserStatsResult> InvokeAsync(GetSubuserStatsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetSubuserStatsResult>("sendgrid:index:getSubuserStats", args ?? new GetSubuserStatsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves email statistics for specific SendGrid Subusers.
+        /// 
+        /// Returns metrics for each requested subuser (up to 10) and each day, week or month in the date range, for per-tenant usage reporting.
+        /// </summary>
+        public static Output<GetSubuserStatsResult> Invoke(GetSubuserStatsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSubuserStatsResult>("sendgrid:index:getSubuserStats", args ?? new GetSubuserStatsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves email statistics for specific SendGrid Subusers.
+        /// 
+        /// Returns metrics for each requested subuser (up to 10) and each day, week or month in the date range, for per-tenant usage reporting.
+        /// </summary>
+        public static Output<GetSubuserStatsResult> Invoke(GetSubuserStatsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSubuserStatsResult>("sendgrid:index:getSubuserStats", args ?? new GetSubuserStatsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetSubuserStatsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("aggregatedBy")]
+        public string? AggregatedBy { get; set; }
+
+        [Input("endDate")]
+        public string? EndDate { get; set; }
+
+        [Input("startDate", required: true)]
+        public string StartDate { get; set; } = null!;
+
+        [Input("subusers", required: true)]
+        private List<string>? _subusers;
+        public List<string> Subusers
+        {
+            get => _subusers ?? (_subusers = new List<string>());
+            set => _subusers = value;
+        }
+
+        public GetSubuserStatsArgs()
+        {
+        }
+        public static new GetSubuserStatsArgs Empty => new GetSubuserStatsArgs();
+    }
+
+    public sealed class GetSubuserStatsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("aggregatedBy")]
+        public Input<string>? AggregatedBy { get; set; }
+
+        [Input("endDate")]
+        public Input<string>? EndDate { get; set; }
+
+        [Input("startDate", required: true)]
+        public Input<string> StartDate { get; set; } = null!;
+
+        [Input("subusers", required: true)]
+        private InputList<string>? _subusers;
+        public InputList<string> Subusers
+        {
+            get => _subusers ?? (_subusers = new InputList<string>());
+            set => _subusers = value;
+        }
+
+        public GetSubuserStatsInvokeArgs()
+        {
+        }
+        public static new GetSubuserStatsInvokeArgs Empty => new GetSubuserStatsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetSubuserStatsResult
+    {
+        public readonly ImmutableArray<Outputs.StatsEntry> Stats;
+
+        [OutputConstructor]
+        private GetSubuserStatsResult(ImmutableArray<Outputs.StatsEntry> stats)
+        {
+            Stats = stats;
+        }
+    }
+}
@@ -0,0 +1,74 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetTrackingSettings
+    {
+        /// <summary>
+        /// Gets the click, open, subscription and Google Analytics tracking settings of the SendGrid account.
+        /// 
+        /// Useful for auditing tracking across accounts or subusers in a single call.
+        /// </summary>
+        public static Task<GetTrackingSettingsResult> InvokeAsync(GetTrackingSettingsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetTrackingSettingsResult>("sendgrid:index:getTrackingSettings", args ?? new GetTrackingSettingsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Gets the click, open, subscription and Google Analytics tracking settings of the SendGrid account.
+        /// 
+        /// Useful for auditing tracking across accounts or subusers in a single call.
+        /// </summary>
+        public static Output<GetTrackingSettingsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetTrackingSettingsResult>("sendgrid:index:getTrackingSettings", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Gets the click, open, subscription and Google Analytics tracking settings of the SendGrid account.
+        /// 
+        /// Useful for auditing tracking across accounts or subusers in a single call.
+        /// </summary>
+        public static Output<GetTrackingSettingsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetTrackingSettingsResult>("sendgrid:index:getTrackingSettings", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetTrackingSettingsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetTrackingSettingsArgs()
+        {
+        }
+        public static new GetTrackingSettingsArgs Empty => new GetTrackingSettingsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetTrackingSettingsResult
+    {
+        public readonly Outputs.ClickTrackingSetting Click;
+        public readonly Outputs.GoogleAnalyticsTrackingSetting GoogleAnalytics;
+        public readonly Outputs.OpenTrackingSetting Open;
+        public readonly Outputs.SubscriptionTrackingSetting Subscription;
+
+        [OutputConstructor]
+        private GetTrackingSettingsResult(
+            Outputs.ClickTrackingSetting click,
+
+            Outputs.GoogleAnalyticsTrackingSetting googleAnalytics,
+
+            Outputs.OpenTrackingSetting open,
+
+            Outputs.SubscriptionTrackingSetting subscription)
+        {
+            Click = click;
+            GoogleAnalytics = googleAnalytics;
+            Open = open;
+            Subscription = subscription;
+        }
+    }
+}
@@ -0,0 +1,86 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class GetUsage
+    {
+        /// <summary>
+        /// Gets the email credits of the SendGrid account for the current period.
+        /// 
+        /// Useful for setting the percentage of a usage_limit Alert relative to the plan size, e.g. to be notified when a fixed number of emails remains.
+        /// </summary>
+        public static Task<GetUsageResult> InvokeAsync(GetUsageArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetUsageResult>("sendgrid:index:getUsage", args ?? new GetUsageArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Gets the email credits of the SendGrid account for the current period.
+        /// 
+        /// Useful for setting the percentage of a usage_limit Alert relative to the plan size, e.g. to be notified when a fixed number of emails remains.
+        /// </summary>
+        public static Output<GetUsageResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetUsageResult>("sendgrid:index:getUsage", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Gets the email credits of the SendGrid account for the current period.
+        /// 
+        /// Useful for setting the percentage of a usage_limit Alert relative to the plan size, e.g. to be notified when a fixed number of emails remains.
+        /// </summary>
+        public static Output<GetUsageResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetUsageResult>("sendgrid:index:getUsage", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetUsageArgs : global::Pulumi.InvokeArgs
+    {
+        public GetUsageArgs()
+        {
+        }
+        public static new GetUsageArgs Empty => new GetUsageArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetUsageResult
+    {
+        public readonly string LastReset;
+        public readonly string NextReset;
+        public readonly int Overage;
+        public readonly int Remain;
+        public readonly string ResetFrequency;
+        public readonly int Total;
+        public readonly int Used;
+
+        [OutputConstructor]
+        private GetUsageResult(
+            string lastReset,
+
+            string nextReset,
+
+            int overage,
+
+            int remain,
+
+            string resetFrequency,
+
+            int total,
+
+            int used)
+        {
+            LastReset = lastReset;
+            NextReset = nextReset;
+            Overage = overage;
+            Remain = remain;
+            ResetFrequency = resetFrequency;
+            Total = total;
+            Used = used;
+        }
+    }
+}
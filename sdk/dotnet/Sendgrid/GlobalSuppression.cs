@@ -23,6 +23,9 @@ namespace Community.Pulumi.Sendgrid
         [Output("createdAt")]
         public Output<int?> CreatedAt { get; private set; } = null!;
 
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("email")]
         public Output<string> Email { get; private set; } = null!;
 
@@ -50,6 +53,10 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "email",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -72,6 +79,9 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class GlobalSuppressionArgs : global::Pulumi.ResourceArgs
     {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("email", required: true)]
         public Input<string> Email { get; set; } = null!;
 
@@ -20,11 +20,14 @@ namespace Community.Pulumi.Sendgrid
     [SendgridResourceType("sendgrid:index:IpPool")]
     public partial class IpPool : global::Pulumi.CustomResource
     {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("ips")]
         public Output<ImmutableArray<string>> Ips { get; private set; } = null!;
 
         [Output("name")]
-        public Output<string> Name { get; private set; } = null!;
+        public Output<string?> Name { get; private set; } = null!;
 
         [Output("poolName")]
         public Output<string> PoolName { get; private set; } = null!;
@@ -37,7 +40,7 @@ namespace Community.Pulumi.Sendgrid
         /// <param name="name">The unique name of the resource</param>
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
-        public IpPool(string name, IpPoolArgs args, CustomResourceOptions? options = null)
+        public IpPool(string name, IpPoolArgs? args = null, CustomResourceOptions? options = null)
             : base("sendgrid:index:IpPool", name, args ?? new IpPoolArgs(), MakeResourceOptions(options, ""))
         {
         }
@@ -75,8 +78,11 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class IpPoolArgs : global::Pulumi.ResourceArgs
     {
-        [Input("name", required: true)]
-        public Input<string> Name { get; set; } = null!;
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("name")]
+        public Input<string>? Name { get; set; }
 
         public IpPoolArgs()
         {
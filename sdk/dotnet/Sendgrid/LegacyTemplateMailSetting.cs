@@ -0,0 +1,92 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Manages the SendGrid legacy template mail setting.
+    /// 
+    /// For accounts still using legacy templates, this setting wraps every email in the same HTML, with the &lt;% body %&gt; tag where the content of each email goes. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the setting.
+    /// 
+    /// New accounts should use dynamic templates with the Template resource instead.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:LegacyTemplateMailSetting")]
+    public partial class LegacyTemplateMailSetting : global::Pulumi.CustomResource
+    {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("enabled")]
+        public Output<bool> Enabled { get; private set; } = null!;
+
+        [Output("htmlContent")]
+        public Output<string?> HtmlContent { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a LegacyTemplateMailSetting resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public LegacyTemplateMailSetting(string name, LegacyTemplateMailSettingArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:LegacyTemplateMailSetting", name, args ?? new LegacyTemplateMailSettingArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private LegacyTemplateMailSetting(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:LegacyTemplateMailSetting", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing LegacyTemplateMailSetting resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static LegacyTemplateMailSetting Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new LegacyTemplateMailSetting(name, id, options);
+        }
+    }
+
+    public sealed class LegacyTemplateMailSettingArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("enabled", required: true)]
+        public Input<bool> Enabled { get; set; } = null!;
+
+        [Input("htmlContent")]
+        public Input<string>? HtmlContent { get; set; }
+
+        public LegacyTemplateMailSettingArgs()
+        {
+        }
+        public static new LegacyTemplateMailSettingArgs Empty => new LegacyTemplateMailSettingArgs();
+    }
+}
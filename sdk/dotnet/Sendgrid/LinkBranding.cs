@@ -26,6 +26,12 @@ namespace Community.Pulumi.Sendgrid
         [Output("default")]
         public Output<bool?> Default { get; private set; } = null!;
 
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("dnsRecords")]
+        public Output<ImmutableArray<Outputs.DomainDNSRecord>> DnsRecords { get; private set; } = null!;
+
         [Output("domain")]
         public Output<string> Domain { get; private set; } = null!;
 
@@ -38,9 +44,15 @@ namespace Community.Pulumi.Sendgrid
         [Output("ownerCname")]
         public Output<Outputs.LinkBrandingDNSRecord?> OwnerCname { get; private set; } = null!;
 
+        [Output("providerRecords")]
+        public Output<ImmutableArray<Outputs.DNSProviderRecord>> ProviderRecords { get; private set; } = null!;
+
         [Output("region")]
         public Output<string?> Region { get; private set; } = null!;
 
+        [Output("revalidateOnRefresh")]
+        public Output<bool?> RevalidateOnRefresh { get; private set; } = null!;
+
         [Output("subdomain")]
         public Output<string?> Subdomain { get; private set; } = null!;
 
@@ -53,6 +65,15 @@ namespace Community.Pulumi.Sendgrid
         [Output("valid")]
         public Output<bool> Valid { get; private set; } = null!;
 
+        [Output("waitForValidation")]
+        public Output<bool?> WaitForValidation { get; private set; } = null!;
+
+        [Output("waitIntervalSeconds")]
+        public Output<int?> WaitIntervalSeconds { get; private set; } = null!;
+
+        [Output("waitTimeoutSeconds")]
+        public Output<int?> WaitTimeoutSeconds { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a LinkBranding resource with the given unique name, arguments, and options.
@@ -77,6 +98,11 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "domain",
+                    "subdomain",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -102,15 +128,30 @@ namespace Community.Pulumi.Sendgrid
         [Input("default")]
         public Input<bool>? Default { get; set; }
 
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("domain", required: true)]
         public Input<string> Domain { get; set; } = null!;
 
         [Input("region")]
         public Input<string>? Region { get; set; }
 
+        [Input("revalidateOnRefresh")]
+        public Input<bool>? RevalidateOnRefresh { get; set; }
+
         [Input("subdomain")]
         public Input<string>? Subdomain { get; set; }
 
+        [Input("waitForValidation")]
+        public Input<bool>? WaitForValidation { get; set; }
+
+        [Input("waitIntervalSeconds")]
+        public Input<int>? WaitIntervalSeconds { get; set; }
+
+        [Input("waitTimeoutSeconds")]
+        public Input<int>? WaitTimeoutSeconds { get; set; }
+
         public LinkBrandingArgs()
         {
         }
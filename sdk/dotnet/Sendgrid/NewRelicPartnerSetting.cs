@@ -0,0 +1,109 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Manages the SendGrid New Relic partner setting.
+    /// 
+    /// When enabled, SendGrid sends your email statistics to New Relic using the given license key. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the integration.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:NewRelicPartnerSetting")]
+    public partial class NewRelicPartnerSetting : global::Pulumi.CustomResource
+    {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("enableSubuserStatistics")]
+        public Output<bool?> EnableSubuserStatistics { get; private set; } = null!;
+
+        [Output("enabled")]
+        public Output<bool> Enabled { get; private set; } = null!;
+
+        [Output("licenseKey")]
+        public Output<string?> LicenseKey { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a NewRelicPartnerSetting resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public NewRelicPartnerSetting(string name, NewRelicPartnerSettingArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:NewRelicPartnerSetting", name, args ?? new NewRelicPartnerSettingArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private NewRelicPartnerSetting(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:NewRelicPartnerSetting", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                AdditionalSecretOutputs =
+                {
+                    "licenseKey",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing NewRelicPartnerSetting resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static NewRelicPartnerSetting Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new NewRelicPartnerSetting(name, id, options);
+        }
+    }
+
+    public sealed class NewRelicPartnerSettingArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("enableSubuserStatistics")]
+        public Input<bool>? EnableSubuserStatistics { get; set; }
+
+        [Input("enabled", required: true)]
+        public Input<bool> Enabled { get; set; } = null!;
+
+        [Input("licenseKey")]
+        private Input<string>? _licenseKey;
+        public Input<string>? LicenseKey
+        {
+            get => _licenseKey;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _licenseKey = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        public NewRelicPartnerSettingArgs()
+        {
+        }
+        public static new NewRelicPartnerSettingArgs Empty => new NewRelicPartnerSettingArgs();
+    }
+}
@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class AlertSummary
+    {
+        public readonly int AlertId;
+        public readonly int CreatedAt;
+        public readonly string EmailTo;
+        public readonly string? Frequency;
+        public readonly int? Percentage;
+        public readonly string Type;
+        public readonly int UpdatedAt;
+
+        [OutputConstructor]
+        private AlertSummary(
+            int alertId,
+
+            int createdAt,
+
+            string emailTo,
+
+            string? frequency,
+
+            int? percentage,
+
+            string type,
+
+            int updatedAt)
+        {
+            AlertId = alertId;
+            CreatedAt = createdAt;
+            EmailTo = emailTo;
+            Frequency = frequency;
+            Percentage = percentage;
+            Type = type;
+            UpdatedAt = updatedAt;
+        }
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class BlockEntry
+    {
+        public readonly int Created;
+        public readonly string Email;
+        public readonly string Reason;
+        public readonly string Status;
+
+        [OutputConstructor]
+        private BlockEntry(
+            int created,
+
+            string email,
+
+            string reason,
+
+            string status)
+        {
+            Created = created;
+            Email = email;
+            Reason = reason;
+            Status = status;
+        }
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class DNSProviderRecord
+    {
+        public readonly string Name;
+        public readonly int Ttl;
+        public readonly string Type;
+        public readonly string Value;
+
+        [OutputConstructor]
+        private DNSProviderRecord(
+            string name,
+
+            int ttl,
+
+            string type,
+
+            string value)
+        {
+            Name = name;
+            Ttl = ttl;
+            Type = type;
+            Value = value;
+        }
+    }
+}
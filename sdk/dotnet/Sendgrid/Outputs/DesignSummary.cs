@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class DesignSummary
+    {
+        public readonly ImmutableArray<string> Categories;
+        public readonly string CreatedAt;
+        public readonly string DesignId;
+        public readonly string Editor;
+        public readonly string Name;
+        public readonly string Subject;
+        public readonly string ThumbnailUrl;
+        public readonly string UpdatedAt;
+
+        [OutputConstructor]
+        private DesignSummary(
+            ImmutableArray<string> categories,
+
+            string createdAt,
+
+            string designId,
+
+            string editor,
+
+            string name,
+
+            string subject,
+
+            string thumbnailUrl,
+
+            string updatedAt)
+        {
+            Categories = categories;
+            CreatedAt = createdAt;
+            DesignId = designId;
+            Editor = editor;
+            Name = name;
+            Subject = subject;
+            ThumbnailUrl = thumbnailUrl;
+            UpdatedAt = updatedAt;
+        }
+    }
+}
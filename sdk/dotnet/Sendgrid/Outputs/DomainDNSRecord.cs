@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class DomainDNSRecord
+    {
+        public readonly string Data;
+        public readonly string Host;
+        public readonly string Name;
+        public readonly string Type;
+        public readonly bool Valid;
+
+        [OutputConstructor]
+        private DomainDNSRecord(
+            string data,
+
+            string host,
+
+            string name,
+
+            string type,
+
+            bool valid)
+        {
+            Data = data;
+            Host = host;
+            Name = name;
+            Type = type;
+            Valid = valid;
+        }
+    }
+}
@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class EmailActivityMessage
+    {
+        public readonly int ClicksCount;
+        public readonly string FromEmail;
+        public readonly string LastEventTime;
+        public readonly string MsgId;
+        public readonly int OpensCount;
+        public readonly string Status;
+        public readonly string Subject;
+        public readonly string ToEmail;
+
+        [OutputConstructor]
+        private EmailActivityMessage(
+            int clicksCount,
+
+            string fromEmail,
+
+            string lastEventTime,
+
+            string msgId,
+
+            int opensCount,
+
+            string status,
+
+            string subject,
+
+            string toEmail)
+        {
+            ClicksCount = clicksCount;
+            FromEmail = fromEmail;
+            LastEventTime = lastEventTime;
+            MsgId = msgId;
+            OpensCount = opensCount;
+            Status = status;
+            Subject = subject;
+            ToEmail = toEmail;
+        }
+    }
+}
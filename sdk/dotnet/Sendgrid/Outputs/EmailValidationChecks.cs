@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class EmailValidationChecks
+    {
+        public readonly bool HasKnownBounces;
+        public readonly bool HasMxOrARecord;
+        public readonly bool HasSuspectedBounces;
+        public readonly bool HasValidAddressSyntax;
+        public readonly bool IsSuspectedDisposableAddress;
+        public readonly bool IsSuspectedRoleAddress;
+
+        [OutputConstructor]
+        private EmailValidationChecks(
+            bool hasKnownBounces,
+
+            bool hasMxOrARecord,
+
+            bool hasSuspectedBounces,
+
+            bool hasValidAddressSyntax,
+
+            bool isSuspectedDisposableAddress,
+
+            bool isSuspectedRoleAddress)
+        {
+            HasKnownBounces = hasKnownBounces;
+            HasMxOrARecord = hasMxOrARecord;
+            HasSuspectedBounces = hasSuspectedBounces;
+            HasValidAddressSyntax = hasValidAddressSyntax;
+            IsSuspectedDisposableAddress = isSuspectedDisposableAddress;
+            IsSuspectedRoleAddress = isSuspectedRoleAddress;
+        }
+    }
+}
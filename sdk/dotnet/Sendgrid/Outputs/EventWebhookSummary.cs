@@ -0,0 +1,82 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class EventWebhookSummary
+    {
+        public readonly bool Bounce;
+        public readonly bool Click;
+        public readonly bool Deferred;
+        public readonly bool Delivered;
+        public readonly bool Dropped;
+        public readonly bool Enabled;
+        public readonly string? FriendlyName;
+        public readonly bool GroupResubscribe;
+        public readonly bool GroupUnsubscribe;
+        public readonly bool Open;
+        public readonly bool Processed;
+        public readonly bool SpamReport;
+        public readonly bool Unsubscribe;
+        public readonly string Url;
+        public readonly string WebhookId;
+
+        [OutputConstructor]
+        private EventWebhookSummary(
+            bool bounce,
+
+            bool click,
+
+            bool deferred,
+
+            bool delivered,
+
+            bool dropped,
+
+            bool enabled,
+
+            string? friendlyName,
+
+            bool groupResubscribe,
+
+            bool groupUnsubscribe,
+
+            bool open,
+
+            bool processed,
+
+            bool spamReport,
+
+            bool unsubscribe,
+
+            string url,
+
+            string webhookId)
+        {
+            Bounce = bounce;
+            Click = click;
+            Deferred = deferred;
+            Delivered = delivered;
+            Dropped = dropped;
+            Enabled = enabled;
+            FriendlyName = friendlyName;
+            GroupResubscribe = groupResubscribe;
+            GroupUnsubscribe = groupUnsubscribe;
+            Open = open;
+            Processed = processed;
+            SpamReport = spamReport;
+            Unsubscribe = unsubscribe;
+            Url = url;
+            WebhookId = webhookId;
+        }
+    }
+}
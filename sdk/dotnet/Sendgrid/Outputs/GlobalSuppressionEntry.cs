@@ -0,0 +1,30 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class GlobalSuppressionEntry
+    {
+        public readonly int Created;
+        public readonly string Email;
+
+        [OutputConstructor]
+        private GlobalSuppressionEntry(
+            int created,
+
+            string email)
+        {
+            Created = created;
+            Email = email;
+        }
+    }
+}
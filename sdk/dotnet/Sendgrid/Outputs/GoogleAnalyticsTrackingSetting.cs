@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class GoogleAnalyticsTrackingSetting
+    {
+        public readonly bool Enabled;
+        public readonly string UtmCampaign;
+        public readonly string UtmContent;
+        public readonly string UtmMedium;
+        public readonly string UtmSource;
+        public readonly string UtmTerm;
+
+        [OutputConstructor]
+        private GoogleAnalyticsTrackingSetting(
+            bool enabled,
+
+            string utmCampaign,
+
+            string utmContent,
+
+            string utmMedium,
+
+            string utmSource,
+
+            string utmTerm)
+        {
+            Enabled = enabled;
+            UtmCampaign = utmCampaign;
+            UtmContent = utmContent;
+            UtmMedium = utmMedium;
+            UtmSource = utmSource;
+            UtmTerm = utmTerm;
+        }
+    }
+}
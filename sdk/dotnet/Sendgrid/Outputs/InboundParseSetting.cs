@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class InboundParseSetting
+    {
+        public readonly string Hostname;
+        public readonly bool SendRaw;
+        public readonly bool SpamCheck;
+        public readonly string Url;
+
+        [OutputConstructor]
+        private InboundParseSetting(
+            string hostname,
+
+            bool sendRaw,
+
+            bool spamCheck,
+
+            string url)
+        {
+            Hostname = hostname;
+            SendRaw = sendRaw;
+            SpamCheck = spamCheck;
+            Url = url;
+        }
+    }
+}
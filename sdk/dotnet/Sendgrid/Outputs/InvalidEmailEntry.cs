@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class InvalidEmailEntry
+    {
+        public readonly int Created;
+        public readonly string Email;
+        public readonly string Reason;
+
+        [OutputConstructor]
+        private InvalidEmailEntry(
+            int created,
+
+            string email,
+
+            string reason)
+        {
+            Created = created;
+            Email = email;
+            Reason = reason;
+        }
+    }
+}
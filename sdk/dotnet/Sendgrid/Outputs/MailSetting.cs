@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class MailSetting
+    {
+        public readonly string Description;
+        public readonly bool Enabled;
+        public readonly string Name;
+        public readonly string Title;
+
+        [OutputConstructor]
+        private MailSetting(
+            string description,
+
+            bool enabled,
+
+            string name,
+
+            string title)
+        {
+            Description = description;
+            Enabled = enabled;
+            Name = name;
+            Title = title;
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class MarketingListSummary
+    {
+        public readonly int ContactCount;
+        public readonly string ListId;
+        public readonly string Name;
+
+        [OutputConstructor]
+        private MarketingListSummary(
+            int contactCount,
+
+            string listId,
+
+            string name)
+        {
+            ContactCount = contactCount;
+            ListId = listId;
+            Name = name;
+        }
+    }
+}
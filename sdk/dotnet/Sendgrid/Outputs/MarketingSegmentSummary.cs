@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class MarketingSegmentSummary
+    {
+        public readonly int ContactsCount;
+        public readonly string CreatedAt;
+        public readonly string Name;
+        public readonly ImmutableArray<string> ParentListIds;
+        public readonly string QueryDsl;
+        public readonly string SegmentId;
+        public readonly string UpdatedAt;
+
+        [OutputConstructor]
+        private MarketingSegmentSummary(
+            int contactsCount,
+
+            string createdAt,
+
+            string name,
+
+            ImmutableArray<string> parentListIds,
+
+            string queryDsl,
+
+            string segmentId,
+
+            string updatedAt)
+        {
+            ContactsCount = contactsCount;
+            CreatedAt = createdAt;
+            Name = name;
+            ParentListIds = parentListIds;
+            QueryDsl = queryDsl;
+            SegmentId = segmentId;
+            UpdatedAt = updatedAt;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class SsoCertificate
+    {
+        public readonly int CertificateId;
+        public readonly string IntegrationId;
+        public readonly int NotAfter;
+        public readonly int NotBefore;
+        public readonly string PublicCertificate;
+
+        [OutputConstructor]
+        private SsoCertificate(
+            int certificateId,
+
+            string integrationId,
+
+            int notAfter,
+
+            int notBefore,
+
+            string publicCertificate)
+        {
+            CertificateId = certificateId;
+            IntegrationId = integrationId;
+            NotAfter = notAfter;
+            NotBefore = notBefore;
+            PublicCertificate = publicCertificate;
+        }
+    }
+}
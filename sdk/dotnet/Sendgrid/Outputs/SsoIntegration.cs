@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class SsoIntegration
+    {
+        public readonly string AudienceUrl;
+        public readonly bool Completed;
+        public readonly bool Enabled;
+        public readonly string EntityId;
+        public readonly string IntegrationId;
+        public readonly string Name;
+        public readonly string SigninUrl;
+        public readonly string SignoutUrl;
+        public readonly string SingleSignonUrl;
+
+        [OutputConstructor]
+        private SsoIntegration(
+            string audienceUrl,
+
+            bool completed,
+
+            bool enabled,
+
+            string entityId,
+
+            string integrationId,
+
+            string name,
+
+            string signinUrl,
+
+            string signoutUrl,
+
+            string singleSignonUrl)
+        {
+            AudienceUrl = audienceUrl;
+            Completed = completed;
+            Enabled = enabled;
+            EntityId = entityId;
+            IntegrationId = integrationId;
+            Name = name;
+            SigninUrl = signinUrl;
+            SignoutUrl = signoutUrl;
+            SingleSignonUrl = singleSignonUrl;
+        }
+    }
+}
@@ -0,0 +1,30 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class StatsEntry
+    {
+        public readonly string Date;
+        public readonly ImmutableArray<Outputs.StatsMetricsEntry> Stats;
+
+        [OutputConstructor]
+        private StatsEntry(
+            string date,
+
+            ImmutableArray<Outputs.StatsMetricsEntry> stats)
+        {
+            Date = date;
+            Stats = stats;
+        }
+    }
+}
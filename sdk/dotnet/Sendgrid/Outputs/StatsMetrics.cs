@@ -0,0 +1,86 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class StatsMetrics
+    {
+        public readonly int Blocks;
+        public readonly int BounceDrops;
+        public readonly int Bounces;
+        public readonly int Clicks;
+        public readonly int Deferred;
+        public readonly int Delivered;
+        public readonly int InvalidEmails;
+        public readonly int Opens;
+        public readonly int Processed;
+        public readonly int Requests;
+        public readonly int SpamReportDrops;
+        public readonly int SpamReports;
+        public readonly int UniqueClicks;
+        public readonly int UniqueOpens;
+        public readonly int UnsubscribeDrops;
+        public readonly int Unsubscribes;
+
+        [OutputConstructor]
+        private StatsMetrics(
+            int blocks,
+
+            int bounceDrops,
+
+            int bounces,
+
+            int clicks,
+
+            int deferred,
+
+            int delivered,
+
+            int invalidEmails,
+
+            int opens,
+
+            int processed,
+
+            int requests,
+
+            int spamReportDrops,
+
+            int spamReports,
+
+            int uniqueClicks,
+
+            int uniqueOpens,
+
+            int unsubscribeDrops,
+
+            int unsubscribes)
+        {
+            Blocks = blocks;
+            BounceDrops = bounceDrops;
+            Bounces = bounces;
+            Clicks = clicks;
+            Deferred = deferred;
+            Delivered = delivered;
+            InvalidEmails = invalidEmails;
+            Opens = opens;
+            Processed = processed;
+            Requests = requests;
+            SpamReportDrops = spamReportDrops;
+            SpamReports = spamReports;
+            UniqueClicks = uniqueClicks;
+            UniqueOpens = uniqueOpens;
+            UnsubscribeDrops = unsubscribeDrops;
+            Unsubscribes = unsubscribes;
+        }
+    }
+}
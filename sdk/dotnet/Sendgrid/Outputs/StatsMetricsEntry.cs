@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class StatsMetricsEntry
+    {
+        public readonly Outputs.StatsMetrics Metrics;
+        public readonly string? Name;
+        public readonly string? Type;
+
+        [OutputConstructor]
+        private StatsMetricsEntry(
+            Outputs.StatsMetrics metrics,
+
+            string? name,
+
+            string? type)
+        {
+            Metrics = metrics;
+            Name = name;
+            Type = type;
+        }
+    }
+}
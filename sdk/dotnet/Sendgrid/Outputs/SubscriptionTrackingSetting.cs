@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class SubscriptionTrackingSetting
+    {
+        public readonly bool Enabled;
+        public readonly string HtmlContent;
+        public readonly string Landing;
+        public readonly string PlainContent;
+        public readonly string Replace;
+        public readonly string Url;
+
+        [OutputConstructor]
+        private SubscriptionTrackingSetting(
+            bool enabled,
+
+            string htmlContent,
+
+            string landing,
+
+            string plainContent,
+
+            string replace,
+
+            string url)
+        {
+            Enabled = enabled;
+            HtmlContent = htmlContent;
+            Landing = landing;
+            PlainContent = plainContent;
+            Replace = replace;
+            Url = url;
+        }
+    }
+}
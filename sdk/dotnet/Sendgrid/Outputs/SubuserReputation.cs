@@ -0,0 +1,30 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid.Outputs
+{
+
+    [OutputType]
+    public sealed class SubuserReputation
+    {
+        public readonly double Reputation;
+        public readonly string Username;
+
+        [OutputConstructor]
+        private SubuserReputation(
+            double reputation,
+
+            string username)
+        {
+            Reputation = reputation;
+            Username = username;
+        }
+    }
+}
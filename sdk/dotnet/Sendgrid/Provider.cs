@@ -14,17 +14,65 @@ namespace Community.Pulumi.Sendgrid
     public partial class Provider : global::Pulumi.ProviderResource
     {
         /// <summary>
-        /// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+        /// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
         /// </summary>
         [Output("apiKey")]
         public Output<string?> ApiKey { get; private set; } = null!;
 
         /// <summary>
-        /// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+        /// The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+        /// </summary>
+        [Output("apiKeyFile")]
+        public Output<string?> ApiKeyFile { get; private set; } = null!;
+
+        /// <summary>
+        /// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
         /// </summary>
         [Output("baseUrl")]
         public Output<string?> BaseUrl { get; private set; } = null!;
 
+        /// <summary>
+        /// The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+        /// </summary>
+        [Output("httpProxy")]
+        public Output<string?> HttpProxy { get; private set; } = null!;
+
+        /// <summary>
+        /// The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+        /// </summary>
+        [Output("httpsProxy")]
+        public Output<string?> HttpsProxy { get; private set; } = null!;
+
+        /// <summary>
+        /// A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+        /// </summary>
+        [Output("noProxy")]
+        public Output<string?> NoProxy { get; private set; } = null!;
+
+        /// <summary>
+        /// The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+        /// </summary>
+        [Output("onBehalfOf")]
+        public Output<string?> OnBehalfOf { get; private set; } = null!;
+
+        /// <summary>
+        /// The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+        /// </summary>
+        [Output("region")]
+        public Output<string?> Region { get; private set; } = null!;
+
+        /// <summary>
+        /// The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.&lt;status&gt;` counters and a `sendgrid.latency` timer.
+        /// </summary>
+        [Output("statsdAddress")]
+        public Output<string?> StatsdAddress { get; private set; } = null!;
+
+        /// <summary>
+        /// A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+        /// </summary>
+        [Output("userAgentSuffix")]
+        public Output<string?> UserAgentSuffix { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Provider resource with the given unique name, arguments, and options.
@@ -47,6 +95,8 @@ namespace Community.Pulumi.Sendgrid
                 AdditionalSecretOutputs =
                 {
                     "apiKey",
+                    "httpProxy",
+                    "httpsProxy",
                 },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
@@ -62,7 +112,7 @@ namespace Community.Pulumi.Sendgrid
         private Input<string>? _apiKey;
 
         /// <summary>
-        /// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+        /// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
         /// </summary>
         public Input<string>? ApiKey
         {
@@ -75,14 +125,196 @@ namespace Community.Pulumi.Sendgrid
         }
 
         /// <summary>
-        /// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+        /// The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+        /// </summary>
+        [Input("apiKeyFile")]
+        public Input<string>? ApiKeyFile { get; set; }
+
+        /// <summary>
+        /// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
         /// </summary>
         [Input("baseUrl")]
         public Input<string>? BaseUrl { get; set; }
 
+        /// <summary>
+        /// How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+        /// </summary>
+        [Input("circuitBreakerCooldownSeconds", json: true)]
+        public Input<int>? CircuitBreakerCooldownSeconds { get; set; }
+
+        /// <summary>
+        /// The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+        /// </summary>
+        [Input("circuitBreakerThreshold", json: true)]
+        public Input<int>? CircuitBreakerThreshold { get; set; }
+
+        /// <summary>
+        /// Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+        /// </summary>
+        [Input("enableLegacyContactDb", json: true)]
+        public Input<bool>? EnableLegacyContactDb { get; set; }
+
+        [Input("headers", json: true)]
+        private InputMap<string>? _headers;
+
+        /// <summary>
+        /// Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+        /// </summary>
+        public InputMap<string> Headers
+        {
+            get => _headers ?? (_headers = new InputMap<string>());
+            set => _headers = value;
+        }
+
+        [Input("httpProxy")]
+        private Input<string>? _httpProxy;
+
+        /// <summary>
+        /// The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+        /// </summary>
+        public Input<string>? HttpProxy
+        {
+            get => _httpProxy;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _httpProxy = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("httpsProxy")]
+        private Input<string>? _httpsProxy;
+
+        /// <summary>
+        /// The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+        /// </summary>
+        public Input<string>? HttpsProxy
+        {
+            get => _httpsProxy;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _httpsProxy = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+        /// </summary>
+        [Input("logMetrics", json: true)]
+        public Input<bool>? LogMetrics { get; set; }
+
+        /// <summary>
+        /// The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+        /// </summary>
+        [Input("maxConcurrentRequests", json: true)]
+        public Input<int>? MaxConcurrentRequests { get; set; }
+
+        /// <summary>
+        /// The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+        /// </summary>
+        [Input("maxRetries", json: true)]
+        public Input<int>? MaxRetries { get; set; }
+
+        /// <summary>
+        /// A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+        /// </summary>
+        [Input("noProxy")]
+        public Input<string>? NoProxy { get; set; }
+
+        /// <summary>
+        /// The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+        /// </summary>
+        [Input("onBehalfOf")]
+        public Input<string>? OnBehalfOf { get; set; }
+
+        /// <summary>
+        /// The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+        /// </summary>
+        [Input("rateLimitBurst", json: true)]
+        public Input<int>? RateLimitBurst { get; set; }
+
+        /// <summary>
+        /// The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+        /// </summary>
+        [Input("rateLimitPerSecond", json: true)]
+        public Input<double>? RateLimitPerSecond { get; set; }
+
+        /// <summary>
+        /// The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+        /// </summary>
+        [Input("readTimeoutSeconds", json: true)]
+        public Input<int>? ReadTimeoutSeconds { get; set; }
+
+        /// <summary>
+        /// The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+        /// </summary>
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
+        /// <summary>
+        /// The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+        /// </summary>
+        [Input("requestTimeoutSeconds", json: true)]
+        public Input<int>? RequestTimeoutSeconds { get; set; }
+
+        [Input("requiredScopes", json: true)]
+        private InputList<string>? _requiredScopes;
+
+        /// <summary>
+        /// API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+        /// </summary>
+        public InputList<string> RequiredScopes
+        {
+            get => _requiredScopes ?? (_requiredScopes = new InputList<string>());
+            set => _requiredScopes = value;
+        }
+
+        /// <summary>
+        /// The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+        /// </summary>
+        [Input("retryBudget", json: true)]
+        public Input<int>? RetryBudget { get; set; }
+
+        /// <summary>
+        /// The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.&lt;status&gt;` counters and a `sendgrid.latency` timer.
+        /// </summary>
+        [Input("statsdAddress")]
+        public Input<string>? StatsdAddress { get; set; }
+
+        /// <summary>
+        /// A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+        /// </summary>
+        [Input("userAgentSuffix")]
+        public Input<string>? UserAgentSuffix { get; set; }
+
+        /// <summary>
+        /// Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+        /// </summary>
+        [Input("validateApiKey", json: true)]
+        public Input<bool>? ValidateApiKey { get; set; }
+
+        /// <summary>
+        /// Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+        /// </summary>
+        [Input("validateOnPreview", json: true)]
+        public Input<bool>? ValidateOnPreview { get; set; }
+
+        /// <summary>
+        /// The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
+        /// </summary>
+        [Input("writeTimeoutSeconds", json: true)]
+        public Input<int>? WriteTimeoutSeconds { get; set; }
+
         public ProviderArgs()
         {
-            BaseUrl = "https://api.sendgrid.com";
+            CircuitBreakerCooldownSeconds = 30;
+            CircuitBreakerThreshold = 5;
+            MaxConcurrentRequests = 10;
+            MaxRetries = 3;
+            RequestTimeoutSeconds = 30;
+            RetryBudget = 20;
+            ValidateApiKey = true;
         }
         public static new ProviderArgs Empty => new ProviderArgs();
     }
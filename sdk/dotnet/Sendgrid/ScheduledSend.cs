@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Manages the status of a SendGrid scheduled send.
+    /// 
+    /// Emails sent with a batch ID and a send_at time can be paused or cancelled until they are sent. Setting the status to "pause" holds the batch, and "cancel" discards it when its send time arrives. Deleting this resource removes the status, so a paused batch is sent again.
+    /// 
+    /// Batch IDs can be created with the SendGrid API and passed to the mail send requests of the batch.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:ScheduledSend")]
+    public partial class ScheduledSend : global::Pulumi.CustomResource
+    {
+        [Output("batchId")]
+        public Output<string> BatchId { get; private set; } = null!;
+
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("status")]
+        public Output<string> Status { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ScheduledSend resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ScheduledSend(string name, ScheduledSendArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:ScheduledSend", name, args ?? new ScheduledSendArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ScheduledSend(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:ScheduledSend", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "batchId",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ScheduledSend resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ScheduledSend Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ScheduledSend(name, id, options);
+        }
+    }
+
+    public sealed class ScheduledSendArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("batchId", required: true)]
+        public Input<string> BatchId { get; set; } = null!;
+
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("status", required: true)]
+        public Input<string> Status { get; set; } = null!;
+
+        public ScheduledSendArgs()
+        {
+        }
+        public static new ScheduledSendArgs Empty => new ScheduledSendArgs();
+    }
+}
@@ -0,0 +1,93 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class SearchEmailActivity
+    {
+        /// <summary>
+        /// Searches the SendGrid Email Activity feed.
+        /// 
+        /// Filter by recipient, delivery status or message ID to verify that a message was delivered, for example as a deploy-time smoke test. Requires the Email Activity add-on.
+        /// </summary>
+        public static Task<SearchEmailActivityResult> InvokeAsync(SearchEmailActivityArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<SearchEmailActivityResult>("sendgrid:index:searchEmailActivity", args ?? new SearchEmailActivityArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Searches the SendGrid Email Activity feed.
+        /// 
+        /// Filter by recipient, delivery status or message ID to verify that a message was delivered, for example as a deploy-time smoke test. Requires the Email Activity add-on.
+        /// </summary>
+        public static Output<SearchEmailActivityResult> Invoke(SearchEmailActivityInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<SearchEmailActivityResult>("sendgrid:index:searchEmailActivity", args ?? new SearchEmailActivityInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Searches the SendGrid Email Activity feed.
+        /// 
+        /// Filter by recipient, delivery status or message ID to verify that a message was delivered, for example as a deploy-time smoke test. Requires the Email Activity add-on.
+        /// </summary>
+        public static Output<SearchEmailActivityResult> Invoke(SearchEmailActivityInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<SearchEmailActivityResult>("sendgrid:index:searchEmailActivity", args ?? new SearchEmailActivityInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class SearchEmailActivityArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("limit")]
+        public int? Limit { get; set; }
+
+        [Input("msgId")]
+        public string? MsgId { get; set; }
+
+        [Input("status")]
+        public string? Status { get; set; }
+
+        [Input("toEmail")]
+        public string? ToEmail { get; set; }
+
+        public SearchEmailActivityArgs()
+        {
+        }
+        public static new SearchEmailActivityArgs Empty => new SearchEmailActivityArgs();
+    }
+
+    public sealed class SearchEmailActivityInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("limit")]
+        public Input<int>? Limit { get; set; }
+
+        [Input("msgId")]
+        public Input<string>? MsgId { get; set; }
+
+        [Input("status")]
+        public Input<string>? Status { get; set; }
+
+        [Input("toEmail")]
+        public Input<string>? ToEmail { get; set; }
+
+        public SearchEmailActivityInvokeArgs()
+        {
+        }
+        public static new SearchEmailActivityInvokeArgs Empty => new SearchEmailActivityInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class SearchEmailActivityResult
+    {
+        public readonly ImmutableArray<Outputs.EmailActivityMessage> Messages;
+
+        [OutputConstructor]
+        private SearchEmailActivityResult(ImmutableArray<Outputs.EmailActivityMessage> messages)
+        {
+            Messages = messages;
+        }
+    }
+}
@@ -0,0 +1,117 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class SendTestEmail
+    {
+        /// <summary>
+        /// Sends a single test email through the Mail Send API.
+        /// 
+        /// Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. An email is sent every time the function is called, including during previews, so call it from a dedicated smoke test program, or set sandboxMode to only validate the request.
+        /// </summary>
+        public static Task<SendTestEmailResult> InvokeAsync(SendTestEmailArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<SendTestEmailResult>("sendgrid:index:sendTestEmail", args ?? new SendTestEmailArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Sends a single test email through the Mail Send API.
+        /// 
+        /// Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. An email is sent every time the function is called, including during previews, so call it from a dedicated smoke test program, or set sandboxMode to only validate the request.
+        /// </summary>
+        public static Output<SendTestEmailResult> Invoke(SendTestEmailInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<SendTestEmailResult>("sendgrid:index:sendTestEmail", args ?? new SendTestEmailInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Sends a single test email through the Mail Send API.
+        /// 
+        /// Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. An email is sent every time the function is called, including during previews, so call it from a dedicated smoke test program, or set sandboxMode to only validate the request.
+        /// </summary>
+        public static Output<SendTestEmailResult> Invoke(SendTestEmailInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<SendTestEmailResult>("sendgrid:index:sendTestEmail", args ?? new SendTestEmailInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class SendTestEmailArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("content")]
+        public string? Content { get; set; }
+
+        [Input("dynamicTemplateData")]
+        public string? DynamicTemplateData { get; set; }
+
+        [Input("from", required: true)]
+        public string From { get; set; } = null!;
+
+        [Input("fromName")]
+        public string? FromName { get; set; }
+
+        [Input("sandboxMode")]
+        public bool? SandboxMode { get; set; }
+
+        [Input("subject")]
+        public string? Subject { get; set; }
+
+        [Input("templateId")]
+        public string? TemplateId { get; set; }
+
+        [Input("to", required: true)]
+        public string To { get; set; } = null!;
+
+        public SendTestEmailArgs()
+        {
+        }
+        public static new SendTestEmailArgs Empty => new SendTestEmailArgs();
+    }
+
+    public sealed class SendTestEmailInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("content")]
+        public Input<string>? Content { get; set; }
+
+        [Input("dynamicTemplateData")]
+        public Input<string>? DynamicTemplateData { get; set; }
+
+        [Input("from", required: true)]
+        public Input<string> From { get; set; } = null!;
+
+        [Input("fromName")]
+        public Input<string>? FromName { get; set; }
+
+        [Input("sandboxMode")]
+        public Input<bool>? SandboxMode { get; set; }
+
+        [Input("subject")]
+        public Input<string>? Subject { get; set; }
+
+        [Input("templateId")]
+        public Input<string>? TemplateId { get; set; }
+
+        [Input("to", required: true)]
+        public Input<string> To { get; set; } = null!;
+
+        public SendTestEmailInvokeArgs()
+        {
+        }
+        public static new SendTestEmailInvokeArgs Empty => new SendTestEmailInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class SendTestEmailResult
+    {
+        public readonly string MessageId;
+
+        [OutputConstructor]
+        private SendTestEmailResult(string messageId)
+        {
+            MessageId = messageId;
+        }
+    }
+}
@@ -20,6 +20,9 @@ namespace Community.Pulumi.Sendgrid
     [SendgridResourceType("sendgrid:index:Subuser")]
     public partial class Subuser : global::Pulumi.CustomResource
     {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("disabled")]
         public Output<bool> Disabled { get; private set; } = null!;
 
@@ -84,6 +87,9 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class SubuserArgs : global::Pulumi.ResourceArgs
     {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("disabled")]
         public Input<bool>? Disabled { get; set; }
 
@@ -0,0 +1,98 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Associates a domain authentication of the parent account with a subuser.
+    /// 
+    /// The subuser can then send from the authenticated domain without authenticating it itself. A subuser has at most one associated domain, so the resource ID is the subuser's username. Deleting the resource removes the association.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:SubuserDomainAssociation")]
+    public partial class SubuserDomainAssociation : global::Pulumi.CustomResource
+    {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("domain")]
+        public Output<string> Domain { get; private set; } = null!;
+
+        [Output("domainId")]
+        public Output<int> DomainId { get; private set; } = null!;
+
+        [Output("username")]
+        public Output<string> Username { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SubuserDomainAssociation resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SubuserDomainAssociation(string name, SubuserDomainAssociationArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:SubuserDomainAssociation", name, args ?? new SubuserDomainAssociationArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private SubuserDomainAssociation(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:SubuserDomainAssociation", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "domainId",
+                    "username",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing SubuserDomainAssociation resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static SubuserDomainAssociation Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new SubuserDomainAssociation(name, id, options);
+        }
+    }
+
+    public sealed class SubuserDomainAssociationArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("domainId", required: true)]
+        public Input<int> DomainId { get; set; } = null!;
+
+        [Input("username", required: true)]
+        public Input<string> Username { get; set; } = null!;
+
+        public SubuserDomainAssociationArgs()
+        {
+        }
+        public static new SubuserDomainAssociationArgs Empty => new SubuserDomainAssociationArgs();
+    }
+}
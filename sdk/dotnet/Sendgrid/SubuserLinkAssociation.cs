@@ -0,0 +1,98 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Associates a link branding of the parent account with a subuser.
+    /// 
+    /// The links in the subuser's emails then use the branded domain without the subuser branding it itself. A subuser has at most one associated link branding, so the resource ID is the subuser's username. Deleting the resource removes the association.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:SubuserLinkAssociation")]
+    public partial class SubuserLinkAssociation : global::Pulumi.CustomResource
+    {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("domain")]
+        public Output<string> Domain { get; private set; } = null!;
+
+        [Output("linkId")]
+        public Output<int> LinkId { get; private set; } = null!;
+
+        [Output("username")]
+        public Output<string> Username { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SubuserLinkAssociation resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SubuserLinkAssociation(string name, SubuserLinkAssociationArgs args, CustomResourceOptions? options = null)
+            : base("sendgrid:index:SubuserLinkAssociation", name, args ?? new SubuserLinkAssociationArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private SubuserLinkAssociation(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sendgrid:index:SubuserLinkAssociation", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "linkId",
+                    "username",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing SubuserLinkAssociation resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static SubuserLinkAssociation Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new SubuserLinkAssociation(name, id, options);
+        }
+    }
+
+    public sealed class SubuserLinkAssociationArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("linkId", required: true)]
+        public Input<int> LinkId { get; set; } = null!;
+
+        [Input("username", required: true)]
+        public Input<string> Username { get; set; } = null!;
+
+        public SubuserLinkAssociationArgs()
+        {
+        }
+        public static new SubuserLinkAssociationArgs Empty => new SubuserLinkAssociationArgs();
+    }
+}
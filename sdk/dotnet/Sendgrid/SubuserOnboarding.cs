@@ -0,0 +1,120 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Provisions a SendGrid subuser for a tenant.
+    /// 
+    /// The component creates the Subuser with its IPs, then an ApiKey in the subuser's account through a provider configured with onBehalfOf, and associates the given domain authentication and link branding with the subuser, in the order SendGrid requires. The on-behalf-of provider takes the settings of the provider constructing the component, such as its credentials, proxy, timeouts, retries and headers, except onBehalfOf, validateApiKey and requiredScopes.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:SubuserOnboarding")]
+    public partial class SubuserOnboarding : global::Pulumi.ComponentResource
+    {
+        [Output("apiKeyId")]
+        public Output<string> ApiKeyId { get; private set; } = null!;
+
+        [Output("apiKeyValue")]
+        public Output<string> ApiKeyValue { get; private set; } = null!;
+
+        [Output("userId")]
+        public Output<int> UserId { get; private set; } = null!;
+
+        [Output("username")]
+        public Output<string> Username { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SubuserOnboarding resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SubuserOnboarding(string name, SubuserOnboardingArgs args, ComponentResourceOptions? options = null)
+            : base("sendgrid:index:SubuserOnboarding", name, args ?? new SubuserOnboardingArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                AdditionalSecretOutputs =
+                {
+                    "apiKeyValue",
+                },
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class SubuserOnboardingArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("apiKeyName")]
+        public Input<string>? ApiKeyName { get; set; }
+
+        [Input("apiKeyScopes")]
+        private InputList<string>? _apiKeyScopes;
+        public InputList<string> ApiKeyScopes
+        {
+            get => _apiKeyScopes ?? (_apiKeyScopes = new InputList<string>());
+            set => _apiKeyScopes = value;
+        }
+
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("domainId")]
+        public Input<int>? DomainId { get; set; }
+
+        [Input("email", required: true)]
+        public Input<string> Email { get; set; } = null!;
+
+        [Input("ips")]
+        private InputList<string>? _ips;
+        public InputList<string> Ips
+        {
+            get => _ips ?? (_ips = new InputList<string>());
+            set => _ips = value;
+        }
+
+        [Input("linkId")]
+        public Input<int>? LinkId { get; set; }
+
+        [Input("password", required: true)]
+        private Input<string>? _password;
+        public Input<string>? Password
+        {
+            get => _password;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _password = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
+        [Input("username", required: true)]
+        public Input<string> Username { get; set; } = null!;
+
+        public SubuserOnboardingArgs()
+        {
+        }
+        public static new SubuserOnboardingArgs Empty => new SubuserOnboardingArgs();
+    }
+}
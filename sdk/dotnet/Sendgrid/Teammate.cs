@@ -20,6 +20,9 @@ namespace Community.Pulumi.Sendgrid
     [SendgridResourceType("sendgrid:index:Teammate")]
     public partial class Teammate : global::Pulumi.CustomResource
     {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("email")]
         public Output<string> Email { get; private set; } = null!;
 
@@ -68,6 +71,10 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                AdditionalSecretOutputs =
+                {
+                    "token",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -90,6 +97,9 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class TeammateArgs : global::Pulumi.ResourceArgs
     {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("email", required: true)]
         public Input<string> Email { get; set; } = null!;
 
@@ -17,16 +17,22 @@ namespace Community.Pulumi.Sendgrid
     /// 
     /// Templates can be either 'legacy' (plain text/HTML) or 'dynamic' (supporting handlebars syntax for personalization).
     /// 
-    /// **Note:** Template versions are managed separately via the TemplateVersion resource.
+    /// **Note:** Template versions are managed separately via the TemplateVersion resource. Deleting a template that still has versions, e.g. ones created outside of Pulumi, fails unless forceDelete is set.
     /// </summary>
     [SendgridResourceType("sendgrid:index:Template")]
     public partial class Template : global::Pulumi.CustomResource
     {
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
+        [Output("forceDelete")]
+        public Output<bool?> ForceDelete { get; private set; } = null!;
+
         [Output("generation")]
         public Output<string> Generation { get; private set; } = null!;
 
         [Output("name")]
-        public Output<string> Name { get; private set; } = null!;
+        public Output<string?> Name { get; private set; } = null!;
 
         [Output("templateId")]
         public Output<string> TemplateId { get; private set; } = null!;
@@ -61,6 +67,10 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "generation",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -83,11 +93,17 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class TemplateArgs : global::Pulumi.ResourceArgs
     {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("forceDelete")]
+        public Input<bool>? ForceDelete { get; set; }
+
         [Input("generation", required: true)]
         public Input<string> Generation { get; set; } = null!;
 
-        [Input("name", required: true)]
-        public Input<string> Name { get; set; } = null!;
+        [Input("name")]
+        public Input<string>? Name { get; set; }
 
         public TemplateArgs()
         {
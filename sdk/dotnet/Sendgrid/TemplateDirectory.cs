@@ -0,0 +1,79 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Syncs a directory of HTML files to versions of a SendGrid template.
+    /// 
+    /// Each .html file in the directory becomes a TemplateVersion named after the file without its extension. Files are ordered by name, and the last one is the newest and is made the active version, so names should sort by age, e.g. with a date or version prefix. A version is only updated when the content of its file changes; adding a file adds a version and removing one deletes its version.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:TemplateDirectory")]
+    public partial class TemplateDirectory : global::Pulumi.ComponentResource
+    {
+        [Output("activeVersionId")]
+        public Output<string> ActiveVersionId { get; private set; } = null!;
+
+        [Output("contentHashes")]
+        public Output<ImmutableDictionary<string, string>> ContentHashes { get; private set; } = null!;
+
+        [Output("versionIds")]
+        public Output<ImmutableDictionary<string, string>> VersionIds { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a TemplateDirectory resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public TemplateDirectory(string name, TemplateDirectoryArgs args, ComponentResourceOptions? options = null)
+            : base("sendgrid:index:TemplateDirectory", name, args ?? new TemplateDirectoryArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class TemplateDirectoryArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("directory", required: true)]
+        public string Directory { get; set; } = null!;
+
+        [Input("generatePlainContent")]
+        public Input<bool>? GeneratePlainContent { get; set; }
+
+        [Input("subject")]
+        public Input<string>? Subject { get; set; }
+
+        [Input("templateId", required: true)]
+        public Input<string> TemplateId { get; set; } = null!;
+
+        public TemplateDirectoryArgs()
+        {
+        }
+        public static new TemplateDirectoryArgs Empty => new TemplateDirectoryArgs();
+    }
+}
@@ -17,6 +17,8 @@ namespace Community.Pulumi.Sendgrid
     /// 
     /// Each template can have multiple versions, but only one can be active at a time. The active version is used when sending emails through the template.
     /// 
+    /// Activating a version deactivates the one that was active. When that happens to a version with active set to 1, refresh records it as inactive with the deactivatedBy output, and it is not activated again until active is changed, so two versions set active do not take turns.
+    /// 
     /// **Note:** Dynamic templates support handlebars syntax for personalization.
     /// </summary>
     [SendgridResourceType("sendgrid:index:TemplateVersion")]
@@ -25,6 +27,12 @@ namespace Community.Pulumi.Sendgrid
         [Output("active")]
         public Output<int?> Active { get; private set; } = null!;
 
+        [Output("deactivatedBy")]
+        public Output<string?> DeactivatedBy { get; private set; } = null!;
+
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("editor")]
         public Output<string?> Editor { get; private set; } = null!;
 
@@ -40,6 +48,9 @@ namespace Community.Pulumi.Sendgrid
         [Output("plainContent")]
         public Output<string?> PlainContent { get; private set; } = null!;
 
+        [Output("renderPreview")]
+        public Output<bool?> RenderPreview { get; private set; } = null!;
+
         [Output("subject")]
         public Output<string?> Subject { get; private set; } = null!;
 
@@ -82,6 +93,10 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "templateId",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -107,6 +122,9 @@ namespace Community.Pulumi.Sendgrid
         [Input("active")]
         public Input<int>? Active { get; set; }
 
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("editor")]
         public Input<string>? Editor { get; set; }
 
@@ -122,6 +140,9 @@ namespace Community.Pulumi.Sendgrid
         [Input("plainContent")]
         public Input<string>? PlainContent { get; set; }
 
+        [Input("renderPreview")]
+        public Input<bool>? RenderPreview { get; set; }
+
         [Input("subject")]
         public Input<string>? Subject { get; set; }
 
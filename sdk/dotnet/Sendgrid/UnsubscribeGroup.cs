@@ -16,13 +16,26 @@ namespace Community.Pulumi.Sendgrid
     /// Unsubscribe groups allow recipients to opt out of specific types of emails while still receiving others. For example, you might have separate groups for marketing, newsletters, and product updates, allowing users to choose which types of emails they want to receive.
     /// 
     /// When a recipient unsubscribes from a group, they will no longer receive emails that are associated with that group.
+    /// 
+    /// Deleting the account's default group fails, so emails sent without a group keep an unsubscribe option. Make another group the default first, e.g. by creating one with isDefault set to true.
+    /// 
+    /// When SendGrid refuses to delete a group because it still has suppressions, the delete fails unless forceDelete is set, in which case the suppressions are removed and the delete retried.
     /// </summary>
     [SendgridResourceType("sendgrid:index:UnsubscribeGroup")]
     public partial class UnsubscribeGroup : global::Pulumi.CustomResource
     {
+        [Output("adoptExisting")]
+        public Output<bool?> AdoptExisting { get; private set; } = null!;
+
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("description")]
         public Output<string?> Description { get; private set; } = null!;
 
+        [Output("forceDelete")]
+        public Output<bool?> ForceDelete { get; private set; } = null!;
+
         [Output("groupId")]
         public Output<int> GroupId { get; private set; } = null!;
 
@@ -30,7 +43,7 @@ namespace Community.Pulumi.Sendgrid
         public Output<bool?> IsDefault { get; private set; } = null!;
 
         [Output("name")]
-        public Output<string> Name { get; private set; } = null!;
+        public Output<string?> Name { get; private set; } = null!;
 
         [Output("unsubscribes")]
         public Output<int> Unsubscribes { get; private set; } = null!;
@@ -43,7 +56,7 @@ namespace Community.Pulumi.Sendgrid
         /// <param name="name">The unique name of the resource</param>
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
-        public UnsubscribeGroup(string name, UnsubscribeGroupArgs args, CustomResourceOptions? options = null)
+        public UnsubscribeGroup(string name, UnsubscribeGroupArgs? args = null, CustomResourceOptions? options = null)
             : base("sendgrid:index:UnsubscribeGroup", name, args ?? new UnsubscribeGroupArgs(), MakeResourceOptions(options, ""))
         {
         }
@@ -81,14 +94,23 @@ namespace Community.Pulumi.Sendgrid
 
     public sealed class UnsubscribeGroupArgs : global::Pulumi.ResourceArgs
     {
+        [Input("adoptExisting")]
+        public Input<bool>? AdoptExisting { get; set; }
+
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("description")]
         public Input<string>? Description { get; set; }
 
+        [Input("forceDelete")]
+        public Input<bool>? ForceDelete { get; set; }
+
         [Input("isDefault")]
         public Input<bool>? IsDefault { get; set; }
 
-        [Input("name", required: true)]
-        public Input<string> Name { get; set; } = null!;
+        [Input("name")]
+        public Input<string>? Name { get; set; }
 
         public UnsubscribeGroupArgs()
         {
@@ -0,0 +1,108 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    public static class ValidateEmail
+    {
+        /// <summary>
+        /// Validates an email address with the SendGrid Email Address Validation API.
+        /// 
+        /// Returns a verdict, a score and the checks behind them, so provisioning programs can vet alert recipients, teammate emails and verified sender addresses before creating resources.
+        /// 
+        /// Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.
+        /// </summary>
+        public static Task<ValidateEmailResult> InvokeAsync(ValidateEmailArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ValidateEmailResult>("sendgrid:index:validateEmail", args ?? new ValidateEmailArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Validates an email address with the SendGrid Email Address Validation API.
+        /// 
+        /// Returns a verdict, a score and the checks behind them, so provisioning programs can vet alert recipients, teammate emails and verified sender addresses before creating resources.
+        /// 
+        /// Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.
+        /// </summary>
+        public static Output<ValidateEmailResult> Invoke(ValidateEmailInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ValidateEmailResult>("sendgrid:index:validateEmail", args ?? new ValidateEmailInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Validates an email address with the SendGrid Email Address Validation API.
+        /// 
+        /// Returns a verdict, a score and the checks behind them, so provisioning programs can vet alert recipients, teammate emails and verified sender addresses before creating resources.
+        /// 
+        /// Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.
+        /// </summary>
+        public static Output<ValidateEmailResult> Invoke(ValidateEmailInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<ValidateEmailResult>("sendgrid:index:validateEmail", args ?? new ValidateEmailInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ValidateEmailArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("email", required: true)]
+        public string Email { get; set; } = null!;
+
+        [Input("source")]
+        public string? Source { get; set; }
+
+        public ValidateEmailArgs()
+        {
+        }
+        public static new ValidateEmailArgs Empty => new ValidateEmailArgs();
+    }
+
+    public sealed class ValidateEmailInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("email", required: true)]
+        public Input<string> Email { get; set; } = null!;
+
+        [Input("source")]
+        public Input<string>? Source { get; set; }
+
+        public ValidateEmailInvokeArgs()
+        {
+        }
+        public static new ValidateEmailInvokeArgs Empty => new ValidateEmailInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ValidateEmailResult
+    {
+        public readonly Outputs.EmailValidationChecks Checks;
+        public readonly string Host;
+        public readonly string Local;
+        public readonly double Score;
+        public readonly string? Suggestion;
+        public readonly string Verdict;
+
+        [OutputConstructor]
+        private ValidateEmailResult(
+            Outputs.EmailValidationChecks checks,
+
+            string host,
+
+            string local,
+
+            double score,
+
+            string? suggestion,
+
+            string verdict)
+        {
+            Checks = checks;
+            Host = host;
+            Local = local;
+            Score = score;
+            Suggestion = suggestion;
+            Verdict = verdict;
+        }
+    }
+}
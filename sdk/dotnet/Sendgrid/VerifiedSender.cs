@@ -32,6 +32,9 @@ namespace Community.Pulumi.Sendgrid
         [Output("country")]
         public Output<string> Country { get; private set; } = null!;
 
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         [Output("fromEmail")]
         public Output<string> FromEmail { get; private set; } = null!;
 
@@ -42,7 +45,7 @@ namespace Community.Pulumi.Sendgrid
         public Output<bool> Locked { get; private set; } = null!;
 
         [Output("nickname")]
-        public Output<string> Nickname { get; private set; } = null!;
+        public Output<string?> Nickname { get; private set; } = null!;
 
         [Output("replyTo")]
         public Output<string> ReplyTo { get; private set; } = null!;
@@ -59,6 +62,15 @@ namespace Community.Pulumi.Sendgrid
         [Output("verified")]
         public Output<bool> Verified { get; private set; } = null!;
 
+        [Output("waitForVerification")]
+        public Output<bool?> WaitForVerification { get; private set; } = null!;
+
+        [Output("waitIntervalSeconds")]
+        public Output<int?> WaitIntervalSeconds { get; private set; } = null!;
+
+        [Output("waitTimeoutSeconds")]
+        public Output<int?> WaitTimeoutSeconds { get; private set; } = null!;
+
         [Output("zip")]
         public Output<string?> Zip { get; private set; } = null!;
 
@@ -86,6 +98,10 @@ namespace Community.Pulumi.Sendgrid
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+                ReplaceOnChanges =
+                {
+                    "fromEmail",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -120,14 +136,17 @@ namespace Community.Pulumi.Sendgrid
         [Input("country", required: true)]
         public Input<string> Country { get; set; } = null!;
 
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         [Input("fromEmail", required: true)]
         public Input<string> FromEmail { get; set; } = null!;
 
         [Input("fromName")]
         public Input<string>? FromName { get; set; }
 
-        [Input("nickname", required: true)]
-        public Input<string> Nickname { get; set; } = null!;
+        [Input("nickname")]
+        public Input<string>? Nickname { get; set; }
 
         [Input("replyTo", required: true)]
         public Input<string> ReplyTo { get; set; } = null!;
@@ -138,6 +157,15 @@ namespace Community.Pulumi.Sendgrid
         [Input("state")]
         public Input<string>? State { get; set; }
 
+        [Input("waitForVerification")]
+        public Input<bool>? WaitForVerification { get; set; }
+
+        [Input("waitIntervalSeconds")]
+        public Input<int>? WaitIntervalSeconds { get; set; }
+
+        [Input("waitTimeoutSeconds")]
+        public Input<int>? WaitTimeoutSeconds { get; set; }
+
         [Input("zip")]
         public Input<string>? Zip { get; set; }
 
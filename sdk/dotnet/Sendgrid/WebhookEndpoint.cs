@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Community.Pulumi.Sendgrid
+{
+    /// <summary>
+    /// Sets up a SendGrid Event Webhook for a receiving service.
+    /// 
+    /// The component creates an EventWebhook posting the given events, with optional OAuth settings, enables its signature verification and sends test events once it is set up, which the service's logs show it received. The webhookId and publicKey outputs are what the receiving service needs to verify events.
+    /// </summary>
+    [SendgridResourceType("sendgrid:index:WebhookEndpoint")]
+    public partial class WebhookEndpoint : global::Pulumi.ComponentResource
+    {
+        [Output("publicKey")]
+        public Output<string> PublicKey { get; private set; } = null!;
+
+        [Output("webhookId")]
+        public Output<string> WebhookId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a WebhookEndpoint resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public WebhookEndpoint(string name, WebhookEndpointArgs args, ComponentResourceOptions? options = null)
+            : base("sendgrid:index:WebhookEndpoint", name, args ?? new WebhookEndpointArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/JDetmar/pulumi-sendgrid",
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class WebhookEndpointArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
+        [Input("events", required: true)]
+        private InputList<string>? _events;
+        public InputList<string> Events
+        {
+            get => _events ?? (_events = new InputList<string>());
+            set => _events = value;
+        }
+
+        [Input("friendlyName")]
+        public Input<string>? FriendlyName { get; set; }
+
+        [Input("oauthClientId")]
+        public Input<string>? OauthClientId { get; set; }
+
+        [Input("oauthClientSecret")]
+        private Input<string>? _oauthClientSecret;
+        public Input<string>? OauthClientSecret
+        {
+            get => _oauthClientSecret;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _oauthClientSecret = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("oauthTokenUrl")]
+        public Input<string>? OauthTokenUrl { get; set; }
+
+        [Input("signatureVerification")]
+        public bool? SignatureVerification { get; set; }
+
+        [Input("testDelivery")]
+        public bool? TestDelivery { get; set; }
+
+        [Input("url", required: true)]
+        public Input<string> Url { get; set; } = null!;
+
+        public WebhookEndpointArgs()
+        {
+        }
+        public static new WebhookEndpointArgs Empty => new WebhookEndpointArgs();
+    }
+}
@@ -20,16 +20,20 @@ import (
 // 2. **stats_notification**: Sends periodic email statistics (daily, weekly, or monthly).
 //
 // You can create multiple alerts of the same type with different email recipients.
+//
+// SendGrid does not reject duplicate alerts, so with adoptExisting an identical alert is looked up before creating one, and a retried deployment takes it over instead of creating a second alert.
 type Alert struct {
 	pulumi.CustomResourceState
 
-	AlertId    pulumi.IntOutput       `pulumi:"alertId"`
-	CreatedAt  pulumi.IntOutput       `pulumi:"createdAt"`
-	EmailTo    pulumi.StringOutput    `pulumi:"emailTo"`
-	Frequency  pulumi.StringPtrOutput `pulumi:"frequency"`
-	Percentage pulumi.IntPtrOutput    `pulumi:"percentage"`
-	Type       pulumi.StringOutput    `pulumi:"type"`
-	UpdatedAt  pulumi.IntOutput       `pulumi:"updatedAt"`
+	AdoptExisting      pulumi.BoolPtrOutput   `pulumi:"adoptExisting"`
+	AlertId            pulumi.IntOutput       `pulumi:"alertId"`
+	CreatedAt          pulumi.IntOutput       `pulumi:"createdAt"`
+	DeletionProtection pulumi.BoolPtrOutput   `pulumi:"deletionProtection"`
+	EmailTo            pulumi.StringOutput    `pulumi:"emailTo"`
+	Frequency          pulumi.StringPtrOutput `pulumi:"frequency"`
+	Percentage         pulumi.IntPtrOutput    `pulumi:"percentage"`
+	Type               pulumi.StringOutput    `pulumi:"type"`
+	UpdatedAt          pulumi.IntOutput       `pulumi:"updatedAt"`
 }
 
 // NewAlert registers a new resource with the given unique name, arguments, and options.
@@ -45,6 +49,10 @@ func NewAlert(ctx *pulumi.Context,
 	if args.Type == nil {
 		return nil, errors.New("invalid value for required argument 'Type'")
 	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"type",
+	})
+	opts = append(opts, replaceOnChanges)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Alert
 	err := ctx.RegisterResource("sendgrid:index:Alert", name, args, &resource, opts...)
@@ -78,18 +86,22 @@ func (AlertState) ElementType() reflect.Type {
 }
 
 type alertArgs struct {
-	EmailTo    string  `pulumi:"emailTo"`
-	Frequency  *string `pulumi:"frequency"`
-	Percentage *int    `pulumi:"percentage"`
-	Type       string  `pulumi:"type"`
+	AdoptExisting      *bool   `pulumi:"adoptExisting"`
+	DeletionProtection *bool   `pulumi:"deletionProtection"`
+	EmailTo            string  `pulumi:"emailTo"`
+	Frequency          *string `pulumi:"frequency"`
+	Percentage         *int    `pulumi:"percentage"`
+	Type               string  `pulumi:"type"`
 }
 
 // The set of arguments for constructing a Alert resource.
 type AlertArgs struct {
-	EmailTo    pulumi.StringInput
-	Frequency  pulumi.StringPtrInput
-	Percentage pulumi.IntPtrInput
-	Type       pulumi.StringInput
+	AdoptExisting      pulumi.BoolPtrInput
+	DeletionProtection pulumi.BoolPtrInput
+	EmailTo            pulumi.StringInput
+	Frequency          pulumi.StringPtrInput
+	Percentage         pulumi.IntPtrInput
+	Type               pulumi.StringInput
 }
 
 func (AlertArgs) ElementType() reflect.Type {
@@ -179,6 +191,10 @@ func (o AlertOutput) ToAlertOutputWithContext(ctx context.Context) AlertOutput {
 	return o
 }
 
+func (o AlertOutput) AdoptExisting() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Alert) pulumi.BoolPtrOutput { return v.AdoptExisting }).(pulumi.BoolPtrOutput)
+}
+
 func (o AlertOutput) AlertId() pulumi.IntOutput {
 	return o.ApplyT(func(v *Alert) pulumi.IntOutput { return v.AlertId }).(pulumi.IntOutput)
 }
@@ -187,6 +203,10 @@ func (o AlertOutput) CreatedAt() pulumi.IntOutput {
 	return o.ApplyT(func(v *Alert) pulumi.IntOutput { return v.CreatedAt }).(pulumi.IntOutput)
 }
 
+func (o AlertOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Alert) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o AlertOutput) EmailTo() pulumi.StringOutput {
 	return o.ApplyT(func(v *Alert) pulumi.StringOutput { return v.EmailTo }).(pulumi.StringOutput)
 }
@@ -7,7 +7,6 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
 	"github.com/JDetmar/pulumi-sendgrid/sdk/go/sendgrid/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -20,22 +19,20 @@ import (
 type ApiKey struct {
 	pulumi.CustomResourceState
 
-	ApiKeyId    pulumi.StringOutput      `pulumi:"apiKeyId"`
-	ApiKeyValue pulumi.StringPtrOutput   `pulumi:"apiKeyValue"`
-	Name        pulumi.StringOutput      `pulumi:"name"`
-	Scopes      pulumi.StringArrayOutput `pulumi:"scopes"`
+	ApiKeyId           pulumi.StringOutput      `pulumi:"apiKeyId"`
+	ApiKeyValue        pulumi.StringPtrOutput   `pulumi:"apiKeyValue"`
+	DeletionProtection pulumi.BoolPtrOutput     `pulumi:"deletionProtection"`
+	Name               pulumi.StringPtrOutput   `pulumi:"name"`
+	Scopes             pulumi.StringArrayOutput `pulumi:"scopes"`
 }
 
 // NewApiKey registers a new resource with the given unique name, arguments, and options.
 func NewApiKey(ctx *pulumi.Context,
 	name string, args *ApiKeyArgs, opts ...pulumi.ResourceOption) (*ApiKey, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &ApiKeyArgs{}
 	}
 
-	if args.Name == nil {
-		return nil, errors.New("invalid value for required argument 'Name'")
-	}
 	secrets := pulumi.AdditionalSecretOutputs([]string{
 		"apiKeyValue",
 	})
@@ -73,14 +70,16 @@ func (ApiKeyState) ElementType() reflect.Type {
 }
 
 type apiKeyArgs struct {
-	Name   string   `pulumi:"name"`
-	Scopes []string `pulumi:"scopes"`
+	DeletionProtection *bool    `pulumi:"deletionProtection"`
+	Name               *string  `pulumi:"name"`
+	Scopes             []string `pulumi:"scopes"`
 }
 
 // The set of arguments for constructing a ApiKey resource.
 type ApiKeyArgs struct {
-	Name   pulumi.StringInput
-	Scopes pulumi.StringArrayInput
+	DeletionProtection pulumi.BoolPtrInput
+	Name               pulumi.StringPtrInput
+	Scopes             pulumi.StringArrayInput
 }
 
 func (ApiKeyArgs) ElementType() reflect.Type {
@@ -178,8 +177,12 @@ func (o ApiKeyOutput) ApiKeyValue() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *ApiKey) pulumi.StringPtrOutput { return v.ApiKeyValue }).(pulumi.StringPtrOutput)
 }
 
-func (o ApiKeyOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v *ApiKey) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+func (o ApiKeyOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ApiKey) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
+func (o ApiKeyOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ApiKey) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
 }
 
 func (o ApiKeyOutput) Scopes() pulumi.StringArrayOutput {
@@ -20,22 +20,30 @@ import (
 type DomainAuthentication struct {
 	pulumi.CustomResourceState
 
-	AutomaticSecurity  pulumi.BoolPtrOutput     `pulumi:"automaticSecurity"`
-	CustomDkimSelector pulumi.StringPtrOutput   `pulumi:"customDkimSelector"`
-	CustomSpf          pulumi.BoolPtrOutput     `pulumi:"customSpf"`
-	Default            pulumi.BoolPtrOutput     `pulumi:"default"`
-	Dkim1              DNSRecordPtrOutput       `pulumi:"dkim1"`
-	Dkim2              DNSRecordPtrOutput       `pulumi:"dkim2"`
-	Domain             pulumi.StringOutput      `pulumi:"domain"`
-	DomainId           pulumi.IntOutput         `pulumi:"domainId"`
-	Ips                pulumi.StringArrayOutput `pulumi:"ips"`
-	Legacy             pulumi.BoolOutput        `pulumi:"legacy"`
-	MailCname          DNSRecordPtrOutput       `pulumi:"mailCname"`
-	Region             pulumi.StringPtrOutput   `pulumi:"region"`
-	Subdomain          pulumi.StringPtrOutput   `pulumi:"subdomain"`
-	UserId             pulumi.IntOutput         `pulumi:"userId"`
-	Username           pulumi.StringOutput      `pulumi:"username"`
-	Valid              pulumi.BoolOutput        `pulumi:"valid"`
+	AdoptExisting       pulumi.BoolPtrOutput         `pulumi:"adoptExisting"`
+	AutomaticSecurity   pulumi.BoolPtrOutput         `pulumi:"automaticSecurity"`
+	CustomDkimSelector  pulumi.StringPtrOutput       `pulumi:"customDkimSelector"`
+	CustomSpf           pulumi.BoolPtrOutput         `pulumi:"customSpf"`
+	Default             pulumi.BoolPtrOutput         `pulumi:"default"`
+	DeletionProtection  pulumi.BoolPtrOutput         `pulumi:"deletionProtection"`
+	Dkim1               DNSRecordPtrOutput           `pulumi:"dkim1"`
+	Dkim2               DNSRecordPtrOutput           `pulumi:"dkim2"`
+	DnsRecords          DomainDNSRecordArrayOutput   `pulumi:"dnsRecords"`
+	Domain              pulumi.StringOutput          `pulumi:"domain"`
+	DomainId            pulumi.IntOutput             `pulumi:"domainId"`
+	Ips                 pulumi.StringArrayOutput     `pulumi:"ips"`
+	Legacy              pulumi.BoolOutput            `pulumi:"legacy"`
+	MailCname           DNSRecordPtrOutput           `pulumi:"mailCname"`
+	ProviderRecords     DNSProviderRecordArrayOutput `pulumi:"providerRecords"`
+	Region              pulumi.StringPtrOutput       `pulumi:"region"`
+	RevalidateOnRefresh pulumi.BoolPtrOutput         `pulumi:"revalidateOnRefresh"`
+	Subdomain           pulumi.StringPtrOutput       `pulumi:"subdomain"`
+	UserId              pulumi.IntOutput             `pulumi:"userId"`
+	Username            pulumi.StringOutput          `pulumi:"username"`
+	Valid               pulumi.BoolOutput            `pulumi:"valid"`
+	WaitForValidation   pulumi.BoolPtrOutput         `pulumi:"waitForValidation"`
+	WaitIntervalSeconds pulumi.IntPtrOutput          `pulumi:"waitIntervalSeconds"`
+	WaitTimeoutSeconds  pulumi.IntPtrOutput          `pulumi:"waitTimeoutSeconds"`
 }
 
 // NewDomainAuthentication registers a new resource with the given unique name, arguments, and options.
@@ -48,6 +56,11 @@ func NewDomainAuthentication(ctx *pulumi.Context,
 	if args.Domain == nil {
 		return nil, errors.New("invalid value for required argument 'Domain'")
 	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"domain",
+		"subdomain",
+	})
+	opts = append(opts, replaceOnChanges)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource DomainAuthentication
 	err := ctx.RegisterResource("sendgrid:index:DomainAuthentication", name, args, &resource, opts...)
@@ -81,26 +94,38 @@ func (DomainAuthenticationState) ElementType() reflect.Type {
 }
 
 type domainAuthenticationArgs struct {
-	AutomaticSecurity  *bool    `pulumi:"automaticSecurity"`
-	CustomDkimSelector *string  `pulumi:"customDkimSelector"`
-	CustomSpf          *bool    `pulumi:"customSpf"`
-	Default            *bool    `pulumi:"default"`
-	Domain             string   `pulumi:"domain"`
-	Ips                []string `pulumi:"ips"`
-	Region             *string  `pulumi:"region"`
-	Subdomain          *string  `pulumi:"subdomain"`
+	AdoptExisting       *bool    `pulumi:"adoptExisting"`
+	AutomaticSecurity   *bool    `pulumi:"automaticSecurity"`
+	CustomDkimSelector  *string  `pulumi:"customDkimSelector"`
+	CustomSpf           *bool    `pulumi:"customSpf"`
+	Default             *bool    `pulumi:"default"`
+	DeletionProtection  *bool    `pulumi:"deletionProtection"`
+	Domain              string   `pulumi:"domain"`
+	Ips                 []string `pulumi:"ips"`
+	Region              *string  `pulumi:"region"`
+	RevalidateOnRefresh *bool    `pulumi:"revalidateOnRefresh"`
+	Subdomain           *string  `pulumi:"subdomain"`
+	WaitForValidation   *bool    `pulumi:"waitForValidation"`
+	WaitIntervalSeconds *int     `pulumi:"waitIntervalSeconds"`
+	WaitTimeoutSeconds  *int     `pulumi:"waitTimeoutSeconds"`
 }
 
 // The set of arguments for constructing a DomainAuthentication resource.
 type DomainAuthenticationArgs struct {
-	AutomaticSecurity  pulumi.BoolPtrInput
-	CustomDkimSelector pulumi.StringPtrInput
-	CustomSpf          pulumi.BoolPtrInput
-	Default            pulumi.BoolPtrInput
-	Domain             pulumi.StringInput
-	Ips                pulumi.StringArrayInput
-	Region             pulumi.StringPtrInput
-	Subdomain          pulumi.StringPtrInput
+	AdoptExisting       pulumi.BoolPtrInput
+	AutomaticSecurity   pulumi.BoolPtrInput
+	CustomDkimSelector  pulumi.StringPtrInput
+	CustomSpf           pulumi.BoolPtrInput
+	Default             pulumi.BoolPtrInput
+	DeletionProtection  pulumi.BoolPtrInput
+	Domain              pulumi.StringInput
+	Ips                 pulumi.StringArrayInput
+	Region              pulumi.StringPtrInput
+	RevalidateOnRefresh pulumi.BoolPtrInput
+	Subdomain           pulumi.StringPtrInput
+	WaitForValidation   pulumi.BoolPtrInput
+	WaitIntervalSeconds pulumi.IntPtrInput
+	WaitTimeoutSeconds  pulumi.IntPtrInput
 }
 
 func (DomainAuthenticationArgs) ElementType() reflect.Type {
@@ -190,6 +215,10 @@ func (o DomainAuthenticationOutput) ToDomainAuthenticationOutputWithContext(ctx
 	return o
 }
 
+func (o DomainAuthenticationOutput) AdoptExisting() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *DomainAuthentication) pulumi.BoolPtrOutput { return v.AdoptExisting }).(pulumi.BoolPtrOutput)
+}
+
 func (o DomainAuthenticationOutput) AutomaticSecurity() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *DomainAuthentication) pulumi.BoolPtrOutput { return v.AutomaticSecurity }).(pulumi.BoolPtrOutput)
 }
@@ -206,6 +235,10 @@ func (o DomainAuthenticationOutput) Default() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *DomainAuthentication) pulumi.BoolPtrOutput { return v.Default }).(pulumi.BoolPtrOutput)
 }
 
+func (o DomainAuthenticationOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *DomainAuthentication) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o DomainAuthenticationOutput) Dkim1() DNSRecordPtrOutput {
 	return o.ApplyT(func(v *DomainAuthentication) DNSRecordPtrOutput { return v.Dkim1 }).(DNSRecordPtrOutput)
 }
@@ -214,6 +247,10 @@ func (o DomainAuthenticationOutput) Dkim2() DNSRecordPtrOutput {
 	return o.ApplyT(func(v *DomainAuthentication) DNSRecordPtrOutput { return v.Dkim2 }).(DNSRecordPtrOutput)
 }
 
+func (o DomainAuthenticationOutput) DnsRecords() DomainDNSRecordArrayOutput {
+	return o.ApplyT(func(v *DomainAuthentication) DomainDNSRecordArrayOutput { return v.DnsRecords }).(DomainDNSRecordArrayOutput)
+}
+
 func (o DomainAuthenticationOutput) Domain() pulumi.StringOutput {
 	return o.ApplyT(func(v *DomainAuthentication) pulumi.StringOutput { return v.Domain }).(pulumi.StringOutput)
 }
@@ -234,10 +271,18 @@ func (o DomainAuthenticationOutput) MailCname() DNSRecordPtrOutput {
 	return o.ApplyT(func(v *DomainAuthentication) DNSRecordPtrOutput { return v.MailCname }).(DNSRecordPtrOutput)
 }
 
+func (o DomainAuthenticationOutput) ProviderRecords() DNSProviderRecordArrayOutput {
+	return o.ApplyT(func(v *DomainAuthentication) DNSProviderRecordArrayOutput { return v.ProviderRecords }).(DNSProviderRecordArrayOutput)
+}
+
 func (o DomainAuthenticationOutput) Region() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *DomainAuthentication) pulumi.StringPtrOutput { return v.Region }).(pulumi.StringPtrOutput)
 }
 
+func (o DomainAuthenticationOutput) RevalidateOnRefresh() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *DomainAuthentication) pulumi.BoolPtrOutput { return v.RevalidateOnRefresh }).(pulumi.BoolPtrOutput)
+}
+
 func (o DomainAuthenticationOutput) Subdomain() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *DomainAuthentication) pulumi.StringPtrOutput { return v.Subdomain }).(pulumi.StringPtrOutput)
 }
@@ -254,6 +299,18 @@ func (o DomainAuthenticationOutput) Valid() pulumi.BoolOutput {
 	return o.ApplyT(func(v *DomainAuthentication) pulumi.BoolOutput { return v.Valid }).(pulumi.BoolOutput)
 }
 
+func (o DomainAuthenticationOutput) WaitForValidation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *DomainAuthentication) pulumi.BoolPtrOutput { return v.WaitForValidation }).(pulumi.BoolPtrOutput)
+}
+
+func (o DomainAuthenticationOutput) WaitIntervalSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DomainAuthentication) pulumi.IntPtrOutput { return v.WaitIntervalSeconds }).(pulumi.IntPtrOutput)
+}
+
+func (o DomainAuthenticationOutput) WaitTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DomainAuthentication) pulumi.IntPtrOutput { return v.WaitTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
 type DomainAuthenticationArrayOutput struct{ *pulumi.OutputState }
 
 func (DomainAuthenticationArrayOutput) ElementType() reflect.Type {
@@ -16,25 +16,31 @@ import (
 //
 // Event Webhooks allow you to receive HTTP POST notifications when email events occur, such as delivery, opens, clicks, bounces, and more. Configure the URL endpoint and select which events to track.
 //
-// Note: Only one webhook can be configured per URL. Signature verification must be configured separately after webhook creation.
+// Note: Only one webhook can be configured per URL. Signature verification is enabled with the EventWebhookSignature resource.
 type EventWebhook struct {
 	pulumi.CustomResourceState
 
-	Bounce           pulumi.BoolPtrOutput   `pulumi:"bounce"`
-	Click            pulumi.BoolPtrOutput   `pulumi:"click"`
-	Deferred         pulumi.BoolPtrOutput   `pulumi:"deferred"`
-	Delivered        pulumi.BoolPtrOutput   `pulumi:"delivered"`
-	Dropped          pulumi.BoolPtrOutput   `pulumi:"dropped"`
-	Enabled          pulumi.BoolPtrOutput   `pulumi:"enabled"`
-	FriendlyName     pulumi.StringPtrOutput `pulumi:"friendlyName"`
-	GroupResubscribe pulumi.BoolPtrOutput   `pulumi:"groupResubscribe"`
-	GroupUnsubscribe pulumi.BoolPtrOutput   `pulumi:"groupUnsubscribe"`
-	Open             pulumi.BoolPtrOutput   `pulumi:"open"`
-	Processed        pulumi.BoolPtrOutput   `pulumi:"processed"`
-	SpamReport       pulumi.BoolPtrOutput   `pulumi:"spamReport"`
-	Unsubscribe      pulumi.BoolPtrOutput   `pulumi:"unsubscribe"`
-	Url              pulumi.StringOutput    `pulumi:"url"`
-	WebhookId        pulumi.StringOutput    `pulumi:"webhookId"`
+	AdoptExisting      pulumi.BoolPtrOutput   `pulumi:"adoptExisting"`
+	Bounce             pulumi.BoolPtrOutput   `pulumi:"bounce"`
+	Click              pulumi.BoolPtrOutput   `pulumi:"click"`
+	Deferred           pulumi.BoolPtrOutput   `pulumi:"deferred"`
+	DeletionProtection pulumi.BoolPtrOutput   `pulumi:"deletionProtection"`
+	Delivered          pulumi.BoolPtrOutput   `pulumi:"delivered"`
+	Dropped            pulumi.BoolPtrOutput   `pulumi:"dropped"`
+	Enabled            pulumi.BoolPtrOutput   `pulumi:"enabled"`
+	FriendlyName       pulumi.StringPtrOutput `pulumi:"friendlyName"`
+	GroupResubscribe   pulumi.BoolPtrOutput   `pulumi:"groupResubscribe"`
+	GroupUnsubscribe   pulumi.BoolPtrOutput   `pulumi:"groupUnsubscribe"`
+	OauthClientId      pulumi.StringPtrOutput `pulumi:"oauthClientId"`
+	OauthClientSecret  pulumi.StringPtrOutput `pulumi:"oauthClientSecret"`
+	OauthTokenUrl      pulumi.StringPtrOutput `pulumi:"oauthTokenUrl"`
+	Open               pulumi.BoolPtrOutput   `pulumi:"open"`
+	Processed          pulumi.BoolPtrOutput   `pulumi:"processed"`
+	SpamReport         pulumi.BoolPtrOutput   `pulumi:"spamReport"`
+	Unsubscribe        pulumi.BoolPtrOutput   `pulumi:"unsubscribe"`
+	Url                pulumi.StringOutput    `pulumi:"url"`
+	VerifyUrlReachable pulumi.BoolPtrOutput   `pulumi:"verifyUrlReachable"`
+	WebhookId          pulumi.StringOutput    `pulumi:"webhookId"`
 }
 
 // NewEventWebhook registers a new resource with the given unique name, arguments, and options.
@@ -47,6 +53,13 @@ func NewEventWebhook(ctx *pulumi.Context,
 	if args.Url == nil {
 		return nil, errors.New("invalid value for required argument 'Url'")
 	}
+	if args.OauthClientSecret != nil {
+		args.OauthClientSecret = pulumi.ToSecret(args.OauthClientSecret).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"oauthClientSecret",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource EventWebhook
 	err := ctx.RegisterResource("sendgrid:index:EventWebhook", name, args, &resource, opts...)
@@ -80,38 +93,50 @@ func (EventWebhookState) ElementType() reflect.Type {
 }
 
 type eventWebhookArgs struct {
-	Bounce           *bool   `pulumi:"bounce"`
-	Click            *bool   `pulumi:"click"`
-	Deferred         *bool   `pulumi:"deferred"`
-	Delivered        *bool   `pulumi:"delivered"`
-	Dropped          *bool   `pulumi:"dropped"`
-	Enabled          *bool   `pulumi:"enabled"`
-	FriendlyName     *string `pulumi:"friendlyName"`
-	GroupResubscribe *bool   `pulumi:"groupResubscribe"`
-	GroupUnsubscribe *bool   `pulumi:"groupUnsubscribe"`
-	Open             *bool   `pulumi:"open"`
-	Processed        *bool   `pulumi:"processed"`
-	SpamReport       *bool   `pulumi:"spamReport"`
-	Unsubscribe      *bool   `pulumi:"unsubscribe"`
-	Url              string  `pulumi:"url"`
+	AdoptExisting      *bool   `pulumi:"adoptExisting"`
+	Bounce             *bool   `pulumi:"bounce"`
+	Click              *bool   `pulumi:"click"`
+	Deferred           *bool   `pulumi:"deferred"`
+	DeletionProtection *bool   `pulumi:"deletionProtection"`
+	Delivered          *bool   `pulumi:"delivered"`
+	Dropped            *bool   `pulumi:"dropped"`
+	Enabled            *bool   `pulumi:"enabled"`
+	FriendlyName       *string `pulumi:"friendlyName"`
+	GroupResubscribe   *bool   `pulumi:"groupResubscribe"`
+	GroupUnsubscribe   *bool   `pulumi:"groupUnsubscribe"`
+	OauthClientId      *string `pulumi:"oauthClientId"`
+	OauthClientSecret  *string `pulumi:"oauthClientSecret"`
+	OauthTokenUrl      *string `pulumi:"oauthTokenUrl"`
+	Open               *bool   `pulumi:"open"`
+	Processed          *bool   `pulumi:"processed"`
+	SpamReport         *bool   `pulumi:"spamReport"`
+	Unsubscribe        *bool   `pulumi:"unsubscribe"`
+	Url                string  `pulumi:"url"`
+	VerifyUrlReachable *bool   `pulumi:"verifyUrlReachable"`
 }
 
 // The set of arguments for constructing a EventWebhook resource.
 type EventWebhookArgs struct {
-	Bounce           pulumi.BoolPtrInput
-	Click            pulumi.BoolPtrInput
-	Deferred         pulumi.BoolPtrInput
-	Delivered        pulumi.BoolPtrInput
-	Dropped          pulumi.BoolPtrInput
-	Enabled          pulumi.BoolPtrInput
-	FriendlyName     pulumi.StringPtrInput
-	GroupResubscribe pulumi.BoolPtrInput
-	GroupUnsubscribe pulumi.BoolPtrInput
-	Open             pulumi.BoolPtrInput
-	Processed        pulumi.BoolPtrInput
-	SpamReport       pulumi.BoolPtrInput
-	Unsubscribe      pulumi.BoolPtrInput
-	Url              pulumi.StringInput
+	AdoptExisting      pulumi.BoolPtrInput
+	Bounce             pulumi.BoolPtrInput
+	Click              pulumi.BoolPtrInput
+	Deferred           pulumi.BoolPtrInput
+	DeletionProtection pulumi.BoolPtrInput
+	Delivered          pulumi.BoolPtrInput
+	Dropped            pulumi.BoolPtrInput
+	Enabled            pulumi.BoolPtrInput
+	FriendlyName       pulumi.StringPtrInput
+	GroupResubscribe   pulumi.BoolPtrInput
+	GroupUnsubscribe   pulumi.BoolPtrInput
+	OauthClientId      pulumi.StringPtrInput
+	OauthClientSecret  pulumi.StringPtrInput
+	OauthTokenUrl      pulumi.StringPtrInput
+	Open               pulumi.BoolPtrInput
+	Processed          pulumi.BoolPtrInput
+	SpamReport         pulumi.BoolPtrInput
+	Unsubscribe        pulumi.BoolPtrInput
+	Url                pulumi.StringInput
+	VerifyUrlReachable pulumi.BoolPtrInput
 }
 
 func (EventWebhookArgs) ElementType() reflect.Type {
@@ -201,6 +226,10 @@ func (o EventWebhookOutput) ToEventWebhookOutputWithContext(ctx context.Context)
 	return o
 }
 
+func (o EventWebhookOutput) AdoptExisting() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.AdoptExisting }).(pulumi.BoolPtrOutput)
+}
+
 func (o EventWebhookOutput) Bounce() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.Bounce }).(pulumi.BoolPtrOutput)
 }
@@ -213,6 +242,10 @@ func (o EventWebhookOutput) Deferred() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.Deferred }).(pulumi.BoolPtrOutput)
 }
 
+func (o EventWebhookOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o EventWebhookOutput) Delivered() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.Delivered }).(pulumi.BoolPtrOutput)
 }
@@ -237,6 +270,18 @@ func (o EventWebhookOutput) GroupUnsubscribe() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.GroupUnsubscribe }).(pulumi.BoolPtrOutput)
 }
 
+func (o EventWebhookOutput) OauthClientId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EventWebhook) pulumi.StringPtrOutput { return v.OauthClientId }).(pulumi.StringPtrOutput)
+}
+
+func (o EventWebhookOutput) OauthClientSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EventWebhook) pulumi.StringPtrOutput { return v.OauthClientSecret }).(pulumi.StringPtrOutput)
+}
+
+func (o EventWebhookOutput) OauthTokenUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EventWebhook) pulumi.StringPtrOutput { return v.OauthTokenUrl }).(pulumi.StringPtrOutput)
+}
+
 func (o EventWebhookOutput) Open() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.Open }).(pulumi.BoolPtrOutput)
 }
@@ -257,6 +302,10 @@ func (o EventWebhookOutput) Url() pulumi.StringOutput {
 	return o.ApplyT(func(v *EventWebhook) pulumi.StringOutput { return v.Url }).(pulumi.StringOutput)
 }
 
+func (o EventWebhookOutput) VerifyUrlReachable() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EventWebhook) pulumi.BoolPtrOutput { return v.VerifyUrlReachable }).(pulumi.BoolPtrOutput)
+}
+
 func (o EventWebhookOutput) WebhookId() pulumi.StringOutput {
 	return o.ApplyT(func(v *EventWebhook) pulumi.StringOutput { return v.WebhookId }).(pulumi.StringOutput)
 }
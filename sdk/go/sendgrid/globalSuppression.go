@@ -20,8 +20,9 @@ import (
 type GlobalSuppression struct {
 	pulumi.CustomResourceState
 
-	CreatedAt pulumi.IntPtrOutput `pulumi:"createdAt"`
-	Email     pulumi.StringOutput `pulumi:"email"`
+	CreatedAt          pulumi.IntPtrOutput  `pulumi:"createdAt"`
+	DeletionProtection pulumi.BoolPtrOutput `pulumi:"deletionProtection"`
+	Email              pulumi.StringOutput  `pulumi:"email"`
 }
 
 // NewGlobalSuppression registers a new resource with the given unique name, arguments, and options.
@@ -34,6 +35,10 @@ func NewGlobalSuppression(ctx *pulumi.Context,
 	if args.Email == nil {
 		return nil, errors.New("invalid value for required argument 'Email'")
 	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"email",
+	})
+	opts = append(opts, replaceOnChanges)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource GlobalSuppression
 	err := ctx.RegisterResource("sendgrid:index:GlobalSuppression", name, args, &resource, opts...)
@@ -67,12 +72,14 @@ func (GlobalSuppressionState) ElementType() reflect.Type {
 }
 
 type globalSuppressionArgs struct {
-	Email string `pulumi:"email"`
+	DeletionProtection *bool  `pulumi:"deletionProtection"`
+	Email              string `pulumi:"email"`
 }
 
 // The set of arguments for constructing a GlobalSuppression resource.
 type GlobalSuppressionArgs struct {
-	Email pulumi.StringInput
+	DeletionProtection pulumi.BoolPtrInput
+	Email              pulumi.StringInput
 }
 
 func (GlobalSuppressionArgs) ElementType() reflect.Type {
@@ -166,6 +173,10 @@ func (o GlobalSuppressionOutput) CreatedAt() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *GlobalSuppression) pulumi.IntPtrOutput { return v.CreatedAt }).(pulumi.IntPtrOutput)
 }
 
+func (o GlobalSuppressionOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GlobalSuppression) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o GlobalSuppressionOutput) Email() pulumi.StringOutput {
 	return o.ApplyT(func(v *GlobalSuppression) pulumi.StringOutput { return v.Email }).(pulumi.StringOutput)
 }
@@ -21,32 +21,64 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
+	case "sendgrid:index:AccountEmail":
+		r = &AccountEmail{}
+	case "sendgrid:index:AccountPassword":
+		r = &AccountPassword{}
+	case "sendgrid:index:AccountUsername":
+		r = &AccountUsername{}
 	case "sendgrid:index:Alert":
 		r = &Alert{}
 	case "sendgrid:index:ApiKey":
 		r = &ApiKey{}
+	case "sendgrid:index:AuthenticatedDomain":
+		r = &AuthenticatedDomain{}
+	case "sendgrid:index:ContactDbList":
+		r = &ContactDbList{}
+	case "sendgrid:index:ContactDbRecipient":
+		r = &ContactDbRecipient{}
 	case "sendgrid:index:DomainAuthentication":
 		r = &DomainAuthentication{}
 	case "sendgrid:index:EventWebhook":
 		r = &EventWebhook{}
+	case "sendgrid:index:EventWebhookSignature":
+		r = &EventWebhookSignature{}
+	case "sendgrid:index:EventWebhookTestDelivery":
+		r = &EventWebhookTestDelivery{}
 	case "sendgrid:index:GlobalSuppression":
 		r = &GlobalSuppression{}
 	case "sendgrid:index:IpPool":
 		r = &IpPool{}
+	case "sendgrid:index:LegacyTemplateMailSetting":
+		r = &LegacyTemplateMailSetting{}
 	case "sendgrid:index:LinkBranding":
 		r = &LinkBranding{}
+	case "sendgrid:index:NewRelicPartnerSetting":
+		r = &NewRelicPartnerSetting{}
+	case "sendgrid:index:ScheduledSend":
+		r = &ScheduledSend{}
 	case "sendgrid:index:Subuser":
 		r = &Subuser{}
+	case "sendgrid:index:SubuserDomainAssociation":
+		r = &SubuserDomainAssociation{}
+	case "sendgrid:index:SubuserLinkAssociation":
+		r = &SubuserLinkAssociation{}
+	case "sendgrid:index:SubuserOnboarding":
+		r = &SubuserOnboarding{}
 	case "sendgrid:index:Teammate":
 		r = &Teammate{}
 	case "sendgrid:index:Template":
 		r = &Template{}
+	case "sendgrid:index:TemplateDirectory":
+		r = &TemplateDirectory{}
 	case "sendgrid:index:TemplateVersion":
 		r = &TemplateVersion{}
 	case "sendgrid:index:UnsubscribeGroup":
 		r = &UnsubscribeGroup{}
 	case "sendgrid:index:VerifiedSender":
 		r = &VerifiedSender{}
+	case "sendgrid:index:WebhookEndpoint":
+		r = &WebhookEndpoint{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
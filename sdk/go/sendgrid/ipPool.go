@@ -7,7 +7,6 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
 	"github.com/JDetmar/pulumi-sendgrid/sdk/go/sendgrid/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -20,21 +19,19 @@ import (
 type IpPool struct {
 	pulumi.CustomResourceState
 
-	Ips      pulumi.StringArrayOutput `pulumi:"ips"`
-	Name     pulumi.StringOutput      `pulumi:"name"`
-	PoolName pulumi.StringOutput      `pulumi:"poolName"`
+	DeletionProtection pulumi.BoolPtrOutput     `pulumi:"deletionProtection"`
+	Ips                pulumi.StringArrayOutput `pulumi:"ips"`
+	Name               pulumi.StringPtrOutput   `pulumi:"name"`
+	PoolName           pulumi.StringOutput      `pulumi:"poolName"`
 }
 
 // NewIpPool registers a new resource with the given unique name, arguments, and options.
 func NewIpPool(ctx *pulumi.Context,
 	name string, args *IpPoolArgs, opts ...pulumi.ResourceOption) (*IpPool, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &IpPoolArgs{}
 	}
 
-	if args.Name == nil {
-		return nil, errors.New("invalid value for required argument 'Name'")
-	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource IpPool
 	err := ctx.RegisterResource("sendgrid:index:IpPool", name, args, &resource, opts...)
@@ -68,12 +65,14 @@ func (IpPoolState) ElementType() reflect.Type {
 }
 
 type ipPoolArgs struct {
-	Name string `pulumi:"name"`
+	DeletionProtection *bool   `pulumi:"deletionProtection"`
+	Name               *string `pulumi:"name"`
 }
 
 // The set of arguments for constructing a IpPool resource.
 type IpPoolArgs struct {
-	Name pulumi.StringInput
+	DeletionProtection pulumi.BoolPtrInput
+	Name               pulumi.StringPtrInput
 }
 
 func (IpPoolArgs) ElementType() reflect.Type {
@@ -163,12 +162,16 @@ func (o IpPoolOutput) ToIpPoolOutputWithContext(ctx context.Context) IpPoolOutpu
 	return o
 }
 
+func (o IpPoolOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *IpPool) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o IpPoolOutput) Ips() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *IpPool) pulumi.StringArrayOutput { return v.Ips }).(pulumi.StringArrayOutput)
 }
 
-func (o IpPoolOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v *IpPool) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+func (o IpPoolOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *IpPool) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
 }
 
 func (o IpPoolOutput) PoolName() pulumi.StringOutput {
@@ -20,17 +20,24 @@ import (
 type LinkBranding struct {
 	pulumi.CustomResourceState
 
-	BrandCname LinkBrandingDNSRecordPtrOutput `pulumi:"brandCname"`
-	Default    pulumi.BoolPtrOutput           `pulumi:"default"`
-	Domain     pulumi.StringOutput            `pulumi:"domain"`
-	Legacy     pulumi.BoolOutput              `pulumi:"legacy"`
-	LinkId     pulumi.IntOutput               `pulumi:"linkId"`
-	OwnerCname LinkBrandingDNSRecordPtrOutput `pulumi:"ownerCname"`
-	Region     pulumi.StringPtrOutput         `pulumi:"region"`
-	Subdomain  pulumi.StringPtrOutput         `pulumi:"subdomain"`
-	UserId     pulumi.IntOutput               `pulumi:"userId"`
-	Username   pulumi.StringOutput            `pulumi:"username"`
-	Valid      pulumi.BoolOutput              `pulumi:"valid"`
+	BrandCname          LinkBrandingDNSRecordPtrOutput `pulumi:"brandCname"`
+	Default             pulumi.BoolPtrOutput           `pulumi:"default"`
+	DeletionProtection  pulumi.BoolPtrOutput           `pulumi:"deletionProtection"`
+	DnsRecords          DomainDNSRecordArrayOutput     `pulumi:"dnsRecords"`
+	Domain              pulumi.StringOutput            `pulumi:"domain"`
+	Legacy              pulumi.BoolOutput              `pulumi:"legacy"`
+	LinkId              pulumi.IntOutput               `pulumi:"linkId"`
+	OwnerCname          LinkBrandingDNSRecordPtrOutput `pulumi:"ownerCname"`
+	ProviderRecords     DNSProviderRecordArrayOutput   `pulumi:"providerRecords"`
+	Region              pulumi.StringPtrOutput         `pulumi:"region"`
+	RevalidateOnRefresh pulumi.BoolPtrOutput           `pulumi:"revalidateOnRefresh"`
+	Subdomain           pulumi.StringPtrOutput         `pulumi:"subdomain"`
+	UserId              pulumi.IntOutput               `pulumi:"userId"`
+	Username            pulumi.StringOutput            `pulumi:"username"`
+	Valid               pulumi.BoolOutput              `pulumi:"valid"`
+	WaitForValidation   pulumi.BoolPtrOutput           `pulumi:"waitForValidation"`
+	WaitIntervalSeconds pulumi.IntPtrOutput            `pulumi:"waitIntervalSeconds"`
+	WaitTimeoutSeconds  pulumi.IntPtrOutput            `pulumi:"waitTimeoutSeconds"`
 }
 
 // NewLinkBranding registers a new resource with the given unique name, arguments, and options.
@@ -43,6 +50,11 @@ func NewLinkBranding(ctx *pulumi.Context,
 	if args.Domain == nil {
 		return nil, errors.New("invalid value for required argument 'Domain'")
 	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"domain",
+		"subdomain",
+	})
+	opts = append(opts, replaceOnChanges)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource LinkBranding
 	err := ctx.RegisterResource("sendgrid:index:LinkBranding", name, args, &resource, opts...)
@@ -76,18 +88,28 @@ func (LinkBrandingState) ElementType() reflect.Type {
 }
 
 type linkBrandingArgs struct {
-	Default   *bool   `pulumi:"default"`
-	Domain    string  `pulumi:"domain"`
-	Region    *string `pulumi:"region"`
-	Subdomain *string `pulumi:"subdomain"`
+	Default             *bool   `pulumi:"default"`
+	DeletionProtection  *bool   `pulumi:"deletionProtection"`
+	Domain              string  `pulumi:"domain"`
+	Region              *string `pulumi:"region"`
+	RevalidateOnRefresh *bool   `pulumi:"revalidateOnRefresh"`
+	Subdomain           *string `pulumi:"subdomain"`
+	WaitForValidation   *bool   `pulumi:"waitForValidation"`
+	WaitIntervalSeconds *int    `pulumi:"waitIntervalSeconds"`
+	WaitTimeoutSeconds  *int    `pulumi:"waitTimeoutSeconds"`
 }
 
 // The set of arguments for constructing a LinkBranding resource.
 type LinkBrandingArgs struct {
-	Default   pulumi.BoolPtrInput
-	Domain    pulumi.StringInput
-	Region    pulumi.StringPtrInput
-	Subdomain pulumi.StringPtrInput
+	Default             pulumi.BoolPtrInput
+	DeletionProtection  pulumi.BoolPtrInput
+	Domain              pulumi.StringInput
+	Region              pulumi.StringPtrInput
+	RevalidateOnRefresh pulumi.BoolPtrInput
+	Subdomain           pulumi.StringPtrInput
+	WaitForValidation   pulumi.BoolPtrInput
+	WaitIntervalSeconds pulumi.IntPtrInput
+	WaitTimeoutSeconds  pulumi.IntPtrInput
 }
 
 func (LinkBrandingArgs) ElementType() reflect.Type {
@@ -185,6 +207,14 @@ func (o LinkBrandingOutput) Default() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *LinkBranding) pulumi.BoolPtrOutput { return v.Default }).(pulumi.BoolPtrOutput)
 }
 
+func (o LinkBrandingOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *LinkBranding) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
+func (o LinkBrandingOutput) DnsRecords() DomainDNSRecordArrayOutput {
+	return o.ApplyT(func(v *LinkBranding) DomainDNSRecordArrayOutput { return v.DnsRecords }).(DomainDNSRecordArrayOutput)
+}
+
 func (o LinkBrandingOutput) Domain() pulumi.StringOutput {
 	return o.ApplyT(func(v *LinkBranding) pulumi.StringOutput { return v.Domain }).(pulumi.StringOutput)
 }
@@ -201,10 +231,18 @@ func (o LinkBrandingOutput) OwnerCname() LinkBrandingDNSRecordPtrOutput {
 	return o.ApplyT(func(v *LinkBranding) LinkBrandingDNSRecordPtrOutput { return v.OwnerCname }).(LinkBrandingDNSRecordPtrOutput)
 }
 
+func (o LinkBrandingOutput) ProviderRecords() DNSProviderRecordArrayOutput {
+	return o.ApplyT(func(v *LinkBranding) DNSProviderRecordArrayOutput { return v.ProviderRecords }).(DNSProviderRecordArrayOutput)
+}
+
 func (o LinkBrandingOutput) Region() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *LinkBranding) pulumi.StringPtrOutput { return v.Region }).(pulumi.StringPtrOutput)
 }
 
+func (o LinkBrandingOutput) RevalidateOnRefresh() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *LinkBranding) pulumi.BoolPtrOutput { return v.RevalidateOnRefresh }).(pulumi.BoolPtrOutput)
+}
+
 func (o LinkBrandingOutput) Subdomain() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *LinkBranding) pulumi.StringPtrOutput { return v.Subdomain }).(pulumi.StringPtrOutput)
 }
@@ -221,6 +259,18 @@ func (o LinkBrandingOutput) Valid() pulumi.BoolOutput {
 	return o.ApplyT(func(v *LinkBranding) pulumi.BoolOutput { return v.Valid }).(pulumi.BoolOutput)
 }
 
+func (o LinkBrandingOutput) WaitForValidation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *LinkBranding) pulumi.BoolPtrOutput { return v.WaitForValidation }).(pulumi.BoolPtrOutput)
+}
+
+func (o LinkBrandingOutput) WaitIntervalSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *LinkBranding) pulumi.IntPtrOutput { return v.WaitIntervalSeconds }).(pulumi.IntPtrOutput)
+}
+
+func (o LinkBrandingOutput) WaitTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *LinkBranding) pulumi.IntPtrOutput { return v.WaitTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
 type LinkBrandingArrayOutput struct{ *pulumi.OutputState }
 
 func (LinkBrandingArrayOutput) ElementType() reflect.Type {
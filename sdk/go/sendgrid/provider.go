@@ -14,10 +14,26 @@ import (
 type Provider struct {
 	pulumi.ProviderResourceState
 
-	// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+	// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
 	ApiKey pulumi.StringPtrOutput `pulumi:"apiKey"`
-	// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+	// The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+	ApiKeyFile pulumi.StringPtrOutput `pulumi:"apiKeyFile"`
+	// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
 	BaseUrl pulumi.StringPtrOutput `pulumi:"baseUrl"`
+	// The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+	HttpProxy pulumi.StringPtrOutput `pulumi:"httpProxy"`
+	// The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+	HttpsProxy pulumi.StringPtrOutput `pulumi:"httpsProxy"`
+	// A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+	NoProxy pulumi.StringPtrOutput `pulumi:"noProxy"`
+	// The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+	OnBehalfOf pulumi.StringPtrOutput `pulumi:"onBehalfOf"`
+	// The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+	Region pulumi.StringPtrOutput `pulumi:"region"`
+	// The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+	StatsdAddress pulumi.StringPtrOutput `pulumi:"statsdAddress"`
+	// A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+	UserAgentSuffix pulumi.StringPtrOutput `pulumi:"userAgentSuffix"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -27,14 +43,40 @@ func NewProvider(ctx *pulumi.Context,
 		args = &ProviderArgs{}
 	}
 
-	if args.BaseUrl == nil {
-		args.BaseUrl = pulumi.StringPtr("https://api.sendgrid.com")
+	if args.CircuitBreakerCooldownSeconds == nil {
+		args.CircuitBreakerCooldownSeconds = pulumi.IntPtr(30)
+	}
+	if args.CircuitBreakerThreshold == nil {
+		args.CircuitBreakerThreshold = pulumi.IntPtr(5)
+	}
+	if args.MaxConcurrentRequests == nil {
+		args.MaxConcurrentRequests = pulumi.IntPtr(10)
+	}
+	if args.MaxRetries == nil {
+		args.MaxRetries = pulumi.IntPtr(3)
+	}
+	if args.RequestTimeoutSeconds == nil {
+		args.RequestTimeoutSeconds = pulumi.IntPtr(30)
+	}
+	if args.RetryBudget == nil {
+		args.RetryBudget = pulumi.IntPtr(20)
+	}
+	if args.ValidateApiKey == nil {
+		args.ValidateApiKey = pulumi.BoolPtr(true)
 	}
 	if args.ApiKey != nil {
 		args.ApiKey = pulumi.ToSecret(args.ApiKey).(pulumi.StringPtrInput)
 	}
+	if args.HttpProxy != nil {
+		args.HttpProxy = pulumi.ToSecret(args.HttpProxy).(pulumi.StringPtrInput)
+	}
+	if args.HttpsProxy != nil {
+		args.HttpsProxy = pulumi.ToSecret(args.HttpsProxy).(pulumi.StringPtrInput)
+	}
 	secrets := pulumi.AdditionalSecretOutputs([]string{
 		"apiKey",
+		"httpProxy",
+		"httpsProxy",
 	})
 	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
@@ -47,18 +89,114 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
-	// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+	// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
 	ApiKey *string `pulumi:"apiKey"`
-	// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+	// The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+	ApiKeyFile *string `pulumi:"apiKeyFile"`
+	// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
 	BaseUrl *string `pulumi:"baseUrl"`
+	// How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+	CircuitBreakerCooldownSeconds *int `pulumi:"circuitBreakerCooldownSeconds"`
+	// The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+	CircuitBreakerThreshold *int `pulumi:"circuitBreakerThreshold"`
+	// Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+	EnableLegacyContactDb *bool `pulumi:"enableLegacyContactDb"`
+	// Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+	Headers map[string]string `pulumi:"headers"`
+	// The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+	HttpProxy *string `pulumi:"httpProxy"`
+	// The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+	HttpsProxy *string `pulumi:"httpsProxy"`
+	// Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+	LogMetrics *bool `pulumi:"logMetrics"`
+	// The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+	MaxConcurrentRequests *int `pulumi:"maxConcurrentRequests"`
+	// The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+	MaxRetries *int `pulumi:"maxRetries"`
+	// A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+	NoProxy *string `pulumi:"noProxy"`
+	// The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+	OnBehalfOf *string `pulumi:"onBehalfOf"`
+	// The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+	RateLimitBurst *int `pulumi:"rateLimitBurst"`
+	// The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+	RateLimitPerSecond *float64 `pulumi:"rateLimitPerSecond"`
+	// The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+	ReadTimeoutSeconds *int `pulumi:"readTimeoutSeconds"`
+	// The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+	Region *string `pulumi:"region"`
+	// The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+	RequestTimeoutSeconds *int `pulumi:"requestTimeoutSeconds"`
+	// API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+	RequiredScopes []string `pulumi:"requiredScopes"`
+	// The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+	RetryBudget *int `pulumi:"retryBudget"`
+	// The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+	StatsdAddress *string `pulumi:"statsdAddress"`
+	// A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+	UserAgentSuffix *string `pulumi:"userAgentSuffix"`
+	// Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+	ValidateApiKey *bool `pulumi:"validateApiKey"`
+	// Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+	ValidateOnPreview *bool `pulumi:"validateOnPreview"`
+	// The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
+	WriteTimeoutSeconds *int `pulumi:"writeTimeoutSeconds"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
-	// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+	// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
 	ApiKey pulumi.StringPtrInput
-	// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+	// The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+	ApiKeyFile pulumi.StringPtrInput
+	// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
 	BaseUrl pulumi.StringPtrInput
+	// How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+	CircuitBreakerCooldownSeconds pulumi.IntPtrInput
+	// The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+	CircuitBreakerThreshold pulumi.IntPtrInput
+	// Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+	EnableLegacyContactDb pulumi.BoolPtrInput
+	// Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+	Headers pulumi.StringMapInput
+	// The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+	HttpProxy pulumi.StringPtrInput
+	// The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+	HttpsProxy pulumi.StringPtrInput
+	// Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+	LogMetrics pulumi.BoolPtrInput
+	// The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+	MaxConcurrentRequests pulumi.IntPtrInput
+	// The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+	MaxRetries pulumi.IntPtrInput
+	// A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+	NoProxy pulumi.StringPtrInput
+	// The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+	OnBehalfOf pulumi.StringPtrInput
+	// The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+	RateLimitBurst pulumi.IntPtrInput
+	// The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+	RateLimitPerSecond pulumi.Float64PtrInput
+	// The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+	ReadTimeoutSeconds pulumi.IntPtrInput
+	// The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+	Region pulumi.StringPtrInput
+	// The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+	RequestTimeoutSeconds pulumi.IntPtrInput
+	// API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+	RequiredScopes pulumi.StringArrayInput
+	// The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+	RetryBudget pulumi.IntPtrInput
+	// The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+	StatsdAddress pulumi.StringPtrInput
+	// A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+	UserAgentSuffix pulumi.StringPtrInput
+	// Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+	ValidateApiKey pulumi.BoolPtrInput
+	// Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+	ValidateOnPreview pulumi.BoolPtrInput
+	// The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
+	WriteTimeoutSeconds pulumi.IntPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -98,16 +236,56 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
-// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+// The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
 func (o ProviderOutput) ApiKey() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ApiKey }).(pulumi.StringPtrOutput)
 }
 
-// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+// The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+func (o ProviderOutput) ApiKeyFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ApiKeyFile }).(pulumi.StringPtrOutput)
+}
+
+// The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
 func (o ProviderOutput) BaseUrl() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.BaseUrl }).(pulumi.StringPtrOutput)
 }
 
+// The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+func (o ProviderOutput) HttpProxy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.HttpProxy }).(pulumi.StringPtrOutput)
+}
+
+// The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+func (o ProviderOutput) HttpsProxy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.HttpsProxy }).(pulumi.StringPtrOutput)
+}
+
+// A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+func (o ProviderOutput) NoProxy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.NoProxy }).(pulumi.StringPtrOutput)
+}
+
+// The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+func (o ProviderOutput) OnBehalfOf() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.OnBehalfOf }).(pulumi.StringPtrOutput)
+}
+
+// The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+func (o ProviderOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Region }).(pulumi.StringPtrOutput)
+}
+
+// The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+func (o ProviderOutput) StatsdAddress() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.StatsdAddress }).(pulumi.StringPtrOutput)
+}
+
+// A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+func (o ProviderOutput) UserAgentSuffix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.UserAgentSuffix }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
@@ -13,6 +13,276 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type AlertSummary struct {
+	AlertId    int     `pulumi:"alertId"`
+	CreatedAt  int     `pulumi:"createdAt"`
+	EmailTo    string  `pulumi:"emailTo"`
+	Frequency  *string `pulumi:"frequency"`
+	Percentage *int    `pulumi:"percentage"`
+	Type       string  `pulumi:"type"`
+	UpdatedAt  int     `pulumi:"updatedAt"`
+}
+
+type AlertSummaryOutput struct{ *pulumi.OutputState }
+
+func (AlertSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AlertSummary)(nil)).Elem()
+}
+
+func (o AlertSummaryOutput) ToAlertSummaryOutput() AlertSummaryOutput {
+	return o
+}
+
+func (o AlertSummaryOutput) ToAlertSummaryOutputWithContext(ctx context.Context) AlertSummaryOutput {
+	return o
+}
+
+func (o AlertSummaryOutput) AlertId() pulumi.IntOutput {
+	return o.ApplyT(func(v AlertSummary) int { return v.AlertId }).(pulumi.IntOutput)
+}
+
+func (o AlertSummaryOutput) CreatedAt() pulumi.IntOutput {
+	return o.ApplyT(func(v AlertSummary) int { return v.CreatedAt }).(pulumi.IntOutput)
+}
+
+func (o AlertSummaryOutput) EmailTo() pulumi.StringOutput {
+	return o.ApplyT(func(v AlertSummary) string { return v.EmailTo }).(pulumi.StringOutput)
+}
+
+func (o AlertSummaryOutput) Frequency() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AlertSummary) *string { return v.Frequency }).(pulumi.StringPtrOutput)
+}
+
+func (o AlertSummaryOutput) Percentage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AlertSummary) *int { return v.Percentage }).(pulumi.IntPtrOutput)
+}
+
+func (o AlertSummaryOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v AlertSummary) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o AlertSummaryOutput) UpdatedAt() pulumi.IntOutput {
+	return o.ApplyT(func(v AlertSummary) int { return v.UpdatedAt }).(pulumi.IntOutput)
+}
+
+type AlertSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (AlertSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AlertSummary)(nil)).Elem()
+}
+
+func (o AlertSummaryArrayOutput) ToAlertSummaryArrayOutput() AlertSummaryArrayOutput {
+	return o
+}
+
+func (o AlertSummaryArrayOutput) ToAlertSummaryArrayOutputWithContext(ctx context.Context) AlertSummaryArrayOutput {
+	return o
+}
+
+func (o AlertSummaryArrayOutput) Index(i pulumi.IntInput) AlertSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AlertSummary {
+		return vs[0].([]AlertSummary)[vs[1].(int)]
+	}).(AlertSummaryOutput)
+}
+
+type BlockEntry struct {
+	Created int    `pulumi:"created"`
+	Email   string `pulumi:"email"`
+	Reason  string `pulumi:"reason"`
+	Status  string `pulumi:"status"`
+}
+
+type BlockEntryOutput struct{ *pulumi.OutputState }
+
+func (BlockEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BlockEntry)(nil)).Elem()
+}
+
+func (o BlockEntryOutput) ToBlockEntryOutput() BlockEntryOutput {
+	return o
+}
+
+func (o BlockEntryOutput) ToBlockEntryOutputWithContext(ctx context.Context) BlockEntryOutput {
+	return o
+}
+
+func (o BlockEntryOutput) Created() pulumi.IntOutput {
+	return o.ApplyT(func(v BlockEntry) int { return v.Created }).(pulumi.IntOutput)
+}
+
+func (o BlockEntryOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v BlockEntry) string { return v.Email }).(pulumi.StringOutput)
+}
+
+func (o BlockEntryOutput) Reason() pulumi.StringOutput {
+	return o.ApplyT(func(v BlockEntry) string { return v.Reason }).(pulumi.StringOutput)
+}
+
+func (o BlockEntryOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v BlockEntry) string { return v.Status }).(pulumi.StringOutput)
+}
+
+type BlockEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (BlockEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BlockEntry)(nil)).Elem()
+}
+
+func (o BlockEntryArrayOutput) ToBlockEntryArrayOutput() BlockEntryArrayOutput {
+	return o
+}
+
+func (o BlockEntryArrayOutput) ToBlockEntryArrayOutputWithContext(ctx context.Context) BlockEntryArrayOutput {
+	return o
+}
+
+func (o BlockEntryArrayOutput) Index(i pulumi.IntInput) BlockEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BlockEntry {
+		return vs[0].([]BlockEntry)[vs[1].(int)]
+	}).(BlockEntryOutput)
+}
+
+type BounceEntry struct {
+	Created int    `pulumi:"created"`
+	Email   string `pulumi:"email"`
+	Reason  string `pulumi:"reason"`
+	Status  string `pulumi:"status"`
+}
+
+type BounceEntryOutput struct{ *pulumi.OutputState }
+
+func (BounceEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BounceEntry)(nil)).Elem()
+}
+
+func (o BounceEntryOutput) ToBounceEntryOutput() BounceEntryOutput {
+	return o
+}
+
+func (o BounceEntryOutput) ToBounceEntryOutputWithContext(ctx context.Context) BounceEntryOutput {
+	return o
+}
+
+func (o BounceEntryOutput) Created() pulumi.IntOutput {
+	return o.ApplyT(func(v BounceEntry) int { return v.Created }).(pulumi.IntOutput)
+}
+
+func (o BounceEntryOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v BounceEntry) string { return v.Email }).(pulumi.StringOutput)
+}
+
+func (o BounceEntryOutput) Reason() pulumi.StringOutput {
+	return o.ApplyT(func(v BounceEntry) string { return v.Reason }).(pulumi.StringOutput)
+}
+
+func (o BounceEntryOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v BounceEntry) string { return v.Status }).(pulumi.StringOutput)
+}
+
+type BounceEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (BounceEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BounceEntry)(nil)).Elem()
+}
+
+func (o BounceEntryArrayOutput) ToBounceEntryArrayOutput() BounceEntryArrayOutput {
+	return o
+}
+
+func (o BounceEntryArrayOutput) ToBounceEntryArrayOutputWithContext(ctx context.Context) BounceEntryArrayOutput {
+	return o
+}
+
+func (o BounceEntryArrayOutput) Index(i pulumi.IntInput) BounceEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BounceEntry {
+		return vs[0].([]BounceEntry)[vs[1].(int)]
+	}).(BounceEntryOutput)
+}
+
+type ClickTrackingSetting struct {
+	EnableText bool `pulumi:"enableText"`
+	Enabled    bool `pulumi:"enabled"`
+}
+
+type ClickTrackingSettingOutput struct{ *pulumi.OutputState }
+
+func (ClickTrackingSettingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClickTrackingSetting)(nil)).Elem()
+}
+
+func (o ClickTrackingSettingOutput) ToClickTrackingSettingOutput() ClickTrackingSettingOutput {
+	return o
+}
+
+func (o ClickTrackingSettingOutput) ToClickTrackingSettingOutputWithContext(ctx context.Context) ClickTrackingSettingOutput {
+	return o
+}
+
+func (o ClickTrackingSettingOutput) EnableText() pulumi.BoolOutput {
+	return o.ApplyT(func(v ClickTrackingSetting) bool { return v.EnableText }).(pulumi.BoolOutput)
+}
+
+func (o ClickTrackingSettingOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v ClickTrackingSetting) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+type DNSProviderRecord struct {
+	Name  string `pulumi:"name"`
+	Ttl   int    `pulumi:"ttl"`
+	Type  string `pulumi:"type"`
+	Value string `pulumi:"value"`
+}
+
+type DNSProviderRecordOutput struct{ *pulumi.OutputState }
+
+func (DNSProviderRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSProviderRecord)(nil)).Elem()
+}
+
+func (o DNSProviderRecordOutput) ToDNSProviderRecordOutput() DNSProviderRecordOutput {
+	return o
+}
+
+func (o DNSProviderRecordOutput) ToDNSProviderRecordOutputWithContext(ctx context.Context) DNSProviderRecordOutput {
+	return o
+}
+
+func (o DNSProviderRecordOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSProviderRecord) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o DNSProviderRecordOutput) Ttl() pulumi.IntOutput {
+	return o.ApplyT(func(v DNSProviderRecord) int { return v.Ttl }).(pulumi.IntOutput)
+}
+
+func (o DNSProviderRecordOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSProviderRecord) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o DNSProviderRecordOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSProviderRecord) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type DNSProviderRecordArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSProviderRecordArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSProviderRecord)(nil)).Elem()
+}
+
+func (o DNSProviderRecordArrayOutput) ToDNSProviderRecordArrayOutput() DNSProviderRecordArrayOutput {
+	return o
+}
+
+func (o DNSProviderRecordArrayOutput) ToDNSProviderRecordArrayOutputWithContext(ctx context.Context) DNSProviderRecordArrayOutput {
+	return o
+}
+
+func (o DNSProviderRecordArrayOutput) Index(i pulumi.IntInput) DNSProviderRecordOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DNSProviderRecord {
+		return vs[0].([]DNSProviderRecord)[vs[1].(int)]
+	}).(DNSProviderRecordOutput)
+}
+
 type DNSRecord struct {
 	Data  string `pulumi:"data"`
 	Host  string `pulumi:"host"`
@@ -110,101 +380,1368 @@ func (o DNSRecordPtrOutput) Valid() pulumi.BoolPtrOutput {
 	}).(pulumi.BoolPtrOutput)
 }
 
-type LinkBrandingDNSRecord struct {
+type DesignSummary struct {
+	Categories   []string `pulumi:"categories"`
+	CreatedAt    string   `pulumi:"createdAt"`
+	DesignId     string   `pulumi:"designId"`
+	Editor       string   `pulumi:"editor"`
+	Name         string   `pulumi:"name"`
+	Subject      string   `pulumi:"subject"`
+	ThumbnailUrl string   `pulumi:"thumbnailUrl"`
+	UpdatedAt    string   `pulumi:"updatedAt"`
+}
+
+type DesignSummaryOutput struct{ *pulumi.OutputState }
+
+func (DesignSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DesignSummary)(nil)).Elem()
+}
+
+func (o DesignSummaryOutput) ToDesignSummaryOutput() DesignSummaryOutput {
+	return o
+}
+
+func (o DesignSummaryOutput) ToDesignSummaryOutputWithContext(ctx context.Context) DesignSummaryOutput {
+	return o
+}
+
+func (o DesignSummaryOutput) Categories() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v DesignSummary) []string { return v.Categories }).(pulumi.StringArrayOutput)
+}
+
+func (o DesignSummaryOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v DesignSummary) string { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+func (o DesignSummaryOutput) DesignId() pulumi.StringOutput {
+	return o.ApplyT(func(v DesignSummary) string { return v.DesignId }).(pulumi.StringOutput)
+}
+
+func (o DesignSummaryOutput) Editor() pulumi.StringOutput {
+	return o.ApplyT(func(v DesignSummary) string { return v.Editor }).(pulumi.StringOutput)
+}
+
+func (o DesignSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DesignSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o DesignSummaryOutput) Subject() pulumi.StringOutput {
+	return o.ApplyT(func(v DesignSummary) string { return v.Subject }).(pulumi.StringOutput)
+}
+
+func (o DesignSummaryOutput) ThumbnailUrl() pulumi.StringOutput {
+	return o.ApplyT(func(v DesignSummary) string { return v.ThumbnailUrl }).(pulumi.StringOutput)
+}
+
+func (o DesignSummaryOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v DesignSummary) string { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+type DesignSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (DesignSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DesignSummary)(nil)).Elem()
+}
+
+func (o DesignSummaryArrayOutput) ToDesignSummaryArrayOutput() DesignSummaryArrayOutput {
+	return o
+}
+
+func (o DesignSummaryArrayOutput) ToDesignSummaryArrayOutputWithContext(ctx context.Context) DesignSummaryArrayOutput {
+	return o
+}
+
+func (o DesignSummaryArrayOutput) Index(i pulumi.IntInput) DesignSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DesignSummary {
+		return vs[0].([]DesignSummary)[vs[1].(int)]
+	}).(DesignSummaryOutput)
+}
+
+type DomainDNSRecord struct {
 	Data  string `pulumi:"data"`
 	Host  string `pulumi:"host"`
+	Name  string `pulumi:"name"`
 	Type  string `pulumi:"type"`
 	Valid bool   `pulumi:"valid"`
 }
 
-type LinkBrandingDNSRecordOutput struct{ *pulumi.OutputState }
+type DomainDNSRecordOutput struct{ *pulumi.OutputState }
 
-func (LinkBrandingDNSRecordOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*LinkBrandingDNSRecord)(nil)).Elem()
+func (DomainDNSRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DomainDNSRecord)(nil)).Elem()
 }
 
-func (o LinkBrandingDNSRecordOutput) ToLinkBrandingDNSRecordOutput() LinkBrandingDNSRecordOutput {
+func (o DomainDNSRecordOutput) ToDomainDNSRecordOutput() DomainDNSRecordOutput {
 	return o
 }
 
-func (o LinkBrandingDNSRecordOutput) ToLinkBrandingDNSRecordOutputWithContext(ctx context.Context) LinkBrandingDNSRecordOutput {
+func (o DomainDNSRecordOutput) ToDomainDNSRecordOutputWithContext(ctx context.Context) DomainDNSRecordOutput {
 	return o
 }
 
-func (o LinkBrandingDNSRecordOutput) Data() pulumi.StringOutput {
-	return o.ApplyT(func(v LinkBrandingDNSRecord) string { return v.Data }).(pulumi.StringOutput)
+func (o DomainDNSRecordOutput) Data() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDNSRecord) string { return v.Data }).(pulumi.StringOutput)
 }
 
-func (o LinkBrandingDNSRecordOutput) Host() pulumi.StringOutput {
-	return o.ApplyT(func(v LinkBrandingDNSRecord) string { return v.Host }).(pulumi.StringOutput)
+func (o DomainDNSRecordOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDNSRecord) string { return v.Host }).(pulumi.StringOutput)
 }
 
-func (o LinkBrandingDNSRecordOutput) Type() pulumi.StringOutput {
-	return o.ApplyT(func(v LinkBrandingDNSRecord) string { return v.Type }).(pulumi.StringOutput)
+func (o DomainDNSRecordOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDNSRecord) string { return v.Name }).(pulumi.StringOutput)
 }
 
-func (o LinkBrandingDNSRecordOutput) Valid() pulumi.BoolOutput {
-	return o.ApplyT(func(v LinkBrandingDNSRecord) bool { return v.Valid }).(pulumi.BoolOutput)
+func (o DomainDNSRecordOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDNSRecord) string { return v.Type }).(pulumi.StringOutput)
 }
 
-type LinkBrandingDNSRecordPtrOutput struct{ *pulumi.OutputState }
+func (o DomainDNSRecordOutput) Valid() pulumi.BoolOutput {
+	return o.ApplyT(func(v DomainDNSRecord) bool { return v.Valid }).(pulumi.BoolOutput)
+}
 
-func (LinkBrandingDNSRecordPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**LinkBrandingDNSRecord)(nil)).Elem()
+type DomainDNSRecordArrayOutput struct{ *pulumi.OutputState }
+
+func (DomainDNSRecordArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DomainDNSRecord)(nil)).Elem()
 }
 
-func (o LinkBrandingDNSRecordPtrOutput) ToLinkBrandingDNSRecordPtrOutput() LinkBrandingDNSRecordPtrOutput {
+func (o DomainDNSRecordArrayOutput) ToDomainDNSRecordArrayOutput() DomainDNSRecordArrayOutput {
 	return o
 }
 
-func (o LinkBrandingDNSRecordPtrOutput) ToLinkBrandingDNSRecordPtrOutputWithContext(ctx context.Context) LinkBrandingDNSRecordPtrOutput {
+func (o DomainDNSRecordArrayOutput) ToDomainDNSRecordArrayOutputWithContext(ctx context.Context) DomainDNSRecordArrayOutput {
 	return o
 }
 
-func (o LinkBrandingDNSRecordPtrOutput) Elem() LinkBrandingDNSRecordOutput {
-	return o.ApplyT(func(v *LinkBrandingDNSRecord) LinkBrandingDNSRecord {
-		if v != nil {
-			return *v
-		}
-		var ret LinkBrandingDNSRecord
-		return ret
-	}).(LinkBrandingDNSRecordOutput)
+func (o DomainDNSRecordArrayOutput) Index(i pulumi.IntInput) DomainDNSRecordOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DomainDNSRecord {
+		return vs[0].([]DomainDNSRecord)[vs[1].(int)]
+	}).(DomainDNSRecordOutput)
 }
 
-func (o LinkBrandingDNSRecordPtrOutput) Data() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *LinkBrandingDNSRecord) *string {
-		if v == nil {
-			return nil
-		}
-		return &v.Data
-	}).(pulumi.StringPtrOutput)
+type EmailActivityMessage struct {
+	ClicksCount   int    `pulumi:"clicksCount"`
+	FromEmail     string `pulumi:"fromEmail"`
+	LastEventTime string `pulumi:"lastEventTime"`
+	MsgId         string `pulumi:"msgId"`
+	OpensCount    int    `pulumi:"opensCount"`
+	Status        string `pulumi:"status"`
+	Subject       string `pulumi:"subject"`
+	ToEmail       string `pulumi:"toEmail"`
 }
 
-func (o LinkBrandingDNSRecordPtrOutput) Host() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *LinkBrandingDNSRecord) *string {
-		if v == nil {
-			return nil
-		}
-		return &v.Host
-	}).(pulumi.StringPtrOutput)
+type EmailActivityMessageOutput struct{ *pulumi.OutputState }
+
+func (EmailActivityMessageOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EmailActivityMessage)(nil)).Elem()
 }
 
-func (o LinkBrandingDNSRecordPtrOutput) Type() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *LinkBrandingDNSRecord) *string {
-		if v == nil {
-			return nil
-		}
-		return &v.Type
-	}).(pulumi.StringPtrOutput)
+func (o EmailActivityMessageOutput) ToEmailActivityMessageOutput() EmailActivityMessageOutput {
+	return o
 }
 
-func (o LinkBrandingDNSRecordPtrOutput) Valid() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *LinkBrandingDNSRecord) *bool {
-		if v == nil {
-			return nil
-		}
-		return &v.Valid
-	}).(pulumi.BoolPtrOutput)
+func (o EmailActivityMessageOutput) ToEmailActivityMessageOutputWithContext(ctx context.Context) EmailActivityMessageOutput {
+	return o
+}
+
+func (o EmailActivityMessageOutput) ClicksCount() pulumi.IntOutput {
+	return o.ApplyT(func(v EmailActivityMessage) int { return v.ClicksCount }).(pulumi.IntOutput)
+}
+
+func (o EmailActivityMessageOutput) FromEmail() pulumi.StringOutput {
+	return o.ApplyT(func(v EmailActivityMessage) string { return v.FromEmail }).(pulumi.StringOutput)
+}
+
+func (o EmailActivityMessageOutput) LastEventTime() pulumi.StringOutput {
+	return o.ApplyT(func(v EmailActivityMessage) string { return v.LastEventTime }).(pulumi.StringOutput)
+}
+
+func (o EmailActivityMessageOutput) MsgId() pulumi.StringOutput {
+	return o.ApplyT(func(v EmailActivityMessage) string { return v.MsgId }).(pulumi.StringOutput)
+}
+
+func (o EmailActivityMessageOutput) OpensCount() pulumi.IntOutput {
+	return o.ApplyT(func(v EmailActivityMessage) int { return v.OpensCount }).(pulumi.IntOutput)
+}
+
+func (o EmailActivityMessageOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v EmailActivityMessage) string { return v.Status }).(pulumi.StringOutput)
+}
+
+func (o EmailActivityMessageOutput) Subject() pulumi.StringOutput {
+	return o.ApplyT(func(v EmailActivityMessage) string { return v.Subject }).(pulumi.StringOutput)
+}
+
+func (o EmailActivityMessageOutput) ToEmail() pulumi.StringOutput {
+	return o.ApplyT(func(v EmailActivityMessage) string { return v.ToEmail }).(pulumi.StringOutput)
+}
+
+type EmailActivityMessageArrayOutput struct{ *pulumi.OutputState }
+
+func (EmailActivityMessageArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]EmailActivityMessage)(nil)).Elem()
+}
+
+func (o EmailActivityMessageArrayOutput) ToEmailActivityMessageArrayOutput() EmailActivityMessageArrayOutput {
+	return o
+}
+
+func (o EmailActivityMessageArrayOutput) ToEmailActivityMessageArrayOutputWithContext(ctx context.Context) EmailActivityMessageArrayOutput {
+	return o
+}
+
+func (o EmailActivityMessageArrayOutput) Index(i pulumi.IntInput) EmailActivityMessageOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) EmailActivityMessage {
+		return vs[0].([]EmailActivityMessage)[vs[1].(int)]
+	}).(EmailActivityMessageOutput)
+}
+
+type EmailValidationChecks struct {
+	HasKnownBounces              bool `pulumi:"hasKnownBounces"`
+	HasMxOrARecord               bool `pulumi:"hasMxOrARecord"`
+	HasSuspectedBounces          bool `pulumi:"hasSuspectedBounces"`
+	HasValidAddressSyntax        bool `pulumi:"hasValidAddressSyntax"`
+	IsSuspectedDisposableAddress bool `pulumi:"isSuspectedDisposableAddress"`
+	IsSuspectedRoleAddress       bool `pulumi:"isSuspectedRoleAddress"`
+}
+
+type EmailValidationChecksOutput struct{ *pulumi.OutputState }
+
+func (EmailValidationChecksOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EmailValidationChecks)(nil)).Elem()
+}
+
+func (o EmailValidationChecksOutput) ToEmailValidationChecksOutput() EmailValidationChecksOutput {
+	return o
+}
+
+func (o EmailValidationChecksOutput) ToEmailValidationChecksOutputWithContext(ctx context.Context) EmailValidationChecksOutput {
+	return o
+}
+
+func (o EmailValidationChecksOutput) HasKnownBounces() pulumi.BoolOutput {
+	return o.ApplyT(func(v EmailValidationChecks) bool { return v.HasKnownBounces }).(pulumi.BoolOutput)
+}
+
+func (o EmailValidationChecksOutput) HasMxOrARecord() pulumi.BoolOutput {
+	return o.ApplyT(func(v EmailValidationChecks) bool { return v.HasMxOrARecord }).(pulumi.BoolOutput)
+}
+
+func (o EmailValidationChecksOutput) HasSuspectedBounces() pulumi.BoolOutput {
+	return o.ApplyT(func(v EmailValidationChecks) bool { return v.HasSuspectedBounces }).(pulumi.BoolOutput)
+}
+
+func (o EmailValidationChecksOutput) HasValidAddressSyntax() pulumi.BoolOutput {
+	return o.ApplyT(func(v EmailValidationChecks) bool { return v.HasValidAddressSyntax }).(pulumi.BoolOutput)
+}
+
+func (o EmailValidationChecksOutput) IsSuspectedDisposableAddress() pulumi.BoolOutput {
+	return o.ApplyT(func(v EmailValidationChecks) bool { return v.IsSuspectedDisposableAddress }).(pulumi.BoolOutput)
+}
+
+func (o EmailValidationChecksOutput) IsSuspectedRoleAddress() pulumi.BoolOutput {
+	return o.ApplyT(func(v EmailValidationChecks) bool { return v.IsSuspectedRoleAddress }).(pulumi.BoolOutput)
+}
+
+type EventWebhookSummary struct {
+	Bounce           bool    `pulumi:"bounce"`
+	Click            bool    `pulumi:"click"`
+	Deferred         bool    `pulumi:"deferred"`
+	Delivered        bool    `pulumi:"delivered"`
+	Dropped          bool    `pulumi:"dropped"`
+	Enabled          bool    `pulumi:"enabled"`
+	FriendlyName     *string `pulumi:"friendlyName"`
+	GroupResubscribe bool    `pulumi:"groupResubscribe"`
+	GroupUnsubscribe bool    `pulumi:"groupUnsubscribe"`
+	Open             bool    `pulumi:"open"`
+	Processed        bool    `pulumi:"processed"`
+	SpamReport       bool    `pulumi:"spamReport"`
+	Unsubscribe      bool    `pulumi:"unsubscribe"`
+	Url              string  `pulumi:"url"`
+	WebhookId        string  `pulumi:"webhookId"`
+}
+
+type EventWebhookSummaryOutput struct{ *pulumi.OutputState }
+
+func (EventWebhookSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EventWebhookSummary)(nil)).Elem()
+}
+
+func (o EventWebhookSummaryOutput) ToEventWebhookSummaryOutput() EventWebhookSummaryOutput {
+	return o
+}
+
+func (o EventWebhookSummaryOutput) ToEventWebhookSummaryOutputWithContext(ctx context.Context) EventWebhookSummaryOutput {
+	return o
+}
+
+func (o EventWebhookSummaryOutput) Bounce() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Bounce }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Click() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Click }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Deferred() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Deferred }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Delivered() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Delivered }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Dropped() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Dropped }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) FriendlyName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EventWebhookSummary) *string { return v.FriendlyName }).(pulumi.StringPtrOutput)
+}
+
+func (o EventWebhookSummaryOutput) GroupResubscribe() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.GroupResubscribe }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) GroupUnsubscribe() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.GroupUnsubscribe }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Open() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Open }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Processed() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Processed }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) SpamReport() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.SpamReport }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Unsubscribe() pulumi.BoolOutput {
+	return o.ApplyT(func(v EventWebhookSummary) bool { return v.Unsubscribe }).(pulumi.BoolOutput)
+}
+
+func (o EventWebhookSummaryOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v EventWebhookSummary) string { return v.Url }).(pulumi.StringOutput)
+}
+
+func (o EventWebhookSummaryOutput) WebhookId() pulumi.StringOutput {
+	return o.ApplyT(func(v EventWebhookSummary) string { return v.WebhookId }).(pulumi.StringOutput)
+}
+
+type EventWebhookSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (EventWebhookSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]EventWebhookSummary)(nil)).Elem()
+}
+
+func (o EventWebhookSummaryArrayOutput) ToEventWebhookSummaryArrayOutput() EventWebhookSummaryArrayOutput {
+	return o
+}
+
+func (o EventWebhookSummaryArrayOutput) ToEventWebhookSummaryArrayOutputWithContext(ctx context.Context) EventWebhookSummaryArrayOutput {
+	return o
+}
+
+func (o EventWebhookSummaryArrayOutput) Index(i pulumi.IntInput) EventWebhookSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) EventWebhookSummary {
+		return vs[0].([]EventWebhookSummary)[vs[1].(int)]
+	}).(EventWebhookSummaryOutput)
+}
+
+type GlobalSuppressionEntry struct {
+	Created int    `pulumi:"created"`
+	Email   string `pulumi:"email"`
+}
+
+type GlobalSuppressionEntryOutput struct{ *pulumi.OutputState }
+
+func (GlobalSuppressionEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GlobalSuppressionEntry)(nil)).Elem()
+}
+
+func (o GlobalSuppressionEntryOutput) ToGlobalSuppressionEntryOutput() GlobalSuppressionEntryOutput {
+	return o
+}
+
+func (o GlobalSuppressionEntryOutput) ToGlobalSuppressionEntryOutputWithContext(ctx context.Context) GlobalSuppressionEntryOutput {
+	return o
+}
+
+func (o GlobalSuppressionEntryOutput) Created() pulumi.IntOutput {
+	return o.ApplyT(func(v GlobalSuppressionEntry) int { return v.Created }).(pulumi.IntOutput)
+}
+
+func (o GlobalSuppressionEntryOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v GlobalSuppressionEntry) string { return v.Email }).(pulumi.StringOutput)
+}
+
+type GlobalSuppressionEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (GlobalSuppressionEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GlobalSuppressionEntry)(nil)).Elem()
+}
+
+func (o GlobalSuppressionEntryArrayOutput) ToGlobalSuppressionEntryArrayOutput() GlobalSuppressionEntryArrayOutput {
+	return o
+}
+
+func (o GlobalSuppressionEntryArrayOutput) ToGlobalSuppressionEntryArrayOutputWithContext(ctx context.Context) GlobalSuppressionEntryArrayOutput {
+	return o
+}
+
+func (o GlobalSuppressionEntryArrayOutput) Index(i pulumi.IntInput) GlobalSuppressionEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) GlobalSuppressionEntry {
+		return vs[0].([]GlobalSuppressionEntry)[vs[1].(int)]
+	}).(GlobalSuppressionEntryOutput)
+}
+
+type GoogleAnalyticsTrackingSetting struct {
+	Enabled     bool   `pulumi:"enabled"`
+	UtmCampaign string `pulumi:"utmCampaign"`
+	UtmContent  string `pulumi:"utmContent"`
+	UtmMedium   string `pulumi:"utmMedium"`
+	UtmSource   string `pulumi:"utmSource"`
+	UtmTerm     string `pulumi:"utmTerm"`
+}
+
+type GoogleAnalyticsTrackingSettingOutput struct{ *pulumi.OutputState }
+
+func (GoogleAnalyticsTrackingSettingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GoogleAnalyticsTrackingSetting)(nil)).Elem()
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) ToGoogleAnalyticsTrackingSettingOutput() GoogleAnalyticsTrackingSettingOutput {
+	return o
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) ToGoogleAnalyticsTrackingSettingOutputWithContext(ctx context.Context) GoogleAnalyticsTrackingSettingOutput {
+	return o
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v GoogleAnalyticsTrackingSetting) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) UtmCampaign() pulumi.StringOutput {
+	return o.ApplyT(func(v GoogleAnalyticsTrackingSetting) string { return v.UtmCampaign }).(pulumi.StringOutput)
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) UtmContent() pulumi.StringOutput {
+	return o.ApplyT(func(v GoogleAnalyticsTrackingSetting) string { return v.UtmContent }).(pulumi.StringOutput)
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) UtmMedium() pulumi.StringOutput {
+	return o.ApplyT(func(v GoogleAnalyticsTrackingSetting) string { return v.UtmMedium }).(pulumi.StringOutput)
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) UtmSource() pulumi.StringOutput {
+	return o.ApplyT(func(v GoogleAnalyticsTrackingSetting) string { return v.UtmSource }).(pulumi.StringOutput)
+}
+
+func (o GoogleAnalyticsTrackingSettingOutput) UtmTerm() pulumi.StringOutput {
+	return o.ApplyT(func(v GoogleAnalyticsTrackingSetting) string { return v.UtmTerm }).(pulumi.StringOutput)
+}
+
+type InboundParseSetting struct {
+	Hostname  string `pulumi:"hostname"`
+	SendRaw   bool   `pulumi:"sendRaw"`
+	SpamCheck bool   `pulumi:"spamCheck"`
+	Url       string `pulumi:"url"`
+}
+
+type InboundParseSettingOutput struct{ *pulumi.OutputState }
+
+func (InboundParseSettingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InboundParseSetting)(nil)).Elem()
+}
+
+func (o InboundParseSettingOutput) ToInboundParseSettingOutput() InboundParseSettingOutput {
+	return o
+}
+
+func (o InboundParseSettingOutput) ToInboundParseSettingOutputWithContext(ctx context.Context) InboundParseSettingOutput {
+	return o
+}
+
+func (o InboundParseSettingOutput) Hostname() pulumi.StringOutput {
+	return o.ApplyT(func(v InboundParseSetting) string { return v.Hostname }).(pulumi.StringOutput)
+}
+
+func (o InboundParseSettingOutput) SendRaw() pulumi.BoolOutput {
+	return o.ApplyT(func(v InboundParseSetting) bool { return v.SendRaw }).(pulumi.BoolOutput)
+}
+
+func (o InboundParseSettingOutput) SpamCheck() pulumi.BoolOutput {
+	return o.ApplyT(func(v InboundParseSetting) bool { return v.SpamCheck }).(pulumi.BoolOutput)
+}
+
+func (o InboundParseSettingOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v InboundParseSetting) string { return v.Url }).(pulumi.StringOutput)
+}
+
+type InboundParseSettingArrayOutput struct{ *pulumi.OutputState }
+
+func (InboundParseSettingArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]InboundParseSetting)(nil)).Elem()
+}
+
+func (o InboundParseSettingArrayOutput) ToInboundParseSettingArrayOutput() InboundParseSettingArrayOutput {
+	return o
+}
+
+func (o InboundParseSettingArrayOutput) ToInboundParseSettingArrayOutputWithContext(ctx context.Context) InboundParseSettingArrayOutput {
+	return o
+}
+
+func (o InboundParseSettingArrayOutput) Index(i pulumi.IntInput) InboundParseSettingOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) InboundParseSetting {
+		return vs[0].([]InboundParseSetting)[vs[1].(int)]
+	}).(InboundParseSettingOutput)
+}
+
+type InvalidEmailEntry struct {
+	Created int    `pulumi:"created"`
+	Email   string `pulumi:"email"`
+	Reason  string `pulumi:"reason"`
+}
+
+type InvalidEmailEntryOutput struct{ *pulumi.OutputState }
+
+func (InvalidEmailEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InvalidEmailEntry)(nil)).Elem()
+}
+
+func (o InvalidEmailEntryOutput) ToInvalidEmailEntryOutput() InvalidEmailEntryOutput {
+	return o
+}
+
+func (o InvalidEmailEntryOutput) ToInvalidEmailEntryOutputWithContext(ctx context.Context) InvalidEmailEntryOutput {
+	return o
+}
+
+func (o InvalidEmailEntryOutput) Created() pulumi.IntOutput {
+	return o.ApplyT(func(v InvalidEmailEntry) int { return v.Created }).(pulumi.IntOutput)
+}
+
+func (o InvalidEmailEntryOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v InvalidEmailEntry) string { return v.Email }).(pulumi.StringOutput)
+}
+
+func (o InvalidEmailEntryOutput) Reason() pulumi.StringOutput {
+	return o.ApplyT(func(v InvalidEmailEntry) string { return v.Reason }).(pulumi.StringOutput)
+}
+
+type InvalidEmailEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (InvalidEmailEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]InvalidEmailEntry)(nil)).Elem()
+}
+
+func (o InvalidEmailEntryArrayOutput) ToInvalidEmailEntryArrayOutput() InvalidEmailEntryArrayOutput {
+	return o
+}
+
+func (o InvalidEmailEntryArrayOutput) ToInvalidEmailEntryArrayOutputWithContext(ctx context.Context) InvalidEmailEntryArrayOutput {
+	return o
+}
+
+func (o InvalidEmailEntryArrayOutput) Index(i pulumi.IntInput) InvalidEmailEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) InvalidEmailEntry {
+		return vs[0].([]InvalidEmailEntry)[vs[1].(int)]
+	}).(InvalidEmailEntryOutput)
+}
+
+type LinkBrandingDNSRecord struct {
+	Data  string `pulumi:"data"`
+	Host  string `pulumi:"host"`
+	Type  string `pulumi:"type"`
+	Valid bool   `pulumi:"valid"`
+}
+
+type LinkBrandingDNSRecordOutput struct{ *pulumi.OutputState }
+
+func (LinkBrandingDNSRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LinkBrandingDNSRecord)(nil)).Elem()
+}
+
+func (o LinkBrandingDNSRecordOutput) ToLinkBrandingDNSRecordOutput() LinkBrandingDNSRecordOutput {
+	return o
+}
+
+func (o LinkBrandingDNSRecordOutput) ToLinkBrandingDNSRecordOutputWithContext(ctx context.Context) LinkBrandingDNSRecordOutput {
+	return o
+}
+
+func (o LinkBrandingDNSRecordOutput) Data() pulumi.StringOutput {
+	return o.ApplyT(func(v LinkBrandingDNSRecord) string { return v.Data }).(pulumi.StringOutput)
+}
+
+func (o LinkBrandingDNSRecordOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v LinkBrandingDNSRecord) string { return v.Host }).(pulumi.StringOutput)
+}
+
+func (o LinkBrandingDNSRecordOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v LinkBrandingDNSRecord) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o LinkBrandingDNSRecordOutput) Valid() pulumi.BoolOutput {
+	return o.ApplyT(func(v LinkBrandingDNSRecord) bool { return v.Valid }).(pulumi.BoolOutput)
+}
+
+type LinkBrandingDNSRecordPtrOutput struct{ *pulumi.OutputState }
+
+func (LinkBrandingDNSRecordPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**LinkBrandingDNSRecord)(nil)).Elem()
+}
+
+func (o LinkBrandingDNSRecordPtrOutput) ToLinkBrandingDNSRecordPtrOutput() LinkBrandingDNSRecordPtrOutput {
+	return o
+}
+
+func (o LinkBrandingDNSRecordPtrOutput) ToLinkBrandingDNSRecordPtrOutputWithContext(ctx context.Context) LinkBrandingDNSRecordPtrOutput {
+	return o
+}
+
+func (o LinkBrandingDNSRecordPtrOutput) Elem() LinkBrandingDNSRecordOutput {
+	return o.ApplyT(func(v *LinkBrandingDNSRecord) LinkBrandingDNSRecord {
+		if v != nil {
+			return *v
+		}
+		var ret LinkBrandingDNSRecord
+		return ret
+	}).(LinkBrandingDNSRecordOutput)
+}
+
+func (o LinkBrandingDNSRecordPtrOutput) Data() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *LinkBrandingDNSRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Data
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o LinkBrandingDNSRecordPtrOutput) Host() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *LinkBrandingDNSRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Host
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o LinkBrandingDNSRecordPtrOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *LinkBrandingDNSRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Type
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o LinkBrandingDNSRecordPtrOutput) Valid() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *LinkBrandingDNSRecord) *bool {
+		if v == nil {
+			return nil
+		}
+		return &v.Valid
+	}).(pulumi.BoolPtrOutput)
+}
+
+type MailSetting struct {
+	Description string `pulumi:"description"`
+	Enabled     bool   `pulumi:"enabled"`
+	Name        string `pulumi:"name"`
+	Title       string `pulumi:"title"`
+}
+
+type MailSettingOutput struct{ *pulumi.OutputState }
+
+func (MailSettingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MailSetting)(nil)).Elem()
+}
+
+func (o MailSettingOutput) ToMailSettingOutput() MailSettingOutput {
+	return o
+}
+
+func (o MailSettingOutput) ToMailSettingOutputWithContext(ctx context.Context) MailSettingOutput {
+	return o
+}
+
+func (o MailSettingOutput) Description() pulumi.StringOutput {
+	return o.ApplyT(func(v MailSetting) string { return v.Description }).(pulumi.StringOutput)
+}
+
+func (o MailSettingOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v MailSetting) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+func (o MailSettingOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v MailSetting) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o MailSettingOutput) Title() pulumi.StringOutput {
+	return o.ApplyT(func(v MailSetting) string { return v.Title }).(pulumi.StringOutput)
+}
+
+type MailSettingArrayOutput struct{ *pulumi.OutputState }
+
+func (MailSettingArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MailSetting)(nil)).Elem()
+}
+
+func (o MailSettingArrayOutput) ToMailSettingArrayOutput() MailSettingArrayOutput {
+	return o
+}
+
+func (o MailSettingArrayOutput) ToMailSettingArrayOutputWithContext(ctx context.Context) MailSettingArrayOutput {
+	return o
+}
+
+func (o MailSettingArrayOutput) Index(i pulumi.IntInput) MailSettingOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MailSetting {
+		return vs[0].([]MailSetting)[vs[1].(int)]
+	}).(MailSettingOutput)
+}
+
+type MarketingListSummary struct {
+	ContactCount int    `pulumi:"contactCount"`
+	ListId       string `pulumi:"listId"`
+	Name         string `pulumi:"name"`
+}
+
+type MarketingListSummaryOutput struct{ *pulumi.OutputState }
+
+func (MarketingListSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MarketingListSummary)(nil)).Elem()
+}
+
+func (o MarketingListSummaryOutput) ToMarketingListSummaryOutput() MarketingListSummaryOutput {
+	return o
+}
+
+func (o MarketingListSummaryOutput) ToMarketingListSummaryOutputWithContext(ctx context.Context) MarketingListSummaryOutput {
+	return o
+}
+
+func (o MarketingListSummaryOutput) ContactCount() pulumi.IntOutput {
+	return o.ApplyT(func(v MarketingListSummary) int { return v.ContactCount }).(pulumi.IntOutput)
+}
+
+func (o MarketingListSummaryOutput) ListId() pulumi.StringOutput {
+	return o.ApplyT(func(v MarketingListSummary) string { return v.ListId }).(pulumi.StringOutput)
+}
+
+func (o MarketingListSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v MarketingListSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type MarketingListSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (MarketingListSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MarketingListSummary)(nil)).Elem()
+}
+
+func (o MarketingListSummaryArrayOutput) ToMarketingListSummaryArrayOutput() MarketingListSummaryArrayOutput {
+	return o
+}
+
+func (o MarketingListSummaryArrayOutput) ToMarketingListSummaryArrayOutputWithContext(ctx context.Context) MarketingListSummaryArrayOutput {
+	return o
+}
+
+func (o MarketingListSummaryArrayOutput) Index(i pulumi.IntInput) MarketingListSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MarketingListSummary {
+		return vs[0].([]MarketingListSummary)[vs[1].(int)]
+	}).(MarketingListSummaryOutput)
+}
+
+type MarketingSegmentSummary struct {
+	ContactsCount int      `pulumi:"contactsCount"`
+	CreatedAt     string   `pulumi:"createdAt"`
+	Name          string   `pulumi:"name"`
+	ParentListIds []string `pulumi:"parentListIds"`
+	QueryDsl      string   `pulumi:"queryDsl"`
+	SegmentId     string   `pulumi:"segmentId"`
+	UpdatedAt     string   `pulumi:"updatedAt"`
+}
+
+type MarketingSegmentSummaryOutput struct{ *pulumi.OutputState }
+
+func (MarketingSegmentSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MarketingSegmentSummary)(nil)).Elem()
+}
+
+func (o MarketingSegmentSummaryOutput) ToMarketingSegmentSummaryOutput() MarketingSegmentSummaryOutput {
+	return o
+}
+
+func (o MarketingSegmentSummaryOutput) ToMarketingSegmentSummaryOutputWithContext(ctx context.Context) MarketingSegmentSummaryOutput {
+	return o
+}
+
+func (o MarketingSegmentSummaryOutput) ContactsCount() pulumi.IntOutput {
+	return o.ApplyT(func(v MarketingSegmentSummary) int { return v.ContactsCount }).(pulumi.IntOutput)
+}
+
+func (o MarketingSegmentSummaryOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v MarketingSegmentSummary) string { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+func (o MarketingSegmentSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v MarketingSegmentSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o MarketingSegmentSummaryOutput) ParentListIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v MarketingSegmentSummary) []string { return v.ParentListIds }).(pulumi.StringArrayOutput)
+}
+
+func (o MarketingSegmentSummaryOutput) QueryDsl() pulumi.StringOutput {
+	return o.ApplyT(func(v MarketingSegmentSummary) string { return v.QueryDsl }).(pulumi.StringOutput)
+}
+
+func (o MarketingSegmentSummaryOutput) SegmentId() pulumi.StringOutput {
+	return o.ApplyT(func(v MarketingSegmentSummary) string { return v.SegmentId }).(pulumi.StringOutput)
+}
+
+func (o MarketingSegmentSummaryOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v MarketingSegmentSummary) string { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+type MarketingSegmentSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (MarketingSegmentSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MarketingSegmentSummary)(nil)).Elem()
+}
+
+func (o MarketingSegmentSummaryArrayOutput) ToMarketingSegmentSummaryArrayOutput() MarketingSegmentSummaryArrayOutput {
+	return o
+}
+
+func (o MarketingSegmentSummaryArrayOutput) ToMarketingSegmentSummaryArrayOutputWithContext(ctx context.Context) MarketingSegmentSummaryArrayOutput {
+	return o
+}
+
+func (o MarketingSegmentSummaryArrayOutput) Index(i pulumi.IntInput) MarketingSegmentSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MarketingSegmentSummary {
+		return vs[0].([]MarketingSegmentSummary)[vs[1].(int)]
+	}).(MarketingSegmentSummaryOutput)
+}
+
+type OpenTrackingSetting struct {
+	Enabled bool `pulumi:"enabled"`
+}
+
+type OpenTrackingSettingOutput struct{ *pulumi.OutputState }
+
+func (OpenTrackingSettingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpenTrackingSetting)(nil)).Elem()
+}
+
+func (o OpenTrackingSettingOutput) ToOpenTrackingSettingOutput() OpenTrackingSettingOutput {
+	return o
+}
+
+func (o OpenTrackingSettingOutput) ToOpenTrackingSettingOutputWithContext(ctx context.Context) OpenTrackingSettingOutput {
+	return o
+}
+
+func (o OpenTrackingSettingOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v OpenTrackingSetting) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+type SpamReportEntry struct {
+	Created int    `pulumi:"created"`
+	Email   string `pulumi:"email"`
+	Ip      string `pulumi:"ip"`
+}
+
+type SpamReportEntryOutput struct{ *pulumi.OutputState }
+
+func (SpamReportEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SpamReportEntry)(nil)).Elem()
+}
+
+func (o SpamReportEntryOutput) ToSpamReportEntryOutput() SpamReportEntryOutput {
+	return o
+}
+
+func (o SpamReportEntryOutput) ToSpamReportEntryOutputWithContext(ctx context.Context) SpamReportEntryOutput {
+	return o
+}
+
+func (o SpamReportEntryOutput) Created() pulumi.IntOutput {
+	return o.ApplyT(func(v SpamReportEntry) int { return v.Created }).(pulumi.IntOutput)
+}
+
+func (o SpamReportEntryOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v SpamReportEntry) string { return v.Email }).(pulumi.StringOutput)
+}
+
+func (o SpamReportEntryOutput) Ip() pulumi.StringOutput {
+	return o.ApplyT(func(v SpamReportEntry) string { return v.Ip }).(pulumi.StringOutput)
+}
+
+type SpamReportEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (SpamReportEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SpamReportEntry)(nil)).Elem()
+}
+
+func (o SpamReportEntryArrayOutput) ToSpamReportEntryArrayOutput() SpamReportEntryArrayOutput {
+	return o
+}
+
+func (o SpamReportEntryArrayOutput) ToSpamReportEntryArrayOutputWithContext(ctx context.Context) SpamReportEntryArrayOutput {
+	return o
+}
+
+func (o SpamReportEntryArrayOutput) Index(i pulumi.IntInput) SpamReportEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SpamReportEntry {
+		return vs[0].([]SpamReportEntry)[vs[1].(int)]
+	}).(SpamReportEntryOutput)
+}
+
+type SsoCertificate struct {
+	CertificateId     int    `pulumi:"certificateId"`
+	IntegrationId     string `pulumi:"integrationId"`
+	NotAfter          int    `pulumi:"notAfter"`
+	NotBefore         int    `pulumi:"notBefore"`
+	PublicCertificate string `pulumi:"publicCertificate"`
+}
+
+type SsoCertificateOutput struct{ *pulumi.OutputState }
+
+func (SsoCertificateOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SsoCertificate)(nil)).Elem()
+}
+
+func (o SsoCertificateOutput) ToSsoCertificateOutput() SsoCertificateOutput {
+	return o
+}
+
+func (o SsoCertificateOutput) ToSsoCertificateOutputWithContext(ctx context.Context) SsoCertificateOutput {
+	return o
+}
+
+func (o SsoCertificateOutput) CertificateId() pulumi.IntOutput {
+	return o.ApplyT(func(v SsoCertificate) int { return v.CertificateId }).(pulumi.IntOutput)
+}
+
+func (o SsoCertificateOutput) IntegrationId() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoCertificate) string { return v.IntegrationId }).(pulumi.StringOutput)
+}
+
+func (o SsoCertificateOutput) NotAfter() pulumi.IntOutput {
+	return o.ApplyT(func(v SsoCertificate) int { return v.NotAfter }).(pulumi.IntOutput)
+}
+
+func (o SsoCertificateOutput) NotBefore() pulumi.IntOutput {
+	return o.ApplyT(func(v SsoCertificate) int { return v.NotBefore }).(pulumi.IntOutput)
+}
+
+func (o SsoCertificateOutput) PublicCertificate() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoCertificate) string { return v.PublicCertificate }).(pulumi.StringOutput)
+}
+
+type SsoCertificateArrayOutput struct{ *pulumi.OutputState }
+
+func (SsoCertificateArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SsoCertificate)(nil)).Elem()
+}
+
+func (o SsoCertificateArrayOutput) ToSsoCertificateArrayOutput() SsoCertificateArrayOutput {
+	return o
+}
+
+func (o SsoCertificateArrayOutput) ToSsoCertificateArrayOutputWithContext(ctx context.Context) SsoCertificateArrayOutput {
+	return o
+}
+
+func (o SsoCertificateArrayOutput) Index(i pulumi.IntInput) SsoCertificateOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SsoCertificate {
+		return vs[0].([]SsoCertificate)[vs[1].(int)]
+	}).(SsoCertificateOutput)
+}
+
+type SsoIntegration struct {
+	AudienceUrl     string `pulumi:"audienceUrl"`
+	Completed       bool   `pulumi:"completed"`
+	Enabled         bool   `pulumi:"enabled"`
+	EntityId        string `pulumi:"entityId"`
+	IntegrationId   string `pulumi:"integrationId"`
+	Name            string `pulumi:"name"`
+	SigninUrl       string `pulumi:"signinUrl"`
+	SignoutUrl      string `pulumi:"signoutUrl"`
+	SingleSignonUrl string `pulumi:"singleSignonUrl"`
+}
+
+type SsoIntegrationOutput struct{ *pulumi.OutputState }
+
+func (SsoIntegrationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SsoIntegration)(nil)).Elem()
+}
+
+func (o SsoIntegrationOutput) ToSsoIntegrationOutput() SsoIntegrationOutput {
+	return o
+}
+
+func (o SsoIntegrationOutput) ToSsoIntegrationOutputWithContext(ctx context.Context) SsoIntegrationOutput {
+	return o
+}
+
+func (o SsoIntegrationOutput) AudienceUrl() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoIntegration) string { return v.AudienceUrl }).(pulumi.StringOutput)
+}
+
+func (o SsoIntegrationOutput) Completed() pulumi.BoolOutput {
+	return o.ApplyT(func(v SsoIntegration) bool { return v.Completed }).(pulumi.BoolOutput)
+}
+
+func (o SsoIntegrationOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v SsoIntegration) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+func (o SsoIntegrationOutput) EntityId() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoIntegration) string { return v.EntityId }).(pulumi.StringOutput)
+}
+
+func (o SsoIntegrationOutput) IntegrationId() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoIntegration) string { return v.IntegrationId }).(pulumi.StringOutput)
+}
+
+func (o SsoIntegrationOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoIntegration) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o SsoIntegrationOutput) SigninUrl() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoIntegration) string { return v.SigninUrl }).(pulumi.StringOutput)
+}
+
+func (o SsoIntegrationOutput) SignoutUrl() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoIntegration) string { return v.SignoutUrl }).(pulumi.StringOutput)
+}
+
+func (o SsoIntegrationOutput) SingleSignonUrl() pulumi.StringOutput {
+	return o.ApplyT(func(v SsoIntegration) string { return v.SingleSignonUrl }).(pulumi.StringOutput)
+}
+
+type SsoIntegrationArrayOutput struct{ *pulumi.OutputState }
+
+func (SsoIntegrationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SsoIntegration)(nil)).Elem()
+}
+
+func (o SsoIntegrationArrayOutput) ToSsoIntegrationArrayOutput() SsoIntegrationArrayOutput {
+	return o
+}
+
+func (o SsoIntegrationArrayOutput) ToSsoIntegrationArrayOutputWithContext(ctx context.Context) SsoIntegrationArrayOutput {
+	return o
+}
+
+func (o SsoIntegrationArrayOutput) Index(i pulumi.IntInput) SsoIntegrationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SsoIntegration {
+		return vs[0].([]SsoIntegration)[vs[1].(int)]
+	}).(SsoIntegrationOutput)
+}
+
+type StatsEntry struct {
+	Date  string              `pulumi:"date"`
+	Stats []StatsMetricsEntry `pulumi:"stats"`
+}
+
+type StatsEntryOutput struct{ *pulumi.OutputState }
+
+func (StatsEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*StatsEntry)(nil)).Elem()
+}
+
+func (o StatsEntryOutput) ToStatsEntryOutput() StatsEntryOutput {
+	return o
+}
+
+func (o StatsEntryOutput) ToStatsEntryOutputWithContext(ctx context.Context) StatsEntryOutput {
+	return o
+}
+
+func (o StatsEntryOutput) Date() pulumi.StringOutput {
+	return o.ApplyT(func(v StatsEntry) string { return v.Date }).(pulumi.StringOutput)
+}
+
+func (o StatsEntryOutput) Stats() StatsMetricsEntryArrayOutput {
+	return o.ApplyT(func(v StatsEntry) []StatsMetricsEntry { return v.Stats }).(StatsMetricsEntryArrayOutput)
+}
+
+type StatsEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (StatsEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]StatsEntry)(nil)).Elem()
+}
+
+func (o StatsEntryArrayOutput) ToStatsEntryArrayOutput() StatsEntryArrayOutput {
+	return o
+}
+
+func (o StatsEntryArrayOutput) ToStatsEntryArrayOutputWithContext(ctx context.Context) StatsEntryArrayOutput {
+	return o
+}
+
+func (o StatsEntryArrayOutput) Index(i pulumi.IntInput) StatsEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) StatsEntry {
+		return vs[0].([]StatsEntry)[vs[1].(int)]
+	}).(StatsEntryOutput)
+}
+
+type StatsMetrics struct {
+	Blocks           int `pulumi:"blocks"`
+	BounceDrops      int `pulumi:"bounceDrops"`
+	Bounces          int `pulumi:"bounces"`
+	Clicks           int `pulumi:"clicks"`
+	Deferred         int `pulumi:"deferred"`
+	Delivered        int `pulumi:"delivered"`
+	InvalidEmails    int `pulumi:"invalidEmails"`
+	Opens            int `pulumi:"opens"`
+	Processed        int `pulumi:"processed"`
+	Requests         int `pulumi:"requests"`
+	SpamReportDrops  int `pulumi:"spamReportDrops"`
+	SpamReports      int `pulumi:"spamReports"`
+	UniqueClicks     int `pulumi:"uniqueClicks"`
+	UniqueOpens      int `pulumi:"uniqueOpens"`
+	UnsubscribeDrops int `pulumi:"unsubscribeDrops"`
+	Unsubscribes     int `pulumi:"unsubscribes"`
+}
+
+type StatsMetricsOutput struct{ *pulumi.OutputState }
+
+func (StatsMetricsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*StatsMetrics)(nil)).Elem()
+}
+
+func (o StatsMetricsOutput) ToStatsMetricsOutput() StatsMetricsOutput {
+	return o
+}
+
+func (o StatsMetricsOutput) ToStatsMetricsOutputWithContext(ctx context.Context) StatsMetricsOutput {
+	return o
+}
+
+func (o StatsMetricsOutput) Blocks() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Blocks }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) BounceDrops() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.BounceDrops }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Bounces() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Bounces }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Clicks() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Clicks }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Deferred() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Deferred }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Delivered() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Delivered }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) InvalidEmails() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.InvalidEmails }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Opens() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Opens }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Processed() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Processed }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Requests() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Requests }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) SpamReportDrops() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.SpamReportDrops }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) SpamReports() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.SpamReports }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) UniqueClicks() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.UniqueClicks }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) UniqueOpens() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.UniqueOpens }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) UnsubscribeDrops() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.UnsubscribeDrops }).(pulumi.IntOutput)
+}
+
+func (o StatsMetricsOutput) Unsubscribes() pulumi.IntOutput {
+	return o.ApplyT(func(v StatsMetrics) int { return v.Unsubscribes }).(pulumi.IntOutput)
+}
+
+type StatsMetricsEntry struct {
+	Metrics StatsMetrics `pulumi:"metrics"`
+	Name    *string      `pulumi:"name"`
+	Type    *string      `pulumi:"type"`
+}
+
+type StatsMetricsEntryOutput struct{ *pulumi.OutputState }
+
+func (StatsMetricsEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*StatsMetricsEntry)(nil)).Elem()
+}
+
+func (o StatsMetricsEntryOutput) ToStatsMetricsEntryOutput() StatsMetricsEntryOutput {
+	return o
+}
+
+func (o StatsMetricsEntryOutput) ToStatsMetricsEntryOutputWithContext(ctx context.Context) StatsMetricsEntryOutput {
+	return o
+}
+
+func (o StatsMetricsEntryOutput) Metrics() StatsMetricsOutput {
+	return o.ApplyT(func(v StatsMetricsEntry) StatsMetrics { return v.Metrics }).(StatsMetricsOutput)
+}
+
+func (o StatsMetricsEntryOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v StatsMetricsEntry) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+func (o StatsMetricsEntryOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v StatsMetricsEntry) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type StatsMetricsEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (StatsMetricsEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]StatsMetricsEntry)(nil)).Elem()
+}
+
+func (o StatsMetricsEntryArrayOutput) ToStatsMetricsEntryArrayOutput() StatsMetricsEntryArrayOutput {
+	return o
+}
+
+func (o StatsMetricsEntryArrayOutput) ToStatsMetricsEntryArrayOutputWithContext(ctx context.Context) StatsMetricsEntryArrayOutput {
+	return o
+}
+
+func (o StatsMetricsEntryArrayOutput) Index(i pulumi.IntInput) StatsMetricsEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) StatsMetricsEntry {
+		return vs[0].([]StatsMetricsEntry)[vs[1].(int)]
+	}).(StatsMetricsEntryOutput)
+}
+
+type SubscriptionTrackingSetting struct {
+	Enabled      bool   `pulumi:"enabled"`
+	HtmlContent  string `pulumi:"htmlContent"`
+	Landing      string `pulumi:"landing"`
+	PlainContent string `pulumi:"plainContent"`
+	Replace      string `pulumi:"replace"`
+	Url          string `pulumi:"url"`
+}
+
+type SubscriptionTrackingSettingOutput struct{ *pulumi.OutputState }
+
+func (SubscriptionTrackingSettingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SubscriptionTrackingSetting)(nil)).Elem()
+}
+
+func (o SubscriptionTrackingSettingOutput) ToSubscriptionTrackingSettingOutput() SubscriptionTrackingSettingOutput {
+	return o
+}
+
+func (o SubscriptionTrackingSettingOutput) ToSubscriptionTrackingSettingOutputWithContext(ctx context.Context) SubscriptionTrackingSettingOutput {
+	return o
+}
+
+func (o SubscriptionTrackingSettingOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v SubscriptionTrackingSetting) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+func (o SubscriptionTrackingSettingOutput) HtmlContent() pulumi.StringOutput {
+	return o.ApplyT(func(v SubscriptionTrackingSetting) string { return v.HtmlContent }).(pulumi.StringOutput)
+}
+
+func (o SubscriptionTrackingSettingOutput) Landing() pulumi.StringOutput {
+	return o.ApplyT(func(v SubscriptionTrackingSetting) string { return v.Landing }).(pulumi.StringOutput)
+}
+
+func (o SubscriptionTrackingSettingOutput) PlainContent() pulumi.StringOutput {
+	return o.ApplyT(func(v SubscriptionTrackingSetting) string { return v.PlainContent }).(pulumi.StringOutput)
+}
+
+func (o SubscriptionTrackingSettingOutput) Replace() pulumi.StringOutput {
+	return o.ApplyT(func(v SubscriptionTrackingSetting) string { return v.Replace }).(pulumi.StringOutput)
+}
+
+func (o SubscriptionTrackingSettingOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v SubscriptionTrackingSetting) string { return v.Url }).(pulumi.StringOutput)
+}
+
+type SubuserReputation struct {
+	Reputation float64 `pulumi:"reputation"`
+	Username   string  `pulumi:"username"`
+}
+
+type SubuserReputationOutput struct{ *pulumi.OutputState }
+
+func (SubuserReputationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SubuserReputation)(nil)).Elem()
+}
+
+func (o SubuserReputationOutput) ToSubuserReputationOutput() SubuserReputationOutput {
+	return o
+}
+
+func (o SubuserReputationOutput) ToSubuserReputationOutputWithContext(ctx context.Context) SubuserReputationOutput {
+	return o
+}
+
+func (o SubuserReputationOutput) Reputation() pulumi.Float64Output {
+	return o.ApplyT(func(v SubuserReputation) float64 { return v.Reputation }).(pulumi.Float64Output)
+}
+
+func (o SubuserReputationOutput) Username() pulumi.StringOutput {
+	return o.ApplyT(func(v SubuserReputation) string { return v.Username }).(pulumi.StringOutput)
+}
+
+type SubuserReputationArrayOutput struct{ *pulumi.OutputState }
+
+func (SubuserReputationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SubuserReputation)(nil)).Elem()
+}
+
+func (o SubuserReputationArrayOutput) ToSubuserReputationArrayOutput() SubuserReputationArrayOutput {
+	return o
+}
+
+func (o SubuserReputationArrayOutput) ToSubuserReputationArrayOutputWithContext(ctx context.Context) SubuserReputationArrayOutput {
+	return o
+}
+
+func (o SubuserReputationArrayOutput) Index(i pulumi.IntInput) SubuserReputationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SubuserReputation {
+		return vs[0].([]SubuserReputation)[vs[1].(int)]
+	}).(SubuserReputationOutput)
 }
 
 type TemplateVersionSummary struct {
@@ -270,10 +1807,56 @@ func (o TemplateVersionSummaryArrayOutput) Index(i pulumi.IntInput) TemplateVers
 }
 
 func init() {
+	pulumi.RegisterOutputType(AlertSummaryOutput{})
+	pulumi.RegisterOutputType(AlertSummaryArrayOutput{})
+	pulumi.RegisterOutputType(BlockEntryOutput{})
+	pulumi.RegisterOutputType(BlockEntryArrayOutput{})
+	pulumi.RegisterOutputType(BounceEntryOutput{})
+	pulumi.RegisterOutputType(BounceEntryArrayOutput{})
+	pulumi.RegisterOutputType(ClickTrackingSettingOutput{})
+	pulumi.RegisterOutputType(DNSProviderRecordOutput{})
+	pulumi.RegisterOutputType(DNSProviderRecordArrayOutput{})
 	pulumi.RegisterOutputType(DNSRecordOutput{})
 	pulumi.RegisterOutputType(DNSRecordPtrOutput{})
+	pulumi.RegisterOutputType(DesignSummaryOutput{})
+	pulumi.RegisterOutputType(DesignSummaryArrayOutput{})
+	pulumi.RegisterOutputType(DomainDNSRecordOutput{})
+	pulumi.RegisterOutputType(DomainDNSRecordArrayOutput{})
+	pulumi.RegisterOutputType(EmailActivityMessageOutput{})
+	pulumi.RegisterOutputType(EmailActivityMessageArrayOutput{})
+	pulumi.RegisterOutputType(EmailValidationChecksOutput{})
+	pulumi.RegisterOutputType(EventWebhookSummaryOutput{})
+	pulumi.RegisterOutputType(EventWebhookSummaryArrayOutput{})
+	pulumi.RegisterOutputType(GlobalSuppressionEntryOutput{})
+	pulumi.RegisterOutputType(GlobalSuppressionEntryArrayOutput{})
+	pulumi.RegisterOutputType(GoogleAnalyticsTrackingSettingOutput{})
+	pulumi.RegisterOutputType(InboundParseSettingOutput{})
+	pulumi.RegisterOutputType(InboundParseSettingArrayOutput{})
+	pulumi.RegisterOutputType(InvalidEmailEntryOutput{})
+	pulumi.RegisterOutputType(InvalidEmailEntryArrayOutput{})
 	pulumi.RegisterOutputType(LinkBrandingDNSRecordOutput{})
 	pulumi.RegisterOutputType(LinkBrandingDNSRecordPtrOutput{})
+	pulumi.RegisterOutputType(MailSettingOutput{})
+	pulumi.RegisterOutputType(MailSettingArrayOutput{})
+	pulumi.RegisterOutputType(MarketingListSummaryOutput{})
+	pulumi.RegisterOutputType(MarketingListSummaryArrayOutput{})
+	pulumi.RegisterOutputType(MarketingSegmentSummaryOutput{})
+	pulumi.RegisterOutputType(MarketingSegmentSummaryArrayOutput{})
+	pulumi.RegisterOutputType(OpenTrackingSettingOutput{})
+	pulumi.RegisterOutputType(SpamReportEntryOutput{})
+	pulumi.RegisterOutputType(SpamReportEntryArrayOutput{})
+	pulumi.RegisterOutputType(SsoCertificateOutput{})
+	pulumi.RegisterOutputType(SsoCertificateArrayOutput{})
+	pulumi.RegisterOutputType(SsoIntegrationOutput{})
+	pulumi.RegisterOutputType(SsoIntegrationArrayOutput{})
+	pulumi.RegisterOutputType(StatsEntryOutput{})
+	pulumi.RegisterOutputType(StatsEntryArrayOutput{})
+	pulumi.RegisterOutputType(StatsMetricsOutput{})
+	pulumi.RegisterOutputType(StatsMetricsEntryOutput{})
+	pulumi.RegisterOutputType(StatsMetricsEntryArrayOutput{})
+	pulumi.RegisterOutputType(SubscriptionTrackingSettingOutput{})
+	pulumi.RegisterOutputType(SubuserReputationOutput{})
+	pulumi.RegisterOutputType(SubuserReputationArrayOutput{})
 	pulumi.RegisterOutputType(TemplateVersionSummaryOutput{})
 	pulumi.RegisterOutputType(TemplateVersionSummaryArrayOutput{})
 }
@@ -20,12 +20,13 @@ import (
 type Subuser struct {
 	pulumi.CustomResourceState
 
-	Disabled pulumi.BoolOutput        `pulumi:"disabled"`
-	Email    pulumi.StringOutput      `pulumi:"email"`
-	Ips      pulumi.StringArrayOutput `pulumi:"ips"`
-	Region   pulumi.StringPtrOutput   `pulumi:"region"`
-	UserId   pulumi.IntOutput         `pulumi:"userId"`
-	Username pulumi.StringOutput      `pulumi:"username"`
+	DeletionProtection pulumi.BoolPtrOutput     `pulumi:"deletionProtection"`
+	Disabled           pulumi.BoolOutput        `pulumi:"disabled"`
+	Email              pulumi.StringOutput      `pulumi:"email"`
+	Ips                pulumi.StringArrayOutput `pulumi:"ips"`
+	Region             pulumi.StringPtrOutput   `pulumi:"region"`
+	UserId             pulumi.IntOutput         `pulumi:"userId"`
+	Username           pulumi.StringOutput      `pulumi:"username"`
 }
 
 // NewSubuser registers a new resource with the given unique name, arguments, and options.
@@ -80,22 +81,24 @@ func (SubuserState) ElementType() reflect.Type {
 }
 
 type subuserArgs struct {
-	Disabled *bool    `pulumi:"disabled"`
-	Email    string   `pulumi:"email"`
-	Ips      []string `pulumi:"ips"`
-	Password string   `pulumi:"password"`
-	Region   *string  `pulumi:"region"`
-	Username string   `pulumi:"username"`
+	DeletionProtection *bool    `pulumi:"deletionProtection"`
+	Disabled           *bool    `pulumi:"disabled"`
+	Email              string   `pulumi:"email"`
+	Ips                []string `pulumi:"ips"`
+	Password           string   `pulumi:"password"`
+	Region             *string  `pulumi:"region"`
+	Username           string   `pulumi:"username"`
 }
 
 // The set of arguments for constructing a Subuser resource.
 type SubuserArgs struct {
-	Disabled pulumi.BoolPtrInput
-	Email    pulumi.StringInput
-	Ips      pulumi.StringArrayInput
-	Password pulumi.StringInput
-	Region   pulumi.StringPtrInput
-	Username pulumi.StringInput
+	DeletionProtection pulumi.BoolPtrInput
+	Disabled           pulumi.BoolPtrInput
+	Email              pulumi.StringInput
+	Ips                pulumi.StringArrayInput
+	Password           pulumi.StringInput
+	Region             pulumi.StringPtrInput
+	Username           pulumi.StringInput
 }
 
 func (SubuserArgs) ElementType() reflect.Type {
@@ -185,6 +188,10 @@ func (o SubuserOutput) ToSubuserOutputWithContext(ctx context.Context) SubuserOu
 	return o
 }
 
+func (o SubuserOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Subuser) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o SubuserOutput) Disabled() pulumi.BoolOutput {
 	return o.ApplyT(func(v *Subuser) pulumi.BoolOutput { return v.Disabled }).(pulumi.BoolOutput)
 }
@@ -20,14 +20,15 @@ import (
 type Teammate struct {
 	pulumi.CustomResourceState
 
-	Email     pulumi.StringOutput      `pulumi:"email"`
-	FirstName pulumi.StringPtrOutput   `pulumi:"firstName"`
-	IsAdmin   pulumi.BoolOutput        `pulumi:"isAdmin"`
-	LastName  pulumi.StringPtrOutput   `pulumi:"lastName"`
-	Scopes    pulumi.StringArrayOutput `pulumi:"scopes"`
-	Token     pulumi.StringPtrOutput   `pulumi:"token"`
-	UserType  pulumi.StringPtrOutput   `pulumi:"userType"`
-	Username  pulumi.StringPtrOutput   `pulumi:"username"`
+	DeletionProtection pulumi.BoolPtrOutput     `pulumi:"deletionProtection"`
+	Email              pulumi.StringOutput      `pulumi:"email"`
+	FirstName          pulumi.StringPtrOutput   `pulumi:"firstName"`
+	IsAdmin            pulumi.BoolOutput        `pulumi:"isAdmin"`
+	LastName           pulumi.StringPtrOutput   `pulumi:"lastName"`
+	Scopes             pulumi.StringArrayOutput `pulumi:"scopes"`
+	Token              pulumi.StringPtrOutput   `pulumi:"token"`
+	UserType           pulumi.StringPtrOutput   `pulumi:"userType"`
+	Username           pulumi.StringPtrOutput   `pulumi:"username"`
 }
 
 // NewTeammate registers a new resource with the given unique name, arguments, and options.
@@ -40,6 +41,10 @@ func NewTeammate(ctx *pulumi.Context,
 	if args.Email == nil {
 		return nil, errors.New("invalid value for required argument 'Email'")
 	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"token",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Teammate
 	err := ctx.RegisterResource("sendgrid:index:Teammate", name, args, &resource, opts...)
@@ -73,16 +78,18 @@ func (TeammateState) ElementType() reflect.Type {
 }
 
 type teammateArgs struct {
-	Email   string   `pulumi:"email"`
-	IsAdmin *bool    `pulumi:"isAdmin"`
-	Scopes  []string `pulumi:"scopes"`
+	DeletionProtection *bool    `pulumi:"deletionProtection"`
+	Email              string   `pulumi:"email"`
+	IsAdmin            *bool    `pulumi:"isAdmin"`
+	Scopes             []string `pulumi:"scopes"`
 }
 
 // The set of arguments for constructing a Teammate resource.
 type TeammateArgs struct {
-	Email   pulumi.StringInput
-	IsAdmin pulumi.BoolPtrInput
-	Scopes  pulumi.StringArrayInput
+	DeletionProtection pulumi.BoolPtrInput
+	Email              pulumi.StringInput
+	IsAdmin            pulumi.BoolPtrInput
+	Scopes             pulumi.StringArrayInput
 }
 
 func (TeammateArgs) ElementType() reflect.Type {
@@ -172,6 +179,10 @@ func (o TeammateOutput) ToTeammateOutputWithContext(ctx context.Context) Teammat
 	return o
 }
 
+func (o TeammateOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Teammate) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o TeammateOutput) Email() pulumi.StringOutput {
 	return o.ApplyT(func(v *Teammate) pulumi.StringOutput { return v.Email }).(pulumi.StringOutput)
 }
@@ -18,15 +18,17 @@ import (
 //
 // Templates can be either 'legacy' (plain text/HTML) or 'dynamic' (supporting handlebars syntax for personalization).
 //
-// **Note:** Template versions are managed separately via the TemplateVersion resource.
+// **Note:** Template versions are managed separately via the TemplateVersion resource. Deleting a template that still has versions, e.g. ones created outside of Pulumi, fails unless forceDelete is set.
 type Template struct {
 	pulumi.CustomResourceState
 
-	Generation pulumi.StringOutput               `pulumi:"generation"`
-	Name       pulumi.StringOutput               `pulumi:"name"`
-	TemplateId pulumi.StringOutput               `pulumi:"templateId"`
-	UpdatedAt  pulumi.StringPtrOutput            `pulumi:"updatedAt"`
-	Versions   TemplateVersionSummaryArrayOutput `pulumi:"versions"`
+	DeletionProtection pulumi.BoolPtrOutput              `pulumi:"deletionProtection"`
+	ForceDelete        pulumi.BoolPtrOutput              `pulumi:"forceDelete"`
+	Generation         pulumi.StringOutput               `pulumi:"generation"`
+	Name               pulumi.StringPtrOutput            `pulumi:"name"`
+	TemplateId         pulumi.StringOutput               `pulumi:"templateId"`
+	UpdatedAt          pulumi.StringPtrOutput            `pulumi:"updatedAt"`
+	Versions           TemplateVersionSummaryArrayOutput `pulumi:"versions"`
 }
 
 // NewTemplate registers a new resource with the given unique name, arguments, and options.
@@ -39,9 +41,10 @@ func NewTemplate(ctx *pulumi.Context,
 	if args.Generation == nil {
 		return nil, errors.New("invalid value for required argument 'Generation'")
 	}
-	if args.Name == nil {
-		return nil, errors.New("invalid value for required argument 'Name'")
-	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"generation",
+	})
+	opts = append(opts, replaceOnChanges)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Template
 	err := ctx.RegisterResource("sendgrid:index:Template", name, args, &resource, opts...)
@@ -75,14 +78,18 @@ func (TemplateState) ElementType() reflect.Type {
 }
 
 type templateArgs struct {
-	Generation string `pulumi:"generation"`
-	Name       string `pulumi:"name"`
+	DeletionProtection *bool   `pulumi:"deletionProtection"`
+	ForceDelete        *bool   `pulumi:"forceDelete"`
+	Generation         string  `pulumi:"generation"`
+	Name               *string `pulumi:"name"`
 }
 
 // The set of arguments for constructing a Template resource.
 type TemplateArgs struct {
-	Generation pulumi.StringInput
-	Name       pulumi.StringInput
+	DeletionProtection pulumi.BoolPtrInput
+	ForceDelete        pulumi.BoolPtrInput
+	Generation         pulumi.StringInput
+	Name               pulumi.StringPtrInput
 }
 
 func (TemplateArgs) ElementType() reflect.Type {
@@ -172,12 +179,20 @@ func (o TemplateOutput) ToTemplateOutputWithContext(ctx context.Context) Templat
 	return o
 }
 
+func (o TemplateOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Template) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
+func (o TemplateOutput) ForceDelete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Template) pulumi.BoolPtrOutput { return v.ForceDelete }).(pulumi.BoolPtrOutput)
+}
+
 func (o TemplateOutput) Generation() pulumi.StringOutput {
 	return o.ApplyT(func(v *Template) pulumi.StringOutput { return v.Generation }).(pulumi.StringOutput)
 }
 
-func (o TemplateOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v *Template) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+func (o TemplateOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Template) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
 }
 
 func (o TemplateOutput) TemplateId() pulumi.StringOutput {
@@ -18,16 +18,21 @@ import (
 //
 // Each template can have multiple versions, but only one can be active at a time. The active version is used when sending emails through the template.
 //
+// Activating a version deactivates the one that was active. When that happens to a version with active set to 1, refresh records it as inactive with the deactivatedBy output, and it is not activated again until active is changed, so two versions set active do not take turns.
+//
 // **Note:** Dynamic templates support handlebars syntax for personalization.
 type TemplateVersion struct {
 	pulumi.CustomResourceState
 
 	Active               pulumi.IntPtrOutput    `pulumi:"active"`
+	DeactivatedBy        pulumi.StringPtrOutput `pulumi:"deactivatedBy"`
+	DeletionProtection   pulumi.BoolPtrOutput   `pulumi:"deletionProtection"`
 	Editor               pulumi.StringPtrOutput `pulumi:"editor"`
 	GeneratePlainContent pulumi.BoolPtrOutput   `pulumi:"generatePlainContent"`
 	HtmlContent          pulumi.StringPtrOutput `pulumi:"htmlContent"`
 	Name                 pulumi.StringOutput    `pulumi:"name"`
 	PlainContent         pulumi.StringPtrOutput `pulumi:"plainContent"`
+	RenderPreview        pulumi.BoolPtrOutput   `pulumi:"renderPreview"`
 	Subject              pulumi.StringPtrOutput `pulumi:"subject"`
 	TemplateId           pulumi.StringOutput    `pulumi:"templateId"`
 	TestData             pulumi.StringPtrOutput `pulumi:"testData"`
@@ -49,6 +54,10 @@ func NewTemplateVersion(ctx *pulumi.Context,
 	if args.TemplateId == nil {
 		return nil, errors.New("invalid value for required argument 'TemplateId'")
 	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"templateId",
+	})
+	opts = append(opts, replaceOnChanges)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource TemplateVersion
 	err := ctx.RegisterResource("sendgrid:index:TemplateVersion", name, args, &resource, opts...)
@@ -83,11 +92,13 @@ func (TemplateVersionState) ElementType() reflect.Type {
 
 type templateVersionArgs struct {
 	Active               *int    `pulumi:"active"`
+	DeletionProtection   *bool   `pulumi:"deletionProtection"`
 	Editor               *string `pulumi:"editor"`
 	GeneratePlainContent *bool   `pulumi:"generatePlainContent"`
 	HtmlContent          *string `pulumi:"htmlContent"`
 	Name                 string  `pulumi:"name"`
 	PlainContent         *string `pulumi:"plainContent"`
+	RenderPreview        *bool   `pulumi:"renderPreview"`
 	Subject              *string `pulumi:"subject"`
 	TemplateId           string  `pulumi:"templateId"`
 	TestData             *string `pulumi:"testData"`
@@ -96,11 +107,13 @@ type templateVersionArgs struct {
 // The set of arguments for constructing a TemplateVersion resource.
 type TemplateVersionArgs struct {
 	Active               pulumi.IntPtrInput
+	DeletionProtection   pulumi.BoolPtrInput
 	Editor               pulumi.StringPtrInput
 	GeneratePlainContent pulumi.BoolPtrInput
 	HtmlContent          pulumi.StringPtrInput
 	Name                 pulumi.StringInput
 	PlainContent         pulumi.StringPtrInput
+	RenderPreview        pulumi.BoolPtrInput
 	Subject              pulumi.StringPtrInput
 	TemplateId           pulumi.StringInput
 	TestData             pulumi.StringPtrInput
@@ -197,6 +210,14 @@ func (o TemplateVersionOutput) Active() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *TemplateVersion) pulumi.IntPtrOutput { return v.Active }).(pulumi.IntPtrOutput)
 }
 
+func (o TemplateVersionOutput) DeactivatedBy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *TemplateVersion) pulumi.StringPtrOutput { return v.DeactivatedBy }).(pulumi.StringPtrOutput)
+}
+
+func (o TemplateVersionOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *TemplateVersion) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o TemplateVersionOutput) Editor() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *TemplateVersion) pulumi.StringPtrOutput { return v.Editor }).(pulumi.StringPtrOutput)
 }
@@ -217,6 +238,10 @@ func (o TemplateVersionOutput) PlainContent() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *TemplateVersion) pulumi.StringPtrOutput { return v.PlainContent }).(pulumi.StringPtrOutput)
 }
 
+func (o TemplateVersionOutput) RenderPreview() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *TemplateVersion) pulumi.BoolPtrOutput { return v.RenderPreview }).(pulumi.BoolPtrOutput)
+}
+
 func (o TemplateVersionOutput) Subject() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *TemplateVersion) pulumi.StringPtrOutput { return v.Subject }).(pulumi.StringPtrOutput)
 }
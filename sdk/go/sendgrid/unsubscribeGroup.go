@@ -7,7 +7,6 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
 	"github.com/JDetmar/pulumi-sendgrid/sdk/go/sendgrid/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -17,26 +16,30 @@ import (
 // Unsubscribe groups allow recipients to opt out of specific types of emails while still receiving others. For example, you might have separate groups for marketing, newsletters, and product updates, allowing users to choose which types of emails they want to receive.
 //
 // When a recipient unsubscribes from a group, they will no longer receive emails that are associated with that group.
+//
+// Deleting the account's default group fails, so emails sent without a group keep an unsubscribe option. Make another group the default first, e.g. by creating one with isDefault set to true.
+//
+// When SendGrid refuses to delete a group because it still has suppressions, the delete fails unless forceDelete is set, in which case the suppressions are removed and the delete retried.
 type UnsubscribeGroup struct {
 	pulumi.CustomResourceState
 
-	Description  pulumi.StringPtrOutput `pulumi:"description"`
-	GroupId      pulumi.IntOutput       `pulumi:"groupId"`
-	IsDefault    pulumi.BoolPtrOutput   `pulumi:"isDefault"`
-	Name         pulumi.StringOutput    `pulumi:"name"`
-	Unsubscribes pulumi.IntOutput       `pulumi:"unsubscribes"`
+	AdoptExisting      pulumi.BoolPtrOutput   `pulumi:"adoptExisting"`
+	DeletionProtection pulumi.BoolPtrOutput   `pulumi:"deletionProtection"`
+	Description        pulumi.StringPtrOutput `pulumi:"description"`
+	ForceDelete        pulumi.BoolPtrOutput   `pulumi:"forceDelete"`
+	GroupId            pulumi.IntOutput       `pulumi:"groupId"`
+	IsDefault          pulumi.BoolPtrOutput   `pulumi:"isDefault"`
+	Name               pulumi.StringPtrOutput `pulumi:"name"`
+	Unsubscribes       pulumi.IntOutput       `pulumi:"unsubscribes"`
 }
 
 // NewUnsubscribeGroup registers a new resource with the given unique name, arguments, and options.
 func NewUnsubscribeGroup(ctx *pulumi.Context,
 	name string, args *UnsubscribeGroupArgs, opts ...pulumi.ResourceOption) (*UnsubscribeGroup, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &UnsubscribeGroupArgs{}
 	}
 
-	if args.Name == nil {
-		return nil, errors.New("invalid value for required argument 'Name'")
-	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource UnsubscribeGroup
 	err := ctx.RegisterResource("sendgrid:index:UnsubscribeGroup", name, args, &resource, opts...)
@@ -70,16 +73,22 @@ func (UnsubscribeGroupState) ElementType() reflect.Type {
 }
 
 type unsubscribeGroupArgs struct {
-	Description *string `pulumi:"description"`
-	IsDefault   *bool   `pulumi:"isDefault"`
-	Name        string  `pulumi:"name"`
+	AdoptExisting      *bool   `pulumi:"adoptExisting"`
+	DeletionProtection *bool   `pulumi:"deletionProtection"`
+	Description        *string `pulumi:"description"`
+	ForceDelete        *bool   `pulumi:"forceDelete"`
+	IsDefault          *bool   `pulumi:"isDefault"`
+	Name               *string `pulumi:"name"`
 }
 
 // The set of arguments for constructing a UnsubscribeGroup resource.
 type UnsubscribeGroupArgs struct {
-	Description pulumi.StringPtrInput
-	IsDefault   pulumi.BoolPtrInput
-	Name        pulumi.StringInput
+	AdoptExisting      pulumi.BoolPtrInput
+	DeletionProtection pulumi.BoolPtrInput
+	Description        pulumi.StringPtrInput
+	ForceDelete        pulumi.BoolPtrInput
+	IsDefault          pulumi.BoolPtrInput
+	Name               pulumi.StringPtrInput
 }
 
 func (UnsubscribeGroupArgs) ElementType() reflect.Type {
@@ -169,10 +178,22 @@ func (o UnsubscribeGroupOutput) ToUnsubscribeGroupOutputWithContext(ctx context.
 	return o
 }
 
+func (o UnsubscribeGroupOutput) AdoptExisting() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.BoolPtrOutput { return v.AdoptExisting }).(pulumi.BoolPtrOutput)
+}
+
+func (o UnsubscribeGroupOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o UnsubscribeGroupOutput) Description() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.StringPtrOutput { return v.Description }).(pulumi.StringPtrOutput)
 }
 
+func (o UnsubscribeGroupOutput) ForceDelete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.BoolPtrOutput { return v.ForceDelete }).(pulumi.BoolPtrOutput)
+}
+
 func (o UnsubscribeGroupOutput) GroupId() pulumi.IntOutput {
 	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.IntOutput { return v.GroupId }).(pulumi.IntOutput)
 }
@@ -181,8 +202,8 @@ func (o UnsubscribeGroupOutput) IsDefault() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.BoolPtrOutput { return v.IsDefault }).(pulumi.BoolPtrOutput)
 }
 
-func (o UnsubscribeGroupOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+func (o UnsubscribeGroupOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UnsubscribeGroup) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
 }
 
 func (o UnsubscribeGroupOutput) Unsubscribes() pulumi.IntOutput {
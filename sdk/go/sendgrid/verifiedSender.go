@@ -20,20 +20,24 @@ import (
 type VerifiedSender struct {
 	pulumi.CustomResourceState
 
-	Address     pulumi.StringOutput    `pulumi:"address"`
-	Address2    pulumi.StringPtrOutput `pulumi:"address2"`
-	City        pulumi.StringOutput    `pulumi:"city"`
-	Country     pulumi.StringOutput    `pulumi:"country"`
-	FromEmail   pulumi.StringOutput    `pulumi:"fromEmail"`
-	FromName    pulumi.StringPtrOutput `pulumi:"fromName"`
-	Locked      pulumi.BoolOutput      `pulumi:"locked"`
-	Nickname    pulumi.StringOutput    `pulumi:"nickname"`
-	ReplyTo     pulumi.StringOutput    `pulumi:"replyTo"`
-	ReplyToName pulumi.StringPtrOutput `pulumi:"replyToName"`
-	SenderId    pulumi.IntOutput       `pulumi:"senderId"`
-	State       pulumi.StringPtrOutput `pulumi:"state"`
-	Verified    pulumi.BoolOutput      `pulumi:"verified"`
-	Zip         pulumi.StringPtrOutput `pulumi:"zip"`
+	Address             pulumi.StringOutput    `pulumi:"address"`
+	Address2            pulumi.StringPtrOutput `pulumi:"address2"`
+	City                pulumi.StringOutput    `pulumi:"city"`
+	Country             pulumi.StringOutput    `pulumi:"country"`
+	DeletionProtection  pulumi.BoolPtrOutput   `pulumi:"deletionProtection"`
+	FromEmail           pulumi.StringOutput    `pulumi:"fromEmail"`
+	FromName            pulumi.StringPtrOutput `pulumi:"fromName"`
+	Locked              pulumi.BoolOutput      `pulumi:"locked"`
+	Nickname            pulumi.StringPtrOutput `pulumi:"nickname"`
+	ReplyTo             pulumi.StringOutput    `pulumi:"replyTo"`
+	ReplyToName         pulumi.StringPtrOutput `pulumi:"replyToName"`
+	SenderId            pulumi.IntOutput       `pulumi:"senderId"`
+	State               pulumi.StringPtrOutput `pulumi:"state"`
+	Verified            pulumi.BoolOutput      `pulumi:"verified"`
+	WaitForVerification pulumi.BoolPtrOutput   `pulumi:"waitForVerification"`
+	WaitIntervalSeconds pulumi.IntPtrOutput    `pulumi:"waitIntervalSeconds"`
+	WaitTimeoutSeconds  pulumi.IntPtrOutput    `pulumi:"waitTimeoutSeconds"`
+	Zip                 pulumi.StringPtrOutput `pulumi:"zip"`
 }
 
 // NewVerifiedSender registers a new resource with the given unique name, arguments, and options.
@@ -55,12 +59,13 @@ func NewVerifiedSender(ctx *pulumi.Context,
 	if args.FromEmail == nil {
 		return nil, errors.New("invalid value for required argument 'FromEmail'")
 	}
-	if args.Nickname == nil {
-		return nil, errors.New("invalid value for required argument 'Nickname'")
-	}
 	if args.ReplyTo == nil {
 		return nil, errors.New("invalid value for required argument 'ReplyTo'")
 	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"fromEmail",
+	})
+	opts = append(opts, replaceOnChanges)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource VerifiedSender
 	err := ctx.RegisterResource("sendgrid:index:VerifiedSender", name, args, &resource, opts...)
@@ -94,32 +99,40 @@ func (VerifiedSenderState) ElementType() reflect.Type {
 }
 
 type verifiedSenderArgs struct {
-	Address     string  `pulumi:"address"`
-	Address2    *string `pulumi:"address2"`
-	City        string  `pulumi:"city"`
-	Country     string  `pulumi:"country"`
-	FromEmail   string  `pulumi:"fromEmail"`
-	FromName    *string `pulumi:"fromName"`
-	Nickname    string  `pulumi:"nickname"`
-	ReplyTo     string  `pulumi:"replyTo"`
-	ReplyToName *string `pulumi:"replyToName"`
-	State       *string `pulumi:"state"`
-	Zip         *string `pulumi:"zip"`
+	Address             string  `pulumi:"address"`
+	Address2            *string `pulumi:"address2"`
+	City                string  `pulumi:"city"`
+	Country             string  `pulumi:"country"`
+	DeletionProtection  *bool   `pulumi:"deletionProtection"`
+	FromEmail           string  `pulumi:"fromEmail"`
+	FromName            *string `pulumi:"fromName"`
+	Nickname            *string `pulumi:"nickname"`
+	ReplyTo             string  `pulumi:"replyTo"`
+	ReplyToName         *string `pulumi:"replyToName"`
+	State               *string `pulumi:"state"`
+	WaitForVerification *bool   `pulumi:"waitForVerification"`
+	WaitIntervalSeconds *int    `pulumi:"waitIntervalSeconds"`
+	WaitTimeoutSeconds  *int    `pulumi:"waitTimeoutSeconds"`
+	Zip                 *string `pulumi:"zip"`
 }
 
 // The set of arguments for constructing a VerifiedSender resource.
 type VerifiedSenderArgs struct {
-	Address     pulumi.StringInput
-	Address2    pulumi.StringPtrInput
-	City        pulumi.StringInput
-	Country     pulumi.StringInput
-	FromEmail   pulumi.StringInput
-	FromName    pulumi.StringPtrInput
-	Nickname    pulumi.StringInput
-	ReplyTo     pulumi.StringInput
-	ReplyToName pulumi.StringPtrInput
-	State       pulumi.StringPtrInput
-	Zip         pulumi.StringPtrInput
+	Address             pulumi.StringInput
+	Address2            pulumi.StringPtrInput
+	City                pulumi.StringInput
+	Country             pulumi.StringInput
+	DeletionProtection  pulumi.BoolPtrInput
+	FromEmail           pulumi.StringInput
+	FromName            pulumi.StringPtrInput
+	Nickname            pulumi.StringPtrInput
+	ReplyTo             pulumi.StringInput
+	ReplyToName         pulumi.StringPtrInput
+	State               pulumi.StringPtrInput
+	WaitForVerification pulumi.BoolPtrInput
+	WaitIntervalSeconds pulumi.IntPtrInput
+	WaitTimeoutSeconds  pulumi.IntPtrInput
+	Zip                 pulumi.StringPtrInput
 }
 
 func (VerifiedSenderArgs) ElementType() reflect.Type {
@@ -225,6 +238,10 @@ func (o VerifiedSenderOutput) Country() pulumi.StringOutput {
 	return o.ApplyT(func(v *VerifiedSender) pulumi.StringOutput { return v.Country }).(pulumi.StringOutput)
 }
 
+func (o VerifiedSenderOutput) DeletionProtection() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *VerifiedSender) pulumi.BoolPtrOutput { return v.DeletionProtection }).(pulumi.BoolPtrOutput)
+}
+
 func (o VerifiedSenderOutput) FromEmail() pulumi.StringOutput {
 	return o.ApplyT(func(v *VerifiedSender) pulumi.StringOutput { return v.FromEmail }).(pulumi.StringOutput)
 }
@@ -237,8 +254,8 @@ func (o VerifiedSenderOutput) Locked() pulumi.BoolOutput {
 	return o.ApplyT(func(v *VerifiedSender) pulumi.BoolOutput { return v.Locked }).(pulumi.BoolOutput)
 }
 
-func (o VerifiedSenderOutput) Nickname() pulumi.StringOutput {
-	return o.ApplyT(func(v *VerifiedSender) pulumi.StringOutput { return v.Nickname }).(pulumi.StringOutput)
+func (o VerifiedSenderOutput) Nickname() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *VerifiedSender) pulumi.StringPtrOutput { return v.Nickname }).(pulumi.StringPtrOutput)
 }
 
 func (o VerifiedSenderOutput) ReplyTo() pulumi.StringOutput {
@@ -261,6 +278,18 @@ func (o VerifiedSenderOutput) Verified() pulumi.BoolOutput {
 	return o.ApplyT(func(v *VerifiedSender) pulumi.BoolOutput { return v.Verified }).(pulumi.BoolOutput)
 }
 
+func (o VerifiedSenderOutput) WaitForVerification() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *VerifiedSender) pulumi.BoolPtrOutput { return v.WaitForVerification }).(pulumi.BoolPtrOutput)
+}
+
+func (o VerifiedSenderOutput) WaitIntervalSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *VerifiedSender) pulumi.IntPtrOutput { return v.WaitIntervalSeconds }).(pulumi.IntPtrOutput)
+}
+
+func (o VerifiedSenderOutput) WaitTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *VerifiedSender) pulumi.IntPtrOutput { return v.WaitTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
 func (o VerifiedSenderOutput) Zip() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *VerifiedSender) pulumi.StringPtrOutput { return v.Zip }).(pulumi.StringPtrOutput)
 }
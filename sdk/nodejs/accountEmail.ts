@@ -0,0 +1,69 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Manages the contact email address of the SendGrid account.
+ *
+ * Sets the email address of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get the right contact address. There is one address per account, so only one of these resources should exist per provider. Deleting the resource leaves the address unchanged, as an account always has one.
+ */
+export class AccountEmail extends pulumi.CustomResource {
+    /**
+     * Get an existing AccountEmail resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): AccountEmail {
+        return new AccountEmail(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:AccountEmail';
+
+    /**
+     * Returns true if the given object is an instance of AccountEmail.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AccountEmail {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AccountEmail.__pulumiType;
+    }
+
+    declare public readonly email: pulumi.Output<string>;
+
+    /**
+     * Create a AccountEmail resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AccountEmailArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.email === undefined && !opts.urn) {
+                throw new Error("Missing required property 'email'");
+            }
+            resourceInputs["email"] = args?.email;
+        } else {
+            resourceInputs["email"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AccountEmail.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AccountEmail resource.
+ */
+export interface AccountEmailArgs {
+    email: pulumi.Input<string>;
+}
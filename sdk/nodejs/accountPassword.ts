@@ -0,0 +1,84 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Rotates the password of the SendGrid account.
+ *
+ * Creating the resource changes the password from oldPassword to newPassword. Each later change of newPassword rotates it again, using the previous newPassword as the old one, so account credentials can be rotated alongside API keys. Changing triggers without changing newPassword is an error.
+ *
+ * SendGrid never returns passwords, so refresh cannot detect a password changed elsewhere, and deleting the resource leaves the current password in place.
+ */
+export class AccountPassword extends pulumi.CustomResource {
+    /**
+     * Get an existing AccountPassword resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): AccountPassword {
+        return new AccountPassword(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:AccountPassword';
+
+    /**
+     * Returns true if the given object is an instance of AccountPassword.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AccountPassword {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AccountPassword.__pulumiType;
+    }
+
+    declare public readonly newPassword: pulumi.Output<string>;
+    declare public readonly oldPassword: pulumi.Output<string>;
+    declare public readonly triggers: pulumi.Output<{[key: string]: string} | undefined>;
+
+    /**
+     * Create a AccountPassword resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AccountPasswordArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.newPassword === undefined && !opts.urn) {
+                throw new Error("Missing required property 'newPassword'");
+            }
+            if (args?.oldPassword === undefined && !opts.urn) {
+                throw new Error("Missing required property 'oldPassword'");
+            }
+            resourceInputs["newPassword"] = args?.newPassword ? pulumi.secret(args.newPassword) : undefined;
+            resourceInputs["oldPassword"] = args?.oldPassword ? pulumi.secret(args.oldPassword) : undefined;
+            resourceInputs["triggers"] = args?.triggers;
+        } else {
+            resourceInputs["newPassword"] = undefined /*out*/;
+            resourceInputs["oldPassword"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["newPassword", "oldPassword"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(AccountPassword.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AccountPassword resource.
+ */
+export interface AccountPasswordArgs {
+    newPassword: pulumi.Input<string>;
+    oldPassword: pulumi.Input<string>;
+    triggers?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
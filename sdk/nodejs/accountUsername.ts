@@ -0,0 +1,72 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Manages the username of the SendGrid account.
+ *
+ * Sets the username of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get deterministic usernames. With validateOnPreview, previews fail when a subuser of the account already has the username. There is one username per account, so only one of these resources should exist per provider. Deleting the resource leaves the username unchanged, as an account always has one.
+ */
+export class AccountUsername extends pulumi.CustomResource {
+    /**
+     * Get an existing AccountUsername resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): AccountUsername {
+        return new AccountUsername(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:AccountUsername';
+
+    /**
+     * Returns true if the given object is an instance of AccountUsername.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AccountUsername {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AccountUsername.__pulumiType;
+    }
+
+    declare public /*out*/ readonly userId: pulumi.Output<number>;
+    declare public readonly username: pulumi.Output<string>;
+
+    /**
+     * Create a AccountUsername resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AccountUsernameArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.username === undefined && !opts.urn) {
+                throw new Error("Missing required property 'username'");
+            }
+            resourceInputs["username"] = args?.username;
+            resourceInputs["userId"] = undefined /*out*/;
+        } else {
+            resourceInputs["userId"] = undefined /*out*/;
+            resourceInputs["username"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AccountUsername.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AccountUsername resource.
+ */
+export interface AccountUsernameArgs {
+    username: pulumi.Input<string>;
+}
@@ -13,6 +13,8 @@ import * as utilities from "./utilities";
  * 2. **stats_notification**: Sends periodic email statistics (daily, weekly, or monthly).
  *
  * You can create multiple alerts of the same type with different email recipients.
+ *
+ * SendGrid does not reject duplicate alerts, so with adoptExisting an identical alert is looked up before creating one, and a retried deployment takes it over instead of creating a second alert.
  */
 export class Alert extends pulumi.CustomResource {
     /**
@@ -41,8 +43,10 @@ export class Alert extends pulumi.CustomResource {
         return obj['__pulumiType'] === Alert.__pulumiType;
     }
 
+    declare public readonly adoptExisting: pulumi.Output<boolean | undefined>;
     declare public /*out*/ readonly alertId: pulumi.Output<number>;
     declare public /*out*/ readonly createdAt: pulumi.Output<number>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly emailTo: pulumi.Output<string>;
     declare public readonly frequency: pulumi.Output<string | undefined>;
     declare public readonly percentage: pulumi.Output<number | undefined>;
@@ -66,6 +70,8 @@ export class Alert extends pulumi.CustomResource {
             if (args?.type === undefined && !opts.urn) {
                 throw new Error("Missing required property 'type'");
             }
+            resourceInputs["adoptExisting"] = args?.adoptExisting;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["emailTo"] = args?.emailTo;
             resourceInputs["frequency"] = args?.frequency;
             resourceInputs["percentage"] = args?.percentage;
@@ -74,8 +80,10 @@ export class Alert extends pulumi.CustomResource {
             resourceInputs["createdAt"] = undefined /*out*/;
             resourceInputs["updatedAt"] = undefined /*out*/;
         } else {
+            resourceInputs["adoptExisting"] = undefined /*out*/;
             resourceInputs["alertId"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["emailTo"] = undefined /*out*/;
             resourceInputs["frequency"] = undefined /*out*/;
             resourceInputs["percentage"] = undefined /*out*/;
@@ -83,6 +91,8 @@ export class Alert extends pulumi.CustomResource {
             resourceInputs["updatedAt"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["type"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(Alert.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -91,6 +101,8 @@ export class Alert extends pulumi.CustomResource {
  * The set of arguments for constructing a Alert resource.
  */
 export interface AlertArgs {
+    adoptExisting?: pulumi.Input<boolean>;
+    deletionProtection?: pulumi.Input<boolean>;
     emailTo: pulumi.Input<string>;
     frequency?: pulumi.Input<string>;
     percentage?: pulumi.Input<number>;
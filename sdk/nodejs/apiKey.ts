@@ -40,7 +40,8 @@ export class ApiKey extends pulumi.CustomResource {
 
     declare public /*out*/ readonly apiKeyId: pulumi.Output<string>;
     declare public /*out*/ readonly apiKeyValue: pulumi.Output<string | undefined>;
-    declare public readonly name: pulumi.Output<string>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public readonly name: pulumi.Output<string | undefined>;
     declare public readonly scopes: pulumi.Output<string[] | undefined>;
 
     /**
@@ -50,13 +51,11 @@ export class ApiKey extends pulumi.CustomResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: ApiKeyArgs, opts?: pulumi.CustomResourceOptions) {
+    constructor(name: string, args?: ApiKeyArgs, opts?: pulumi.CustomResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            if (args?.name === undefined && !opts.urn) {
-                throw new Error("Missing required property 'name'");
-            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["name"] = args?.name;
             resourceInputs["scopes"] = args?.scopes;
             resourceInputs["apiKeyId"] = undefined /*out*/;
@@ -64,6 +63,7 @@ export class ApiKey extends pulumi.CustomResource {
         } else {
             resourceInputs["apiKeyId"] = undefined /*out*/;
             resourceInputs["apiKeyValue"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["scopes"] = undefined /*out*/;
         }
@@ -78,6 +78,7 @@ export class ApiKey extends pulumi.CustomResource {
  * The set of arguments for constructing a ApiKey resource.
  */
 export interface ApiKeyArgs {
-    name: pulumi.Input<string>;
+    deletionProtection?: pulumi.Input<boolean>;
+    name?: pulumi.Input<string>;
     scopes?: pulumi.Input<pulumi.Input<string>[]>;
 }
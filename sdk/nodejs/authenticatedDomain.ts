@@ -0,0 +1,88 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Authenticates a SendGrid sending domain and brands its links.
+ *
+ * The component creates a DomainAuthentication and a LinkBranding for the same domain, as most sending domains need both, and exposes their DNS records as a single list. valid is true once both have been validated; set waitForValidation to wait for it during the deployment.
+ */
+export class AuthenticatedDomain extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:AuthenticatedDomain';
+
+    /**
+     * Returns true if the given object is an instance of AuthenticatedDomain.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AuthenticatedDomain {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AuthenticatedDomain.__pulumiType;
+    }
+
+    declare public /*out*/ readonly dnsRecords: pulumi.Output<outputs.DomainDNSRecord[]>;
+    declare public /*out*/ readonly domainId: pulumi.Output<number>;
+    declare public /*out*/ readonly linkId: pulumi.Output<number>;
+    declare public /*out*/ readonly providerRecords: pulumi.Output<outputs.DNSProviderRecord[]>;
+    declare public /*out*/ readonly valid: pulumi.Output<boolean>;
+
+    /**
+     * Create a AuthenticatedDomain resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AuthenticatedDomainArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.domain === undefined && !opts.urn) {
+                throw new Error("Missing required property 'domain'");
+            }
+            resourceInputs["automaticSecurity"] = args?.automaticSecurity;
+            resourceInputs["default"] = args?.default;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["domain"] = args?.domain;
+            resourceInputs["linkSubdomain"] = args?.linkSubdomain;
+            resourceInputs["region"] = args?.region;
+            resourceInputs["subdomain"] = args?.subdomain;
+            resourceInputs["waitForValidation"] = args?.waitForValidation;
+            resourceInputs["waitTimeoutSeconds"] = args?.waitTimeoutSeconds;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
+            resourceInputs["domainId"] = undefined /*out*/;
+            resourceInputs["linkId"] = undefined /*out*/;
+            resourceInputs["providerRecords"] = undefined /*out*/;
+            resourceInputs["valid"] = undefined /*out*/;
+        } else {
+            resourceInputs["dnsRecords"] = undefined /*out*/;
+            resourceInputs["domainId"] = undefined /*out*/;
+            resourceInputs["linkId"] = undefined /*out*/;
+            resourceInputs["providerRecords"] = undefined /*out*/;
+            resourceInputs["valid"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AuthenticatedDomain.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AuthenticatedDomain resource.
+ */
+export interface AuthenticatedDomainArgs {
+    automaticSecurity?: pulumi.Input<boolean>;
+    default?: pulumi.Input<boolean>;
+    deletionProtection?: pulumi.Input<boolean>;
+    domain: pulumi.Input<string>;
+    linkSubdomain?: pulumi.Input<string>;
+    region?: pulumi.Input<string>;
+    subdomain?: pulumi.Input<string>;
+    waitForValidation?: pulumi.Input<boolean>;
+    waitTimeoutSeconds?: pulumi.Input<number>;
+}
@@ -8,7 +8,7 @@ declare var exports: any;
 const __config = new pulumi.Config("sendgrid");
 
 /**
- * The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+ * The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
  */
 export declare const apiKey: string | undefined;
 Object.defineProperty(exports, "apiKey", {
@@ -19,12 +19,276 @@ Object.defineProperty(exports, "apiKey", {
 });
 
 /**
- * The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+ * The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
  */
-export declare const baseUrl: string;
+export declare const apiKeyFile: string | undefined;
+Object.defineProperty(exports, "apiKeyFile", {
+    get() {
+        return __config.get("apiKeyFile");
+    },
+    enumerable: true,
+});
+
+/**
+ * The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
+ */
+export declare const baseUrl: string | undefined;
 Object.defineProperty(exports, "baseUrl", {
     get() {
-        return __config.get("baseUrl") ?? "https://api.sendgrid.com";
+        return __config.get("baseUrl");
+    },
+    enumerable: true,
+});
+
+/**
+ * How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+ */
+export declare const circuitBreakerCooldownSeconds: number;
+Object.defineProperty(exports, "circuitBreakerCooldownSeconds", {
+    get() {
+        return __config.getObject<number>("circuitBreakerCooldownSeconds") ?? 30;
+    },
+    enumerable: true,
+});
+
+/**
+ * The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+ */
+export declare const circuitBreakerThreshold: number;
+Object.defineProperty(exports, "circuitBreakerThreshold", {
+    get() {
+        return __config.getObject<number>("circuitBreakerThreshold") ?? 5;
+    },
+    enumerable: true,
+});
+
+/**
+ * Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+ */
+export declare const enableLegacyContactDb: boolean | undefined;
+Object.defineProperty(exports, "enableLegacyContactDb", {
+    get() {
+        return __config.getObject<boolean>("enableLegacyContactDb");
+    },
+    enumerable: true,
+});
+
+/**
+ * Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+ */
+export declare const headers: {[key: string]: string} | undefined;
+Object.defineProperty(exports, "headers", {
+    get() {
+        return __config.getObject<{[key: string]: string}>("headers");
+    },
+    enumerable: true,
+});
+
+/**
+ * The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+ */
+export declare const httpProxy: string | undefined;
+Object.defineProperty(exports, "httpProxy", {
+    get() {
+        return __config.get("httpProxy");
+    },
+    enumerable: true,
+});
+
+/**
+ * The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+ */
+export declare const httpsProxy: string | undefined;
+Object.defineProperty(exports, "httpsProxy", {
+    get() {
+        return __config.get("httpsProxy");
+    },
+    enumerable: true,
+});
+
+/**
+ * Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+ */
+export declare const logMetrics: boolean | undefined;
+Object.defineProperty(exports, "logMetrics", {
+    get() {
+        return __config.getObject<boolean>("logMetrics");
+    },
+    enumerable: true,
+});
+
+/**
+ * The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+ */
+export declare const maxConcurrentRequests: number;
+Object.defineProperty(exports, "maxConcurrentRequests", {
+    get() {
+        return __config.getObject<number>("maxConcurrentRequests") ?? 10;
+    },
+    enumerable: true,
+});
+
+/**
+ * The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+ */
+export declare const maxRetries: number;
+Object.defineProperty(exports, "maxRetries", {
+    get() {
+        return __config.getObject<number>("maxRetries") ?? 3;
+    },
+    enumerable: true,
+});
+
+/**
+ * A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+ */
+export declare const noProxy: string | undefined;
+Object.defineProperty(exports, "noProxy", {
+    get() {
+        return __config.get("noProxy");
+    },
+    enumerable: true,
+});
+
+/**
+ * The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+ */
+export declare const onBehalfOf: string | undefined;
+Object.defineProperty(exports, "onBehalfOf", {
+    get() {
+        return __config.get("onBehalfOf");
+    },
+    enumerable: true,
+});
+
+/**
+ * The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+ */
+export declare const rateLimitBurst: number | undefined;
+Object.defineProperty(exports, "rateLimitBurst", {
+    get() {
+        return __config.getObject<number>("rateLimitBurst");
+    },
+    enumerable: true,
+});
+
+/**
+ * The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+ */
+export declare const rateLimitPerSecond: number | undefined;
+Object.defineProperty(exports, "rateLimitPerSecond", {
+    get() {
+        return __config.getObject<number>("rateLimitPerSecond");
+    },
+    enumerable: true,
+});
+
+/**
+ * The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+ */
+export declare const readTimeoutSeconds: number | undefined;
+Object.defineProperty(exports, "readTimeoutSeconds", {
+    get() {
+        return __config.getObject<number>("readTimeoutSeconds");
+    },
+    enumerable: true,
+});
+
+/**
+ * The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+ */
+export declare const region: string | undefined;
+Object.defineProperty(exports, "region", {
+    get() {
+        return __config.get("region");
+    },
+    enumerable: true,
+});
+
+/**
+ * The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+ */
+export declare const requestTimeoutSeconds: number;
+Object.defineProperty(exports, "requestTimeoutSeconds", {
+    get() {
+        return __config.getObject<number>("requestTimeoutSeconds") ?? 30;
+    },
+    enumerable: true,
+});
+
+/**
+ * API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+ */
+export declare const requiredScopes: string[] | undefined;
+Object.defineProperty(exports, "requiredScopes", {
+    get() {
+        return __config.getObject<string[]>("requiredScopes");
+    },
+    enumerable: true,
+});
+
+/**
+ * The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+ */
+export declare const retryBudget: number;
+Object.defineProperty(exports, "retryBudget", {
+    get() {
+        return __config.getObject<number>("retryBudget") ?? 20;
+    },
+    enumerable: true,
+});
+
+/**
+ * The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+ */
+export declare const statsdAddress: string | undefined;
+Object.defineProperty(exports, "statsdAddress", {
+    get() {
+        return __config.get("statsdAddress");
+    },
+    enumerable: true,
+});
+
+/**
+ * A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+ */
+export declare const userAgentSuffix: string | undefined;
+Object.defineProperty(exports, "userAgentSuffix", {
+    get() {
+        return __config.get("userAgentSuffix");
+    },
+    enumerable: true,
+});
+
+/**
+ * Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+ */
+export declare const validateApiKey: boolean;
+Object.defineProperty(exports, "validateApiKey", {
+    get() {
+        return __config.getObject<boolean>("validateApiKey") ?? true;
+    },
+    enumerable: true,
+});
+
+/**
+ * Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+ */
+export declare const validateOnPreview: boolean | undefined;
+Object.defineProperty(exports, "validateOnPreview", {
+    get() {
+        return __config.getObject<boolean>("validateOnPreview");
+    },
+    enumerable: true,
+});
+
+/**
+ * The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
+ */
+export declare const writeTimeoutSeconds: number | undefined;
+Object.defineProperty(exports, "writeTimeoutSeconds", {
+    get() {
+        return __config.getObject<number>("writeTimeoutSeconds");
     },
     enumerable: true,
 });
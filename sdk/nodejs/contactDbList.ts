@@ -0,0 +1,79 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Manages a list in the SendGrid Legacy Marketing Campaigns contact database.
+ *
+ * Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. Deleting the list leaves its recipients in the contact database.
+ */
+export class ContactDbList extends pulumi.CustomResource {
+    /**
+     * Get an existing ContactDbList resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ContactDbList {
+        return new ContactDbList(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:ContactDbList';
+
+    /**
+     * Returns true if the given object is an instance of ContactDbList.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ContactDbList {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ContactDbList.__pulumiType;
+    }
+
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public /*out*/ readonly listId: pulumi.Output<number>;
+    declare public readonly name: pulumi.Output<string>;
+    declare public /*out*/ readonly recipientCount: pulumi.Output<number>;
+
+    /**
+     * Create a ContactDbList resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ContactDbListArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.name === undefined && !opts.urn) {
+                throw new Error("Missing required property 'name'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["name"] = args?.name;
+            resourceInputs["listId"] = undefined /*out*/;
+            resourceInputs["recipientCount"] = undefined /*out*/;
+        } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["listId"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["recipientCount"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(ContactDbList.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ContactDbList resource.
+ */
+export interface ContactDbListArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    name: pulumi.Input<string>;
+}
@@ -0,0 +1,90 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Manages a recipient in the SendGrid Legacy Marketing Campaigns contact database.
+ *
+ * Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. The recipient is added to the lists in `listIds`, e.g. the `listId` of a `ContactDbList`, and removed from lists dropped from it.
+ */
+export class ContactDbRecipient extends pulumi.CustomResource {
+    /**
+     * Get an existing ContactDbRecipient resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ContactDbRecipient {
+        return new ContactDbRecipient(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:ContactDbRecipient';
+
+    /**
+     * Returns true if the given object is an instance of ContactDbRecipient.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ContactDbRecipient {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ContactDbRecipient.__pulumiType;
+    }
+
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public readonly email: pulumi.Output<string>;
+    declare public readonly firstName: pulumi.Output<string | undefined>;
+    declare public readonly lastName: pulumi.Output<string | undefined>;
+    declare public readonly listIds: pulumi.Output<number[] | undefined>;
+    declare public /*out*/ readonly recipientId: pulumi.Output<string>;
+
+    /**
+     * Create a ContactDbRecipient resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ContactDbRecipientArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.email === undefined && !opts.urn) {
+                throw new Error("Missing required property 'email'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["email"] = args?.email;
+            resourceInputs["firstName"] = args?.firstName;
+            resourceInputs["lastName"] = args?.lastName;
+            resourceInputs["listIds"] = args?.listIds;
+            resourceInputs["recipientId"] = undefined /*out*/;
+        } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["email"] = undefined /*out*/;
+            resourceInputs["firstName"] = undefined /*out*/;
+            resourceInputs["lastName"] = undefined /*out*/;
+            resourceInputs["listIds"] = undefined /*out*/;
+            resourceInputs["recipientId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["email"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(ContactDbRecipient.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ContactDbRecipient resource.
+ */
+export interface ContactDbRecipientArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    email: pulumi.Input<string>;
+    firstName?: pulumi.Input<string>;
+    lastName?: pulumi.Input<string>;
+    listIds?: pulumi.Input<pulumi.Input<number>[]>;
+}
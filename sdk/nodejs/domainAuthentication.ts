@@ -40,22 +40,30 @@ export class DomainAuthentication extends pulumi.CustomResource {
         return obj['__pulumiType'] === DomainAuthentication.__pulumiType;
     }
 
+    declare public readonly adoptExisting: pulumi.Output<boolean | undefined>;
     declare public readonly automaticSecurity: pulumi.Output<boolean | undefined>;
     declare public readonly customDkimSelector: pulumi.Output<string | undefined>;
     declare public readonly customSpf: pulumi.Output<boolean | undefined>;
     declare public readonly default: pulumi.Output<boolean | undefined>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public /*out*/ readonly dkim1: pulumi.Output<outputs.DNSRecord | undefined>;
     declare public /*out*/ readonly dkim2: pulumi.Output<outputs.DNSRecord | undefined>;
+    declare public /*out*/ readonly dnsRecords: pulumi.Output<outputs.DomainDNSRecord[]>;
     declare public readonly domain: pulumi.Output<string>;
     declare public /*out*/ readonly domainId: pulumi.Output<number>;
     declare public readonly ips: pulumi.Output<string[] | undefined>;
     declare public /*out*/ readonly legacy: pulumi.Output<boolean>;
     declare public /*out*/ readonly mailCname: pulumi.Output<outputs.DNSRecord | undefined>;
+    declare public /*out*/ readonly providerRecords: pulumi.Output<outputs.DNSProviderRecord[] | undefined>;
     declare public readonly region: pulumi.Output<string | undefined>;
+    declare public readonly revalidateOnRefresh: pulumi.Output<boolean | undefined>;
     declare public readonly subdomain: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly userId: pulumi.Output<number>;
     declare public /*out*/ readonly username: pulumi.Output<string>;
     declare public /*out*/ readonly valid: pulumi.Output<boolean>;
+    declare public readonly waitForValidation: pulumi.Output<boolean | undefined>;
+    declare public readonly waitIntervalSeconds: pulumi.Output<number | undefined>;
+    declare public readonly waitTimeoutSeconds: pulumi.Output<number | undefined>;
 
     /**
      * Create a DomainAuthentication resource with the given unique name, arguments, and options.
@@ -71,41 +79,59 @@ export class DomainAuthentication extends pulumi.CustomResource {
             if (args?.domain === undefined && !opts.urn) {
                 throw new Error("Missing required property 'domain'");
             }
+            resourceInputs["adoptExisting"] = args?.adoptExisting;
             resourceInputs["automaticSecurity"] = args?.automaticSecurity;
             resourceInputs["customDkimSelector"] = args?.customDkimSelector;
             resourceInputs["customSpf"] = args?.customSpf;
             resourceInputs["default"] = args?.default;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["domain"] = args?.domain;
             resourceInputs["ips"] = args?.ips;
             resourceInputs["region"] = args?.region;
+            resourceInputs["revalidateOnRefresh"] = args?.revalidateOnRefresh;
             resourceInputs["subdomain"] = args?.subdomain;
+            resourceInputs["waitForValidation"] = args?.waitForValidation;
+            resourceInputs["waitIntervalSeconds"] = args?.waitIntervalSeconds;
+            resourceInputs["waitTimeoutSeconds"] = args?.waitTimeoutSeconds;
             resourceInputs["dkim1"] = undefined /*out*/;
             resourceInputs["dkim2"] = undefined /*out*/;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
             resourceInputs["domainId"] = undefined /*out*/;
             resourceInputs["legacy"] = undefined /*out*/;
             resourceInputs["mailCname"] = undefined /*out*/;
+            resourceInputs["providerRecords"] = undefined /*out*/;
             resourceInputs["userId"] = undefined /*out*/;
             resourceInputs["username"] = undefined /*out*/;
             resourceInputs["valid"] = undefined /*out*/;
         } else {
+            resourceInputs["adoptExisting"] = undefined /*out*/;
             resourceInputs["automaticSecurity"] = undefined /*out*/;
             resourceInputs["customDkimSelector"] = undefined /*out*/;
             resourceInputs["customSpf"] = undefined /*out*/;
             resourceInputs["default"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["dkim1"] = undefined /*out*/;
             resourceInputs["dkim2"] = undefined /*out*/;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
             resourceInputs["domain"] = undefined /*out*/;
             resourceInputs["domainId"] = undefined /*out*/;
             resourceInputs["ips"] = undefined /*out*/;
             resourceInputs["legacy"] = undefined /*out*/;
             resourceInputs["mailCname"] = undefined /*out*/;
+            resourceInputs["providerRecords"] = undefined /*out*/;
             resourceInputs["region"] = undefined /*out*/;
+            resourceInputs["revalidateOnRefresh"] = undefined /*out*/;
             resourceInputs["subdomain"] = undefined /*out*/;
             resourceInputs["userId"] = undefined /*out*/;
             resourceInputs["username"] = undefined /*out*/;
             resourceInputs["valid"] = undefined /*out*/;
+            resourceInputs["waitForValidation"] = undefined /*out*/;
+            resourceInputs["waitIntervalSeconds"] = undefined /*out*/;
+            resourceInputs["waitTimeoutSeconds"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["domain", "subdomain"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(DomainAuthentication.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -114,12 +140,18 @@ export class DomainAuthentication extends pulumi.CustomResource {
  * The set of arguments for constructing a DomainAuthentication resource.
  */
 export interface DomainAuthenticationArgs {
+    adoptExisting?: pulumi.Input<boolean>;
     automaticSecurity?: pulumi.Input<boolean>;
     customDkimSelector?: pulumi.Input<string>;
     customSpf?: pulumi.Input<boolean>;
     default?: pulumi.Input<boolean>;
+    deletionProtection?: pulumi.Input<boolean>;
     domain: pulumi.Input<string>;
     ips?: pulumi.Input<pulumi.Input<string>[]>;
     region?: pulumi.Input<string>;
+    revalidateOnRefresh?: pulumi.Input<boolean>;
     subdomain?: pulumi.Input<string>;
+    waitForValidation?: pulumi.Input<boolean>;
+    waitIntervalSeconds?: pulumi.Input<number>;
+    waitTimeoutSeconds?: pulumi.Input<number>;
 }
@@ -9,7 +9,7 @@ import * as utilities from "./utilities";
  *
  * Event Webhooks allow you to receive HTTP POST notifications when email events occur, such as delivery, opens, clicks, bounces, and more. Configure the URL endpoint and select which events to track.
  *
- * Note: Only one webhook can be configured per URL. Signature verification must be configured separately after webhook creation.
+ * Note: Only one webhook can be configured per URL. Signature verification is enabled with the EventWebhookSignature resource.
  */
 export class EventWebhook extends pulumi.CustomResource {
     /**
@@ -38,20 +38,26 @@ export class EventWebhook extends pulumi.CustomResource {
         return obj['__pulumiType'] === EventWebhook.__pulumiType;
     }
 
+    declare public readonly adoptExisting: pulumi.Output<boolean | undefined>;
     declare public readonly bounce: pulumi.Output<boolean | undefined>;
     declare public readonly click: pulumi.Output<boolean | undefined>;
     declare public readonly deferred: pulumi.Output<boolean | undefined>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly delivered: pulumi.Output<boolean | undefined>;
     declare public readonly dropped: pulumi.Output<boolean | undefined>;
     declare public readonly enabled: pulumi.Output<boolean | undefined>;
     declare public readonly friendlyName: pulumi.Output<string | undefined>;
     declare public readonly groupResubscribe: pulumi.Output<boolean | undefined>;
     declare public readonly groupUnsubscribe: pulumi.Output<boolean | undefined>;
+    declare public readonly oauthClientId: pulumi.Output<string | undefined>;
+    declare public readonly oauthClientSecret: pulumi.Output<string | undefined>;
+    declare public readonly oauthTokenUrl: pulumi.Output<string | undefined>;
     declare public readonly open: pulumi.Output<boolean | undefined>;
     declare public readonly processed: pulumi.Output<boolean | undefined>;
     declare public readonly spamReport: pulumi.Output<boolean | undefined>;
     declare public readonly unsubscribe: pulumi.Output<boolean | undefined>;
     declare public readonly url: pulumi.Output<string>;
+    declare public readonly verifyUrlReachable: pulumi.Output<boolean | undefined>;
     declare public /*out*/ readonly webhookId: pulumi.Output<string>;
 
     /**
@@ -68,39 +74,53 @@ export class EventWebhook extends pulumi.CustomResource {
             if (args?.url === undefined && !opts.urn) {
                 throw new Error("Missing required property 'url'");
             }
+            resourceInputs["adoptExisting"] = args?.adoptExisting;
             resourceInputs["bounce"] = args?.bounce;
             resourceInputs["click"] = args?.click;
             resourceInputs["deferred"] = args?.deferred;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["delivered"] = args?.delivered;
             resourceInputs["dropped"] = args?.dropped;
             resourceInputs["enabled"] = args?.enabled;
             resourceInputs["friendlyName"] = args?.friendlyName;
             resourceInputs["groupResubscribe"] = args?.groupResubscribe;
             resourceInputs["groupUnsubscribe"] = args?.groupUnsubscribe;
+            resourceInputs["oauthClientId"] = args?.oauthClientId;
+            resourceInputs["oauthClientSecret"] = args?.oauthClientSecret ? pulumi.secret(args.oauthClientSecret) : undefined;
+            resourceInputs["oauthTokenUrl"] = args?.oauthTokenUrl;
             resourceInputs["open"] = args?.open;
             resourceInputs["processed"] = args?.processed;
             resourceInputs["spamReport"] = args?.spamReport;
             resourceInputs["unsubscribe"] = args?.unsubscribe;
             resourceInputs["url"] = args?.url;
+            resourceInputs["verifyUrlReachable"] = args?.verifyUrlReachable;
             resourceInputs["webhookId"] = undefined /*out*/;
         } else {
+            resourceInputs["adoptExisting"] = undefined /*out*/;
             resourceInputs["bounce"] = undefined /*out*/;
             resourceInputs["click"] = undefined /*out*/;
             resourceInputs["deferred"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["delivered"] = undefined /*out*/;
             resourceInputs["dropped"] = undefined /*out*/;
             resourceInputs["enabled"] = undefined /*out*/;
             resourceInputs["friendlyName"] = undefined /*out*/;
             resourceInputs["groupResubscribe"] = undefined /*out*/;
             resourceInputs["groupUnsubscribe"] = undefined /*out*/;
+            resourceInputs["oauthClientId"] = undefined /*out*/;
+            resourceInputs["oauthClientSecret"] = undefined /*out*/;
+            resourceInputs["oauthTokenUrl"] = undefined /*out*/;
             resourceInputs["open"] = undefined /*out*/;
             resourceInputs["processed"] = undefined /*out*/;
             resourceInputs["spamReport"] = undefined /*out*/;
             resourceInputs["unsubscribe"] = undefined /*out*/;
             resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["verifyUrlReachable"] = undefined /*out*/;
             resourceInputs["webhookId"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["oauthClientSecret"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(EventWebhook.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -109,18 +129,24 @@ export class EventWebhook extends pulumi.CustomResource {
  * The set of arguments for constructing a EventWebhook resource.
  */
 export interface EventWebhookArgs {
+    adoptExisting?: pulumi.Input<boolean>;
     bounce?: pulumi.Input<boolean>;
     click?: pulumi.Input<boolean>;
     deferred?: pulumi.Input<boolean>;
+    deletionProtection?: pulumi.Input<boolean>;
     delivered?: pulumi.Input<boolean>;
     dropped?: pulumi.Input<boolean>;
     enabled?: pulumi.Input<boolean>;
     friendlyName?: pulumi.Input<string>;
     groupResubscribe?: pulumi.Input<boolean>;
     groupUnsubscribe?: pulumi.Input<boolean>;
+    oauthClientId?: pulumi.Input<string>;
+    oauthClientSecret?: pulumi.Input<string>;
+    oauthTokenUrl?: pulumi.Input<string>;
     open?: pulumi.Input<boolean>;
     processed?: pulumi.Input<boolean>;
     spamReport?: pulumi.Input<boolean>;
     unsubscribe?: pulumi.Input<boolean>;
     url: pulumi.Input<string>;
+    verifyUrlReachable?: pulumi.Input<boolean>;
 }
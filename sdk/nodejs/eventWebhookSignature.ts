@@ -0,0 +1,78 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Enables signature verification of a SendGrid Event Webhook.
+ *
+ * SendGrid then signs each request to the webhook, so the receiving service can verify events with the publicKey output. The resource ID is the webhook ID. Deleting the resource disables signing; enabling it again generates a new key pair.
+ */
+export class EventWebhookSignature extends pulumi.CustomResource {
+    /**
+     * Get an existing EventWebhookSignature resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): EventWebhookSignature {
+        return new EventWebhookSignature(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:EventWebhookSignature';
+
+    /**
+     * Returns true if the given object is an instance of EventWebhookSignature.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is EventWebhookSignature {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === EventWebhookSignature.__pulumiType;
+    }
+
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public /*out*/ readonly publicKey: pulumi.Output<string>;
+    declare public readonly webhookId: pulumi.Output<string>;
+
+    /**
+     * Create a EventWebhookSignature resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: EventWebhookSignatureArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.webhookId === undefined && !opts.urn) {
+                throw new Error("Missing required property 'webhookId'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["webhookId"] = args?.webhookId;
+            resourceInputs["publicKey"] = undefined /*out*/;
+        } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["publicKey"] = undefined /*out*/;
+            resourceInputs["webhookId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["webhookId"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(EventWebhookSignature.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a EventWebhookSignature resource.
+ */
+export interface EventWebhookSignatureArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    webhookId: pulumi.Input<string>;
+}
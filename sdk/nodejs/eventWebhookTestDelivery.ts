@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Sends test events to a SendGrid Event Webhook.
+ *
+ * SendGrid posts its sample events to the webhook when the resource is created and again whenever any input changes, including triggers. The deployment fails if SendGrid refuses to send them, e.g. for an unknown webhook, but SendGrid does not report how the receiving service responded, so check the service's logs to see whether it accepted them. Deleting the resource sends nothing.
+ */
+export class EventWebhookTestDelivery extends pulumi.CustomResource {
+    /**
+     * Get an existing EventWebhookTestDelivery resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): EventWebhookTestDelivery {
+        return new EventWebhookTestDelivery(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:EventWebhookTestDelivery';
+
+    /**
+     * Returns true if the given object is an instance of EventWebhookTestDelivery.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is EventWebhookTestDelivery {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === EventWebhookTestDelivery.__pulumiType;
+    }
+
+    declare public readonly oauthClientId: pulumi.Output<string | undefined>;
+    declare public readonly oauthClientSecret: pulumi.Output<string | undefined>;
+    declare public readonly oauthTokenUrl: pulumi.Output<string | undefined>;
+    declare public readonly triggers: pulumi.Output<{[key: string]: string} | undefined>;
+    declare public readonly url: pulumi.Output<string>;
+    declare public readonly webhookId: pulumi.Output<string>;
+
+    /**
+     * Create a EventWebhookTestDelivery resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: EventWebhookTestDeliveryArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.url === undefined && !opts.urn) {
+                throw new Error("Missing required property 'url'");
+            }
+            if (args?.webhookId === undefined && !opts.urn) {
+                throw new Error("Missing required property 'webhookId'");
+            }
+            resourceInputs["oauthClientId"] = args?.oauthClientId;
+            resourceInputs["oauthClientSecret"] = args?.oauthClientSecret ? pulumi.secret(args.oauthClientSecret) : undefined;
+            resourceInputs["oauthTokenUrl"] = args?.oauthTokenUrl;
+            resourceInputs["triggers"] = args?.triggers;
+            resourceInputs["url"] = args?.url;
+            resourceInputs["webhookId"] = args?.webhookId;
+        } else {
+            resourceInputs["oauthClientId"] = undefined /*out*/;
+            resourceInputs["oauthClientSecret"] = undefined /*out*/;
+            resourceInputs["oauthTokenUrl"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+            resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["webhookId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["oauthClientSecret"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        const replaceOnChanges = { replaceOnChanges: ["webhookId"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(EventWebhookTestDelivery.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a EventWebhookTestDelivery resource.
+ */
+export interface EventWebhookTestDeliveryArgs {
+    oauthClientId?: pulumi.Input<string>;
+    oauthClientSecret?: pulumi.Input<string>;
+    oauthTokenUrl?: pulumi.Input<string>;
+    triggers?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    url: pulumi.Input<string>;
+    webhookId: pulumi.Input<string>;
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Generates a new mail batch ID.
+ *
+ * Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend resource to pause or cancel them. Every call mints a new ID, including during previews, so store the ID, e.g. in stack configuration, when it must stay the same across deployments.
+ */
+export function generateBatchId(args?: GenerateBatchIdArgs, opts?: pulumi.InvokeOptions): Promise<GenerateBatchIdResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:generateBatchId", {
+    }, opts);
+}
+
+export interface GenerateBatchIdArgs {
+}
+
+export interface GenerateBatchIdResult {
+    readonly batchId: string;
+}
+/**
+ * Generates a new mail batch ID.
+ *
+ * Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend resource to pause or cancel them. Every call mints a new ID, including during previews, so store the ID, e.g. in stack configuration, when it must stay the same across deployments.
+ */
+export function generateBatchIdOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GenerateBatchIdResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:generateBatchId", {
+    }, opts);
+}
+
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Gets the plan type, sender reputation and profile of the SendGrid account.
+ *
+ * Useful for conditional logic, e.g. to skip resources such as subusers and IP pools that free plans do not support.
+ */
+export function getAccountProfile(args?: GetAccountProfileArgs, opts?: pulumi.InvokeOptions): Promise<GetAccountProfileResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getAccountProfile", {
+    }, opts);
+}
+
+export interface GetAccountProfileArgs {
+}
+
+export interface GetAccountProfileResult {
+    readonly address: string;
+    readonly address2: string;
+    readonly city: string;
+    readonly company: string;
+    readonly country: string;
+    readonly firstName: string;
+    readonly lastName: string;
+    readonly phone: string;
+    readonly reputation: number;
+    readonly state: string;
+    readonly type: string;
+    readonly website: string;
+    readonly zip: string;
+}
+/**
+ * Gets the plan type, sender reputation and profile of the SendGrid account.
+ *
+ * Useful for conditional logic, e.g. to skip resources such as subusers and IP pools that free plans do not support.
+ */
+export function getAccountProfileOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetAccountProfileResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getAccountProfile", {
+    }, opts);
+}
+
@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists all SendGrid Alerts configured on the account.
+ *
+ * Returns the type, recipient, percentage and frequency of every alert. Use the `alertId` of an entry to import an existing alert with `pulumi import`.
+ */
+export function getAlerts(args?: GetAlertsArgs, opts?: pulumi.InvokeOptions): Promise<GetAlertsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getAlerts", {
+    }, opts);
+}
+
+export interface GetAlertsArgs {
+}
+
+export interface GetAlertsResult {
+    readonly alerts: outputs.AlertSummary[];
+}
+/**
+ * Lists all SendGrid Alerts configured on the account.
+ *
+ * Returns the type, recipient, percentage and frequency of every alert. Use the `alertId` of an entry to import an existing alert with `pulumi import`.
+ */
+export function getAlertsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetAlertsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getAlerts", {
+    }, opts);
+}
+
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the email addresses on the SendGrid blocks list.
+ *
+ * Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. Optionally filter by the Unix time range in which the block occurred.
+ */
+export function getBlocks(args?: GetBlocksArgs, opts?: pulumi.InvokeOptions): Promise<GetBlocksResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getBlocks", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetBlocksArgs {
+    endTime?: number;
+    startTime?: number;
+}
+
+export interface GetBlocksResult {
+    readonly blocks: outputs.BlockEntry[];
+}
+/**
+ * Lists the email addresses on the SendGrid blocks list.
+ *
+ * Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. Optionally filter by the Unix time range in which the block occurred.
+ */
+export function getBlocksOutput(args?: GetBlocksOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetBlocksResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getBlocks", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetBlocksOutputArgs {
+    endTime?: pulumi.Input<number>;
+    startTime?: pulumi.Input<number>;
+}
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the email addresses on the SendGrid bounce list.
+ *
+ * Optionally filter by the Unix time range in which the bounce occurred. Useful for cleanup jobs and audits without a second client library.
+ */
+export function getBounces(args?: GetBouncesArgs, opts?: pulumi.InvokeOptions): Promise<GetBouncesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getBounces", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetBouncesArgs {
+    endTime?: number;
+    startTime?: number;
+}
+
+export interface GetBouncesResult {
+    readonly bounces: outputs.BounceEntry[];
+}
+/**
+ * Lists the email addresses on the SendGrid bounce list.
+ *
+ * Optionally filter by the Unix time range in which the bounce occurred. Useful for cleanup jobs and audits without a second client library.
+ */
+export function getBouncesOutput(args?: GetBouncesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetBouncesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getBounces", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetBouncesOutputArgs {
+    endTime?: pulumi.Input<number>;
+    startTime?: pulumi.Input<number>;
+}
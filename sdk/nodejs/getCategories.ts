@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the categories used on the SendGrid account.
+ *
+ * Optionally filter by name prefix. Useful for validating category names used in templates and campaigns at plan time.
+ */
+export function getCategories(args?: GetCategoriesArgs, opts?: pulumi.InvokeOptions): Promise<GetCategoriesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getCategories", {
+        "search": args.search,
+    }, opts);
+}
+
+export interface GetCategoriesArgs {
+    search?: string;
+}
+
+export interface GetCategoriesResult {
+    readonly categories: string[];
+}
+/**
+ * Lists the categories used on the SendGrid account.
+ *
+ * Optionally filter by name prefix. Useful for validating category names used in templates and campaigns at plan time.
+ */
+export function getCategoriesOutput(args?: GetCategoriesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetCategoriesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getCategories", {
+        "search": args.search,
+    }, opts);
+}
+
+export interface GetCategoriesOutputArgs {
+    search?: pulumi.Input<string>;
+}
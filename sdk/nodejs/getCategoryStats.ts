@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Retrieves email statistics for specific SendGrid categories.
+ *
+ * Returns metrics for each requested category (up to 10) and each day, week or month in the date range, for per-product email reporting.
+ */
+export function getCategoryStats(args: GetCategoryStatsArgs, opts?: pulumi.InvokeOptions): Promise<GetCategoryStatsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getCategoryStats", {
+        "aggregatedBy": args.aggregatedBy,
+        "categories": args.categories,
+        "endDate": args.endDate,
+        "startDate": args.startDate,
+    }, opts);
+}
+
+export interface GetCategoryStatsArgs {
+    aggregatedBy?: string;
+    categories: string[];
+    endDate?: string;
+    startDate: string;
+}
+
+export interface GetCategoryStatsResult {
+    readonly stats: outputs.StatsEntry[];
+}
+/**
+ * Retrieves email statistics for specific SendGrid categories.
+ *
+ * Returns metrics for each requested category (up to 10) and each day, week or month in the date range, for per-product email reporting.
+ */
+export function getCategoryStatsOutput(args: GetCategoryStatsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetCategoryStatsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getCategoryStats", {
+        "aggregatedBy": args.aggregatedBy,
+        "categories": args.categories,
+        "endDate": args.endDate,
+        "startDate": args.startDate,
+    }, opts);
+}
+
+export interface GetCategoryStatsOutputArgs {
+    aggregatedBy?: pulumi.Input<string>;
+    categories: pulumi.Input<pulumi.Input<string>[]>;
+    endDate?: pulumi.Input<string>;
+    startDate: pulumi.Input<string>;
+}
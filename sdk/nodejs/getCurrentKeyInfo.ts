@@ -0,0 +1,44 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Reports the scopes of the API key the provider is configured with.
+ *
+ * The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` to fail with a clear error when the key cannot manage the resources a program declares, e.g. ["templates.create", "templates.update"].
+ */
+export function getCurrentKeyInfo(args?: GetCurrentKeyInfoArgs, opts?: pulumi.InvokeOptions): Promise<GetCurrentKeyInfoResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getCurrentKeyInfo", {
+        "requiredScopes": args.requiredScopes,
+    }, opts);
+}
+
+export interface GetCurrentKeyInfoArgs {
+    requiredScopes?: string[];
+}
+
+export interface GetCurrentKeyInfoResult {
+    readonly apiKeyId?: string;
+    readonly name?: string;
+    readonly scopes: string[];
+}
+/**
+ * Reports the scopes of the API key the provider is configured with.
+ *
+ * The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` to fail with a clear error when the key cannot manage the resources a program declares, e.g. ["templates.create", "templates.update"].
+ */
+export function getCurrentKeyInfoOutput(args?: GetCurrentKeyInfoOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetCurrentKeyInfoResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getCurrentKeyInfo", {
+        "requiredScopes": args.requiredScopes,
+    }, opts);
+}
+
+export interface GetCurrentKeyInfoOutputArgs {
+    requiredScopes?: pulumi.Input<pulumi.Input<string>[]>;
+}
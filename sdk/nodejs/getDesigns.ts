@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the designs in the SendGrid Design Library.
+ *
+ * Returns the ID, name and thumbnail of every design, so that SingleSend resources can reference design-library content by `designId`.
+ */
+export function getDesigns(args?: GetDesignsArgs, opts?: pulumi.InvokeOptions): Promise<GetDesignsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getDesigns", {
+    }, opts);
+}
+
+export interface GetDesignsArgs {
+}
+
+export interface GetDesignsResult {
+    readonly designs: outputs.DesignSummary[];
+}
+/**
+ * Lists the designs in the SendGrid Design Library.
+ *
+ * Returns the ID, name and thumbnail of every design, so that SingleSend resources can reference design-library content by `designId`.
+ */
+export function getDesignsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetDesignsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getDesigns", {
+    }, opts);
+}
+
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists all SendGrid Event Webhooks configured on the account.
+ *
+ * Returns every webhook URL together with the event types it is subscribed to, which is useful for auditing which endpoints receive account data.
+ */
+export function getEventWebhooks(args?: GetEventWebhooksArgs, opts?: pulumi.InvokeOptions): Promise<GetEventWebhooksResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getEventWebhooks", {
+    }, opts);
+}
+
+export interface GetEventWebhooksArgs {
+}
+
+export interface GetEventWebhooksResult {
+    readonly maxAllowed: number;
+    readonly webhooks: outputs.EventWebhookSummary[];
+}
+/**
+ * Lists all SendGrid Event Webhooks configured on the account.
+ *
+ * Returns every webhook URL together with the event types it is subscribed to, which is useful for auditing which endpoints receive account data.
+ */
+export function getEventWebhooksOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetEventWebhooksResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getEventWebhooks", {
+    }, opts);
+}
+
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the email addresses on the SendGrid global unsubscribe list.
+ *
+ * Includes addresses added through the GlobalSuppression resource as well as recipients who unsubscribed from all email. Optionally filter by Unix time range.
+ */
+export function getGlobalSuppressions(args?: GetGlobalSuppressionsArgs, opts?: pulumi.InvokeOptions): Promise<GetGlobalSuppressionsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getGlobalSuppressions", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetGlobalSuppressionsArgs {
+    endTime?: number;
+    startTime?: number;
+}
+
+export interface GetGlobalSuppressionsResult {
+    readonly suppressions: outputs.GlobalSuppressionEntry[];
+}
+/**
+ * Lists the email addresses on the SendGrid global unsubscribe list.
+ *
+ * Includes addresses added through the GlobalSuppression resource as well as recipients who unsubscribed from all email. Optionally filter by Unix time range.
+ */
+export function getGlobalSuppressionsOutput(args?: GetGlobalSuppressionsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetGlobalSuppressionsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getGlobalSuppressions", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetGlobalSuppressionsOutputArgs {
+    endTime?: pulumi.Input<number>;
+    startTime?: pulumi.Input<number>;
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the recipients suppressed for a SendGrid Unsubscribe Group.
+ *
+ * Returns every email address that has unsubscribed from the group. Large groups are retrieved page by page automatically.
+ */
+export function getGroupSuppressions(args: GetGroupSuppressionsArgs, opts?: pulumi.InvokeOptions): Promise<GetGroupSuppressionsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getGroupSuppressions", {
+        "groupId": args.groupId,
+    }, opts);
+}
+
+export interface GetGroupSuppressionsArgs {
+    groupId: number;
+}
+
+export interface GetGroupSuppressionsResult {
+    readonly emails: string[];
+}
+/**
+ * Lists the recipients suppressed for a SendGrid Unsubscribe Group.
+ *
+ * Returns every email address that has unsubscribed from the group. Large groups are retrieved page by page automatically.
+ */
+export function getGroupSuppressionsOutput(args: GetGroupSuppressionsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetGroupSuppressionsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getGroupSuppressions", {
+        "groupId": args.groupId,
+    }, opts);
+}
+
+export interface GetGroupSuppressionsOutputArgs {
+    groupId: pulumi.Input<number>;
+}
@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the Inbound Parse settings of the SendGrid account.
+ *
+ * Each setting maps a receiving hostname to the URL that parsed email is posted to. Useful for auditing which hostnames receive email, and for finding settings to adopt.
+ */
+export function getInboundParseSettings(args?: GetInboundParseSettingsArgs, opts?: pulumi.InvokeOptions): Promise<GetInboundParseSettingsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getInboundParseSettings", {
+    }, opts);
+}
+
+export interface GetInboundParseSettingsArgs {
+}
+
+export interface GetInboundParseSettingsResult {
+    readonly settings: outputs.InboundParseSetting[];
+}
+/**
+ * Lists the Inbound Parse settings of the SendGrid account.
+ *
+ * Each setting maps a receiving hostname to the URL that parsed email is posted to. Useful for auditing which hostnames receive email, and for finding settings to adopt.
+ */
+export function getInboundParseSettingsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetInboundParseSettingsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getInboundParseSettings", {
+    }, opts);
+}
+
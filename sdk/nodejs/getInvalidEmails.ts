@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the email addresses on the SendGrid invalid emails list.
+ *
+ * Invalid addresses are malformed or do not exist at the receiving server. Useful for address-hygiene reporting. Optionally filter by Unix time range.
+ */
+export function getInvalidEmails(args?: GetInvalidEmailsArgs, opts?: pulumi.InvokeOptions): Promise<GetInvalidEmailsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getInvalidEmails", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetInvalidEmailsArgs {
+    endTime?: number;
+    startTime?: number;
+}
+
+export interface GetInvalidEmailsResult {
+    readonly invalidEmails: outputs.InvalidEmailEntry[];
+}
+/**
+ * Lists the email addresses on the SendGrid invalid emails list.
+ *
+ * Invalid addresses are malformed or do not exist at the receiving server. Useful for address-hygiene reporting. Optionally filter by Unix time range.
+ */
+export function getInvalidEmailsOutput(args?: GetInvalidEmailsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetInvalidEmailsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getInvalidEmails", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetInvalidEmailsOutputArgs {
+    endTime?: pulumi.Input<number>;
+    startTime?: pulumi.Input<number>;
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the mail settings of the SendGrid account and whether each is enabled.
+ *
+ * Returns the settings both as a list and as a map from setting name to enabled, e.g. `{"bcc": false, "footer": true}`, so compliance audits can diff the account against the desired state.
+ */
+export function getMailSettings(args?: GetMailSettingsArgs, opts?: pulumi.InvokeOptions): Promise<GetMailSettingsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getMailSettings", {
+    }, opts);
+}
+
+export interface GetMailSettingsArgs {
+}
+
+export interface GetMailSettingsResult {
+    readonly enabled: {[key: string]: boolean};
+    readonly settings: outputs.MailSetting[];
+}
+/**
+ * Lists the mail settings of the SendGrid account and whether each is enabled.
+ *
+ * Returns the settings both as a list and as a map from setting name to enabled, e.g. `{"bcc": false, "footer": true}`, so compliance audits can diff the account against the desired state.
+ */
+export function getMailSettingsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetMailSettingsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getMailSettings", {
+    }, opts);
+}
+
@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the SendGrid Marketing Campaigns contact lists.
+ *
+ * Returns the ID, name and contact count of every list, so that segments and single sends can reference lists managed by the marketing team.
+ */
+export function getMarketingLists(args?: GetMarketingListsArgs, opts?: pulumi.InvokeOptions): Promise<GetMarketingListsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getMarketingLists", {
+    }, opts);
+}
+
+export interface GetMarketingListsArgs {
+}
+
+export interface GetMarketingListsResult {
+    readonly lists: outputs.MarketingListSummary[];
+}
+/**
+ * Lists the SendGrid Marketing Campaigns contact lists.
+ *
+ * Returns the ID, name and contact count of every list, so that segments and single sends can reference lists managed by the marketing team.
+ */
+export function getMarketingListsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetMarketingListsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getMarketingLists", {
+    }, opts);
+}
+
@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the SendGrid Marketing Campaigns segments.
+ *
+ * Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, so that single sends can reference existing segments.
+ */
+export function getMarketingSegments(args?: GetMarketingSegmentsArgs, opts?: pulumi.InvokeOptions): Promise<GetMarketingSegmentsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getMarketingSegments", {
+    }, opts);
+}
+
+export interface GetMarketingSegmentsArgs {
+}
+
+export interface GetMarketingSegmentsResult {
+    readonly segments: outputs.MarketingSegmentSummary[];
+}
+/**
+ * Lists the SendGrid Marketing Campaigns segments.
+ *
+ * Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, so that single sends can reference existing segments.
+ */
+export function getMarketingSegmentsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetMarketingSegmentsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getMarketingSegments", {
+    }, opts);
+}
+
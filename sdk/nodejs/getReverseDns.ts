@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Looks up the reverse DNS entry of a dedicated IP address.
+ *
+ * Returns the rDNS hostname, whether it has been validated, and the A record to publish, also in the shape DNS provider resources expect, so it can be wired into DNS like the records of DomainAuthentication and LinkBranding.
+ */
+export function getReverseDns(args: GetReverseDnsArgs, opts?: pulumi.InvokeOptions): Promise<GetReverseDnsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getReverseDns", {
+        "ip": args.ip,
+    }, opts);
+}
+
+export interface GetReverseDnsArgs {
+    ip: string;
+}
+
+export interface GetReverseDnsResult {
+    readonly dnsRecords: outputs.DomainDNSRecord[];
+    readonly domain: string;
+    readonly ip: string;
+    readonly legacy: boolean;
+    readonly providerRecords: outputs.DNSProviderRecord[];
+    readonly rdns: string;
+    readonly reverseDnsId: number;
+    readonly subdomain: string;
+    readonly valid: boolean;
+}
+/**
+ * Looks up the reverse DNS entry of a dedicated IP address.
+ *
+ * Returns the rDNS hostname, whether it has been validated, and the A record to publish, also in the shape DNS provider resources expect, so it can be wired into DNS like the records of DomainAuthentication and LinkBranding.
+ */
+export function getReverseDnsOutput(args: GetReverseDnsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetReverseDnsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getReverseDns", {
+        "ip": args.ip,
+    }, opts);
+}
+
+export interface GetReverseDnsOutputArgs {
+    ip: pulumi.Input<string>;
+}
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the email addresses on the SendGrid spam reports list.
+ *
+ * Returns each recipient that marked a message as spam, when they reported it and the sending IP. Optionally filter by the Unix time range in which the report was made.
+ */
+export function getSpamReports(args?: GetSpamReportsArgs, opts?: pulumi.InvokeOptions): Promise<GetSpamReportsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getSpamReports", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetSpamReportsArgs {
+    endTime?: number;
+    startTime?: number;
+}
+
+export interface GetSpamReportsResult {
+    readonly spamReports: outputs.SpamReportEntry[];
+}
+/**
+ * Lists the email addresses on the SendGrid spam reports list.
+ *
+ * Returns each recipient that marked a message as spam, when they reported it and the sending IP. Optionally filter by the Unix time range in which the report was made.
+ */
+export function getSpamReportsOutput(args?: GetSpamReportsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetSpamReportsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getSpamReports", {
+        "endTime": args.endTime,
+        "startTime": args.startTime,
+    }, opts);
+}
+
+export interface GetSpamReportsOutputArgs {
+    endTime?: pulumi.Input<number>;
+    startTime?: pulumi.Input<number>;
+}
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the certificates of the SendGrid account's SSO integrations.
+ *
+ * Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` to only list certificates that expire soon, e.g. to raise certificate rotation alerts.
+ */
+export function getSsoCertificates(args?: GetSsoCertificatesArgs, opts?: pulumi.InvokeOptions): Promise<GetSsoCertificatesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getSsoCertificates", {
+        "expiringWithinDays": args.expiringWithinDays,
+        "integrationId": args.integrationId,
+    }, opts);
+}
+
+export interface GetSsoCertificatesArgs {
+    expiringWithinDays?: number;
+    integrationId?: string;
+}
+
+export interface GetSsoCertificatesResult {
+    readonly certificates: outputs.SsoCertificate[];
+}
+/**
+ * Lists the certificates of the SendGrid account's SSO integrations.
+ *
+ * Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` to only list certificates that expire soon, e.g. to raise certificate rotation alerts.
+ */
+export function getSsoCertificatesOutput(args?: GetSsoCertificatesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetSsoCertificatesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getSsoCertificates", {
+        "expiringWithinDays": args.expiringWithinDays,
+        "integrationId": args.integrationId,
+    }, opts);
+}
+
+export interface GetSsoCertificatesOutputArgs {
+    expiringWithinDays?: pulumi.Input<number>;
+    integrationId?: pulumi.Input<string>;
+}
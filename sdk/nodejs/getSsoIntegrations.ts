@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the SSO integrations of the SendGrid account.
+ *
+ * Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's SAML application must be configured with, so they can be passed to IdP resources or exported as stack outputs.
+ */
+export function getSsoIntegrations(args?: GetSsoIntegrationsArgs, opts?: pulumi.InvokeOptions): Promise<GetSsoIntegrationsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getSsoIntegrations", {
+    }, opts);
+}
+
+export interface GetSsoIntegrationsArgs {
+}
+
+export interface GetSsoIntegrationsResult {
+    readonly integrations: outputs.SsoIntegration[];
+}
+/**
+ * Lists the SSO integrations of the SendGrid account.
+ *
+ * Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's SAML application must be configured with, so they can be passed to IdP resources or exported as stack outputs.
+ */
+export function getSsoIntegrationsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetSsoIntegrationsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getSsoIntegrations", {
+    }, opts);
+}
+
@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Retrieves global email statistics for the SendGrid account.
+ *
+ * Returns delivered, open, click, bounce and other metrics for each day, week or month in the requested date range, so dashboards and alert thresholds can be derived at deploy time.
+ */
+export function getStats(args: GetStatsArgs, opts?: pulumi.InvokeOptions): Promise<GetStatsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getStats", {
+        "aggregatedBy": args.aggregatedBy,
+        "endDate": args.endDate,
+        "startDate": args.startDate,
+    }, opts);
+}
+
+export interface GetStatsArgs {
+    aggregatedBy?: string;
+    endDate?: string;
+    startDate: string;
+}
+
+export interface GetStatsResult {
+    readonly stats: outputs.StatsEntry[];
+}
+/**
+ * Retrieves global email statistics for the SendGrid account.
+ *
+ * Returns delivered, open, click, bounce and other metrics for each day, week or month in the requested date range, so dashboards and alert thresholds can be derived at deploy time.
+ */
+export function getStatsOutput(args: GetStatsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetStatsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getStats", {
+        "aggregatedBy": args.aggregatedBy,
+        "endDate": args.endDate,
+        "startDate": args.startDate,
+    }, opts);
+}
+
+export interface GetStatsOutputArgs {
+    aggregatedBy?: pulumi.Input<string>;
+    endDate?: pulumi.Input<string>;
+    startDate: pulumi.Input<string>;
+}
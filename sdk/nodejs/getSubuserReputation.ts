@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Retrieves the sender reputation of SendGrid Subusers.
+ *
+ * The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.
+ */
+export function getSubuserReputation(args: GetSubuserReputationArgs, opts?: pulumi.InvokeOptions): Promise<GetSubuserReputationResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getSubuserReputation", {
+        "usernames": args.usernames,
+    }, opts);
+}
+
+export interface GetSubuserReputationArgs {
+    usernames: string[];
+}
+
+export interface GetSubuserReputationResult {
+    readonly reputations: outputs.SubuserReputation[];
+}
+/**
+ * Retrieves the sender reputation of SendGrid Subusers.
+ *
+ * The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.
+ */
+export function getSubuserReputationOutput(args: GetSubuserReputationOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetSubuserReputationResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getSubuserReputation", {
+        "usernames": args.usernames,
+    }, opts);
+}
+
+export interface GetSubuserReputationOutputArgs {
+    usernames: pulumi.Input<pulumi.Input<string>[]>;
+}
@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Retrieves email statistics for specific SendGrid Subusers.
+ *
+ * Returns metrics for each requested subuser (up to 10) and each day, week or month in the date range, for per-tenant usage reporting.
+ */
+export function getSubuserStats(args: GetSubuserStatsArgs, opts?: pulumi.InvokeOptions): Promise<GetSubuserStatsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getSubuserStats", {
+        "aggregatedBy": args.aggregatedBy,
+        "endDate": args.endDate,
+        "startDate": args.startDate,
+        "subusers": args.subusers,
+    }, opts);
+}
+
+export interface GetSubuserStatsArgs {
+    aggregatedBy?: string;
+    endDate?: string;
+    startDate: string;
+    subusers: string[];
+}
+
+export interface GetSubuserStatsResult {
+    readonly stats: outputs.StatsEntry[];
+}
+/**
+ * Retrieves email statistics for specific SendGrid Subusers.
+ *
+ * Returns metrics for each requested subuser (up to 10) and each day, week or month in the date range, for per-tenant usage reporting.
+ */
+export function getSubuserStatsOutput(args: GetSubuserStatsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetSubuserStatsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getSubuserStats", {
+        "aggregatedBy": args.aggregatedBy,
+        "endDate": args.endDate,
+        "startDate": args.startDate,
+        "subusers": args.subusers,
+    }, opts);
+}
+
+export interface GetSubuserStatsOutputArgs {
+    aggregatedBy?: pulumi.Input<string>;
+    endDate?: pulumi.Input<string>;
+    startDate: pulumi.Input<string>;
+    subusers: pulumi.Input<pulumi.Input<string>[]>;
+}
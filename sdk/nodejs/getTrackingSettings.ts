@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Gets the click, open, subscription and Google Analytics tracking settings of the SendGrid account.
+ *
+ * Useful for auditing tracking across accounts or subusers in a single call.
+ */
+export function getTrackingSettings(args?: GetTrackingSettingsArgs, opts?: pulumi.InvokeOptions): Promise<GetTrackingSettingsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getTrackingSettings", {
+    }, opts);
+}
+
+export interface GetTrackingSettingsArgs {
+}
+
+export interface GetTrackingSettingsResult {
+    readonly click: outputs.ClickTrackingSetting;
+    readonly googleAnalytics: outputs.GoogleAnalyticsTrackingSetting;
+    readonly open: outputs.OpenTrackingSetting;
+    readonly subscription: outputs.SubscriptionTrackingSetting;
+}
+/**
+ * Gets the click, open, subscription and Google Analytics tracking settings of the SendGrid account.
+ *
+ * Useful for auditing tracking across accounts or subusers in a single call.
+ */
+export function getTrackingSettingsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetTrackingSettingsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getTrackingSettings", {
+    }, opts);
+}
+
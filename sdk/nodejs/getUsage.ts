@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Gets the email credits of the SendGrid account for the current period.
+ *
+ * Useful for setting the percentage of a usage_limit Alert relative to the plan size, e.g. to be notified when a fixed number of emails remains.
+ */
+export function getUsage(args?: GetUsageArgs, opts?: pulumi.InvokeOptions): Promise<GetUsageResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:getUsage", {
+    }, opts);
+}
+
+export interface GetUsageArgs {
+}
+
+export interface GetUsageResult {
+    readonly lastReset: string;
+    readonly nextReset: string;
+    readonly overage: number;
+    readonly remain: number;
+    readonly resetFrequency: string;
+    readonly total: number;
+    readonly used: number;
+}
+/**
+ * Gets the email credits of the SendGrid account for the current period.
+ *
+ * Useful for setting the percentage of a usage_limit Alert relative to the plan size, e.g. to be notified when a fixed number of emails remains.
+ */
+export function getUsageOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetUsageResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:getUsage", {
+    }, opts);
+}
+
@@ -39,6 +39,7 @@ export class GlobalSuppression extends pulumi.CustomResource {
     }
 
     declare public /*out*/ readonly createdAt: pulumi.Output<number | undefined>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly email: pulumi.Output<string>;
 
     /**
@@ -55,13 +56,17 @@ export class GlobalSuppression extends pulumi.CustomResource {
             if (args?.email === undefined && !opts.urn) {
                 throw new Error("Missing required property 'email'");
             }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["email"] = args?.email;
             resourceInputs["createdAt"] = undefined /*out*/;
         } else {
             resourceInputs["createdAt"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["email"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["email"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(GlobalSuppression.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -70,5 +75,6 @@ export class GlobalSuppression extends pulumi.CustomResource {
  * The set of arguments for constructing a GlobalSuppression resource.
  */
 export interface GlobalSuppressionArgs {
+    deletionProtection?: pulumi.Input<boolean>;
     email: pulumi.Input<string>;
 }
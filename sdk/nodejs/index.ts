@@ -5,6 +5,21 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
+export { AccountEmailArgs } from "./accountEmail";
+export type AccountEmail = import("./accountEmail").AccountEmail;
+export const AccountEmail: typeof import("./accountEmail").AccountEmail = null as any;
+utilities.lazyLoad(exports, ["AccountEmail"], () => require("./accountEmail"));
+
+export { AccountPasswordArgs } from "./accountPassword";
+export type AccountPassword = import("./accountPassword").AccountPassword;
+export const AccountPassword: typeof import("./accountPassword").AccountPassword = null as any;
+utilities.lazyLoad(exports, ["AccountPassword"], () => require("./accountPassword"));
+
+export { AccountUsernameArgs } from "./accountUsername";
+export type AccountUsername = import("./accountUsername").AccountUsername;
+export const AccountUsername: typeof import("./accountUsername").AccountUsername = null as any;
+utilities.lazyLoad(exports, ["AccountUsername"], () => require("./accountUsername"));
+
 export { AlertArgs } from "./alert";
 export type Alert = import("./alert").Alert;
 export const Alert: typeof import("./alert").Alert = null as any;
@@ -15,6 +30,21 @@ export type ApiKey = import("./apiKey").ApiKey;
 export const ApiKey: typeof import("./apiKey").ApiKey = null as any;
 utilities.lazyLoad(exports, ["ApiKey"], () => require("./apiKey"));
 
+export { AuthenticatedDomainArgs } from "./authenticatedDomain";
+export type AuthenticatedDomain = import("./authenticatedDomain").AuthenticatedDomain;
+export const AuthenticatedDomain: typeof import("./authenticatedDomain").AuthenticatedDomain = null as any;
+utilities.lazyLoad(exports, ["AuthenticatedDomain"], () => require("./authenticatedDomain"));
+
+export { ContactDbListArgs } from "./contactDbList";
+export type ContactDbList = import("./contactDbList").ContactDbList;
+export const ContactDbList: typeof import("./contactDbList").ContactDbList = null as any;
+utilities.lazyLoad(exports, ["ContactDbList"], () => require("./contactDbList"));
+
+export { ContactDbRecipientArgs } from "./contactDbRecipient";
+export type ContactDbRecipient = import("./contactDbRecipient").ContactDbRecipient;
+export const ContactDbRecipient: typeof import("./contactDbRecipient").ContactDbRecipient = null as any;
+utilities.lazyLoad(exports, ["ContactDbRecipient"], () => require("./contactDbRecipient"));
+
 export { DomainAuthenticationArgs } from "./domainAuthentication";
 export type DomainAuthentication = import("./domainAuthentication").DomainAuthentication;
 export const DomainAuthentication: typeof import("./domainAuthentication").DomainAuthentication = null as any;
@@ -25,6 +55,146 @@ export type EventWebhook = import("./eventWebhook").EventWebhook;
 export const EventWebhook: typeof import("./eventWebhook").EventWebhook = null as any;
 utilities.lazyLoad(exports, ["EventWebhook"], () => require("./eventWebhook"));
 
+export { EventWebhookSignatureArgs } from "./eventWebhookSignature";
+export type EventWebhookSignature = import("./eventWebhookSignature").EventWebhookSignature;
+export const EventWebhookSignature: typeof import("./eventWebhookSignature").EventWebhookSignature = null as any;
+utilities.lazyLoad(exports, ["EventWebhookSignature"], () => require("./eventWebhookSignature"));
+
+export { EventWebhookTestDeliveryArgs } from "./eventWebhookTestDelivery";
+export type EventWebhookTestDelivery = import("./eventWebhookTestDelivery").EventWebhookTestDelivery;
+export const EventWebhookTestDelivery: typeof import("./eventWebhookTestDelivery").EventWebhookTestDelivery = null as any;
+utilities.lazyLoad(exports, ["EventWebhookTestDelivery"], () => require("./eventWebhookTestDelivery"));
+
+export { GenerateBatchIdArgs, GenerateBatchIdResult } from "./generateBatchId";
+export const generateBatchId: typeof import("./generateBatchId").generateBatchId = null as any;
+export const generateBatchIdOutput: typeof import("./generateBatchId").generateBatchIdOutput = null as any;
+utilities.lazyLoad(exports, ["generateBatchId","generateBatchIdOutput"], () => require("./generateBatchId"));
+
+export { GetAccountProfileArgs, GetAccountProfileResult } from "./getAccountProfile";
+export const getAccountProfile: typeof import("./getAccountProfile").getAccountProfile = null as any;
+export const getAccountProfileOutput: typeof import("./getAccountProfile").getAccountProfileOutput = null as any;
+utilities.lazyLoad(exports, ["getAccountProfile","getAccountProfileOutput"], () => require("./getAccountProfile"));
+
+export { GetAlertsArgs, GetAlertsResult } from "./getAlerts";
+export const getAlerts: typeof import("./getAlerts").getAlerts = null as any;
+export const getAlertsOutput: typeof import("./getAlerts").getAlertsOutput = null as any;
+utilities.lazyLoad(exports, ["getAlerts","getAlertsOutput"], () => require("./getAlerts"));
+
+export { GetBlocksArgs, GetBlocksResult, GetBlocksOutputArgs } from "./getBlocks";
+export const getBlocks: typeof import("./getBlocks").getBlocks = null as any;
+export const getBlocksOutput: typeof import("./getBlocks").getBlocksOutput = null as any;
+utilities.lazyLoad(exports, ["getBlocks","getBlocksOutput"], () => require("./getBlocks"));
+
+export { GetBouncesArgs, GetBouncesResult, GetBouncesOutputArgs } from "./getBounces";
+export const getBounces: typeof import("./getBounces").getBounces = null as any;
+export const getBouncesOutput: typeof import("./getBounces").getBouncesOutput = null as any;
+utilities.lazyLoad(exports, ["getBounces","getBouncesOutput"], () => require("./getBounces"));
+
+export { GetCategoriesArgs, GetCategoriesResult, GetCategoriesOutputArgs } from "./getCategories";
+export const getCategories: typeof import("./getCategories").getCategories = null as any;
+export const getCategoriesOutput: typeof import("./getCategories").getCategoriesOutput = null as any;
+utilities.lazyLoad(exports, ["getCategories","getCategoriesOutput"], () => require("./getCategories"));
+
+export { GetCategoryStatsArgs, GetCategoryStatsResult, GetCategoryStatsOutputArgs } from "./getCategoryStats";
+export const getCategoryStats: typeof import("./getCategoryStats").getCategoryStats = null as any;
+export const getCategoryStatsOutput: typeof import("./getCategoryStats").getCategoryStatsOutput = null as any;
+utilities.lazyLoad(exports, ["getCategoryStats","getCategoryStatsOutput"], () => require("./getCategoryStats"));
+
+export { GetCurrentKeyInfoArgs, GetCurrentKeyInfoResult, GetCurrentKeyInfoOutputArgs } from "./getCurrentKeyInfo";
+export const getCurrentKeyInfo: typeof import("./getCurrentKeyInfo").getCurrentKeyInfo = null as any;
+export const getCurrentKeyInfoOutput: typeof import("./getCurrentKeyInfo").getCurrentKeyInfoOutput = null as any;
+utilities.lazyLoad(exports, ["getCurrentKeyInfo","getCurrentKeyInfoOutput"], () => require("./getCurrentKeyInfo"));
+
+export { GetDesignsArgs, GetDesignsResult } from "./getDesigns";
+export const getDesigns: typeof import("./getDesigns").getDesigns = null as any;
+export const getDesignsOutput: typeof import("./getDesigns").getDesignsOutput = null as any;
+utilities.lazyLoad(exports, ["getDesigns","getDesignsOutput"], () => require("./getDesigns"));
+
+export { GetEventWebhooksArgs, GetEventWebhooksResult } from "./getEventWebhooks";
+export const getEventWebhooks: typeof import("./getEventWebhooks").getEventWebhooks = null as any;
+export const getEventWebhooksOutput: typeof import("./getEventWebhooks").getEventWebhooksOutput = null as any;
+utilities.lazyLoad(exports, ["getEventWebhooks","getEventWebhooksOutput"], () => require("./getEventWebhooks"));
+
+export { GetGlobalSuppressionsArgs, GetGlobalSuppressionsResult, GetGlobalSuppressionsOutputArgs } from "./getGlobalSuppressions";
+export const getGlobalSuppressions: typeof import("./getGlobalSuppressions").getGlobalSuppressions = null as any;
+export const getGlobalSuppressionsOutput: typeof import("./getGlobalSuppressions").getGlobalSuppressionsOutput = null as any;
+utilities.lazyLoad(exports, ["getGlobalSuppressions","getGlobalSuppressionsOutput"], () => require("./getGlobalSuppressions"));
+
+export { GetGroupSuppressionsArgs, GetGroupSuppressionsResult, GetGroupSuppressionsOutputArgs } from "./getGroupSuppressions";
+export const getGroupSuppressions: typeof import("./getGroupSuppressions").getGroupSuppressions = null as any;
+export const getGroupSuppressionsOutput: typeof import("./getGroupSuppressions").getGroupSuppressionsOutput = null as any;
+utilities.lazyLoad(exports, ["getGroupSuppressions","getGroupSuppressionsOutput"], () => require("./getGroupSuppressions"));
+
+export { GetInboundParseSettingsArgs, GetInboundParseSettingsResult } from "./getInboundParseSettings";
+export const getInboundParseSettings: typeof import("./getInboundParseSettings").getInboundParseSettings = null as any;
+export const getInboundParseSettingsOutput: typeof import("./getInboundParseSettings").getInboundParseSettingsOutput = null as any;
+utilities.lazyLoad(exports, ["getInboundParseSettings","getInboundParseSettingsOutput"], () => require("./getInboundParseSettings"));
+
+export { GetInvalidEmailsArgs, GetInvalidEmailsResult, GetInvalidEmailsOutputArgs } from "./getInvalidEmails";
+export const getInvalidEmails: typeof import("./getInvalidEmails").getInvalidEmails = null as any;
+export const getInvalidEmailsOutput: typeof import("./getInvalidEmails").getInvalidEmailsOutput = null as any;
+utilities.lazyLoad(exports, ["getInvalidEmails","getInvalidEmailsOutput"], () => require("./getInvalidEmails"));
+
+export { GetMailSettingsArgs, GetMailSettingsResult } from "./getMailSettings";
+export const getMailSettings: typeof import("./getMailSettings").getMailSettings = null as any;
+export const getMailSettingsOutput: typeof import("./getMailSettings").getMailSettingsOutput = null as any;
+utilities.lazyLoad(exports, ["getMailSettings","getMailSettingsOutput"], () => require("./getMailSettings"));
+
+export { GetMarketingListsArgs, GetMarketingListsResult } from "./getMarketingLists";
+export const getMarketingLists: typeof import("./getMarketingLists").getMarketingLists = null as any;
+export const getMarketingListsOutput: typeof import("./getMarketingLists").getMarketingListsOutput = null as any;
+utilities.lazyLoad(exports, ["getMarketingLists","getMarketingListsOutput"], () => require("./getMarketingLists"));
+
+export { GetMarketingSegmentsArgs, GetMarketingSegmentsResult } from "./getMarketingSegments";
+export const getMarketingSegments: typeof import("./getMarketingSegments").getMarketingSegments = null as any;
+export const getMarketingSegmentsOutput: typeof import("./getMarketingSegments").getMarketingSegmentsOutput = null as any;
+utilities.lazyLoad(exports, ["getMarketingSegments","getMarketingSegmentsOutput"], () => require("./getMarketingSegments"));
+
+export { GetReverseDnsArgs, GetReverseDnsResult, GetReverseDnsOutputArgs } from "./getReverseDns";
+export const getReverseDns: typeof import("./getReverseDns").getReverseDns = null as any;
+export const getReverseDnsOutput: typeof import("./getReverseDns").getReverseDnsOutput = null as any;
+utilities.lazyLoad(exports, ["getReverseDns","getReverseDnsOutput"], () => require("./getReverseDns"));
+
+export { GetSpamReportsArgs, GetSpamReportsResult, GetSpamReportsOutputArgs } from "./getSpamReports";
+export const getSpamReports: typeof import("./getSpamReports").getSpamReports = null as any;
+export const getSpamReportsOutput: typeof import("./getSpamReports").getSpamReportsOutput = null as any;
+utilities.lazyLoad(exports, ["getSpamReports","getSpamReportsOutput"], () => require("./getSpamReports"));
+
+export { GetSsoCertificatesArgs, GetSsoCertificatesResult, GetSsoCertificatesOutputArgs } from "./getSsoCertificates";
+export const getSsoCertificates: typeof import("./getSsoCertificates").getSsoCertificates = null as any;
+export const getSsoCertificatesOutput: typeof import("./getSsoCertificates").getSsoCertificatesOutput = null as any;
+utilities.lazyLoad(exports, ["getSsoCertificates","getSsoCertificatesOutput"], () => require("./getSsoCertificates"));
+
+export { GetSsoIntegrationsArgs, GetSsoIntegrationsResult } from "./getSsoIntegrations";
+export const getSsoIntegrations: typeof import("./getSsoIntegrations").getSsoIntegrations = null as any;
+export const getSsoIntegrationsOutput: typeof import("./getSsoIntegrations").getSsoIntegrationsOutput = null as any;
+utilities.lazyLoad(exports, ["getSsoIntegrations","getSsoIntegrationsOutput"], () => require("./getSsoIntegrations"));
+
+export { GetStatsArgs, GetStatsResult, GetStatsOutputArgs } from "./getStats";
+export const getStats: typeof import("./getStats").getStats = null as any;
+export const getStatsOutput: typeof import("./getStats").getStatsOutput = null as any;
+utilities.lazyLoad(exports, ["getStats","getStatsOutput"], () => require("./getStats"));
+
+export { GetSubuserReputationArgs, GetSubuserReputationResult, GetSubuserReputationOutputArgs } from "./getSubuserReputation";
+export const getSubuserReputation: typeof import("./getSubuserReputation").getSubuserReputation = null as any;
+export const getSubuserReputationOutput: typeof import("./getSubuserReputation").getSubuserReputationOutput = null as any;
+utilities.lazyLoad(exports, ["getSubuserReputation","getSubuserReputationOutput"], () => require("./getSubuserReputation"));
+
+export { GetSubuserStatsArgs, GetSubuserStatsResult, GetSubuserStatsOutputArgs } from "./getSubuserStats";
+export const getSubuserStats: typeof import("./getSubuserStats").getSubuserStats = null as any;
+export const getSubuserStatsOutput: typeof import("./getSubuserStats").getSubuserStatsOutput = null as any;
+utilities.lazyLoad(exports, ["getSubuserStats","getSubuserStatsOutput"], () => require("./getSubuserStats"));
+
+export { GetTrackingSettingsArgs, GetTrackingSettingsResult } from "./getTrackingSettings";
+export const getTrackingSettings: typeof import("./getTrackingSettings").getTrackingSettings = null as any;
+export const getTrackingSettingsOutput: typeof import("./getTrackingSettings").getTrackingSettingsOutput = null as any;
+utilities.lazyLoad(exports, ["getTrackingSettings","getTrackingSettingsOutput"], () => require("./getTrackingSettings"));
+
+export { GetUsageArgs, GetUsageResult } from "./getUsage";
+export const getUsage: typeof import("./getUsage").getUsage = null as any;
+export const getUsageOutput: typeof import("./getUsage").getUsageOutput = null as any;
+utilities.lazyLoad(exports, ["getUsage","getUsageOutput"], () => require("./getUsage"));
+
 export { GlobalSuppressionArgs } from "./globalSuppression";
 export type GlobalSuppression = import("./globalSuppression").GlobalSuppression;
 export const GlobalSuppression: typeof import("./globalSuppression").GlobalSuppression = null as any;
@@ -35,21 +205,61 @@ export type IpPool = import("./ipPool").IpPool;
 export const IpPool: typeof import("./ipPool").IpPool = null as any;
 utilities.lazyLoad(exports, ["IpPool"], () => require("./ipPool"));
 
+export { LegacyTemplateMailSettingArgs } from "./legacyTemplateMailSetting";
+export type LegacyTemplateMailSetting = import("./legacyTemplateMailSetting").LegacyTemplateMailSetting;
+export const LegacyTemplateMailSetting: typeof import("./legacyTemplateMailSetting").LegacyTemplateMailSetting = null as any;
+utilities.lazyLoad(exports, ["LegacyTemplateMailSetting"], () => require("./legacyTemplateMailSetting"));
+
 export { LinkBrandingArgs } from "./linkBranding";
 export type LinkBranding = import("./linkBranding").LinkBranding;
 export const LinkBranding: typeof import("./linkBranding").LinkBranding = null as any;
 utilities.lazyLoad(exports, ["LinkBranding"], () => require("./linkBranding"));
 
+export { NewRelicPartnerSettingArgs } from "./newRelicPartnerSetting";
+export type NewRelicPartnerSetting = import("./newRelicPartnerSetting").NewRelicPartnerSetting;
+export const NewRelicPartnerSetting: typeof import("./newRelicPartnerSetting").NewRelicPartnerSetting = null as any;
+utilities.lazyLoad(exports, ["NewRelicPartnerSetting"], () => require("./newRelicPartnerSetting"));
+
 export { ProviderArgs } from "./provider";
 export type Provider = import("./provider").Provider;
 export const Provider: typeof import("./provider").Provider = null as any;
 utilities.lazyLoad(exports, ["Provider"], () => require("./provider"));
 
+export { ScheduledSendArgs } from "./scheduledSend";
+export type ScheduledSend = import("./scheduledSend").ScheduledSend;
+export const ScheduledSend: typeof import("./scheduledSend").ScheduledSend = null as any;
+utilities.lazyLoad(exports, ["ScheduledSend"], () => require("./scheduledSend"));
+
+export { SearchEmailActivityArgs, SearchEmailActivityResult, SearchEmailActivityOutputArgs } from "./searchEmailActivity";
+export const searchEmailActivity: typeof import("./searchEmailActivity").searchEmailActivity = null as any;
+export const searchEmailActivityOutput: typeof import("./searchEmailActivity").searchEmailActivityOutput = null as any;
+utilities.lazyLoad(exports, ["searchEmailActivity","searchEmailActivityOutput"], () => require("./searchEmailActivity"));
+
+export { SendTestEmailArgs, SendTestEmailResult, SendTestEmailOutputArgs } from "./sendTestEmail";
+export const sendTestEmail: typeof import("./sendTestEmail").sendTestEmail = null as any;
+export const sendTestEmailOutput: typeof import("./sendTestEmail").sendTestEmailOutput = null as any;
+utilities.lazyLoad(exports, ["sendTestEmail","sendTestEmailOutput"], () => require("./sendTestEmail"));
+
 export { SubuserArgs } from "./subuser";
 export type Subuser = import("./subuser").Subuser;
 export const Subuser: typeof import("./subuser").Subuser = null as any;
 utilities.lazyLoad(exports, ["Subuser"], () => require("./subuser"));
 
+export { SubuserDomainAssociationArgs } from "./subuserDomainAssociation";
+export type SubuserDomainAssociation = import("./subuserDomainAssociation").SubuserDomainAssociation;
+export const SubuserDomainAssociation: typeof import("./subuserDomainAssociation").SubuserDomainAssociation = null as any;
+utilities.lazyLoad(exports, ["SubuserDomainAssociation"], () => require("./subuserDomainAssociation"));
+
+export { SubuserLinkAssociationArgs } from "./subuserLinkAssociation";
+export type SubuserLinkAssociation = import("./subuserLinkAssociation").SubuserLinkAssociation;
+export const SubuserLinkAssociation: typeof import("./subuserLinkAssociation").SubuserLinkAssociation = null as any;
+utilities.lazyLoad(exports, ["SubuserLinkAssociation"], () => require("./subuserLinkAssociation"));
+
+export { SubuserOnboardingArgs } from "./subuserOnboarding";
+export type SubuserOnboarding = import("./subuserOnboarding").SubuserOnboarding;
+export const SubuserOnboarding: typeof import("./subuserOnboarding").SubuserOnboarding = null as any;
+utilities.lazyLoad(exports, ["SubuserOnboarding"], () => require("./subuserOnboarding"));
+
 export { TeammateArgs } from "./teammate";
 export type Teammate = import("./teammate").Teammate;
 export const Teammate: typeof import("./teammate").Teammate = null as any;
@@ -60,6 +270,11 @@ export type Template = import("./template").Template;
 export const Template: typeof import("./template").Template = null as any;
 utilities.lazyLoad(exports, ["Template"], () => require("./template"));
 
+export { TemplateDirectoryArgs } from "./templateDirectory";
+export type TemplateDirectory = import("./templateDirectory").TemplateDirectory;
+export const TemplateDirectory: typeof import("./templateDirectory").TemplateDirectory = null as any;
+utilities.lazyLoad(exports, ["TemplateDirectory"], () => require("./templateDirectory"));
+
 export { TemplateVersionArgs } from "./templateVersion";
 export type TemplateVersion = import("./templateVersion").TemplateVersion;
 export const TemplateVersion: typeof import("./templateVersion").TemplateVersion = null as any;
@@ -70,11 +285,21 @@ export type UnsubscribeGroup = import("./unsubscribeGroup").UnsubscribeGroup;
 export const UnsubscribeGroup: typeof import("./unsubscribeGroup").UnsubscribeGroup = null as any;
 utilities.lazyLoad(exports, ["UnsubscribeGroup"], () => require("./unsubscribeGroup"));
 
+export { ValidateEmailArgs, ValidateEmailResult, ValidateEmailOutputArgs } from "./validateEmail";
+export const validateEmail: typeof import("./validateEmail").validateEmail = null as any;
+export const validateEmailOutput: typeof import("./validateEmail").validateEmailOutput = null as any;
+utilities.lazyLoad(exports, ["validateEmail","validateEmailOutput"], () => require("./validateEmail"));
+
 export { VerifiedSenderArgs } from "./verifiedSender";
 export type VerifiedSender = import("./verifiedSender").VerifiedSender;
 export const VerifiedSender: typeof import("./verifiedSender").VerifiedSender = null as any;
 utilities.lazyLoad(exports, ["VerifiedSender"], () => require("./verifiedSender"));
 
+export { WebhookEndpointArgs } from "./webhookEndpoint";
+export type WebhookEndpoint = import("./webhookEndpoint").WebhookEndpoint;
+export const WebhookEndpoint: typeof import("./webhookEndpoint").WebhookEndpoint = null as any;
+utilities.lazyLoad(exports, ["WebhookEndpoint"], () => require("./webhookEndpoint"));
+
 
 // Export sub-modules:
 import * as config from "./config";
@@ -89,32 +314,64 @@ const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
+            case "sendgrid:index:AccountEmail":
+                return new AccountEmail(name, <any>undefined, { urn })
+            case "sendgrid:index:AccountPassword":
+                return new AccountPassword(name, <any>undefined, { urn })
+            case "sendgrid:index:AccountUsername":
+                return new AccountUsername(name, <any>undefined, { urn })
             case "sendgrid:index:Alert":
                 return new Alert(name, <any>undefined, { urn })
             case "sendgrid:index:ApiKey":
                 return new ApiKey(name, <any>undefined, { urn })
+            case "sendgrid:index:AuthenticatedDomain":
+                return new AuthenticatedDomain(name, <any>undefined, { urn })
+            case "sendgrid:index:ContactDbList":
+                return new ContactDbList(name, <any>undefined, { urn })
+            case "sendgrid:index:ContactDbRecipient":
+                return new ContactDbRecipient(name, <any>undefined, { urn })
             case "sendgrid:index:DomainAuthentication":
                 return new DomainAuthentication(name, <any>undefined, { urn })
             case "sendgrid:index:EventWebhook":
                 return new EventWebhook(name, <any>undefined, { urn })
+            case "sendgrid:index:EventWebhookSignature":
+                return new EventWebhookSignature(name, <any>undefined, { urn })
+            case "sendgrid:index:EventWebhookTestDelivery":
+                return new EventWebhookTestDelivery(name, <any>undefined, { urn })
             case "sendgrid:index:GlobalSuppression":
                 return new GlobalSuppression(name, <any>undefined, { urn })
             case "sendgrid:index:IpPool":
                 return new IpPool(name, <any>undefined, { urn })
+            case "sendgrid:index:LegacyTemplateMailSetting":
+                return new LegacyTemplateMailSetting(name, <any>undefined, { urn })
             case "sendgrid:index:LinkBranding":
                 return new LinkBranding(name, <any>undefined, { urn })
+            case "sendgrid:index:NewRelicPartnerSetting":
+                return new NewRelicPartnerSetting(name, <any>undefined, { urn })
+            case "sendgrid:index:ScheduledSend":
+                return new ScheduledSend(name, <any>undefined, { urn })
             case "sendgrid:index:Subuser":
                 return new Subuser(name, <any>undefined, { urn })
+            case "sendgrid:index:SubuserDomainAssociation":
+                return new SubuserDomainAssociation(name, <any>undefined, { urn })
+            case "sendgrid:index:SubuserLinkAssociation":
+                return new SubuserLinkAssociation(name, <any>undefined, { urn })
+            case "sendgrid:index:SubuserOnboarding":
+                return new SubuserOnboarding(name, <any>undefined, { urn })
             case "sendgrid:index:Teammate":
                 return new Teammate(name, <any>undefined, { urn })
             case "sendgrid:index:Template":
                 return new Template(name, <any>undefined, { urn })
+            case "sendgrid:index:TemplateDirectory":
+                return new TemplateDirectory(name, <any>undefined, { urn })
             case "sendgrid:index:TemplateVersion":
                 return new TemplateVersion(name, <any>undefined, { urn })
             case "sendgrid:index:UnsubscribeGroup":
                 return new UnsubscribeGroup(name, <any>undefined, { urn })
             case "sendgrid:index:VerifiedSender":
                 return new VerifiedSender(name, <any>undefined, { urn })
+            case "sendgrid:index:WebhookEndpoint":
+                return new WebhookEndpoint(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
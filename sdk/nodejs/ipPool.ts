@@ -38,8 +38,9 @@ export class IpPool extends pulumi.CustomResource {
         return obj['__pulumiType'] === IpPool.__pulumiType;
     }
 
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public /*out*/ readonly ips: pulumi.Output<string[]>;
-    declare public readonly name: pulumi.Output<string>;
+    declare public readonly name: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly poolName: pulumi.Output<string>;
 
     /**
@@ -49,17 +50,16 @@ export class IpPool extends pulumi.CustomResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: IpPoolArgs, opts?: pulumi.CustomResourceOptions) {
+    constructor(name: string, args?: IpPoolArgs, opts?: pulumi.CustomResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            if (args?.name === undefined && !opts.urn) {
-                throw new Error("Missing required property 'name'");
-            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["name"] = args?.name;
             resourceInputs["ips"] = undefined /*out*/;
             resourceInputs["poolName"] = undefined /*out*/;
         } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["ips"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["poolName"] = undefined /*out*/;
@@ -73,5 +73,6 @@ export class IpPool extends pulumi.CustomResource {
  * The set of arguments for constructing a IpPool resource.
  */
 export interface IpPoolArgs {
-    name: pulumi.Input<string>;
+    deletionProtection?: pulumi.Input<boolean>;
+    name?: pulumi.Input<string>;
 }
@@ -0,0 +1,79 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Manages the SendGrid legacy template mail setting.
+ *
+ * For accounts still using legacy templates, this setting wraps every email in the same HTML, with the <% body %> tag where the content of each email goes. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the setting.
+ *
+ * New accounts should use dynamic templates with the Template resource instead.
+ */
+export class LegacyTemplateMailSetting extends pulumi.CustomResource {
+    /**
+     * Get an existing LegacyTemplateMailSetting resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): LegacyTemplateMailSetting {
+        return new LegacyTemplateMailSetting(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:LegacyTemplateMailSetting';
+
+    /**
+     * Returns true if the given object is an instance of LegacyTemplateMailSetting.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is LegacyTemplateMailSetting {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === LegacyTemplateMailSetting.__pulumiType;
+    }
+
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public readonly enabled: pulumi.Output<boolean>;
+    declare public readonly htmlContent: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a LegacyTemplateMailSetting resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: LegacyTemplateMailSettingArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.enabled === undefined && !opts.urn) {
+                throw new Error("Missing required property 'enabled'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["enabled"] = args?.enabled;
+            resourceInputs["htmlContent"] = args?.htmlContent;
+        } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["enabled"] = undefined /*out*/;
+            resourceInputs["htmlContent"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(LegacyTemplateMailSetting.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a LegacyTemplateMailSetting resource.
+ */
+export interface LegacyTemplateMailSettingArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    enabled: pulumi.Input<boolean>;
+    htmlContent?: pulumi.Input<string>;
+}
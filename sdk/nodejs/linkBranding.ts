@@ -42,15 +42,22 @@ export class LinkBranding extends pulumi.CustomResource {
 
     declare public /*out*/ readonly brandCname: pulumi.Output<outputs.LinkBrandingDNSRecord | undefined>;
     declare public readonly default: pulumi.Output<boolean | undefined>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public /*out*/ readonly dnsRecords: pulumi.Output<outputs.DomainDNSRecord[]>;
     declare public readonly domain: pulumi.Output<string>;
     declare public /*out*/ readonly legacy: pulumi.Output<boolean>;
     declare public /*out*/ readonly linkId: pulumi.Output<number>;
     declare public /*out*/ readonly ownerCname: pulumi.Output<outputs.LinkBrandingDNSRecord | undefined>;
+    declare public /*out*/ readonly providerRecords: pulumi.Output<outputs.DNSProviderRecord[] | undefined>;
     declare public readonly region: pulumi.Output<string | undefined>;
+    declare public readonly revalidateOnRefresh: pulumi.Output<boolean | undefined>;
     declare public readonly subdomain: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly userId: pulumi.Output<number>;
     declare public /*out*/ readonly username: pulumi.Output<string>;
     declare public /*out*/ readonly valid: pulumi.Output<boolean>;
+    declare public readonly waitForValidation: pulumi.Output<boolean | undefined>;
+    declare public readonly waitIntervalSeconds: pulumi.Output<number | undefined>;
+    declare public readonly waitTimeoutSeconds: pulumi.Output<number | undefined>;
 
     /**
      * Create a LinkBranding resource with the given unique name, arguments, and options.
@@ -67,30 +74,46 @@ export class LinkBranding extends pulumi.CustomResource {
                 throw new Error("Missing required property 'domain'");
             }
             resourceInputs["default"] = args?.default;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["domain"] = args?.domain;
             resourceInputs["region"] = args?.region;
+            resourceInputs["revalidateOnRefresh"] = args?.revalidateOnRefresh;
             resourceInputs["subdomain"] = args?.subdomain;
+            resourceInputs["waitForValidation"] = args?.waitForValidation;
+            resourceInputs["waitIntervalSeconds"] = args?.waitIntervalSeconds;
+            resourceInputs["waitTimeoutSeconds"] = args?.waitTimeoutSeconds;
             resourceInputs["brandCname"] = undefined /*out*/;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
             resourceInputs["legacy"] = undefined /*out*/;
             resourceInputs["linkId"] = undefined /*out*/;
             resourceInputs["ownerCname"] = undefined /*out*/;
+            resourceInputs["providerRecords"] = undefined /*out*/;
             resourceInputs["userId"] = undefined /*out*/;
             resourceInputs["username"] = undefined /*out*/;
             resourceInputs["valid"] = undefined /*out*/;
         } else {
             resourceInputs["brandCname"] = undefined /*out*/;
             resourceInputs["default"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
             resourceInputs["domain"] = undefined /*out*/;
             resourceInputs["legacy"] = undefined /*out*/;
             resourceInputs["linkId"] = undefined /*out*/;
             resourceInputs["ownerCname"] = undefined /*out*/;
+            resourceInputs["providerRecords"] = undefined /*out*/;
             resourceInputs["region"] = undefined /*out*/;
+            resourceInputs["revalidateOnRefresh"] = undefined /*out*/;
             resourceInputs["subdomain"] = undefined /*out*/;
             resourceInputs["userId"] = undefined /*out*/;
             resourceInputs["username"] = undefined /*out*/;
             resourceInputs["valid"] = undefined /*out*/;
+            resourceInputs["waitForValidation"] = undefined /*out*/;
+            resourceInputs["waitIntervalSeconds"] = undefined /*out*/;
+            resourceInputs["waitTimeoutSeconds"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["domain", "subdomain"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(LinkBranding.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -100,7 +123,12 @@ export class LinkBranding extends pulumi.CustomResource {
  */
 export interface LinkBrandingArgs {
     default?: pulumi.Input<boolean>;
+    deletionProtection?: pulumi.Input<boolean>;
     domain: pulumi.Input<string>;
     region?: pulumi.Input<string>;
+    revalidateOnRefresh?: pulumi.Input<boolean>;
     subdomain?: pulumi.Input<string>;
+    waitForValidation?: pulumi.Input<boolean>;
+    waitIntervalSeconds?: pulumi.Input<number>;
+    waitTimeoutSeconds?: pulumi.Input<number>;
 }
@@ -0,0 +1,83 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Manages the SendGrid New Relic partner setting.
+ *
+ * When enabled, SendGrid sends your email statistics to New Relic using the given license key. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the integration.
+ */
+export class NewRelicPartnerSetting extends pulumi.CustomResource {
+    /**
+     * Get an existing NewRelicPartnerSetting resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): NewRelicPartnerSetting {
+        return new NewRelicPartnerSetting(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:NewRelicPartnerSetting';
+
+    /**
+     * Returns true if the given object is an instance of NewRelicPartnerSetting.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is NewRelicPartnerSetting {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === NewRelicPartnerSetting.__pulumiType;
+    }
+
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public readonly enableSubuserStatistics: pulumi.Output<boolean | undefined>;
+    declare public readonly enabled: pulumi.Output<boolean>;
+    declare public readonly licenseKey: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a NewRelicPartnerSetting resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: NewRelicPartnerSettingArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.enabled === undefined && !opts.urn) {
+                throw new Error("Missing required property 'enabled'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["enableSubuserStatistics"] = args?.enableSubuserStatistics;
+            resourceInputs["enabled"] = args?.enabled;
+            resourceInputs["licenseKey"] = args?.licenseKey ? pulumi.secret(args.licenseKey) : undefined;
+        } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["enableSubuserStatistics"] = undefined /*out*/;
+            resourceInputs["enabled"] = undefined /*out*/;
+            resourceInputs["licenseKey"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["licenseKey"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(NewRelicPartnerSetting.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a NewRelicPartnerSetting resource.
+ */
+export interface NewRelicPartnerSettingArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    enableSubuserStatistics?: pulumi.Input<boolean>;
+    enabled: pulumi.Input<boolean>;
+    licenseKey?: pulumi.Input<string>;
+}
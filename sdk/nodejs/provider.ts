@@ -20,13 +20,45 @@ export class Provider extends pulumi.ProviderResource {
     }
 
     /**
-     * The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+     * The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
      */
     declare public readonly apiKey: pulumi.Output<string | undefined>;
     /**
-     * The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+     * The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+     */
+    declare public readonly apiKeyFile: pulumi.Output<string | undefined>;
+    /**
+     * The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
      */
     declare public readonly baseUrl: pulumi.Output<string | undefined>;
+    /**
+     * The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+     */
+    declare public readonly httpProxy: pulumi.Output<string | undefined>;
+    /**
+     * The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+     */
+    declare public readonly httpsProxy: pulumi.Output<string | undefined>;
+    /**
+     * A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+     */
+    declare public readonly noProxy: pulumi.Output<string | undefined>;
+    /**
+     * The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+     */
+    declare public readonly onBehalfOf: pulumi.Output<string | undefined>;
+    /**
+     * The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+     */
+    declare public readonly region: pulumi.Output<string | undefined>;
+    /**
+     * The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+     */
+    declare public readonly statsdAddress: pulumi.Output<string | undefined>;
+    /**
+     * A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+     */
+    declare public readonly userAgentSuffix: pulumi.Output<string | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -40,10 +72,34 @@ export class Provider extends pulumi.ProviderResource {
         opts = opts || {};
         {
             resourceInputs["apiKey"] = args?.apiKey ? pulumi.secret(args.apiKey) : undefined;
-            resourceInputs["baseUrl"] = (args?.baseUrl) ?? "https://api.sendgrid.com";
+            resourceInputs["apiKeyFile"] = args?.apiKeyFile;
+            resourceInputs["baseUrl"] = args?.baseUrl;
+            resourceInputs["circuitBreakerCooldownSeconds"] = pulumi.output((args?.circuitBreakerCooldownSeconds) ?? 30).apply(JSON.stringify);
+            resourceInputs["circuitBreakerThreshold"] = pulumi.output((args?.circuitBreakerThreshold) ?? 5).apply(JSON.stringify);
+            resourceInputs["enableLegacyContactDb"] = pulumi.output(args?.enableLegacyContactDb).apply(JSON.stringify);
+            resourceInputs["headers"] = pulumi.output(args?.headers).apply(JSON.stringify);
+            resourceInputs["httpProxy"] = args?.httpProxy ? pulumi.secret(args.httpProxy) : undefined;
+            resourceInputs["httpsProxy"] = args?.httpsProxy ? pulumi.secret(args.httpsProxy) : undefined;
+            resourceInputs["logMetrics"] = pulumi.output(args?.logMetrics).apply(JSON.stringify);
+            resourceInputs["maxConcurrentRequests"] = pulumi.output((args?.maxConcurrentRequests) ?? 10).apply(JSON.stringify);
+            resourceInputs["maxRetries"] = pulumi.output((args?.maxRetries) ?? 3).apply(JSON.stringify);
+            resourceInputs["noProxy"] = args?.noProxy;
+            resourceInputs["onBehalfOf"] = args?.onBehalfOf;
+            resourceInputs["rateLimitBurst"] = pulumi.output(args?.rateLimitBurst).apply(JSON.stringify);
+            resourceInputs["rateLimitPerSecond"] = pulumi.output(args?.rateLimitPerSecond).apply(JSON.stringify);
+            resourceInputs["readTimeoutSeconds"] = pulumi.output(args?.readTimeoutSeconds).apply(JSON.stringify);
+            resourceInputs["region"] = args?.region;
+            resourceInputs["requestTimeoutSeconds"] = pulumi.output((args?.requestTimeoutSeconds) ?? 30).apply(JSON.stringify);
+            resourceInputs["requiredScopes"] = pulumi.output(args?.requiredScopes).apply(JSON.stringify);
+            resourceInputs["retryBudget"] = pulumi.output((args?.retryBudget) ?? 20).apply(JSON.stringify);
+            resourceInputs["statsdAddress"] = args?.statsdAddress;
+            resourceInputs["userAgentSuffix"] = args?.userAgentSuffix;
+            resourceInputs["validateApiKey"] = pulumi.output((args?.validateApiKey) ?? true).apply(JSON.stringify);
+            resourceInputs["validateOnPreview"] = pulumi.output(args?.validateOnPreview).apply(JSON.stringify);
+            resourceInputs["writeTimeoutSeconds"] = pulumi.output(args?.writeTimeoutSeconds).apply(JSON.stringify);
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
-        const secretOpts = { additionalSecretOutputs: ["apiKey"] };
+        const secretOpts = { additionalSecretOutputs: ["apiKey", "httpProxy", "httpsProxy"] };
         opts = pulumi.mergeOptions(opts, secretOpts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
     }
@@ -54,11 +110,107 @@ export class Provider extends pulumi.ProviderResource {
  */
 export interface ProviderArgs {
     /**
-     * The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+     * The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
      */
     apiKey?: pulumi.Input<string>;
     /**
-     * The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+     * The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+     */
+    apiKeyFile?: pulumi.Input<string>;
+    /**
+     * The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
      */
     baseUrl?: pulumi.Input<string>;
+    /**
+     * How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+     */
+    circuitBreakerCooldownSeconds?: pulumi.Input<number>;
+    /**
+     * The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+     */
+    circuitBreakerThreshold?: pulumi.Input<number>;
+    /**
+     * Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+     */
+    enableLegacyContactDb?: pulumi.Input<boolean>;
+    /**
+     * Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+     */
+    headers?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+     */
+    httpProxy?: pulumi.Input<string>;
+    /**
+     * The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+     */
+    httpsProxy?: pulumi.Input<string>;
+    /**
+     * Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+     */
+    logMetrics?: pulumi.Input<boolean>;
+    /**
+     * The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+     */
+    maxConcurrentRequests?: pulumi.Input<number>;
+    /**
+     * The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+     */
+    maxRetries?: pulumi.Input<number>;
+    /**
+     * A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+     */
+    noProxy?: pulumi.Input<string>;
+    /**
+     * The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+     */
+    onBehalfOf?: pulumi.Input<string>;
+    /**
+     * The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+     */
+    rateLimitBurst?: pulumi.Input<number>;
+    /**
+     * The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+     */
+    rateLimitPerSecond?: pulumi.Input<number>;
+    /**
+     * The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+     */
+    readTimeoutSeconds?: pulumi.Input<number>;
+    /**
+     * The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+     */
+    region?: pulumi.Input<string>;
+    /**
+     * The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+     */
+    requestTimeoutSeconds?: pulumi.Input<number>;
+    /**
+     * API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+     */
+    requiredScopes?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+     */
+    retryBudget?: pulumi.Input<number>;
+    /**
+     * The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+     */
+    statsdAddress?: pulumi.Input<string>;
+    /**
+     * A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+     */
+    userAgentSuffix?: pulumi.Input<string>;
+    /**
+     * Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+     */
+    validateApiKey?: pulumi.Input<boolean>;
+    /**
+     * Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+     */
+    validateOnPreview?: pulumi.Input<boolean>;
+    /**
+     * The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
+     */
+    writeTimeoutSeconds?: pulumi.Input<number>;
 }
@@ -0,0 +1,84 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Manages the status of a SendGrid scheduled send.
+ *
+ * Emails sent with a batch ID and a send_at time can be paused or cancelled until they are sent. Setting the status to "pause" holds the batch, and "cancel" discards it when its send time arrives. Deleting this resource removes the status, so a paused batch is sent again.
+ *
+ * Batch IDs can be created with the SendGrid API and passed to the mail send requests of the batch.
+ */
+export class ScheduledSend extends pulumi.CustomResource {
+    /**
+     * Get an existing ScheduledSend resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ScheduledSend {
+        return new ScheduledSend(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:ScheduledSend';
+
+    /**
+     * Returns true if the given object is an instance of ScheduledSend.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ScheduledSend {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ScheduledSend.__pulumiType;
+    }
+
+    declare public readonly batchId: pulumi.Output<string>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public readonly status: pulumi.Output<string>;
+
+    /**
+     * Create a ScheduledSend resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ScheduledSendArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.batchId === undefined && !opts.urn) {
+                throw new Error("Missing required property 'batchId'");
+            }
+            if (args?.status === undefined && !opts.urn) {
+                throw new Error("Missing required property 'status'");
+            }
+            resourceInputs["batchId"] = args?.batchId;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["status"] = args?.status;
+        } else {
+            resourceInputs["batchId"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["batchId"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(ScheduledSend.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ScheduledSend resource.
+ */
+export interface ScheduledSendArgs {
+    batchId: pulumi.Input<string>;
+    deletionProtection?: pulumi.Input<boolean>;
+    status: pulumi.Input<string>;
+}
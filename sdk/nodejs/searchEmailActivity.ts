@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Searches the SendGrid Email Activity feed.
+ *
+ * Filter by recipient, delivery status or message ID to verify that a message was delivered, for example as a deploy-time smoke test. Requires the Email Activity add-on.
+ */
+export function searchEmailActivity(args?: SearchEmailActivityArgs, opts?: pulumi.InvokeOptions): Promise<SearchEmailActivityResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:searchEmailActivity", {
+        "limit": args.limit,
+        "msgId": args.msgId,
+        "status": args.status,
+        "toEmail": args.toEmail,
+    }, opts);
+}
+
+export interface SearchEmailActivityArgs {
+    limit?: number;
+    msgId?: string;
+    status?: string;
+    toEmail?: string;
+}
+
+export interface SearchEmailActivityResult {
+    readonly messages: outputs.EmailActivityMessage[];
+}
+/**
+ * Searches the SendGrid Email Activity feed.
+ *
+ * Filter by recipient, delivery status or message ID to verify that a message was delivered, for example as a deploy-time smoke test. Requires the Email Activity add-on.
+ */
+export function searchEmailActivityOutput(args?: SearchEmailActivityOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<SearchEmailActivityResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:searchEmailActivity", {
+        "limit": args.limit,
+        "msgId": args.msgId,
+        "status": args.status,
+        "toEmail": args.toEmail,
+    }, opts);
+}
+
+export interface SearchEmailActivityOutputArgs {
+    limit?: pulumi.Input<number>;
+    msgId?: pulumi.Input<string>;
+    status?: pulumi.Input<string>;
+    toEmail?: pulumi.Input<string>;
+}
@@ -0,0 +1,68 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Sends a single test email through the Mail Send API.
+ *
+ * Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. An email is sent every time the function is called, including during previews, so call it from a dedicated smoke test program, or set sandboxMode to only validate the request.
+ */
+export function sendTestEmail(args: SendTestEmailArgs, opts?: pulumi.InvokeOptions): Promise<SendTestEmailResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:sendTestEmail", {
+        "content": args.content,
+        "dynamicTemplateData": args.dynamicTemplateData,
+        "from": args.from,
+        "fromName": args.fromName,
+        "sandboxMode": args.sandboxMode,
+        "subject": args.subject,
+        "templateId": args.templateId,
+        "to": args.to,
+    }, opts);
+}
+
+export interface SendTestEmailArgs {
+    content?: string;
+    dynamicTemplateData?: string;
+    from: string;
+    fromName?: string;
+    sandboxMode?: boolean;
+    subject?: string;
+    templateId?: string;
+    to: string;
+}
+
+export interface SendTestEmailResult {
+    readonly messageId: string;
+}
+/**
+ * Sends a single test email through the Mail Send API.
+ *
+ * Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. An email is sent every time the function is called, including during previews, so call it from a dedicated smoke test program, or set sandboxMode to only validate the request.
+ */
+export function sendTestEmailOutput(args: SendTestEmailOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<SendTestEmailResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:sendTestEmail", {
+        "content": args.content,
+        "dynamicTemplateData": args.dynamicTemplateData,
+        "from": args.from,
+        "fromName": args.fromName,
+        "sandboxMode": args.sandboxMode,
+        "subject": args.subject,
+        "templateId": args.templateId,
+        "to": args.to,
+    }, opts);
+}
+
+export interface SendTestEmailOutputArgs {
+    content?: pulumi.Input<string>;
+    dynamicTemplateData?: pulumi.Input<string>;
+    from: pulumi.Input<string>;
+    fromName?: pulumi.Input<string>;
+    sandboxMode?: pulumi.Input<boolean>;
+    subject?: pulumi.Input<string>;
+    templateId?: pulumi.Input<string>;
+    to: pulumi.Input<string>;
+}
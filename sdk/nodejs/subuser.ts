@@ -38,6 +38,7 @@ export class Subuser extends pulumi.CustomResource {
         return obj['__pulumiType'] === Subuser.__pulumiType;
     }
 
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly disabled: pulumi.Output<boolean>;
     declare public readonly email: pulumi.Output<string>;
     declare public readonly ips: pulumi.Output<string[] | undefined>;
@@ -65,6 +66,7 @@ export class Subuser extends pulumi.CustomResource {
             if (args?.username === undefined && !opts.urn) {
                 throw new Error("Missing required property 'username'");
             }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["disabled"] = args?.disabled;
             resourceInputs["email"] = args?.email;
             resourceInputs["ips"] = args?.ips;
@@ -73,6 +75,7 @@ export class Subuser extends pulumi.CustomResource {
             resourceInputs["username"] = args?.username;
             resourceInputs["userId"] = undefined /*out*/;
         } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["disabled"] = undefined /*out*/;
             resourceInputs["email"] = undefined /*out*/;
             resourceInputs["ips"] = undefined /*out*/;
@@ -89,6 +92,7 @@ export class Subuser extends pulumi.CustomResource {
  * The set of arguments for constructing a Subuser resource.
  */
 export interface SubuserArgs {
+    deletionProtection?: pulumi.Input<boolean>;
     disabled?: pulumi.Input<boolean>;
     email: pulumi.Input<string>;
     ips?: pulumi.Input<pulumi.Input<string>[]>;
@@ -0,0 +1,85 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Associates a domain authentication of the parent account with a subuser.
+ *
+ * The subuser can then send from the authenticated domain without authenticating it itself. A subuser has at most one associated domain, so the resource ID is the subuser's username. Deleting the resource removes the association.
+ */
+export class SubuserDomainAssociation extends pulumi.CustomResource {
+    /**
+     * Get an existing SubuserDomainAssociation resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): SubuserDomainAssociation {
+        return new SubuserDomainAssociation(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:SubuserDomainAssociation';
+
+    /**
+     * Returns true if the given object is an instance of SubuserDomainAssociation.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SubuserDomainAssociation {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SubuserDomainAssociation.__pulumiType;
+    }
+
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public /*out*/ readonly domain: pulumi.Output<string>;
+    declare public readonly domainId: pulumi.Output<number>;
+    declare public readonly username: pulumi.Output<string>;
+
+    /**
+     * Create a SubuserDomainAssociation resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SubuserDomainAssociationArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.domainId === undefined && !opts.urn) {
+                throw new Error("Missing required property 'domainId'");
+            }
+            if (args?.username === undefined && !opts.urn) {
+                throw new Error("Missing required property 'username'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["domainId"] = args?.domainId;
+            resourceInputs["username"] = args?.username;
+            resourceInputs["domain"] = undefined /*out*/;
+        } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["domain"] = undefined /*out*/;
+            resourceInputs["domainId"] = undefined /*out*/;
+            resourceInputs["username"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["domainId", "username"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(SubuserDomainAssociation.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SubuserDomainAssociation resource.
+ */
+export interface SubuserDomainAssociationArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    domainId: pulumi.Input<number>;
+    username: pulumi.Input<string>;
+}
@@ -0,0 +1,85 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Associates a link branding of the parent account with a subuser.
+ *
+ * The links in the subuser's emails then use the branded domain without the subuser branding it itself. A subuser has at most one associated link branding, so the resource ID is the subuser's username. Deleting the resource removes the association.
+ */
+export class SubuserLinkAssociation extends pulumi.CustomResource {
+    /**
+     * Get an existing SubuserLinkAssociation resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): SubuserLinkAssociation {
+        return new SubuserLinkAssociation(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:SubuserLinkAssociation';
+
+    /**
+     * Returns true if the given object is an instance of SubuserLinkAssociation.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SubuserLinkAssociation {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SubuserLinkAssociation.__pulumiType;
+    }
+
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public /*out*/ readonly domain: pulumi.Output<string>;
+    declare public readonly linkId: pulumi.Output<number>;
+    declare public readonly username: pulumi.Output<string>;
+
+    /**
+     * Create a SubuserLinkAssociation resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SubuserLinkAssociationArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.linkId === undefined && !opts.urn) {
+                throw new Error("Missing required property 'linkId'");
+            }
+            if (args?.username === undefined && !opts.urn) {
+                throw new Error("Missing required property 'username'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["linkId"] = args?.linkId;
+            resourceInputs["username"] = args?.username;
+            resourceInputs["domain"] = undefined /*out*/;
+        } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["domain"] = undefined /*out*/;
+            resourceInputs["linkId"] = undefined /*out*/;
+            resourceInputs["username"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["linkId", "username"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(SubuserLinkAssociation.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SubuserLinkAssociation resource.
+ */
+export interface SubuserLinkAssociationArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    linkId: pulumi.Input<number>;
+    username: pulumi.Input<string>;
+}
@@ -0,0 +1,92 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Provisions a SendGrid subuser for a tenant.
+ *
+ * The component creates the Subuser with its IPs, then an ApiKey in the subuser's account through a provider configured with onBehalfOf, and associates the given domain authentication and link branding with the subuser, in the order SendGrid requires. The on-behalf-of provider takes the settings of the provider constructing the component, such as its credentials, proxy, timeouts, retries and headers, except onBehalfOf, validateApiKey and requiredScopes.
+ */
+export class SubuserOnboarding extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:SubuserOnboarding';
+
+    /**
+     * Returns true if the given object is an instance of SubuserOnboarding.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SubuserOnboarding {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SubuserOnboarding.__pulumiType;
+    }
+
+    declare public /*out*/ readonly apiKeyId: pulumi.Output<string>;
+    declare public /*out*/ readonly apiKeyValue: pulumi.Output<string>;
+    declare public /*out*/ readonly userId: pulumi.Output<number>;
+    declare public readonly username: pulumi.Output<string>;
+
+    /**
+     * Create a SubuserOnboarding resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SubuserOnboardingArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.email === undefined && !opts.urn) {
+                throw new Error("Missing required property 'email'");
+            }
+            if (args?.password === undefined && !opts.urn) {
+                throw new Error("Missing required property 'password'");
+            }
+            if (args?.username === undefined && !opts.urn) {
+                throw new Error("Missing required property 'username'");
+            }
+            resourceInputs["apiKeyName"] = args?.apiKeyName;
+            resourceInputs["apiKeyScopes"] = args?.apiKeyScopes;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["domainId"] = args?.domainId;
+            resourceInputs["email"] = args?.email;
+            resourceInputs["ips"] = args?.ips;
+            resourceInputs["linkId"] = args?.linkId;
+            resourceInputs["password"] = args?.password ? pulumi.secret(args.password) : undefined;
+            resourceInputs["region"] = args?.region;
+            resourceInputs["username"] = args?.username;
+            resourceInputs["apiKeyId"] = undefined /*out*/;
+            resourceInputs["apiKeyValue"] = undefined /*out*/;
+            resourceInputs["userId"] = undefined /*out*/;
+        } else {
+            resourceInputs["apiKeyId"] = undefined /*out*/;
+            resourceInputs["apiKeyValue"] = undefined /*out*/;
+            resourceInputs["userId"] = undefined /*out*/;
+            resourceInputs["username"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["apiKeyValue"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(SubuserOnboarding.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SubuserOnboarding resource.
+ */
+export interface SubuserOnboardingArgs {
+    apiKeyName?: pulumi.Input<string>;
+    apiKeyScopes?: pulumi.Input<string[]>;
+    deletionProtection?: pulumi.Input<boolean>;
+    domainId?: pulumi.Input<number>;
+    email: pulumi.Input<string>;
+    ips?: pulumi.Input<string[]>;
+    linkId?: pulumi.Input<number>;
+    password: pulumi.Input<string>;
+    region?: pulumi.Input<string>;
+    username: pulumi.Input<string>;
+}
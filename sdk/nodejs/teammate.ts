@@ -38,6 +38,7 @@ export class Teammate extends pulumi.CustomResource {
         return obj['__pulumiType'] === Teammate.__pulumiType;
     }
 
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly email: pulumi.Output<string>;
     declare public /*out*/ readonly firstName: pulumi.Output<string | undefined>;
     declare public readonly isAdmin: pulumi.Output<boolean>;
@@ -61,6 +62,7 @@ export class Teammate extends pulumi.CustomResource {
             if (args?.email === undefined && !opts.urn) {
                 throw new Error("Missing required property 'email'");
             }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["email"] = args?.email;
             resourceInputs["isAdmin"] = args?.isAdmin;
             resourceInputs["scopes"] = args?.scopes;
@@ -70,6 +72,7 @@ export class Teammate extends pulumi.CustomResource {
             resourceInputs["userType"] = undefined /*out*/;
             resourceInputs["username"] = undefined /*out*/;
         } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["email"] = undefined /*out*/;
             resourceInputs["firstName"] = undefined /*out*/;
             resourceInputs["isAdmin"] = undefined /*out*/;
@@ -80,6 +83,8 @@ export class Teammate extends pulumi.CustomResource {
             resourceInputs["username"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["token"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(Teammate.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -88,6 +93,7 @@ export class Teammate extends pulumi.CustomResource {
  * The set of arguments for constructing a Teammate resource.
  */
 export interface TeammateArgs {
+    deletionProtection?: pulumi.Input<boolean>;
     email: pulumi.Input<string>;
     isAdmin?: pulumi.Input<boolean>;
     scopes?: pulumi.Input<pulumi.Input<string>[]>;
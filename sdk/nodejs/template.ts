@@ -13,7 +13,7 @@ import * as utilities from "./utilities";
  *
  * Templates can be either 'legacy' (plain text/HTML) or 'dynamic' (supporting handlebars syntax for personalization).
  *
- * **Note:** Template versions are managed separately via the TemplateVersion resource.
+ * **Note:** Template versions are managed separately via the TemplateVersion resource. Deleting a template that still has versions, e.g. ones created outside of Pulumi, fails unless forceDelete is set.
  */
 export class Template extends pulumi.CustomResource {
     /**
@@ -42,8 +42,10 @@ export class Template extends pulumi.CustomResource {
         return obj['__pulumiType'] === Template.__pulumiType;
     }
 
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
+    declare public readonly forceDelete: pulumi.Output<boolean | undefined>;
     declare public readonly generation: pulumi.Output<string>;
-    declare public readonly name: pulumi.Output<string>;
+    declare public readonly name: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly templateId: pulumi.Output<string>;
     declare public /*out*/ readonly updatedAt: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly versions: pulumi.Output<outputs.TemplateVersionSummary[] | undefined>;
@@ -62,15 +64,16 @@ export class Template extends pulumi.CustomResource {
             if (args?.generation === undefined && !opts.urn) {
                 throw new Error("Missing required property 'generation'");
             }
-            if (args?.name === undefined && !opts.urn) {
-                throw new Error("Missing required property 'name'");
-            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["forceDelete"] = args?.forceDelete;
             resourceInputs["generation"] = args?.generation;
             resourceInputs["name"] = args?.name;
             resourceInputs["templateId"] = undefined /*out*/;
             resourceInputs["updatedAt"] = undefined /*out*/;
             resourceInputs["versions"] = undefined /*out*/;
         } else {
+            resourceInputs["deletionProtection"] = undefined /*out*/;
+            resourceInputs["forceDelete"] = undefined /*out*/;
             resourceInputs["generation"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["templateId"] = undefined /*out*/;
@@ -78,6 +81,8 @@ export class Template extends pulumi.CustomResource {
             resourceInputs["versions"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["generation"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(Template.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -86,6 +91,8 @@ export class Template extends pulumi.CustomResource {
  * The set of arguments for constructing a Template resource.
  */
 export interface TemplateArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    forceDelete?: pulumi.Input<boolean>;
     generation: pulumi.Input<string>;
-    name: pulumi.Input<string>;
+    name?: pulumi.Input<string>;
 }
@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Syncs a directory of HTML files to versions of a SendGrid template.
+ *
+ * Each .html file in the directory becomes a TemplateVersion named after the file without its extension. Files are ordered by name, and the last one is the newest and is made the active version, so names should sort by age, e.g. with a date or version prefix. A version is only updated when the content of its file changes; adding a file adds a version and removing one deletes its version.
+ */
+export class TemplateDirectory extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:TemplateDirectory';
+
+    /**
+     * Returns true if the given object is an instance of TemplateDirectory.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is TemplateDirectory {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === TemplateDirectory.__pulumiType;
+    }
+
+    declare public /*out*/ readonly activeVersionId: pulumi.Output<string>;
+    declare public /*out*/ readonly contentHashes: pulumi.Output<{[key: string]: string}>;
+    declare public /*out*/ readonly versionIds: pulumi.Output<{[key: string]: string}>;
+
+    /**
+     * Create a TemplateDirectory resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: TemplateDirectoryArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.directory === undefined && !opts.urn) {
+                throw new Error("Missing required property 'directory'");
+            }
+            if (args?.templateId === undefined && !opts.urn) {
+                throw new Error("Missing required property 'templateId'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["directory"] = args?.directory;
+            resourceInputs["generatePlainContent"] = args?.generatePlainContent;
+            resourceInputs["subject"] = args?.subject;
+            resourceInputs["templateId"] = args?.templateId;
+            resourceInputs["activeVersionId"] = undefined /*out*/;
+            resourceInputs["contentHashes"] = undefined /*out*/;
+            resourceInputs["versionIds"] = undefined /*out*/;
+        } else {
+            resourceInputs["activeVersionId"] = undefined /*out*/;
+            resourceInputs["contentHashes"] = undefined /*out*/;
+            resourceInputs["versionIds"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(TemplateDirectory.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a TemplateDirectory resource.
+ */
+export interface TemplateDirectoryArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    directory: string;
+    generatePlainContent?: pulumi.Input<boolean>;
+    subject?: pulumi.Input<string>;
+    templateId: pulumi.Input<string>;
+}
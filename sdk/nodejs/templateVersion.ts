@@ -11,6 +11,8 @@ import * as utilities from "./utilities";
  *
  * Each template can have multiple versions, but only one can be active at a time. The active version is used when sending emails through the template.
  *
+ * Activating a version deactivates the one that was active. When that happens to a version with active set to 1, refresh records it as inactive with the deactivatedBy output, and it is not activated again until active is changed, so two versions set active do not take turns.
+ *
  * **Note:** Dynamic templates support handlebars syntax for personalization.
  */
 export class TemplateVersion extends pulumi.CustomResource {
@@ -41,11 +43,14 @@ export class TemplateVersion extends pulumi.CustomResource {
     }
 
     declare public readonly active: pulumi.Output<number | undefined>;
+    declare public /*out*/ readonly deactivatedBy: pulumi.Output<string | undefined>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly editor: pulumi.Output<string | undefined>;
     declare public readonly generatePlainContent: pulumi.Output<boolean | undefined>;
     declare public readonly htmlContent: pulumi.Output<string | undefined>;
     declare public readonly name: pulumi.Output<string>;
     declare public readonly plainContent: pulumi.Output<string | undefined>;
+    declare public readonly renderPreview: pulumi.Output<boolean | undefined>;
     declare public readonly subject: pulumi.Output<string | undefined>;
     declare public readonly templateId: pulumi.Output<string>;
     declare public readonly testData: pulumi.Output<string | undefined>;
@@ -71,24 +76,30 @@ export class TemplateVersion extends pulumi.CustomResource {
                 throw new Error("Missing required property 'templateId'");
             }
             resourceInputs["active"] = args?.active;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["editor"] = args?.editor;
             resourceInputs["generatePlainContent"] = args?.generatePlainContent;
             resourceInputs["htmlContent"] = args?.htmlContent;
             resourceInputs["name"] = args?.name;
             resourceInputs["plainContent"] = args?.plainContent;
+            resourceInputs["renderPreview"] = args?.renderPreview;
             resourceInputs["subject"] = args?.subject;
             resourceInputs["templateId"] = args?.templateId;
             resourceInputs["testData"] = args?.testData;
+            resourceInputs["deactivatedBy"] = undefined /*out*/;
             resourceInputs["thumbnailUrl"] = undefined /*out*/;
             resourceInputs["updatedAt"] = undefined /*out*/;
             resourceInputs["versionId"] = undefined /*out*/;
         } else {
             resourceInputs["active"] = undefined /*out*/;
+            resourceInputs["deactivatedBy"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["editor"] = undefined /*out*/;
             resourceInputs["generatePlainContent"] = undefined /*out*/;
             resourceInputs["htmlContent"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["plainContent"] = undefined /*out*/;
+            resourceInputs["renderPreview"] = undefined /*out*/;
             resourceInputs["subject"] = undefined /*out*/;
             resourceInputs["templateId"] = undefined /*out*/;
             resourceInputs["testData"] = undefined /*out*/;
@@ -97,6 +108,8 @@ export class TemplateVersion extends pulumi.CustomResource {
             resourceInputs["versionId"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["templateId"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(TemplateVersion.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -106,11 +119,13 @@ export class TemplateVersion extends pulumi.CustomResource {
  */
 export interface TemplateVersionArgs {
     active?: pulumi.Input<number>;
+    deletionProtection?: pulumi.Input<boolean>;
     editor?: pulumi.Input<string>;
     generatePlainContent?: pulumi.Input<boolean>;
     htmlContent?: pulumi.Input<string>;
     name: pulumi.Input<string>;
     plainContent?: pulumi.Input<string>;
+    renderPreview?: pulumi.Input<boolean>;
     subject?: pulumi.Input<string>;
     templateId: pulumi.Input<string>;
     testData?: pulumi.Input<string>;
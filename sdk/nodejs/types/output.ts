@@ -5,6 +5,42 @@ import * as pulumi from "@pulumi/pulumi";
 import * as inputs from "../types/input";
 import * as outputs from "../types/output";
 
+export interface AlertSummary {
+    alertId: number;
+    createdAt: number;
+    emailTo: string;
+    frequency?: string;
+    percentage?: number;
+    type: string;
+    updatedAt: number;
+}
+
+export interface BlockEntry {
+    created: number;
+    email: string;
+    reason: string;
+    status: string;
+}
+
+export interface BounceEntry {
+    created: number;
+    email: string;
+    reason: string;
+    status: string;
+}
+
+export interface ClickTrackingSetting {
+    enableText: boolean;
+    enabled: boolean;
+}
+
+export interface DNSProviderRecord {
+    name: string;
+    ttl: number;
+    type: string;
+    value: string;
+}
+
 export interface DNSRecord {
     data: string;
     host: string;
@@ -12,6 +48,90 @@ export interface DNSRecord {
     valid: boolean;
 }
 
+export interface DesignSummary {
+    categories: string[];
+    createdAt: string;
+    designId: string;
+    editor: string;
+    name: string;
+    subject: string;
+    thumbnailUrl: string;
+    updatedAt: string;
+}
+
+export interface DomainDNSRecord {
+    data: string;
+    host: string;
+    name: string;
+    type: string;
+    valid: boolean;
+}
+
+export interface EmailActivityMessage {
+    clicksCount: number;
+    fromEmail: string;
+    lastEventTime: string;
+    msgId: string;
+    opensCount: number;
+    status: string;
+    subject: string;
+    toEmail: string;
+}
+
+export interface EmailValidationChecks {
+    hasKnownBounces: boolean;
+    hasMxOrARecord: boolean;
+    hasSuspectedBounces: boolean;
+    hasValidAddressSyntax: boolean;
+    isSuspectedDisposableAddress: boolean;
+    isSuspectedRoleAddress: boolean;
+}
+
+export interface EventWebhookSummary {
+    bounce: boolean;
+    click: boolean;
+    deferred: boolean;
+    delivered: boolean;
+    dropped: boolean;
+    enabled: boolean;
+    friendlyName?: string;
+    groupResubscribe: boolean;
+    groupUnsubscribe: boolean;
+    open: boolean;
+    processed: boolean;
+    spamReport: boolean;
+    unsubscribe: boolean;
+    url: string;
+    webhookId: string;
+}
+
+export interface GlobalSuppressionEntry {
+    created: number;
+    email: string;
+}
+
+export interface GoogleAnalyticsTrackingSetting {
+    enabled: boolean;
+    utmCampaign: string;
+    utmContent: string;
+    utmMedium: string;
+    utmSource: string;
+    utmTerm: string;
+}
+
+export interface InboundParseSetting {
+    hostname: string;
+    sendRaw: boolean;
+    spamCheck: boolean;
+    url: string;
+}
+
+export interface InvalidEmailEntry {
+    created: number;
+    email: string;
+    reason: string;
+}
+
 export interface LinkBrandingDNSRecord {
     data: string;
     host: string;
@@ -19,6 +139,103 @@ export interface LinkBrandingDNSRecord {
     valid: boolean;
 }
 
+export interface MailSetting {
+    description: string;
+    enabled: boolean;
+    name: string;
+    title: string;
+}
+
+export interface MarketingListSummary {
+    contactCount: number;
+    listId: string;
+    name: string;
+}
+
+export interface MarketingSegmentSummary {
+    contactsCount: number;
+    createdAt: string;
+    name: string;
+    parentListIds: string[];
+    queryDsl: string;
+    segmentId: string;
+    updatedAt: string;
+}
+
+export interface OpenTrackingSetting {
+    enabled: boolean;
+}
+
+export interface SpamReportEntry {
+    created: number;
+    email: string;
+    ip: string;
+}
+
+export interface SsoCertificate {
+    certificateId: number;
+    integrationId: string;
+    notAfter: number;
+    notBefore: number;
+    publicCertificate: string;
+}
+
+export interface SsoIntegration {
+    audienceUrl: string;
+    completed: boolean;
+    enabled: boolean;
+    entityId: string;
+    integrationId: string;
+    name: string;
+    signinUrl: string;
+    signoutUrl: string;
+    singleSignonUrl: string;
+}
+
+export interface StatsEntry {
+    date: string;
+    stats: outputs.StatsMetricsEntry[];
+}
+
+export interface StatsMetrics {
+    blocks: number;
+    bounceDrops: number;
+    bounces: number;
+    clicks: number;
+    deferred: number;
+    delivered: number;
+    invalidEmails: number;
+    opens: number;
+    processed: number;
+    requests: number;
+    spamReportDrops: number;
+    spamReports: number;
+    uniqueClicks: number;
+    uniqueOpens: number;
+    unsubscribeDrops: number;
+    unsubscribes: number;
+}
+
+export interface StatsMetricsEntry {
+    metrics: outputs.StatsMetrics;
+    name?: string;
+    type?: string;
+}
+
+export interface SubscriptionTrackingSetting {
+    enabled: boolean;
+    htmlContent: string;
+    landing: string;
+    plainContent: string;
+    replace: string;
+    url: string;
+}
+
+export interface SubuserReputation {
+    reputation: number;
+    username: string;
+}
+
 export interface TemplateVersionSummary {
     active: boolean;
     id: string;
@@ -10,6 +10,10 @@ import * as utilities from "./utilities";
  * Unsubscribe groups allow recipients to opt out of specific types of emails while still receiving others. For example, you might have separate groups for marketing, newsletters, and product updates, allowing users to choose which types of emails they want to receive.
  *
  * When a recipient unsubscribes from a group, they will no longer receive emails that are associated with that group.
+ *
+ * Deleting the account's default group fails, so emails sent without a group keep an unsubscribe option. Make another group the default first, e.g. by creating one with isDefault set to true.
+ *
+ * When SendGrid refuses to delete a group because it still has suppressions, the delete fails unless forceDelete is set, in which case the suppressions are removed and the delete retried.
  */
 export class UnsubscribeGroup extends pulumi.CustomResource {
     /**
@@ -38,10 +42,13 @@ export class UnsubscribeGroup extends pulumi.CustomResource {
         return obj['__pulumiType'] === UnsubscribeGroup.__pulumiType;
     }
 
+    declare public readonly adoptExisting: pulumi.Output<boolean | undefined>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly description: pulumi.Output<string | undefined>;
+    declare public readonly forceDelete: pulumi.Output<boolean | undefined>;
     declare public /*out*/ readonly groupId: pulumi.Output<number>;
     declare public readonly isDefault: pulumi.Output<boolean | undefined>;
-    declare public readonly name: pulumi.Output<string>;
+    declare public readonly name: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly unsubscribes: pulumi.Output<number>;
 
     /**
@@ -51,20 +58,23 @@ export class UnsubscribeGroup extends pulumi.CustomResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: UnsubscribeGroupArgs, opts?: pulumi.CustomResourceOptions) {
+    constructor(name: string, args?: UnsubscribeGroupArgs, opts?: pulumi.CustomResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            if (args?.name === undefined && !opts.urn) {
-                throw new Error("Missing required property 'name'");
-            }
+            resourceInputs["adoptExisting"] = args?.adoptExisting;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["description"] = args?.description;
+            resourceInputs["forceDelete"] = args?.forceDelete;
             resourceInputs["isDefault"] = args?.isDefault;
             resourceInputs["name"] = args?.name;
             resourceInputs["groupId"] = undefined /*out*/;
             resourceInputs["unsubscribes"] = undefined /*out*/;
         } else {
+            resourceInputs["adoptExisting"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["description"] = undefined /*out*/;
+            resourceInputs["forceDelete"] = undefined /*out*/;
             resourceInputs["groupId"] = undefined /*out*/;
             resourceInputs["isDefault"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
@@ -79,7 +89,10 @@ export class UnsubscribeGroup extends pulumi.CustomResource {
  * The set of arguments for constructing a UnsubscribeGroup resource.
  */
 export interface UnsubscribeGroupArgs {
+    adoptExisting?: pulumi.Input<boolean>;
+    deletionProtection?: pulumi.Input<boolean>;
     description?: pulumi.Input<string>;
+    forceDelete?: pulumi.Input<boolean>;
     isDefault?: pulumi.Input<boolean>;
-    name: pulumi.Input<string>;
+    name?: pulumi.Input<string>;
 }
@@ -0,0 +1,55 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Validates an email address with the SendGrid Email Address Validation API.
+ *
+ * Returns a verdict, a score and the checks behind them, so provisioning programs can vet alert recipients, teammate emails and verified sender addresses before creating resources.
+ *
+ * Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.
+ */
+export function validateEmail(args: ValidateEmailArgs, opts?: pulumi.InvokeOptions): Promise<ValidateEmailResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("sendgrid:index:validateEmail", {
+        "email": args.email,
+        "source": args.source,
+    }, opts);
+}
+
+export interface ValidateEmailArgs {
+    email: string;
+    source?: string;
+}
+
+export interface ValidateEmailResult {
+    readonly checks: outputs.EmailValidationChecks;
+    readonly host: string;
+    readonly local: string;
+    readonly score: number;
+    readonly suggestion?: string;
+    readonly verdict: string;
+}
+/**
+ * Validates an email address with the SendGrid Email Address Validation API.
+ *
+ * Returns a verdict, a score and the checks behind them, so provisioning programs can vet alert recipients, teammate emails and verified sender addresses before creating resources.
+ *
+ * Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.
+ */
+export function validateEmailOutput(args: ValidateEmailOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<ValidateEmailResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("sendgrid:index:validateEmail", {
+        "email": args.email,
+        "source": args.source,
+    }, opts);
+}
+
+export interface ValidateEmailOutputArgs {
+    email: pulumi.Input<string>;
+    source?: pulumi.Input<string>;
+}
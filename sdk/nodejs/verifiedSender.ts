@@ -42,15 +42,19 @@ export class VerifiedSender extends pulumi.CustomResource {
     declare public readonly address2: pulumi.Output<string | undefined>;
     declare public readonly city: pulumi.Output<string>;
     declare public readonly country: pulumi.Output<string>;
+    declare public readonly deletionProtection: pulumi.Output<boolean | undefined>;
     declare public readonly fromEmail: pulumi.Output<string>;
     declare public readonly fromName: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly locked: pulumi.Output<boolean>;
-    declare public readonly nickname: pulumi.Output<string>;
+    declare public readonly nickname: pulumi.Output<string | undefined>;
     declare public readonly replyTo: pulumi.Output<string>;
     declare public readonly replyToName: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly senderId: pulumi.Output<number>;
     declare public readonly state: pulumi.Output<string | undefined>;
     declare public /*out*/ readonly verified: pulumi.Output<boolean>;
+    declare public readonly waitForVerification: pulumi.Output<boolean | undefined>;
+    declare public readonly waitIntervalSeconds: pulumi.Output<number | undefined>;
+    declare public readonly waitTimeoutSeconds: pulumi.Output<number | undefined>;
     declare public readonly zip: pulumi.Output<string | undefined>;
 
     /**
@@ -76,9 +80,6 @@ export class VerifiedSender extends pulumi.CustomResource {
             if (args?.fromEmail === undefined && !opts.urn) {
                 throw new Error("Missing required property 'fromEmail'");
             }
-            if (args?.nickname === undefined && !opts.urn) {
-                throw new Error("Missing required property 'nickname'");
-            }
             if (args?.replyTo === undefined && !opts.urn) {
                 throw new Error("Missing required property 'replyTo'");
             }
@@ -86,12 +87,16 @@ export class VerifiedSender extends pulumi.CustomResource {
             resourceInputs["address2"] = args?.address2;
             resourceInputs["city"] = args?.city;
             resourceInputs["country"] = args?.country;
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
             resourceInputs["fromEmail"] = args?.fromEmail;
             resourceInputs["fromName"] = args?.fromName;
             resourceInputs["nickname"] = args?.nickname;
             resourceInputs["replyTo"] = args?.replyTo;
             resourceInputs["replyToName"] = args?.replyToName;
             resourceInputs["state"] = args?.state;
+            resourceInputs["waitForVerification"] = args?.waitForVerification;
+            resourceInputs["waitIntervalSeconds"] = args?.waitIntervalSeconds;
+            resourceInputs["waitTimeoutSeconds"] = args?.waitTimeoutSeconds;
             resourceInputs["zip"] = args?.zip;
             resourceInputs["locked"] = undefined /*out*/;
             resourceInputs["senderId"] = undefined /*out*/;
@@ -101,6 +106,7 @@ export class VerifiedSender extends pulumi.CustomResource {
             resourceInputs["address2"] = undefined /*out*/;
             resourceInputs["city"] = undefined /*out*/;
             resourceInputs["country"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["fromEmail"] = undefined /*out*/;
             resourceInputs["fromName"] = undefined /*out*/;
             resourceInputs["locked"] = undefined /*out*/;
@@ -110,9 +116,14 @@ export class VerifiedSender extends pulumi.CustomResource {
             resourceInputs["senderId"] = undefined /*out*/;
             resourceInputs["state"] = undefined /*out*/;
             resourceInputs["verified"] = undefined /*out*/;
+            resourceInputs["waitForVerification"] = undefined /*out*/;
+            resourceInputs["waitIntervalSeconds"] = undefined /*out*/;
+            resourceInputs["waitTimeoutSeconds"] = undefined /*out*/;
             resourceInputs["zip"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["fromEmail"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(VerifiedSender.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -125,11 +136,15 @@ export interface VerifiedSenderArgs {
     address2?: pulumi.Input<string>;
     city: pulumi.Input<string>;
     country: pulumi.Input<string>;
+    deletionProtection?: pulumi.Input<boolean>;
     fromEmail: pulumi.Input<string>;
     fromName?: pulumi.Input<string>;
-    nickname: pulumi.Input<string>;
+    nickname?: pulumi.Input<string>;
     replyTo: pulumi.Input<string>;
     replyToName?: pulumi.Input<string>;
     state?: pulumi.Input<string>;
+    waitForVerification?: pulumi.Input<boolean>;
+    waitIntervalSeconds?: pulumi.Input<number>;
+    waitTimeoutSeconds?: pulumi.Input<number>;
     zip?: pulumi.Input<string>;
 }
@@ -0,0 +1,80 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Sets up a SendGrid Event Webhook for a receiving service.
+ *
+ * The component creates an EventWebhook posting the given events, with optional OAuth settings, enables its signature verification and sends test events once it is set up, which the service's logs show it received. The webhookId and publicKey outputs are what the receiving service needs to verify events.
+ */
+export class WebhookEndpoint extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'sendgrid:index:WebhookEndpoint';
+
+    /**
+     * Returns true if the given object is an instance of WebhookEndpoint.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is WebhookEndpoint {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === WebhookEndpoint.__pulumiType;
+    }
+
+    declare public /*out*/ readonly publicKey: pulumi.Output<string>;
+    declare public /*out*/ readonly webhookId: pulumi.Output<string>;
+
+    /**
+     * Create a WebhookEndpoint resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: WebhookEndpointArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.events === undefined && !opts.urn) {
+                throw new Error("Missing required property 'events'");
+            }
+            if (args?.url === undefined && !opts.urn) {
+                throw new Error("Missing required property 'url'");
+            }
+            resourceInputs["deletionProtection"] = args?.deletionProtection;
+            resourceInputs["events"] = args?.events;
+            resourceInputs["friendlyName"] = args?.friendlyName;
+            resourceInputs["oauthClientId"] = args?.oauthClientId;
+            resourceInputs["oauthClientSecret"] = args?.oauthClientSecret ? pulumi.secret(args.oauthClientSecret) : undefined;
+            resourceInputs["oauthTokenUrl"] = args?.oauthTokenUrl;
+            resourceInputs["signatureVerification"] = args?.signatureVerification;
+            resourceInputs["testDelivery"] = args?.testDelivery;
+            resourceInputs["url"] = args?.url;
+            resourceInputs["publicKey"] = undefined /*out*/;
+            resourceInputs["webhookId"] = undefined /*out*/;
+        } else {
+            resourceInputs["publicKey"] = undefined /*out*/;
+            resourceInputs["webhookId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(WebhookEndpoint.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a WebhookEndpoint resource.
+ */
+export interface WebhookEndpointArgs {
+    deletionProtection?: pulumi.Input<boolean>;
+    events: pulumi.Input<string[]>;
+    friendlyName?: pulumi.Input<string>;
+    oauthClientId?: pulumi.Input<string>;
+    oauthClientSecret?: pulumi.Input<string>;
+    oauthTokenUrl?: pulumi.Input<string>;
+    signatureVerification?: boolean;
+    testDelivery?: boolean;
+    url: pulumi.Input<string>;
+}
@@ -6,20 +6,65 @@ import builtins as _builtins
 from . import _utilities
 import typing
 # Export this package's modules as members:
+from .account_email import *
+from .account_password import *
+from .account_username import *
 from .alert import *
 from .api_key import *
+from .authenticated_domain import *
+from .contact_db_list import *
+from .contact_db_recipient import *
 from .domain_authentication import *
 from .event_webhook import *
+from .event_webhook_signature import *
+from .event_webhook_test_delivery import *
+from .generate_batch_id import *
+from .get_account_profile import *
+from .get_alerts import *
+from .get_blocks import *
+from .get_bounces import *
+from .get_categories import *
+from .get_category_stats import *
+from .get_current_key_info import *
+from .get_designs import *
+from .get_event_webhooks import *
+from .get_global_suppressions import *
+from .get_group_suppressions import *
+from .get_inbound_parse_settings import *
+from .get_invalid_emails import *
+from .get_mail_settings import *
+from .get_marketing_lists import *
+from .get_marketing_segments import *
+from .get_reverse_dns import *
+from .get_spam_reports import *
+from .get_sso_certificates import *
+from .get_sso_integrations import *
+from .get_stats import *
+from .get_subuser_reputation import *
+from .get_subuser_stats import *
+from .get_tracking_settings import *
+from .get_usage import *
 from .global_suppression import *
 from .ip_pool import *
+from .legacy_template_mail_setting import *
 from .link_branding import *
+from .new_relic_partner_setting import *
 from .provider import *
+from .scheduled_send import *
+from .search_email_activity import *
+from .send_test_email import *
 from .subuser import *
+from .subuser_domain_association import *
+from .subuser_link_association import *
+from .subuser_onboarding import *
 from .teammate import *
 from .template import *
+from .template_directory import *
 from .template_version import *
 from .unsubscribe_group import *
+from .validate_email import *
 from .verified_sender import *
+from .webhook_endpoint import *
 from . import outputs
 
 # Make subpackages available:
@@ -37,19 +82,35 @@ _utilities.register(
   "mod": "index",
   "fqn": "pulumi_sendgrid",
   "classes": {
+   "sendgrid:index:AccountEmail": "AccountEmail",
+   "sendgrid:index:AccountPassword": "AccountPassword",
+   "sendgrid:index:AccountUsername": "AccountUsername",
    "sendgrid:index:Alert": "Alert",
    "sendgrid:index:ApiKey": "ApiKey",
+   "sendgrid:index:AuthenticatedDomain": "AuthenticatedDomain",
+   "sendgrid:index:ContactDbList": "ContactDbList",
+   "sendgrid:index:ContactDbRecipient": "ContactDbRecipient",
    "sendgrid:index:DomainAuthentication": "DomainAuthentication",
    "sendgrid:index:EventWebhook": "EventWebhook",
+   "sendgrid:index:EventWebhookSignature": "EventWebhookSignature",
+   "sendgrid:index:EventWebhookTestDelivery": "EventWebhookTestDelivery",
    "sendgrid:index:GlobalSuppression": "GlobalSuppression",
    "sendgrid:index:IpPool": "IpPool",
+   "sendgrid:index:LegacyTemplateMailSetting": "LegacyTemplateMailSetting",
    "sendgrid:index:LinkBranding": "LinkBranding",
+   "sendgrid:index:NewRelicPartnerSetting": "NewRelicPartnerSetting",
+   "sendgrid:index:ScheduledSend": "ScheduledSend",
    "sendgrid:index:Subuser": "Subuser",
+   "sendgrid:index:SubuserDomainAssociation": "SubuserDomainAssociation",
+   "sendgrid:index:SubuserLinkAssociation": "SubuserLinkAssociation",
+   "sendgrid:index:SubuserOnboarding": "SubuserOnboarding",
    "sendgrid:index:Teammate": "Teammate",
    "sendgrid:index:Template": "Template",
+   "sendgrid:index:TemplateDirectory": "TemplateDirectory",
    "sendgrid:index:TemplateVersion": "TemplateVersion",
    "sendgrid:index:UnsubscribeGroup": "UnsubscribeGroup",
-   "sendgrid:index:VerifiedSender": "VerifiedSender"
+   "sendgrid:index:VerifiedSender": "VerifiedSender",
+   "sendgrid:index:WebhookEndpoint": "WebhookEndpoint"
   }
  }
 ]
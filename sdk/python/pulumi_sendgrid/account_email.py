@@ -0,0 +1,122 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['AccountEmailArgs', 'AccountEmail']
+
+@pulumi.input_type
+class AccountEmailArgs:
+    def __init__(__self__, *,
+                 email: pulumi.Input[_builtins.str]):
+        """
+        The set of arguments for constructing a AccountEmail resource.
+        """
+        pulumi.set(__self__, "email", email)
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "email")
+
+    @email.setter
+    def email(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "email", value)
+
+
+@pulumi.type_token("sendgrid:index:AccountEmail")
+class AccountEmail(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 email: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Manages the contact email address of the SendGrid account.
+
+        Sets the email address of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get the right contact address. There is one address per account, so only one of these resources should exist per provider. Deleting the resource leaves the address unchanged, as an account always has one.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AccountEmailArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Manages the contact email address of the SendGrid account.
+
+        Sets the email address of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get the right contact address. There is one address per account, so only one of these resources should exist per provider. Deleting the resource leaves the address unchanged, as an account always has one.
+
+        :param str resource_name: The name of the resource.
+        :param AccountEmailArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AccountEmailArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 email: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AccountEmailArgs.__new__(AccountEmailArgs)
+
+            if email is None and not opts.urn:
+                raise TypeError("Missing required property 'email'")
+            __props__.__dict__["email"] = email
+        super(AccountEmail, __self__).__init__(
+            'sendgrid:index:AccountEmail',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'AccountEmail':
+        """
+        Get an existing AccountEmail resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = AccountEmailArgs.__new__(AccountEmailArgs)
+
+        __props__.__dict__["email"] = None
+        return AccountEmail(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "email")
+
@@ -0,0 +1,171 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['AccountPasswordArgs', 'AccountPassword']
+
+@pulumi.input_type
+class AccountPasswordArgs:
+    def __init__(__self__, *,
+                 new_password: pulumi.Input[_builtins.str],
+                 old_password: pulumi.Input[_builtins.str],
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None):
+        """
+        The set of arguments for constructing a AccountPassword resource.
+        """
+        pulumi.set(__self__, "new_password", new_password)
+        pulumi.set(__self__, "old_password", old_password)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @_builtins.property
+    @pulumi.getter(name="newPassword")
+    def new_password(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "new_password")
+
+    @new_password.setter
+    def new_password(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "new_password", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oldPassword")
+    def old_password(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "old_password")
+
+    @old_password.setter
+    def old_password(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "old_password", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "triggers", value)
+
+
+@pulumi.type_token("sendgrid:index:AccountPassword")
+class AccountPassword(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 new_password: Optional[pulumi.Input[_builtins.str]] = None,
+                 old_password: Optional[pulumi.Input[_builtins.str]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 __props__=None):
+        """
+        Rotates the password of the SendGrid account.
+
+        Creating the resource changes the password from oldPassword to newPassword. Each later change of newPassword rotates it again, using the previous newPassword as the old one, so account credentials can be rotated alongside API keys. Changing triggers without changing newPassword is an error.
+
+        SendGrid never returns passwords, so refresh cannot detect a password changed elsewhere, and deleting the resource leaves the current password in place.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AccountPasswordArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Rotates the password of the SendGrid account.
+
+        Creating the resource changes the password from oldPassword to newPassword. Each later change of newPassword rotates it again, using the previous newPassword as the old one, so account credentials can be rotated alongside API keys. Changing triggers without changing newPassword is an error.
+
+        SendGrid never returns passwords, so refresh cannot detect a password changed elsewhere, and deleting the resource leaves the current password in place.
+
+        :param str resource_name: The name of the resource.
+        :param AccountPasswordArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AccountPasswordArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 new_password: Optional[pulumi.Input[_builtins.str]] = None,
+                 old_password: Optional[pulumi.Input[_builtins.str]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AccountPasswordArgs.__new__(AccountPasswordArgs)
+
+            if new_password is None and not opts.urn:
+                raise TypeError("Missing required property 'new_password'")
+            __props__.__dict__["new_password"] = None if new_password is None else pulumi.Output.secret(new_password)
+            if old_password is None and not opts.urn:
+                raise TypeError("Missing required property 'old_password'")
+            __props__.__dict__["old_password"] = None if old_password is None else pulumi.Output.secret(old_password)
+            __props__.__dict__["triggers"] = triggers
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["newPassword", "oldPassword"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(AccountPassword, __self__).__init__(
+            'sendgrid:index:AccountPassword',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'AccountPassword':
+        """
+        Get an existing AccountPassword resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = AccountPasswordArgs.__new__(AccountPasswordArgs)
+
+        __props__.__dict__["new_password"] = None
+        __props__.__dict__["old_password"] = None
+        __props__.__dict__["triggers"] = None
+        return AccountPassword(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="newPassword")
+    def new_password(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "new_password")
+
+    @_builtins.property
+    @pulumi.getter(name="oldPassword")
+    def old_password(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "old_password")
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        return pulumi.get(self, "triggers")
+
@@ -0,0 +1,129 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['AccountUsernameArgs', 'AccountUsername']
+
+@pulumi.input_type
+class AccountUsernameArgs:
+    def __init__(__self__, *,
+                 username: pulumi.Input[_builtins.str]):
+        """
+        The set of arguments for constructing a AccountUsername resource.
+        """
+        pulumi.set(__self__, "username", username)
+
+    @_builtins.property
+    @pulumi.getter
+    def username(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "username")
+
+    @username.setter
+    def username(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "username", value)
+
+
+@pulumi.type_token("sendgrid:index:AccountUsername")
+class AccountUsername(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 username: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Manages the username of the SendGrid account.
+
+        Sets the username of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get deterministic usernames. With validateOnPreview, previews fail when a subuser of the account already has the username. There is one username per account, so only one of these resources should exist per provider. Deleting the resource leaves the username unchanged, as an account always has one.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AccountUsernameArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Manages the username of the SendGrid account.
+
+        Sets the username of the account the provider authenticates as, or of the subuser given by onBehalfOf, so accounts provisioned programmatically get deterministic usernames. With validateOnPreview, previews fail when a subuser of the account already has the username. There is one username per account, so only one of these resources should exist per provider. Deleting the resource leaves the username unchanged, as an account always has one.
+
+        :param str resource_name: The name of the resource.
+        :param AccountUsernameArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AccountUsernameArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 username: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AccountUsernameArgs.__new__(AccountUsernameArgs)
+
+            if username is None and not opts.urn:
+                raise TypeError("Missing required property 'username'")
+            __props__.__dict__["username"] = username
+            __props__.__dict__["user_id"] = None
+        super(AccountUsername, __self__).__init__(
+            'sendgrid:index:AccountUsername',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'AccountUsername':
+        """
+        Get an existing AccountUsername resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = AccountUsernameArgs.__new__(AccountUsernameArgs)
+
+        __props__.__dict__["user_id"] = None
+        __props__.__dict__["username"] = None
+        return AccountUsername(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="userId")
+    def user_id(self) -> pulumi.Output[_builtins.int]:
+        return pulumi.get(self, "user_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def username(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "username")
+
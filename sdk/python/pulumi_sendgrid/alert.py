@@ -21,6 +21,8 @@ class AlertArgs:
     def __init__(__self__, *,
                  email_to: pulumi.Input[_builtins.str],
                  type: pulumi.Input[_builtins.str],
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  frequency: Optional[pulumi.Input[_builtins.str]] = None,
                  percentage: Optional[pulumi.Input[_builtins.int]] = None):
         """
@@ -28,6 +30,10 @@ class AlertArgs:
         """
         pulumi.set(__self__, "email_to", email_to)
         pulumi.set(__self__, "type", type)
+        if adopt_existing is not None:
+            pulumi.set(__self__, "adopt_existing", adopt_existing)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if frequency is not None:
             pulumi.set(__self__, "frequency", frequency)
         if percentage is not None:
@@ -51,6 +57,24 @@ class AlertArgs:
     def type(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "type", value)
 
+    @_builtins.property
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
+
+    @adopt_existing.setter
+    def adopt_existing(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "adopt_existing", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter
     def frequency(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -76,6 +100,8 @@ class Alert(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  email_to: Optional[pulumi.Input[_builtins.str]] = None,
                  frequency: Optional[pulumi.Input[_builtins.str]] = None,
                  percentage: Optional[pulumi.Input[_builtins.int]] = None,
@@ -91,6 +117,8 @@ class Alert(pulumi.CustomResource):
 
         You can create multiple alerts of the same type with different email recipients.
 
+        SendGrid does not reject duplicate alerts, so with adoptExisting an identical alert is looked up before creating one, and a retried deployment takes it over instead of creating a second alert.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
         """
@@ -110,6 +138,8 @@ class Alert(pulumi.CustomResource):
 
         You can create multiple alerts of the same type with different email recipients.
 
+        SendGrid does not reject duplicate alerts, so with adoptExisting an identical alert is looked up before creating one, and a retried deployment takes it over instead of creating a second alert.
+
         :param str resource_name: The name of the resource.
         :param AlertArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -125,6 +155,8 @@ class Alert(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  email_to: Optional[pulumi.Input[_builtins.str]] = None,
                  frequency: Optional[pulumi.Input[_builtins.str]] = None,
                  percentage: Optional[pulumi.Input[_builtins.int]] = None,
@@ -138,6 +170,8 @@ class Alert(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = AlertArgs.__new__(AlertArgs)
 
+            __props__.__dict__["adopt_existing"] = adopt_existing
+            __props__.__dict__["deletion_protection"] = deletion_protection
             if email_to is None and not opts.urn:
                 raise TypeError("Missing required property 'email_to'")
             __props__.__dict__["email_to"] = email_to
@@ -149,6 +183,8 @@ class Alert(pulumi.CustomResource):
             __props__.__dict__["alert_id"] = None
             __props__.__dict__["created_at"] = None
             __props__.__dict__["updated_at"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["type"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(Alert, __self__).__init__(
             'sendgrid:index:Alert',
             resource_name,
@@ -171,8 +207,10 @@ class Alert(pulumi.CustomResource):
 
         __props__ = AlertArgs.__new__(AlertArgs)
 
+        __props__.__dict__["adopt_existing"] = None
         __props__.__dict__["alert_id"] = None
         __props__.__dict__["created_at"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["email_to"] = None
         __props__.__dict__["frequency"] = None
         __props__.__dict__["percentage"] = None
@@ -180,6 +218,11 @@ class Alert(pulumi.CustomResource):
         __props__.__dict__["updated_at"] = None
         return Alert(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
+
     @_builtins.property
     @pulumi.getter(name="alertId")
     def alert_id(self) -> pulumi.Output[_builtins.int]:
@@ -190,6 +233,11 @@ class Alert(pulumi.CustomResource):
     def created_at(self) -> pulumi.Output[_builtins.int]:
         return pulumi.get(self, "created_at")
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter(name="emailTo")
     def email_to(self) -> pulumi.Output[_builtins.str]:
@@ -0,0 +1,252 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = ['AuthenticatedDomainArgs', 'AuthenticatedDomain']
+
+@pulumi.input_type
+class AuthenticatedDomainArgs:
+    def __init__(__self__, *,
+                 domain: pulumi.Input[_builtins.str],
+                 automatic_security: Optional[pulumi.Input[_builtins.bool]] = None,
+                 default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 link_subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None):
+        """
+        The set of arguments for constructing a AuthenticatedDomain resource.
+        """
+        pulumi.set(__self__, "domain", domain)
+        if automatic_security is not None:
+            pulumi.set(__self__, "automatic_security", automatic_security)
+        if default is not None:
+            pulumi.set(__self__, "default", default)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if link_subdomain is not None:
+            pulumi.set(__self__, "link_subdomain", link_subdomain)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
+        if subdomain is not None:
+            pulumi.set(__self__, "subdomain", subdomain)
+        if wait_for_validation is not None:
+            pulumi.set(__self__, "wait_for_validation", wait_for_validation)
+        if wait_timeout_seconds is not None:
+            pulumi.set(__self__, "wait_timeout_seconds", wait_timeout_seconds)
+
+    @_builtins.property
+    @pulumi.getter
+    def domain(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "domain")
+
+    @domain.setter
+    def domain(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "domain", value)
+
+    @_builtins.property
+    @pulumi.getter(name="automaticSecurity")
+    def automatic_security(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "automatic_security")
+
+    @automatic_security.setter
+    def automatic_security(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "automatic_security", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def default(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "default")
+
+    @default.setter
+    def default(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "default", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="linkSubdomain")
+    def link_subdomain(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "link_subdomain")
+
+    @link_subdomain.setter
+    def link_subdomain(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "link_subdomain", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def region(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "region", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def subdomain(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "subdomain")
+
+    @subdomain.setter
+    def subdomain(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "subdomain", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitForValidation")
+    def wait_for_validation(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "wait_for_validation")
+
+    @wait_for_validation.setter
+    def wait_for_validation(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "wait_for_validation", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitTimeoutSeconds")
+    def wait_timeout_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "wait_timeout_seconds")
+
+    @wait_timeout_seconds.setter
+    def wait_timeout_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "wait_timeout_seconds", value)
+
+
+@pulumi.type_token("sendgrid:index:AuthenticatedDomain")
+class AuthenticatedDomain(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 automatic_security: Optional[pulumi.Input[_builtins.bool]] = None,
+                 default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 domain: Optional[pulumi.Input[_builtins.str]] = None,
+                 link_subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 __props__=None):
+        """
+        Authenticates a SendGrid sending domain and brands its links.
+
+        The component creates a DomainAuthentication and a LinkBranding for the same domain, as most sending domains need both, and exposes their DNS records as a single list. valid is true once both have been validated; set waitForValidation to wait for it during the deployment.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AuthenticatedDomainArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Authenticates a SendGrid sending domain and brands its links.
+
+        The component creates a DomainAuthentication and a LinkBranding for the same domain, as most sending domains need both, and exposes their DNS records as a single list. valid is true once both have been validated; set waitForValidation to wait for it during the deployment.
+
+        :param str resource_name: The name of the resource.
+        :param AuthenticatedDomainArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AuthenticatedDomainArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 automatic_security: Optional[pulumi.Input[_builtins.bool]] = None,
+                 default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 domain: Optional[pulumi.Input[_builtins.str]] = None,
+                 link_subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AuthenticatedDomainArgs.__new__(AuthenticatedDomainArgs)
+
+            __props__.__dict__["automatic_security"] = automatic_security
+            __props__.__dict__["default"] = default
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if domain is None and not opts.urn:
+                raise TypeError("Missing required property 'domain'")
+            __props__.__dict__["domain"] = domain
+            __props__.__dict__["link_subdomain"] = link_subdomain
+            __props__.__dict__["region"] = region
+            __props__.__dict__["subdomain"] = subdomain
+            __props__.__dict__["wait_for_validation"] = wait_for_validation
+            __props__.__dict__["wait_timeout_seconds"] = wait_timeout_seconds
+            __props__.__dict__["dns_records"] = None
+            __props__.__dict__["domain_id"] = None
+            __props__.__dict__["link_id"] = None
+            __props__.__dict__["provider_records"] = None
+            __props__.__dict__["valid"] = None
+        super(AuthenticatedDomain, __self__).__init__(
+            'sendgrid:index:AuthenticatedDomain',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter(name="dnsRecords")
+    def dns_records(self) -> pulumi.Output[Sequence['outputs.DomainDNSRecord']]:
+        return pulumi.get(self, "dns_records")
+
+    @_builtins.property
+    @pulumi.getter(name="domainId")
+    def domain_id(self) -> pulumi.Output[_builtins.int]:
+        return pulumi.get(self, "domain_id")
+
+    @_builtins.property
+    @pulumi.getter(name="linkId")
+    def link_id(self) -> pulumi.Output[_builtins.int]:
+        return pulumi.get(self, "link_id")
+
+    @_builtins.property
+    @pulumi.getter(name="providerRecords")
+    def provider_records(self) -> pulumi.Output[Sequence['outputs.DNSProviderRecord']]:
+        return pulumi.get(self, "provider_records")
+
+    @_builtins.property
+    @pulumi.getter
+    def valid(self) -> pulumi.Output[_builtins.bool]:
+        return pulumi.get(self, "valid")
+
@@ -16,11 +16,131 @@ from .. import _utilities
 
 apiKey: Optional[str]
 """
-The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
 """
 
-baseUrl: str
+apiKeyFile: Optional[str]
 """
-The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+"""
+
+baseUrl: Optional[str]
+"""
+The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
+"""
+
+circuitBreakerCooldownSeconds: int
+"""
+How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+"""
+
+circuitBreakerThreshold: int
+"""
+The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+"""
+
+enableLegacyContactDb: Optional[bool]
+"""
+Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+"""
+
+headers: Optional[str]
+"""
+Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+"""
+
+httpProxy: Optional[str]
+"""
+The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+"""
+
+httpsProxy: Optional[str]
+"""
+The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+"""
+
+logMetrics: Optional[bool]
+"""
+Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+"""
+
+maxConcurrentRequests: int
+"""
+The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+"""
+
+maxRetries: int
+"""
+The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+"""
+
+noProxy: Optional[str]
+"""
+A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+"""
+
+onBehalfOf: Optional[str]
+"""
+The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+"""
+
+rateLimitBurst: Optional[int]
+"""
+The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+"""
+
+rateLimitPerSecond: Optional[float]
+"""
+The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+"""
+
+readTimeoutSeconds: Optional[int]
+"""
+The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+"""
+
+region: Optional[str]
+"""
+The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+"""
+
+requestTimeoutSeconds: int
+"""
+The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+"""
+
+requiredScopes: Optional[str]
+"""
+API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+"""
+
+retryBudget: int
+"""
+The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+"""
+
+statsdAddress: Optional[str]
+"""
+The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+"""
+
+userAgentSuffix: Optional[str]
+"""
+A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+"""
+
+validateApiKey: bool
+"""
+Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+"""
+
+validateOnPreview: Optional[bool]
+"""
+Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+"""
+
+writeTimeoutSeconds: Optional[int]
+"""
+The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
 """
 
@@ -0,0 +1,157 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['ContactDbListArgs', 'ContactDbList']
+
+@pulumi.input_type
+class ContactDbListArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None):
+        """
+        The set of arguments for constructing a ContactDbList resource.
+        """
+        pulumi.set(__self__, "name", name)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+
+@pulumi.type_token("sendgrid:index:ContactDbList")
+class ContactDbList(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Manages a list in the SendGrid Legacy Marketing Campaigns contact database.
+
+        Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. Deleting the list leaves its recipients in the contact database.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ContactDbListArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Manages a list in the SendGrid Legacy Marketing Campaigns contact database.
+
+        Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. Deleting the list leaves its recipients in the contact database.
+
+        :param str resource_name: The name of the resource.
+        :param ContactDbListArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ContactDbListArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ContactDbListArgs.__new__(ContactDbListArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["list_id"] = None
+            __props__.__dict__["recipient_count"] = None
+        super(ContactDbList, __self__).__init__(
+            'sendgrid:index:ContactDbList',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ContactDbList':
+        """
+        Get an existing ContactDbList resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ContactDbListArgs.__new__(ContactDbListArgs)
+
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["list_id"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["recipient_count"] = None
+        return ContactDbList(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter(name="listId")
+    def list_id(self) -> pulumi.Output[_builtins.int]:
+        return pulumi.get(self, "list_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter(name="recipientCount")
+    def recipient_count(self) -> pulumi.Output[_builtins.int]:
+        return pulumi.get(self, "recipient_count")
+
@@ -0,0 +1,215 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['ContactDbRecipientArgs', 'ContactDbRecipient']
+
+@pulumi.input_type
+class ContactDbRecipientArgs:
+    def __init__(__self__, *,
+                 email: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 first_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 last_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 list_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.int]]]] = None):
+        """
+        The set of arguments for constructing a ContactDbRecipient resource.
+        """
+        pulumi.set(__self__, "email", email)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if first_name is not None:
+            pulumi.set(__self__, "first_name", first_name)
+        if last_name is not None:
+            pulumi.set(__self__, "last_name", last_name)
+        if list_ids is not None:
+            pulumi.set(__self__, "list_ids", list_ids)
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "email")
+
+    @email.setter
+    def email(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "email", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="firstName")
+    def first_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "first_name")
+
+    @first_name.setter
+    def first_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "first_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="lastName")
+    def last_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "last_name")
+
+    @last_name.setter
+    def last_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "last_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="listIds")
+    def list_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.int]]]]:
+        return pulumi.get(self, "list_ids")
+
+    @list_ids.setter
+    def list_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.int]]]]):
+        pulumi.set(self, "list_ids", value)
+
+
+@pulumi.type_token("sendgrid:index:ContactDbRecipient")
+class ContactDbRecipient(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 email: Optional[pulumi.Input[_builtins.str]] = None,
+                 first_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 last_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 list_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.int]]]] = None,
+                 __props__=None):
+        """
+        Manages a recipient in the SendGrid Legacy Marketing Campaigns contact database.
+
+        Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. The recipient is added to the lists in `listIds`, e.g. the `listId` of a `ContactDbList`, and removed from lists dropped from it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ContactDbRecipientArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Manages a recipient in the SendGrid Legacy Marketing Campaigns contact database.
+
+        Only accounts that have not migrated to new Marketing Campaigns can use the legacy contact database, so this resource requires the provider's `enableLegacyContactDb` setting. The recipient is added to the lists in `listIds`, e.g. the `listId` of a `ContactDbList`, and removed from lists dropped from it.
+
+        :param str resource_name: The name of the resource.
+        :param ContactDbRecipientArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ContactDbRecipientArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 email: Optional[pulumi.Input[_builtins.str]] = None,
+                 first_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 last_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 list_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.int]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ContactDbRecipientArgs.__new__(ContactDbRecipientArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if email is None and not opts.urn:
+                raise TypeError("Missing required property 'email'")
+            __props__.__dict__["email"] = email
+            __props__.__dict__["first_name"] = first_name
+            __props__.__dict__["last_name"] = last_name
+            __props__.__dict__["list_ids"] = list_ids
+            __props__.__dict__["recipient_id"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["email"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(ContactDbRecipient, __self__).__init__(
+            'sendgrid:index:ContactDbRecipient',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ContactDbRecipient':
+        """
+        Get an existing ContactDbRecipient resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ContactDbRecipientArgs.__new__(ContactDbRecipientArgs)
+
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["email"] = None
+        __props__.__dict__["first_name"] = None
+        __props__.__dict__["last_name"] = None
+        __props__.__dict__["list_ids"] = None
+        __props__.__dict__["recipient_id"] = None
+        return ContactDbRecipient(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "email")
+
+    @_builtins.property
+    @pulumi.getter(name="firstName")
+    def first_name(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "first_name")
+
+    @_builtins.property
+    @pulumi.getter(name="lastName")
+    def last_name(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "last_name")
+
+    @_builtins.property
+    @pulumi.getter(name="listIds")
+    def list_ids(self) -> pulumi.Output[Optional[Sequence[_builtins.int]]]:
+        return pulumi.get(self, "list_ids")
+
+    @_builtins.property
+    @pulumi.getter(name="recipientId")
+    def recipient_id(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "recipient_id")
+
@@ -21,17 +21,25 @@ __all__ = ['DomainAuthenticationArgs', 'DomainAuthentication']
 class DomainAuthenticationArgs:
     def __init__(__self__, *,
                  domain: pulumi.Input[_builtins.str],
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
                  automatic_security: Optional[pulumi.Input[_builtins.bool]] = None,
                  custom_dkim_selector: Optional[pulumi.Input[_builtins.str]] = None,
                  custom_spf: Optional[pulumi.Input[_builtins.bool]] = None,
                  default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  ips: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  region: Optional[pulumi.Input[_builtins.str]] = None,
-                 subdomain: Optional[pulumi.Input[_builtins.str]] = None):
+                 revalidate_on_refresh: Optional[pulumi.Input[_builtins.bool]] = None,
+                 subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None):
         """
         The set of arguments for constructing a DomainAuthentication resource.
         """
         pulumi.set(__self__, "domain", domain)
+        if adopt_existing is not None:
+            pulumi.set(__self__, "adopt_existing", adopt_existing)
         if automatic_security is not None:
             pulumi.set(__self__, "automatic_security", automatic_security)
         if custom_dkim_selector is not None:
@@ -40,12 +48,22 @@ class DomainAuthenticationArgs:
             pulumi.set(__self__, "custom_spf", custom_spf)
         if default is not None:
             pulumi.set(__self__, "default", default)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if ips is not None:
             pulumi.set(__self__, "ips", ips)
         if region is not None:
             pulumi.set(__self__, "region", region)
+        if revalidate_on_refresh is not None:
+            pulumi.set(__self__, "revalidate_on_refresh", revalidate_on_refresh)
         if subdomain is not None:
             pulumi.set(__self__, "subdomain", subdomain)
+        if wait_for_validation is not None:
+            pulumi.set(__self__, "wait_for_validation", wait_for_validation)
+        if wait_interval_seconds is not None:
+            pulumi.set(__self__, "wait_interval_seconds", wait_interval_seconds)
+        if wait_timeout_seconds is not None:
+            pulumi.set(__self__, "wait_timeout_seconds", wait_timeout_seconds)
 
     @_builtins.property
     @pulumi.getter
@@ -56,6 +74,15 @@ class DomainAuthenticationArgs:
     def domain(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "domain", value)
 
+    @_builtins.property
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
+
+    @adopt_existing.setter
+    def adopt_existing(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "adopt_existing", value)
+
     @_builtins.property
     @pulumi.getter(name="automaticSecurity")
     def automatic_security(self) -> Optional[pulumi.Input[_builtins.bool]]:
@@ -92,6 +119,15 @@ class DomainAuthenticationArgs:
     def default(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "default", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter
     def ips(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
@@ -110,6 +146,15 @@ class DomainAuthenticationArgs:
     def region(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "region", value)
 
+    @_builtins.property
+    @pulumi.getter(name="revalidateOnRefresh")
+    def revalidate_on_refresh(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "revalidate_on_refresh")
+
+    @revalidate_on_refresh.setter
+    def revalidate_on_refresh(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "revalidate_on_refresh", value)
+
     @_builtins.property
     @pulumi.getter
     def subdomain(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -119,6 +164,33 @@ class DomainAuthenticationArgs:
     def subdomain(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "subdomain", value)
 
+    @_builtins.property
+    @pulumi.getter(name="waitForValidation")
+    def wait_for_validation(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "wait_for_validation")
+
+    @wait_for_validation.setter
+    def wait_for_validation(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "wait_for_validation", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitIntervalSeconds")
+    def wait_interval_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "wait_interval_seconds")
+
+    @wait_interval_seconds.setter
+    def wait_interval_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "wait_interval_seconds", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitTimeoutSeconds")
+    def wait_timeout_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "wait_timeout_seconds")
+
+    @wait_timeout_seconds.setter
+    def wait_timeout_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "wait_timeout_seconds", value)
+
 
 @pulumi.type_token("sendgrid:index:DomainAuthentication")
 class DomainAuthentication(pulumi.CustomResource):
@@ -126,14 +198,20 @@ class DomainAuthentication(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
                  automatic_security: Optional[pulumi.Input[_builtins.bool]] = None,
                  custom_dkim_selector: Optional[pulumi.Input[_builtins.str]] = None,
                  custom_spf: Optional[pulumi.Input[_builtins.bool]] = None,
                  default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  domain: Optional[pulumi.Input[_builtins.str]] = None,
                  ips: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  region: Optional[pulumi.Input[_builtins.str]] = None,
+                 revalidate_on_refresh: Optional[pulumi.Input[_builtins.bool]] = None,
                  subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  __props__=None):
         """
         Manages a SendGrid Domain Authentication.
@@ -173,14 +251,20 @@ class DomainAuthentication(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
                  automatic_security: Optional[pulumi.Input[_builtins.bool]] = None,
                  custom_dkim_selector: Optional[pulumi.Input[_builtins.str]] = None,
                  custom_spf: Optional[pulumi.Input[_builtins.bool]] = None,
                  default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  domain: Optional[pulumi.Input[_builtins.str]] = None,
                  ips: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  region: Optional[pulumi.Input[_builtins.str]] = None,
+                 revalidate_on_refresh: Optional[pulumi.Input[_builtins.bool]] = None,
                  subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -190,24 +274,34 @@ class DomainAuthentication(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = DomainAuthenticationArgs.__new__(DomainAuthenticationArgs)
 
+            __props__.__dict__["adopt_existing"] = adopt_existing
             __props__.__dict__["automatic_security"] = automatic_security
             __props__.__dict__["custom_dkim_selector"] = custom_dkim_selector
             __props__.__dict__["custom_spf"] = custom_spf
             __props__.__dict__["default"] = default
+            __props__.__dict__["deletion_protection"] = deletion_protection
             if domain is None and not opts.urn:
                 raise TypeError("Missing required property 'domain'")
             __props__.__dict__["domain"] = domain
             __props__.__dict__["ips"] = ips
             __props__.__dict__["region"] = region
+            __props__.__dict__["revalidate_on_refresh"] = revalidate_on_refresh
             __props__.__dict__["subdomain"] = subdomain
+            __props__.__dict__["wait_for_validation"] = wait_for_validation
+            __props__.__dict__["wait_interval_seconds"] = wait_interval_seconds
+            __props__.__dict__["wait_timeout_seconds"] = wait_timeout_seconds
             __props__.__dict__["dkim1"] = None
             __props__.__dict__["dkim2"] = None
+            __props__.__dict__["dns_records"] = None
             __props__.__dict__["domain_id"] = None
             __props__.__dict__["legacy"] = None
             __props__.__dict__["mail_cname"] = None
+            __props__.__dict__["provider_records"] = None
             __props__.__dict__["user_id"] = None
             __props__.__dict__["username"] = None
             __props__.__dict__["valid"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["domain", "subdomain"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(DomainAuthentication, __self__).__init__(
             'sendgrid:index:DomainAuthentication',
             resource_name,
@@ -230,24 +324,37 @@ class DomainAuthentication(pulumi.CustomResource):
 
         __props__ = DomainAuthenticationArgs.__new__(DomainAuthenticationArgs)
 
+        __props__.__dict__["adopt_existing"] = None
         __props__.__dict__["automatic_security"] = None
         __props__.__dict__["custom_dkim_selector"] = None
         __props__.__dict__["custom_spf"] = None
         __props__.__dict__["default"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["dkim1"] = None
         __props__.__dict__["dkim2"] = None
+        __props__.__dict__["dns_records"] = None
         __props__.__dict__["domain"] = None
         __props__.__dict__["domain_id"] = None
         __props__.__dict__["ips"] = None
         __props__.__dict__["legacy"] = None
         __props__.__dict__["mail_cname"] = None
+        __props__.__dict__["provider_records"] = None
         __props__.__dict__["region"] = None
+        __props__.__dict__["revalidate_on_refresh"] = None
         __props__.__dict__["subdomain"] = None
         __props__.__dict__["user_id"] = None
         __props__.__dict__["username"] = None
         __props__.__dict__["valid"] = None
+        __props__.__dict__["wait_for_validation"] = None
+        __props__.__dict__["wait_interval_seconds"] = None
+        __props__.__dict__["wait_timeout_seconds"] = None
         return DomainAuthentication(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
+
     @_builtins.property
     @pulumi.getter(name="automaticSecurity")
     def automatic_security(self) -> pulumi.Output[Optional[_builtins.bool]]:
@@ -268,6 +375,11 @@ class DomainAuthentication(pulumi.CustomResource):
     def default(self) -> pulumi.Output[Optional[_builtins.bool]]:
         return pulumi.get(self, "default")
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def dkim1(self) -> pulumi.Output[Optional['outputs.DNSRecord']]:
@@ -278,6 +390,11 @@ class DomainAuthentication(pulumi.CustomResource):
     def dkim2(self) -> pulumi.Output[Optional['outputs.DNSRecord']]:
         return pulumi.get(self, "dkim2")
 
+    @_builtins.property
+    @pulumi.getter(name="dnsRecords")
+    def dns_records(self) -> pulumi.Output[Sequence['outputs.DomainDNSRecord']]:
+        return pulumi.get(self, "dns_records")
+
     @_builtins.property
     @pulumi.getter
     def domain(self) -> pulumi.Output[_builtins.str]:
@@ -303,11 +420,21 @@ class DomainAuthentication(pulumi.CustomResource):
     def mail_cname(self) -> pulumi.Output[Optional['outputs.DNSRecord']]:
         return pulumi.get(self, "mail_cname")
 
+    @_builtins.property
+    @pulumi.getter(name="providerRecords")
+    def provider_records(self) -> pulumi.Output[Optional[Sequence['outputs.DNSProviderRecord']]]:
+        return pulumi.get(self, "provider_records")
+
     @_builtins.property
     @pulumi.getter
     def region(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "region")
 
+    @_builtins.property
+    @pulumi.getter(name="revalidateOnRefresh")
+    def revalidate_on_refresh(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "revalidate_on_refresh")
+
     @_builtins.property
     @pulumi.getter
     def subdomain(self) -> pulumi.Output[Optional[_builtins.str]]:
@@ -328,3 +455,18 @@ class DomainAuthentication(pulumi.CustomResource):
     def valid(self) -> pulumi.Output[_builtins.bool]:
         return pulumi.get(self, "valid")
 
+    @_builtins.property
+    @pulumi.getter(name="waitForValidation")
+    def wait_for_validation(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "wait_for_validation")
+
+    @_builtins.property
+    @pulumi.getter(name="waitIntervalSeconds")
+    def wait_interval_seconds(self) -> pulumi.Output[Optional[_builtins.int]]:
+        return pulumi.get(self, "wait_interval_seconds")
+
+    @_builtins.property
+    @pulumi.getter(name="waitTimeoutSeconds")
+    def wait_timeout_seconds(self) -> pulumi.Output[Optional[_builtins.int]]:
+        return pulumi.get(self, "wait_timeout_seconds")
+
@@ -20,29 +20,39 @@ __all__ = ['EventWebhookArgs', 'EventWebhook']
 class EventWebhookArgs:
     def __init__(__self__, *,
                  url: pulumi.Input[_builtins.str],
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
                  bounce: Optional[pulumi.Input[_builtins.bool]] = None,
                  click: Optional[pulumi.Input[_builtins.bool]] = None,
                  deferred: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  delivered: Optional[pulumi.Input[_builtins.bool]] = None,
                  dropped: Optional[pulumi.Input[_builtins.bool]] = None,
                  enabled: Optional[pulumi.Input[_builtins.bool]] = None,
                  friendly_name: Optional[pulumi.Input[_builtins.str]] = None,
                  group_resubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
                  group_unsubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
                  open: Optional[pulumi.Input[_builtins.bool]] = None,
                  processed: Optional[pulumi.Input[_builtins.bool]] = None,
                  spam_report: Optional[pulumi.Input[_builtins.bool]] = None,
-                 unsubscribe: Optional[pulumi.Input[_builtins.bool]] = None):
+                 unsubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
+                 verify_url_reachable: Optional[pulumi.Input[_builtins.bool]] = None):
         """
         The set of arguments for constructing a EventWebhook resource.
         """
         pulumi.set(__self__, "url", url)
+        if adopt_existing is not None:
+            pulumi.set(__self__, "adopt_existing", adopt_existing)
         if bounce is not None:
             pulumi.set(__self__, "bounce", bounce)
         if click is not None:
             pulumi.set(__self__, "click", click)
         if deferred is not None:
             pulumi.set(__self__, "deferred", deferred)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if delivered is not None:
             pulumi.set(__self__, "delivered", delivered)
         if dropped is not None:
@@ -55,6 +65,12 @@ class EventWebhookArgs:
             pulumi.set(__self__, "group_resubscribe", group_resubscribe)
         if group_unsubscribe is not None:
             pulumi.set(__self__, "group_unsubscribe", group_unsubscribe)
+        if oauth_client_id is not None:
+            pulumi.set(__self__, "oauth_client_id", oauth_client_id)
+        if oauth_client_secret is not None:
+            pulumi.set(__self__, "oauth_client_secret", oauth_client_secret)
+        if oauth_token_url is not None:
+            pulumi.set(__self__, "oauth_token_url", oauth_token_url)
         if open is not None:
             pulumi.set(__self__, "open", open)
         if processed is not None:
@@ -63,6 +79,8 @@ class EventWebhookArgs:
             pulumi.set(__self__, "spam_report", spam_report)
         if unsubscribe is not None:
             pulumi.set(__self__, "unsubscribe", unsubscribe)
+        if verify_url_reachable is not None:
+            pulumi.set(__self__, "verify_url_reachable", verify_url_reachable)
 
     @_builtins.property
     @pulumi.getter
@@ -73,6 +91,15 @@ class EventWebhookArgs:
     def url(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "url", value)
 
+    @_builtins.property
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
+
+    @adopt_existing.setter
+    def adopt_existing(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "adopt_existing", value)
+
     @_builtins.property
     @pulumi.getter
     def bounce(self) -> Optional[pulumi.Input[_builtins.bool]]:
@@ -100,6 +127,15 @@ class EventWebhookArgs:
     def deferred(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "deferred", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter
     def delivered(self) -> Optional[pulumi.Input[_builtins.bool]]:
@@ -154,6 +190,33 @@ class EventWebhookArgs:
     def group_unsubscribe(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "group_unsubscribe", value)
 
+    @_builtins.property
+    @pulumi.getter(name="oauthClientId")
+    def oauth_client_id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_id")
+
+    @oauth_client_id.setter
+    def oauth_client_id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_client_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientSecret")
+    def oauth_client_secret(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_secret")
+
+    @oauth_client_secret.setter
+    def oauth_client_secret(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_client_secret", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthTokenUrl")
+    def oauth_token_url(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_token_url")
+
+    @oauth_token_url.setter
+    def oauth_token_url(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_token_url", value)
+
     @_builtins.property
     @pulumi.getter
     def open(self) -> Optional[pulumi.Input[_builtins.bool]]:
@@ -190,6 +253,15 @@ class EventWebhookArgs:
     def unsubscribe(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "unsubscribe", value)
 
+    @_builtins.property
+    @pulumi.getter(name="verifyUrlReachable")
+    def verify_url_reachable(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "verify_url_reachable")
+
+    @verify_url_reachable.setter
+    def verify_url_reachable(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "verify_url_reachable", value)
+
 
 @pulumi.type_token("sendgrid:index:EventWebhook")
 class EventWebhook(pulumi.CustomResource):
@@ -197,27 +269,33 @@ class EventWebhook(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
                  bounce: Optional[pulumi.Input[_builtins.bool]] = None,
                  click: Optional[pulumi.Input[_builtins.bool]] = None,
                  deferred: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  delivered: Optional[pulumi.Input[_builtins.bool]] = None,
                  dropped: Optional[pulumi.Input[_builtins.bool]] = None,
                  enabled: Optional[pulumi.Input[_builtins.bool]] = None,
                  friendly_name: Optional[pulumi.Input[_builtins.str]] = None,
                  group_resubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
                  group_unsubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
                  open: Optional[pulumi.Input[_builtins.bool]] = None,
                  processed: Optional[pulumi.Input[_builtins.bool]] = None,
                  spam_report: Optional[pulumi.Input[_builtins.bool]] = None,
                  unsubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
                  url: Optional[pulumi.Input[_builtins.str]] = None,
+                 verify_url_reachable: Optional[pulumi.Input[_builtins.bool]] = None,
                  __props__=None):
         """
         Manages a SendGrid Event Webhook.
 
         Event Webhooks allow you to receive HTTP POST notifications when email events occur, such as delivery, opens, clicks, bounces, and more. Configure the URL endpoint and select which events to track.
 
-        Note: Only one webhook can be configured per URL. Signature verification must be configured separately after webhook creation.
+        Note: Only one webhook can be configured per URL. Signature verification is enabled with the EventWebhookSignature resource.
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -233,7 +311,7 @@ class EventWebhook(pulumi.CustomResource):
 
         Event Webhooks allow you to receive HTTP POST notifications when email events occur, such as delivery, opens, clicks, bounces, and more. Configure the URL endpoint and select which events to track.
 
-        Note: Only one webhook can be configured per URL. Signature verification must be configured separately after webhook creation.
+        Note: Only one webhook can be configured per URL. Signature verification is enabled with the EventWebhookSignature resource.
 
         :param str resource_name: The name of the resource.
         :param EventWebhookArgs args: The arguments to use to populate this resource's properties.
@@ -250,20 +328,26 @@ class EventWebhook(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
                  bounce: Optional[pulumi.Input[_builtins.bool]] = None,
                  click: Optional[pulumi.Input[_builtins.bool]] = None,
                  deferred: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  delivered: Optional[pulumi.Input[_builtins.bool]] = None,
                  dropped: Optional[pulumi.Input[_builtins.bool]] = None,
                  enabled: Optional[pulumi.Input[_builtins.bool]] = None,
                  friendly_name: Optional[pulumi.Input[_builtins.str]] = None,
                  group_resubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
                  group_unsubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
                  open: Optional[pulumi.Input[_builtins.bool]] = None,
                  processed: Optional[pulumi.Input[_builtins.bool]] = None,
                  spam_report: Optional[pulumi.Input[_builtins.bool]] = None,
                  unsubscribe: Optional[pulumi.Input[_builtins.bool]] = None,
                  url: Optional[pulumi.Input[_builtins.str]] = None,
+                 verify_url_reachable: Optional[pulumi.Input[_builtins.bool]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -273,15 +357,20 @@ class EventWebhook(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = EventWebhookArgs.__new__(EventWebhookArgs)
 
+            __props__.__dict__["adopt_existing"] = adopt_existing
             __props__.__dict__["bounce"] = bounce
             __props__.__dict__["click"] = click
             __props__.__dict__["deferred"] = deferred
+            __props__.__dict__["deletion_protection"] = deletion_protection
             __props__.__dict__["delivered"] = delivered
             __props__.__dict__["dropped"] = dropped
             __props__.__dict__["enabled"] = enabled
             __props__.__dict__["friendly_name"] = friendly_name
             __props__.__dict__["group_resubscribe"] = group_resubscribe
             __props__.__dict__["group_unsubscribe"] = group_unsubscribe
+            __props__.__dict__["oauth_client_id"] = oauth_client_id
+            __props__.__dict__["oauth_client_secret"] = None if oauth_client_secret is None else pulumi.Output.secret(oauth_client_secret)
+            __props__.__dict__["oauth_token_url"] = oauth_token_url
             __props__.__dict__["open"] = open
             __props__.__dict__["processed"] = processed
             __props__.__dict__["spam_report"] = spam_report
@@ -289,7 +378,10 @@ class EventWebhook(pulumi.CustomResource):
             if url is None and not opts.urn:
                 raise TypeError("Missing required property 'url'")
             __props__.__dict__["url"] = url
+            __props__.__dict__["verify_url_reachable"] = verify_url_reachable
             __props__.__dict__["webhook_id"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["oauthClientSecret"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(EventWebhook, __self__).__init__(
             'sendgrid:index:EventWebhook',
             resource_name,
@@ -312,23 +404,34 @@ class EventWebhook(pulumi.CustomResource):
 
         __props__ = EventWebhookArgs.__new__(EventWebhookArgs)
 
+        __props__.__dict__["adopt_existing"] = None
         __props__.__dict__["bounce"] = None
         __props__.__dict__["click"] = None
         __props__.__dict__["deferred"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["delivered"] = None
         __props__.__dict__["dropped"] = None
         __props__.__dict__["enabled"] = None
         __props__.__dict__["friendly_name"] = None
         __props__.__dict__["group_resubscribe"] = None
         __props__.__dict__["group_unsubscribe"] = None
+        __props__.__dict__["oauth_client_id"] = None
+        __props__.__dict__["oauth_client_secret"] = None
+        __props__.__dict__["oauth_token_url"] = None
         __props__.__dict__["open"] = None
         __props__.__dict__["processed"] = None
         __props__.__dict__["spam_report"] = None
         __props__.__dict__["unsubscribe"] = None
         __props__.__dict__["url"] = None
+        __props__.__dict__["verify_url_reachable"] = None
         __props__.__dict__["webhook_id"] = None
         return EventWebhook(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
+
     @_builtins.property
     @pulumi.getter
     def bounce(self) -> pulumi.Output[Optional[_builtins.bool]]:
@@ -344,6 +447,11 @@ class EventWebhook(pulumi.CustomResource):
     def deferred(self) -> pulumi.Output[Optional[_builtins.bool]]:
         return pulumi.get(self, "deferred")
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def delivered(self) -> pulumi.Output[Optional[_builtins.bool]]:
@@ -374,6 +482,21 @@ class EventWebhook(pulumi.CustomResource):
     def group_unsubscribe(self) -> pulumi.Output[Optional[_builtins.bool]]:
         return pulumi.get(self, "group_unsubscribe")
 
+    @_builtins.property
+    @pulumi.getter(name="oauthClientId")
+    def oauth_client_id(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_id")
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientSecret")
+    def oauth_client_secret(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_secret")
+
+    @_builtins.property
+    @pulumi.getter(name="oauthTokenUrl")
+    def oauth_token_url(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "oauth_token_url")
+
     @_builtins.property
     @pulumi.getter
     def open(self) -> pulumi.Output[Optional[_builtins.bool]]:
@@ -399,6 +522,11 @@ class EventWebhook(pulumi.CustomResource):
     def url(self) -> pulumi.Output[_builtins.str]:
         return pulumi.get(self, "url")
 
+    @_builtins.property
+    @pulumi.getter(name="verifyUrlReachable")
+    def verify_url_reachable(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "verify_url_reachable")
+
     @_builtins.property
     @pulumi.getter(name="webhookId")
     def webhook_id(self) -> pulumi.Output[_builtins.str]:
@@ -0,0 +1,152 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['EventWebhookSignatureArgs', 'EventWebhookSignature']
+
+@pulumi.input_type
+class EventWebhookSignatureArgs:
+    def __init__(__self__, *,
+                 webhook_id: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None):
+        """
+        The set of arguments for constructing a EventWebhookSignature resource.
+        """
+        pulumi.set(__self__, "webhook_id", webhook_id)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+
+    @_builtins.property
+    @pulumi.getter(name="webhookId")
+    def webhook_id(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "webhook_id")
+
+    @webhook_id.setter
+    def webhook_id(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "webhook_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+
+@pulumi.type_token("sendgrid:index:EventWebhookSignature")
+class EventWebhookSignature(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 webhook_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Enables signature verification of a SendGrid Event Webhook.
+
+        SendGrid then signs each request to the webhook, so the receiving service can verify events with the publicKey output. The resource ID is the webhook ID. Deleting the resource disables signing; enabling it again generates a new key pair.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: EventWebhookSignatureArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Enables signature verification of a SendGrid Event Webhook.
+
+        SendGrid then signs each request to the webhook, so the receiving service can verify events with the publicKey output. The resource ID is the webhook ID. Deleting the resource disables signing; enabling it again generates a new key pair.
+
+        :param str resource_name: The name of the resource.
+        :param EventWebhookSignatureArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(EventWebhookSignatureArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 webhook_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = EventWebhookSignatureArgs.__new__(EventWebhookSignatureArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if webhook_id is None and not opts.urn:
+                raise TypeError("Missing required property 'webhook_id'")
+            __props__.__dict__["webhook_id"] = webhook_id
+            __props__.__dict__["public_key"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["webhookId"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(EventWebhookSignature, __self__).__init__(
+            'sendgrid:index:EventWebhookSignature',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'EventWebhookSignature':
+        """
+        Get an existing EventWebhookSignature resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = EventWebhookSignatureArgs.__new__(EventWebhookSignatureArgs)
+
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["public_key"] = None
+        __props__.__dict__["webhook_id"] = None
+        return EventWebhookSignature(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter(name="publicKey")
+    def public_key(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "public_key")
+
+    @_builtins.property
+    @pulumi.getter(name="webhookId")
+    def webhook_id(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "webhook_id")
+
@@ -0,0 +1,232 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['EventWebhookTestDeliveryArgs', 'EventWebhookTestDelivery']
+
+@pulumi.input_type
+class EventWebhookTestDeliveryArgs:
+    def __init__(__self__, *,
+                 url: pulumi.Input[_builtins.str],
+                 webhook_id: pulumi.Input[_builtins.str],
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None):
+        """
+        The set of arguments for constructing a EventWebhookTestDelivery resource.
+        """
+        pulumi.set(__self__, "url", url)
+        pulumi.set(__self__, "webhook_id", webhook_id)
+        if oauth_client_id is not None:
+            pulumi.set(__self__, "oauth_client_id", oauth_client_id)
+        if oauth_client_secret is not None:
+            pulumi.set(__self__, "oauth_client_secret", oauth_client_secret)
+        if oauth_token_url is not None:
+            pulumi.set(__self__, "oauth_token_url", oauth_token_url)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @_builtins.property
+    @pulumi.getter
+    def url(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "url", value)
+
+    @_builtins.property
+    @pulumi.getter(name="webhookId")
+    def webhook_id(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "webhook_id")
+
+    @webhook_id.setter
+    def webhook_id(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "webhook_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientId")
+    def oauth_client_id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_id")
+
+    @oauth_client_id.setter
+    def oauth_client_id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_client_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientSecret")
+    def oauth_client_secret(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_secret")
+
+    @oauth_client_secret.setter
+    def oauth_client_secret(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_client_secret", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthTokenUrl")
+    def oauth_token_url(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_token_url")
+
+    @oauth_token_url.setter
+    def oauth_token_url(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_token_url", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "triggers", value)
+
+
+@pulumi.type_token("sendgrid:index:EventWebhookTestDelivery")
+class EventWebhookTestDelivery(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 url: Optional[pulumi.Input[_builtins.str]] = None,
+                 webhook_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Sends test events to a SendGrid Event Webhook.
+
+        SendGrid posts its sample events to the webhook when the resource is created and again whenever any input changes, including triggers. The deployment fails if SendGrid refuses to send them, e.g. for an unknown webhook, but SendGrid does not report how the receiving service responded, so check the service's logs to see whether it accepted them. Deleting the resource sends nothing.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: EventWebhookTestDeliveryArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Sends test events to a SendGrid Event Webhook.
+
+        SendGrid posts its sample events to the webhook when the resource is created and again whenever any input changes, including triggers. The deployment fails if SendGrid refuses to send them, e.g. for an unknown webhook, but SendGrid does not report how the receiving service responded, so check the service's logs to see whether it accepted them. Deleting the resource sends nothing.
+
+        :param str resource_name: The name of the resource.
+        :param EventWebhookTestDeliveryArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(EventWebhookTestDeliveryArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 url: Optional[pulumi.Input[_builtins.str]] = None,
+                 webhook_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = EventWebhookTestDeliveryArgs.__new__(EventWebhookTestDeliveryArgs)
+
+            __props__.__dict__["oauth_client_id"] = oauth_client_id
+            __props__.__dict__["oauth_client_secret"] = None if oauth_client_secret is None else pulumi.Output.secret(oauth_client_secret)
+            __props__.__dict__["oauth_token_url"] = oauth_token_url
+            __props__.__dict__["triggers"] = triggers
+            if url is None and not opts.urn:
+                raise TypeError("Missing required property 'url'")
+            __props__.__dict__["url"] = url
+            if webhook_id is None and not opts.urn:
+                raise TypeError("Missing required property 'webhook_id'")
+            __props__.__dict__["webhook_id"] = webhook_id
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["oauthClientSecret"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["webhookId"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(EventWebhookTestDelivery, __self__).__init__(
+            'sendgrid:index:EventWebhookTestDelivery',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'EventWebhookTestDelivery':
+        """
+        Get an existing EventWebhookTestDelivery resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = EventWebhookTestDeliveryArgs.__new__(EventWebhookTestDeliveryArgs)
+
+        __props__.__dict__["oauth_client_id"] = None
+        __props__.__dict__["oauth_client_secret"] = None
+        __props__.__dict__["oauth_token_url"] = None
+        __props__.__dict__["triggers"] = None
+        __props__.__dict__["url"] = None
+        __props__.__dict__["webhook_id"] = None
+        return EventWebhookTestDelivery(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientId")
+    def oauth_client_id(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_id")
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientSecret")
+    def oauth_client_secret(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_secret")
+
+    @_builtins.property
+    @pulumi.getter(name="oauthTokenUrl")
+    def oauth_token_url(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "oauth_token_url")
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        return pulumi.get(self, "triggers")
+
+    @_builtins.property
+    @pulumi.getter
+    def url(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "url")
+
+    @_builtins.property
+    @pulumi.getter(name="webhookId")
+    def webhook_id(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "webhook_id")
+
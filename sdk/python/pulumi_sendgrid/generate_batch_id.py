@@ -0,0 +1,68 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GenerateBatchIdResult',
+    'AwaitableGenerateBatchIdResult',
+    'generate_batch_id',
+    'generate_batch_id_output',
+]
+
+@pulumi.output_type
+class GenerateBatchIdResult:
+    def __init__(__self__, batch_id=None):
+        if batch_id and not isinstance(batch_id, str):
+            raise TypeError("Expected argument 'batch_id' to be a str")
+        pulumi.set(__self__, "batch_id", batch_id)
+
+    @_builtins.property
+    @pulumi.getter(name="batchId")
+    def batch_id(self) -> _builtins.str:
+        return pulumi.get(self, "batch_id")
+
+
+class AwaitableGenerateBatchIdResult(GenerateBatchIdResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GenerateBatchIdResult(
+            batch_id=self.batch_id)
+
+
+def generate_batch_id(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGenerateBatchIdResult:
+    """
+    Generates a new mail batch ID.
+
+    Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend resource to pause or cancel them. Every call mints a new ID, including during previews, so store the ID, e.g. in stack configuration, when it must stay the same across deployments.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:generateBatchId', __args__, opts=opts, typ=GenerateBatchIdResult).value
+
+    return AwaitableGenerateBatchIdResult(
+        batch_id=pulumi.get(__ret__, 'batch_id'))
+def generate_batch_id_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GenerateBatchIdResult]:
+    """
+    Generates a new mail batch ID.
+
+    Pass the batch ID to the mail send requests of a scheduled send, and to the ScheduledSend resource to pause or cancel them. Every call mints a new ID, including during previews, so store the ID, e.g. in stack configuration, when it must stay the same across deployments.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:generateBatchId', __args__, opts=opts, typ=GenerateBatchIdResult)
+    return __ret__.apply(lambda __response__: GenerateBatchIdResult(
+        batch_id=pulumi.get(__response__, 'batch_id')))
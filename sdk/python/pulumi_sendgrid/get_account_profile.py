@@ -0,0 +1,200 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetAccountProfileResult',
+    'AwaitableGetAccountProfileResult',
+    'get_account_profile',
+    'get_account_profile_output',
+]
+
+@pulumi.output_type
+class GetAccountProfileResult:
+    def __init__(__self__, address=None, address2=None, city=None, company=None, country=None, first_name=None, last_name=None, phone=None, reputation=None, state=None, type=None, website=None, zip=None):
+        if address and not isinstance(address, str):
+            raise TypeError("Expected argument 'address' to be a str")
+        pulumi.set(__self__, "address", address)
+        if address2 and not isinstance(address2, str):
+            raise TypeError("Expected argument 'address2' to be a str")
+        pulumi.set(__self__, "address2", address2)
+        if city and not isinstance(city, str):
+            raise TypeError("Expected argument 'city' to be a str")
+        pulumi.set(__self__, "city", city)
+        if company and not isinstance(company, str):
+            raise TypeError("Expected argument 'company' to be a str")
+        pulumi.set(__self__, "company", company)
+        if country and not isinstance(country, str):
+            raise TypeError("Expected argument 'country' to be a str")
+        pulumi.set(__self__, "country", country)
+        if first_name and not isinstance(first_name, str):
+            raise TypeError("Expected argument 'first_name' to be a str")
+        pulumi.set(__self__, "first_name", first_name)
+        if last_name and not isinstance(last_name, str):
+            raise TypeError("Expected argument 'last_name' to be a str")
+        pulumi.set(__self__, "last_name", last_name)
+        if phone and not isinstance(phone, str):
+            raise TypeError("Expected argument 'phone' to be a str")
+        pulumi.set(__self__, "phone", phone)
+        if reputation and not isinstance(reputation, float):
+            raise TypeError("Expected argument 'reputation' to be a float")
+        pulumi.set(__self__, "reputation", reputation)
+        if state and not isinstance(state, str):
+            raise TypeError("Expected argument 'state' to be a str")
+        pulumi.set(__self__, "state", state)
+        if type and not isinstance(type, str):
+            raise TypeError("Expected argument 'type' to be a str")
+        pulumi.set(__self__, "type", type)
+        if website and not isinstance(website, str):
+            raise TypeError("Expected argument 'website' to be a str")
+        pulumi.set(__self__, "website", website)
+        if zip and not isinstance(zip, str):
+            raise TypeError("Expected argument 'zip' to be a str")
+        pulumi.set(__self__, "zip", zip)
+
+    @_builtins.property
+    @pulumi.getter
+    def address(self) -> _builtins.str:
+        return pulumi.get(self, "address")
+
+    @_builtins.property
+    @pulumi.getter
+    def address2(self) -> _builtins.str:
+        return pulumi.get(self, "address2")
+
+    @_builtins.property
+    @pulumi.getter
+    def city(self) -> _builtins.str:
+        return pulumi.get(self, "city")
+
+    @_builtins.property
+    @pulumi.getter
+    def company(self) -> _builtins.str:
+        return pulumi.get(self, "company")
+
+    @_builtins.property
+    @pulumi.getter
+    def country(self) -> _builtins.str:
+        return pulumi.get(self, "country")
+
+    @_builtins.property
+    @pulumi.getter(name="firstName")
+    def first_name(self) -> _builtins.str:
+        return pulumi.get(self, "first_name")
+
+    @_builtins.property
+    @pulumi.getter(name="lastName")
+    def last_name(self) -> _builtins.str:
+        return pulumi.get(self, "last_name")
+
+    @_builtins.property
+    @pulumi.getter
+    def phone(self) -> _builtins.str:
+        return pulumi.get(self, "phone")
+
+    @_builtins.property
+    @pulumi.getter
+    def reputation(self) -> _builtins.float:
+        return pulumi.get(self, "reputation")
+
+    @_builtins.property
+    @pulumi.getter
+    def state(self) -> _builtins.str:
+        return pulumi.get(self, "state")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> _builtins.str:
+        return pulumi.get(self, "type")
+
+    @_builtins.property
+    @pulumi.getter
+    def website(self) -> _builtins.str:
+        return pulumi.get(self, "website")
+
+    @_builtins.property
+    @pulumi.getter
+    def zip(self) -> _builtins.str:
+        return pulumi.get(self, "zip")
+
+
+class AwaitableGetAccountProfileResult(GetAccountProfileResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetAccountProfileResult(
+            address=self.address,
+            address2=self.address2,
+            city=self.city,
+            company=self.company,
+            country=self.country,
+            first_name=self.first_name,
+            last_name=self.last_name,
+            phone=self.phone,
+            reputation=self.reputation,
+            state=self.state,
+            type=self.type,
+            website=self.website,
+            zip=self.zip)
+
+
+def get_account_profile(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetAccountProfileResult:
+    """
+    Gets the plan type, sender reputation and profile of the SendGrid account.
+
+    Useful for conditional logic, e.g. to skip resources such as subusers and IP pools that free plans do not support.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getAccountProfile', __args__, opts=opts, typ=GetAccountProfileResult).value
+
+    return AwaitableGetAccountProfileResult(
+        address=pulumi.get(__ret__, 'address'),
+        address2=pulumi.get(__ret__, 'address2'),
+        city=pulumi.get(__ret__, 'city'),
+        company=pulumi.get(__ret__, 'company'),
+        country=pulumi.get(__ret__, 'country'),
+        first_name=pulumi.get(__ret__, 'first_name'),
+        last_name=pulumi.get(__ret__, 'last_name'),
+        phone=pulumi.get(__ret__, 'phone'),
+        reputation=pulumi.get(__ret__, 'reputation'),
+        state=pulumi.get(__ret__, 'state'),
+        type=pulumi.get(__ret__, 'type'),
+        website=pulumi.get(__ret__, 'website'),
+        zip=pulumi.get(__ret__, 'zip'))
+def get_account_profile_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetAccountProfileResult]:
+    """
+    Gets the plan type, sender reputation and profile of the SendGrid account.
+
+    Useful for conditional logic, e.g. to skip resources such as subusers and IP pools that free plans do not support.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getAccountProfile', __args__, opts=opts, typ=GetAccountProfileResult)
+    return __ret__.apply(lambda __response__: GetAccountProfileResult(
+        address=pulumi.get(__response__, 'address'),
+        address2=pulumi.get(__response__, 'address2'),
+        city=pulumi.get(__response__, 'city'),
+        company=pulumi.get(__response__, 'company'),
+        country=pulumi.get(__response__, 'country'),
+        first_name=pulumi.get(__response__, 'first_name'),
+        last_name=pulumi.get(__response__, 'last_name'),
+        phone=pulumi.get(__response__, 'phone'),
+        reputation=pulumi.get(__response__, 'reputation'),
+        state=pulumi.get(__response__, 'state'),
+        type=pulumi.get(__response__, 'type'),
+        website=pulumi.get(__response__, 'website'),
+        zip=pulumi.get(__response__, 'zip')))
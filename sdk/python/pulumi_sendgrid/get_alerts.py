@@ -0,0 +1,69 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetAlertsResult',
+    'AwaitableGetAlertsResult',
+    'get_alerts',
+    'get_alerts_output',
+]
+
+@pulumi.output_type
+class GetAlertsResult:
+    def __init__(__self__, alerts=None):
+        if alerts and not isinstance(alerts, list):
+            raise TypeError("Expected argument 'alerts' to be a list")
+        pulumi.set(__self__, "alerts", alerts)
+
+    @_builtins.property
+    @pulumi.getter
+    def alerts(self) -> Sequence['outputs.AlertSummary']:
+        return pulumi.get(self, "alerts")
+
+
+class AwaitableGetAlertsResult(GetAlertsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetAlertsResult(
+            alerts=self.alerts)
+
+
+def get_alerts(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetAlertsResult:
+    """
+    Lists all SendGrid Alerts configured on the account.
+
+    Returns the type, recipient, percentage and frequency of every alert. Use the `alertId` of an entry to import an existing alert with `pulumi import`.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getAlerts', __args__, opts=opts, typ=GetAlertsResult).value
+
+    return AwaitableGetAlertsResult(
+        alerts=pulumi.get(__ret__, 'alerts'))
+def get_alerts_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetAlertsResult]:
+    """
+    Lists all SendGrid Alerts configured on the account.
+
+    Returns the type, recipient, percentage and frequency of every alert. Use the `alertId` of an entry to import an existing alert with `pulumi import`.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getAlerts', __args__, opts=opts, typ=GetAlertsResult)
+    return __ret__.apply(lambda __response__: GetAlertsResult(
+        alerts=pulumi.get(__response__, 'alerts')))
@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetBlocksResult',
+    'AwaitableGetBlocksResult',
+    'get_blocks',
+    'get_blocks_output',
+]
+
+@pulumi.output_type
+class GetBlocksResult:
+    def __init__(__self__, blocks=None):
+        if blocks and not isinstance(blocks, list):
+            raise TypeError("Expected argument 'blocks' to be a list")
+        pulumi.set(__self__, "blocks", blocks)
+
+    @_builtins.property
+    @pulumi.getter
+    def blocks(self) -> Sequence['outputs.BlockEntry']:
+        return pulumi.get(self, "blocks")
+
+
+class AwaitableGetBlocksResult(GetBlocksResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetBlocksResult(
+            blocks=self.blocks)
+
+
+def get_blocks(end_time: Optional[_builtins.int] = None,
+               start_time: Optional[_builtins.int] = None,
+               opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetBlocksResult:
+    """
+    Lists the email addresses on the SendGrid blocks list.
+
+    Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. Optionally filter by the Unix time range in which the block occurred.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getBlocks', __args__, opts=opts, typ=GetBlocksResult).value
+
+    return AwaitableGetBlocksResult(
+        blocks=pulumi.get(__ret__, 'blocks'))
+def get_blocks_output(end_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                      start_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                      opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetBlocksResult]:
+    """
+    Lists the email addresses on the SendGrid blocks list.
+
+    Blocks occur when a receiving server rejects a message for reasons unrelated to the recipient address. Optionally filter by the Unix time range in which the block occurred.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getBlocks', __args__, opts=opts, typ=GetBlocksResult)
+    return __ret__.apply(lambda __response__: GetBlocksResult(
+        blocks=pulumi.get(__response__, 'blocks')))
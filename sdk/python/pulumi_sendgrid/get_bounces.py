@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetBouncesResult',
+    'AwaitableGetBouncesResult',
+    'get_bounces',
+    'get_bounces_output',
+]
+
+@pulumi.output_type
+class GetBouncesResult:
+    def __init__(__self__, bounces=None):
+        if bounces and not isinstance(bounces, list):
+            raise TypeError("Expected argument 'bounces' to be a list")
+        pulumi.set(__self__, "bounces", bounces)
+
+    @_builtins.property
+    @pulumi.getter
+    def bounces(self) -> Sequence['outputs.BounceEntry']:
+        return pulumi.get(self, "bounces")
+
+
+class AwaitableGetBouncesResult(GetBouncesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetBouncesResult(
+            bounces=self.bounces)
+
+
+def get_bounces(end_time: Optional[_builtins.int] = None,
+                start_time: Optional[_builtins.int] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetBouncesResult:
+    """
+    Lists the email addresses on the SendGrid bounce list.
+
+    Optionally filter by the Unix time range in which the bounce occurred. Useful for cleanup jobs and audits without a second client library.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getBounces', __args__, opts=opts, typ=GetBouncesResult).value
+
+    return AwaitableGetBouncesResult(
+        bounces=pulumi.get(__ret__, 'bounces'))
+def get_bounces_output(end_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                       start_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                       opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetBouncesResult]:
+    """
+    Lists the email addresses on the SendGrid bounce list.
+
+    Optionally filter by the Unix time range in which the bounce occurred. Useful for cleanup jobs and audits without a second client library.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getBounces', __args__, opts=opts, typ=GetBouncesResult)
+    return __ret__.apply(lambda __response__: GetBouncesResult(
+        bounces=pulumi.get(__response__, 'bounces')))
@@ -0,0 +1,72 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetCategoriesResult',
+    'AwaitableGetCategoriesResult',
+    'get_categories',
+    'get_categories_output',
+]
+
+@pulumi.output_type
+class GetCategoriesResult:
+    def __init__(__self__, categories=None):
+        if categories and not isinstance(categories, list):
+            raise TypeError("Expected argument 'categories' to be a list")
+        pulumi.set(__self__, "categories", categories)
+
+    @_builtins.property
+    @pulumi.getter
+    def categories(self) -> Sequence[_builtins.str]:
+        return pulumi.get(self, "categories")
+
+
+class AwaitableGetCategoriesResult(GetCategoriesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetCategoriesResult(
+            categories=self.categories)
+
+
+def get_categories(search: Optional[_builtins.str] = None,
+                   opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetCategoriesResult:
+    """
+    Lists the categories used on the SendGrid account.
+
+    Optionally filter by name prefix. Useful for validating category names used in templates and campaigns at plan time.
+    """
+    __args__ = dict()
+    __args__['search'] = search
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getCategories', __args__, opts=opts, typ=GetCategoriesResult).value
+
+    return AwaitableGetCategoriesResult(
+        categories=pulumi.get(__ret__, 'categories'))
+def get_categories_output(search: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                          opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetCategoriesResult]:
+    """
+    Lists the categories used on the SendGrid account.
+
+    Optionally filter by name prefix. Useful for validating category names used in templates and campaigns at plan time.
+    """
+    __args__ = dict()
+    __args__['search'] = search
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getCategories', __args__, opts=opts, typ=GetCategoriesResult)
+    return __ret__.apply(lambda __response__: GetCategoriesResult(
+        categories=pulumi.get(__response__, 'categories')))
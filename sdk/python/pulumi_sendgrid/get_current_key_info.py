@@ -0,0 +1,94 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetCurrentKeyInfoResult',
+    'AwaitableGetCurrentKeyInfoResult',
+    'get_current_key_info',
+    'get_current_key_info_output',
+]
+
+@pulumi.output_type
+class GetCurrentKeyInfoResult:
+    def __init__(__self__, api_key_id=None, name=None, scopes=None):
+        if api_key_id and not isinstance(api_key_id, str):
+            raise TypeError("Expected argument 'api_key_id' to be a str")
+        pulumi.set(__self__, "api_key_id", api_key_id)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if scopes and not isinstance(scopes, list):
+            raise TypeError("Expected argument 'scopes' to be a list")
+        pulumi.set(__self__, "scopes", scopes)
+
+    @_builtins.property
+    @pulumi.getter(name="apiKeyId")
+    def api_key_id(self) -> Optional[_builtins.str]:
+        return pulumi.get(self, "api_key_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[_builtins.str]:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def scopes(self) -> Sequence[_builtins.str]:
+        return pulumi.get(self, "scopes")
+
+
+class AwaitableGetCurrentKeyInfoResult(GetCurrentKeyInfoResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetCurrentKeyInfoResult(
+            api_key_id=self.api_key_id,
+            name=self.name,
+            scopes=self.scopes)
+
+
+def get_current_key_info(required_scopes: Optional[Sequence[_builtins.str]] = None,
+                         opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetCurrentKeyInfoResult:
+    """
+    Reports the scopes of the API key the provider is configured with.
+
+    The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` to fail with a clear error when the key cannot manage the resources a program declares, e.g. ["templates.create", "templates.update"].
+    """
+    __args__ = dict()
+    __args__['requiredScopes'] = required_scopes
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getCurrentKeyInfo', __args__, opts=opts, typ=GetCurrentKeyInfoResult).value
+
+    return AwaitableGetCurrentKeyInfoResult(
+        api_key_id=pulumi.get(__ret__, 'api_key_id'),
+        name=pulumi.get(__ret__, 'name'),
+        scopes=pulumi.get(__ret__, 'scopes'))
+def get_current_key_info_output(required_scopes: Optional[pulumi.Input[Optional[Sequence[_builtins.str]]]] = None,
+                                opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetCurrentKeyInfoResult]:
+    """
+    Reports the scopes of the API key the provider is configured with.
+
+    The key's ID and name are included when the key grants `api_keys.read`. Set `requiredScopes` to fail with a clear error when the key cannot manage the resources a program declares, e.g. ["templates.create", "templates.update"].
+    """
+    __args__ = dict()
+    __args__['requiredScopes'] = required_scopes
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getCurrentKeyInfo', __args__, opts=opts, typ=GetCurrentKeyInfoResult)
+    return __ret__.apply(lambda __response__: GetCurrentKeyInfoResult(
+        api_key_id=pulumi.get(__response__, 'api_key_id'),
+        name=pulumi.get(__response__, 'name'),
+        scopes=pulumi.get(__response__, 'scopes')))
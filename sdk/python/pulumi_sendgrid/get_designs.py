@@ -0,0 +1,69 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetDesignsResult',
+    'AwaitableGetDesignsResult',
+    'get_designs',
+    'get_designs_output',
+]
+
+@pulumi.output_type
+class GetDesignsResult:
+    def __init__(__self__, designs=None):
+        if designs and not isinstance(designs, list):
+            raise TypeError("Expected argument 'designs' to be a list")
+        pulumi.set(__self__, "designs", designs)
+
+    @_builtins.property
+    @pulumi.getter
+    def designs(self) -> Sequence['outputs.DesignSummary']:
+        return pulumi.get(self, "designs")
+
+
+class AwaitableGetDesignsResult(GetDesignsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetDesignsResult(
+            designs=self.designs)
+
+
+def get_designs(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetDesignsResult:
+    """
+    Lists the designs in the SendGrid Design Library.
+
+    Returns the ID, name and thumbnail of every design, so that SingleSend resources can reference design-library content by `designId`.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getDesigns', __args__, opts=opts, typ=GetDesignsResult).value
+
+    return AwaitableGetDesignsResult(
+        designs=pulumi.get(__ret__, 'designs'))
+def get_designs_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetDesignsResult]:
+    """
+    Lists the designs in the SendGrid Design Library.
+
+    Returns the ID, name and thumbnail of every design, so that SingleSend resources can reference design-library content by `designId`.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getDesigns', __args__, opts=opts, typ=GetDesignsResult)
+    return __ret__.apply(lambda __response__: GetDesignsResult(
+        designs=pulumi.get(__response__, 'designs')))
@@ -0,0 +1,80 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetEventWebhooksResult',
+    'AwaitableGetEventWebhooksResult',
+    'get_event_webhooks',
+    'get_event_webhooks_output',
+]
+
+@pulumi.output_type
+class GetEventWebhooksResult:
+    def __init__(__self__, max_allowed=None, webhooks=None):
+        if max_allowed and not isinstance(max_allowed, int):
+            raise TypeError("Expected argument 'max_allowed' to be a int")
+        pulumi.set(__self__, "max_allowed", max_allowed)
+        if webhooks and not isinstance(webhooks, list):
+            raise TypeError("Expected argument 'webhooks' to be a list")
+        pulumi.set(__self__, "webhooks", webhooks)
+
+    @_builtins.property
+    @pulumi.getter(name="maxAllowed")
+    def max_allowed(self) -> _builtins.int:
+        return pulumi.get(self, "max_allowed")
+
+    @_builtins.property
+    @pulumi.getter
+    def webhooks(self) -> Sequence['outputs.EventWebhookSummary']:
+        return pulumi.get(self, "webhooks")
+
+
+class AwaitableGetEventWebhooksResult(GetEventWebhooksResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetEventWebhooksResult(
+            max_allowed=self.max_allowed,
+            webhooks=self.webhooks)
+
+
+def get_event_webhooks(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetEventWebhooksResult:
+    """
+    Lists all SendGrid Event Webhooks configured on the account.
+
+    Returns every webhook URL together with the event types it is subscribed to, which is useful for auditing which endpoints receive account data.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getEventWebhooks', __args__, opts=opts, typ=GetEventWebhooksResult).value
+
+    return AwaitableGetEventWebhooksResult(
+        max_allowed=pulumi.get(__ret__, 'max_allowed'),
+        webhooks=pulumi.get(__ret__, 'webhooks'))
+def get_event_webhooks_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetEventWebhooksResult]:
+    """
+    Lists all SendGrid Event Webhooks configured on the account.
+
+    Returns every webhook URL together with the event types it is subscribed to, which is useful for auditing which endpoints receive account data.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getEventWebhooks', __args__, opts=opts, typ=GetEventWebhooksResult)
+    return __ret__.apply(lambda __response__: GetEventWebhooksResult(
+        max_allowed=pulumi.get(__response__, 'max_allowed'),
+        webhooks=pulumi.get(__response__, 'webhooks')))
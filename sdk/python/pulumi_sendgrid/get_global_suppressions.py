@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetGlobalSuppressionsResult',
+    'AwaitableGetGlobalSuppressionsResult',
+    'get_global_suppressions',
+    'get_global_suppressions_output',
+]
+
+@pulumi.output_type
+class GetGlobalSuppressionsResult:
+    def __init__(__self__, suppressions=None):
+        if suppressions and not isinstance(suppressions, list):
+            raise TypeError("Expected argument 'suppressions' to be a list")
+        pulumi.set(__self__, "suppressions", suppressions)
+
+    @_builtins.property
+    @pulumi.getter
+    def suppressions(self) -> Sequence['outputs.GlobalSuppressionEntry']:
+        return pulumi.get(self, "suppressions")
+
+
+class AwaitableGetGlobalSuppressionsResult(GetGlobalSuppressionsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetGlobalSuppressionsResult(
+            suppressions=self.suppressions)
+
+
+def get_global_suppressions(end_time: Optional[_builtins.int] = None,
+                            start_time: Optional[_builtins.int] = None,
+                            opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetGlobalSuppressionsResult:
+    """
+    Lists the email addresses on the SendGrid global unsubscribe list.
+
+    Includes addresses added through the GlobalSuppression resource as well as recipients who unsubscribed from all email. Optionally filter by Unix time range.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getGlobalSuppressions', __args__, opts=opts, typ=GetGlobalSuppressionsResult).value
+
+    return AwaitableGetGlobalSuppressionsResult(
+        suppressions=pulumi.get(__ret__, 'suppressions'))
+def get_global_suppressions_output(end_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                                   start_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                                   opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetGlobalSuppressionsResult]:
+    """
+    Lists the email addresses on the SendGrid global unsubscribe list.
+
+    Includes addresses added through the GlobalSuppression resource as well as recipients who unsubscribed from all email. Optionally filter by Unix time range.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getGlobalSuppressions', __args__, opts=opts, typ=GetGlobalSuppressionsResult)
+    return __ret__.apply(lambda __response__: GetGlobalSuppressionsResult(
+        suppressions=pulumi.get(__response__, 'suppressions')))
@@ -0,0 +1,72 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetGroupSuppressionsResult',
+    'AwaitableGetGroupSuppressionsResult',
+    'get_group_suppressions',
+    'get_group_suppressions_output',
+]
+
+@pulumi.output_type
+class GetGroupSuppressionsResult:
+    def __init__(__self__, emails=None):
+        if emails and not isinstance(emails, list):
+            raise TypeError("Expected argument 'emails' to be a list")
+        pulumi.set(__self__, "emails", emails)
+
+    @_builtins.property
+    @pulumi.getter
+    def emails(self) -> Sequence[_builtins.str]:
+        return pulumi.get(self, "emails")
+
+
+class AwaitableGetGroupSuppressionsResult(GetGroupSuppressionsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetGroupSuppressionsResult(
+            emails=self.emails)
+
+
+def get_group_suppressions(group_id: Optional[_builtins.int] = None,
+                           opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetGroupSuppressionsResult:
+    """
+    Lists the recipients suppressed for a SendGrid Unsubscribe Group.
+
+    Returns every email address that has unsubscribed from the group. Large groups are retrieved page by page automatically.
+    """
+    __args__ = dict()
+    __args__['groupId'] = group_id
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getGroupSuppressions', __args__, opts=opts, typ=GetGroupSuppressionsResult).value
+
+    return AwaitableGetGroupSuppressionsResult(
+        emails=pulumi.get(__ret__, 'emails'))
+def get_group_suppressions_output(group_id: Optional[pulumi.Input[_builtins.int]] = None,
+                                  opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetGroupSuppressionsResult]:
+    """
+    Lists the recipients suppressed for a SendGrid Unsubscribe Group.
+
+    Returns every email address that has unsubscribed from the group. Large groups are retrieved page by page automatically.
+    """
+    __args__ = dict()
+    __args__['groupId'] = group_id
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getGroupSuppressions', __args__, opts=opts, typ=GetGroupSuppressionsResult)
+    return __ret__.apply(lambda __response__: GetGroupSuppressionsResult(
+        emails=pulumi.get(__response__, 'emails')))
@@ -0,0 +1,69 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetInboundParseSettingsResult',
+    'AwaitableGetInboundParseSettingsResult',
+    'get_inbound_parse_settings',
+    'get_inbound_parse_settings_output',
+]
+
+@pulumi.output_type
+class GetInboundParseSettingsResult:
+    def __init__(__self__, settings=None):
+        if settings and not isinstance(settings, list):
+            raise TypeError("Expected argument 'settings' to be a list")
+        pulumi.set(__self__, "settings", settings)
+
+    @_builtins.property
+    @pulumi.getter
+    def settings(self) -> Sequence['outputs.InboundParseSetting']:
+        return pulumi.get(self, "settings")
+
+
+class AwaitableGetInboundParseSettingsResult(GetInboundParseSettingsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetInboundParseSettingsResult(
+            settings=self.settings)
+
+
+def get_inbound_parse_settings(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetInboundParseSettingsResult:
+    """
+    Lists the Inbound Parse settings of the SendGrid account.
+
+    Each setting maps a receiving hostname to the URL that parsed email is posted to. Useful for auditing which hostnames receive email, and for finding settings to adopt.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getInboundParseSettings', __args__, opts=opts, typ=GetInboundParseSettingsResult).value
+
+    return AwaitableGetInboundParseSettingsResult(
+        settings=pulumi.get(__ret__, 'settings'))
+def get_inbound_parse_settings_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetInboundParseSettingsResult]:
+    """
+    Lists the Inbound Parse settings of the SendGrid account.
+
+    Each setting maps a receiving hostname to the URL that parsed email is posted to. Useful for auditing which hostnames receive email, and for finding settings to adopt.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getInboundParseSettings', __args__, opts=opts, typ=GetInboundParseSettingsResult)
+    return __ret__.apply(lambda __response__: GetInboundParseSettingsResult(
+        settings=pulumi.get(__response__, 'settings')))
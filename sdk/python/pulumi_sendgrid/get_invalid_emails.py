@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetInvalidEmailsResult',
+    'AwaitableGetInvalidEmailsResult',
+    'get_invalid_emails',
+    'get_invalid_emails_output',
+]
+
+@pulumi.output_type
+class GetInvalidEmailsResult:
+    def __init__(__self__, invalid_emails=None):
+        if invalid_emails and not isinstance(invalid_emails, list):
+            raise TypeError("Expected argument 'invalid_emails' to be a list")
+        pulumi.set(__self__, "invalid_emails", invalid_emails)
+
+    @_builtins.property
+    @pulumi.getter(name="invalidEmails")
+    def invalid_emails(self) -> Sequence['outputs.InvalidEmailEntry']:
+        return pulumi.get(self, "invalid_emails")
+
+
+class AwaitableGetInvalidEmailsResult(GetInvalidEmailsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetInvalidEmailsResult(
+            invalid_emails=self.invalid_emails)
+
+
+def get_invalid_emails(end_time: Optional[_builtins.int] = None,
+                       start_time: Optional[_builtins.int] = None,
+                       opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetInvalidEmailsResult:
+    """
+    Lists the email addresses on the SendGrid invalid emails list.
+
+    Invalid addresses are malformed or do not exist at the receiving server. Useful for address-hygiene reporting. Optionally filter by Unix time range.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getInvalidEmails', __args__, opts=opts, typ=GetInvalidEmailsResult).value
+
+    return AwaitableGetInvalidEmailsResult(
+        invalid_emails=pulumi.get(__ret__, 'invalid_emails'))
+def get_invalid_emails_output(end_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                              start_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                              opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetInvalidEmailsResult]:
+    """
+    Lists the email addresses on the SendGrid invalid emails list.
+
+    Invalid addresses are malformed or do not exist at the receiving server. Useful for address-hygiene reporting. Optionally filter by Unix time range.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getInvalidEmails', __args__, opts=opts, typ=GetInvalidEmailsResult)
+    return __ret__.apply(lambda __response__: GetInvalidEmailsResult(
+        invalid_emails=pulumi.get(__response__, 'invalid_emails')))
@@ -0,0 +1,80 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetMailSettingsResult',
+    'AwaitableGetMailSettingsResult',
+    'get_mail_settings',
+    'get_mail_settings_output',
+]
+
+@pulumi.output_type
+class GetMailSettingsResult:
+    def __init__(__self__, enabled=None, settings=None):
+        if enabled and not isinstance(enabled, dict):
+            raise TypeError("Expected argument 'enabled' to be a dict")
+        pulumi.set(__self__, "enabled", enabled)
+        if settings and not isinstance(settings, list):
+            raise TypeError("Expected argument 'settings' to be a list")
+        pulumi.set(__self__, "settings", settings)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> Mapping[str, _builtins.bool]:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter
+    def settings(self) -> Sequence['outputs.MailSetting']:
+        return pulumi.get(self, "settings")
+
+
+class AwaitableGetMailSettingsResult(GetMailSettingsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetMailSettingsResult(
+            enabled=self.enabled,
+            settings=self.settings)
+
+
+def get_mail_settings(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetMailSettingsResult:
+    """
+    Lists the mail settings of the SendGrid account and whether each is enabled.
+
+    Returns the settings both as a list and as a map from setting name to enabled, e.g. `{"bcc": false, "footer": true}`, so compliance audits can diff the account against the desired state.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getMailSettings', __args__, opts=opts, typ=GetMailSettingsResult).value
+
+    return AwaitableGetMailSettingsResult(
+        enabled=pulumi.get(__ret__, 'enabled'),
+        settings=pulumi.get(__ret__, 'settings'))
+def get_mail_settings_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetMailSettingsResult]:
+    """
+    Lists the mail settings of the SendGrid account and whether each is enabled.
+
+    Returns the settings both as a list and as a map from setting name to enabled, e.g. `{"bcc": false, "footer": true}`, so compliance audits can diff the account against the desired state.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getMailSettings', __args__, opts=opts, typ=GetMailSettingsResult)
+    return __ret__.apply(lambda __response__: GetMailSettingsResult(
+        enabled=pulumi.get(__response__, 'enabled'),
+        settings=pulumi.get(__response__, 'settings')))
@@ -0,0 +1,69 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetMarketingListsResult',
+    'AwaitableGetMarketingListsResult',
+    'get_marketing_lists',
+    'get_marketing_lists_output',
+]
+
+@pulumi.output_type
+class GetMarketingListsResult:
+    def __init__(__self__, lists=None):
+        if lists and not isinstance(lists, list):
+            raise TypeError("Expected argument 'lists' to be a list")
+        pulumi.set(__self__, "lists", lists)
+
+    @_builtins.property
+    @pulumi.getter
+    def lists(self) -> Sequence['outputs.MarketingListSummary']:
+        return pulumi.get(self, "lists")
+
+
+class AwaitableGetMarketingListsResult(GetMarketingListsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetMarketingListsResult(
+            lists=self.lists)
+
+
+def get_marketing_lists(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetMarketingListsResult:
+    """
+    Lists the SendGrid Marketing Campaigns contact lists.
+
+    Returns the ID, name and contact count of every list, so that segments and single sends can reference lists managed by the marketing team.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getMarketingLists', __args__, opts=opts, typ=GetMarketingListsResult).value
+
+    return AwaitableGetMarketingListsResult(
+        lists=pulumi.get(__ret__, 'lists'))
+def get_marketing_lists_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetMarketingListsResult]:
+    """
+    Lists the SendGrid Marketing Campaigns contact lists.
+
+    Returns the ID, name and contact count of every list, so that segments and single sends can reference lists managed by the marketing team.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getMarketingLists', __args__, opts=opts, typ=GetMarketingListsResult)
+    return __ret__.apply(lambda __response__: GetMarketingListsResult(
+        lists=pulumi.get(__response__, 'lists')))
@@ -0,0 +1,69 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetMarketingSegmentsResult',
+    'AwaitableGetMarketingSegmentsResult',
+    'get_marketing_segments',
+    'get_marketing_segments_output',
+]
+
+@pulumi.output_type
+class GetMarketingSegmentsResult:
+    def __init__(__self__, segments=None):
+        if segments and not isinstance(segments, list):
+            raise TypeError("Expected argument 'segments' to be a list")
+        pulumi.set(__self__, "segments", segments)
+
+    @_builtins.property
+    @pulumi.getter
+    def segments(self) -> Sequence['outputs.MarketingSegmentSummary']:
+        return pulumi.get(self, "segments")
+
+
+class AwaitableGetMarketingSegmentsResult(GetMarketingSegmentsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetMarketingSegmentsResult(
+            segments=self.segments)
+
+
+def get_marketing_segments(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetMarketingSegmentsResult:
+    """
+    Lists the SendGrid Marketing Campaigns segments.
+
+    Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, so that single sends can reference existing segments.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getMarketingSegments', __args__, opts=opts, typ=GetMarketingSegmentsResult).value
+
+    return AwaitableGetMarketingSegmentsResult(
+        segments=pulumi.get(__ret__, 'segments'))
+def get_marketing_segments_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetMarketingSegmentsResult]:
+    """
+    Lists the SendGrid Marketing Campaigns segments.
+
+    Returns the ID, query and contact count of every segment using the Segmentation 2.0 API, so that single sends can reference existing segments.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getMarketingSegments', __args__, opts=opts, typ=GetMarketingSegmentsResult)
+    return __ret__.apply(lambda __response__: GetMarketingSegmentsResult(
+        segments=pulumi.get(__response__, 'segments')))
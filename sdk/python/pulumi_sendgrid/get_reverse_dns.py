@@ -0,0 +1,161 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetReverseDnsResult',
+    'AwaitableGetReverseDnsResult',
+    'get_reverse_dns',
+    'get_reverse_dns_output',
+]
+
+@pulumi.output_type
+class GetReverseDnsResult:
+    def __init__(__self__, dns_records=None, domain=None, ip=None, legacy=None, provider_records=None, rdns=None, reverse_dns_id=None, subdomain=None, valid=None):
+        if dns_records and not isinstance(dns_records, list):
+            raise TypeError("Expected argument 'dns_records' to be a list")
+        pulumi.set(__self__, "dns_records", dns_records)
+        if domain and not isinstance(domain, str):
+            raise TypeError("Expected argument 'domain' to be a str")
+        pulumi.set(__self__, "domain", domain)
+        if ip and not isinstance(ip, str):
+            raise TypeError("Expected argument 'ip' to be a str")
+        pulumi.set(__self__, "ip", ip)
+        if legacy and not isinstance(legacy, bool):
+            raise TypeError("Expected argument 'legacy' to be a bool")
+        pulumi.set(__self__, "legacy", legacy)
+        if provider_records and not isinstance(provider_records, list):
+            raise TypeError("Expected argument 'provider_records' to be a list")
+        pulumi.set(__self__, "provider_records", provider_records)
+        if rdns and not isinstance(rdns, str):
+            raise TypeError("Expected argument 'rdns' to be a str")
+        pulumi.set(__self__, "rdns", rdns)
+        if reverse_dns_id and not isinstance(reverse_dns_id, int):
+            raise TypeError("Expected argument 'reverse_dns_id' to be a int")
+        pulumi.set(__self__, "reverse_dns_id", reverse_dns_id)
+        if subdomain and not isinstance(subdomain, str):
+            raise TypeError("Expected argument 'subdomain' to be a str")
+        pulumi.set(__self__, "subdomain", subdomain)
+        if valid and not isinstance(valid, bool):
+            raise TypeError("Expected argument 'valid' to be a bool")
+        pulumi.set(__self__, "valid", valid)
+
+    @_builtins.property
+    @pulumi.getter(name="dnsRecords")
+    def dns_records(self) -> Sequence['outputs.DomainDNSRecord']:
+        return pulumi.get(self, "dns_records")
+
+    @_builtins.property
+    @pulumi.getter
+    def domain(self) -> _builtins.str:
+        return pulumi.get(self, "domain")
+
+    @_builtins.property
+    @pulumi.getter
+    def ip(self) -> _builtins.str:
+        return pulumi.get(self, "ip")
+
+    @_builtins.property
+    @pulumi.getter
+    def legacy(self) -> _builtins.bool:
+        return pulumi.get(self, "legacy")
+
+    @_builtins.property
+    @pulumi.getter(name="providerRecords")
+    def provider_records(self) -> Sequence['outputs.DNSProviderRecord']:
+        return pulumi.get(self, "provider_records")
+
+    @_builtins.property
+    @pulumi.getter
+    def rdns(self) -> _builtins.str:
+        return pulumi.get(self, "rdns")
+
+    @_builtins.property
+    @pulumi.getter(name="reverseDnsId")
+    def reverse_dns_id(self) -> _builtins.int:
+        return pulumi.get(self, "reverse_dns_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def subdomain(self) -> _builtins.str:
+        return pulumi.get(self, "subdomain")
+
+    @_builtins.property
+    @pulumi.getter
+    def valid(self) -> _builtins.bool:
+        return pulumi.get(self, "valid")
+
+
+class AwaitableGetReverseDnsResult(GetReverseDnsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetReverseDnsResult(
+            dns_records=self.dns_records,
+            domain=self.domain,
+            ip=self.ip,
+            legacy=self.legacy,
+            provider_records=self.provider_records,
+            rdns=self.rdns,
+            reverse_dns_id=self.reverse_dns_id,
+            subdomain=self.subdomain,
+            valid=self.valid)
+
+
+def get_reverse_dns(ip: Optional[_builtins.str] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetReverseDnsResult:
+    """
+    Looks up the reverse DNS entry of a dedicated IP address.
+
+    Returns the rDNS hostname, whether it has been validated, and the A record to publish, also in the shape DNS provider resources expect, so it can be wired into DNS like the records of DomainAuthentication and LinkBranding.
+    """
+    __args__ = dict()
+    __args__['ip'] = ip
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getReverseDns', __args__, opts=opts, typ=GetReverseDnsResult).value
+
+    return AwaitableGetReverseDnsResult(
+        dns_records=pulumi.get(__ret__, 'dns_records'),
+        domain=pulumi.get(__ret__, 'domain'),
+        ip=pulumi.get(__ret__, 'ip'),
+        legacy=pulumi.get(__ret__, 'legacy'),
+        provider_records=pulumi.get(__ret__, 'provider_records'),
+        rdns=pulumi.get(__ret__, 'rdns'),
+        reverse_dns_id=pulumi.get(__ret__, 'reverse_dns_id'),
+        subdomain=pulumi.get(__ret__, 'subdomain'),
+        valid=pulumi.get(__ret__, 'valid'))
+def get_reverse_dns_output(ip: Optional[pulumi.Input[_builtins.str]] = None,
+                           opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetReverseDnsResult]:
+    """
+    Looks up the reverse DNS entry of a dedicated IP address.
+
+    Returns the rDNS hostname, whether it has been validated, and the A record to publish, also in the shape DNS provider resources expect, so it can be wired into DNS like the records of DomainAuthentication and LinkBranding.
+    """
+    __args__ = dict()
+    __args__['ip'] = ip
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getReverseDns', __args__, opts=opts, typ=GetReverseDnsResult)
+    return __ret__.apply(lambda __response__: GetReverseDnsResult(
+        dns_records=pulumi.get(__response__, 'dns_records'),
+        domain=pulumi.get(__response__, 'domain'),
+        ip=pulumi.get(__response__, 'ip'),
+        legacy=pulumi.get(__response__, 'legacy'),
+        provider_records=pulumi.get(__response__, 'provider_records'),
+        rdns=pulumi.get(__response__, 'rdns'),
+        reverse_dns_id=pulumi.get(__response__, 'reverse_dns_id'),
+        subdomain=pulumi.get(__response__, 'subdomain'),
+        valid=pulumi.get(__response__, 'valid')))
@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetSpamReportsResult',
+    'AwaitableGetSpamReportsResult',
+    'get_spam_reports',
+    'get_spam_reports_output',
+]
+
+@pulumi.output_type
+class GetSpamReportsResult:
+    def __init__(__self__, spam_reports=None):
+        if spam_reports and not isinstance(spam_reports, list):
+            raise TypeError("Expected argument 'spam_reports' to be a list")
+        pulumi.set(__self__, "spam_reports", spam_reports)
+
+    @_builtins.property
+    @pulumi.getter(name="spamReports")
+    def spam_reports(self) -> Sequence['outputs.SpamReportEntry']:
+        return pulumi.get(self, "spam_reports")
+
+
+class AwaitableGetSpamReportsResult(GetSpamReportsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetSpamReportsResult(
+            spam_reports=self.spam_reports)
+
+
+def get_spam_reports(end_time: Optional[_builtins.int] = None,
+                     start_time: Optional[_builtins.int] = None,
+                     opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetSpamReportsResult:
+    """
+    Lists the email addresses on the SendGrid spam reports list.
+
+    Returns each recipient that marked a message as spam, when they reported it and the sending IP. Optionally filter by the Unix time range in which the report was made.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getSpamReports', __args__, opts=opts, typ=GetSpamReportsResult).value
+
+    return AwaitableGetSpamReportsResult(
+        spam_reports=pulumi.get(__ret__, 'spam_reports'))
+def get_spam_reports_output(end_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                            start_time: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                            opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetSpamReportsResult]:
+    """
+    Lists the email addresses on the SendGrid spam reports list.
+
+    Returns each recipient that marked a message as spam, when they reported it and the sending IP. Optionally filter by the Unix time range in which the report was made.
+    """
+    __args__ = dict()
+    __args__['endTime'] = end_time
+    __args__['startTime'] = start_time
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getSpamReports', __args__, opts=opts, typ=GetSpamReportsResult)
+    return __ret__.apply(lambda __response__: GetSpamReportsResult(
+        spam_reports=pulumi.get(__response__, 'spam_reports')))
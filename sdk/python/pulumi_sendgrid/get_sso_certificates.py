@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetSsoCertificatesResult',
+    'AwaitableGetSsoCertificatesResult',
+    'get_sso_certificates',
+    'get_sso_certificates_output',
+]
+
+@pulumi.output_type
+class GetSsoCertificatesResult:
+    def __init__(__self__, certificates=None):
+        if certificates and not isinstance(certificates, list):
+            raise TypeError("Expected argument 'certificates' to be a list")
+        pulumi.set(__self__, "certificates", certificates)
+
+    @_builtins.property
+    @pulumi.getter
+    def certificates(self) -> Sequence['outputs.SsoCertificate']:
+        return pulumi.get(self, "certificates")
+
+
+class AwaitableGetSsoCertificatesResult(GetSsoCertificatesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetSsoCertificatesResult(
+            certificates=self.certificates)
+
+
+def get_sso_certificates(expiring_within_days: Optional[_builtins.int] = None,
+                         integration_id: Optional[_builtins.str] = None,
+                         opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetSsoCertificatesResult:
+    """
+    Lists the certificates of the SendGrid account's SSO integrations.
+
+    Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` to only list certificates that expire soon, e.g. to raise certificate rotation alerts.
+    """
+    __args__ = dict()
+    __args__['expiringWithinDays'] = expiring_within_days
+    __args__['integrationId'] = integration_id
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getSsoCertificates', __args__, opts=opts, typ=GetSsoCertificatesResult).value
+
+    return AwaitableGetSsoCertificatesResult(
+        certificates=pulumi.get(__ret__, 'certificates'))
+def get_sso_certificates_output(expiring_within_days: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                                integration_id: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                                opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetSsoCertificatesResult]:
+    """
+    Lists the certificates of the SendGrid account's SSO integrations.
+
+    Each certificate includes the Unix timestamps of its validity period. Set `expiringWithinDays` to only list certificates that expire soon, e.g. to raise certificate rotation alerts.
+    """
+    __args__ = dict()
+    __args__['expiringWithinDays'] = expiring_within_days
+    __args__['integrationId'] = integration_id
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getSsoCertificates', __args__, opts=opts, typ=GetSsoCertificatesResult)
+    return __ret__.apply(lambda __response__: GetSsoCertificatesResult(
+        certificates=pulumi.get(__response__, 'certificates')))
@@ -0,0 +1,69 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetSsoIntegrationsResult',
+    'AwaitableGetSsoIntegrationsResult',
+    'get_sso_integrations',
+    'get_sso_integrations_output',
+]
+
+@pulumi.output_type
+class GetSsoIntegrationsResult:
+    def __init__(__self__, integrations=None):
+        if integrations and not isinstance(integrations, list):
+            raise TypeError("Expected argument 'integrations' to be a list")
+        pulumi.set(__self__, "integrations", integrations)
+
+    @_builtins.property
+    @pulumi.getter
+    def integrations(self) -> Sequence['outputs.SsoIntegration']:
+        return pulumi.get(self, "integrations")
+
+
+class AwaitableGetSsoIntegrationsResult(GetSsoIntegrationsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetSsoIntegrationsResult(
+            integrations=self.integrations)
+
+
+def get_sso_integrations(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetSsoIntegrationsResult:
+    """
+    Lists the SSO integrations of the SendGrid account.
+
+    Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's SAML application must be configured with, so they can be passed to IdP resources or exported as stack outputs.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getSsoIntegrations', __args__, opts=opts, typ=GetSsoIntegrationsResult).value
+
+    return AwaitableGetSsoIntegrationsResult(
+        integrations=pulumi.get(__ret__, 'integrations'))
+def get_sso_integrations_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetSsoIntegrationsResult]:
+    """
+    Lists the SSO integrations of the SendGrid account.
+
+    Each integration includes the `audienceUrl` and `singleSignonUrl` that the identity provider's SAML application must be configured with, so they can be passed to IdP resources or exported as stack outputs.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getSsoIntegrations', __args__, opts=opts, typ=GetSsoIntegrationsResult)
+    return __ret__.apply(lambda __response__: GetSsoIntegrationsResult(
+        integrations=pulumi.get(__response__, 'integrations')))
@@ -0,0 +1,81 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetStatsResult',
+    'AwaitableGetStatsResult',
+    'get_stats',
+    'get_stats_output',
+]
+
+@pulumi.output_type
+class GetStatsResult:
+    def __init__(__self__, stats=None):
+        if stats and not isinstance(stats, list):
+            raise TypeError("Expected argument 'stats' to be a list")
+        pulumi.set(__self__, "stats", stats)
+
+    @_builtins.property
+    @pulumi.getter
+    def stats(self) -> Sequence['outputs.StatsEntry']:
+        return pulumi.get(self, "stats")
+
+
+class AwaitableGetStatsResult(GetStatsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetStatsResult(
+            stats=self.stats)
+
+
+def get_stats(aggregated_by: Optional[_builtins.str] = None,
+              end_date: Optional[_builtins.str] = None,
+              start_date: Optional[_builtins.str] = None,
+              opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetStatsResult:
+    """
+    Retrieves global email statistics for the SendGrid account.
+
+    Returns delivered, open, click, bounce and other metrics for each day, week or month in the requested date range, so dashboards and alert thresholds can be derived at deploy time.
+    """
+    __args__ = dict()
+    __args__['aggregatedBy'] = aggregated_by
+    __args__['endDate'] = end_date
+    __args__['startDate'] = start_date
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getStats', __args__, opts=opts, typ=GetStatsResult).value
+
+    return AwaitableGetStatsResult(
+        stats=pulumi.get(__ret__, 'stats'))
+def get_stats_output(aggregated_by: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                     end_date: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                     start_date: Optional[pulumi.Input[_builtins.str]] = None,
+                     opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetStatsResult]:
+    """
+    Retrieves global email statistics for the SendGrid account.
+
+    Returns delivered, open, click, bounce and other metrics for each day, week or month in the requested date range, so dashboards and alert thresholds can be derived at deploy time.
+    """
+    __args__ = dict()
+    __args__['aggregatedBy'] = aggregated_by
+    __args__['endDate'] = end_date
+    __args__['startDate'] = start_date
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getStats', __args__, opts=opts, typ=GetStatsResult)
+    return __ret__.apply(lambda __response__: GetStatsResult(
+        stats=pulumi.get(__response__, 'stats')))
@@ -0,0 +1,73 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetSubuserReputationResult',
+    'AwaitableGetSubuserReputationResult',
+    'get_subuser_reputation',
+    'get_subuser_reputation_output',
+]
+
+@pulumi.output_type
+class GetSubuserReputationResult:
+    def __init__(__self__, reputations=None):
+        if reputations and not isinstance(reputations, list):
+            raise TypeError("Expected argument 'reputations' to be a list")
+        pulumi.set(__self__, "reputations", reputations)
+
+    @_builtins.property
+    @pulumi.getter
+    def reputations(self) -> Sequence['outputs.SubuserReputation']:
+        return pulumi.get(self, "reputations")
+
+
+class AwaitableGetSubuserReputationResult(GetSubuserReputationResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetSubuserReputationResult(
+            reputations=self.reputations)
+
+
+def get_subuser_reputation(usernames: Optional[Sequence[_builtins.str]] = None,
+                           opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetSubuserReputationResult:
+    """
+    Retrieves the sender reputation of SendGrid Subusers.
+
+    The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.
+    """
+    __args__ = dict()
+    __args__['usernames'] = usernames
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getSubuserReputation', __args__, opts=opts, typ=GetSubuserReputationResult).value
+
+    return AwaitableGetSubuserReputationResult(
+        reputations=pulumi.get(__ret__, 'reputations'))
+def get_subuser_reputation_output(usernames: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                                  opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetSubuserReputationResult]:
+    """
+    Retrieves the sender reputation of SendGrid Subusers.
+
+    The reputation is a score from 0 to 100 based on bounces, spam reports and other signals. Use it to drive automated IP pool moves or alerts for subusers whose reputation drops.
+    """
+    __args__ = dict()
+    __args__['usernames'] = usernames
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getSubuserReputation', __args__, opts=opts, typ=GetSubuserReputationResult)
+    return __ret__.apply(lambda __response__: GetSubuserReputationResult(
+        reputations=pulumi.get(__response__, 'reputations')))
@@ -0,0 +1,85 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetSubuserStatsResult',
+    'AwaitableGetSubuserStatsResult',
+    'get_subuser_stats',
+    'get_subuser_stats_output',
+]
+
+@pulumi.output_type
+class GetSubuserStatsResult:
+    def __init__(__self__, stats=None):
+        if stats and not isinstance(stats, list):
+            raise TypeError("Expected argument 'stats' to be a list")
+        pulumi.set(__self__, "stats", stats)
+
+    @_builtins.property
+    @pulumi.getter
+    def stats(self) -> Sequence['outputs.StatsEntry']:
+        return pulumi.get(self, "stats")
+
+
+class AwaitableGetSubuserStatsResult(GetSubuserStatsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetSubuserStatsResult(
+            stats=self.stats)
+
+
+def get_subuser_stats(aggregated_by: Optional[_builtins.str] = None,
+                      end_date: Optional[_builtins.str] = None,
+                      start_date: Optional[_builtins.str] = None,
+                      subusers: Optional[Sequence[_builtins.str]] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetSubuserStatsResult:
+    """
+    Retrieves email statistics for specific SendGrid Subusers.
+
+    Returns metrics for each requested subuser (up to 10) and each day, week or month in the date range, for per-tenant usage reporting.
+    """
+    __args__ = dict()
+    __args__['aggregatedBy'] = aggregated_by
+    __args__['endDate'] = end_date
+    __args__['startDate'] = start_date
+    __args__['subusers'] = subusers
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getSubuserStats', __args__, opts=opts, typ=GetSubuserStatsResult).value
+
+    return AwaitableGetSubuserStatsResult(
+        stats=pulumi.get(__ret__, 'stats'))
+def get_subuser_stats_output(aggregated_by: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                             end_date: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                             start_date: Optional[pulumi.Input[_builtins.str]] = None,
+                             subusers: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetSubuserStatsResult]:
+    """
+    Retrieves email statistics for specific SendGrid Subusers.
+
+    Returns metrics for each requested subuser (up to 10) and each day, week or month in the date range, for per-tenant usage reporting.
+    """
+    __args__ = dict()
+    __args__['aggregatedBy'] = aggregated_by
+    __args__['endDate'] = end_date
+    __args__['startDate'] = start_date
+    __args__['subusers'] = subusers
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getSubuserStats', __args__, opts=opts, typ=GetSubuserStatsResult)
+    return __ret__.apply(lambda __response__: GetSubuserStatsResult(
+        stats=pulumi.get(__response__, 'stats')))
@@ -0,0 +1,102 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetTrackingSettingsResult',
+    'AwaitableGetTrackingSettingsResult',
+    'get_tracking_settings',
+    'get_tracking_settings_output',
+]
+
+@pulumi.output_type
+class GetTrackingSettingsResult:
+    def __init__(__self__, click=None, google_analytics=None, open=None, subscription=None):
+        if click and not isinstance(click, dict):
+            raise TypeError("Expected argument 'click' to be a dict")
+        pulumi.set(__self__, "click", click)
+        if google_analytics and not isinstance(google_analytics, dict):
+            raise TypeError("Expected argument 'google_analytics' to be a dict")
+        pulumi.set(__self__, "google_analytics", google_analytics)
+        if open and not isinstance(open, dict):
+            raise TypeError("Expected argument 'open' to be a dict")
+        pulumi.set(__self__, "open", open)
+        if subscription and not isinstance(subscription, dict):
+            raise TypeError("Expected argument 'subscription' to be a dict")
+        pulumi.set(__self__, "subscription", subscription)
+
+    @_builtins.property
+    @pulumi.getter
+    def click(self) -> 'outputs.ClickTrackingSetting':
+        return pulumi.get(self, "click")
+
+    @_builtins.property
+    @pulumi.getter(name="googleAnalytics")
+    def google_analytics(self) -> 'outputs.GoogleAnalyticsTrackingSetting':
+        return pulumi.get(self, "google_analytics")
+
+    @_builtins.property
+    @pulumi.getter
+    def open(self) -> 'outputs.OpenTrackingSetting':
+        return pulumi.get(self, "open")
+
+    @_builtins.property
+    @pulumi.getter
+    def subscription(self) -> 'outputs.SubscriptionTrackingSetting':
+        return pulumi.get(self, "subscription")
+
+
+class AwaitableGetTrackingSettingsResult(GetTrackingSettingsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetTrackingSettingsResult(
+            click=self.click,
+            google_analytics=self.google_analytics,
+            open=self.open,
+            subscription=self.subscription)
+
+
+def get_tracking_settings(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetTrackingSettingsResult:
+    """
+    Gets the click, open, subscription and Google Analytics tracking settings of the SendGrid account.
+
+    Useful for auditing tracking across accounts or subusers in a single call.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getTrackingSettings', __args__, opts=opts, typ=GetTrackingSettingsResult).value
+
+    return AwaitableGetTrackingSettingsResult(
+        click=pulumi.get(__ret__, 'click'),
+        google_analytics=pulumi.get(__ret__, 'google_analytics'),
+        open=pulumi.get(__ret__, 'open'),
+        subscription=pulumi.get(__ret__, 'subscription'))
+def get_tracking_settings_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetTrackingSettingsResult]:
+    """
+    Gets the click, open, subscription and Google Analytics tracking settings of the SendGrid account.
+
+    Useful for auditing tracking across accounts or subusers in a single call.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getTrackingSettings', __args__, opts=opts, typ=GetTrackingSettingsResult)
+    return __ret__.apply(lambda __response__: GetTrackingSettingsResult(
+        click=pulumi.get(__response__, 'click'),
+        google_analytics=pulumi.get(__response__, 'google_analytics'),
+        open=pulumi.get(__response__, 'open'),
+        subscription=pulumi.get(__response__, 'subscription')))
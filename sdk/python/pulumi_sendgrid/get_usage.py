@@ -0,0 +1,134 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetUsageResult',
+    'AwaitableGetUsageResult',
+    'get_usage',
+    'get_usage_output',
+]
+
+@pulumi.output_type
+class GetUsageResult:
+    def __init__(__self__, last_reset=None, next_reset=None, overage=None, remain=None, reset_frequency=None, total=None, used=None):
+        if last_reset and not isinstance(last_reset, str):
+            raise TypeError("Expected argument 'last_reset' to be a str")
+        pulumi.set(__self__, "last_reset", last_reset)
+        if next_reset and not isinstance(next_reset, str):
+            raise TypeError("Expected argument 'next_reset' to be a str")
+        pulumi.set(__self__, "next_reset", next_reset)
+        if overage and not isinstance(overage, int):
+            raise TypeError("Expected argument 'overage' to be a int")
+        pulumi.set(__self__, "overage", overage)
+        if remain and not isinstance(remain, int):
+            raise TypeError("Expected argument 'remain' to be a int")
+        pulumi.set(__self__, "remain", remain)
+        if reset_frequency and not isinstance(reset_frequency, str):
+            raise TypeError("Expected argument 'reset_frequency' to be a str")
+        pulumi.set(__self__, "reset_frequency", reset_frequency)
+        if total and not isinstance(total, int):
+            raise TypeError("Expected argument 'total' to be a int")
+        pulumi.set(__self__, "total", total)
+        if used and not isinstance(used, int):
+            raise TypeError("Expected argument 'used' to be a int")
+        pulumi.set(__self__, "used", used)
+
+    @_builtins.property
+    @pulumi.getter(name="lastReset")
+    def last_reset(self) -> _builtins.str:
+        return pulumi.get(self, "last_reset")
+
+    @_builtins.property
+    @pulumi.getter(name="nextReset")
+    def next_reset(self) -> _builtins.str:
+        return pulumi.get(self, "next_reset")
+
+    @_builtins.property
+    @pulumi.getter
+    def overage(self) -> _builtins.int:
+        return pulumi.get(self, "overage")
+
+    @_builtins.property
+    @pulumi.getter
+    def remain(self) -> _builtins.int:
+        return pulumi.get(self, "remain")
+
+    @_builtins.property
+    @pulumi.getter(name="resetFrequency")
+    def reset_frequency(self) -> _builtins.str:
+        return pulumi.get(self, "reset_frequency")
+
+    @_builtins.property
+    @pulumi.getter
+    def total(self) -> _builtins.int:
+        return pulumi.get(self, "total")
+
+    @_builtins.property
+    @pulumi.getter
+    def used(self) -> _builtins.int:
+        return pulumi.get(self, "used")
+
+
+class AwaitableGetUsageResult(GetUsageResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetUsageResult(
+            last_reset=self.last_reset,
+            next_reset=self.next_reset,
+            overage=self.overage,
+            remain=self.remain,
+            reset_frequency=self.reset_frequency,
+            total=self.total,
+            used=self.used)
+
+
+def get_usage(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetUsageResult:
+    """
+    Gets the email credits of the SendGrid account for the current period.
+
+    Useful for setting the percentage of a usage_limit Alert relative to the plan size, e.g. to be notified when a fixed number of emails remains.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:getUsage', __args__, opts=opts, typ=GetUsageResult).value
+
+    return AwaitableGetUsageResult(
+        last_reset=pulumi.get(__ret__, 'last_reset'),
+        next_reset=pulumi.get(__ret__, 'next_reset'),
+        overage=pulumi.get(__ret__, 'overage'),
+        remain=pulumi.get(__ret__, 'remain'),
+        reset_frequency=pulumi.get(__ret__, 'reset_frequency'),
+        total=pulumi.get(__ret__, 'total'),
+        used=pulumi.get(__ret__, 'used'))
+def get_usage_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetUsageResult]:
+    """
+    Gets the email credits of the SendGrid account for the current period.
+
+    Useful for setting the percentage of a usage_limit Alert relative to the plan size, e.g. to be notified when a fixed number of emails remains.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:getUsage', __args__, opts=opts, typ=GetUsageResult)
+    return __ret__.apply(lambda __response__: GetUsageResult(
+        last_reset=pulumi.get(__response__, 'last_reset'),
+        next_reset=pulumi.get(__response__, 'next_reset'),
+        overage=pulumi.get(__response__, 'overage'),
+        remain=pulumi.get(__response__, 'remain'),
+        reset_frequency=pulumi.get(__response__, 'reset_frequency'),
+        total=pulumi.get(__response__, 'total'),
+        used=pulumi.get(__response__, 'used')))
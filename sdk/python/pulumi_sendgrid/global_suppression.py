@@ -19,11 +19,14 @@ __all__ = ['GlobalSuppressionArgs', 'GlobalSuppression']
 @pulumi.input_type
 class GlobalSuppressionArgs:
     def __init__(__self__, *,
-                 email: pulumi.Input[_builtins.str]):
+                 email: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None):
         """
         The set of arguments for constructing a GlobalSuppression resource.
         """
         pulumi.set(__self__, "email", email)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
 
     @_builtins.property
     @pulumi.getter
@@ -34,6 +37,15 @@ class GlobalSuppressionArgs:
     def email(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "email", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
 
 @pulumi.type_token("sendgrid:index:GlobalSuppression")
 class GlobalSuppression(pulumi.CustomResource):
@@ -41,6 +53,7 @@ class GlobalSuppression(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  email: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
         """
@@ -81,6 +94,7 @@ class GlobalSuppression(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  email: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -91,10 +105,13 @@ class GlobalSuppression(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = GlobalSuppressionArgs.__new__(GlobalSuppressionArgs)
 
+            __props__.__dict__["deletion_protection"] = deletion_protection
             if email is None and not opts.urn:
                 raise TypeError("Missing required property 'email'")
             __props__.__dict__["email"] = email
             __props__.__dict__["created_at"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["email"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(GlobalSuppression, __self__).__init__(
             'sendgrid:index:GlobalSuppression',
             resource_name,
@@ -118,6 +135,7 @@ class GlobalSuppression(pulumi.CustomResource):
         __props__ = GlobalSuppressionArgs.__new__(GlobalSuppressionArgs)
 
         __props__.__dict__["created_at"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["email"] = None
         return GlobalSuppression(resource_name, opts=opts, __props__=__props__)
 
@@ -126,6 +144,11 @@ class GlobalSuppression(pulumi.CustomResource):
     def created_at(self) -> pulumi.Output[Optional[_builtins.int]]:
         return pulumi.get(self, "created_at")
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def email(self) -> pulumi.Output[_builtins.str]:
@@ -19,19 +19,32 @@ __all__ = ['IpPoolArgs', 'IpPool']
 @pulumi.input_type
 class IpPoolArgs:
     def __init__(__self__, *,
-                 name: pulumi.Input[_builtins.str]):
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None):
         """
         The set of arguments for constructing a IpPool resource.
         """
-        pulumi.set(__self__, "name", name)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
 
     @_builtins.property
     @pulumi.getter
-    def name(self) -> pulumi.Input[_builtins.str]:
+    def name(self) -> Optional[pulumi.Input[_builtins.str]]:
         return pulumi.get(self, "name")
 
     @name.setter
-    def name(self, value: pulumi.Input[_builtins.str]):
+    def name(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "name", value)
 
 
@@ -41,6 +54,7 @@ class IpPool(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
         """
@@ -57,7 +71,7 @@ class IpPool(pulumi.CustomResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
-                 args: IpPoolArgs,
+                 args: Optional[IpPoolArgs] = None,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
         Manages a SendGrid IP Pool.
@@ -81,6 +95,7 @@ class IpPool(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -91,8 +106,7 @@ class IpPool(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = IpPoolArgs.__new__(IpPoolArgs)
 
-            if name is None and not opts.urn:
-                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["deletion_protection"] = deletion_protection
             __props__.__dict__["name"] = name
             __props__.__dict__["ips"] = None
             __props__.__dict__["pool_name"] = None
@@ -118,11 +132,17 @@ class IpPool(pulumi.CustomResource):
 
         __props__ = IpPoolArgs.__new__(IpPoolArgs)
 
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["ips"] = None
         __props__.__dict__["name"] = None
         __props__.__dict__["pool_name"] = None
         return IpPool(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def ips(self) -> pulumi.Output[Sequence[_builtins.str]]:
@@ -130,7 +150,7 @@ class IpPool(pulumi.CustomResource):
 
     @_builtins.property
     @pulumi.getter
-    def name(self) -> pulumi.Output[_builtins.str]:
+    def name(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "name")
 
     @_builtins.property
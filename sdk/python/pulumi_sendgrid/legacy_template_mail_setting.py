@@ -0,0 +1,168 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['LegacyTemplateMailSettingArgs', 'LegacyTemplateMailSetting']
+
+@pulumi.input_type
+class LegacyTemplateMailSettingArgs:
+    def __init__(__self__, *,
+                 enabled: pulumi.Input[_builtins.bool],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 html_content: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a LegacyTemplateMailSetting resource.
+        """
+        pulumi.set(__self__, "enabled", enabled)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if html_content is not None:
+            pulumi.set(__self__, "html_content", html_content)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> pulumi.Input[_builtins.bool]:
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: pulumi.Input[_builtins.bool]):
+        pulumi.set(self, "enabled", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="htmlContent")
+    def html_content(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "html_content")
+
+    @html_content.setter
+    def html_content(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "html_content", value)
+
+
+@pulumi.type_token("sendgrid:index:LegacyTemplateMailSetting")
+class LegacyTemplateMailSetting(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 enabled: Optional[pulumi.Input[_builtins.bool]] = None,
+                 html_content: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Manages the SendGrid legacy template mail setting.
+
+        For accounts still using legacy templates, this setting wraps every email in the same HTML, with the <% body %> tag where the content of each email goes. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the setting.
+
+        New accounts should use dynamic templates with the Template resource instead.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: LegacyTemplateMailSettingArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Manages the SendGrid legacy template mail setting.
+
+        For accounts still using legacy templates, this setting wraps every email in the same HTML, with the <% body %> tag where the content of each email goes. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the setting.
+
+        New accounts should use dynamic templates with the Template resource instead.
+
+        :param str resource_name: The name of the resource.
+        :param LegacyTemplateMailSettingArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(LegacyTemplateMailSettingArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 enabled: Optional[pulumi.Input[_builtins.bool]] = None,
+                 html_content: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = LegacyTemplateMailSettingArgs.__new__(LegacyTemplateMailSettingArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if enabled is None and not opts.urn:
+                raise TypeError("Missing required property 'enabled'")
+            __props__.__dict__["enabled"] = enabled
+            __props__.__dict__["html_content"] = html_content
+        super(LegacyTemplateMailSetting, __self__).__init__(
+            'sendgrid:index:LegacyTemplateMailSetting',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'LegacyTemplateMailSetting':
+        """
+        Get an existing LegacyTemplateMailSetting resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = LegacyTemplateMailSettingArgs.__new__(LegacyTemplateMailSettingArgs)
+
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["enabled"] = None
+        __props__.__dict__["html_content"] = None
+        return LegacyTemplateMailSetting(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> pulumi.Output[_builtins.bool]:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter(name="htmlContent")
+    def html_content(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "html_content")
+
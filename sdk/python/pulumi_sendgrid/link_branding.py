@@ -22,18 +22,33 @@ class LinkBrandingArgs:
     def __init__(__self__, *,
                  domain: pulumi.Input[_builtins.str],
                  default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  region: Optional[pulumi.Input[_builtins.str]] = None,
-                 subdomain: Optional[pulumi.Input[_builtins.str]] = None):
+                 revalidate_on_refresh: Optional[pulumi.Input[_builtins.bool]] = None,
+                 subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None):
         """
         The set of arguments for constructing a LinkBranding resource.
         """
         pulumi.set(__self__, "domain", domain)
         if default is not None:
             pulumi.set(__self__, "default", default)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if region is not None:
             pulumi.set(__self__, "region", region)
+        if revalidate_on_refresh is not None:
+            pulumi.set(__self__, "revalidate_on_refresh", revalidate_on_refresh)
         if subdomain is not None:
             pulumi.set(__self__, "subdomain", subdomain)
+        if wait_for_validation is not None:
+            pulumi.set(__self__, "wait_for_validation", wait_for_validation)
+        if wait_interval_seconds is not None:
+            pulumi.set(__self__, "wait_interval_seconds", wait_interval_seconds)
+        if wait_timeout_seconds is not None:
+            pulumi.set(__self__, "wait_timeout_seconds", wait_timeout_seconds)
 
     @_builtins.property
     @pulumi.getter
@@ -53,6 +68,15 @@ class LinkBrandingArgs:
     def default(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "default", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter
     def region(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -62,6 +86,15 @@ class LinkBrandingArgs:
     def region(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "region", value)
 
+    @_builtins.property
+    @pulumi.getter(name="revalidateOnRefresh")
+    def revalidate_on_refresh(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "revalidate_on_refresh")
+
+    @revalidate_on_refresh.setter
+    def revalidate_on_refresh(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "revalidate_on_refresh", value)
+
     @_builtins.property
     @pulumi.getter
     def subdomain(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -71,6 +104,33 @@ class LinkBrandingArgs:
     def subdomain(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "subdomain", value)
 
+    @_builtins.property
+    @pulumi.getter(name="waitForValidation")
+    def wait_for_validation(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "wait_for_validation")
+
+    @wait_for_validation.setter
+    def wait_for_validation(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "wait_for_validation", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitIntervalSeconds")
+    def wait_interval_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "wait_interval_seconds")
+
+    @wait_interval_seconds.setter
+    def wait_interval_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "wait_interval_seconds", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitTimeoutSeconds")
+    def wait_timeout_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "wait_timeout_seconds")
+
+    @wait_timeout_seconds.setter
+    def wait_timeout_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "wait_timeout_seconds", value)
+
 
 @pulumi.type_token("sendgrid:index:LinkBranding")
 class LinkBranding(pulumi.CustomResource):
@@ -79,9 +139,14 @@ class LinkBranding(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  domain: Optional[pulumi.Input[_builtins.str]] = None,
                  region: Optional[pulumi.Input[_builtins.str]] = None,
+                 revalidate_on_refresh: Optional[pulumi.Input[_builtins.bool]] = None,
                  subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  __props__=None):
         """
         Manages a SendGrid Link Branding.
@@ -122,9 +187,14 @@ class LinkBranding(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  domain: Optional[pulumi.Input[_builtins.str]] = None,
                  region: Optional[pulumi.Input[_builtins.str]] = None,
+                 revalidate_on_refresh: Optional[pulumi.Input[_builtins.bool]] = None,
                  subdomain: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_validation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -135,18 +205,27 @@ class LinkBranding(pulumi.CustomResource):
             __props__ = LinkBrandingArgs.__new__(LinkBrandingArgs)
 
             __props__.__dict__["default"] = default
+            __props__.__dict__["deletion_protection"] = deletion_protection
             if domain is None and not opts.urn:
                 raise TypeError("Missing required property 'domain'")
             __props__.__dict__["domain"] = domain
             __props__.__dict__["region"] = region
+            __props__.__dict__["revalidate_on_refresh"] = revalidate_on_refresh
             __props__.__dict__["subdomain"] = subdomain
+            __props__.__dict__["wait_for_validation"] = wait_for_validation
+            __props__.__dict__["wait_interval_seconds"] = wait_interval_seconds
+            __props__.__dict__["wait_timeout_seconds"] = wait_timeout_seconds
             __props__.__dict__["brand_cname"] = None
+            __props__.__dict__["dns_records"] = None
             __props__.__dict__["legacy"] = None
             __props__.__dict__["link_id"] = None
             __props__.__dict__["owner_cname"] = None
+            __props__.__dict__["provider_records"] = None
             __props__.__dict__["user_id"] = None
             __props__.__dict__["username"] = None
             __props__.__dict__["valid"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["domain", "subdomain"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(LinkBranding, __self__).__init__(
             'sendgrid:index:LinkBranding',
             resource_name,
@@ -171,15 +250,22 @@ class LinkBranding(pulumi.CustomResource):
 
         __props__.__dict__["brand_cname"] = None
         __props__.__dict__["default"] = None
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["dns_records"] = None
         __props__.__dict__["domain"] = None
         __props__.__dict__["legacy"] = None
         __props__.__dict__["link_id"] = None
         __props__.__dict__["owner_cname"] = None
+        __props__.__dict__["provider_records"] = None
         __props__.__dict__["region"] = None
+        __props__.__dict__["revalidate_on_refresh"] = None
         __props__.__dict__["subdomain"] = None
         __props__.__dict__["user_id"] = None
         __props__.__dict__["username"] = None
         __props__.__dict__["valid"] = None
+        __props__.__dict__["wait_for_validation"] = None
+        __props__.__dict__["wait_interval_seconds"] = None
+        __props__.__dict__["wait_timeout_seconds"] = None
         return LinkBranding(resource_name, opts=opts, __props__=__props__)
 
     @_builtins.property
@@ -192,6 +278,16 @@ class LinkBranding(pulumi.CustomResource):
     def default(self) -> pulumi.Output[Optional[_builtins.bool]]:
         return pulumi.get(self, "default")
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter(name="dnsRecords")
+    def dns_records(self) -> pulumi.Output[Sequence['outputs.DomainDNSRecord']]:
+        return pulumi.get(self, "dns_records")
+
     @_builtins.property
     @pulumi.getter
     def domain(self) -> pulumi.Output[_builtins.str]:
@@ -212,11 +308,21 @@ class LinkBranding(pulumi.CustomResource):
     def owner_cname(self) -> pulumi.Output[Optional['outputs.LinkBrandingDNSRecord']]:
         return pulumi.get(self, "owner_cname")
 
+    @_builtins.property
+    @pulumi.getter(name="providerRecords")
+    def provider_records(self) -> pulumi.Output[Optional[Sequence['outputs.DNSProviderRecord']]]:
+        return pulumi.get(self, "provider_records")
+
     @_builtins.property
     @pulumi.getter
     def region(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "region")
 
+    @_builtins.property
+    @pulumi.getter(name="revalidateOnRefresh")
+    def revalidate_on_refresh(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "revalidate_on_refresh")
+
     @_builtins.property
     @pulumi.getter
     def subdomain(self) -> pulumi.Output[Optional[_builtins.str]]:
@@ -237,3 +343,18 @@ class LinkBranding(pulumi.CustomResource):
     def valid(self) -> pulumi.Output[_builtins.bool]:
         return pulumi.get(self, "valid")
 
+    @_builtins.property
+    @pulumi.getter(name="waitForValidation")
+    def wait_for_validation(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "wait_for_validation")
+
+    @_builtins.property
+    @pulumi.getter(name="waitIntervalSeconds")
+    def wait_interval_seconds(self) -> pulumi.Output[Optional[_builtins.int]]:
+        return pulumi.get(self, "wait_interval_seconds")
+
+    @_builtins.property
+    @pulumi.getter(name="waitTimeoutSeconds")
+    def wait_timeout_seconds(self) -> pulumi.Output[Optional[_builtins.int]]:
+        return pulumi.get(self, "wait_timeout_seconds")
+
@@ -0,0 +1,187 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['NewRelicPartnerSettingArgs', 'NewRelicPartnerSetting']
+
+@pulumi.input_type
+class NewRelicPartnerSettingArgs:
+    def __init__(__self__, *,
+                 enabled: pulumi.Input[_builtins.bool],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 enable_subuser_statistics: Optional[pulumi.Input[_builtins.bool]] = None,
+                 license_key: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a NewRelicPartnerSetting resource.
+        """
+        pulumi.set(__self__, "enabled", enabled)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if enable_subuser_statistics is not None:
+            pulumi.set(__self__, "enable_subuser_statistics", enable_subuser_statistics)
+        if license_key is not None:
+            pulumi.set(__self__, "license_key", license_key)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> pulumi.Input[_builtins.bool]:
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: pulumi.Input[_builtins.bool]):
+        pulumi.set(self, "enabled", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="enableSubuserStatistics")
+    def enable_subuser_statistics(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "enable_subuser_statistics")
+
+    @enable_subuser_statistics.setter
+    def enable_subuser_statistics(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "enable_subuser_statistics", value)
+
+    @_builtins.property
+    @pulumi.getter(name="licenseKey")
+    def license_key(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "license_key")
+
+    @license_key.setter
+    def license_key(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "license_key", value)
+
+
+@pulumi.type_token("sendgrid:index:NewRelicPartnerSetting")
+class NewRelicPartnerSetting(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 enable_subuser_statistics: Optional[pulumi.Input[_builtins.bool]] = None,
+                 enabled: Optional[pulumi.Input[_builtins.bool]] = None,
+                 license_key: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Manages the SendGrid New Relic partner setting.
+
+        When enabled, SendGrid sends your email statistics to New Relic using the given license key. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the integration.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: NewRelicPartnerSettingArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Manages the SendGrid New Relic partner setting.
+
+        When enabled, SendGrid sends your email statistics to New Relic using the given license key. There is one setting per account, so only one of these resources should exist per provider; deleting it disables the integration.
+
+        :param str resource_name: The name of the resource.
+        :param NewRelicPartnerSettingArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(NewRelicPartnerSettingArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 enable_subuser_statistics: Optional[pulumi.Input[_builtins.bool]] = None,
+                 enabled: Optional[pulumi.Input[_builtins.bool]] = None,
+                 license_key: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = NewRelicPartnerSettingArgs.__new__(NewRelicPartnerSettingArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            __props__.__dict__["enable_subuser_statistics"] = enable_subuser_statistics
+            if enabled is None and not opts.urn:
+                raise TypeError("Missing required property 'enabled'")
+            __props__.__dict__["enabled"] = enabled
+            __props__.__dict__["license_key"] = None if license_key is None else pulumi.Output.secret(license_key)
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["licenseKey"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(NewRelicPartnerSetting, __self__).__init__(
+            'sendgrid:index:NewRelicPartnerSetting',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'NewRelicPartnerSetting':
+        """
+        Get an existing NewRelicPartnerSetting resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = NewRelicPartnerSettingArgs.__new__(NewRelicPartnerSettingArgs)
+
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["enable_subuser_statistics"] = None
+        __props__.__dict__["enabled"] = None
+        __props__.__dict__["license_key"] = None
+        return NewRelicPartnerSetting(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter(name="enableSubuserStatistics")
+    def enable_subuser_statistics(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "enable_subuser_statistics")
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> pulumi.Output[_builtins.bool]:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter(name="licenseKey")
+    def license_key(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "license_key")
+
@@ -13,13 +13,214 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from . import _utilities
+from . import outputs
 
 __all__ = [
+    'AlertSummary',
+    'BlockEntry',
+    'BounceEntry',
+    'ClickTrackingSetting',
+    'DNSProviderRecord',
     'DNSRecord',
+    'DesignSummary',
+    'DomainDNSRecord',
+    'EmailActivityMessage',
+    'EmailValidationChecks',
+    'EventWebhookSummary',
+    'GlobalSuppressionEntry',
+    'GoogleAnalyticsTrackingSetting',
+    'InboundParseSetting',
+    'InvalidEmailEntry',
     'LinkBrandingDNSRecord',
+    'MailSetting',
+    'MarketingListSummary',
+    'MarketingSegmentSummary',
+    'OpenTrackingSetting',
+    'SpamReportEntry',
+    'SsoCertificate',
+    'SsoIntegration',
+    'StatsEntry',
+    'StatsMetrics',
+    'StatsMetricsEntry',
+    'SubscriptionTrackingSetting',
+    'SubuserReputation',
     'TemplateVersionSummary',
 ]
 
+@pulumi.output_type
+class AlertSummary(dict):
+    def __init__(__self__, *,
+                 alert_id: _builtins.int,
+                 created_at: _builtins.int,
+                 email_to: _builtins.str,
+                 type: _builtins.str,
+                 updated_at: _builtins.int,
+                 frequency: Optional[_builtins.str] = None,
+                 percentage: Optional[_builtins.int] = None):
+        pulumi.set(__self__, "alert_id", alert_id)
+        pulumi.set(__self__, "created_at", created_at)
+        pulumi.set(__self__, "email_to", email_to)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "updated_at", updated_at)
+        if frequency is not None:
+            pulumi.set(__self__, "frequency", frequency)
+        if percentage is not None:
+            pulumi.set(__self__, "percentage", percentage)
+
+    @_builtins.property
+    @pulumi.getter(name="alertId")
+    def alert_id(self) -> _builtins.int:
+        return pulumi.get(self, "alert_id")
+
+    @_builtins.property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> _builtins.int:
+        return pulumi.get(self, "created_at")
+
+    @_builtins.property
+    @pulumi.getter(name="emailTo")
+    def email_to(self) -> _builtins.str:
+        return pulumi.get(self, "email_to")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> _builtins.str:
+        return pulumi.get(self, "type")
+
+    @_builtins.property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> _builtins.int:
+        return pulumi.get(self, "updated_at")
+
+    @_builtins.property
+    @pulumi.getter
+    def frequency(self) -> Optional[_builtins.str]:
+        return pulumi.get(self, "frequency")
+
+    @_builtins.property
+    @pulumi.getter
+    def percentage(self) -> Optional[_builtins.int]:
+        return pulumi.get(self, "percentage")
+
+
+@pulumi.output_type
+class BlockEntry(dict):
+    def __init__(__self__, *,
+                 created: _builtins.int,
+                 email: _builtins.str,
+                 reason: _builtins.str,
+                 status: _builtins.str):
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "email", email)
+        pulumi.set(__self__, "reason", reason)
+        pulumi.set(__self__, "status", status)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.int:
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> _builtins.str:
+        return pulumi.get(self, "email")
+
+    @_builtins.property
+    @pulumi.getter
+    def reason(self) -> _builtins.str:
+        return pulumi.get(self, "reason")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        return pulumi.get(self, "status")
+
+
+@pulumi.output_type
+class BounceEntry(dict):
+    def __init__(__self__, *,
+                 created: _builtins.int,
+                 email: _builtins.str,
+                 reason: _builtins.str,
+                 status: _builtins.str):
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "email", email)
+        pulumi.set(__self__, "reason", reason)
+        pulumi.set(__self__, "status", status)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.int:
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> _builtins.str:
+        return pulumi.get(self, "email")
+
+    @_builtins.property
+    @pulumi.getter
+    def reason(self) -> _builtins.str:
+        return pulumi.get(self, "reason")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        return pulumi.get(self, "status")
+
+
+@pulumi.output_type
+class ClickTrackingSetting(dict):
+    def __init__(__self__, *,
+                 enable_text: _builtins.bool,
+                 enabled: _builtins.bool):
+        pulumi.set(__self__, "enable_text", enable_text)
+        pulumi.set(__self__, "enabled", enabled)
+
+    @_builtins.property
+    @pulumi.getter(name="enableText")
+    def enable_text(self) -> _builtins.bool:
+        return pulumi.get(self, "enable_text")
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        return pulumi.get(self, "enabled")
+
+
+@pulumi.output_type
+class DNSProviderRecord(dict):
+    def __init__(__self__, *,
+                 name: _builtins.str,
+                 ttl: _builtins.int,
+                 type: _builtins.str,
+                 value: _builtins.str):
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "ttl", ttl)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "value", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def ttl(self) -> _builtins.int:
+        return pulumi.get(self, "ttl")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> _builtins.str:
+        return pulumi.get(self, "type")
+
+    @_builtins.property
+    @pulumi.getter
+    def value(self) -> _builtins.str:
+        return pulumi.get(self, "value")
+
+
 @pulumi.output_type
 class DNSRecord(dict):
     def __init__(__self__, *,
@@ -54,14 +255,77 @@ class DNSRecord(dict):
 
 
 @pulumi.output_type
-class LinkBrandingDNSRecord(dict):
+class DesignSummary(dict):
+    def __init__(__self__, *,
+                 categories: Sequence[_builtins.str],
+                 created_at: _builtins.str,
+                 design_id: _builtins.str,
+                 editor: _builtins.str,
+                 name: _builtins.str,
+                 subject: _builtins.str,
+                 thumbnail_url: _builtins.str,
+                 updated_at: _builtins.str):
+        pulumi.set(__self__, "categories", categories)
+        pulumi.set(__self__, "created_at", created_at)
+        pulumi.set(__self__, "design_id", design_id)
+        pulumi.set(__self__, "editor", editor)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "subject", subject)
+        pulumi.set(__self__, "thumbnail_url", thumbnail_url)
+        pulumi.set(__self__, "updated_at", updated_at)
+
+    @_builtins.property
+    @pulumi.getter
+    def categories(self) -> Sequence[_builtins.str]:
+        return pulumi.get(self, "categories")
+
+    @_builtins.property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> _builtins.str:
+        return pulumi.get(self, "created_at")
+
+    @_builtins.property
+    @pulumi.getter(name="designId")
+    def design_id(self) -> _builtins.str:
+        return pulumi.get(self, "design_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def editor(self) -> _builtins.str:
+        return pulumi.get(self, "editor")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def subject(self) -> _builtins.str:
+        return pulumi.get(self, "subject")
+
+    @_builtins.property
+    @pulumi.getter(name="thumbnailUrl")
+    def thumbnail_url(self) -> _builtins.str:
+        return pulumi.get(self, "thumbnail_url")
+
+    @_builtins.property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> _builtins.str:
+        return pulumi.get(self, "updated_at")
+
+
+@pulumi.output_type
+class DomainDNSRecord(dict):
     def __init__(__self__, *,
                  data: _builtins.str,
                  host: _builtins.str,
+                 name: _builtins.str,
                  type: _builtins.str,
                  valid: _builtins.bool):
         pulumi.set(__self__, "data", data)
         pulumi.set(__self__, "host", host)
+        pulumi.set(__self__, "name", name)
         pulumi.set(__self__, "type", type)
         pulumi.set(__self__, "valid", valid)
 
@@ -75,6 +339,11 @@ class LinkBrandingDNSRecord(dict):
     def host(self) -> _builtins.str:
         return pulumi.get(self, "host")
 
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        return pulumi.get(self, "name")
+
     @_builtins.property
     @pulumi.getter
     def type(self) -> _builtins.str:
@@ -86,6 +355,872 @@ class LinkBrandingDNSRecord(dict):
         return pulumi.get(self, "valid")
 
 
+@pulumi.output_type
+class EmailActivityMessage(dict):
+    def __init__(__self__, *,
+                 clicks_count: _builtins.int,
+                 from_email: _builtins.str,
+                 last_event_time: _builtins.str,
+                 msg_id: _builtins.str,
+                 opens_count: _builtins.int,
+                 status: _builtins.str,
+                 subject: _builtins.str,
+                 to_email: _builtins.str):
+        pulumi.set(__self__, "clicks_count", clicks_count)
+        pulumi.set(__self__, "from_email", from_email)
+        pulumi.set(__self__, "last_event_time", last_event_time)
+        pulumi.set(__self__, "msg_id", msg_id)
+        pulumi.set(__self__, "opens_count", opens_count)
+        pulumi.set(__self__, "status", status)
+        pulumi.set(__self__, "subject", subject)
+        pulumi.set(__self__, "to_email", to_email)
+
+    @_builtins.property
+    @pulumi.getter(name="clicksCount")
+    def clicks_count(self) -> _builtins.int:
+        return pulumi.get(self, "clicks_count")
+
+    @_builtins.property
+    @pulumi.getter(name="fromEmail")
+    def from_email(self) -> _builtins.str:
+        return pulumi.get(self, "from_email")
+
+    @_builtins.property
+    @pulumi.getter(name="lastEventTime")
+    def last_event_time(self) -> _builtins.str:
+        return pulumi.get(self, "last_event_time")
+
+    @_builtins.property
+    @pulumi.getter(name="msgId")
+    def msg_id(self) -> _builtins.str:
+        return pulumi.get(self, "msg_id")
+
+    @_builtins.property
+    @pulumi.getter(name="opensCount")
+    def opens_count(self) -> _builtins.int:
+        return pulumi.get(self, "opens_count")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        return pulumi.get(self, "status")
+
+    @_builtins.property
+    @pulumi.getter
+    def subject(self) -> _builtins.str:
+        return pulumi.get(self, "subject")
+
+    @_builtins.property
+    @pulumi.getter(name="toEmail")
+    def to_email(self) -> _builtins.str:
+        return pulumi.get(self, "to_email")
+
+
+@pulumi.output_type
+class EmailValidationChecks(dict):
+    def __init__(__self__, *,
+                 has_known_bounces: _builtins.bool,
+                 has_mx_or_a_record: _builtins.bool,
+                 has_suspected_bounces: _builtins.bool,
+                 has_valid_address_syntax: _builtins.bool,
+                 is_suspected_disposable_address: _builtins.bool,
+                 is_suspected_role_address: _builtins.bool):
+        pulumi.set(__self__, "has_known_bounces", has_known_bounces)
+        pulumi.set(__self__, "has_mx_or_a_record", has_mx_or_a_record)
+        pulumi.set(__self__, "has_suspected_bounces", has_suspected_bounces)
+        pulumi.set(__self__, "has_valid_address_syntax", has_valid_address_syntax)
+        pulumi.set(__self__, "is_suspected_disposable_address", is_suspected_disposable_address)
+        pulumi.set(__self__, "is_suspected_role_address", is_suspected_role_address)
+
+    @_builtins.property
+    @pulumi.getter(name="hasKnownBounces")
+    def has_known_bounces(self) -> _builtins.bool:
+        return pulumi.get(self, "has_known_bounces")
+
+    @_builtins.property
+    @pulumi.getter(name="hasMxOrARecord")
+    def has_mx_or_a_record(self) -> _builtins.bool:
+        return pulumi.get(self, "has_mx_or_a_record")
+
+    @_builtins.property
+    @pulumi.getter(name="hasSuspectedBounces")
+    def has_suspected_bounces(self) -> _builtins.bool:
+        return pulumi.get(self, "has_suspected_bounces")
+
+    @_builtins.property
+    @pulumi.getter(name="hasValidAddressSyntax")
+    def has_valid_address_syntax(self) -> _builtins.bool:
+        return pulumi.get(self, "has_valid_address_syntax")
+
+    @_builtins.property
+    @pulumi.getter(name="isSuspectedDisposableAddress")
+    def is_suspected_disposable_address(self) -> _builtins.bool:
+        return pulumi.get(self, "is_suspected_disposable_address")
+
+    @_builtins.property
+    @pulumi.getter(name="isSuspectedRoleAddress")
+    def is_suspected_role_address(self) -> _builtins.bool:
+        return pulumi.get(self, "is_suspected_role_address")
+
+
+@pulumi.output_type
+class EventWebhookSummary(dict):
+    def __init__(__self__, *,
+                 bounce: _builtins.bool,
+                 click: _builtins.bool,
+                 deferred: _builtins.bool,
+                 delivered: _builtins.bool,
+                 dropped: _builtins.bool,
+                 enabled: _builtins.bool,
+                 group_resubscribe: _builtins.bool,
+                 group_unsubscribe: _builtins.bool,
+                 open: _builtins.bool,
+                 processed: _builtins.bool,
+                 spam_report: _builtins.bool,
+                 unsubscribe: _builtins.bool,
+                 url: _builtins.str,
+                 webhook_id: _builtins.str,
+                 friendly_name: Optional[_builtins.str] = None):
+        pulumi.set(__self__, "bounce", bounce)
+        pulumi.set(__self__, "click", click)
+        pulumi.set(__self__, "deferred", deferred)
+        pulumi.set(__self__, "delivered", delivered)
+        pulumi.set(__self__, "dropped", dropped)
+        pulumi.set(__self__, "enabled", enabled)
+        pulumi.set(__self__, "group_resubscribe", group_resubscribe)
+        pulumi.set(__self__, "group_unsubscribe", group_unsubscribe)
+        pulumi.set(__self__, "open", open)
+        pulumi.set(__self__, "processed", processed)
+        pulumi.set(__self__, "spam_report", spam_report)
+        pulumi.set(__self__, "unsubscribe", unsubscribe)
+        pulumi.set(__self__, "url", url)
+        pulumi.set(__self__, "webhook_id", webhook_id)
+        if friendly_name is not None:
+            pulumi.set(__self__, "friendly_name", friendly_name)
+
+    @_builtins.property
+    @pulumi.getter
+    def bounce(self) -> _builtins.bool:
+        return pulumi.get(self, "bounce")
+
+    @_builtins.property
+    @pulumi.getter
+    def click(self) -> _builtins.bool:
+        return pulumi.get(self, "click")
+
+    @_builtins.property
+    @pulumi.getter
+    def deferred(self) -> _builtins.bool:
+        return pulumi.get(self, "deferred")
+
+    @_builtins.property
+    @pulumi.getter
+    def delivered(self) -> _builtins.bool:
+        return pulumi.get(self, "delivered")
+
+    @_builtins.property
+    @pulumi.getter
+    def dropped(self) -> _builtins.bool:
+        return pulumi.get(self, "dropped")
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter(name="groupResubscribe")
+    def group_resubscribe(self) -> _builtins.bool:
+        return pulumi.get(self, "group_resubscribe")
+
+    @_builtins.property
+    @pulumi.getter(name="groupUnsubscribe")
+    def group_unsubscribe(self) -> _builtins.bool:
+        return pulumi.get(self, "group_unsubscribe")
+
+    @_builtins.property
+    @pulumi.getter
+    def open(self) -> _builtins.bool:
+        return pulumi.get(self, "open")
+
+    @_builtins.property
+    @pulumi.getter
+    def processed(self) -> _builtins.bool:
+        return pulumi.get(self, "processed")
+
+    @_builtins.property
+    @pulumi.getter(name="spamReport")
+    def spam_report(self) -> _builtins.bool:
+        return pulumi.get(self, "spam_report")
+
+    @_builtins.property
+    @pulumi.getter
+    def unsubscribe(self) -> _builtins.bool:
+        return pulumi.get(self, "unsubscribe")
+
+    @_builtins.property
+    @pulumi.getter
+    def url(self) -> _builtins.str:
+        return pulumi.get(self, "url")
+
+    @_builtins.property
+    @pulumi.getter(name="webhookId")
+    def webhook_id(self) -> _builtins.str:
+        return pulumi.get(self, "webhook_id")
+
+    @_builtins.property
+    @pulumi.getter(name="friendlyName")
+    def friendly_name(self) -> Optional[_builtins.str]:
+        return pulumi.get(self, "friendly_name")
+
+
+@pulumi.output_type
+class GlobalSuppressionEntry(dict):
+    def __init__(__self__, *,
+                 created: _builtins.int,
+                 email: _builtins.str):
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "email", email)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.int:
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> _builtins.str:
+        return pulumi.get(self, "email")
+
+
+@pulumi.output_type
+class GoogleAnalyticsTrackingSetting(dict):
+    def __init__(__self__, *,
+                 enabled: _builtins.bool,
+                 utm_campaign: _builtins.str,
+                 utm_content: _builtins.str,
+                 utm_medium: _builtins.str,
+                 utm_source: _builtins.str,
+                 utm_term: _builtins.str):
+        pulumi.set(__self__, "enabled", enabled)
+        pulumi.set(__self__, "utm_campaign", utm_campaign)
+        pulumi.set(__self__, "utm_content", utm_content)
+        pulumi.set(__self__, "utm_medium", utm_medium)
+        pulumi.set(__self__, "utm_source", utm_source)
+        pulumi.set(__self__, "utm_term", utm_term)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter(name="utmCampaign")
+    def utm_campaign(self) -> _builtins.str:
+        return pulumi.get(self, "utm_campaign")
+
+    @_builtins.property
+    @pulumi.getter(name="utmContent")
+    def utm_content(self) -> _builtins.str:
+        return pulumi.get(self, "utm_content")
+
+    @_builtins.property
+    @pulumi.getter(name="utmMedium")
+    def utm_medium(self) -> _builtins.str:
+        return pulumi.get(self, "utm_medium")
+
+    @_builtins.property
+    @pulumi.getter(name="utmSource")
+    def utm_source(self) -> _builtins.str:
+        return pulumi.get(self, "utm_source")
+
+    @_builtins.property
+    @pulumi.getter(name="utmTerm")
+    def utm_term(self) -> _builtins.str:
+        return pulumi.get(self, "utm_term")
+
+
+@pulumi.output_type
+class InboundParseSetting(dict):
+    def __init__(__self__, *,
+                 hostname: _builtins.str,
+                 send_raw: _builtins.bool,
+                 spam_check: _builtins.bool,
+                 url: _builtins.str):
+        pulumi.set(__self__, "hostname", hostname)
+        pulumi.set(__self__, "send_raw", send_raw)
+        pulumi.set(__self__, "spam_check", spam_check)
+        pulumi.set(__self__, "url", url)
+
+    @_builtins.property
+    @pulumi.getter
+    def hostname(self) -> _builtins.str:
+        return pulumi.get(self, "hostname")
+
+    @_builtins.property
+    @pulumi.getter(name="sendRaw")
+    def send_raw(self) -> _builtins.bool:
+        return pulumi.get(self, "send_raw")
+
+    @_builtins.property
+    @pulumi.getter(name="spamCheck")
+    def spam_check(self) -> _builtins.bool:
+        return pulumi.get(self, "spam_check")
+
+    @_builtins.property
+    @pulumi.getter
+    def url(self) -> _builtins.str:
+        return pulumi.get(self, "url")
+
+
+@pulumi.output_type
+class InvalidEmailEntry(dict):
+    def __init__(__self__, *,
+                 created: _builtins.int,
+                 email: _builtins.str,
+                 reason: _builtins.str):
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "email", email)
+        pulumi.set(__self__, "reason", reason)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.int:
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> _builtins.str:
+        return pulumi.get(self, "email")
+
+    @_builtins.property
+    @pulumi.getter
+    def reason(self) -> _builtins.str:
+        return pulumi.get(self, "reason")
+
+
+@pulumi.output_type
+class LinkBrandingDNSRecord(dict):
+    def __init__(__self__, *,
+                 data: _builtins.str,
+                 host: _builtins.str,
+                 type: _builtins.str,
+                 valid: _builtins.bool):
+        pulumi.set(__self__, "data", data)
+        pulumi.set(__self__, "host", host)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "valid", valid)
+
+    @_builtins.property
+    @pulumi.getter
+    def data(self) -> _builtins.str:
+        return pulumi.get(self, "data")
+
+    @_builtins.property
+    @pulumi.getter
+    def host(self) -> _builtins.str:
+        return pulumi.get(self, "host")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> _builtins.str:
+        return pulumi.get(self, "type")
+
+    @_builtins.property
+    @pulumi.getter
+    def valid(self) -> _builtins.bool:
+        return pulumi.get(self, "valid")
+
+
+@pulumi.output_type
+class MailSetting(dict):
+    def __init__(__self__, *,
+                 description: _builtins.str,
+                 enabled: _builtins.bool,
+                 name: _builtins.str,
+                 title: _builtins.str):
+        pulumi.set(__self__, "description", description)
+        pulumi.set(__self__, "enabled", enabled)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "title", title)
+
+    @_builtins.property
+    @pulumi.getter
+    def description(self) -> _builtins.str:
+        return pulumi.get(self, "description")
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def title(self) -> _builtins.str:
+        return pulumi.get(self, "title")
+
+
+@pulumi.output_type
+class MarketingListSummary(dict):
+    def __init__(__self__, *,
+                 contact_count: _builtins.int,
+                 list_id: _builtins.str,
+                 name: _builtins.str):
+        pulumi.set(__self__, "contact_count", contact_count)
+        pulumi.set(__self__, "list_id", list_id)
+        pulumi.set(__self__, "name", name)
+
+    @_builtins.property
+    @pulumi.getter(name="contactCount")
+    def contact_count(self) -> _builtins.int:
+        return pulumi.get(self, "contact_count")
+
+    @_builtins.property
+    @pulumi.getter(name="listId")
+    def list_id(self) -> _builtins.str:
+        return pulumi.get(self, "list_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        return pulumi.get(self, "name")
+
+
+@pulumi.output_type
+class MarketingSegmentSummary(dict):
+    def __init__(__self__, *,
+                 contacts_count: _builtins.int,
+                 created_at: _builtins.str,
+                 name: _builtins.str,
+                 parent_list_ids: Sequence[_builtins.str],
+                 query_dsl: _builtins.str,
+                 segment_id: _builtins.str,
+                 updated_at: _builtins.str):
+        pulumi.set(__self__, "contacts_count", contacts_count)
+        pulumi.set(__self__, "created_at", created_at)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "parent_list_ids", parent_list_ids)
+        pulumi.set(__self__, "query_dsl", query_dsl)
+        pulumi.set(__self__, "segment_id", segment_id)
+        pulumi.set(__self__, "updated_at", updated_at)
+
+    @_builtins.property
+    @pulumi.getter(name="contactsCount")
+    def contacts_count(self) -> _builtins.int:
+        return pulumi.get(self, "contacts_count")
+
+    @_builtins.property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> _builtins.str:
+        return pulumi.get(self, "created_at")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter(name="parentListIds")
+    def parent_list_ids(self) -> Sequence[_builtins.str]:
+        return pulumi.get(self, "parent_list_ids")
+
+    @_builtins.property
+    @pulumi.getter(name="queryDsl")
+    def query_dsl(self) -> _builtins.str:
+        return pulumi.get(self, "query_dsl")
+
+    @_builtins.property
+    @pulumi.getter(name="segmentId")
+    def segment_id(self) -> _builtins.str:
+        return pulumi.get(self, "segment_id")
+
+    @_builtins.property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> _builtins.str:
+        return pulumi.get(self, "updated_at")
+
+
+@pulumi.output_type
+class OpenTrackingSetting(dict):
+    def __init__(__self__, *,
+                 enabled: _builtins.bool):
+        pulumi.set(__self__, "enabled", enabled)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        return pulumi.get(self, "enabled")
+
+
+@pulumi.output_type
+class SpamReportEntry(dict):
+    def __init__(__self__, *,
+                 created: _builtins.int,
+                 email: _builtins.str,
+                 ip: _builtins.str):
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "email", email)
+        pulumi.set(__self__, "ip", ip)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.int:
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> _builtins.str:
+        return pulumi.get(self, "email")
+
+    @_builtins.property
+    @pulumi.getter
+    def ip(self) -> _builtins.str:
+        return pulumi.get(self, "ip")
+
+
+@pulumi.output_type
+class SsoCertificate(dict):
+    def __init__(__self__, *,
+                 certificate_id: _builtins.int,
+                 integration_id: _builtins.str,
+                 not_after: _builtins.int,
+                 not_before: _builtins.int,
+                 public_certificate: _builtins.str):
+        pulumi.set(__self__, "certificate_id", certificate_id)
+        pulumi.set(__self__, "integration_id", integration_id)
+        pulumi.set(__self__, "not_after", not_after)
+        pulumi.set(__self__, "not_before", not_before)
+        pulumi.set(__self__, "public_certificate", public_certificate)
+
+    @_builtins.property
+    @pulumi.getter(name="certificateId")
+    def certificate_id(self) -> _builtins.int:
+        return pulumi.get(self, "certificate_id")
+
+    @_builtins.property
+    @pulumi.getter(name="integrationId")
+    def integration_id(self) -> _builtins.str:
+        return pulumi.get(self, "integration_id")
+
+    @_builtins.property
+    @pulumi.getter(name="notAfter")
+    def not_after(self) -> _builtins.int:
+        return pulumi.get(self, "not_after")
+
+    @_builtins.property
+    @pulumi.getter(name="notBefore")
+    def not_before(self) -> _builtins.int:
+        return pulumi.get(self, "not_before")
+
+    @_builtins.property
+    @pulumi.getter(name="publicCertificate")
+    def public_certificate(self) -> _builtins.str:
+        return pulumi.get(self, "public_certificate")
+
+
+@pulumi.output_type
+class SsoIntegration(dict):
+    def __init__(__self__, *,
+                 audience_url: _builtins.str,
+                 completed: _builtins.bool,
+                 enabled: _builtins.bool,
+                 entity_id: _builtins.str,
+                 integration_id: _builtins.str,
+                 name: _builtins.str,
+                 signin_url: _builtins.str,
+                 signout_url: _builtins.str,
+                 single_signon_url: _builtins.str):
+        pulumi.set(__self__, "audience_url", audience_url)
+        pulumi.set(__self__, "completed", completed)
+        pulumi.set(__self__, "enabled", enabled)
+        pulumi.set(__self__, "entity_id", entity_id)
+        pulumi.set(__self__, "integration_id", integration_id)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "signin_url", signin_url)
+        pulumi.set(__self__, "signout_url", signout_url)
+        pulumi.set(__self__, "single_signon_url", single_signon_url)
+
+    @_builtins.property
+    @pulumi.getter(name="audienceUrl")
+    def audience_url(self) -> _builtins.str:
+        return pulumi.get(self, "audience_url")
+
+    @_builtins.property
+    @pulumi.getter
+    def completed(self) -> _builtins.bool:
+        return pulumi.get(self, "completed")
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter(name="entityId")
+    def entity_id(self) -> _builtins.str:
+        return pulumi.get(self, "entity_id")
+
+    @_builtins.property
+    @pulumi.getter(name="integrationId")
+    def integration_id(self) -> _builtins.str:
+        return pulumi.get(self, "integration_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter(name="signinUrl")
+    def signin_url(self) -> _builtins.str:
+        return pulumi.get(self, "signin_url")
+
+    @_builtins.property
+    @pulumi.getter(name="signoutUrl")
+    def signout_url(self) -> _builtins.str:
+        return pulumi.get(self, "signout_url")
+
+    @_builtins.property
+    @pulumi.getter(name="singleSignonUrl")
+    def single_signon_url(self) -> _builtins.str:
+        return pulumi.get(self, "single_signon_url")
+
+
+@pulumi.output_type
+class StatsEntry(dict):
+    def __init__(__self__, *,
+                 date: _builtins.str,
+                 stats: Sequence['outputs.StatsMetricsEntry']):
+        pulumi.set(__self__, "date", date)
+        pulumi.set(__self__, "stats", stats)
+
+    @_builtins.property
+    @pulumi.getter
+    def date(self) -> _builtins.str:
+        return pulumi.get(self, "date")
+
+    @_builtins.property
+    @pulumi.getter
+    def stats(self) -> Sequence['outputs.StatsMetricsEntry']:
+        return pulumi.get(self, "stats")
+
+
+@pulumi.output_type
+class StatsMetrics(dict):
+    def __init__(__self__, *,
+                 blocks: _builtins.int,
+                 bounce_drops: _builtins.int,
+                 bounces: _builtins.int,
+                 clicks: _builtins.int,
+                 deferred: _builtins.int,
+                 delivered: _builtins.int,
+                 invalid_emails: _builtins.int,
+                 opens: _builtins.int,
+                 processed: _builtins.int,
+                 requests: _builtins.int,
+                 spam_report_drops: _builtins.int,
+                 spam_reports: _builtins.int,
+                 unique_clicks: _builtins.int,
+                 unique_opens: _builtins.int,
+                 unsubscribe_drops: _builtins.int,
+                 unsubscribes: _builtins.int):
+        pulumi.set(__self__, "blocks", blocks)
+        pulumi.set(__self__, "bounce_drops", bounce_drops)
+        pulumi.set(__self__, "bounces", bounces)
+        pulumi.set(__self__, "clicks", clicks)
+        pulumi.set(__self__, "deferred", deferred)
+        pulumi.set(__self__, "delivered", delivered)
+        pulumi.set(__self__, "invalid_emails", invalid_emails)
+        pulumi.set(__self__, "opens", opens)
+        pulumi.set(__self__, "processed", processed)
+        pulumi.set(__self__, "requests", requests)
+        pulumi.set(__self__, "spam_report_drops", spam_report_drops)
+        pulumi.set(__self__, "spam_reports", spam_reports)
+        pulumi.set(__self__, "unique_clicks", unique_clicks)
+        pulumi.set(__self__, "unique_opens", unique_opens)
+        pulumi.set(__self__, "unsubscribe_drops", unsubscribe_drops)
+        pulumi.set(__self__, "unsubscribes", unsubscribes)
+
+    @_builtins.property
+    @pulumi.getter
+    def blocks(self) -> _builtins.int:
+        return pulumi.get(self, "blocks")
+
+    @_builtins.property
+    @pulumi.getter(name="bounceDrops")
+    def bounce_drops(self) -> _builtins.int:
+        return pulumi.get(self, "bounce_drops")
+
+    @_builtins.property
+    @pulumi.getter
+    def bounces(self) -> _builtins.int:
+        return pulumi.get(self, "bounces")
+
+    @_builtins.property
+    @pulumi.getter
+    def clicks(self) -> _builtins.int:
+        return pulumi.get(self, "clicks")
+
+    @_builtins.property
+    @pulumi.getter
+    def deferred(self) -> _builtins.int:
+        return pulumi.get(self, "deferred")
+
+    @_builtins.property
+    @pulumi.getter
+    def delivered(self) -> _builtins.int:
+        return pulumi.get(self, "delivered")
+
+    @_builtins.property
+    @pulumi.getter(name="invalidEmails")
+    def invalid_emails(self) -> _builtins.int:
+        return pulumi.get(self, "invalid_emails")
+
+    @_builtins.property
+    @pulumi.getter
+    def opens(self) -> _builtins.int:
+        return pulumi.get(self, "opens")
+
+    @_builtins.property
+    @pulumi.getter
+    def processed(self) -> _builtins.int:
+        return pulumi.get(self, "processed")
+
+    @_builtins.property
+    @pulumi.getter
+    def requests(self) -> _builtins.int:
+        return pulumi.get(self, "requests")
+
+    @_builtins.property
+    @pulumi.getter(name="spamReportDrops")
+    def spam_report_drops(self) -> _builtins.int:
+        return pulumi.get(self, "spam_report_drops")
+
+    @_builtins.property
+    @pulumi.getter(name="spamReports")
+    def spam_reports(self) -> _builtins.int:
+        return pulumi.get(self, "spam_reports")
+
+    @_builtins.property
+    @pulumi.getter(name="uniqueClicks")
+    def unique_clicks(self) -> _builtins.int:
+        return pulumi.get(self, "unique_clicks")
+
+    @_builtins.property
+    @pulumi.getter(name="uniqueOpens")
+    def unique_opens(self) -> _builtins.int:
+        return pulumi.get(self, "unique_opens")
+
+    @_builtins.property
+    @pulumi.getter(name="unsubscribeDrops")
+    def unsubscribe_drops(self) -> _builtins.int:
+        return pulumi.get(self, "unsubscribe_drops")
+
+    @_builtins.property
+    @pulumi.getter
+    def unsubscribes(self) -> _builtins.int:
+        return pulumi.get(self, "unsubscribes")
+
+
+@pulumi.output_type
+class StatsMetricsEntry(dict):
+    def __init__(__self__, *,
+                 metrics: 'outputs.StatsMetrics',
+                 name: Optional[_builtins.str] = None,
+                 type: Optional[_builtins.str] = None):
+        pulumi.set(__self__, "metrics", metrics)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @_builtins.property
+    @pulumi.getter
+    def metrics(self) -> 'outputs.StatsMetrics':
+        return pulumi.get(self, "metrics")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[_builtins.str]:
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> Optional[_builtins.str]:
+        return pulumi.get(self, "type")
+
+
+@pulumi.output_type
+class SubscriptionTrackingSetting(dict):
+    def __init__(__self__, *,
+                 enabled: _builtins.bool,
+                 html_content: _builtins.str,
+                 landing: _builtins.str,
+                 plain_content: _builtins.str,
+                 replace: _builtins.str,
+                 url: _builtins.str):
+        pulumi.set(__self__, "enabled", enabled)
+        pulumi.set(__self__, "html_content", html_content)
+        pulumi.set(__self__, "landing", landing)
+        pulumi.set(__self__, "plain_content", plain_content)
+        pulumi.set(__self__, "replace", replace)
+        pulumi.set(__self__, "url", url)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter(name="htmlContent")
+    def html_content(self) -> _builtins.str:
+        return pulumi.get(self, "html_content")
+
+    @_builtins.property
+    @pulumi.getter
+    def landing(self) -> _builtins.str:
+        return pulumi.get(self, "landing")
+
+    @_builtins.property
+    @pulumi.getter(name="plainContent")
+    def plain_content(self) -> _builtins.str:
+        return pulumi.get(self, "plain_content")
+
+    @_builtins.property
+    @pulumi.getter
+    def replace(self) -> _builtins.str:
+        return pulumi.get(self, "replace")
+
+    @_builtins.property
+    @pulumi.getter
+    def url(self) -> _builtins.str:
+        return pulumi.get(self, "url")
+
+
+@pulumi.output_type
+class SubuserReputation(dict):
+    def __init__(__self__, *,
+                 reputation: _builtins.float,
+                 username: _builtins.str):
+        pulumi.set(__self__, "reputation", reputation)
+        pulumi.set(__self__, "username", username)
+
+    @_builtins.property
+    @pulumi.getter
+    def reputation(self) -> _builtins.float:
+        return pulumi.get(self, "reputation")
+
+    @_builtins.property
+    @pulumi.getter
+    def username(self) -> _builtins.str:
+        return pulumi.get(self, "username")
+
+
 @pulumi.output_type
 class TemplateVersionSummary(dict):
     @staticmethod
@@ -20,24 +20,132 @@ __all__ = ['ProviderArgs', 'Provider']
 class ProviderArgs:
     def __init__(__self__, *,
                  api_key: Optional[pulumi.Input[_builtins.str]] = None,
-                 base_url: Optional[pulumi.Input[_builtins.str]] = None):
+                 api_key_file: Optional[pulumi.Input[_builtins.str]] = None,
+                 base_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 circuit_breaker_cooldown_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 circuit_breaker_threshold: Optional[pulumi.Input[_builtins.int]] = None,
+                 enable_legacy_contact_db: Optional[pulumi.Input[_builtins.bool]] = None,
+                 headers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 http_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 https_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 log_metrics: Optional[pulumi.Input[_builtins.bool]] = None,
+                 max_concurrent_requests: Optional[pulumi.Input[_builtins.int]] = None,
+                 max_retries: Optional[pulumi.Input[_builtins.int]] = None,
+                 no_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 on_behalf_of: Optional[pulumi.Input[_builtins.str]] = None,
+                 rate_limit_burst: Optional[pulumi.Input[_builtins.int]] = None,
+                 rate_limit_per_second: Optional[pulumi.Input[_builtins.float]] = None,
+                 read_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 request_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 required_scopes: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 retry_budget: Optional[pulumi.Input[_builtins.int]] = None,
+                 statsd_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 user_agent_suffix: Optional[pulumi.Input[_builtins.str]] = None,
+                 validate_api_key: Optional[pulumi.Input[_builtins.bool]] = None,
+                 validate_on_preview: Optional[pulumi.Input[_builtins.bool]] = None,
+                 write_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None):
         """
         The set of arguments for constructing a Provider resource.
-        :param pulumi.Input[_builtins.str] api_key: The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
-        :param pulumi.Input[_builtins.str] base_url: The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+        :param pulumi.Input[_builtins.str] api_key: The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
+        :param pulumi.Input[_builtins.str] api_key_file: The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+        :param pulumi.Input[_builtins.str] base_url: The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
+        :param pulumi.Input[_builtins.int] circuit_breaker_cooldown_seconds: How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+        :param pulumi.Input[_builtins.int] circuit_breaker_threshold: The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+        :param pulumi.Input[_builtins.bool] enable_legacy_contact_db: Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] headers: Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+        :param pulumi.Input[_builtins.str] http_proxy: The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+        :param pulumi.Input[_builtins.str] https_proxy: The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+        :param pulumi.Input[_builtins.bool] log_metrics: Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+        :param pulumi.Input[_builtins.int] max_concurrent_requests: The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+        :param pulumi.Input[_builtins.int] max_retries: The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+        :param pulumi.Input[_builtins.str] no_proxy: A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+        :param pulumi.Input[_builtins.str] on_behalf_of: The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+        :param pulumi.Input[_builtins.int] rate_limit_burst: The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+        :param pulumi.Input[_builtins.float] rate_limit_per_second: The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+        :param pulumi.Input[_builtins.int] read_timeout_seconds: The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+        :param pulumi.Input[_builtins.str] region: The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+        :param pulumi.Input[_builtins.int] request_timeout_seconds: The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] required_scopes: API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+        :param pulumi.Input[_builtins.int] retry_budget: The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+        :param pulumi.Input[_builtins.str] statsd_address: The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+        :param pulumi.Input[_builtins.str] user_agent_suffix: A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+        :param pulumi.Input[_builtins.bool] validate_api_key: Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+        :param pulumi.Input[_builtins.bool] validate_on_preview: Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+        :param pulumi.Input[_builtins.int] write_timeout_seconds: The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
         """
         if api_key is not None:
             pulumi.set(__self__, "api_key", api_key)
-        if base_url is None:
-            base_url = 'https://api.sendgrid.com'
+        if api_key_file is not None:
+            pulumi.set(__self__, "api_key_file", api_key_file)
         if base_url is not None:
             pulumi.set(__self__, "base_url", base_url)
+        if circuit_breaker_cooldown_seconds is None:
+            circuit_breaker_cooldown_seconds = 30
+        if circuit_breaker_cooldown_seconds is not None:
+            pulumi.set(__self__, "circuit_breaker_cooldown_seconds", circuit_breaker_cooldown_seconds)
+        if circuit_breaker_threshold is None:
+            circuit_breaker_threshold = 5
+        if circuit_breaker_threshold is not None:
+            pulumi.set(__self__, "circuit_breaker_threshold", circuit_breaker_threshold)
+        if enable_legacy_contact_db is not None:
+            pulumi.set(__self__, "enable_legacy_contact_db", enable_legacy_contact_db)
+        if headers is not None:
+            pulumi.set(__self__, "headers", headers)
+        if http_proxy is not None:
+            pulumi.set(__self__, "http_proxy", http_proxy)
+        if https_proxy is not None:
+            pulumi.set(__self__, "https_proxy", https_proxy)
+        if log_metrics is not None:
+            pulumi.set(__self__, "log_metrics", log_metrics)
+        if max_concurrent_requests is None:
+            max_concurrent_requests = 10
+        if max_concurrent_requests is not None:
+            pulumi.set(__self__, "max_concurrent_requests", max_concurrent_requests)
+        if max_retries is None:
+            max_retries = 3
+        if max_retries is not None:
+            pulumi.set(__self__, "max_retries", max_retries)
+        if no_proxy is not None:
+            pulumi.set(__self__, "no_proxy", no_proxy)
+        if on_behalf_of is not None:
+            pulumi.set(__self__, "on_behalf_of", on_behalf_of)
+        if rate_limit_burst is not None:
+            pulumi.set(__self__, "rate_limit_burst", rate_limit_burst)
+        if rate_limit_per_second is not None:
+            pulumi.set(__self__, "rate_limit_per_second", rate_limit_per_second)
+        if read_timeout_seconds is not None:
+            pulumi.set(__self__, "read_timeout_seconds", read_timeout_seconds)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
+        if request_timeout_seconds is None:
+            request_timeout_seconds = 30
+        if request_timeout_seconds is not None:
+            pulumi.set(__self__, "request_timeout_seconds", request_timeout_seconds)
+        if required_scopes is not None:
+            pulumi.set(__self__, "required_scopes", required_scopes)
+        if retry_budget is None:
+            retry_budget = 20
+        if retry_budget is not None:
+            pulumi.set(__self__, "retry_budget", retry_budget)
+        if statsd_address is not None:
+            pulumi.set(__self__, "statsd_address", statsd_address)
+        if user_agent_suffix is not None:
+            pulumi.set(__self__, "user_agent_suffix", user_agent_suffix)
+        if validate_api_key is None:
+            validate_api_key = True
+        if validate_api_key is not None:
+            pulumi.set(__self__, "validate_api_key", validate_api_key)
+        if validate_on_preview is not None:
+            pulumi.set(__self__, "validate_on_preview", validate_on_preview)
+        if write_timeout_seconds is not None:
+            pulumi.set(__self__, "write_timeout_seconds", write_timeout_seconds)
 
     @_builtins.property
     @pulumi.getter(name="apiKey")
     def api_key(self) -> Optional[pulumi.Input[_builtins.str]]:
         """
-        The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+        The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
         """
         return pulumi.get(self, "api_key")
 
@@ -45,11 +153,23 @@ class ProviderArgs:
     def api_key(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "api_key", value)
 
+    @_builtins.property
+    @pulumi.getter(name="apiKeyFile")
+    def api_key_file(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+        """
+        return pulumi.get(self, "api_key_file")
+
+    @api_key_file.setter
+    def api_key_file(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "api_key_file", value)
+
     @_builtins.property
     @pulumi.getter(name="baseUrl")
     def base_url(self) -> Optional[pulumi.Input[_builtins.str]]:
         """
-        The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+        The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
         """
         return pulumi.get(self, "base_url")
 
@@ -57,6 +177,282 @@ class ProviderArgs:
     def base_url(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "base_url", value)
 
+    @_builtins.property
+    @pulumi.getter(name="circuitBreakerCooldownSeconds")
+    def circuit_breaker_cooldown_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+        """
+        return pulumi.get(self, "circuit_breaker_cooldown_seconds")
+
+    @circuit_breaker_cooldown_seconds.setter
+    def circuit_breaker_cooldown_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "circuit_breaker_cooldown_seconds", value)
+
+    @_builtins.property
+    @pulumi.getter(name="circuitBreakerThreshold")
+    def circuit_breaker_threshold(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+        """
+        return pulumi.get(self, "circuit_breaker_threshold")
+
+    @circuit_breaker_threshold.setter
+    def circuit_breaker_threshold(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "circuit_breaker_threshold", value)
+
+    @_builtins.property
+    @pulumi.getter(name="enableLegacyContactDb")
+    def enable_legacy_contact_db(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+        """
+        return pulumi.get(self, "enable_legacy_contact_db")
+
+    @enable_legacy_contact_db.setter
+    def enable_legacy_contact_db(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "enable_legacy_contact_db", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def headers(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+        """
+        return pulumi.get(self, "headers")
+
+    @headers.setter
+    def headers(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "headers", value)
+
+    @_builtins.property
+    @pulumi.getter(name="httpProxy")
+    def http_proxy(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+        """
+        return pulumi.get(self, "http_proxy")
+
+    @http_proxy.setter
+    def http_proxy(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "http_proxy", value)
+
+    @_builtins.property
+    @pulumi.getter(name="httpsProxy")
+    def https_proxy(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+        """
+        return pulumi.get(self, "https_proxy")
+
+    @https_proxy.setter
+    def https_proxy(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "https_proxy", value)
+
+    @_builtins.property
+    @pulumi.getter(name="logMetrics")
+    def log_metrics(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+        """
+        return pulumi.get(self, "log_metrics")
+
+    @log_metrics.setter
+    def log_metrics(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "log_metrics", value)
+
+    @_builtins.property
+    @pulumi.getter(name="maxConcurrentRequests")
+    def max_concurrent_requests(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+        """
+        return pulumi.get(self, "max_concurrent_requests")
+
+    @max_concurrent_requests.setter
+    def max_concurrent_requests(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "max_concurrent_requests", value)
+
+    @_builtins.property
+    @pulumi.getter(name="maxRetries")
+    def max_retries(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+        """
+        return pulumi.get(self, "max_retries")
+
+    @max_retries.setter
+    def max_retries(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "max_retries", value)
+
+    @_builtins.property
+    @pulumi.getter(name="noProxy")
+    def no_proxy(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+        """
+        return pulumi.get(self, "no_proxy")
+
+    @no_proxy.setter
+    def no_proxy(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "no_proxy", value)
+
+    @_builtins.property
+    @pulumi.getter(name="onBehalfOf")
+    def on_behalf_of(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+        """
+        return pulumi.get(self, "on_behalf_of")
+
+    @on_behalf_of.setter
+    def on_behalf_of(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "on_behalf_of", value)
+
+    @_builtins.property
+    @pulumi.getter(name="rateLimitBurst")
+    def rate_limit_burst(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+        """
+        return pulumi.get(self, "rate_limit_burst")
+
+    @rate_limit_burst.setter
+    def rate_limit_burst(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "rate_limit_burst", value)
+
+    @_builtins.property
+    @pulumi.getter(name="rateLimitPerSecond")
+    def rate_limit_per_second(self) -> Optional[pulumi.Input[_builtins.float]]:
+        """
+        The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+        """
+        return pulumi.get(self, "rate_limit_per_second")
+
+    @rate_limit_per_second.setter
+    def rate_limit_per_second(self, value: Optional[pulumi.Input[_builtins.float]]):
+        pulumi.set(self, "rate_limit_per_second", value)
+
+    @_builtins.property
+    @pulumi.getter(name="readTimeoutSeconds")
+    def read_timeout_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+        """
+        return pulumi.get(self, "read_timeout_seconds")
+
+    @read_timeout_seconds.setter
+    def read_timeout_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "read_timeout_seconds", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def region(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+        """
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "region", value)
+
+    @_builtins.property
+    @pulumi.getter(name="requestTimeoutSeconds")
+    def request_timeout_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+        """
+        return pulumi.get(self, "request_timeout_seconds")
+
+    @request_timeout_seconds.setter
+    def request_timeout_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "request_timeout_seconds", value)
+
+    @_builtins.property
+    @pulumi.getter(name="requiredScopes")
+    def required_scopes(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+        """
+        return pulumi.get(self, "required_scopes")
+
+    @required_scopes.setter
+    def required_scopes(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "required_scopes", value)
+
+    @_builtins.property
+    @pulumi.getter(name="retryBudget")
+    def retry_budget(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+        """
+        return pulumi.get(self, "retry_budget")
+
+    @retry_budget.setter
+    def retry_budget(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "retry_budget", value)
+
+    @_builtins.property
+    @pulumi.getter(name="statsdAddress")
+    def statsd_address(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+        """
+        return pulumi.get(self, "statsd_address")
+
+    @statsd_address.setter
+    def statsd_address(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "statsd_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="userAgentSuffix")
+    def user_agent_suffix(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+        """
+        return pulumi.get(self, "user_agent_suffix")
+
+    @user_agent_suffix.setter
+    def user_agent_suffix(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "user_agent_suffix", value)
+
+    @_builtins.property
+    @pulumi.getter(name="validateApiKey")
+    def validate_api_key(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+        """
+        return pulumi.get(self, "validate_api_key")
+
+    @validate_api_key.setter
+    def validate_api_key(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "validate_api_key", value)
+
+    @_builtins.property
+    @pulumi.getter(name="validateOnPreview")
+    def validate_on_preview(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+        """
+        return pulumi.get(self, "validate_on_preview")
+
+    @validate_on_preview.setter
+    def validate_on_preview(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "validate_on_preview", value)
+
+    @_builtins.property
+    @pulumi.getter(name="writeTimeoutSeconds")
+    def write_timeout_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
+        """
+        return pulumi.get(self, "write_timeout_seconds")
+
+    @write_timeout_seconds.setter
+    def write_timeout_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "write_timeout_seconds", value)
+
 
 @pulumi.type_token("pulumi:providers:sendgrid")
 class Provider(pulumi.ProviderResource):
@@ -65,14 +461,62 @@ class Provider(pulumi.ProviderResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  api_key: Optional[pulumi.Input[_builtins.str]] = None,
+                 api_key_file: Optional[pulumi.Input[_builtins.str]] = None,
                  base_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 circuit_breaker_cooldown_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 circuit_breaker_threshold: Optional[pulumi.Input[_builtins.int]] = None,
+                 enable_legacy_contact_db: Optional[pulumi.Input[_builtins.bool]] = None,
+                 headers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 http_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 https_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 log_metrics: Optional[pulumi.Input[_builtins.bool]] = None,
+                 max_concurrent_requests: Optional[pulumi.Input[_builtins.int]] = None,
+                 max_retries: Optional[pulumi.Input[_builtins.int]] = None,
+                 no_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 on_behalf_of: Optional[pulumi.Input[_builtins.str]] = None,
+                 rate_limit_burst: Optional[pulumi.Input[_builtins.int]] = None,
+                 rate_limit_per_second: Optional[pulumi.Input[_builtins.float]] = None,
+                 read_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 request_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 required_scopes: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 retry_budget: Optional[pulumi.Input[_builtins.int]] = None,
+                 statsd_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 user_agent_suffix: Optional[pulumi.Input[_builtins.str]] = None,
+                 validate_api_key: Optional[pulumi.Input[_builtins.bool]] = None,
+                 validate_on_preview: Optional[pulumi.Input[_builtins.bool]] = None,
+                 write_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  __props__=None):
         """
         Create a Sendgrid resource with the given unique name, props, and options.
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
-        :param pulumi.Input[_builtins.str] api_key: The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
-        :param pulumi.Input[_builtins.str] base_url: The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+        :param pulumi.Input[_builtins.str] api_key: The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
+        :param pulumi.Input[_builtins.str] api_key_file: The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+        :param pulumi.Input[_builtins.str] base_url: The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
+        :param pulumi.Input[_builtins.int] circuit_breaker_cooldown_seconds: How long in seconds requests fail fast once the circuit breaker trips, before a single request is let through to check whether SendGrid has recovered. Defaults to 30.
+        :param pulumi.Input[_builtins.int] circuit_breaker_threshold: The number of consecutive 5xx responses or network failures after which the provider stops calling SendGrid and fails remaining operations fast with a clear diagnostic, instead of hammering a degraded API. Defaults to 5. Set to 0 to disable.
+        :param pulumi.Input[_builtins.bool] enable_legacy_contact_db: Whether to enable the `ContactDbList` and `ContactDbRecipient` resources, which manage the Legacy Marketing Campaigns contact database. Only accounts that have not migrated to new Marketing Campaigns can use it. Defaults to false.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] headers: Extra HTTP headers added to every request, e.g. for egress gateway attribution. Cannot override the Authorization, Content-Type or User-Agent headers.
+        :param pulumi.Input[_builtins.str] http_proxy: The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+        :param pulumi.Input[_builtins.str] https_proxy: The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+        :param pulumi.Input[_builtins.bool] log_metrics: Log a summary after each resource or function operation of the SendGrid requests it sent: the number of requests, errors by HTTP status and a latency histogram. Defaults to false.
+        :param pulumi.Input[_builtins.int] max_concurrent_requests: The maximum number of requests the provider has in flight to SendGrid at once, shared across all resources in the deployment. Pulumi's default parallelism easily exceeds what the API accepts; further operations wait for a free slot. Defaults to 10. Set to 0 to remove the limit.
+        :param pulumi.Input[_builtins.int] max_retries: The number of times a rate-limited (429) or transiently failed (5xx) request is retried with exponential backoff, honoring the Retry-After and X-RateLimit-Reset headers. A 404 reading or updating a resource within 30 seconds of creating it, such as a subuser, is retried too, as SendGrid can take a moment to make new resources visible. Defaults to 3. Set to 0 to disable retries.
+        :param pulumi.Input[_builtins.str] no_proxy: A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+        :param pulumi.Input[_builtins.str] on_behalf_of: The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+        :param pulumi.Input[_builtins.int] rate_limit_burst: The number of requests that may be sent at once before `rateLimitPerSecond` applies. Defaults to `rateLimitPerSecond` rounded up.
+        :param pulumi.Input[_builtins.float] rate_limit_per_second: The average number of requests per second the provider sends to SendGrid, shared across all resources in the deployment. Use this to stay below your account's rate limits instead of relying on retries. Unset or 0 disables client-side rate limiting.
+        :param pulumi.Input[_builtins.int] read_timeout_seconds: The time limit in seconds for read (GET) requests, overriding `requestTimeoutSeconds`. Use a short value to make refreshes fail fast.
+        :param pulumi.Input[_builtins.str] region: The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+        :param pulumi.Input[_builtins.int] request_timeout_seconds: The time limit in seconds for a single SendGrid API request. Defaults to 30. Set to 0 to disable the limit.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] required_scopes: API key scopes that must be granted, e.g. ["templates.create"]. Configuration fails with a clear error if the key is missing any of them. Requires `validateApiKey`.
+        :param pulumi.Input[_builtins.int] retry_budget: The number of retries shared by all resources in the deployment. Every retry of a failed request spends one and successful requests slowly earn them back; once spent, failed requests are not retried. While SendGrid is rate limiting, all requests wait for the rate limit to reset instead of retrying independently. Defaults to 20. Set to 0 to let each request retry on its own.
+        :param pulumi.Input[_builtins.str] statsd_address: The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+        :param pulumi.Input[_builtins.str] user_agent_suffix: A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+        :param pulumi.Input[_builtins.bool] validate_api_key: Whether to check the API key against SendGrid when the provider is configured, so an invalid key fails before any resource operations run. Defaults to true.
+        :param pulumi.Input[_builtins.bool] validate_on_preview: Whether to validate resource inputs against SendGrid with read-only lookups during previews, e.g. that the scopes of an API key or teammate can be granted, an API key, domain or unsubscribe group name is not already taken, and a template version's template exists. Catches errors that would otherwise only surface during `pulumi up`. Defaults to false.
+        :param pulumi.Input[_builtins.int] write_timeout_seconds: The time limit in seconds for create, update and delete requests, overriding `requestTimeoutSeconds`. Use a long value for large template uploads.
         """
         ...
     @overload
@@ -98,7 +542,31 @@ class Provider(pulumi.ProviderResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  api_key: Optional[pulumi.Input[_builtins.str]] = None,
+                 api_key_file: Optional[pulumi.Input[_builtins.str]] = None,
                  base_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 circuit_breaker_cooldown_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 circuit_breaker_threshold: Optional[pulumi.Input[_builtins.int]] = None,
+                 enable_legacy_contact_db: Optional[pulumi.Input[_builtins.bool]] = None,
+                 headers: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 http_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 https_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 log_metrics: Optional[pulumi.Input[_builtins.bool]] = None,
+                 max_concurrent_requests: Optional[pulumi.Input[_builtins.int]] = None,
+                 max_retries: Optional[pulumi.Input[_builtins.int]] = None,
+                 no_proxy: Optional[pulumi.Input[_builtins.str]] = None,
+                 on_behalf_of: Optional[pulumi.Input[_builtins.str]] = None,
+                 rate_limit_burst: Optional[pulumi.Input[_builtins.int]] = None,
+                 rate_limit_per_second: Optional[pulumi.Input[_builtins.float]] = None,
+                 read_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 request_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 required_scopes: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 retry_budget: Optional[pulumi.Input[_builtins.int]] = None,
+                 statsd_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 user_agent_suffix: Optional[pulumi.Input[_builtins.str]] = None,
+                 validate_api_key: Optional[pulumi.Input[_builtins.bool]] = None,
+                 validate_on_preview: Optional[pulumi.Input[_builtins.bool]] = None,
+                 write_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -109,10 +577,46 @@ class Provider(pulumi.ProviderResource):
             __props__ = ProviderArgs.__new__(ProviderArgs)
 
             __props__.__dict__["api_key"] = None if api_key is None else pulumi.Output.secret(api_key)
-            if base_url is None:
-                base_url = 'https://api.sendgrid.com'
+            __props__.__dict__["api_key_file"] = api_key_file
             __props__.__dict__["base_url"] = base_url
-        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["apiKey"])
+            if circuit_breaker_cooldown_seconds is None:
+                circuit_breaker_cooldown_seconds = 30
+            __props__.__dict__["circuit_breaker_cooldown_seconds"] = pulumi.Output.from_input(circuit_breaker_cooldown_seconds).apply(pulumi.runtime.to_json) if circuit_breaker_cooldown_seconds is not None else None
+            if circuit_breaker_threshold is None:
+                circuit_breaker_threshold = 5
+            __props__.__dict__["circuit_breaker_threshold"] = pulumi.Output.from_input(circuit_breaker_threshold).apply(pulumi.runtime.to_json) if circuit_breaker_threshold is not None else None
+            __props__.__dict__["enable_legacy_contact_db"] = pulumi.Output.from_input(enable_legacy_contact_db).apply(pulumi.runtime.to_json) if enable_legacy_contact_db is not None else None
+            __props__.__dict__["headers"] = pulumi.Output.from_input(headers).apply(pulumi.runtime.to_json) if headers is not None else None
+            __props__.__dict__["http_proxy"] = None if http_proxy is None else pulumi.Output.secret(http_proxy)
+            __props__.__dict__["https_proxy"] = None if https_proxy is None else pulumi.Output.secret(https_proxy)
+            __props__.__dict__["log_metrics"] = pulumi.Output.from_input(log_metrics).apply(pulumi.runtime.to_json) if log_metrics is not None else None
+            if max_concurrent_requests is None:
+                max_concurrent_requests = 10
+            __props__.__dict__["max_concurrent_requests"] = pulumi.Output.from_input(max_concurrent_requests).apply(pulumi.runtime.to_json) if max_concurrent_requests is not None else None
+            if max_retries is None:
+                max_retries = 3
+            __props__.__dict__["max_retries"] = pulumi.Output.from_input(max_retries).apply(pulumi.runtime.to_json) if max_retries is not None else None
+            __props__.__dict__["no_proxy"] = no_proxy
+            __props__.__dict__["on_behalf_of"] = on_behalf_of
+            __props__.__dict__["rate_limit_burst"] = pulumi.Output.from_input(rate_limit_burst).apply(pulumi.runtime.to_json) if rate_limit_burst is not None else None
+            __props__.__dict__["rate_limit_per_second"] = pulumi.Output.from_input(rate_limit_per_second).apply(pulumi.runtime.to_json) if rate_limit_per_second is not None else None
+            __props__.__dict__["read_timeout_seconds"] = pulumi.Output.from_input(read_timeout_seconds).apply(pulumi.runtime.to_json) if read_timeout_seconds is not None else None
+            __props__.__dict__["region"] = region
+            if request_timeout_seconds is None:
+                request_timeout_seconds = 30
+            __props__.__dict__["request_timeout_seconds"] = pulumi.Output.from_input(request_timeout_seconds).apply(pulumi.runtime.to_json) if request_timeout_seconds is not None else None
+            __props__.__dict__["required_scopes"] = pulumi.Output.from_input(required_scopes).apply(pulumi.runtime.to_json) if required_scopes is not None else None
+            if retry_budget is None:
+                retry_budget = 20
+            __props__.__dict__["retry_budget"] = pulumi.Output.from_input(retry_budget).apply(pulumi.runtime.to_json) if retry_budget is not None else None
+            __props__.__dict__["statsd_address"] = statsd_address
+            __props__.__dict__["user_agent_suffix"] = user_agent_suffix
+            if validate_api_key is None:
+                validate_api_key = True
+            __props__.__dict__["validate_api_key"] = pulumi.Output.from_input(validate_api_key).apply(pulumi.runtime.to_json) if validate_api_key is not None else None
+            __props__.__dict__["validate_on_preview"] = pulumi.Output.from_input(validate_on_preview).apply(pulumi.runtime.to_json) if validate_on_preview is not None else None
+            __props__.__dict__["write_timeout_seconds"] = pulumi.Output.from_input(write_timeout_seconds).apply(pulumi.runtime.to_json) if write_timeout_seconds is not None else None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["apiKey", "httpProxy", "httpsProxy"])
         opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(Provider, __self__).__init__(
             'sendgrid',
@@ -124,15 +628,79 @@ class Provider(pulumi.ProviderResource):
     @pulumi.getter(name="apiKey")
     def api_key(self) -> pulumi.Output[Optional[_builtins.str]]:
         """
-        The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY environment variable.
+        The SendGrid API key for authentication. Can also be set via the SENDGRID_API_KEY or SG_API_KEY environment variables.
         """
         return pulumi.get(self, "api_key")
 
+    @_builtins.property
+    @pulumi.getter(name="apiKeyFile")
+    def api_key_file(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The path of a file containing the SendGrid API key, e.g. a mounted secret. Can also be set via the SENDGRID_API_KEY_FILE environment variable. Ignored when `apiKey` is set.
+        """
+        return pulumi.get(self, "api_key_file")
+
     @_builtins.property
     @pulumi.getter(name="baseUrl")
     def base_url(self) -> pulumi.Output[Optional[_builtins.str]]:
         """
-        The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers.
+        The SendGrid API base URL. Defaults to https://api.sendgrid.com. Use https://api.eu.sendgrid.com for EU regional subusers. Takes precedence over `region`, e.g. to target a mock server in CI.
         """
         return pulumi.get(self, "base_url")
 
+    @_builtins.property
+    @pulumi.getter(name="httpProxy")
+    def http_proxy(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The proxy to route plain HTTP requests through, e.g. http://proxy.internal:3128. Falls back to the HTTP_PROXY environment variable.
+        """
+        return pulumi.get(self, "http_proxy")
+
+    @_builtins.property
+    @pulumi.getter(name="httpsProxy")
+    def https_proxy(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The proxy to route HTTPS requests through, e.g. http://proxy.internal:3128. Falls back to the HTTPS_PROXY environment variable.
+        """
+        return pulumi.get(self, "https_proxy")
+
+    @_builtins.property
+    @pulumi.getter(name="noProxy")
+    def no_proxy(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A comma-separated list of hosts, domains or CIDR ranges that bypass the proxy. Falls back to the NO_PROXY environment variable.
+        """
+        return pulumi.get(self, "no_proxy")
+
+    @_builtins.property
+    @pulumi.getter(name="onBehalfOf")
+    def on_behalf_of(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The username of a subuser whose account this provider manages, authenticating with the parent account's API key. Use one explicit provider instance per subuser to manage several subusers from a single program.
+        """
+        return pulumi.get(self, "on_behalf_of")
+
+    @_builtins.property
+    @pulumi.getter
+    def region(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The SendGrid data-residency region to send API calls to: "global" (https://api.sendgrid.com) or "eu" (https://api.eu.sendgrid.com). Can also be set via the SENDGRID_REGION environment variable. Defaults to "global".
+        """
+        return pulumi.get(self, "region")
+
+    @_builtins.property
+    @pulumi.getter(name="statsdAddress")
+    def statsd_address(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The host:port of a statsd server to push request metrics to over UDP: a `sendgrid.requests` counter, `sendgrid.errors.<status>` counters and a `sendgrid.latency` timer.
+        """
+        return pulumi.get(self, "statsd_address")
+
+    @_builtins.property
+    @pulumi.getter(name="userAgentSuffix")
+    def user_agent_suffix(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A suffix appended to the User-Agent header of every request, e.g. to attribute traffic to a team at an egress gateway.
+        """
+        return pulumi.get(self, "user_agent_suffix")
+
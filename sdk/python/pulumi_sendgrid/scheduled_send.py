@@ -0,0 +1,171 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['ScheduledSendArgs', 'ScheduledSend']
+
+@pulumi.input_type
+class ScheduledSendArgs:
+    def __init__(__self__, *,
+                 batch_id: pulumi.Input[_builtins.str],
+                 status: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None):
+        """
+        The set of arguments for constructing a ScheduledSend resource.
+        """
+        pulumi.set(__self__, "batch_id", batch_id)
+        pulumi.set(__self__, "status", status)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+
+    @_builtins.property
+    @pulumi.getter(name="batchId")
+    def batch_id(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "batch_id")
+
+    @batch_id.setter
+    def batch_id(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "batch_id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "status")
+
+    @status.setter
+    def status(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "status", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+
+@pulumi.type_token("sendgrid:index:ScheduledSend")
+class ScheduledSend(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 batch_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 status: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Manages the status of a SendGrid scheduled send.
+
+        Emails sent with a batch ID and a send_at time can be paused or cancelled until they are sent. Setting the status to "pause" holds the batch, and "cancel" discards it when its send time arrives. Deleting this resource removes the status, so a paused batch is sent again.
+
+        Batch IDs can be created with the SendGrid API and passed to the mail send requests of the batch.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ScheduledSendArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Manages the status of a SendGrid scheduled send.
+
+        Emails sent with a batch ID and a send_at time can be paused or cancelled until they are sent. Setting the status to "pause" holds the batch, and "cancel" discards it when its send time arrives. Deleting this resource removes the status, so a paused batch is sent again.
+
+        Batch IDs can be created with the SendGrid API and passed to the mail send requests of the batch.
+
+        :param str resource_name: The name of the resource.
+        :param ScheduledSendArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ScheduledSendArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 batch_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 status: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ScheduledSendArgs.__new__(ScheduledSendArgs)
+
+            if batch_id is None and not opts.urn:
+                raise TypeError("Missing required property 'batch_id'")
+            __props__.__dict__["batch_id"] = batch_id
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if status is None and not opts.urn:
+                raise TypeError("Missing required property 'status'")
+            __props__.__dict__["status"] = status
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["batchId"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(ScheduledSend, __self__).__init__(
+            'sendgrid:index:ScheduledSend',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ScheduledSend':
+        """
+        Get an existing ScheduledSend resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ScheduledSendArgs.__new__(ScheduledSendArgs)
+
+        __props__.__dict__["batch_id"] = None
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["status"] = None
+        return ScheduledSend(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="batchId")
+    def batch_id(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "batch_id")
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "status")
+
@@ -0,0 +1,85 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'SearchEmailActivityResult',
+    'AwaitableSearchEmailActivityResult',
+    'search_email_activity',
+    'search_email_activity_output',
+]
+
+@pulumi.output_type
+class SearchEmailActivityResult:
+    def __init__(__self__, messages=None):
+        if messages and not isinstance(messages, list):
+            raise TypeError("Expected argument 'messages' to be a list")
+        pulumi.set(__self__, "messages", messages)
+
+    @_builtins.property
+    @pulumi.getter
+    def messages(self) -> Sequence['outputs.EmailActivityMessage']:
+        return pulumi.get(self, "messages")
+
+
+class AwaitableSearchEmailActivityResult(SearchEmailActivityResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return SearchEmailActivityResult(
+            messages=self.messages)
+
+
+def search_email_activity(limit: Optional[_builtins.int] = None,
+                          msg_id: Optional[_builtins.str] = None,
+                          status: Optional[_builtins.str] = None,
+                          to_email: Optional[_builtins.str] = None,
+                          opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableSearchEmailActivityResult:
+    """
+    Searches the SendGrid Email Activity feed.
+
+    Filter by recipient, delivery status or message ID to verify that a message was delivered, for example as a deploy-time smoke test. Requires the Email Activity add-on.
+    """
+    __args__ = dict()
+    __args__['limit'] = limit
+    __args__['msgId'] = msg_id
+    __args__['status'] = status
+    __args__['toEmail'] = to_email
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:searchEmailActivity', __args__, opts=opts, typ=SearchEmailActivityResult).value
+
+    return AwaitableSearchEmailActivityResult(
+        messages=pulumi.get(__ret__, 'messages'))
+def search_email_activity_output(limit: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                                 msg_id: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                                 status: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                                 to_email: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                                 opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[SearchEmailActivityResult]:
+    """
+    Searches the SendGrid Email Activity feed.
+
+    Filter by recipient, delivery status or message ID to verify that a message was delivered, for example as a deploy-time smoke test. Requires the Email Activity add-on.
+    """
+    __args__ = dict()
+    __args__['limit'] = limit
+    __args__['msgId'] = msg_id
+    __args__['status'] = status
+    __args__['toEmail'] = to_email
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:searchEmailActivity', __args__, opts=opts, typ=SearchEmailActivityResult)
+    return __ret__.apply(lambda __response__: SearchEmailActivityResult(
+        messages=pulumi.get(__response__, 'messages')))
@@ -0,0 +1,100 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'SendTestEmailResult',
+    'AwaitableSendTestEmailResult',
+    'send_test_email',
+    'send_test_email_output',
+]
+
+@pulumi.output_type
+class SendTestEmailResult:
+    def __init__(__self__, message_id=None):
+        if message_id and not isinstance(message_id, str):
+            raise TypeError("Expected argument 'message_id' to be a str")
+        pulumi.set(__self__, "message_id", message_id)
+
+    @_builtins.property
+    @pulumi.getter(name="messageId")
+    def message_id(self) -> _builtins.str:
+        return pulumi.get(self, "message_id")
+
+
+class AwaitableSendTestEmailResult(SendTestEmailResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return SendTestEmailResult(
+            message_id=self.message_id)
+
+
+def send_test_email(content: Optional[_builtins.str] = None,
+                    dynamic_template_data: Optional[_builtins.str] = None,
+                    from_: Optional[_builtins.str] = None,
+                    from_name: Optional[_builtins.str] = None,
+                    sandbox_mode: Optional[_builtins.bool] = None,
+                    subject: Optional[_builtins.str] = None,
+                    template_id: Optional[_builtins.str] = None,
+                    to: Optional[_builtins.str] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableSendTestEmailResult:
+    """
+    Sends a single test email through the Mail Send API.
+
+    Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. An email is sent every time the function is called, including during previews, so call it from a dedicated smoke test program, or set sandboxMode to only validate the request.
+    """
+    __args__ = dict()
+    __args__['content'] = content
+    __args__['dynamicTemplateData'] = dynamic_template_data
+    __args__['from'] = from_
+    __args__['fromName'] = from_name
+    __args__['sandboxMode'] = sandbox_mode
+    __args__['subject'] = subject
+    __args__['templateId'] = template_id
+    __args__['to'] = to
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:sendTestEmail', __args__, opts=opts, typ=SendTestEmailResult).value
+
+    return AwaitableSendTestEmailResult(
+        message_id=pulumi.get(__ret__, 'message_id'))
+def send_test_email_output(content: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                           dynamic_template_data: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                           from_: Optional[pulumi.Input[_builtins.str]] = None,
+                           from_name: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                           sandbox_mode: Optional[pulumi.Input[Optional[_builtins.bool]]] = None,
+                           subject: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                           template_id: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                           to: Optional[pulumi.Input[_builtins.str]] = None,
+                           opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[SendTestEmailResult]:
+    """
+    Sends a single test email through the Mail Send API.
+
+    Intended for post-deployment smoke tests of templates, domain authentication and API key scopes. An email is sent every time the function is called, including during previews, so call it from a dedicated smoke test program, or set sandboxMode to only validate the request.
+    """
+    __args__ = dict()
+    __args__['content'] = content
+    __args__['dynamicTemplateData'] = dynamic_template_data
+    __args__['from'] = from_
+    __args__['fromName'] = from_name
+    __args__['sandboxMode'] = sandbox_mode
+    __args__['subject'] = subject
+    __args__['templateId'] = template_id
+    __args__['to'] = to
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:sendTestEmail', __args__, opts=opts, typ=SendTestEmailResult)
+    return __ret__.apply(lambda __response__: SendTestEmailResult(
+        message_id=pulumi.get(__response__, 'message_id')))
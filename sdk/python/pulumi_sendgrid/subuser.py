@@ -22,6 +22,7 @@ class SubuserArgs:
                  email: pulumi.Input[_builtins.str],
                  password: pulumi.Input[_builtins.str],
                  username: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  disabled: Optional[pulumi.Input[_builtins.bool]] = None,
                  ips: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  region: Optional[pulumi.Input[_builtins.str]] = None):
@@ -31,6 +32,8 @@ class SubuserArgs:
         pulumi.set(__self__, "email", email)
         pulumi.set(__self__, "password", password)
         pulumi.set(__self__, "username", username)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if disabled is not None:
             pulumi.set(__self__, "disabled", disabled)
         if ips is not None:
@@ -65,6 +68,15 @@ class SubuserArgs:
     def username(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "username", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter
     def disabled(self) -> Optional[pulumi.Input[_builtins.bool]]:
@@ -99,6 +111,7 @@ class Subuser(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  disabled: Optional[pulumi.Input[_builtins.bool]] = None,
                  email: Optional[pulumi.Input[_builtins.str]] = None,
                  ips: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
@@ -144,6 +157,7 @@ class Subuser(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  disabled: Optional[pulumi.Input[_builtins.bool]] = None,
                  email: Optional[pulumi.Input[_builtins.str]] = None,
                  ips: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
@@ -159,6 +173,7 @@ class Subuser(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = SubuserArgs.__new__(SubuserArgs)
 
+            __props__.__dict__["deletion_protection"] = deletion_protection
             __props__.__dict__["disabled"] = disabled
             if email is None and not opts.urn:
                 raise TypeError("Missing required property 'email'")
@@ -194,6 +209,7 @@ class Subuser(pulumi.CustomResource):
 
         __props__ = SubuserArgs.__new__(SubuserArgs)
 
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["disabled"] = None
         __props__.__dict__["email"] = None
         __props__.__dict__["ips"] = None
@@ -202,6 +218,11 @@ class Subuser(pulumi.CustomResource):
         __props__.__dict__["username"] = None
         return Subuser(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def disabled(self) -> pulumi.Output[_builtins.bool]:
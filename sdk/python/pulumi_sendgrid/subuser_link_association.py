@@ -0,0 +1,174 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['SubuserLinkAssociationArgs', 'SubuserLinkAssociation']
+
+@pulumi.input_type
+class SubuserLinkAssociationArgs:
+    def __init__(__self__, *,
+                 link_id: pulumi.Input[_builtins.int],
+                 username: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None):
+        """
+        The set of arguments for constructing a SubuserLinkAssociation resource.
+        """
+        pulumi.set(__self__, "link_id", link_id)
+        pulumi.set(__self__, "username", username)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+
+    @_builtins.property
+    @pulumi.getter(name="linkId")
+    def link_id(self) -> pulumi.Input[_builtins.int]:
+        return pulumi.get(self, "link_id")
+
+    @link_id.setter
+    def link_id(self, value: pulumi.Input[_builtins.int]):
+        pulumi.set(self, "link_id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def username(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "username")
+
+    @username.setter
+    def username(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "username", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+
+@pulumi.type_token("sendgrid:index:SubuserLinkAssociation")
+class SubuserLinkAssociation(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 link_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 username: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Associates a link branding of the parent account with a subuser.
+
+        The links in the subuser's emails then use the branded domain without the subuser branding it itself. A subuser has at most one associated link branding, so the resource ID is the subuser's username. Deleting the resource removes the association.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: SubuserLinkAssociationArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Associates a link branding of the parent account with a subuser.
+
+        The links in the subuser's emails then use the branded domain without the subuser branding it itself. A subuser has at most one associated link branding, so the resource ID is the subuser's username. Deleting the resource removes the association.
+
+        :param str resource_name: The name of the resource.
+        :param SubuserLinkAssociationArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(SubuserLinkAssociationArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 link_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 username: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = SubuserLinkAssociationArgs.__new__(SubuserLinkAssociationArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if link_id is None and not opts.urn:
+                raise TypeError("Missing required property 'link_id'")
+            __props__.__dict__["link_id"] = link_id
+            if username is None and not opts.urn:
+                raise TypeError("Missing required property 'username'")
+            __props__.__dict__["username"] = username
+            __props__.__dict__["domain"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["linkId", "username"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(SubuserLinkAssociation, __self__).__init__(
+            'sendgrid:index:SubuserLinkAssociation',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'SubuserLinkAssociation':
+        """
+        Get an existing SubuserLinkAssociation resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = SubuserLinkAssociationArgs.__new__(SubuserLinkAssociationArgs)
+
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["domain"] = None
+        __props__.__dict__["link_id"] = None
+        __props__.__dict__["username"] = None
+        return SubuserLinkAssociation(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter
+    def domain(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "domain")
+
+    @_builtins.property
+    @pulumi.getter(name="linkId")
+    def link_id(self) -> pulumi.Output[_builtins.int]:
+        return pulumi.get(self, "link_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def username(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "username")
+
@@ -0,0 +1,263 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['SubuserOnboardingArgs', 'SubuserOnboarding']
+
+@pulumi.input_type
+class SubuserOnboardingArgs:
+    def __init__(__self__, *,
+                 email: pulumi.Input[_builtins.str],
+                 password: pulumi.Input[_builtins.str],
+                 username: pulumi.Input[_builtins.str],
+                 api_key_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 api_key_scopes: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 domain_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 ips: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 link_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a SubuserOnboarding resource.
+        """
+        pulumi.set(__self__, "email", email)
+        pulumi.set(__self__, "password", password)
+        pulumi.set(__self__, "username", username)
+        if api_key_name is not None:
+            pulumi.set(__self__, "api_key_name", api_key_name)
+        if api_key_scopes is not None:
+            pulumi.set(__self__, "api_key_scopes", api_key_scopes)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if domain_id is not None:
+            pulumi.set(__self__, "domain_id", domain_id)
+        if ips is not None:
+            pulumi.set(__self__, "ips", ips)
+        if link_id is not None:
+            pulumi.set(__self__, "link_id", link_id)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
+
+    @_builtins.property
+    @pulumi.getter
+    def email(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "email")
+
+    @email.setter
+    def email(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "email", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def password(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "password", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def username(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "username")
+
+    @username.setter
+    def username(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "username", value)
+
+    @_builtins.property
+    @pulumi.getter(name="apiKeyName")
+    def api_key_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "api_key_name")
+
+    @api_key_name.setter
+    def api_key_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "api_key_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="apiKeyScopes")
+    def api_key_scopes(self) -> Optional[pulumi.Input[Sequence[_builtins.str]]]:
+        return pulumi.get(self, "api_key_scopes")
+
+    @api_key_scopes.setter
+    def api_key_scopes(self, value: Optional[pulumi.Input[Sequence[_builtins.str]]]):
+        pulumi.set(self, "api_key_scopes", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="domainId")
+    def domain_id(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "domain_id")
+
+    @domain_id.setter
+    def domain_id(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "domain_id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def ips(self) -> Optional[pulumi.Input[Sequence[_builtins.str]]]:
+        return pulumi.get(self, "ips")
+
+    @ips.setter
+    def ips(self, value: Optional[pulumi.Input[Sequence[_builtins.str]]]):
+        pulumi.set(self, "ips", value)
+
+    @_builtins.property
+    @pulumi.getter(name="linkId")
+    def link_id(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "link_id")
+
+    @link_id.setter
+    def link_id(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "link_id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def region(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "region", value)
+
+
+@pulumi.type_token("sendgrid:index:SubuserOnboarding")
+class SubuserOnboarding(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 api_key_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 api_key_scopes: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 domain_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 email: Optional[pulumi.Input[_builtins.str]] = None,
+                 ips: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 link_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 password: Optional[pulumi.Input[_builtins.str]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 username: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Provisions a SendGrid subuser for a tenant.
+
+        The component creates the Subuser with its IPs, then an ApiKey in the subuser's account through a provider configured with onBehalfOf, and associates the given domain authentication and link branding with the subuser, in the order SendGrid requires. The on-behalf-of provider takes the settings of the provider constructing the component, such as its credentials, proxy, timeouts, retries and headers, except onBehalfOf, validateApiKey and requiredScopes.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: SubuserOnboardingArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Provisions a SendGrid subuser for a tenant.
+
+        The component creates the Subuser with its IPs, then an ApiKey in the subuser's account through a provider configured with onBehalfOf, and associates the given domain authentication and link branding with the subuser, in the order SendGrid requires. The on-behalf-of provider takes the settings of the provider constructing the component, such as its credentials, proxy, timeouts, retries and headers, except onBehalfOf, validateApiKey and requiredScopes.
+
+        :param str resource_name: The name of the resource.
+        :param SubuserOnboardingArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(SubuserOnboardingArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 api_key_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 api_key_scopes: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 domain_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 email: Optional[pulumi.Input[_builtins.str]] = None,
+                 ips: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 link_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 password: Optional[pulumi.Input[_builtins.str]] = None,
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 username: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = SubuserOnboardingArgs.__new__(SubuserOnboardingArgs)
+
+            __props__.__dict__["api_key_name"] = api_key_name
+            __props__.__dict__["api_key_scopes"] = api_key_scopes
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            __props__.__dict__["domain_id"] = domain_id
+            if email is None and not opts.urn:
+                raise TypeError("Missing required property 'email'")
+            __props__.__dict__["email"] = email
+            __props__.__dict__["ips"] = ips
+            __props__.__dict__["link_id"] = link_id
+            if password is None and not opts.urn:
+                raise TypeError("Missing required property 'password'")
+            __props__.__dict__["password"] = None if password is None else pulumi.Output.secret(password)
+            __props__.__dict__["region"] = region
+            if username is None and not opts.urn:
+                raise TypeError("Missing required property 'username'")
+            __props__.__dict__["username"] = username
+            __props__.__dict__["api_key_id"] = None
+            __props__.__dict__["api_key_value"] = None
+            __props__.__dict__["user_id"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["apiKeyValue"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(SubuserOnboarding, __self__).__init__(
+            'sendgrid:index:SubuserOnboarding',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter(name="apiKeyId")
+    def api_key_id(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "api_key_id")
+
+    @_builtins.property
+    @pulumi.getter(name="apiKeyValue")
+    def api_key_value(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "api_key_value")
+
+    @_builtins.property
+    @pulumi.getter(name="userId")
+    def user_id(self) -> pulumi.Output[_builtins.int]:
+        return pulumi.get(self, "user_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def username(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "username")
+
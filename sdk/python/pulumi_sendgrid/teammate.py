@@ -20,12 +20,15 @@ __all__ = ['TeammateArgs', 'Teammate']
 class TeammateArgs:
     def __init__(__self__, *,
                  email: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  is_admin: Optional[pulumi.Input[_builtins.bool]] = None,
                  scopes: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None):
         """
         The set of arguments for constructing a Teammate resource.
         """
         pulumi.set(__self__, "email", email)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if is_admin is not None:
             pulumi.set(__self__, "is_admin", is_admin)
         if scopes is not None:
@@ -40,6 +43,15 @@ class TeammateArgs:
     def email(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "email", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter(name="isAdmin")
     def is_admin(self) -> Optional[pulumi.Input[_builtins.bool]]:
@@ -65,6 +77,7 @@ class Teammate(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  email: Optional[pulumi.Input[_builtins.str]] = None,
                  is_admin: Optional[pulumi.Input[_builtins.bool]] = None,
                  scopes: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
@@ -107,6 +120,7 @@ class Teammate(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  email: Optional[pulumi.Input[_builtins.str]] = None,
                  is_admin: Optional[pulumi.Input[_builtins.bool]] = None,
                  scopes: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
@@ -119,6 +133,7 @@ class Teammate(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = TeammateArgs.__new__(TeammateArgs)
 
+            __props__.__dict__["deletion_protection"] = deletion_protection
             if email is None and not opts.urn:
                 raise TypeError("Missing required property 'email'")
             __props__.__dict__["email"] = email
@@ -129,6 +144,8 @@ class Teammate(pulumi.CustomResource):
             __props__.__dict__["token"] = None
             __props__.__dict__["user_type"] = None
             __props__.__dict__["username"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["token"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(Teammate, __self__).__init__(
             'sendgrid:index:Teammate',
             resource_name,
@@ -151,6 +168,7 @@ class Teammate(pulumi.CustomResource):
 
         __props__ = TeammateArgs.__new__(TeammateArgs)
 
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["email"] = None
         __props__.__dict__["first_name"] = None
         __props__.__dict__["is_admin"] = None
@@ -161,6 +179,11 @@ class Teammate(pulumi.CustomResource):
         __props__.__dict__["username"] = None
         return Teammate(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def email(self) -> pulumi.Output[_builtins.str]:
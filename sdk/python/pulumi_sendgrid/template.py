@@ -21,12 +21,19 @@ __all__ = ['TemplateArgs', 'Template']
 class TemplateArgs:
     def __init__(__self__, *,
                  generation: pulumi.Input[_builtins.str],
-                 name: pulumi.Input[_builtins.str]):
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 force_delete: Optional[pulumi.Input[_builtins.bool]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None):
         """
         The set of arguments for constructing a Template resource.
         """
         pulumi.set(__self__, "generation", generation)
-        pulumi.set(__self__, "name", name)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if force_delete is not None:
+            pulumi.set(__self__, "force_delete", force_delete)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
 
     @_builtins.property
     @pulumi.getter
@@ -37,13 +44,31 @@ class TemplateArgs:
     def generation(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "generation", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="forceDelete")
+    def force_delete(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "force_delete")
+
+    @force_delete.setter
+    def force_delete(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "force_delete", value)
+
     @_builtins.property
     @pulumi.getter
-    def name(self) -> pulumi.Input[_builtins.str]:
+    def name(self) -> Optional[pulumi.Input[_builtins.str]]:
         return pulumi.get(self, "name")
 
     @name.setter
-    def name(self, value: pulumi.Input[_builtins.str]):
+    def name(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "name", value)
 
 
@@ -53,6 +78,8 @@ class Template(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 force_delete: Optional[pulumi.Input[_builtins.bool]] = None,
                  generation: Optional[pulumi.Input[_builtins.str]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
@@ -63,7 +90,7 @@ class Template(pulumi.CustomResource):
 
         Templates can be either 'legacy' (plain text/HTML) or 'dynamic' (supporting handlebars syntax for personalization).
 
-        **Note:** Template versions are managed separately via the TemplateVersion resource.
+        **Note:** Template versions are managed separately via the TemplateVersion resource. Deleting a template that still has versions, e.g. ones created outside of Pulumi, fails unless forceDelete is set.
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -81,7 +108,7 @@ class Template(pulumi.CustomResource):
 
         Templates can be either 'legacy' (plain text/HTML) or 'dynamic' (supporting handlebars syntax for personalization).
 
-        **Note:** Template versions are managed separately via the TemplateVersion resource.
+        **Note:** Template versions are managed separately via the TemplateVersion resource. Deleting a template that still has versions, e.g. ones created outside of Pulumi, fails unless forceDelete is set.
 
         :param str resource_name: The name of the resource.
         :param TemplateArgs args: The arguments to use to populate this resource's properties.
@@ -98,6 +125,8 @@ class Template(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 force_delete: Optional[pulumi.Input[_builtins.bool]] = None,
                  generation: Optional[pulumi.Input[_builtins.str]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
@@ -109,15 +138,17 @@ class Template(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = TemplateArgs.__new__(TemplateArgs)
 
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            __props__.__dict__["force_delete"] = force_delete
             if generation is None and not opts.urn:
                 raise TypeError("Missing required property 'generation'")
             __props__.__dict__["generation"] = generation
-            if name is None and not opts.urn:
-                raise TypeError("Missing required property 'name'")
             __props__.__dict__["name"] = name
             __props__.__dict__["template_id"] = None
             __props__.__dict__["updated_at"] = None
             __props__.__dict__["versions"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["generation"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(Template, __self__).__init__(
             'sendgrid:index:Template',
             resource_name,
@@ -140,6 +171,8 @@ class Template(pulumi.CustomResource):
 
         __props__ = TemplateArgs.__new__(TemplateArgs)
 
+        __props__.__dict__["deletion_protection"] = None
+        __props__.__dict__["force_delete"] = None
         __props__.__dict__["generation"] = None
         __props__.__dict__["name"] = None
         __props__.__dict__["template_id"] = None
@@ -147,6 +180,16 @@ class Template(pulumi.CustomResource):
         __props__.__dict__["versions"] = None
         return Template(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @_builtins.property
+    @pulumi.getter(name="forceDelete")
+    def force_delete(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "force_delete")
+
     @_builtins.property
     @pulumi.getter
     def generation(self) -> pulumi.Output[_builtins.str]:
@@ -154,7 +197,7 @@ class Template(pulumi.CustomResource):
 
     @_builtins.property
     @pulumi.getter
-    def name(self) -> pulumi.Output[_builtins.str]:
+    def name(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "name")
 
     @_builtins.property
@@ -0,0 +1,180 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['TemplateDirectoryArgs', 'TemplateDirectory']
+
+@pulumi.input_type
+class TemplateDirectoryArgs:
+    def __init__(__self__, *,
+                 directory: _builtins.str,
+                 template_id: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 generate_plain_content: Optional[pulumi.Input[_builtins.bool]] = None,
+                 subject: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a TemplateDirectory resource.
+        """
+        pulumi.set(__self__, "directory", directory)
+        pulumi.set(__self__, "template_id", template_id)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if generate_plain_content is not None:
+            pulumi.set(__self__, "generate_plain_content", generate_plain_content)
+        if subject is not None:
+            pulumi.set(__self__, "subject", subject)
+
+    @_builtins.property
+    @pulumi.getter
+    def directory(self) -> _builtins.str:
+        return pulumi.get(self, "directory")
+
+    @directory.setter
+    def directory(self, value: _builtins.str):
+        pulumi.set(self, "directory", value)
+
+    @_builtins.property
+    @pulumi.getter(name="templateId")
+    def template_id(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "template_id")
+
+    @template_id.setter
+    def template_id(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "template_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="generatePlainContent")
+    def generate_plain_content(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "generate_plain_content")
+
+    @generate_plain_content.setter
+    def generate_plain_content(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "generate_plain_content", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def subject(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "subject")
+
+    @subject.setter
+    def subject(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "subject", value)
+
+
+@pulumi.type_token("sendgrid:index:TemplateDirectory")
+class TemplateDirectory(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 directory: Optional[_builtins.str] = None,
+                 generate_plain_content: Optional[pulumi.Input[_builtins.bool]] = None,
+                 subject: Optional[pulumi.Input[_builtins.str]] = None,
+                 template_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Syncs a directory of HTML files to versions of a SendGrid template.
+
+        Each .html file in the directory becomes a TemplateVersion named after the file without its extension. Files are ordered by name, and the last one is the newest and is made the active version, so names should sort by age, e.g. with a date or version prefix. A version is only updated when the content of its file changes; adding a file adds a version and removing one deletes its version.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: TemplateDirectoryArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Syncs a directory of HTML files to versions of a SendGrid template.
+
+        Each .html file in the directory becomes a TemplateVersion named after the file without its extension. Files are ordered by name, and the last one is the newest and is made the active version, so names should sort by age, e.g. with a date or version prefix. A version is only updated when the content of its file changes; adding a file adds a version and removing one deletes its version.
+
+        :param str resource_name: The name of the resource.
+        :param TemplateDirectoryArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(TemplateDirectoryArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 directory: Optional[_builtins.str] = None,
+                 generate_plain_content: Optional[pulumi.Input[_builtins.bool]] = None,
+                 subject: Optional[pulumi.Input[_builtins.str]] = None,
+                 template_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = TemplateDirectoryArgs.__new__(TemplateDirectoryArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if directory is None and not opts.urn:
+                raise TypeError("Missing required property 'directory'")
+            __props__.__dict__["directory"] = directory
+            __props__.__dict__["generate_plain_content"] = generate_plain_content
+            __props__.__dict__["subject"] = subject
+            if template_id is None and not opts.urn:
+                raise TypeError("Missing required property 'template_id'")
+            __props__.__dict__["template_id"] = template_id
+            __props__.__dict__["active_version_id"] = None
+            __props__.__dict__["content_hashes"] = None
+            __props__.__dict__["version_ids"] = None
+        super(TemplateDirectory, __self__).__init__(
+            'sendgrid:index:TemplateDirectory',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter(name="activeVersionId")
+    def active_version_id(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "active_version_id")
+
+    @_builtins.property
+    @pulumi.getter(name="contentHashes")
+    def content_hashes(self) -> pulumi.Output[Mapping[str, _builtins.str]]:
+        return pulumi.get(self, "content_hashes")
+
+    @_builtins.property
+    @pulumi.getter(name="versionIds")
+    def version_ids(self) -> pulumi.Output[Mapping[str, _builtins.str]]:
+        return pulumi.get(self, "version_ids")
+
@@ -22,10 +22,12 @@ class TemplateVersionArgs:
                  name: pulumi.Input[_builtins.str],
                  template_id: pulumi.Input[_builtins.str],
                  active: Optional[pulumi.Input[_builtins.int]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  editor: Optional[pulumi.Input[_builtins.str]] = None,
                  generate_plain_content: Optional[pulumi.Input[_builtins.bool]] = None,
                  html_content: Optional[pulumi.Input[_builtins.str]] = None,
                  plain_content: Optional[pulumi.Input[_builtins.str]] = None,
+                 render_preview: Optional[pulumi.Input[_builtins.bool]] = None,
                  subject: Optional[pulumi.Input[_builtins.str]] = None,
                  test_data: Optional[pulumi.Input[_builtins.str]] = None):
         """
@@ -35,6 +37,8 @@ class TemplateVersionArgs:
         pulumi.set(__self__, "template_id", template_id)
         if active is not None:
             pulumi.set(__self__, "active", active)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if editor is not None:
             pulumi.set(__self__, "editor", editor)
         if generate_plain_content is not None:
@@ -43,6 +47,8 @@ class TemplateVersionArgs:
             pulumi.set(__self__, "html_content", html_content)
         if plain_content is not None:
             pulumi.set(__self__, "plain_content", plain_content)
+        if render_preview is not None:
+            pulumi.set(__self__, "render_preview", render_preview)
         if subject is not None:
             pulumi.set(__self__, "subject", subject)
         if test_data is not None:
@@ -75,6 +81,15 @@ class TemplateVersionArgs:
     def active(self, value: Optional[pulumi.Input[_builtins.int]]):
         pulumi.set(self, "active", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter
     def editor(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -111,6 +126,15 @@ class TemplateVersionArgs:
     def plain_content(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "plain_content", value)
 
+    @_builtins.property
+    @pulumi.getter(name="renderPreview")
+    def render_preview(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "render_preview")
+
+    @render_preview.setter
+    def render_preview(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "render_preview", value)
+
     @_builtins.property
     @pulumi.getter
     def subject(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -137,11 +161,13 @@ class TemplateVersion(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  active: Optional[pulumi.Input[_builtins.int]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  editor: Optional[pulumi.Input[_builtins.str]] = None,
                  generate_plain_content: Optional[pulumi.Input[_builtins.bool]] = None,
                  html_content: Optional[pulumi.Input[_builtins.str]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  plain_content: Optional[pulumi.Input[_builtins.str]] = None,
+                 render_preview: Optional[pulumi.Input[_builtins.bool]] = None,
                  subject: Optional[pulumi.Input[_builtins.str]] = None,
                  template_id: Optional[pulumi.Input[_builtins.str]] = None,
                  test_data: Optional[pulumi.Input[_builtins.str]] = None,
@@ -153,6 +179,8 @@ class TemplateVersion(pulumi.CustomResource):
 
         Each template can have multiple versions, but only one can be active at a time. The active version is used when sending emails through the template.
 
+        Activating a version deactivates the one that was active. When that happens to a version with active set to 1, refresh records it as inactive with the deactivatedBy output, and it is not activated again until active is changed, so two versions set active do not take turns.
+
         **Note:** Dynamic templates support handlebars syntax for personalization.
 
         :param str resource_name: The name of the resource.
@@ -171,6 +199,8 @@ class TemplateVersion(pulumi.CustomResource):
 
         Each template can have multiple versions, but only one can be active at a time. The active version is used when sending emails through the template.
 
+        Activating a version deactivates the one that was active. When that happens to a version with active set to 1, refresh records it as inactive with the deactivatedBy output, and it is not activated again until active is changed, so two versions set active do not take turns.
+
         **Note:** Dynamic templates support handlebars syntax for personalization.
 
         :param str resource_name: The name of the resource.
@@ -189,11 +219,13 @@ class TemplateVersion(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  active: Optional[pulumi.Input[_builtins.int]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  editor: Optional[pulumi.Input[_builtins.str]] = None,
                  generate_plain_content: Optional[pulumi.Input[_builtins.bool]] = None,
                  html_content: Optional[pulumi.Input[_builtins.str]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  plain_content: Optional[pulumi.Input[_builtins.str]] = None,
+                 render_preview: Optional[pulumi.Input[_builtins.bool]] = None,
                  subject: Optional[pulumi.Input[_builtins.str]] = None,
                  template_id: Optional[pulumi.Input[_builtins.str]] = None,
                  test_data: Optional[pulumi.Input[_builtins.str]] = None,
@@ -207,6 +239,7 @@ class TemplateVersion(pulumi.CustomResource):
             __props__ = TemplateVersionArgs.__new__(TemplateVersionArgs)
 
             __props__.__dict__["active"] = active
+            __props__.__dict__["deletion_protection"] = deletion_protection
             __props__.__dict__["editor"] = editor
             __props__.__dict__["generate_plain_content"] = generate_plain_content
             __props__.__dict__["html_content"] = html_content
@@ -214,14 +247,18 @@ class TemplateVersion(pulumi.CustomResource):
                 raise TypeError("Missing required property 'name'")
             __props__.__dict__["name"] = name
             __props__.__dict__["plain_content"] = plain_content
+            __props__.__dict__["render_preview"] = render_preview
             __props__.__dict__["subject"] = subject
             if template_id is None and not opts.urn:
                 raise TypeError("Missing required property 'template_id'")
             __props__.__dict__["template_id"] = template_id
             __props__.__dict__["test_data"] = test_data
+            __props__.__dict__["deactivated_by"] = None
             __props__.__dict__["thumbnail_url"] = None
             __props__.__dict__["updated_at"] = None
             __props__.__dict__["version_id"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["templateId"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(TemplateVersion, __self__).__init__(
             'sendgrid:index:TemplateVersion',
             resource_name,
@@ -245,11 +282,14 @@ class TemplateVersion(pulumi.CustomResource):
         __props__ = TemplateVersionArgs.__new__(TemplateVersionArgs)
 
         __props__.__dict__["active"] = None
+        __props__.__dict__["deactivated_by"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["editor"] = None
         __props__.__dict__["generate_plain_content"] = None
         __props__.__dict__["html_content"] = None
         __props__.__dict__["name"] = None
         __props__.__dict__["plain_content"] = None
+        __props__.__dict__["render_preview"] = None
         __props__.__dict__["subject"] = None
         __props__.__dict__["template_id"] = None
         __props__.__dict__["test_data"] = None
@@ -263,6 +303,16 @@ class TemplateVersion(pulumi.CustomResource):
     def active(self) -> pulumi.Output[Optional[_builtins.int]]:
         return pulumi.get(self, "active")
 
+    @_builtins.property
+    @pulumi.getter(name="deactivatedBy")
+    def deactivated_by(self) -> pulumi.Output[Optional[_builtins.str]]:
+        return pulumi.get(self, "deactivated_by")
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def editor(self) -> pulumi.Output[Optional[_builtins.str]]:
@@ -288,6 +338,11 @@ class TemplateVersion(pulumi.CustomResource):
     def plain_content(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "plain_content")
 
+    @_builtins.property
+    @pulumi.getter(name="renderPreview")
+    def render_preview(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "render_preview")
+
     @_builtins.property
     @pulumi.getter
     def subject(self) -> pulumi.Output[Optional[_builtins.str]]:
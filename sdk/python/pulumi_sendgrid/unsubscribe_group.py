@@ -19,26 +19,45 @@ __all__ = ['UnsubscribeGroupArgs', 'UnsubscribeGroup']
 @pulumi.input_type
 class UnsubscribeGroupArgs:
     def __init__(__self__, *,
-                 name: pulumi.Input[_builtins.str],
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  description: Optional[pulumi.Input[_builtins.str]] = None,
-                 is_default: Optional[pulumi.Input[_builtins.bool]] = None):
+                 force_delete: Optional[pulumi.Input[_builtins.bool]] = None,
+                 is_default: Optional[pulumi.Input[_builtins.bool]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None):
         """
         The set of arguments for constructing a UnsubscribeGroup resource.
         """
-        pulumi.set(__self__, "name", name)
+        if adopt_existing is not None:
+            pulumi.set(__self__, "adopt_existing", adopt_existing)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if description is not None:
             pulumi.set(__self__, "description", description)
+        if force_delete is not None:
+            pulumi.set(__self__, "force_delete", force_delete)
         if is_default is not None:
             pulumi.set(__self__, "is_default", is_default)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
 
     @_builtins.property
-    @pulumi.getter
-    def name(self) -> pulumi.Input[_builtins.str]:
-        return pulumi.get(self, "name")
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
 
-    @name.setter
-    def name(self, value: pulumi.Input[_builtins.str]):
-        pulumi.set(self, "name", value)
+    @adopt_existing.setter
+    def adopt_existing(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "adopt_existing", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
 
     @_builtins.property
     @pulumi.getter
@@ -49,6 +68,15 @@ class UnsubscribeGroupArgs:
     def description(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "description", value)
 
+    @_builtins.property
+    @pulumi.getter(name="forceDelete")
+    def force_delete(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "force_delete")
+
+    @force_delete.setter
+    def force_delete(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "force_delete", value)
+
     @_builtins.property
     @pulumi.getter(name="isDefault")
     def is_default(self) -> Optional[pulumi.Input[_builtins.bool]]:
@@ -58,6 +86,15 @@ class UnsubscribeGroupArgs:
     def is_default(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "is_default", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "name", value)
+
 
 @pulumi.type_token("sendgrid:index:UnsubscribeGroup")
 class UnsubscribeGroup(pulumi.CustomResource):
@@ -65,7 +102,10 @@ class UnsubscribeGroup(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  description: Optional[pulumi.Input[_builtins.str]] = None,
+                 force_delete: Optional[pulumi.Input[_builtins.bool]] = None,
                  is_default: Optional[pulumi.Input[_builtins.bool]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
@@ -76,6 +116,10 @@ class UnsubscribeGroup(pulumi.CustomResource):
 
         When a recipient unsubscribes from a group, they will no longer receive emails that are associated with that group.
 
+        Deleting the account's default group fails, so emails sent without a group keep an unsubscribe option. Make another group the default first, e.g. by creating one with isDefault set to true.
+
+        When SendGrid refuses to delete a group because it still has suppressions, the delete fails unless forceDelete is set, in which case the suppressions are removed and the delete retried.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
         """
@@ -83,7 +127,7 @@ class UnsubscribeGroup(pulumi.CustomResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
-                 args: UnsubscribeGroupArgs,
+                 args: Optional[UnsubscribeGroupArgs] = None,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
         Manages a SendGrid Unsubscribe Group (Advanced Suppression Management).
@@ -92,6 +136,10 @@ class UnsubscribeGroup(pulumi.CustomResource):
 
         When a recipient unsubscribes from a group, they will no longer receive emails that are associated with that group.
 
+        Deleting the account's default group fails, so emails sent without a group keep an unsubscribe option. Make another group the default first, e.g. by creating one with isDefault set to true.
+
+        When SendGrid refuses to delete a group because it still has suppressions, the delete fails unless forceDelete is set, in which case the suppressions are removed and the delete retried.
+
         :param str resource_name: The name of the resource.
         :param UnsubscribeGroupArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -107,7 +155,10 @@ class UnsubscribeGroup(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 adopt_existing: Optional[pulumi.Input[_builtins.bool]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  description: Optional[pulumi.Input[_builtins.str]] = None,
+                 force_delete: Optional[pulumi.Input[_builtins.bool]] = None,
                  is_default: Optional[pulumi.Input[_builtins.bool]] = None,
                  name: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
@@ -119,10 +170,11 @@ class UnsubscribeGroup(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = UnsubscribeGroupArgs.__new__(UnsubscribeGroupArgs)
 
+            __props__.__dict__["adopt_existing"] = adopt_existing
+            __props__.__dict__["deletion_protection"] = deletion_protection
             __props__.__dict__["description"] = description
+            __props__.__dict__["force_delete"] = force_delete
             __props__.__dict__["is_default"] = is_default
-            if name is None and not opts.urn:
-                raise TypeError("Missing required property 'name'")
             __props__.__dict__["name"] = name
             __props__.__dict__["group_id"] = None
             __props__.__dict__["unsubscribes"] = None
@@ -148,18 +200,36 @@ class UnsubscribeGroup(pulumi.CustomResource):
 
         __props__ = UnsubscribeGroupArgs.__new__(UnsubscribeGroupArgs)
 
+        __props__.__dict__["adopt_existing"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["description"] = None
+        __props__.__dict__["force_delete"] = None
         __props__.__dict__["group_id"] = None
         __props__.__dict__["is_default"] = None
         __props__.__dict__["name"] = None
         __props__.__dict__["unsubscribes"] = None
         return UnsubscribeGroup(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter(name="adoptExisting")
+    def adopt_existing(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "adopt_existing")
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter
     def description(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "description")
 
+    @_builtins.property
+    @pulumi.getter(name="forceDelete")
+    def force_delete(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "force_delete")
+
     @_builtins.property
     @pulumi.getter(name="groupId")
     def group_id(self) -> pulumi.Output[_builtins.int]:
@@ -172,7 +242,7 @@ class UnsubscribeGroup(pulumi.CustomResource):
 
     @_builtins.property
     @pulumi.getter
-    def name(self) -> pulumi.Output[_builtins.str]:
+    def name(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "name")
 
     @_builtins.property
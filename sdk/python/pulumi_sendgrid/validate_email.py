@@ -0,0 +1,136 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'ValidateEmailResult',
+    'AwaitableValidateEmailResult',
+    'validate_email',
+    'validate_email_output',
+]
+
+@pulumi.output_type
+class ValidateEmailResult:
+    def __init__(__self__, checks=None, host=None, local=None, score=None, suggestion=None, verdict=None):
+        if checks and not isinstance(checks, dict):
+            raise TypeError("Expected argument 'checks' to be a dict")
+        pulumi.set(__self__, "checks", checks)
+        if host and not isinstance(host, str):
+            raise TypeError("Expected argument 'host' to be a str")
+        pulumi.set(__self__, "host", host)
+        if local and not isinstance(local, str):
+            raise TypeError("Expected argument 'local' to be a str")
+        pulumi.set(__self__, "local", local)
+        if score and not isinstance(score, float):
+            raise TypeError("Expected argument 'score' to be a float")
+        pulumi.set(__self__, "score", score)
+        if suggestion and not isinstance(suggestion, str):
+            raise TypeError("Expected argument 'suggestion' to be a str")
+        pulumi.set(__self__, "suggestion", suggestion)
+        if verdict and not isinstance(verdict, str):
+            raise TypeError("Expected argument 'verdict' to be a str")
+        pulumi.set(__self__, "verdict", verdict)
+
+    @_builtins.property
+    @pulumi.getter
+    def checks(self) -> 'outputs.EmailValidationChecks':
+        return pulumi.get(self, "checks")
+
+    @_builtins.property
+    @pulumi.getter
+    def host(self) -> _builtins.str:
+        return pulumi.get(self, "host")
+
+    @_builtins.property
+    @pulumi.getter
+    def local(self) -> _builtins.str:
+        return pulumi.get(self, "local")
+
+    @_builtins.property
+    @pulumi.getter
+    def score(self) -> _builtins.float:
+        return pulumi.get(self, "score")
+
+    @_builtins.property
+    @pulumi.getter
+    def suggestion(self) -> Optional[_builtins.str]:
+        return pulumi.get(self, "suggestion")
+
+    @_builtins.property
+    @pulumi.getter
+    def verdict(self) -> _builtins.str:
+        return pulumi.get(self, "verdict")
+
+
+class AwaitableValidateEmailResult(ValidateEmailResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ValidateEmailResult(
+            checks=self.checks,
+            host=self.host,
+            local=self.local,
+            score=self.score,
+            suggestion=self.suggestion,
+            verdict=self.verdict)
+
+
+def validate_email(email: Optional[_builtins.str] = None,
+                   source: Optional[_builtins.str] = None,
+                   opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableValidateEmailResult:
+    """
+    Validates an email address with the SendGrid Email Address Validation API.
+
+    Returns a verdict, a score and the checks behind them, so provisioning programs can vet alert recipients, teammate emails and verified sender addresses before creating resources.
+
+    Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.
+    """
+    __args__ = dict()
+    __args__['email'] = email
+    __args__['source'] = source
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('sendgrid:index:validateEmail', __args__, opts=opts, typ=ValidateEmailResult).value
+
+    return AwaitableValidateEmailResult(
+        checks=pulumi.get(__ret__, 'checks'),
+        host=pulumi.get(__ret__, 'host'),
+        local=pulumi.get(__ret__, 'local'),
+        score=pulumi.get(__ret__, 'score'),
+        suggestion=pulumi.get(__ret__, 'suggestion'),
+        verdict=pulumi.get(__ret__, 'verdict'))
+def validate_email_output(email: Optional[pulumi.Input[_builtins.str]] = None,
+                          source: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                          opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ValidateEmailResult]:
+    """
+    Validates an email address with the SendGrid Email Address Validation API.
+
+    Returns a verdict, a score and the checks behind them, so provisioning programs can vet alert recipients, teammate emails and verified sender addresses before creating resources.
+
+    Email validation is a paid add-on, and the provider's API key needs the validation.email.create scope.
+    """
+    __args__ = dict()
+    __args__['email'] = email
+    __args__['source'] = source
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('sendgrid:index:validateEmail', __args__, opts=opts, typ=ValidateEmailResult)
+    return __ret__.apply(lambda __response__: ValidateEmailResult(
+        checks=pulumi.get(__response__, 'checks'),
+        host=pulumi.get(__response__, 'host'),
+        local=pulumi.get(__response__, 'local'),
+        score=pulumi.get(__response__, 'score'),
+        suggestion=pulumi.get(__response__, 'suggestion'),
+        verdict=pulumi.get(__response__, 'verdict')))
@@ -23,12 +23,16 @@ class VerifiedSenderArgs:
                  city: pulumi.Input[_builtins.str],
                  country: pulumi.Input[_builtins.str],
                  from_email: pulumi.Input[_builtins.str],
-                 nickname: pulumi.Input[_builtins.str],
                  reply_to: pulumi.Input[_builtins.str],
                  address2: Optional[pulumi.Input[_builtins.str]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  from_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 nickname: Optional[pulumi.Input[_builtins.str]] = None,
                  reply_to_name: Optional[pulumi.Input[_builtins.str]] = None,
                  state: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_verification: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  zip: Optional[pulumi.Input[_builtins.str]] = None):
         """
         The set of arguments for constructing a VerifiedSender resource.
@@ -37,16 +41,25 @@ class VerifiedSenderArgs:
         pulumi.set(__self__, "city", city)
         pulumi.set(__self__, "country", country)
         pulumi.set(__self__, "from_email", from_email)
-        pulumi.set(__self__, "nickname", nickname)
         pulumi.set(__self__, "reply_to", reply_to)
         if address2 is not None:
             pulumi.set(__self__, "address2", address2)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
         if from_name is not None:
             pulumi.set(__self__, "from_name", from_name)
+        if nickname is not None:
+            pulumi.set(__self__, "nickname", nickname)
         if reply_to_name is not None:
             pulumi.set(__self__, "reply_to_name", reply_to_name)
         if state is not None:
             pulumi.set(__self__, "state", state)
+        if wait_for_verification is not None:
+            pulumi.set(__self__, "wait_for_verification", wait_for_verification)
+        if wait_interval_seconds is not None:
+            pulumi.set(__self__, "wait_interval_seconds", wait_interval_seconds)
+        if wait_timeout_seconds is not None:
+            pulumi.set(__self__, "wait_timeout_seconds", wait_timeout_seconds)
         if zip is not None:
             pulumi.set(__self__, "zip", zip)
 
@@ -86,15 +99,6 @@ class VerifiedSenderArgs:
     def from_email(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "from_email", value)
 
-    @_builtins.property
-    @pulumi.getter
-    def nickname(self) -> pulumi.Input[_builtins.str]:
-        return pulumi.get(self, "nickname")
-
-    @nickname.setter
-    def nickname(self, value: pulumi.Input[_builtins.str]):
-        pulumi.set(self, "nickname", value)
-
     @_builtins.property
     @pulumi.getter(name="replyTo")
     def reply_to(self) -> pulumi.Input[_builtins.str]:
@@ -113,6 +117,15 @@ class VerifiedSenderArgs:
     def address2(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "address2", value)
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
     @_builtins.property
     @pulumi.getter(name="fromName")
     def from_name(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -122,6 +135,15 @@ class VerifiedSenderArgs:
     def from_name(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "from_name", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def nickname(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "nickname")
+
+    @nickname.setter
+    def nickname(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "nickname", value)
+
     @_builtins.property
     @pulumi.getter(name="replyToName")
     def reply_to_name(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -140,6 +162,33 @@ class VerifiedSenderArgs:
     def state(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "state", value)
 
+    @_builtins.property
+    @pulumi.getter(name="waitForVerification")
+    def wait_for_verification(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "wait_for_verification")
+
+    @wait_for_verification.setter
+    def wait_for_verification(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "wait_for_verification", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitIntervalSeconds")
+    def wait_interval_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "wait_interval_seconds")
+
+    @wait_interval_seconds.setter
+    def wait_interval_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "wait_interval_seconds", value)
+
+    @_builtins.property
+    @pulumi.getter(name="waitTimeoutSeconds")
+    def wait_timeout_seconds(self) -> Optional[pulumi.Input[_builtins.int]]:
+        return pulumi.get(self, "wait_timeout_seconds")
+
+    @wait_timeout_seconds.setter
+    def wait_timeout_seconds(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "wait_timeout_seconds", value)
+
     @_builtins.property
     @pulumi.getter
     def zip(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -160,12 +209,16 @@ class VerifiedSender(pulumi.CustomResource):
                  address2: Optional[pulumi.Input[_builtins.str]] = None,
                  city: Optional[pulumi.Input[_builtins.str]] = None,
                  country: Optional[pulumi.Input[_builtins.str]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  from_email: Optional[pulumi.Input[_builtins.str]] = None,
                  from_name: Optional[pulumi.Input[_builtins.str]] = None,
                  nickname: Optional[pulumi.Input[_builtins.str]] = None,
                  reply_to: Optional[pulumi.Input[_builtins.str]] = None,
                  reply_to_name: Optional[pulumi.Input[_builtins.str]] = None,
                  state: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_verification: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  zip: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
         """
@@ -210,12 +263,16 @@ class VerifiedSender(pulumi.CustomResource):
                  address2: Optional[pulumi.Input[_builtins.str]] = None,
                  city: Optional[pulumi.Input[_builtins.str]] = None,
                  country: Optional[pulumi.Input[_builtins.str]] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
                  from_email: Optional[pulumi.Input[_builtins.str]] = None,
                  from_name: Optional[pulumi.Input[_builtins.str]] = None,
                  nickname: Optional[pulumi.Input[_builtins.str]] = None,
                  reply_to: Optional[pulumi.Input[_builtins.str]] = None,
                  reply_to_name: Optional[pulumi.Input[_builtins.str]] = None,
                  state: Optional[pulumi.Input[_builtins.str]] = None,
+                 wait_for_verification: Optional[pulumi.Input[_builtins.bool]] = None,
+                 wait_interval_seconds: Optional[pulumi.Input[_builtins.int]] = None,
+                 wait_timeout_seconds: Optional[pulumi.Input[_builtins.int]] = None,
                  zip: Optional[pulumi.Input[_builtins.str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -236,22 +293,26 @@ class VerifiedSender(pulumi.CustomResource):
             if country is None and not opts.urn:
                 raise TypeError("Missing required property 'country'")
             __props__.__dict__["country"] = country
+            __props__.__dict__["deletion_protection"] = deletion_protection
             if from_email is None and not opts.urn:
                 raise TypeError("Missing required property 'from_email'")
             __props__.__dict__["from_email"] = from_email
             __props__.__dict__["from_name"] = from_name
-            if nickname is None and not opts.urn:
-                raise TypeError("Missing required property 'nickname'")
             __props__.__dict__["nickname"] = nickname
             if reply_to is None and not opts.urn:
                 raise TypeError("Missing required property 'reply_to'")
             __props__.__dict__["reply_to"] = reply_to
             __props__.__dict__["reply_to_name"] = reply_to_name
             __props__.__dict__["state"] = state
+            __props__.__dict__["wait_for_verification"] = wait_for_verification
+            __props__.__dict__["wait_interval_seconds"] = wait_interval_seconds
+            __props__.__dict__["wait_timeout_seconds"] = wait_timeout_seconds
             __props__.__dict__["zip"] = zip
             __props__.__dict__["locked"] = None
             __props__.__dict__["sender_id"] = None
             __props__.__dict__["verified"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["fromEmail"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(VerifiedSender, __self__).__init__(
             'sendgrid:index:VerifiedSender',
             resource_name,
@@ -278,6 +339,7 @@ class VerifiedSender(pulumi.CustomResource):
         __props__.__dict__["address2"] = None
         __props__.__dict__["city"] = None
         __props__.__dict__["country"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["from_email"] = None
         __props__.__dict__["from_name"] = None
         __props__.__dict__["locked"] = None
@@ -287,6 +349,9 @@ class VerifiedSender(pulumi.CustomResource):
         __props__.__dict__["sender_id"] = None
         __props__.__dict__["state"] = None
         __props__.__dict__["verified"] = None
+        __props__.__dict__["wait_for_verification"] = None
+        __props__.__dict__["wait_interval_seconds"] = None
+        __props__.__dict__["wait_timeout_seconds"] = None
         __props__.__dict__["zip"] = None
         return VerifiedSender(resource_name, opts=opts, __props__=__props__)
 
@@ -310,6 +375,11 @@ class VerifiedSender(pulumi.CustomResource):
     def country(self) -> pulumi.Output[_builtins.str]:
         return pulumi.get(self, "country")
 
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
     @_builtins.property
     @pulumi.getter(name="fromEmail")
     def from_email(self) -> pulumi.Output[_builtins.str]:
@@ -327,7 +397,7 @@ class VerifiedSender(pulumi.CustomResource):
 
     @_builtins.property
     @pulumi.getter
-    def nickname(self) -> pulumi.Output[_builtins.str]:
+    def nickname(self) -> pulumi.Output[Optional[_builtins.str]]:
         return pulumi.get(self, "nickname")
 
     @_builtins.property
@@ -355,6 +425,21 @@ class VerifiedSender(pulumi.CustomResource):
     def verified(self) -> pulumi.Output[_builtins.bool]:
         return pulumi.get(self, "verified")
 
+    @_builtins.property
+    @pulumi.getter(name="waitForVerification")
+    def wait_for_verification(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        return pulumi.get(self, "wait_for_verification")
+
+    @_builtins.property
+    @pulumi.getter(name="waitIntervalSeconds")
+    def wait_interval_seconds(self) -> pulumi.Output[Optional[_builtins.int]]:
+        return pulumi.get(self, "wait_interval_seconds")
+
+    @_builtins.property
+    @pulumi.getter(name="waitTimeoutSeconds")
+    def wait_timeout_seconds(self) -> pulumi.Output[Optional[_builtins.int]]:
+        return pulumi.get(self, "wait_timeout_seconds")
+
     @_builtins.property
     @pulumi.getter
     def zip(self) -> pulumi.Output[Optional[_builtins.str]]:
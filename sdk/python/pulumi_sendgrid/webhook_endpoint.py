@@ -0,0 +1,234 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['WebhookEndpointArgs', 'WebhookEndpoint']
+
+@pulumi.input_type
+class WebhookEndpointArgs:
+    def __init__(__self__, *,
+                 events: pulumi.Input[Sequence[_builtins.str]],
+                 url: pulumi.Input[_builtins.str],
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 friendly_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 signature_verification: Optional[_builtins.bool] = None,
+                 test_delivery: Optional[_builtins.bool] = None):
+        """
+        The set of arguments for constructing a WebhookEndpoint resource.
+        """
+        pulumi.set(__self__, "events", events)
+        pulumi.set(__self__, "url", url)
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
+        if friendly_name is not None:
+            pulumi.set(__self__, "friendly_name", friendly_name)
+        if oauth_client_id is not None:
+            pulumi.set(__self__, "oauth_client_id", oauth_client_id)
+        if oauth_client_secret is not None:
+            pulumi.set(__self__, "oauth_client_secret", oauth_client_secret)
+        if oauth_token_url is not None:
+            pulumi.set(__self__, "oauth_token_url", oauth_token_url)
+        if signature_verification is not None:
+            pulumi.set(__self__, "signature_verification", signature_verification)
+        if test_delivery is not None:
+            pulumi.set(__self__, "test_delivery", test_delivery)
+
+    @_builtins.property
+    @pulumi.getter
+    def events(self) -> pulumi.Input[Sequence[_builtins.str]]:
+        return pulumi.get(self, "events")
+
+    @events.setter
+    def events(self, value: pulumi.Input[Sequence[_builtins.str]]):
+        pulumi.set(self, "events", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def url(self) -> pulumi.Input[_builtins.str]:
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "url", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="friendlyName")
+    def friendly_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "friendly_name")
+
+    @friendly_name.setter
+    def friendly_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "friendly_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientId")
+    def oauth_client_id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_id")
+
+    @oauth_client_id.setter
+    def oauth_client_id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_client_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthClientSecret")
+    def oauth_client_secret(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_client_secret")
+
+    @oauth_client_secret.setter
+    def oauth_client_secret(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_client_secret", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oauthTokenUrl")
+    def oauth_token_url(self) -> Optional[pulumi.Input[_builtins.str]]:
+        return pulumi.get(self, "oauth_token_url")
+
+    @oauth_token_url.setter
+    def oauth_token_url(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oauth_token_url", value)
+
+    @_builtins.property
+    @pulumi.getter(name="signatureVerification")
+    def signature_verification(self) -> Optional[_builtins.bool]:
+        return pulumi.get(self, "signature_verification")
+
+    @signature_verification.setter
+    def signature_verification(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "signature_verification", value)
+
+    @_builtins.property
+    @pulumi.getter(name="testDelivery")
+    def test_delivery(self) -> Optional[_builtins.bool]:
+        return pulumi.get(self, "test_delivery")
+
+    @test_delivery.setter
+    def test_delivery(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "test_delivery", value)
+
+
+@pulumi.type_token("sendgrid:index:WebhookEndpoint")
+class WebhookEndpoint(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 events: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 friendly_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 signature_verification: Optional[_builtins.bool] = None,
+                 test_delivery: Optional[_builtins.bool] = None,
+                 url: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Sets up a SendGrid Event Webhook for a receiving service.
+
+        The component creates an EventWebhook posting the given events, with optional OAuth settings, enables its signature verification and sends test events once it is set up, which the service's logs show it received. The webhookId and publicKey outputs are what the receiving service needs to verify events.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: WebhookEndpointArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Sets up a SendGrid Event Webhook for a receiving service.
+
+        The component creates an EventWebhook posting the given events, with optional OAuth settings, enables its signature verification and sends test events once it is set up, which the service's logs show it received. The webhookId and publicKey outputs are what the receiving service needs to verify events.
+
+        :param str resource_name: The name of the resource.
+        :param WebhookEndpointArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(WebhookEndpointArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 deletion_protection: Optional[pulumi.Input[_builtins.bool]] = None,
+                 events: Optional[pulumi.Input[Sequence[_builtins.str]]] = None,
+                 friendly_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_client_secret: Optional[pulumi.Input[_builtins.str]] = None,
+                 oauth_token_url: Optional[pulumi.Input[_builtins.str]] = None,
+                 signature_verification: Optional[_builtins.bool] = None,
+                 test_delivery: Optional[_builtins.bool] = None,
+                 url: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = WebhookEndpointArgs.__new__(WebhookEndpointArgs)
+
+            __props__.__dict__["deletion_protection"] = deletion_protection
+            if events is None and not opts.urn:
+                raise TypeError("Missing required property 'events'")
+            __props__.__dict__["events"] = events
+            __props__.__dict__["friendly_name"] = friendly_name
+            __props__.__dict__["oauth_client_id"] = oauth_client_id
+            __props__.__dict__["oauth_client_secret"] = None if oauth_client_secret is None else pulumi.Output.secret(oauth_client_secret)
+            __props__.__dict__["oauth_token_url"] = oauth_token_url
+            __props__.__dict__["signature_verification"] = signature_verification
+            __props__.__dict__["test_delivery"] = test_delivery
+            if url is None and not opts.urn:
+                raise TypeError("Missing required property 'url'")
+            __props__.__dict__["url"] = url
+            __props__.__dict__["public_key"] = None
+            __props__.__dict__["webhook_id"] = None
+        super(WebhookEndpoint, __self__).__init__(
+            'sendgrid:index:WebhookEndpoint',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter(name="publicKey")
+    def public_key(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "public_key")
+
+    @_builtins.property
+    @pulumi.getter(name="webhookId")
+    def webhook_id(self) -> pulumi.Output[_builtins.str]:
+        return pulumi.get(self, "webhook_id")
+